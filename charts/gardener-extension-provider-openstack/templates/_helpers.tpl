@@ -24,4 +24,31 @@ app.kubernetes.io/instance: {{ .Release.Name }}
 
 {{- define "deploymentversion" -}}
 apps/v1
-{{- end -}}
\ No newline at end of file
+{{- end -}}
+{{- /*
+deployments renders the deployments of the extension. The main deployment runs all controllers which are neither
+disabled nor split into a separate deployment and serves the webhooks, every split deployment runs its controllers.
+*/}}
+{{- define "deployments" -}}
+{{- $splitControllers := list }}
+{{- range .Values.splitDeployments }}
+{{- $splitControllers = concat $splitControllers .controllers }}
+{{- end }}
+deployments:
+- name: {{ include "name" . }}
+  leaderElectionID: provider-openstack-leader-election
+  controllers: []
+  disableControllers: {{ concat .Values.disableControllers $splitControllers | uniq | toJson }}
+  webhooks: true
+  replicaCount: {{ .Values.replicaCount }}
+  resources: {{ .Values.resources | toJson }}
+{{- range .Values.splitDeployments }}
+- name: {{ include "name" $ }}-{{ required ".name of split deployments is required" .name }}
+  leaderElectionID: provider-openstack-{{ .name }}-leader-election
+  controllers: {{ required ".controllers of split deployments is required" .controllers | toJson }}
+  disableControllers: {{ $.Values.disableControllers | toJson }}
+  webhooks: false
+  replicaCount: {{ .replicaCount | default $.Values.replicaCount }}
+  resources: {{ .resources | default $.Values.resources | toJson }}
+{{- end }}
+{{- end -}}
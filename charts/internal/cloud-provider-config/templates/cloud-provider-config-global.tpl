@@ -1,7 +1,15 @@
 {{- define "cloud-provider-config-credentials" -}}
 auth-url="{{ .Values.authUrl }}"
+{{- if .Values.domainID }}
+domain-id="{{ .Values.domainID }}"
+{{- else }}
 domain-name="{{ .Values.domainName }}"
+{{- end }}
+{{- if .Values.tenantID }}
+tenant-id="{{ .Values.tenantID }}"
+{{- else }}
 tenant-name="{{ .Values.tenantName }}"
+{{- end }}
 username="{{ .Values.username }}"
 {{- if .Values.password }}
 password="{{ .Values.password }}"
@@ -6,8 +6,20 @@ monitor-timeout="{{ .Values.monitorTimeout }}"
 monitor-max-retries={{ .Values.monitorMaxRetries }}
 lb-version="v2"
 lb-provider="{{ .Values.lbProvider }}"
+{{- if .Values.lbMethod }}
+lb-method="{{ .Values.lbMethod }}"
+{{- end }}
+{{- if .Values.lbAvailabilityZone }}
+availability-zone="{{ .Values.lbAvailabilityZone }}"
+{{- end }}
+{{- if .Values.lbFlavorID }}
+flavor-id="{{ .Values.lbFlavorID }}"
+{{- end }}
 floating-network-id="{{ .Values.floatingNetworkID }}"
 use-octavia="{{ .Values.useOctavia }}"
+{{- if .Values.manageSecurityGroups }}
+manage-security-groups=true
+{{- end }}
 {{- if .Values.floatingSubnetID }}
 floating-subnet-id="{{ .Values.floatingSubnetID }}"
 {{- end }}
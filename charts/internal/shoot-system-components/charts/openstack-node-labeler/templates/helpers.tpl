@@ -0,0 +1,7 @@
+{{- define "openstack-node-labeler.extensionsGroup" -}}
+extensions.gardener.cloud
+{{- end -}}
+
+{{- define "openstack-node-labeler.name" -}}
+provider-openstack
+{{- end -}}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	controllercmd "github.com/gardener/gardener/extensions/pkg/controller/cmd"
 	"github.com/gardener/gardener/extensions/pkg/util"
@@ -17,6 +18,7 @@ import (
 	gardenerhealthz "github.com/gardener/gardener/pkg/healthz"
 	"github.com/spf13/cobra"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	componentbaseconfig "k8s.io/component-base/config"
@@ -28,10 +30,14 @@ import (
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/admission/catalog"
 	admissioncmd "github.com/gardener/gardener-extension-provider-openstack/pkg/admission/cmd"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/admission/flavorcapacity"
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/admission/mutator"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/admission/validator"
 	openstackinstall "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/install"
 	provideropenstack "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+	openstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
 )
 
 // AdmissionName is the name of the admission component.
@@ -74,6 +80,10 @@ func NewAdmissionCommand(ctx context.Context) *cobra.Command {
 
 		enableOverlayAsDefaultForCalico bool
 		enableOverlayAsDefaultForCilium bool
+		flavorCapacityProbeInterval     time.Duration
+		catalogRefreshInterval          time.Duration
+		enableLintEndpoint              bool
+		seedAllowedRegions              []string
 	)
 
 	cmd := &cobra.Command{
@@ -163,15 +173,57 @@ func NewAdmissionCommand(ctx context.Context) *cobra.Command {
 				return fmt.Errorf("could not add readycheck of webhook to manager: %w", err)
 			}
 
+			validator.OpenStackClientFactory = openstackclient.FactoryFactoryFunc(openstackclient.NewOpenstackClientFromCredentials)
+			validator.AllowedRegionsPerSeed, err = validator.ParseAllowedRegionsPerSeed(seedAllowedRegions)
+			if err != nil {
+				return fmt.Errorf("could not parse allowed regions of seeds: %w", err)
+			}
 			mutator.EnableOverlayAsDefaultForCalico = enableOverlayAsDefaultForCalico
 			mutator.EnableOverlayAsDefaultForCilium = enableOverlayAsDefaultForCilium
 
+			if flavorCapacityProbeInterval > 0 {
+				if err := mgr.Add(&flavorcapacity.Prober{
+					Client:         mgr.GetClient(),
+					Reader:         mgr.GetAPIReader(),
+					Decoder:        serializer.NewCodecFactory(mgr.GetScheme()).UniversalDecoder(),
+					FactoryFactory: openstackclient.FactoryFactoryFunc(openstackclient.NewOpenstackClientFromCredentials),
+					Interval:       flavorCapacityProbeInterval,
+					Log:            log.WithName("flavor-capacity-prober"),
+				}); err != nil {
+					return fmt.Errorf("could not add flavor capacity prober to manager: %w", err)
+				}
+				validator.EnableFlavorCapacityWarnings = true
+			}
+
+			if catalogRefreshInterval > 0 {
+				catalogCache := &catalog.Cache{
+					Client:         mgr.GetClient(),
+					Reader:         mgr.GetAPIReader(),
+					Decoder:        serializer.NewCodecFactory(mgr.GetScheme()).UniversalDecoder(),
+					FactoryFactory: openstackclient.FactoryFactoryFunc(openstackclient.NewOpenstackClientFromCredentials),
+					Interval:       catalogRefreshInterval,
+					Log:            log.WithName("catalog"),
+				}
+				if err := mgr.Add(catalogCache); err != nil {
+					return fmt.Errorf("could not add catalog to manager: %w", err)
+				}
+				validator.Catalog = catalogCache
+			}
+
+			if enableLintEndpoint {
+				mgr.GetWebhookServer().Register(validator.LintPath, validator.NewLintHandler(mgr))
+			}
+
 			return mgr.Start(ctx)
 		},
 	}
 
 	cmd.Flags().BoolVar(&enableOverlayAsDefaultForCalico, "enable-overlay-as-default-for-calico", true, "enables network overlay for all new calico shoot clusters")
 	cmd.Flags().BoolVar(&enableOverlayAsDefaultForCilium, "enable-overlay-as-default-for-cilium", true, "enables network overlay for all new cilium shoot clusters")
+	cmd.Flags().DurationVar(&flavorCapacityProbeInterval, "flavor-capacity-probe-interval", 0, "interval in which the capacity of the machine types is probed per zone, probing is disabled if zero")
+	cmd.Flags().DurationVar(&catalogRefreshInterval, "catalog-refresh-interval", 0, "interval in which the catalog of flavors, availability zones and images the worker pools are checked against is refreshed, the catalog is disabled if zero")
+	cmd.Flags().BoolVar(&enableLintEndpoint, "enable-lint-endpoint", false, "enables the endpoint shoot manifests can be linted with without applying them")
+	cmd.Flags().StringArrayVar(&seedAllowedRegions, "seed-allowed-regions", nil, "regions the shoots scheduled to a seed may be located in, given as <seed>=<region>[,<region>...], may be repeated for several seeds")
 
 	verflag.AddFlags(cmd.Flags())
 	aggOption.AddFlags(cmd.Flags())
@@ -34,11 +34,19 @@ import (
 	openstackbackupentry "github.com/gardener/gardener-extension-provider-openstack/pkg/controller/backupentry"
 	openstackbastion "github.com/gardener/gardener-extension-provider-openstack/pkg/controller/bastion"
 	openstackcontrolplane "github.com/gardener/gardener-extension-provider-openstack/pkg/controller/controlplane"
+	openstackcredentialsalias "github.com/gardener/gardener-extension-provider-openstack/pkg/controller/credentialsalias"
 	openstackdnsrecord "github.com/gardener/gardener-extension-provider-openstack/pkg/controller/dnsrecord"
+	openstackendpointprobe "github.com/gardener/gardener-extension-provider-openstack/pkg/controller/endpointprobe"
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/controller/healthcheck"
 	openstackinfrastructure "github.com/gardener/gardener-extension-provider-openstack/pkg/controller/infrastructure"
+	openstackloadbalancerstatus "github.com/gardener/gardener-extension-provider-openstack/pkg/controller/loadbalancerstatus"
+	openstackmaintenance "github.com/gardener/gardener-extension-provider-openstack/pkg/controller/maintenance"
+	openstackport "github.com/gardener/gardener-extension-provider-openstack/pkg/controller/port"
+	openstackquota "github.com/gardener/gardener-extension-provider-openstack/pkg/controller/quota"
 	openstackworker "github.com/gardener/gardener-extension-provider-openstack/pkg/controller/worker"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/features"
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+	openstackcontrolplanewebhook "github.com/gardener/gardener-extension-provider-openstack/pkg/webhook/controlplane"
 	openstackcontrolplaneexposure "github.com/gardener/gardener-extension-provider-openstack/pkg/webhook/controlplaneexposure"
 )
 
@@ -101,6 +109,36 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			MaxConcurrentReconciles: 5,
 		}
 
+		// options for the load balancer status controller
+		loadBalancerStatusCtrlOpts = &controllercmd.ControllerOptions{
+			MaxConcurrentReconciles: 5,
+		}
+
+		// options for the endpoint probe controller
+		endpointProbeCtrlOpts = &controllercmd.ControllerOptions{
+			MaxConcurrentReconciles: 5,
+		}
+
+		// options for the quota controller
+		quotaCtrlOpts = &controllercmd.ControllerOptions{
+			MaxConcurrentReconciles: 5,
+		}
+
+		// options for the maintenance controller
+		maintenanceCtrlOpts = &controllercmd.ControllerOptions{
+			MaxConcurrentReconciles: 5,
+		}
+
+		// options for the credentials alias controller
+		credentialsAliasCtrlOpts = &controllercmd.ControllerOptions{
+			MaxConcurrentReconciles: 5,
+		}
+
+		// options for the port controller
+		portCtrlOpts = &controllercmd.ControllerOptions{
+			MaxConcurrentReconciles: 5,
+		}
+
 		// options for the worker controller
 		workerCtrlOpts = &controllercmd.ControllerOptions{
 			MaxConcurrentReconciles: 5,
@@ -113,6 +151,7 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 
 		gardenerVersion    = new(string)
 		controllerSwitches = openstackcmd.ControllerSwitchOptions()
+		selectionOpts      = openstackcmd.NewSelectionOptions(controllerSwitches)
 		webhookSwitches    = openstackcmd.WebhookSwitchOptions(gardenerVersion)
 		webhookOptions     = webhookcmd.NewAddToManagerOptions(
 			openstack.Name,
@@ -132,9 +171,17 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			controllercmd.PrefixOption("controlplane-", controlPlaneCtrlOpts),
 			controllercmd.PrefixOption("dnsrecord-", dnsRecordCtrlOpts),
 			controllercmd.PrefixOption("infrastructure-", infraCtrlOpts),
+			controllercmd.PrefixOption("loadbalancer-status-", loadBalancerStatusCtrlOpts),
+			controllercmd.PrefixOption("quota-", quotaCtrlOpts),
+			controllercmd.PrefixOption("endpoint-probe-", endpointProbeCtrlOpts),
+			controllercmd.PrefixOption("maintenance-", maintenanceCtrlOpts),
+			controllercmd.PrefixOption("credentials-alias-", credentialsAliasCtrlOpts),
+			controllercmd.PrefixOption("port-", portCtrlOpts),
 			controllercmd.PrefixOption("worker-", workerCtrlOpts),
 			controllercmd.PrefixOption("healthcheck-", healthCheckCtrlOpts),
 			controllercmd.PrefixOption("heartbeat-", heartbeatCtrlOpts),
+			// the selection must be completed before the controller switches as it disables the controllers not selected
+			selectionOpts,
 			controllerSwitches,
 			configFileOpts,
 			reconcileOpts,
@@ -156,6 +203,10 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 				return err
 			}
 
+			if err := configFileOpts.Completed().ApplyFeatureGates(features.ExtensionFeatureGate); err != nil {
+				return fmt.Errorf("could not apply feature gates: %w", err)
+			}
+
 			util.ApplyClientConnectionConfigurationToRESTConfig(configFileOpts.Completed().Config.ClientConnection, restOpts.Completed().Config)
 
 			mgr, err := manager.New(restOpts.Completed().Config, mgrOpts.Completed().Options())
@@ -193,8 +244,16 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			*gardenerVersion = generalOpts.Completed().GardenerVersion
 
 			configFileOpts.Completed().ApplyETCDStorage(&openstackcontrolplaneexposure.DefaultAddOptions.ETCDStorage)
+			configFileOpts.Completed().ApplyZoneRedundantLoadBalancer(&openstackcontrolplaneexposure.DefaultAddOptions.ZoneRedundantLoadBalancer)
 			configFileOpts.Completed().ApplyHealthCheckConfig(&healthcheck.DefaultAddOptions.HealthCheckConfig)
 			configFileOpts.Completed().ApplyBastionConfig(&openstackbastion.DefaultAddOptions.BastionConfig)
+			configFileOpts.Completed().ApplyEgressRestriction(&openstackcontrolplane.DefaultAddOptions.EgressRestriction)
+			configFileOpts.Completed().ApplyEgressRestriction(&openstackcontrolplanewebhook.DefaultAddOptions.EgressRestriction)
+			configFileOpts.Completed().ApplyControlPlaneScheduling(&openstackcontrolplane.DefaultAddOptions.ControlPlaneScheduling)
+			configFileOpts.Completed().ApplyControlPlaneScheduling(&openstackcontrolplanewebhook.DefaultAddOptions.ControlPlaneScheduling)
+			configFileOpts.Completed().ApplyQuotaMonitoring(&openstackquota.DefaultAddOptions.QuotaMonitoring)
+			configFileOpts.Completed().ApplyTerraformer(&openstackinfrastructure.DefaultAddOptions.Terraformer)
+			configFileOpts.Completed().ApplyMachineClassExtraAllowedKeys(&openstackworker.DefaultAddOptions.MachineClassExtraAllowedKeys)
 			healthCheckCtrlOpts.Completed().Apply(&healthcheck.DefaultAddOptions.Controller)
 			heartbeatCtrlOpts.Completed().Apply(&heartbeat.DefaultAddOptions)
 			backupBucketCtrlOpts.Completed().Apply(&openstackbackupbucket.DefaultAddOptions.Controller)
@@ -207,11 +266,19 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			reconcileOpts.Completed().Apply(&openstackcontrolplane.DefaultAddOptions.IgnoreOperationAnnotation)
 			reconcileOpts.Completed().Apply(&openstackworker.DefaultAddOptions.IgnoreOperationAnnotation)
 			reconcileOpts.Completed().Apply(&openstackbastion.DefaultAddOptions.IgnoreOperationAnnotation)
+			loadBalancerStatusCtrlOpts.Completed().Apply(&openstackloadbalancerstatus.DefaultAddOptions.Controller)
+			quotaCtrlOpts.Completed().Apply(&openstackquota.DefaultAddOptions.Controller)
+			endpointProbeCtrlOpts.Completed().Apply(&openstackendpointprobe.DefaultAddOptions.Controller)
+			maintenanceCtrlOpts.Completed().Apply(&openstackmaintenance.DefaultAddOptions.Controller)
+			credentialsAliasCtrlOpts.Completed().Apply(&openstackcredentialsalias.DefaultAddOptions.Controller)
+			portCtrlOpts.Completed().Apply(&openstackport.DefaultAddOptions.Controller)
 			workerCtrlOpts.Completed().Apply(&openstackworker.DefaultAddOptions.Controller)
 			openstackworker.DefaultAddOptions.GardenCluster = gardenCluster
 
-			if _, err := webhookOptions.Completed().AddToManager(ctx, mgr, nil); err != nil {
-				return fmt.Errorf("could not add webhooks to manager: %w", err)
+			if selectionOpts.Completed().Webhooks {
+				if _, err := webhookOptions.Completed().AddToManager(ctx, mgr, nil); err != nil {
+					return fmt.Errorf("could not add webhooks to manager: %w", err)
+				}
 			}
 			openstackcontrolplane.DefaultAddOptions.WebhookServerNamespace = webhookOptions.Server.Namespace
 
@@ -227,8 +294,10 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 				return fmt.Errorf("could not add health check to manager: %w", err)
 			}
 
-			if err := mgr.AddReadyzCheck("webhook-server", mgr.GetWebhookServer().StartedChecker()); err != nil {
-				return fmt.Errorf("could not add ready check for webhook server to manager: %w", err)
+			if selectionOpts.Completed().Webhooks {
+				if err := mgr.AddReadyzCheck("webhook-server", mgr.GetWebhookServer().StartedChecker()); err != nil {
+					return fmt.Errorf("could not add ready check for webhook server to manager: %w", err)
+				}
 			}
 
 			if err := mgr.Start(ctx); err != nil {
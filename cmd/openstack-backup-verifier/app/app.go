@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/spf13/cobra"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/backupverifier"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+	openstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
+)
+
+var log = logf.Log.WithName(openstack.BackupVerifierName)
+
+// options are the command line options of the backup verifier command.
+type options struct {
+	credentialsDir string
+	region         string
+	backupBucket   string
+	maxAge         time.Duration
+}
+
+// NewBackupVerifierCommand creates a new command verifying the integrity of the backups in a backup bucket.
+func NewBackupVerifierCommand(ctx context.Context) *cobra.Command {
+	opts := &options{}
+
+	cmd := &cobra.Command{
+		Use:   openstack.BackupVerifierName,
+		Short: "Verifies the integrity of the recent backups in the Swift container of a backup bucket.",
+		Long: `Verifies the integrity of the recent backups in the Swift container of a backup bucket, i.e. that the content of
+the objects matches their checksums and that large objects consist of all their segments. The result is reported as
+condition of the BackupBucket.`,
+		SilenceUsage: true,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return opts.run(ctx)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&opts.credentialsDir, "credentials-dir", "", "path to the directory containing the OpenStack credentials with one file per key of a backup secret")
+	flags.StringVar(&opts.region, "region", "", "region of the backup bucket")
+	flags.StringVar(&opts.backupBucket, "backup-bucket", "", "name of the backup bucket, which is also the name of its Swift container")
+	flags.DurationVar(&opts.maxAge, "max-age", 24*time.Hour, "maximum age of the verified backups")
+	for _, flag := range []string{"credentials-dir", "region", "backup-bucket"} {
+		if err := cmd.MarkFlagRequired(flag); err != nil {
+			panic(err)
+		}
+	}
+
+	return cmd
+}
+
+func (o *options) run(ctx context.Context) error {
+	restConfig, err := config.GetConfig()
+	if err != nil {
+		return fmt.Errorf("could not get rest config: %w", err)
+	}
+	c, err := client.New(restConfig, client.Options{Scheme: kubernetes.SeedScheme})
+	if err != nil {
+		return fmt.Errorf("could not create client: %w", err)
+	}
+
+	log.Info("Verifying backups", "backupBucket", o.backupBucket, "region", o.region, "maxAge", o.maxAge)
+	result, verifyErr := o.verify(ctx)
+	if verifyErr != nil {
+		log.Error(verifyErr, "Backups could not be verified")
+	} else {
+		log.Info("Verified backups", "verified", result.Verified, "failures", len(result.Failures))
+	}
+
+	if err := backupverifier.ReportCondition(ctx, c, clock.RealClock{}, o.backupBucket, result, verifyErr); err != nil {
+		return fmt.Errorf("could not report result of verification: %w", err)
+	}
+	return verifyErr
+}
+
+func (o *options) verify(ctx context.Context) (*backupverifier.Result, error) {
+	secret, err := openstack.ReadCredentialsSecretFromDir(o.credentialsDir)
+	if err != nil {
+		return nil, err
+	}
+	credentials, err := openstack.ExtractCredentials(secret, false)
+	if err != nil {
+		return nil, err
+	}
+	factory, err := openstackclient.NewOpenstackClientFromCredentials(credentials)
+	if err != nil {
+		return nil, fmt.Errorf("could not create OpenStack client: %w", err)
+	}
+	storage, err := factory.Storage(openstackclient.WithRegion(o.region))
+	if err != nil {
+		return nil, fmt.Errorf("could not create storage client: %w", err)
+	}
+
+	return backupverifier.Verify(ctx, log, storage, o.backupBucket, time.Now().Add(-o.maxAge))
+}
@@ -0,0 +1,197 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/helper"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/clientbuilder"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/openrc"
+)
+
+const (
+	formatRC         = "rc"
+	formatCloudsYAML = "clouds-yaml"
+)
+
+// options are the command line options of the credentials command.
+type options struct {
+	secretPath               string
+	infrastructurePath       string
+	name                     string
+	region                   string
+	authURL                  string
+	format                   string
+	caFile                   string
+	applicationCredentialTTL time.Duration
+}
+
+// NewCredentialsCommand creates a new command printing the credentials of an OpenStack shoot to the given writer.
+func NewCredentialsCommand(out io.Writer) *cobra.Command {
+	opts := &options{}
+
+	cmd := &cobra.Command{
+		Use:   "openstack-credentials",
+		Short: "Prints the credentials of an OpenStack shoot as OpenStack RC file or clouds.yaml.",
+		Long: `Prints the credentials in the given cloud provider secret of an OpenStack shoot as OpenStack RC file, which can
+be sourced in a shell, or as clouds.yaml. If the Infrastructure of the shoot is given, its region is used and the
+resources in its status are listed in a comment.
+
+With --application-credential-ttl, an application credential expiring after the given duration is created for the
+user of the secret and printed instead of its password.`,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return opts.run(out)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&opts.secretPath, "secret", "", "path to the manifest of the Secret containing the OpenStack credentials")
+	flags.StringVar(&opts.infrastructurePath, "infrastructure", "", "path to the manifest of the Infrastructure of the shoot")
+	flags.StringVar(&opts.name, "name", "", "name of the cloud in the clouds.yaml (defaults to the namespace of the Infrastructure or Secret)")
+	flags.StringVar(&opts.region, "region", "", "region of the shoot (defaults to the region of the Infrastructure)")
+	flags.StringVar(&opts.authURL, "auth-url", "", "URL of Keystone (defaults to the authURL of the Secret)")
+	flags.StringVar(&opts.format, "format", formatRC, fmt.Sprintf("output format, either %q or %q", formatRC, formatCloudsYAML))
+	flags.StringVar(&opts.caFile, "ca-file", "", "path the CA bundle of the Secret is written to, required if it contains one")
+	flags.DurationVar(&opts.applicationCredentialTTL, "application-credential-ttl", 0, "if set, a temporary application credential expiring after this duration is issued")
+	if err := cmd.MarkFlagRequired("secret"); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+func (o *options) run(out io.Writer) error {
+	if o.format != formatRC && o.format != formatCloudsYAML {
+		return fmt.Errorf("unsupported format %q, must be %q or %q", o.format, formatRC, formatCloudsYAML)
+	}
+
+	secret := &corev1.Secret{}
+	if err := decodeFile(o.secretPath, secret); err != nil {
+		return err
+	}
+	// stringData is only merged into data by the API server.
+	for key, value := range secret.StringData {
+		if secret.Data == nil {
+			secret.Data = map[string][]byte{}
+		}
+		secret.Data[key] = []byte(value)
+	}
+	credentials, err := openstack.ExtractCredentials(secret, false)
+	if err != nil {
+		return err
+	}
+
+	c := &openrc.Context{
+		Name:        o.name,
+		Credentials: credentials,
+		Region:      o.region,
+	}
+	namespace := secret.Namespace
+
+	if o.infrastructurePath != "" {
+		infra := &extensionsv1alpha1.Infrastructure{}
+		if err := decodeFile(o.infrastructurePath, infra); err != nil {
+			return err
+		}
+		if infra.Status.ProviderStatus != nil {
+			if c.InfrastructureStatus, err = helper.InfrastructureStatusFromRaw(infra.Status.ProviderStatus); err != nil {
+				return fmt.Errorf("could not decode the provider status of the infrastructure: %w", err)
+			}
+		}
+		if c.Region == "" {
+			c.Region = infra.Spec.Region
+		}
+		namespace = infra.Namespace
+	}
+
+	if c.Name == "" {
+		c.Name = namespace
+	}
+	if c.Name == "" {
+		return fmt.Errorf("the name of the cloud cannot be determined, use --name to set it")
+	}
+	if c.Region == "" {
+		return fmt.Errorf("the region cannot be determined, use --region or --infrastructure to set it")
+	}
+	if o.authURL != "" {
+		credentials.AuthURL = o.authURL
+	}
+	if credentials.AuthURL == "" {
+		return fmt.Errorf("the secret does not contain an authURL, use --auth-url to set it")
+	}
+
+	if credentials.CACert != "" {
+		if o.caFile == "" {
+			return fmt.Errorf("the secret contains a CA bundle, use --ca-file to set the path it is written to")
+		}
+		if err := os.WriteFile(o.caFile, []byte(credentials.CACert), 0600); err != nil {
+			return fmt.Errorf("could not write the CA bundle: %w", err)
+		}
+		c.CACertFile = o.caFile
+	}
+
+	if o.applicationCredentialTTL > 0 {
+		if c.Credentials, err = issueApplicationCredential(credentials, c.Name, c.Region, o.applicationCredentialTTL); err != nil {
+			return err
+		}
+	}
+
+	if o.format == formatCloudsYAML {
+		data, err := openrc.CloudsYAML(c)
+		if err != nil {
+			return err
+		}
+		_, err = out.Write(data)
+		return err
+	}
+	_, err = io.WriteString(out, openrc.RC(c))
+	return err
+}
+
+func issueApplicationCredential(credentials *openstack.Credentials, name, region string, ttl time.Duration) (*openstack.Credentials, error) {
+	provider, err := clientbuilder.NewProviderClient(credentials)
+	if err != nil {
+		return nil, fmt.Errorf("could not authenticate: %w", err)
+	}
+	userID, err := openrc.UserID(provider)
+	if err != nil {
+		return nil, err
+	}
+	identity, err := clientbuilder.NewServiceClient(provider, clientbuilder.ServiceTypeIdentity, region)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	return openrc.IssueApplicationCredential(identity, userID, credentials, fmt.Sprintf("%s-debug-%d", name, now.Unix()), now.Add(ttl))
+}
+
+func decodeFile(path string, into runtime.Object) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("could not read %s: %w", path, err)
+	}
+	obj, gvk, err := kubernetes.SeedCodec.UniversalDeserializer().Decode(data, nil, into)
+	if err != nil {
+		return fmt.Errorf("could not decode %s: %w", path, err)
+	}
+	if obj != into {
+		return fmt.Errorf("expected manifest of %T in %s, got %s", into, path, gvk.Kind)
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/nodelabeler"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+	openstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
+)
+
+var log = logf.Log.WithName(openstack.NodeLabelerName)
+
+// options are the command line options of the node labeler command.
+type options struct {
+	credentialsDir     string
+	region             string
+	syncPeriod         time.Duration
+	healthBindAddress  string
+	metricsBindAddress string
+}
+
+// NewNodeLabelerCommand creates a new command running the node labeler in the control plane of a shoot.
+func NewNodeLabelerCommand(ctx context.Context) *cobra.Command {
+	opts := &options{}
+
+	cmd := &cobra.Command{
+		Use:   openstack.NodeLabelerName,
+		Short: "Labels the nodes of an OpenStack shoot with the placement of their servers.",
+		Long: `Labels the nodes of an OpenStack shoot with the placement of their servers in OpenStack, i.e. their server
+group, and the hypervisor they run on and its host aggregates where the policy of Nova allows to see them. The labels are
+synchronized periodically, so that they follow live migrations of the servers. The shoot is accessed with the
+kubeconfig given by the KUBECONFIG environment variable.`,
+		SilenceUsage: true,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return opts.run(ctx)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&opts.credentialsDir, "credentials-dir", "", "path to the directory containing the OpenStack credentials with one file per key of a cloud provider secret")
+	flags.StringVar(&opts.region, "region", "", "region of the shoot")
+	flags.DurationVar(&opts.syncPeriod, "sync-period", nodelabeler.DefaultSyncPeriod, "period in which the labels of the nodes are synchronized with their placement")
+	flags.StringVar(&opts.healthBindAddress, "health-bind-address", ":8081", "address the health probes are served at")
+	flags.StringVar(&opts.metricsBindAddress, "metrics-bind-address", "0", "address the metrics are served at, 0 disables serving them")
+	for _, flag := range []string{"credentials-dir", "region"} {
+		if err := cmd.MarkFlagRequired(flag); err != nil {
+			panic(err)
+		}
+	}
+
+	return cmd
+}
+
+func (o *options) run(ctx context.Context) error {
+	secret, err := openstack.ReadCredentialsSecretFromDir(o.credentialsDir)
+	if err != nil {
+		return err
+	}
+	credentials, err := openstack.ExtractCredentials(secret, false)
+	if err != nil {
+		return err
+	}
+	factory, err := openstackclient.NewOpenstackClientFromCredentials(credentials)
+	if err != nil {
+		return fmt.Errorf("could not create OpenStack client: %w", err)
+	}
+	compute, err := factory.Compute(openstackclient.WithRegion(o.region))
+	if err != nil {
+		return fmt.Errorf("could not create compute client: %w", err)
+	}
+
+	restConfig, err := config.GetConfig()
+	if err != nil {
+		return fmt.Errorf("could not get rest config: %w", err)
+	}
+	mgr, err := manager.New(restConfig, manager.Options{
+		Logger:                 log,
+		HealthProbeBindAddress: o.healthBindAddress,
+		Metrics:                metricsserver.Options{BindAddress: o.metricsBindAddress},
+	})
+	if err != nil {
+		return fmt.Errorf("could not instantiate manager: %w", err)
+	}
+
+	if err := mgr.AddHealthzCheck("ping", healthz.Ping); err != nil {
+		return fmt.Errorf("could not add healthcheck: %w", err)
+	}
+
+	if err := nodelabeler.AddToManagerWithOptions(mgr, nodelabeler.AddOptions{
+		Compute:    compute,
+		SyncPeriod: o.syncPeriod,
+	}); err != nil {
+		return fmt.Errorf("could not add node labeler controller to manager: %w", err)
+	}
+
+	log.Info("Starting node labeler", "region", o.region, "syncPeriod", o.syncPeriod)
+	return mgr.Start(ctx)
+}
@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/simulator"
+)
+
+// options are the command line options of the simulator.
+type options struct {
+	shootPath        string
+	cloudProfilePath string
+	secretPath       string
+	outputDir        string
+	seedVersion      string
+	compare          bool
+}
+
+// NewSimulatorCommand creates a new command for simulating the reconciliation of an OpenStack shoot.
+func NewSimulatorCommand(ctx context.Context) *cobra.Command {
+	opts := &options{}
+
+	cmd := &cobra.Command{
+		Use:   "openstack-simulator",
+		Short: "Renders the resources of an OpenStack shoot offline.",
+		Long: `Renders the machine classes, the Terraform files of the infrastructure and the cloud provider config the
+OpenStack extension would create for the given Shoot, CloudProfile and credentials secret manifests, without
+talking to a seed or to OpenStack. Values which are only known once the infrastructure exists are replaced by
+placeholders like <network-id>.`,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return opts.run(ctx)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&opts.shootPath, "shoot", "", "path to the Shoot manifest")
+	flags.StringVar(&opts.cloudProfilePath, "cloudprofile", "", "path to the CloudProfile manifest")
+	flags.StringVar(&opts.secretPath, "secret", "", "path to the manifest of the Secret containing the OpenStack credentials")
+	flags.StringVar(&opts.outputDir, "output-dir", ".", "directory the rendered files are written to")
+	flags.StringVar(&opts.seedVersion, "seed-version", simulator.DefaultSeedVersion, "Kubernetes version of the seed the charts are rendered for")
+	flags.BoolVar(&opts.compare, "compare", false, "compare the rendered files with the files in the output directory instead of writing them, and fail if they differ")
+	for _, name := range []string{"shoot", "cloudprofile", "secret"} {
+		if err := cmd.MarkFlagRequired(name); err != nil {
+			panic(err)
+		}
+	}
+
+	return cmd
+}
+
+func (o *options) run(ctx context.Context) error {
+	input, err := simulator.ReadInput(o.shootPath, o.cloudProfilePath, o.secretPath)
+	if err != nil {
+		return err
+	}
+	input.SeedVersion = o.seedVersion
+
+	output, err := simulator.Simulate(ctx, input)
+	if err != nil {
+		return err
+	}
+	if o.compare {
+		return output.CompareWithDirectory(o.outputDir)
+	}
+	return output.WriteToDirectory(o.outputDir)
+}
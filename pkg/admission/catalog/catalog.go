@@ -0,0 +1,309 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package catalog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gardener/gardener/extensions/pkg/util"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/go-logr/logr"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/images"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/helper"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+	openstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
+)
+
+const (
+	// secretNamePrefix is the prefix of the names of the secrets containing the credentials the catalog is read with.
+	secretNamePrefix = "catalog-"
+
+	imageStatusActive = "ACTIVE"
+)
+
+// CredentialsSecretName returns the name of the secret in the garden namespace containing the credentials the catalog
+// of the cloud profile with the given name is read with.
+func CredentialsSecretName(cloudProfileName string) string {
+	return secretNamePrefix + cloudProfileName
+}
+
+// RegionCatalog contains the flavors, availability zones and images of a region of a cloud profile.
+type RegionCatalog struct {
+	// Flavors are the names of the flavors of the region.
+	Flavors sets.Set[string]
+	// Zones are the names of the available compute availability zones of the region.
+	Zones sets.Set[string]
+	// Images maps the IDs of the images of the region to their status.
+	Images map[string]string
+	// ImageNames are the names of the images of the region.
+	ImageNames sets.Set[string]
+	// RefreshTime is the time the catalog of the region was read.
+	RefreshTime time.Time
+}
+
+// Cache periodically reads the catalog of flavors, availability zones and images of all regions of the OpenStack cloud
+// profiles and keeps it in memory, so that the admission webhooks can validate shoots against it without calling the
+// OpenStack APIs while answering requests. Cloud profiles for which no credentials secret exists in the garden namespace
+// are skipped. The catalog of a region is kept with its refresh time if it cannot be read, so that its staleness can be
+// reported.
+type Cache struct {
+	// Client is used to list the cloud profiles.
+	Client client.Client
+	// Reader is used to read secrets without starting an informer for them.
+	Reader client.Reader
+	// Decoder decodes the provider config of the cloud profiles.
+	Decoder runtime.Decoder
+	// FactoryFactory creates the OpenStack clients.
+	FactoryFactory openstackclient.FactoryFactory
+	// Interval is the interval in which the catalog is refreshed.
+	Interval time.Duration
+	// Log is the logger of the cache.
+	Log logr.Logger
+
+	lock     sync.RWMutex
+	catalogs map[string]map[string]*RegionCatalog
+}
+
+var _ manager.LeaderElectionRunnable = &Cache{}
+
+// Start refreshes the catalog periodically until the given context is cancelled.
+func (c *Cache) Start(ctx context.Context) error {
+	wait.UntilWithContext(ctx, c.refresh, c.Interval)
+	return nil
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable. Every replica of the admission component keeps its own
+// catalog.
+func (c *Cache) NeedLeaderElection() bool {
+	return false
+}
+
+// Get returns the catalog of the given region of the cloud profile with the given name. It returns nil if the catalog
+// of the region has not been read yet.
+func (c *Cache) Get(cloudProfileName, region string) *RegionCatalog {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.catalogs[cloudProfileName][region]
+}
+
+func (c *Cache) set(cloudProfileName, region string, catalog *RegionCatalog) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.catalogs == nil {
+		c.catalogs = map[string]map[string]*RegionCatalog{}
+	}
+	if c.catalogs[cloudProfileName] == nil {
+		c.catalogs[cloudProfileName] = map[string]*RegionCatalog{}
+	}
+	c.catalogs[cloudProfileName][region] = catalog
+}
+
+// retain removes the catalogs of all cloud profiles and regions which are not given.
+func (c *Cache) retain(regions map[string]sets.Set[string]) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for cloudProfileName, catalogs := range c.catalogs {
+		for region := range catalogs {
+			if !regions[cloudProfileName].Has(region) {
+				delete(catalogs, region)
+			}
+		}
+		if len(catalogs) == 0 {
+			delete(c.catalogs, cloudProfileName)
+		}
+	}
+}
+
+func (c *Cache) refresh(ctx context.Context) {
+	cloudProfiles := &gardencorev1beta1.CloudProfileList{}
+	if err := c.Client.List(ctx, cloudProfiles); err != nil {
+		c.Log.Error(err, "Could not list cloud profiles")
+		return
+	}
+
+	regions := map[string]sets.Set[string]{}
+	for _, cloudProfile := range cloudProfiles.Items {
+		if cloudProfile.Spec.Type != openstack.Type {
+			continue
+		}
+		regions[cloudProfile.Name] = sets.New[string]()
+		for _, region := range cloudProfile.Spec.Regions {
+			regions[cloudProfile.Name].Insert(region.Name)
+		}
+		if err := c.refreshCloudProfile(ctx, &cloudProfile); err != nil {
+			c.Log.Error(err, "Could not refresh catalog", "cloudProfile", cloudProfile.Name)
+		}
+	}
+	c.retain(regions)
+}
+
+func (c *Cache) refreshCloudProfile(ctx context.Context, cloudProfile *gardencorev1beta1.CloudProfile) error {
+	secret := &corev1.Secret{}
+	if err := c.Reader.Get(ctx, client.ObjectKey{Namespace: v1beta1constants.GardenNamespace, Name: CredentialsSecretName(cloudProfile.Name)}, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	credentials, err := openstack.ExtractReadOnlyCredentials(secret)
+	if err != nil {
+		return fmt.Errorf("invalid credentials in secret %s: %w", client.ObjectKeyFromObject(secret), err)
+	}
+
+	if cloudProfile.Spec.ProviderConfig == nil {
+		return fmt.Errorf("providerConfig is not given for cloud profile %q", cloudProfile.Name)
+	}
+	cloudProfileConfig := &api.CloudProfileConfig{}
+	if err := util.Decode(c.Decoder, cloudProfile.Spec.ProviderConfig.Raw, cloudProfileConfig); err != nil {
+		return fmt.Errorf("could not decode providerConfig of cloud profile %q: %w", cloudProfile.Name, err)
+	}
+
+	// the catalogs of the other regions are still refreshed if a region cannot be read
+	var errs []error
+	for _, region := range cloudProfile.Spec.Regions {
+		regionCredentials := *credentials
+		if len(strings.TrimSpace(regionCredentials.AuthURL)) == 0 {
+			if regionCredentials.AuthURL, err = helper.FindKeyStoneURL(cloudProfileConfig.KeyStoneURLs, cloudProfileConfig.KeyStoneURL, region.Name); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+		}
+		if caCert := helper.FindKeyStoneCACert(cloudProfileConfig.KeyStoneURLs, cloudProfileConfig.KeyStoneCACert, region.Name); caCert != nil && len(regionCredentials.CACert) == 0 {
+			regionCredentials.CACert = *caCert
+		}
+		// only the endpoint overrides of the cloud profile are used, like in the cloudprovider secrets of shoots
+		regionCredentials.EndpointOverrides = helper.FindEndpointOverrides(cloudProfileConfig.EndpointOverrides, region.Name)
+
+		catalog, err := c.readRegion(&regionCredentials, region.Name)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("could not read catalog of region %q: %w", region.Name, err))
+			continue
+		}
+		c.set(cloudProfile.Name, region.Name, catalog)
+	}
+	return errors.Join(errs...)
+}
+
+func (c *Cache) readRegion(credentials *openstack.Credentials, region string) (*RegionCatalog, error) {
+	factory, err := c.FactoryFactory.NewFactory(credentials)
+	if err != nil {
+		return nil, fmt.Errorf("could not create OpenStack client: %w", err)
+	}
+	compute, err := factory.Compute(openstackclient.WithRegion(region))
+	if err != nil {
+		return nil, err
+	}
+
+	allFlavors, err := compute.ListFlavors(flavors.ListOpts{AccessType: flavors.AllAccess})
+	if err != nil {
+		return nil, fmt.Errorf("could not list flavors: %w", err)
+	}
+	zones, err := compute.ListAvailabilityZones()
+	if err != nil {
+		return nil, fmt.Errorf("could not list availability zones: %w", err)
+	}
+	allImages, err := compute.ListImages(images.ListOpts{})
+	if err != nil {
+		return nil, fmt.Errorf("could not list images: %w", err)
+	}
+
+	catalog := &RegionCatalog{
+		Flavors:     sets.New[string](),
+		Zones:       sets.New[string](),
+		Images:      make(map[string]string, len(allImages)),
+		ImageNames:  sets.New[string](),
+		RefreshTime: time.Now(),
+	}
+	for _, flavor := range allFlavors {
+		catalog.Flavors.Insert(flavor.Name)
+	}
+	for _, zone := range zones {
+		if zone.ZoneState.Available {
+			catalog.Zones.Insert(zone.ZoneName)
+		}
+	}
+	for _, image := range allImages {
+		catalog.Images[image.ID] = image.Status
+		catalog.ImageNames.Insert(image.Name)
+	}
+	return catalog, nil
+}
+
+// WorkerWarnings returns warnings for the new or changed worker pools of a shoot whose machine type, zones or machine
+// image are not found in the catalog of the region. The warnings state the age of the catalog, as it may be outdated.
+func (c *RegionCatalog) WorkerWarnings(oldWorkers, workers []core.Worker, cloudProfileConfig *api.CloudProfileConfig, region string, fldPath *field.Path) []string {
+	var (
+		warnings   []string
+		oldByName  = make(map[string]core.Worker, len(oldWorkers))
+		refreshAge = time.Since(c.RefreshTime).Round(time.Second)
+	)
+	for _, worker := range oldWorkers {
+		oldByName[worker.Name] = worker
+	}
+	warn := func(path *field.Path, format string, args ...interface{}) {
+		warnings = append(warnings, fmt.Sprintf("%s: %s (according to the catalog of region %q refreshed %s ago)", path, fmt.Sprintf(format, args...), region, refreshAge))
+	}
+
+	for i, worker := range workers {
+		var (
+			idxPath     = fldPath.Index(i)
+			oldWorker   = oldByName[worker.Name]
+			_, existing = oldByName[worker.Name]
+		)
+
+		if (!existing || oldWorker.Machine.Type != worker.Machine.Type) && !c.Flavors.Has(worker.Machine.Type) {
+			warn(idxPath.Child("machine", "type"), "machine type %q is not available as flavor", worker.Machine.Type)
+		}
+
+		oldZones := sets.New(oldWorker.Zones...)
+		for j, zone := range worker.Zones {
+			if !oldZones.Has(zone) && !c.Zones.Has(zone) {
+				warn(idxPath.Child("zones").Index(j), "zone %q is not an available compute availability zone", zone)
+			}
+		}
+
+		image := worker.Machine.Image
+		if image == nil || image.Version == "" || (existing && equality.Semantic.DeepEqual(oldWorker.Machine.Image, image) && equality.Semantic.DeepEqual(oldWorker.Machine.Architecture, worker.Machine.Architecture)) {
+			continue
+		}
+		machineImage, err := helper.FindImageFromCloudProfile(cloudProfileConfig, image.Name, image.Version, region, pointer.StringDeref(worker.Machine.Architecture, v1beta1constants.ArchitectureAMD64))
+		if err != nil {
+			// a missing mapping of the machine image is reported by the validation of the worker pools
+			continue
+		}
+		imagePath := idxPath.Child("machine", "image")
+		switch {
+		case machineImage.ID != "":
+			if status, ok := c.Images[machineImage.ID]; !ok {
+				warn(imagePath, "image %q of machine image %s in version %s is not found", machineImage.ID, image.Name, image.Version)
+			} else if status != imageStatusActive {
+				warn(imagePath, "image %q of machine image %s in version %s has status %s", machineImage.ID, image.Name, image.Version, status)
+			}
+		case machineImage.Image != "" && !c.ImageNames.Has(machineImage.Image):
+			warn(imagePath, "image %q of machine image %s in version %s is not found", machineImage.Image, image.Name, image.Version)
+		}
+	}
+	return warnings
+}
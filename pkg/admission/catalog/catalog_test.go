@@ -0,0 +1,233 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package catalog
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/go-logr/logr"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/availabilityzones"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/images"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	openstackinstall "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/install"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+	openstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client/mocks"
+)
+
+var _ = Describe("Cache", func() {
+	const cloudProfileName = "openstack"
+
+	var (
+		ctx = context.TODO()
+
+		ctrl    *gomock.Controller
+		factory *mocks.MockFactory
+		compute *mocks.MockCompute
+
+		fakeClient client.Client
+		cache      *Cache
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		factory = mocks.NewMockFactory(ctrl)
+		compute = mocks.NewMockCompute(ctrl)
+
+		scheme := runtime.NewScheme()
+		Expect(kubernetesscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(gardencorev1beta1.AddToScheme(scheme)).To(Succeed())
+		Expect(openstackinstall.AddToScheme(scheme)).To(Succeed())
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(scheme).Build()
+
+		cache = &Cache{
+			Client:  fakeClient,
+			Reader:  fakeClient,
+			Decoder: serializer.NewCodecFactory(scheme).UniversalDecoder(),
+			FactoryFactory: openstackclient.FactoryFactoryFunc(func(credentials *openstack.Credentials) (openstackclient.Factory, error) {
+				Expect(credentials.AuthURL).To(Equal("https://keystone.example.com"))
+				return factory, nil
+			}),
+			Interval: time.Minute,
+			Log:      logr.Discard(),
+		}
+
+		Expect(fakeClient.Create(ctx, &gardencorev1beta1.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: cloudProfileName},
+			Spec: gardencorev1beta1.CloudProfileSpec{
+				Type: openstack.Type,
+				ProviderConfig: &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "openstack.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "CloudProfileConfig",
+"keystoneURL": "https://keystone.example.com"
+}`)},
+				Regions: []gardencorev1beta1.Region{{Name: "eu-1"}},
+			},
+		})).To(Succeed())
+	})
+
+	createCredentials := func() {
+		Expect(fakeClient.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: v1beta1constants.GardenNamespace, Name: CredentialsSecretName(cloudProfileName)},
+			Data: map[string][]byte{
+				openstack.DomainName: []byte("domain"),
+				openstack.TenantName: []byte("tenant"),
+				openstack.UserName:   []byte("user"),
+				openstack.Password:   []byte("password"),
+			},
+		})).To(Succeed())
+	}
+
+	It("should skip cloud profiles without credentials", func() {
+		cache.refresh(ctx)
+
+		Expect(cache.Get(cloudProfileName, "eu-1")).To(BeNil())
+	})
+
+	It("should read the catalog of the regions", func() {
+		createCredentials()
+
+		factory.EXPECT().Compute(gomock.Any()).Return(compute, nil)
+		compute.EXPECT().ListFlavors(gomock.Any()).Return([]flavors.Flavor{{Name: "small"}, {Name: "large"}}, nil)
+		compute.EXPECT().ListAvailabilityZones().Return([]availabilityzones.AvailabilityZone{
+			{ZoneName: "eu-1a", ZoneState: availabilityzones.ZoneState{Available: true}},
+			{ZoneName: "eu-1b", ZoneState: availabilityzones.ZoneState{Available: false}},
+		}, nil)
+		compute.EXPECT().ListImages(gomock.Any()).Return([]images.Image{
+			{ID: "image-1", Name: "gardenlinux", Status: "ACTIVE"},
+			{ID: "image-2", Name: "ubuntu", Status: "SAVING"},
+		}, nil)
+
+		cache.refresh(ctx)
+
+		catalog := cache.Get(cloudProfileName, "eu-1")
+		Expect(catalog).NotTo(BeNil())
+		Expect(catalog.Flavors).To(Equal(sets.New("small", "large")))
+		Expect(catalog.Zones).To(Equal(sets.New("eu-1a")))
+		Expect(catalog.Images).To(Equal(map[string]string{"image-1": "ACTIVE", "image-2": "SAVING"}))
+		Expect(catalog.ImageNames).To(Equal(sets.New("gardenlinux", "ubuntu")))
+		Expect(catalog.RefreshTime).NotTo(BeZero())
+	})
+
+	It("should keep the catalog of a region if it cannot be refreshed", func() {
+		createCredentials()
+		previous := &RegionCatalog{Flavors: sets.New("small"), RefreshTime: time.Now().Add(-time.Hour)}
+		cache.set(cloudProfileName, "eu-1", previous)
+
+		factory.EXPECT().Compute(gomock.Any()).Return(compute, nil)
+		compute.EXPECT().ListFlavors(gomock.Any()).Return(nil, errors.New("unavailable"))
+
+		cache.refresh(ctx)
+
+		Expect(cache.Get(cloudProfileName, "eu-1")).To(BeIdenticalTo(previous))
+	})
+
+	It("should remove the catalogs of deleted cloud profiles and regions", func() {
+		cache.set(cloudProfileName, "eu-2", &RegionCatalog{})
+		cache.set("deleted", "eu-1", &RegionCatalog{})
+
+		cache.refresh(ctx)
+
+		Expect(cache.Get(cloudProfileName, "eu-2")).To(BeNil())
+		Expect(cache.Get("deleted", "eu-1")).To(BeNil())
+	})
+})
+
+var _ = Describe("RegionCatalog", func() {
+	Describe("#WorkerWarnings", func() {
+		var (
+			region             = "eu-1"
+			fldPath            = field.NewPath("workers")
+			catalog            *RegionCatalog
+			cloudProfileConfig *api.CloudProfileConfig
+			worker             core.Worker
+		)
+
+		BeforeEach(func() {
+			catalog = &RegionCatalog{
+				Flavors:     sets.New("small"),
+				Zones:       sets.New("eu-1a"),
+				Images:      map[string]string{"image-1": "ACTIVE", "image-2": "SAVING"},
+				ImageNames:  sets.New("gardenlinux-1.0"),
+				RefreshTime: time.Now().Add(-5 * time.Minute),
+			}
+			cloudProfileConfig = &api.CloudProfileConfig{
+				MachineImages: []api.MachineImages{
+					{
+						Name: "gardenlinux",
+						Versions: []api.MachineImageVersion{
+							{Version: "1.0", Image: "gardenlinux-1.0"},
+							{Version: "2.0", Regions: []api.RegionIDMapping{{Name: region, ID: "image-1"}}},
+							{Version: "3.0", Regions: []api.RegionIDMapping{{Name: region, ID: "image-2"}}},
+							{Version: "4.0", Regions: []api.RegionIDMapping{{Name: region, ID: "image-3"}}},
+						},
+					},
+				},
+			}
+			worker = core.Worker{
+				Name: "pool",
+				Machine: core.Machine{
+					Type:  "small",
+					Image: &core.ShootMachineImage{Name: "gardenlinux", Version: "2.0"},
+				},
+				Zones: []string{"eu-1a"},
+			}
+		})
+
+		It("should not warn for worker pools found in the catalog", func() {
+			Expect(catalog.WorkerWarnings(nil, []core.Worker{worker}, cloudProfileConfig, region, fldPath)).To(BeEmpty())
+
+			worker.Machine.Image.Version = "1.0"
+			Expect(catalog.WorkerWarnings(nil, []core.Worker{worker}, cloudProfileConfig, region, fldPath)).To(BeEmpty())
+		})
+
+		It("should warn for unknown machine types, zones and images and state the age of the catalog", func() {
+			worker.Machine.Type = "large"
+			worker.Zones = []string{"eu-1a", "eu-1c"}
+			worker.Machine.Image.Version = "4.0"
+
+			Expect(catalog.WorkerWarnings(nil, []core.Worker{worker}, cloudProfileConfig, region, fldPath)).To(ConsistOf(
+				`workers[0].machine.type: machine type "large" is not available as flavor (according to the catalog of region "eu-1" refreshed 5m0s ago)`,
+				`workers[0].zones[1]: zone "eu-1c" is not an available compute availability zone (according to the catalog of region "eu-1" refreshed 5m0s ago)`,
+				`workers[0].machine.image: image "image-3" of machine image gardenlinux in version 4.0 is not found (according to the catalog of region "eu-1" refreshed 5m0s ago)`,
+			))
+		})
+
+		It("should warn for images which are not active", func() {
+			worker.Machine.Image.Version = "3.0"
+
+			Expect(catalog.WorkerWarnings(nil, []core.Worker{worker}, cloudProfileConfig, region, fldPath)).To(ConsistOf(
+				ContainSubstring(`image "image-2" of machine image gardenlinux in version 3.0 has status SAVING`),
+			))
+		})
+
+		It("should not warn for unchanged worker pools", func() {
+			worker.Machine.Type = "large"
+			worker.Zones = []string{"eu-1c"}
+			worker.Machine.Image.Version = "4.0"
+
+			Expect(catalog.WorkerWarnings([]core.Worker{worker}, []core.Worker{worker}, cloudProfileConfig, region, fldPath)).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flavorcapacity
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// configMapNamePrefix is the prefix of the names of the config maps the probed capacity is published in.
+	configMapNamePrefix = "flavor-capacity-"
+	// secretNamePrefix is the prefix of the names of the secrets containing the credentials used for probing.
+	secretNamePrefix = "flavor-capacity-probe-"
+
+	dataKeyCapacity      = "capacity"
+	dataKeyLastProbeTime = "lastProbeTime"
+)
+
+// Capacity maps regions to zones to flavors to the number of servers of the flavor which still fit into the zone.
+type Capacity map[string]map[string]map[string]int32
+
+// Get returns the number of servers of the given flavor which still fit into the given zone of the region. The second
+// return value is false if the capacity of the flavor has not been probed for the zone.
+func (c Capacity) Get(region, zone, flavor string) (int32, bool) {
+	servers, ok := c[region][zone][flavor]
+	return servers, ok
+}
+
+func (c Capacity) set(region, zone, flavor string, servers int32) {
+	if c[region] == nil {
+		c[region] = map[string]map[string]int32{}
+	}
+	if c[region][zone] == nil {
+		c[region][zone] = map[string]int32{}
+	}
+	c[region][zone][flavor] = servers
+}
+
+// ConfigMapName returns the name of the config map in the garden namespace the capacity probed for the cloud profile
+// with the given name is published in.
+func ConfigMapName(cloudProfileName string) string {
+	return configMapNamePrefix + cloudProfileName
+}
+
+// CredentialsSecretName returns the name of the secret in the garden namespace containing the credentials used to probe
+// the capacity for the cloud profile with the given name.
+func CredentialsSecretName(cloudProfileName string) string {
+	return secretNamePrefix + cloudProfileName
+}
+
+// Read reads the capacity published for the cloud profile with the given name. It returns nil if no capacity has been
+// published.
+func Read(ctx context.Context, reader client.Reader, cloudProfileName string) (Capacity, error) {
+	configMap := &corev1.ConfigMap{}
+	if err := reader.Get(ctx, client.ObjectKey{Namespace: v1beta1constants.GardenNamespace, Name: ConfigMapName(cloudProfileName)}, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	capacity := Capacity{}
+	if err := json.Unmarshal([]byte(configMap.Data[dataKeyCapacity]), &capacity); err != nil {
+		return nil, fmt.Errorf("could not decode flavor capacity of config map %s: %w", client.ObjectKeyFromObject(configMap), err)
+	}
+	return capacity, nil
+}
+
+// write publishes the given capacity for the cloud profile with the given name. The reader is used to avoid the
+// client starting an informer for config maps.
+func write(ctx context.Context, c client.Client, reader client.Reader, cloudProfileName string, capacity Capacity, probeTime time.Time) error {
+	raw, err := json.Marshal(capacity)
+	if err != nil {
+		return err
+	}
+	data := map[string]string{
+		dataKeyCapacity:      string(raw),
+		dataKeyLastProbeTime: probeTime.UTC().Format(time.RFC3339),
+	}
+
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: v1beta1constants.GardenNamespace, Name: ConfigMapName(cloudProfileName)}}
+	if err := reader.Get(ctx, client.ObjectKeyFromObject(configMap), configMap); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		configMap.Data = data
+		return c.Create(ctx, configMap)
+	}
+
+	patch := client.MergeFrom(configMap.DeepCopy())
+	configMap.Data = data
+	return c.Patch(ctx, configMap, patch)
+}
@@ -0,0 +1,17 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flavorcapacity
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestFlavorCapacity(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Admission FlavorCapacity Suite")
+}
@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flavorcapacity
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// flavorCapacity is the number of servers of a flavor which still fit into a zone of a region.
+var flavorCapacity = promauto.With(runtimemetrics.Registry).NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "openstack_flavor_capacity",
+		Help: "Number of servers of a flavor which still fit into an availability zone as probed from the Placement API.",
+	},
+	[]string{"cloudprofile", "region", "zone", "flavor"},
+)
+
+func recordCapacity(cloudProfileName string, capacity Capacity) {
+	flavorCapacity.DeletePartialMatch(prometheus.Labels{"cloudprofile": cloudProfileName})
+	for region, zones := range capacity {
+		for zone, flavors := range zones {
+			for flavor, servers := range flavors {
+				flavorCapacity.WithLabelValues(cloudProfileName, region, zone, flavor).Set(float64(servers))
+			}
+		}
+	}
+}
@@ -0,0 +1,241 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flavorcapacity
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gardener/gardener/extensions/pkg/util"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/go-logr/logr"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
+	"github.com/gophercloud/gophercloud/openstack/placement/v1/resourceproviders"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/helper"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+	openstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
+)
+
+const (
+	resourceClassVCPU     = "VCPU"
+	resourceClassMemoryMB = "MEMORY_MB"
+	resourceClassDiskGB   = "DISK_GB"
+)
+
+// Prober periodically probes the capacity of the machine types of all OpenStack cloud profiles per zone by querying
+// the Placement API and publishes the result in a config map in the garden namespace and as metric. Cloud profiles
+// for which no credentials secret exists in the garden namespace are skipped.
+type Prober struct {
+	// Client is used to list the cloud profiles and to publish the capacity.
+	Client client.Client
+	// Reader is used to read secrets and config maps without starting informers for them.
+	Reader client.Reader
+	// Decoder decodes the provider config of the cloud profiles.
+	Decoder runtime.Decoder
+	// FactoryFactory creates the OpenStack clients.
+	FactoryFactory openstackclient.FactoryFactory
+	// Interval is the interval in which the capacity is probed.
+	Interval time.Duration
+	// Log is the logger of the prober.
+	Log logr.Logger
+}
+
+var _ manager.LeaderElectionRunnable = &Prober{}
+
+// Start probes the capacity periodically until the given context is cancelled.
+func (p *Prober) Start(ctx context.Context) error {
+	wait.UntilWithContext(ctx, p.probe, p.Interval)
+	return nil
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable.
+func (p *Prober) NeedLeaderElection() bool {
+	return true
+}
+
+func (p *Prober) probe(ctx context.Context) {
+	cloudProfiles := &gardencorev1beta1.CloudProfileList{}
+	if err := p.Client.List(ctx, cloudProfiles); err != nil {
+		p.Log.Error(err, "Could not list cloud profiles")
+		return
+	}
+
+	for _, cloudProfile := range cloudProfiles.Items {
+		if cloudProfile.Spec.Type != openstack.Type {
+			continue
+		}
+		if err := p.probeCloudProfile(ctx, &cloudProfile); err != nil {
+			p.Log.Error(err, "Could not probe flavor capacity", "cloudProfile", cloudProfile.Name)
+		}
+	}
+}
+
+func (p *Prober) probeCloudProfile(ctx context.Context, cloudProfile *gardencorev1beta1.CloudProfile) error {
+	secret := &corev1.Secret{}
+	if err := p.Reader.Get(ctx, client.ObjectKey{Namespace: v1beta1constants.GardenNamespace, Name: CredentialsSecretName(cloudProfile.Name)}, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	credentials, err := openstack.ExtractReadOnlyCredentials(secret)
+	if err != nil {
+		return fmt.Errorf("invalid credentials in secret %s: %w", client.ObjectKeyFromObject(secret), err)
+	}
+
+	if cloudProfile.Spec.ProviderConfig == nil {
+		return fmt.Errorf("providerConfig is not given for cloud profile %q", cloudProfile.Name)
+	}
+	cloudProfileConfig := &api.CloudProfileConfig{}
+	if err := util.Decode(p.Decoder, cloudProfile.Spec.ProviderConfig.Raw, cloudProfileConfig); err != nil {
+		return fmt.Errorf("could not decode providerConfig of cloud profile %q: %w", cloudProfile.Name, err)
+	}
+
+	machineTypes := sets.New[string]()
+	for _, machineType := range cloudProfile.Spec.MachineTypes {
+		machineTypes.Insert(machineType.Name)
+	}
+
+	capacity := Capacity{}
+	for _, region := range cloudProfile.Spec.Regions {
+		regionCredentials := *credentials
+		if len(strings.TrimSpace(regionCredentials.AuthURL)) == 0 {
+			if regionCredentials.AuthURL, err = helper.FindKeyStoneURL(cloudProfileConfig.KeyStoneURLs, cloudProfileConfig.KeyStoneURL, region.Name); err != nil {
+				return err
+			}
+		}
+		if caCert := helper.FindKeyStoneCACert(cloudProfileConfig.KeyStoneURLs, cloudProfileConfig.KeyStoneCACert, region.Name); caCert != nil && len(regionCredentials.CACert) == 0 {
+			regionCredentials.CACert = *caCert
+		}
+		// only the endpoint overrides of the cloud profile are used, like in the cloudprovider secrets of shoots
+		regionCredentials.EndpointOverrides = helper.FindEndpointOverrides(cloudProfileConfig.EndpointOverrides, region.Name)
+
+		if err := p.probeRegion(capacity, &regionCredentials, region.Name, machineTypes); err != nil {
+			return fmt.Errorf("could not probe region %q: %w", region.Name, err)
+		}
+	}
+
+	recordCapacity(cloudProfile.Name, capacity)
+	return write(ctx, p.Client, p.Reader, cloudProfile.Name, capacity, time.Now())
+}
+
+func (p *Prober) probeRegion(capacity Capacity, credentials *openstack.Credentials, region string, machineTypes sets.Set[string]) error {
+	factory, err := p.FactoryFactory.NewFactory(credentials)
+	if err != nil {
+		return fmt.Errorf("could not create OpenStack client: %w", err)
+	}
+	compute, err := factory.Compute(openstackclient.WithRegion(region))
+	if err != nil {
+		return err
+	}
+	placement, err := factory.Placement(openstackclient.WithRegion(region))
+	if err != nil {
+		return err
+	}
+
+	zones, err := compute.ListAvailabilityZones()
+	if err != nil {
+		return fmt.Errorf("could not list availability zones: %w", err)
+	}
+	allFlavors, err := compute.ListFlavors(flavors.ListOpts{AccessType: flavors.AllAccess})
+	if err != nil {
+		return fmt.Errorf("could not list flavors: %w", err)
+	}
+	providers, err := placement.ListResourceProviders()
+	if err != nil {
+		return fmt.Errorf("could not list resource providers: %w", err)
+	}
+
+	providersByHost := make(map[string]resourceproviders.ResourceProvider, len(providers))
+	for _, provider := range providers {
+		providersByHost[provider.Name] = provider
+		// the hypervisor hostname may be fully qualified while the host of the compute service is not
+		providersByHost[strings.SplitN(provider.Name, ".", 2)[0]] = provider
+	}
+
+	for _, zone := range zones {
+		var free []map[string]int
+		for host := range zone.Hosts {
+			provider, ok := providersByHost[host]
+			if !ok {
+				continue
+			}
+			resources, err := freeResources(placement, provider.UUID)
+			if err != nil {
+				return fmt.Errorf("could not determine free resources of resource provider %q: %w", provider.Name, err)
+			}
+			free = append(free, resources)
+		}
+
+		for _, flavor := range allFlavors {
+			if machineTypes.Has(flavor.Name) {
+				capacity.set(region, zone.ZoneName, flavor.Name, serversFitting(free, flavor))
+			}
+		}
+	}
+	return nil
+}
+
+// freeResources returns the amount of each resource class of the resource provider which is neither reserved nor used,
+// taking the allocation ratio into account.
+func freeResources(placement openstackclient.Placement, resourceProviderID string) (map[string]int, error) {
+	inventories, err := placement.GetInventories(resourceProviderID)
+	if err != nil {
+		return nil, err
+	}
+	usages, err := placement.GetUsages(resourceProviderID)
+	if err != nil {
+		return nil, err
+	}
+
+	free := make(map[string]int, len(inventories.Inventories))
+	for resourceClass, inventory := range inventories.Inventories {
+		free[resourceClass] = int(float32(inventory.Total-inventory.Reserved)*inventory.AllocationRatio) - usages.Usages[resourceClass]
+	}
+	return free, nil
+}
+
+// serversFitting returns the number of servers of the given flavor which fit into the given free resources of the
+// resource providers of a zone.
+func serversFitting(free []map[string]int, flavor flavors.Flavor) int32 {
+	required := map[string]int{
+		resourceClassVCPU:     flavor.VCPUs,
+		resourceClassMemoryMB: flavor.RAM,
+	}
+	// flavors without root disk boot from volume
+	if flavor.Disk > 0 {
+		required[resourceClassDiskGB] = flavor.Disk
+	}
+
+	var servers int32
+	for _, resources := range free {
+		fitting := -1
+		for resourceClass, amount := range required {
+			if amount <= 0 {
+				continue
+			}
+			n := resources[resourceClass] / amount
+			if fitting < 0 || n < fitting {
+				fitting = n
+			}
+		}
+		if fitting > 0 {
+			servers += int32(fitting)
+		}
+	}
+	return servers
+}
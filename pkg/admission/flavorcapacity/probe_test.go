@@ -0,0 +1,159 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flavorcapacity
+
+import (
+	"context"
+	"time"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/go-logr/logr"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/availabilityzones"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
+	"github.com/gophercloud/gophercloud/openstack/placement/v1/resourceproviders"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	openstackinstall "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/install"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+	openstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client/mocks"
+)
+
+var _ = Describe("Prober", func() {
+	const cloudProfileName = "openstack"
+
+	var (
+		ctx = context.TODO()
+
+		ctrl      *gomock.Controller
+		factory   *mocks.MockFactory
+		compute   *mocks.MockCompute
+		placement *mocks.MockPlacement
+
+		fakeClient client.Client
+		prober     *Prober
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		factory = mocks.NewMockFactory(ctrl)
+		compute = mocks.NewMockCompute(ctrl)
+		placement = mocks.NewMockPlacement(ctrl)
+
+		scheme := runtime.NewScheme()
+		Expect(kubernetesscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(gardencorev1beta1.AddToScheme(scheme)).To(Succeed())
+		Expect(openstackinstall.AddToScheme(scheme)).To(Succeed())
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(scheme).Build()
+
+		prober = &Prober{
+			Client:  fakeClient,
+			Reader:  fakeClient,
+			Decoder: serializer.NewCodecFactory(scheme).UniversalDecoder(),
+			FactoryFactory: openstackclient.FactoryFactoryFunc(func(credentials *openstack.Credentials) (openstackclient.Factory, error) {
+				Expect(credentials.AuthURL).To(Equal("https://keystone.example.com"))
+				return factory, nil
+			}),
+			Interval: time.Minute,
+			Log:      logr.Discard(),
+		}
+
+		Expect(fakeClient.Create(ctx, &gardencorev1beta1.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: cloudProfileName},
+			Spec: gardencorev1beta1.CloudProfileSpec{
+				Type: openstack.Type,
+				ProviderConfig: &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "openstack.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "CloudProfileConfig",
+"keystoneURL": "https://keystone.example.com"
+}`)},
+				MachineTypes: []gardencorev1beta1.MachineType{{Name: "small"}, {Name: "large"}},
+				Regions:      []gardencorev1beta1.Region{{Name: "eu-1"}},
+			},
+		})).To(Succeed())
+	})
+
+	It("should skip cloud profiles without credentials", func() {
+		prober.probe(ctx)
+
+		capacity, err := Read(ctx, fakeClient, cloudProfileName)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(capacity).To(BeNil())
+	})
+
+	It("should publish the capacity of the machine types per zone", func() {
+		Expect(fakeClient.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: v1beta1constants.GardenNamespace, Name: CredentialsSecretName(cloudProfileName)},
+			Data: map[string][]byte{
+				openstack.DomainName: []byte("domain"),
+				openstack.TenantName: []byte("tenant"),
+				openstack.UserName:   []byte("user"),
+				openstack.Password:   []byte("password"),
+			},
+		})).To(Succeed())
+
+		factory.EXPECT().Compute(gomock.Any()).Return(compute, nil)
+		factory.EXPECT().Placement(gomock.Any()).Return(placement, nil)
+		compute.EXPECT().ListAvailabilityZones().Return([]availabilityzones.AvailabilityZone{
+			{ZoneName: "eu-1a", Hosts: availabilityzones.Hosts{"host-1": nil, "host-2": nil}},
+			{ZoneName: "eu-1b", Hosts: availabilityzones.Hosts{"host-3": nil}},
+		}, nil)
+		compute.EXPECT().ListFlavors(gomock.Any()).Return([]flavors.Flavor{
+			{Name: "small", VCPUs: 2, RAM: 4096, Disk: 0},
+			{Name: "large", VCPUs: 16, RAM: 65536, Disk: 100},
+			{Name: "unused", VCPUs: 1, RAM: 1024},
+		}, nil)
+		placement.EXPECT().ListResourceProviders().Return([]resourceproviders.ResourceProvider{
+			{UUID: "rp-1", Name: "host-1.example.com"},
+			{UUID: "rp-2", Name: "host-2"},
+			{UUID: "rp-3", Name: "host-3"},
+		}, nil)
+		expectResources := func(id string, vcpus, usedVCPUs, memory, usedMemory, disk, usedDisk int) {
+			placement.EXPECT().GetInventories(id).Return(&resourceproviders.ResourceProviderInventories{
+				Inventories: map[string]resourceproviders.Inventory{
+					"VCPU":      {Total: vcpus, AllocationRatio: 2},
+					"MEMORY_MB": {Total: memory, Reserved: 512, AllocationRatio: 1},
+					"DISK_GB":   {Total: disk, AllocationRatio: 1},
+				},
+			}, nil)
+			placement.EXPECT().GetUsages(id).Return(&resourceproviders.ResourceProviderUsage{
+				Usages: map[string]int{"VCPU": usedVCPUs, "MEMORY_MB": usedMemory, "DISK_GB": usedDisk},
+			}, nil)
+		}
+		// 32 free vcpus, 16384 free memory, 1000 free disk
+		expectResources("rp-1", 32, 32, 32768+512, 16384, 1000, 0)
+		// 8 free vcpus, 65536 free memory, 50 free disk
+		expectResources("rp-2", 4, 0, 65536+512, 0, 50, 0)
+		// no free memory
+		expectResources("rp-3", 64, 0, 65536+512, 65536, 1000, 0)
+
+		prober.probe(ctx)
+
+		capacity, err := Read(ctx, fakeClient, cloudProfileName)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(capacity).To(Equal(Capacity{
+			"eu-1": {
+				"eu-1a": {"small": 4 + 4, "large": 0},
+				"eu-1b": {"small": 0, "large": 0},
+			},
+		}))
+
+		servers, ok := capacity.Get("eu-1", "eu-1a", "small")
+		Expect(ok).To(BeTrue())
+		Expect(servers).To(Equal(int32(8)))
+		_, ok = capacity.Get("eu-1", "eu-1a", "unused")
+		Expect(ok).To(BeFalse())
+	})
+})
@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mutator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/gardener/extensions/pkg/util"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+)
+
+const (
+	// AnnotationKeyEstimatedMonthlyCostMin is the key of the annotation of shoots with the estimated monthly cost of their
+	// worker pools and volumes if the worker pools run with their minimum number of machines, e.g. `123.45 EUR`.
+	AnnotationKeyEstimatedMonthlyCostMin = "openstack.provider.extensions.gardener.cloud/estimated-monthly-cost-min"
+	// AnnotationKeyEstimatedMonthlyCostMax is the key of the annotation of shoots with the estimated monthly cost of their
+	// worker pools and volumes if the worker pools run with their maximum number of machines, e.g. `456.78 EUR`.
+	AnnotationKeyEstimatedMonthlyCostMax = "openstack.provider.extensions.gardener.cloud/estimated-monthly-cost-max"
+
+	// hoursPerMonth is the average number of hours of a month.
+	hoursPerMonth = 730
+)
+
+// annotateEstimatedCost annotates the shoot with the estimated monthly cost of its worker pools and volumes according
+// to the pricing model of its cloud profile. The annotations are removed if the cloud profile has no pricing model.
+func (s *shoot) annotateEstimatedCost(ctx context.Context, shoot *gardencorev1beta1.Shoot) error {
+	cloudProfile := &gardencorev1beta1.CloudProfile{}
+	if err := s.client.Get(ctx, kutil.Key(shoot.Spec.CloudProfileName), cloudProfile); err != nil {
+		return fmt.Errorf("could not get cloud profile %q: %w", shoot.Spec.CloudProfileName, err)
+	}
+
+	var pricing *api.Pricing
+	if cloudProfile.Spec.ProviderConfig != nil {
+		cloudProfileConfig := &api.CloudProfileConfig{}
+		if err := util.Decode(s.lenientDecoder, cloudProfile.Spec.ProviderConfig.Raw, cloudProfileConfig); err != nil {
+			return fmt.Errorf("could not decode providerConfig of cloud profile %q: %w", cloudProfile.Name, err)
+		}
+		pricing = cloudProfileConfig.Pricing
+	}
+
+	if pricing == nil {
+		delete(shoot.Annotations, AnnotationKeyEstimatedMonthlyCostMin)
+		delete(shoot.Annotations, AnnotationKeyEstimatedMonthlyCostMax)
+		return nil
+	}
+
+	minCost, maxCost := estimateMonthlyCost(shoot.Spec.Provider.Workers, pricing)
+	if shoot.Annotations == nil {
+		shoot.Annotations = map[string]string{}
+	}
+	shoot.Annotations[AnnotationKeyEstimatedMonthlyCostMin] = fmt.Sprintf("%.2f %s", minCost, pricing.Currency)
+	shoot.Annotations[AnnotationKeyEstimatedMonthlyCostMax] = fmt.Sprintf("%.2f %s", maxCost, pricing.Currency)
+	return nil
+}
+
+// estimateMonthlyCost returns the monthly cost of the machines and volumes of the given worker pools if they run with
+// their minimum and maximum number of machines. Machine and volume types without a price are not included.
+func estimateMonthlyCost(workers []gardencorev1beta1.Worker, pricing *api.Pricing) (minCost, maxCost float64) {
+	machineTypePrices := map[string]float64{}
+	for _, price := range pricing.MachineTypes {
+		machineTypePrices[price.Name] = price.PricePerHour.AsApproximateFloat64()
+	}
+	volumeTypePrices := map[string]float64{}
+	for _, price := range pricing.VolumeTypes {
+		volumeTypePrices[price.Name] = price.PricePerGiBMonth.AsApproximateFloat64()
+	}
+
+	volumeCost := func(volumeType *string, size string) float64 {
+		if volumeType == nil {
+			return 0
+		}
+		price, ok := volumeTypePrices[*volumeType]
+		if !ok {
+			return 0
+		}
+		quantity, err := resource.ParseQuantity(size)
+		if err != nil {
+			return 0
+		}
+		return price * quantity.AsApproximateFloat64() / (1 << 30)
+	}
+
+	for _, worker := range workers {
+		machineCost := machineTypePrices[worker.Machine.Type] * hoursPerMonth
+		if worker.Volume != nil {
+			machineCost += volumeCost(worker.Volume.Type, worker.Volume.VolumeSize)
+		}
+		for _, dataVolume := range worker.DataVolumes {
+			machineCost += volumeCost(dataVolume.Type, dataVolume.VolumeSize)
+		}
+
+		minCost += float64(worker.Minimum) * machineCost
+		maxCost += float64(worker.Maximum) * machineCost
+	}
+	return minCost, maxCost
+}
@@ -22,12 +22,16 @@ import (
 // NewShootMutator returns a new instance of a shoot mutator.
 func NewShootMutator(mgr manager.Manager) extensionswebhook.Mutator {
 	return &shoot{
-		decoder: serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
+		client:         mgr.GetClient(),
+		decoder:        serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
+		lenientDecoder: serializer.NewCodecFactory(mgr.GetScheme()).UniversalDecoder(),
 	}
 }
 
 type shoot struct {
-	decoder runtime.Decoder
+	client         client.Client
+	decoder        runtime.Decoder
+	lenientDecoder runtime.Decoder
 }
 
 const (
@@ -46,7 +50,7 @@ var (
 )
 
 // Mutate mutates the given shoot object.
-func (s *shoot) Mutate(_ context.Context, newObj, oldObj client.Object) error {
+func (s *shoot) Mutate(ctx context.Context, newObj, oldObj client.Object) error {
 	shoot, ok := newObj.(*gardencorev1beta1.Shoot)
 	if !ok {
 		return fmt.Errorf("wrong object type %T", newObj)
@@ -83,6 +87,11 @@ func (s *shoot) Mutate(_ context.Context, newObj, oldObj client.Object) error {
 	if shoot.DeletionTimestamp != nil || oldShoot != nil && oldShoot.DeletionTimestamp != nil {
 		return nil
 	}
+
+	if err := s.annotateEstimatedCost(ctx, shoot); err != nil {
+		return err
+	}
+
 	if shoot.Spec.Networking != nil && shoot.Spec.Networking.Type != nil {
 
 		overlayConfig := map[string]interface{}{enabledKey: false}
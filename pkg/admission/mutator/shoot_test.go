@@ -19,8 +19,11 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/admission/mutator"
+	openstackinstall "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/install"
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
 )
 
@@ -31,6 +34,8 @@ var _ = Describe("Shoot mutator", func() {
 		var (
 			ctrl         *gomock.Controller
 			mgr          *mockmanager.MockManager
+			fakeClient   client.Client
+			cloudProfile *gardencorev1beta1.CloudProfile
 			shootMutator extensionswebhook.Mutator
 			shoot        *gardencorev1beta1.Shoot
 			oldShoot     *gardencorev1beta1.Shoot
@@ -43,9 +48,14 @@ var _ = Describe("Shoot mutator", func() {
 
 			scheme := runtime.NewScheme()
 			Expect(gardencorev1beta1.AddToScheme(scheme)).To(Succeed())
+			Expect(openstackinstall.AddToScheme(scheme)).To(Succeed())
+
+			cloudProfile = &gardencorev1beta1.CloudProfile{ObjectMeta: metav1.ObjectMeta{Name: "openstack"}}
+			fakeClient = fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(cloudProfile).Build()
 
 			mgr = mockmanager.NewMockManager(ctrl)
-			mgr.EXPECT().GetScheme().Return(scheme)
+			mgr.EXPECT().GetScheme().Return(scheme).AnyTimes()
+			mgr.EXPECT().GetClient().Return(fakeClient)
 
 			shootMutator = mutator.NewShootMutator(mgr)
 
@@ -55,7 +65,8 @@ var _ = Describe("Shoot mutator", func() {
 					Namespace: namespace,
 				},
 				Spec: gardencorev1beta1.ShootSpec{
-					SeedName: pointer.String("openstack"),
+					CloudProfileName: "openstack",
+					SeedName:         pointer.String("openstack"),
 					Provider: gardencorev1beta1.Provider{
 						Type: openstack.Type,
 						Workers: []gardencorev1beta1.Worker{
@@ -78,7 +89,8 @@ var _ = Describe("Shoot mutator", func() {
 					Namespace: namespace,
 				},
 				Spec: gardencorev1beta1.ShootSpec{
-					SeedName: pointer.String("openstack"),
+					CloudProfileName: "openstack",
+					SeedName:         pointer.String("openstack"),
 					Provider: gardencorev1beta1.Provider{
 						Type: openstack.Type,
 					},
@@ -259,6 +271,50 @@ var _ = Describe("Shoot mutator", func() {
 			})
 		})
 
+		Context("Estimated cost", func() {
+			BeforeEach(func() {
+				shoot.Spec.Provider.Workers = []gardencorev1beta1.Worker{
+					{
+						Name:    "worker",
+						Machine: gardencorev1beta1.Machine{Type: "g_c4_m16"},
+						Minimum: 1,
+						Maximum: 3,
+						Volume:  &gardencorev1beta1.Volume{Type: pointer.String("standard"), VolumeSize: "20Gi"},
+						DataVolumes: []gardencorev1beta1.DataVolume{
+							{Name: "data", Type: pointer.String("standard"), VolumeSize: "100Gi"},
+							{Name: "scratch", Type: pointer.String("unpriced"), VolumeSize: "100Gi"},
+						},
+					},
+				}
+			})
+
+			It("should annotate the shoot with the estimated monthly cost", func() {
+				cloudProfile.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "openstack.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "CloudProfileConfig",
+"pricing": {
+  "currency": "EUR",
+  "machineTypes": [{"name": "g_c4_m16", "pricePerHour": "0.1"}],
+  "volumeTypes": [{"name": "standard", "pricePerGiBMonth": "0.05"}]
+}}`)}
+				Expect(fakeClient.Update(ctx, cloudProfile)).To(Succeed())
+
+				Expect(shootMutator.Mutate(ctx, shoot, nil)).To(Succeed())
+				Expect(shoot.Annotations).To(HaveKeyWithValue(mutator.AnnotationKeyEstimatedMonthlyCostMin, "79.00 EUR"))
+				Expect(shoot.Annotations).To(HaveKeyWithValue(mutator.AnnotationKeyEstimatedMonthlyCostMax, "237.00 EUR"))
+			})
+
+			It("should remove the estimated monthly cost if the cloud profile has no pricing model", func() {
+				shoot.Annotations = map[string]string{
+					mutator.AnnotationKeyEstimatedMonthlyCostMin: "79.00 EUR",
+					mutator.AnnotationKeyEstimatedMonthlyCostMax: "237.00 EUR",
+				}
+
+				Expect(shootMutator.Mutate(ctx, shoot, nil)).To(Succeed())
+				Expect(shoot.Annotations).To(BeEmpty())
+			})
+		})
+
 		Context("Workerless Shoot", func() {
 			BeforeEach(func() {
 				shoot.Spec.Provider.Workers = nil
@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validator
+
+import (
+	"context"
+	"fmt"
+
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	"github.com/gardener/gardener/pkg/apis/core"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	openstackvalidation "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/validation"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+)
+
+// NewBackupBucketValidator returns a new instance of a backup bucket validator.
+func NewBackupBucketValidator(mgr manager.Manager) extensionswebhook.Validator {
+	return &backupBucket{
+		decoder: serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
+	}
+}
+
+type backupBucket struct {
+	decoder runtime.Decoder
+}
+
+// Validate validates the provider config of the given backup bucket.
+func (b *backupBucket) Validate(_ context.Context, newObj, _ client.Object) error {
+	backupBucket, ok := newObj.(*core.BackupBucket)
+	if !ok {
+		return fmt.Errorf("wrong object type %T", newObj)
+	}
+
+	// backup buckets do not provide their provider type to the predicates of the webhook, hence it is checked here
+	if backupBucket.Spec.Provider.Type != openstack.Type || backupBucket.Spec.ProviderConfig == nil {
+		return nil
+	}
+
+	config, err := decodeBackupBucketConfig(b.decoder, backupBucket.Spec.ProviderConfig)
+	if err != nil {
+		return err
+	}
+
+	return openstackvalidation.ValidateBackupBucketConfig(config, field.NewPath("spec", "providerConfig")).ToAggregate()
+}
@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validator_test
+
+import (
+	"context"
+
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	"github.com/gardener/gardener/pkg/apis/core"
+	mockmanager "github.com/gardener/gardener/pkg/mock/controller-runtime/manager"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/admission/validator"
+	openstackinstall "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/install"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+)
+
+var _ = Describe("BackupBucket validator", func() {
+	Describe("#Validate", func() {
+		var (
+			backupBucketValidator extensionswebhook.Validator
+
+			ctrl *gomock.Controller
+			mgr  *mockmanager.MockManager
+
+			ctx          = context.TODO()
+			backupBucket *core.BackupBucket
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+
+			scheme := runtime.NewScheme()
+			Expect(openstackinstall.AddToScheme(scheme)).To(Succeed())
+
+			mgr = mockmanager.NewMockManager(ctrl)
+			mgr.EXPECT().GetScheme().Return(scheme)
+			backupBucketValidator = validator.NewBackupBucketValidator(mgr)
+
+			backupBucket = &core.BackupBucket{
+				Spec: core.BackupBucketSpec{
+					Provider: core.BackupBucketProvider{Type: openstack.Type, Region: "eu-de-1"},
+				},
+			}
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should return err when obj is not a BackupBucket", func() {
+			Expect(backupBucketValidator.Validate(ctx, &core.Shoot{}, nil)).To(MatchError("wrong object type *core.Shoot"))
+		})
+
+		It("should succeed for backup buckets without provider config", func() {
+			Expect(backupBucketValidator.Validate(ctx, backupBucket, nil)).To(Succeed())
+		})
+
+		It("should succeed for a valid provider config", func() {
+			backupBucket.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"openstack.provider.extensions.gardener.cloud/v1alpha1","kind":"BackupBucketConfig","segmentSize":"100Mi","tempURL":{"ttl":"2h"}}`)}
+
+			Expect(backupBucketValidator.Validate(ctx, backupBucket, nil)).To(Succeed())
+		})
+
+		It("should return err for an invalid provider config", func() {
+			backupBucket.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"openstack.provider.extensions.gardener.cloud/v1alpha1","kind":"BackupBucketConfig","segmentSize":"1Ki"}`)}
+
+			Expect(backupBucketValidator.Validate(ctx, backupBucket, nil)).To(MatchError(ContainSubstring("spec.providerConfig.segmentSize")))
+		})
+
+		It("should return err for a provider config which cannot be decoded", func() {
+			backupBucket.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"openstack.provider.extensions.gardener.cloud/v1alpha1","kind":"BackupBucketConfig","unknown":true}`)}
+
+			Expect(backupBucketValidator.Validate(ctx, backupBucket, nil)).To(HaveOccurred())
+		})
+
+		It("should not validate backup buckets of other providers", func() {
+			backupBucket.Spec.Provider.Type = "aws"
+			backupBucket.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"segmentSize":"1Ki"}`)}
+
+			Expect(backupBucketValidator.Validate(ctx, backupBucket, nil)).To(Succeed())
+		})
+	})
+})
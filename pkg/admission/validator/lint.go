@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorehelper "github.com/gardener/gardener/pkg/apis/core/helper"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	openstackvalidation "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/validation"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+)
+
+// LintPath is the path of the endpoint the provider configuration of shoots can be linted with.
+const LintPath = "/lint/shoots"
+
+// maxLintRequestBytes is the maximum size of the shoot manifests accepted by the lint endpoint.
+const maxLintRequestBytes = 3 * 1024 * 1024
+
+// LintResult is the response of the lint endpoint.
+type LintResult struct {
+	// Errors are the validation errors of the shoot. The shoot would be rejected if it is not empty.
+	Errors []string `json:"errors,omitempty"`
+	// Warnings are the warnings the shoot would be admitted with.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// NewLintHandler returns a handler which validates the shoot manifest posted to it like the shoot validator does on
+// creation, without applying it. All validation errors and warnings are returned as LintResult. Validations requiring
+// the cloud provider credentials of the shoot are skipped, as they are not known before the shoot is applied.
+func NewLintHandler(mgr manager.Manager) http.Handler {
+	return &lintHandler{
+		shoot:        newShoot(mgr),
+		shootDecoder: serializer.NewCodecFactory(mgr.GetScheme()).UniversalDecoder(),
+	}
+}
+
+type lintHandler struct {
+	shoot        *shoot
+	shootDecoder runtime.Decoder
+}
+
+func (h *lintHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, fmt.Sprintf("method %s is not allowed", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxLintRequestBytes))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("could not read request body: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	shoot := &core.Shoot{}
+	if _, _, err := h.shootDecoder.Decode(body, nil, shoot); err != nil {
+		http.Error(w, fmt.Sprintf("could not decode shoot: %v", err), http.StatusBadRequest)
+		return
+	}
+	if shoot.Spec.Provider.Type != openstack.Type {
+		http.Error(w, fmt.Sprintf("provider type of shoot must be %q", openstack.Type), http.StatusBadRequest)
+		return
+	}
+
+	result := h.lint(r.Context(), shoot)
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		logger.Error(err, "Could not write lint result")
+	}
+}
+
+func (h *lintHandler) lint(ctx context.Context, shoot *core.Shoot) *LintResult {
+	result := &LintResult{}
+	if gardencorehelper.IsWorkerless(shoot) {
+		return result
+	}
+
+	valContext, err := newValidationContext(ctx, h.shoot.decoder, h.shoot.client, shoot)
+	if err != nil {
+		result.Errors = append(result.Errors, err.Error())
+		return result
+	}
+
+	allErrs := field.ErrorList{}
+	allErrs = append(allErrs, h.shoot.validateShoot(valContext)...)
+	allErrs = append(allErrs, openstackvalidation.ValidateWorkersAgainstStorageClasses(shoot.Spec.Provider.Workers, valContext.cloudProfile, shoot.Spec.Region, valContext.cloudProfileConfig, workersPath)...)
+	allErrs = append(allErrs, openstackvalidation.ValidateWorkersAgainstRegionFlavors(nil, shoot.Spec.Provider.Workers, shoot.Spec.Region, valContext.cloudProfileConfig, workersPath)...)
+	for _, err := range allErrs {
+		result.Errors = append(result.Errors, err.Error())
+	}
+
+	ctx = context.WithValue(ctx, warningsKey{}, &result.Warnings)
+	warnAboutBestPractices(ctx, nil, valContext, nil)
+	h.shoot.warnAboutFlavorCapacity(ctx, nil, shoot)
+	return result
+}
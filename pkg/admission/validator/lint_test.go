@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validator_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/gardener/gardener/pkg/apis/core/install"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	mockmanager "github.com/gardener/gardener/pkg/mock/controller-runtime/manager"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/admission/validator"
+	openstackinstall "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/install"
+)
+
+var _ = Describe("Lint handler", func() {
+	const shootTemplate = `apiVersion: core.gardener.cloud/v1beta1
+kind: Shoot
+metadata:
+  name: foo
+  namespace: garden-dev
+spec:
+  cloudProfileName: openstack
+  region: eu-de-1
+  kubernetes:
+    version: 1.28.2
+  networking:
+    type: calico
+    nodes: 10.250.0.0/16
+  provider:
+    type: %s
+    infrastructureConfig:
+      apiVersion: openstack.provider.extensions.gardener.cloud/v1alpha1
+      kind: InfrastructureConfig
+      floatingPoolName: fip
+      networks:
+        workers: 10.250.0.0/16
+    controlPlaneConfig:
+      apiVersion: openstack.provider.extensions.gardener.cloud/v1alpha1
+      kind: ControlPlaneConfig
+      loadBalancerProvider: octavia
+    workers:
+    - name: worker
+      machine:
+        type: m1.large
+      minimum: 1
+      maximum: 3
+      zones:%s
+`
+
+	var (
+		ctrl    *gomock.Controller
+		handler http.Handler
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		scheme := runtime.NewScheme()
+		install.Install(scheme)
+		Expect(openstackinstall.AddToScheme(scheme)).To(Succeed())
+
+		c := fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(&gardencorev1beta1.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "openstack"},
+			Spec: gardencorev1beta1.CloudProfileSpec{
+				ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"openstack.provider.extensions.gardener.cloud/v1alpha1","kind":"CloudProfileConfig"}`)},
+			},
+		}).Build()
+
+		mgr := mockmanager.NewMockManager(ctrl)
+		mgr.EXPECT().GetScheme().Return(scheme).AnyTimes()
+		mgr.EXPECT().GetClient().Return(c)
+		mgr.EXPECT().GetAPIReader().Return(c)
+		handler = validator.NewLintHandler(mgr)
+	})
+
+	lint := func(method, body string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(method, validator.LintPath, strings.NewReader(body)))
+		return recorder
+	}
+
+	lintResult := func(recorder *httptest.ResponseRecorder) *validator.LintResult {
+		ExpectWithOffset(1, recorder.Code).To(Equal(http.StatusOK))
+		result := &validator.LintResult{}
+		ExpectWithOffset(1, json.Unmarshal(recorder.Body.Bytes(), result)).To(Succeed())
+		return result
+	}
+
+	It("should return no errors for a valid shoot", func() {
+		result := lintResult(lint(http.MethodPost, fmt.Sprintf(shootTemplate, "openstack", " [eu-de-1a]")))
+		Expect(result.Errors).To(BeEmpty())
+	})
+
+	It("should return the validation errors of the shoot", func() {
+		result := lintResult(lint(http.MethodPost, fmt.Sprintf(shootTemplate, "openstack", " []")))
+		Expect(result.Errors).To(ConsistOf("spec.provider.workers[0].zones: Required value: at least one zone must be configured"))
+	})
+
+	It("should return an error if a provider config cannot be decoded", func() {
+		shoot := strings.Replace(fmt.Sprintf(shootTemplate, "openstack", " [eu-de-1a]"), "loadBalancerProvider", "unknownField", 1)
+		result := lintResult(lint(http.MethodPost, shoot))
+		Expect(result.Errors).To(ConsistOf(ContainSubstring("error decoding controlPlaneConfig")))
+	})
+
+	It("should reject shoots of other providers", func() {
+		recorder := lint(http.MethodPost, fmt.Sprintf(shootTemplate, "gcp", " [eu-de-1a]"))
+		Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+	})
+
+	It("should reject bodies which are no shoots", func() {
+		recorder := lint(http.MethodPost, "foo")
+		Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+	})
+
+	It("should reject other methods than POST", func() {
+		recorder := lint(http.MethodGet, "")
+		Expect(recorder.Code).To(Equal(http.StatusMethodNotAllowed))
+	})
+})
@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
+)
+
+// AllowedRegionsPerSeed are the regions the shoots scheduled to a seed may be located in, by the name of the seed.
+// Shoots scheduled to seeds which are not contained may be located in any region.
+var AllowedRegionsPerSeed map[string]sets.Set[string]
+
+// ParseAllowedRegionsPerSeed parses the allowed regions of seeds given as `<seed>=<region>[,<region>...]`.
+func ParseAllowedRegionsPerSeed(values []string) (map[string]sets.Set[string], error) {
+	allowedRegionsPerSeed := make(map[string]sets.Set[string], len(values))
+	for _, value := range values {
+		seedName, regions, ok := strings.Cut(value, "=")
+		if !ok || seedName == "" || regions == "" {
+			return nil, fmt.Errorf("invalid allowed regions %q, expected <seed>=<region>[,<region>...]", value)
+		}
+		if _, ok := allowedRegionsPerSeed[seedName]; ok {
+			return nil, fmt.Errorf("allowed regions of seed %q are given more than once", seedName)
+		}
+		allowedRegionsPerSeed[seedName] = sets.New(strings.Split(regions, ",")...)
+	}
+	return allowedRegionsPerSeed, nil
+}
+
+// validateSeedRegion validates that the region of the shoot is served by the seed it is scheduled to. Only shoots
+// which are newly scheduled to a seed are validated, so that shoots already served by the seed keep being reconciled
+// if the allowed regions are changed later on.
+func validateSeedRegion(oldShoot, shoot *core.Shoot) *field.Error {
+	if shoot.Spec.SeedName == nil || (oldShoot != nil && pointer.StringEqual(oldShoot.Spec.SeedName, shoot.Spec.SeedName)) {
+		return nil
+	}
+
+	allowedRegions, ok := AllowedRegionsPerSeed[*shoot.Spec.SeedName]
+	if !ok || allowedRegions.Has(shoot.Spec.Region) {
+		return nil
+	}
+
+	return field.Forbidden(specPath.Child("seedName"), fmt.Sprintf("seed %q only serves the regions %v, but the shoot is located in region %q", *shoot.Spec.SeedName, sets.List(allowedRegions), shoot.Spec.Region))
+}
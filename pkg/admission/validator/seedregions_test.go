@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validator_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	. "github.com/gardener/gardener-extension-provider-openstack/pkg/admission/validator"
+)
+
+var _ = Describe("Seed regions", func() {
+	Describe("#ParseAllowedRegionsPerSeed", func() {
+		It("should parse the allowed regions of the seeds", func() {
+			allowedRegionsPerSeed, err := ParseAllowedRegionsPerSeed([]string{"seed-a=eu-de-1,eu-de-2", "seed-b=eu-nl-1"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(allowedRegionsPerSeed).To(Equal(map[string]sets.Set[string]{
+				"seed-a": sets.New("eu-de-1", "eu-de-2"),
+				"seed-b": sets.New("eu-nl-1"),
+			}))
+		})
+
+		It("should return an empty map if no regions are given", func() {
+			allowedRegionsPerSeed, err := ParseAllowedRegionsPerSeed(nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(allowedRegionsPerSeed).To(BeEmpty())
+		})
+
+		DescribeTable("should fail for invalid values",
+			func(values []string, expectedErr string) {
+				_, err := ParseAllowedRegionsPerSeed(values)
+				Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+			},
+			Entry("without regions", []string{"seed-a"}, `invalid allowed regions "seed-a"`),
+			Entry("with empty regions", []string{"seed-a="}, `invalid allowed regions "seed-a="`),
+			Entry("without seed", []string{"=eu-de-1"}, `invalid allowed regions "=eu-de-1"`),
+			Entry("with duplicate seeds", []string{"seed-a=eu-de-1", "seed-a=eu-de-2"}, `allowed regions of seed "seed-a" are given more than once`),
+		)
+	})
+})
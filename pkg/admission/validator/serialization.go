@@ -37,3 +37,12 @@ func decodeCloudProfileConfig(decoder runtime.Decoder, config *runtime.RawExtens
 
 	return cloudProfileConfig, nil
 }
+
+func decodeBackupBucketConfig(decoder runtime.Decoder, config *runtime.RawExtension) (*openstack.BackupBucketConfig, error) {
+	backupBucketConfig := &openstack.BackupBucketConfig{}
+	if err := util.Decode(decoder, config.Raw, backupBucketConfig); err != nil {
+		return nil, err
+	}
+
+	return backupBucketConfig, nil
+}
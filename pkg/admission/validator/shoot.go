@@ -7,27 +7,49 @@ package validator
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
 	"github.com/gardener/gardener/pkg/apis/core"
 	gardencorehelper "github.com/gardener/gardener/pkg/apis/core/helper"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/groups"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/admission/catalog"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/admission/flavorcapacity"
 	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/helper"
 	openstackvalidation "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/validation"
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+	openstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
 )
 
+// EnableFlavorCapacityWarnings enables warnings for worker pools whose machine type has no capacity left in their zones.
+var EnableFlavorCapacityWarnings bool
+
+// Catalog is the catalog of flavors, availability zones and images the worker pools are checked against. Warnings
+// for worker pools not matching the catalog are disabled if it is nil.
+var Catalog *catalog.Cache
+
+// OpenStackClientFactory creates the OpenStack clients the additional security groups and key pairs of worker pools are
+// looked up with in the project of the shoot. They are not validated against the project if it is nil.
+var OpenStackClientFactory openstackclient.FactoryFactory
+
 // NewShootValidator returns a new instance of a shoot validator.
 func NewShootValidator(mgr manager.Manager) extensionswebhook.Validator {
+	return newShoot(mgr)
+}
+
+func newShoot(mgr manager.Manager) *shoot {
 	return &shoot{
 		client:         mgr.GetClient(),
 		apiReader:      mgr.GetAPIReader(),
@@ -67,36 +89,94 @@ func (s *shoot) Validate(ctx context.Context, new, old client.Object) error {
 		return fmt.Errorf("wrong object type %T", new)
 	}
 
+	var oldShoot *core.Shoot
+	if old != nil {
+		oldShoot, ok = old.(*core.Shoot)
+		if !ok {
+			return fmt.Errorf("wrong object type %T for old object", old)
+		}
+	}
+
+	if err := validateSeedRegion(oldShoot, shoot); err != nil {
+		return field.ErrorList{err}.ToAggregate()
+	}
+
 	// Skip if it's a workerless Shoot
 	if gardencorehelper.IsWorkerless(shoot) {
 		return nil
 	}
 
-	var credentials *openstack.Credentials
+	// The read-only credentials are used for all lookups except the ones of key pairs, which are owned by the user
+	// creating the servers of the shoot and hence only visible with the credentials of the secret.
+	var credentials, userCredentials *openstack.Credentials
 	if shoot.Spec.SecretBindingName != nil {
 		secret, err := s.getCloudProviderSecretForShoot(ctx, shoot)
 		if err != nil {
 			return fmt.Errorf("failed to get cloud provider credentials: %v", err)
 		}
 
-		credentials, err = openstack.ExtractCredentials(secret, false)
+		credentials, err = openstack.ExtractReadOnlyCredentials(secret)
+		if err != nil {
+			return fmt.Errorf("invalid cloud credentials: %v", err)
+		}
+		userCredentials, err = openstack.ExtractCredentials(secret, false)
 		if err != nil {
 			return fmt.Errorf("invalid cloud credentials: %v", err)
 		}
 	}
 
-	if old != nil {
-		oldShoot, ok := old.(*core.Shoot)
-		if !ok {
-			return fmt.Errorf("wrong object type %T for old object", old)
+	if oldShoot != nil {
+		if err := s.validateShootUpdate(ctx, oldShoot, shoot, credentials, userCredentials); err != nil {
+			return err
 		}
-		return s.validateShootUpdate(ctx, oldShoot, shoot, credentials)
+		s.warnAboutFlavorCapacity(ctx, oldShoot, shoot)
+		return nil
 	}
 
-	return s.validateShootCreation(ctx, shoot, credentials)
+	if err := s.validateShootCreation(ctx, shoot, credentials, userCredentials); err != nil {
+		return err
+	}
+	s.warnAboutFlavorCapacity(ctx, nil, shoot)
+	return nil
 }
 
-func (s *shoot) validateShootCreation(ctx context.Context, shoot *core.Shoot, credentials *openstack.Credentials) error {
+// warnAboutFlavorCapacity adds a warning for every new or changed worker pool whose machine type has no capacity left
+// in one of its zones according to the last flavor capacity probe.
+func (s *shoot) warnAboutFlavorCapacity(ctx context.Context, oldShoot, shoot *core.Shoot) {
+	if !EnableFlavorCapacityWarnings {
+		return
+	}
+
+	capacity, err := flavorcapacity.Read(ctx, s.apiReader, shoot.Spec.CloudProfileName)
+	if err != nil {
+		logger.Error(err, "Could not read flavor capacity", "cloudProfile", shoot.Spec.CloudProfileName)
+		return
+	}
+	if capacity == nil {
+		return
+	}
+
+	oldWorkers := map[string]core.Worker{}
+	if oldShoot != nil {
+		for _, worker := range oldShoot.Spec.Provider.Workers {
+			oldWorkers[worker.Name] = worker
+		}
+	}
+
+	for i, worker := range shoot.Spec.Provider.Workers {
+		oldWorker, ok := oldWorkers[worker.Name]
+		if ok && oldWorker.Machine.Type == worker.Machine.Type && equality.Semantic.DeepEqual(oldWorker.Zones, worker.Zones) {
+			continue
+		}
+		for _, zone := range worker.Zones {
+			if servers, probed := capacity.Get(shoot.Spec.Region, zone, worker.Machine.Type); probed && servers == 0 {
+				addWarning(ctx, "%s: machine type %q has currently no capacity left in zone %q", workersPath.Index(i).String(), worker.Machine.Type, zone)
+			}
+		}
+	}
+}
+
+func (s *shoot) validateShootCreation(ctx context.Context, shoot *core.Shoot, credentials, userCredentials *openstack.Credentials) error {
 	valContext, err := newValidationContext(ctx, s.decoder, s.client, shoot)
 	if err != nil {
 		return err
@@ -105,14 +185,21 @@ func (s *shoot) validateShootCreation(ctx context.Context, shoot *core.Shoot, cr
 	allErrs := field.ErrorList{}
 
 	if credentials != nil {
+		allErrs = append(allErrs, openstackvalidation.ValidateInfrastructureConfigAgainstCredentials(valContext.infraConfig, credentials, infraConfigPath)...)
 		allErrs = append(allErrs, openstackvalidation.ValidateInfrastructureConfigAgainstCloudProfile(nil, valContext.infraConfig, credentials.DomainName, valContext.shoot.Spec.Region, valContext.cloudProfileConfig, infraConfigPath)...)
 		allErrs = append(allErrs, openstackvalidation.ValidateControlPlaneConfigAgainstCloudProfile(nil, valContext.cpConfig, credentials.DomainName, valContext.shoot.Spec.Region, valContext.infraConfig.FloatingPoolName, valContext.cloudProfileConfig, cpConfigPath)...)
+		allErrs = append(allErrs, openstackvalidation.ValidateLoadBalancerClassesAgainstPolicies(valContext.cpConfig, valContext.shoot, credentials.DomainName, valContext.infraConfig.FloatingPoolName, valContext.cloudProfileConfig, cpConfigPath.Child("loadBalancerClasses"))...)
 	}
 	allErrs = append(allErrs, s.validateShoot(valContext)...)
+	allErrs = append(allErrs, openstackvalidation.ValidateWorkersAgainstStorageClasses(valContext.shoot.Spec.Provider.Workers, valContext.cloudProfile, valContext.shoot.Spec.Region, valContext.cloudProfileConfig, workersPath)...)
+	allErrs = append(allErrs, openstackvalidation.ValidateWorkersAgainstRegionFlavors(nil, valContext.shoot.Spec.Provider.Workers, valContext.shoot.Spec.Region, valContext.cloudProfileConfig, workersPath)...)
+	allErrs = append(allErrs, validateWorkersAgainstSecurityGroups(ctx, nil, valContext, credentials)...)
+	allErrs = append(allErrs, validateWorkersAgainstKeyPairs(ctx, nil, valContext, userCredentials)...)
+	warnAboutBestPractices(ctx, nil, valContext, credentials)
 	return allErrs.ToAggregate()
 }
 
-func (s *shoot) validateShootUpdate(ctx context.Context, oldShoot, shoot *core.Shoot, credentials *openstack.Credentials) error {
+func (s *shoot) validateShootUpdate(ctx context.Context, oldShoot, shoot *core.Shoot, credentials, userCredentials *openstack.Credentials) error {
 	oldValContext, err := newValidationContext(ctx, s.lenientDecoder, s.client, oldShoot)
 	if err != nil {
 		return err
@@ -123,10 +210,13 @@ func (s *shoot) validateShootUpdate(ctx context.Context, oldShoot, shoot *core.S
 		return err
 	}
 
+	warnAboutBestPractices(ctx, oldValContext, valContext, credentials)
+
 	allErrs := field.ErrorList{}
 
 	allErrs = append(allErrs, openstackvalidation.ValidateInfrastructureConfigUpdate(oldValContext.infraConfig, valContext.infraConfig, infraConfigPath)...)
 	if credentials != nil {
+		allErrs = append(allErrs, openstackvalidation.ValidateInfrastructureConfigAgainstCredentials(valContext.infraConfig, credentials, infraConfigPath)...)
 		allErrs = append(allErrs, openstackvalidation.ValidateInfrastructureConfigAgainstCloudProfile(oldValContext.infraConfig, valContext.infraConfig, credentials.DomainName, valContext.shoot.Spec.Region, valContext.cloudProfileConfig, infraConfigPath)...)
 	}
 
@@ -148,22 +238,171 @@ func (s *shoot) validateShootUpdate(ctx context.Context, oldShoot, shoot *core.S
 		allErrs = append(allErrs, openstackvalidation.ValidateControlPlaneConfigAgainstCloudProfile(oldCpConfig, cpConfig, credentials.DomainName, valContext.shoot.Spec.Region, valContext.infraConfig.FloatingPoolName, valContext.cloudProfileConfig, cpConfigPath)...)
 	}
 
+	// Only validate against the load balancer class policies when the classes or the shoot properties the policies
+	// select on are updated, so that already running shoots won't break after policies were added to the cloud profile.
+	if credentials != nil &&
+		(!equality.Semantic.DeepEqual(oldCpConfig.LoadBalancerClasses, cpConfig.LoadBalancerClasses) ||
+			oldValContext.infraConfig.FloatingPoolName != valContext.infraConfig.FloatingPoolName ||
+			!equality.Semantic.DeepEqual(oldShoot.Spec.Purpose, shoot.Spec.Purpose) ||
+			!equality.Semantic.DeepEqual(oldShoot.Labels, shoot.Labels)) {
+		allErrs = append(allErrs, openstackvalidation.ValidateLoadBalancerClassesAgainstPolicies(cpConfig, valContext.shoot, credentials.DomainName, valContext.infraConfig.FloatingPoolName, valContext.cloudProfileConfig, cpConfigPath.Child("loadBalancerClasses"))...)
+	}
+
 	if errList := openstackvalidation.ValidateWorkersUpdate(oldValContext.shoot.Spec.Provider.Workers, valContext.shoot.Spec.Provider.Workers, workersPath); len(errList) > 0 {
 		return errList.ToAggregate()
 	}
 
+	// Only validate the worker pools against the storage classes if their zones are changed. This ensures that already
+	// running shoots won't break after storage classes were added to the cloud profile.
+	if !workerZones(oldValContext.shoot.Spec.Provider.Workers).Equal(workerZones(valContext.shoot.Spec.Provider.Workers)) {
+		allErrs = append(allErrs, openstackvalidation.ValidateWorkersAgainstStorageClasses(valContext.shoot.Spec.Provider.Workers, valContext.cloudProfile, valContext.shoot.Spec.Region, valContext.cloudProfileConfig, workersPath)...)
+	}
+
+	allErrs = append(allErrs, openstackvalidation.ValidateWorkersAgainstRegionFlavors(oldValContext.shoot.Spec.Provider.Workers, valContext.shoot.Spec.Provider.Workers, valContext.shoot.Spec.Region, valContext.cloudProfileConfig, workersPath)...)
+	allErrs = append(allErrs, validateWorkersAgainstSecurityGroups(ctx, oldValContext.shoot.Spec.Provider.Workers, valContext, credentials)...)
+	allErrs = append(allErrs, validateWorkersAgainstKeyPairs(ctx, oldValContext.shoot.Spec.Provider.Workers, valContext, userCredentials)...)
 	allErrs = append(allErrs, s.validateShoot(valContext)...)
 	return allErrs.ToAggregate()
 }
 
+// validateWorkersAgainstSecurityGroups validates the additional security groups of the worker pools against the security
+// groups of the project of the shoot. A warning is added instead if they cannot be listed, so that an unavailable
+// Networking API does not block changes of shoots.
+func validateWorkersAgainstSecurityGroups(ctx context.Context, oldWorkers []core.Worker, valContext *validationContext, credentials *openstack.Credentials) field.ErrorList {
+	if OpenStackClientFactory == nil || credentials == nil {
+		return nil
+	}
+
+	allErrs, err := openstackvalidation.ValidateWorkersAgainstSecurityGroups(oldWorkers, valContext.shoot.Spec.Provider.Workers, func() ([]groups.SecGroup, error) {
+		return listProjectSecurityGroups(credentials, valContext.cloudProfileConfig, valContext.shoot.Spec.Region)
+	}, workersPath)
+	if err != nil {
+		addWarning(ctx, "%s: additional security groups could not be validated: %v", workersPath.String(), err)
+	}
+	return allErrs
+}
+
+// validateWorkersAgainstKeyPairs validates that the key pairs of the worker pools exist. As key pairs are owned by users,
+// they are looked up with the given credentials of the cloud provider secret, which are used to create the servers. A
+// warning is added instead if they cannot be looked up, so that an unavailable Compute API does not block changes of
+// shoots.
+func validateWorkersAgainstKeyPairs(ctx context.Context, oldWorkers []core.Worker, valContext *validationContext, credentials *openstack.Credentials) field.ErrorList {
+	if OpenStackClientFactory == nil || credentials == nil {
+		return nil
+	}
+
+	allErrs, err := openstackvalidation.ValidateWorkersAgainstKeyPairs(oldWorkers, valContext.shoot.Spec.Provider.Workers, func(name string) (bool, error) {
+		return keyPairExists(credentials, valContext.cloudProfileConfig, valContext.shoot.Spec.Region, name)
+	}, workersPath)
+	if err != nil {
+		addWarning(ctx, "%s: key pairs could not be validated: %v", workersPath.String(), err)
+	}
+	return allErrs
+}
+
+func listProjectSecurityGroups(credentials *openstack.Credentials, cloudProfileConfig *api.CloudProfileConfig, region string) ([]groups.SecGroup, error) {
+	factory, err := newRegionClientFactory(credentials, cloudProfileConfig, region)
+	if err != nil {
+		return nil, err
+	}
+	projectID, err := factory.ProjectID()
+	if err != nil {
+		return nil, err
+	}
+	networking, err := factory.Networking(openstackclient.WithRegion(region))
+	if err != nil {
+		return nil, err
+	}
+	return networking.ListSecurityGroup(groups.ListOpts{TenantID: projectID})
+}
+
+func keyPairExists(credentials *openstack.Credentials, cloudProfileConfig *api.CloudProfileConfig, region, name string) (bool, error) {
+	factory, err := newRegionClientFactory(credentials, cloudProfileConfig, region)
+	if err != nil {
+		return false, err
+	}
+	compute, err := factory.Compute(openstackclient.WithRegion(region))
+	if err != nil {
+		return false, err
+	}
+	keyPair, err := compute.GetKeyPair(name)
+	if err != nil {
+		return false, err
+	}
+	return keyPair != nil, nil
+}
+
+// newRegionClientFactory creates an OpenStack client factory for the given credentials in the given region, completing
+// them with the keystone URL, CA certificate and endpoint overrides of the region from the cloud profile.
+func newRegionClientFactory(credentials *openstack.Credentials, cloudProfileConfig *api.CloudProfileConfig, region string) (openstackclient.Factory, error) {
+	regionCredentials := *credentials
+	if len(strings.TrimSpace(regionCredentials.AuthURL)) == 0 {
+		authURL, err := helper.FindKeyStoneURL(cloudProfileConfig.KeyStoneURLs, cloudProfileConfig.KeyStoneURL, region)
+		if err != nil {
+			return nil, err
+		}
+		regionCredentials.AuthURL = authURL
+	}
+	if caCert := helper.FindKeyStoneCACert(cloudProfileConfig.KeyStoneURLs, cloudProfileConfig.KeyStoneCACert, region); caCert != nil && len(regionCredentials.CACert) == 0 {
+		regionCredentials.CACert = *caCert
+	}
+	// only the endpoint overrides of the cloud profile are used, like in the cloudprovider secrets of shoots
+	regionCredentials.EndpointOverrides = helper.FindEndpointOverrides(cloudProfileConfig.EndpointOverrides, region)
+
+	factory, err := OpenStackClientFactory.NewFactory(&regionCredentials)
+	if err != nil {
+		return nil, fmt.Errorf("could not create OpenStack client: %w", err)
+	}
+	return factory, nil
+}
+
+func workerZones(workers []core.Worker) sets.Set[string] {
+	zones := sets.New[string]()
+	for _, worker := range workers {
+		zones.Insert(worker.Zones...)
+	}
+	return zones
+}
+
+// warnAboutBestPractices adds warnings for risky but valid configurations of the shoot. Only new or changed parts of the
+// configuration are considered on updates to not repeat warnings the user has already seen.
+func warnAboutBestPractices(ctx context.Context, oldValContext, valContext *validationContext, credentials *openstack.Credentials) {
+	var (
+		warnings    []string
+		oldWorkers  []core.Worker
+		oldInfraCfg *api.InfrastructureConfig
+	)
+	if oldValContext != nil {
+		oldWorkers = oldValContext.shoot.Spec.Provider.Workers
+		oldInfraCfg = oldValContext.infraConfig
+	}
+
+	warnings = append(warnings, openstackvalidation.WarningsForWorkers(oldWorkers, valContext.shoot.Spec.Provider.Workers, valContext.cloudProfile, valContext.shoot.Spec.Region, workersPath)...)
+	if Catalog != nil {
+		if regionCatalog := Catalog.Get(valContext.cloudProfile.Name, valContext.shoot.Spec.Region); regionCatalog != nil {
+			warnings = append(warnings, regionCatalog.WorkerWarnings(oldWorkers, valContext.shoot.Spec.Provider.Workers, valContext.cloudProfileConfig, valContext.shoot.Spec.Region, workersPath)...)
+		}
+	}
+	if credentials != nil {
+		warnings = append(warnings, openstackvalidation.WarningsForInfrastructureConfig(oldInfraCfg, valContext.infraConfig, credentials.DomainName, valContext.shoot.Spec.Region, valContext.cloudProfileConfig, infraConfigPath)...)
+	}
+
+	for _, warning := range warnings {
+		addWarning(ctx, "%s", warning)
+	}
+}
+
 func (s *shoot) validateShoot(context *validationContext) field.ErrorList {
 	allErrs := field.ErrorList{}
 	if context.shoot.Spec.Networking != nil {
 		allErrs = append(allErrs, openstackvalidation.ValidateNetworking(context.shoot.Spec.Networking, nwPath)...)
 		allErrs = append(allErrs, openstackvalidation.ValidateInfrastructureConfig(context.infraConfig, context.shoot.Spec.Networking.Nodes, infraConfigPath)...)
 	}
+	allErrs = append(allErrs, openstackvalidation.ValidateInfrastructureConfigAgainstResources(context.infraConfig, context.shoot.Spec.Resources, infraConfigPath)...)
 	allErrs = append(allErrs, openstackvalidation.ValidateControlPlaneConfig(context.cpConfig, context.infraConfig, context.shoot.Spec.Kubernetes.Version, cpConfigPath)...)
+	allErrs = append(allErrs, openstackvalidation.ValidateKubeProxyReplacement(context.cpConfig, context.shoot.Spec.Kubernetes, context.shoot.Spec.Provider.Workers, cpConfigPath.Child("kubeProxyReplacement"), workersPath)...)
 	allErrs = append(allErrs, openstackvalidation.ValidateWorkers(context.shoot.Spec.Provider.Workers, context.cloudProfileConfig, workersPath)...)
+	allErrs = append(allErrs, openstackvalidation.ValidateWorkersAgainstInfrastructureConfig(context.shoot.Spec.Provider.Workers, context.infraConfig, workersPath)...)
 	return allErrs
 }
 
@@ -225,5 +464,35 @@ func (s *shoot) getCloudProviderSecretForShoot(ctx context.Context, shoot *core.
 		return nil, err
 	}
 
+	if name, ok := openstack.CredentialsResourceNameOf(secret); ok {
+		return s.getAliasedCredentialsSecret(ctx, shoot, name)
+	}
+	return secret, nil
+}
+
+// getAliasedCredentialsSecret returns the centrally managed secret referenced by the resource with the given name of
+// the shoot, whose credentials are aliased by the cloud provider secret of the shoot.
+func (s *shoot) getAliasedCredentialsSecret(ctx context.Context, shoot *core.Shoot, resourceName string) (*corev1.Secret, error) {
+	var resource *core.NamedResourceReference
+	for i := range shoot.Spec.Resources {
+		if shoot.Spec.Resources[i].Name == resourceName {
+			resource = &shoot.Spec.Resources[i]
+			break
+		}
+	}
+	if resource == nil {
+		return nil, fmt.Errorf("resource %q of the aliased credentials not found in the resources of the shoot", resourceName)
+	}
+	if resource.ResourceRef.Kind != "Secret" {
+		return nil, fmt.Errorf("resource %q of the aliased credentials is not a Secret", resourceName)
+	}
+
+	secret := &corev1.Secret{}
+	if err := s.apiReader.Get(ctx, kutil.Key(shoot.Namespace, resource.ResourceRef.Name), secret); err != nil {
+		return nil, err
+	}
+	if err := openstackvalidation.ValidateCloudProviderSecret(secret); err != nil {
+		return nil, err
+	}
 	return secret, nil
 }
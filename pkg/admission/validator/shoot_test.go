@@ -17,6 +17,7 @@ import (
 	"go.uber.org/mock/gomock"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/pointer"
 
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/admission/validator"
@@ -82,5 +83,67 @@ var _ = Describe("Shoot validator", func() {
 				Expect(err).NotTo(HaveOccurred())
 			})
 		})
+
+		Context("Seed regions", func() {
+			BeforeEach(func() {
+				validator.AllowedRegionsPerSeed = map[string]sets.Set[string]{"seed-eu": sets.New("eu-de-1", "eu-de-2")}
+				shoot.Spec.Provider.Workers = []core.Worker{{Name: "worker"}}
+				shoot.Spec.Region = "us-west"
+				shoot.Spec.SeedName = pointer.String("seed-eu")
+			})
+
+			AfterEach(func() {
+				validator.AllowedRegionsPerSeed = nil
+			})
+
+			It("should forbid creating a shoot scheduled to a seed not serving its region", func() {
+				err := shootValidator.Validate(ctx, shoot, nil)
+				Expect(err).To(MatchError(ContainSubstring(`spec.seedName: Forbidden: seed "seed-eu" only serves the regions [eu-de-1 eu-de-2], but the shoot is located in region "us-west"`)))
+			})
+
+			It("should forbid scheduling a shoot to a seed not serving its region", func() {
+				oldShoot := shoot.DeepCopy()
+				oldShoot.Spec.SeedName = nil
+
+				err := shootValidator.Validate(ctx, shoot, oldShoot)
+				Expect(err).To(MatchError(ContainSubstring("spec.seedName: Forbidden")))
+			})
+
+			It("should forbid moving a shoot to a seed not serving its region", func() {
+				oldShoot := shoot.DeepCopy()
+				oldShoot.Spec.SeedName = pointer.String("seed-us")
+
+				err := shootValidator.Validate(ctx, shoot, oldShoot)
+				Expect(err).To(MatchError(ContainSubstring("spec.seedName: Forbidden")))
+			})
+
+			Context("workerless shoot", func() {
+				BeforeEach(func() {
+					shoot.Spec.Provider.Workers = nil
+				})
+
+				It("should allow scheduling a shoot to a seed serving its region", func() {
+					shoot.Spec.Region = "eu-de-2"
+
+					Expect(shootValidator.Validate(ctx, shoot, nil)).To(Succeed())
+				})
+
+				It("should allow scheduling a shoot to a seed without allowed regions", func() {
+					shoot.Spec.SeedName = pointer.String("seed-us")
+
+					Expect(shootValidator.Validate(ctx, shoot, nil)).To(Succeed())
+				})
+
+				It("should allow shoots already scheduled to the seed", func() {
+					Expect(shootValidator.Validate(ctx, shoot, shoot.DeepCopy())).To(Succeed())
+				})
+
+				It("should allow shoots which are not scheduled yet", func() {
+					shoot.Spec.SeedName = nil
+
+					Expect(shootValidator.Validate(ctx, shoot, nil)).To(Succeed())
+				})
+			})
+		})
 	})
 })
@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validator
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+type warningsKey struct{}
+
+// withWarnings wraps the given handler so that validators can return admission warnings via addWarning.
+func withWarnings(handler admission.Handler) admission.Handler {
+	return admission.HandlerFunc(func(ctx context.Context, req admission.Request) admission.Response {
+		var warnings []string
+		response := handler.Handle(context.WithValue(ctx, warningsKey{}, &warnings), req)
+		response.Warnings = append(response.Warnings, warnings...)
+		return response
+	})
+}
+
+// addWarning adds a warning to the response of the admission request handled with the given context. It is a no-op if
+// the handler has not been wrapped with withWarnings.
+func addWarning(ctx context.Context, format string, args ...interface{}) {
+	if warnings, ok := ctx.Value(warningsKey{}).(*[]string); ok {
+		*warnings = append(*warnings, fmt.Sprintf(format, args...))
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/gardener/gardener/pkg/apis/core"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -22,21 +23,24 @@ const (
 	Name = "validator"
 	// SecretsValidatorName is the name of the secrets validator.
 	SecretsValidatorName = "secrets." + Name
+	// BackupBucketsValidatorName is the name of the backup buckets validator.
+	BackupBucketsValidatorName = "backupbuckets." + Name
 )
 
 var logger = log.Log.WithName("openstack-validator-webhook")
 
-// New creates a new webhook that validates Shoot and CloudProfile resources.
+// New creates a new webhook that validates Shoot and CloudProfile resources. Shoots are validated as well when they
+// are scheduled to a seed via their binding subresource.
 func New(mgr manager.Manager) (*extensionswebhook.Webhook, error) {
 	logger.Info("Setting up webhook", "name", Name)
 
-	return extensionswebhook.New(mgr, extensionswebhook.Args{
+	webhook, err := extensionswebhook.New(mgr, extensionswebhook.Args{
 		Provider:   openstack.Type,
 		Name:       Name,
 		Path:       "/webhooks/validate",
 		Predicates: []predicate.Predicate{extensionspredicate.GardenCoreProviderType(openstack.Type)},
 		Validators: map[extensionswebhook.Validator][]extensionswebhook.Type{
-			NewShootValidator(mgr):         {{Obj: &core.Shoot{}}},
+			NewShootValidator(mgr):         {{Obj: &core.Shoot{}}, {Obj: &core.Shoot{}, Subresource: pointer.String("binding")}},
 			NewCloudProfileValidator(mgr):  {{Obj: &core.CloudProfile{}}},
 			NewSecretBindingValidator(mgr): {{Obj: &core.SecretBinding{}}},
 		},
@@ -45,6 +49,12 @@ func New(mgr manager.Manager) (*extensionswebhook.Webhook, error) {
 			MatchLabels: map[string]string{"provider.extensions.gardener.cloud/openstack": "true"},
 		},
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	webhook.Webhook.Handler = withWarnings(webhook.Webhook.Handler)
+	return webhook, nil
 }
 
 // NewSecretsWebhook creates a new validation webhook for Secrets.
@@ -64,3 +74,22 @@ func NewSecretsWebhook(mgr manager.Manager) (*extensionswebhook.Webhook, error)
 		},
 	})
 }
+
+// NewBackupBucketsWebhook creates a new validation webhook for BackupBuckets. Unlike the other garden resources, backup
+// buckets cannot be filtered by the provider type predicate, hence they are validated by a separate webhook.
+func NewBackupBucketsWebhook(mgr manager.Manager) (*extensionswebhook.Webhook, error) {
+	logger.Info("Setting up webhook", "name", BackupBucketsValidatorName)
+
+	return extensionswebhook.New(mgr, extensionswebhook.Args{
+		Provider: openstack.Type,
+		Name:     BackupBucketsValidatorName,
+		Path:     "/webhooks/validate/backupbuckets",
+		Validators: map[extensionswebhook.Validator][]extensionswebhook.Type{
+			NewBackupBucketValidator(mgr): {{Obj: &core.BackupBucket{}}},
+		},
+		Target: extensionswebhook.TargetSeed,
+		ObjectSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{"provider.extensions.gardener.cloud/openstack": "true"},
+		},
+	})
+}
@@ -8,6 +8,7 @@ import (
 	healthcheckconfig "github.com/gardener/gardener/extensions/pkg/apis/config"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	componentbaseconfig "k8s.io/component-base/config"
 )
 
@@ -26,6 +27,34 @@ type ControllerConfiguration struct {
 	HealthCheckConfig *healthcheckconfig.HealthCheckConfig
 	// BastionConfig is the config for the Bastion
 	BastionConfig *BastionConfig
+	// EgressRestriction is the config for restricting the egress traffic of the shoot control plane components.
+	EgressRestriction *EgressRestriction
+	// ControlPlaneScheduling is the config for the scheduling of the shoot control plane components.
+	ControlPlaneScheduling *ControlPlaneScheduling
+	// QuotaMonitoring is the config for monitoring the quota usage of the OpenStack projects of the shoots.
+	QuotaMonitoring *QuotaMonitoring
+	// Terraformer is the config for the Terraformer which reconciles the infrastructure of the shoots.
+	Terraformer *Terraformer
+	// MachineClassExtraAllowedKeys are the keys of the provider spec of the machine classes which may be set in the
+	// MachineClassExtra of the WorkerConfig of the shoots if the MachineClassExtra feature gate is enabled.
+	MachineClassExtraAllowedKeys []string
+	// ZoneRedundantLoadBalancer is the config of the load balancers exposing the kube-apiservers of the shoots which
+	// request a zone-redundant exposure.
+	ZoneRedundantLoadBalancer *ZoneRedundantLoadBalancer
+	// FeatureGates is a map of feature names to bools that enable or disable alpha/experimental features of the
+	// extension, see the features package for the known features.
+	FeatureGates map[string]bool
+}
+
+// ZoneRedundantLoadBalancer is the config of the zone-redundant load balancers exposing the kube-apiservers of shoots.
+// It applies if the seed is an OpenStack cluster which exposes the kube-apiservers with a load balancer per shoot.
+type ZoneRedundantLoadBalancer struct {
+	// FlavorID is the id of the Octavia flavor of the load balancers, e.g. a flavor with the ACTIVE_STANDBY topology
+	// whose amphorae are placed in different zones.
+	FlavorID *string
+	// AvailabilityZone is the name of the Octavia availability zone of the load balancers, e.g. one whose profile spans
+	// several compute zones.
+	AvailabilityZone *string
 }
 
 // ETCD is an etcd configuration.
@@ -56,4 +85,53 @@ type BastionConfig struct {
 	ImageRef string
 	// FlavorRef is the openstack flavorRef reference
 	FlavorRef string
+	// DNS is the config for registering DNS records for the public IPs of the bastion hosts in Designate.
+	DNS *BastionDNS
+}
+
+// BastionDNS is the config for registering DNS records for the public IPs of the bastion hosts in Designate.
+type BastionDNS struct {
+	// Zone is the name of the Designate zone the records are registered in, e.g. `bastion.example.com`. The zone must
+	// be visible to the projects of the shoots, e.g. by sharing it with them.
+	Zone string
+	// TTL is the time to live of the records in seconds.
+	TTL *int32
+}
+
+// EgressRestriction is the config for restricting the egress traffic of the shoot control plane components talking to
+// OpenStack (cloud-controller-manager, CSI controllers and machine-controller-manager) to the OpenStack endpoints.
+type EgressRestriction struct {
+	// Enabled specifies whether the egress traffic is restricted to the endpoints found in the service catalog.
+	Enabled bool
+	// AdditionalCIDRs are CIDRs the egress traffic is additionally allowed to, e.g. for proxies or endpoints
+	// which are not part of the service catalog.
+	AdditionalCIDRs []string
+}
+
+// ControlPlaneScheduling is the config for the scheduling of the shoot control plane components talking to OpenStack
+// (cloud-controller-manager, CSI controllers and machine-controller-manager).
+type ControlPlaneScheduling struct {
+	// PriorityClassName is the name of the priority class of the components.
+	PriorityClassName *string
+	// MaxUnavailable is the maximum number of unavailable pods allowed by the pod disruption budgets of the
+	// cloud-controller-manager and the CSI controllers.
+	MaxUnavailable *intstr.IntOrString
+	// TopologySpreadKeys are the topology keys (e.g. zones or hostnames) the pods of the components are spread across.
+	TopologySpreadKeys []string
+}
+
+// Terraformer is the config for the Terraformer which reconciles the infrastructure of the shoots.
+type Terraformer struct {
+	// Parallelism is the number of OpenStack resources Terraform creates, updates or deletes concurrently.
+	Parallelism *int32
+}
+
+// QuotaMonitoring is the config for periodically collecting the quota usage (instances, cores, RAM, volumes and
+// floating IPs) of the OpenStack projects of the shoots. The usage is exposed as metrics and events are recorded if it
+// reaches one of the thresholds.
+type QuotaMonitoring struct {
+	// SyncPeriod is the period in which the quota usage of a shoot is collected.
+	SyncPeriod *metav1.Duration
+	// Thresholds are the percentages of a quota at which events are recorded.
+	Thresholds []int32
 }
@@ -5,9 +5,30 @@
 package v1alpha1
 
 import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
 )
 
 func addDefaultingFuncs(scheme *runtime.Scheme) error {
 	return RegisterDefaults(scheme)
 }
+
+// SetDefaults_QuotaMonitoring sets default values for QuotaMonitoring objects.
+func SetDefaults_QuotaMonitoring(obj *QuotaMonitoring) {
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: 10 * time.Minute}
+	}
+	if len(obj.Thresholds) == 0 {
+		obj.Thresholds = []int32{80, 90, 100}
+	}
+}
+
+// SetDefaults_BastionDNS sets default values for BastionDNS objects.
+func SetDefaults_BastionDNS(obj *BastionDNS) {
+	if obj.TTL == nil {
+		obj.TTL = pointer.Int32(300)
+	}
+}
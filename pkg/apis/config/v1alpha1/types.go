@@ -8,6 +8,7 @@ import (
 	healthcheckconfigv1alpha1 "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 )
 
@@ -30,6 +31,43 @@ type ControllerConfiguration struct {
 	// BastionConfig the config for the Bastion
 	// +optional
 	BastionConfig *BastionConfig `json:"bastionConfig,omitempty"`
+	// EgressRestriction is the config for restricting the egress traffic of the shoot control plane components.
+	// +optional
+	EgressRestriction *EgressRestriction `json:"egressRestriction,omitempty"`
+	// ControlPlaneScheduling is the config for the scheduling of the shoot control plane components.
+	// +optional
+	ControlPlaneScheduling *ControlPlaneScheduling `json:"controlPlaneScheduling,omitempty"`
+	// QuotaMonitoring is the config for monitoring the quota usage of the OpenStack projects of the shoots.
+	// +optional
+	QuotaMonitoring *QuotaMonitoring `json:"quotaMonitoring,omitempty"`
+	// Terraformer is the config for the Terraformer which reconciles the infrastructure of the shoots.
+	// +optional
+	Terraformer *Terraformer `json:"terraformer,omitempty"`
+	// MachineClassExtraAllowedKeys are the keys of the provider spec of the machine classes which may be set in the
+	// MachineClassExtra of the WorkerConfig of the shoots if the MachineClassExtra feature gate is enabled.
+	// +optional
+	MachineClassExtraAllowedKeys []string `json:"machineClassExtraAllowedKeys,omitempty"`
+	// FeatureGates is a map of feature names to bools that enable or disable alpha/experimental features of the
+	// extension.
+	// +optional
+	FeatureGates map[string]bool `json:"featureGates,omitempty"`
+	// ZoneRedundantLoadBalancer is the config of the load balancers exposing the kube-apiservers of the shoots which
+	// request a zone-redundant exposure.
+	// +optional
+	ZoneRedundantLoadBalancer *ZoneRedundantLoadBalancer `json:"zoneRedundantLoadBalancer,omitempty"`
+}
+
+// ZoneRedundantLoadBalancer is the config of the zone-redundant load balancers exposing the kube-apiservers of shoots.
+// It applies if the seed is an OpenStack cluster which exposes the kube-apiservers with a load balancer per shoot.
+type ZoneRedundantLoadBalancer struct {
+	// FlavorID is the id of the Octavia flavor of the load balancers, e.g. a flavor with the ACTIVE_STANDBY topology
+	// whose amphorae are placed in different zones.
+	// +optional
+	FlavorID *string `json:"flavorID,omitempty"`
+	// AvailabilityZone is the name of the Octavia availability zone of the load balancers, e.g. one whose profile spans
+	// several compute zones.
+	// +optional
+	AvailabilityZone *string `json:"availabilityZone,omitempty"`
 }
 
 // ETCD is an etcd configuration.
@@ -63,4 +101,63 @@ type BastionConfig struct {
 	ImageRef string `json:"imageRef,omitempty"`
 	// FlavorRef is the openstack flavorRef reference
 	FlavorRef string `json:"flavorRef,omitempty"`
+	// DNS is the config for registering DNS records for the public IPs of the bastion hosts in Designate.
+	// +optional
+	DNS *BastionDNS `json:"dns,omitempty"`
+}
+
+// BastionDNS is the config for registering DNS records for the public IPs of the bastion hosts in Designate.
+type BastionDNS struct {
+	// Zone is the name of the Designate zone the records are registered in, e.g. `bastion.example.com`. The zone must
+	// be visible to the projects of the shoots, e.g. by sharing it with them.
+	Zone string `json:"zone"`
+	// TTL is the time to live of the records in seconds. Defaults to 300.
+	// +optional
+	TTL *int32 `json:"ttl,omitempty"`
+}
+
+// EgressRestriction is the config for restricting the egress traffic of the shoot control plane components talking to
+// OpenStack (cloud-controller-manager, CSI controllers and machine-controller-manager) to the OpenStack endpoints.
+type EgressRestriction struct {
+	// Enabled specifies whether the egress traffic is restricted to the endpoints found in the service catalog.
+	Enabled bool `json:"enabled"`
+	// AdditionalCIDRs are CIDRs the egress traffic is additionally allowed to, e.g. for proxies or endpoints
+	// which are not part of the service catalog.
+	// +optional
+	AdditionalCIDRs []string `json:"additionalCIDRs,omitempty"`
+}
+
+// ControlPlaneScheduling is the config for the scheduling of the shoot control plane components talking to OpenStack
+// (cloud-controller-manager, CSI controllers and machine-controller-manager).
+type ControlPlaneScheduling struct {
+	// PriorityClassName is the name of the priority class of the components.
+	// +optional
+	PriorityClassName *string `json:"priorityClassName,omitempty"`
+	// MaxUnavailable is the maximum number of unavailable pods allowed by the pod disruption budgets of the
+	// cloud-controller-manager and the CSI controllers.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+	// TopologySpreadKeys are the topology keys (e.g. zones or hostnames) the pods of the components are spread across.
+	// +optional
+	TopologySpreadKeys []string `json:"topologySpreadKeys,omitempty"`
+}
+
+// Terraformer is the config for the Terraformer which reconciles the infrastructure of the shoots.
+type Terraformer struct {
+	// Parallelism is the number of OpenStack resources Terraform creates, updates or deletes concurrently. Raising it
+	// speeds up the reconciliation on clouds with slow API responses. Defaults to the default of Terraform (10).
+	// +optional
+	Parallelism *int32 `json:"parallelism,omitempty"`
+}
+
+// QuotaMonitoring is the config for periodically collecting the quota usage (instances, cores, RAM, volumes and
+// floating IPs) of the OpenStack projects of the shoots. The usage is exposed as metrics and events are recorded if it
+// reaches one of the thresholds.
+type QuotaMonitoring struct {
+	// SyncPeriod is the period in which the quota usage of a shoot is collected. Defaults to 10m.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// Thresholds are the percentages of a quota at which events are recorded. Defaults to 80, 90 and 100.
+	// +optional
+	Thresholds []int32 `json:"thresholds,omitempty"`
 }
@@ -28,8 +28,10 @@ import (
 	apisconfig "github.com/gardener/gardener/extensions/pkg/apis/config"
 	apisconfigv1alpha1 "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
 	resource "k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 	componentbaseconfig "k8s.io/component-base/config"
 	configv1alpha1 "k8s.io/component-base/config/v1alpha1"
 )
@@ -51,6 +53,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*BastionDNS)(nil), (*config.BastionDNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BastionDNS_To_config_BastionDNS(a.(*BastionDNS), b.(*config.BastionDNS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.BastionDNS)(nil), (*BastionDNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_BastionDNS_To_v1alpha1_BastionDNS(a.(*config.BastionDNS), b.(*BastionDNS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ControlPlaneScheduling)(nil), (*config.ControlPlaneScheduling)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ControlPlaneScheduling_To_config_ControlPlaneScheduling(a.(*ControlPlaneScheduling), b.(*config.ControlPlaneScheduling), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ControlPlaneScheduling)(nil), (*ControlPlaneScheduling)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ControlPlaneScheduling_To_v1alpha1_ControlPlaneScheduling(a.(*config.ControlPlaneScheduling), b.(*ControlPlaneScheduling), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ControllerConfiguration)(nil), (*config.ControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ControllerConfiguration_To_config_ControllerConfiguration(a.(*ControllerConfiguration), b.(*config.ControllerConfiguration), scope)
 	}); err != nil {
@@ -91,12 +113,53 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*EgressRestriction)(nil), (*config.EgressRestriction)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EgressRestriction_To_config_EgressRestriction(a.(*EgressRestriction), b.(*config.EgressRestriction), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.EgressRestriction)(nil), (*EgressRestriction)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_EgressRestriction_To_v1alpha1_EgressRestriction(a.(*config.EgressRestriction), b.(*EgressRestriction), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*QuotaMonitoring)(nil), (*config.QuotaMonitoring)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_QuotaMonitoring_To_config_QuotaMonitoring(a.(*QuotaMonitoring), b.(*config.QuotaMonitoring), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.QuotaMonitoring)(nil), (*QuotaMonitoring)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_QuotaMonitoring_To_v1alpha1_QuotaMonitoring(a.(*config.QuotaMonitoring), b.(*QuotaMonitoring), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Terraformer)(nil), (*config.Terraformer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Terraformer_To_config_Terraformer(a.(*Terraformer), b.(*config.Terraformer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.Terraformer)(nil), (*Terraformer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_Terraformer_To_v1alpha1_Terraformer(a.(*config.Terraformer), b.(*Terraformer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ZoneRedundantLoadBalancer)(nil), (*config.ZoneRedundantLoadBalancer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ZoneRedundantLoadBalancer_To_config_ZoneRedundantLoadBalancer(a.(*ZoneRedundantLoadBalancer), b.(*config.ZoneRedundantLoadBalancer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ZoneRedundantLoadBalancer)(nil), (*ZoneRedundantLoadBalancer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ZoneRedundantLoadBalancer_To_v1alpha1_ZoneRedundantLoadBalancer(a.(*config.ZoneRedundantLoadBalancer), b.(*ZoneRedundantLoadBalancer), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
 func autoConvert_v1alpha1_BastionConfig_To_config_BastionConfig(in *BastionConfig, out *config.BastionConfig, s conversion.Scope) error {
 	out.ImageRef = in.ImageRef
 	out.FlavorRef = in.FlavorRef
+	out.DNS = (*config.BastionDNS)(unsafe.Pointer(in.DNS))
 	return nil
 }
 
@@ -108,6 +171,7 @@ func Convert_v1alpha1_BastionConfig_To_config_BastionConfig(in *BastionConfig, o
 func autoConvert_config_BastionConfig_To_v1alpha1_BastionConfig(in *config.BastionConfig, out *BastionConfig, s conversion.Scope) error {
 	out.ImageRef = in.ImageRef
 	out.FlavorRef = in.FlavorRef
+	out.DNS = (*BastionDNS)(unsafe.Pointer(in.DNS))
 	return nil
 }
 
@@ -116,6 +180,52 @@ func Convert_config_BastionConfig_To_v1alpha1_BastionConfig(in *config.BastionCo
 	return autoConvert_config_BastionConfig_To_v1alpha1_BastionConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_BastionDNS_To_config_BastionDNS(in *BastionDNS, out *config.BastionDNS, s conversion.Scope) error {
+	out.Zone = in.Zone
+	out.TTL = (*int32)(unsafe.Pointer(in.TTL))
+	return nil
+}
+
+// Convert_v1alpha1_BastionDNS_To_config_BastionDNS is an autogenerated conversion function.
+func Convert_v1alpha1_BastionDNS_To_config_BastionDNS(in *BastionDNS, out *config.BastionDNS, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BastionDNS_To_config_BastionDNS(in, out, s)
+}
+
+func autoConvert_config_BastionDNS_To_v1alpha1_BastionDNS(in *config.BastionDNS, out *BastionDNS, s conversion.Scope) error {
+	out.Zone = in.Zone
+	out.TTL = (*int32)(unsafe.Pointer(in.TTL))
+	return nil
+}
+
+// Convert_config_BastionDNS_To_v1alpha1_BastionDNS is an autogenerated conversion function.
+func Convert_config_BastionDNS_To_v1alpha1_BastionDNS(in *config.BastionDNS, out *BastionDNS, s conversion.Scope) error {
+	return autoConvert_config_BastionDNS_To_v1alpha1_BastionDNS(in, out, s)
+}
+
+func autoConvert_v1alpha1_ControlPlaneScheduling_To_config_ControlPlaneScheduling(in *ControlPlaneScheduling, out *config.ControlPlaneScheduling, s conversion.Scope) error {
+	out.PriorityClassName = (*string)(unsafe.Pointer(in.PriorityClassName))
+	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
+	out.TopologySpreadKeys = *(*[]string)(unsafe.Pointer(&in.TopologySpreadKeys))
+	return nil
+}
+
+// Convert_v1alpha1_ControlPlaneScheduling_To_config_ControlPlaneScheduling is an autogenerated conversion function.
+func Convert_v1alpha1_ControlPlaneScheduling_To_config_ControlPlaneScheduling(in *ControlPlaneScheduling, out *config.ControlPlaneScheduling, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ControlPlaneScheduling_To_config_ControlPlaneScheduling(in, out, s)
+}
+
+func autoConvert_config_ControlPlaneScheduling_To_v1alpha1_ControlPlaneScheduling(in *config.ControlPlaneScheduling, out *ControlPlaneScheduling, s conversion.Scope) error {
+	out.PriorityClassName = (*string)(unsafe.Pointer(in.PriorityClassName))
+	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
+	out.TopologySpreadKeys = *(*[]string)(unsafe.Pointer(&in.TopologySpreadKeys))
+	return nil
+}
+
+// Convert_config_ControlPlaneScheduling_To_v1alpha1_ControlPlaneScheduling is an autogenerated conversion function.
+func Convert_config_ControlPlaneScheduling_To_v1alpha1_ControlPlaneScheduling(in *config.ControlPlaneScheduling, out *ControlPlaneScheduling, s conversion.Scope) error {
+	return autoConvert_config_ControlPlaneScheduling_To_v1alpha1_ControlPlaneScheduling(in, out, s)
+}
+
 func autoConvert_v1alpha1_ControllerConfiguration_To_config_ControllerConfiguration(in *ControllerConfiguration, out *config.ControllerConfiguration, s conversion.Scope) error {
 	out.ClientConnection = (*componentbaseconfig.ClientConnectionConfiguration)(unsafe.Pointer(in.ClientConnection))
 	if err := Convert_v1alpha1_ETCD_To_config_ETCD(&in.ETCD, &out.ETCD, s); err != nil {
@@ -123,6 +233,13 @@ func autoConvert_v1alpha1_ControllerConfiguration_To_config_ControllerConfigurat
 	}
 	out.HealthCheckConfig = (*apisconfig.HealthCheckConfig)(unsafe.Pointer(in.HealthCheckConfig))
 	out.BastionConfig = (*config.BastionConfig)(unsafe.Pointer(in.BastionConfig))
+	out.EgressRestriction = (*config.EgressRestriction)(unsafe.Pointer(in.EgressRestriction))
+	out.ControlPlaneScheduling = (*config.ControlPlaneScheduling)(unsafe.Pointer(in.ControlPlaneScheduling))
+	out.QuotaMonitoring = (*config.QuotaMonitoring)(unsafe.Pointer(in.QuotaMonitoring))
+	out.Terraformer = (*config.Terraformer)(unsafe.Pointer(in.Terraformer))
+	out.MachineClassExtraAllowedKeys = *(*[]string)(unsafe.Pointer(&in.MachineClassExtraAllowedKeys))
+	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.ZoneRedundantLoadBalancer = (*config.ZoneRedundantLoadBalancer)(unsafe.Pointer(in.ZoneRedundantLoadBalancer))
 	return nil
 }
 
@@ -138,6 +255,13 @@ func autoConvert_config_ControllerConfiguration_To_v1alpha1_ControllerConfigurat
 	}
 	out.HealthCheckConfig = (*apisconfigv1alpha1.HealthCheckConfig)(unsafe.Pointer(in.HealthCheckConfig))
 	out.BastionConfig = (*BastionConfig)(unsafe.Pointer(in.BastionConfig))
+	out.EgressRestriction = (*EgressRestriction)(unsafe.Pointer(in.EgressRestriction))
+	out.ControlPlaneScheduling = (*ControlPlaneScheduling)(unsafe.Pointer(in.ControlPlaneScheduling))
+	out.QuotaMonitoring = (*QuotaMonitoring)(unsafe.Pointer(in.QuotaMonitoring))
+	out.Terraformer = (*Terraformer)(unsafe.Pointer(in.Terraformer))
+	out.MachineClassExtraAllowedKeys = *(*[]string)(unsafe.Pointer(&in.MachineClassExtraAllowedKeys))
+	out.ZoneRedundantLoadBalancer = (*ZoneRedundantLoadBalancer)(unsafe.Pointer(in.ZoneRedundantLoadBalancer))
+	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
 	return nil
 }
 
@@ -217,3 +341,89 @@ func autoConvert_config_ETCDStorage_To_v1alpha1_ETCDStorage(in *config.ETCDStora
 func Convert_config_ETCDStorage_To_v1alpha1_ETCDStorage(in *config.ETCDStorage, out *ETCDStorage, s conversion.Scope) error {
 	return autoConvert_config_ETCDStorage_To_v1alpha1_ETCDStorage(in, out, s)
 }
+
+func autoConvert_v1alpha1_EgressRestriction_To_config_EgressRestriction(in *EgressRestriction, out *config.EgressRestriction, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.AdditionalCIDRs = *(*[]string)(unsafe.Pointer(&in.AdditionalCIDRs))
+	return nil
+}
+
+// Convert_v1alpha1_EgressRestriction_To_config_EgressRestriction is an autogenerated conversion function.
+func Convert_v1alpha1_EgressRestriction_To_config_EgressRestriction(in *EgressRestriction, out *config.EgressRestriction, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EgressRestriction_To_config_EgressRestriction(in, out, s)
+}
+
+func autoConvert_config_EgressRestriction_To_v1alpha1_EgressRestriction(in *config.EgressRestriction, out *EgressRestriction, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.AdditionalCIDRs = *(*[]string)(unsafe.Pointer(&in.AdditionalCIDRs))
+	return nil
+}
+
+// Convert_config_EgressRestriction_To_v1alpha1_EgressRestriction is an autogenerated conversion function.
+func Convert_config_EgressRestriction_To_v1alpha1_EgressRestriction(in *config.EgressRestriction, out *EgressRestriction, s conversion.Scope) error {
+	return autoConvert_config_EgressRestriction_To_v1alpha1_EgressRestriction(in, out, s)
+}
+
+func autoConvert_v1alpha1_QuotaMonitoring_To_config_QuotaMonitoring(in *QuotaMonitoring, out *config.QuotaMonitoring, s conversion.Scope) error {
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.Thresholds = *(*[]int32)(unsafe.Pointer(&in.Thresholds))
+	return nil
+}
+
+// Convert_v1alpha1_QuotaMonitoring_To_config_QuotaMonitoring is an autogenerated conversion function.
+func Convert_v1alpha1_QuotaMonitoring_To_config_QuotaMonitoring(in *QuotaMonitoring, out *config.QuotaMonitoring, s conversion.Scope) error {
+	return autoConvert_v1alpha1_QuotaMonitoring_To_config_QuotaMonitoring(in, out, s)
+}
+
+func autoConvert_config_QuotaMonitoring_To_v1alpha1_QuotaMonitoring(in *config.QuotaMonitoring, out *QuotaMonitoring, s conversion.Scope) error {
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.Thresholds = *(*[]int32)(unsafe.Pointer(&in.Thresholds))
+	return nil
+}
+
+// Convert_config_QuotaMonitoring_To_v1alpha1_QuotaMonitoring is an autogenerated conversion function.
+func Convert_config_QuotaMonitoring_To_v1alpha1_QuotaMonitoring(in *config.QuotaMonitoring, out *QuotaMonitoring, s conversion.Scope) error {
+	return autoConvert_config_QuotaMonitoring_To_v1alpha1_QuotaMonitoring(in, out, s)
+}
+
+func autoConvert_v1alpha1_Terraformer_To_config_Terraformer(in *Terraformer, out *config.Terraformer, s conversion.Scope) error {
+	out.Parallelism = (*int32)(unsafe.Pointer(in.Parallelism))
+	return nil
+}
+
+// Convert_v1alpha1_Terraformer_To_config_Terraformer is an autogenerated conversion function.
+func Convert_v1alpha1_Terraformer_To_config_Terraformer(in *Terraformer, out *config.Terraformer, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Terraformer_To_config_Terraformer(in, out, s)
+}
+
+func autoConvert_config_Terraformer_To_v1alpha1_Terraformer(in *config.Terraformer, out *Terraformer, s conversion.Scope) error {
+	out.Parallelism = (*int32)(unsafe.Pointer(in.Parallelism))
+	return nil
+}
+
+// Convert_config_Terraformer_To_v1alpha1_Terraformer is an autogenerated conversion function.
+func Convert_config_Terraformer_To_v1alpha1_Terraformer(in *config.Terraformer, out *Terraformer, s conversion.Scope) error {
+	return autoConvert_config_Terraformer_To_v1alpha1_Terraformer(in, out, s)
+}
+
+func autoConvert_v1alpha1_ZoneRedundantLoadBalancer_To_config_ZoneRedundantLoadBalancer(in *ZoneRedundantLoadBalancer, out *config.ZoneRedundantLoadBalancer, s conversion.Scope) error {
+	out.FlavorID = (*string)(unsafe.Pointer(in.FlavorID))
+	out.AvailabilityZone = (*string)(unsafe.Pointer(in.AvailabilityZone))
+	return nil
+}
+
+// Convert_v1alpha1_ZoneRedundantLoadBalancer_To_config_ZoneRedundantLoadBalancer is an autogenerated conversion function.
+func Convert_v1alpha1_ZoneRedundantLoadBalancer_To_config_ZoneRedundantLoadBalancer(in *ZoneRedundantLoadBalancer, out *config.ZoneRedundantLoadBalancer, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ZoneRedundantLoadBalancer_To_config_ZoneRedundantLoadBalancer(in, out, s)
+}
+
+func autoConvert_config_ZoneRedundantLoadBalancer_To_v1alpha1_ZoneRedundantLoadBalancer(in *config.ZoneRedundantLoadBalancer, out *ZoneRedundantLoadBalancer, s conversion.Scope) error {
+	out.FlavorID = (*string)(unsafe.Pointer(in.FlavorID))
+	out.AvailabilityZone = (*string)(unsafe.Pointer(in.AvailabilityZone))
+	return nil
+}
+
+// Convert_config_ZoneRedundantLoadBalancer_To_v1alpha1_ZoneRedundantLoadBalancer is an autogenerated conversion function.
+func Convert_config_ZoneRedundantLoadBalancer_To_v1alpha1_ZoneRedundantLoadBalancer(in *config.ZoneRedundantLoadBalancer, out *ZoneRedundantLoadBalancer, s conversion.Scope) error {
+	return autoConvert_config_ZoneRedundantLoadBalancer_To_v1alpha1_ZoneRedundantLoadBalancer(in, out, s)
+}
@@ -29,5 +29,17 @@ import (
 // Public to allow building arbitrary schemes.
 // All generated defaulters are covering - they call all nested defaulters.
 func RegisterDefaults(scheme *runtime.Scheme) error {
+	scheme.AddTypeDefaultingFunc(&ControllerConfiguration{}, func(obj interface{}) { SetObjectDefaults_ControllerConfiguration(obj.(*ControllerConfiguration)) })
 	return nil
 }
+
+func SetObjectDefaults_ControllerConfiguration(in *ControllerConfiguration) {
+	if in.BastionConfig != nil {
+		if in.BastionConfig.DNS != nil {
+			SetDefaults_BastionDNS(in.BastionConfig.DNS)
+		}
+	}
+	if in.QuotaMonitoring != nil {
+		SetDefaults_QuotaMonitoring(in.QuotaMonitoring)
+	}
+}
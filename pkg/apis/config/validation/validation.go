@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
+
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/config"
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/features"
+)
+
+// ValidateControllerConfiguration validates a ControllerConfiguration object.
+func ValidateControllerConfiguration(cfg *config.ControllerConfiguration) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validateFeatureGates(cfg.FeatureGates, field.NewPath("featureGates"))...)
+	allErrs = append(allErrs, validateMachineClassExtraAllowedKeys(cfg.MachineClassExtraAllowedKeys, field.NewPath("machineClassExtraAllowedKeys"))...)
+	if cfg.BastionConfig != nil {
+		allErrs = append(allErrs, validateBastionDNS(cfg.BastionConfig.DNS, field.NewPath("bastionConfig", "dns"))...)
+	}
+	if cfg.Terraformer != nil && cfg.Terraformer.Parallelism != nil && *cfg.Terraformer.Parallelism <= 0 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("terraformer", "parallelism"), *cfg.Terraformer.Parallelism, "parallelism must be positive"))
+	}
+	if lb := cfg.ZoneRedundantLoadBalancer; lb != nil && pointer.StringDeref(lb.FlavorID, "") == "" && pointer.StringDeref(lb.AvailabilityZone, "") == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("zoneRedundantLoadBalancer"), "flavorID or availabilityZone must be set"))
+	}
+
+	return allErrs
+}
+
+// validateFeatureGates validates that the given feature gates are known and not locked to another value by applying
+// them to a copy of the feature gate of the extension.
+func validateFeatureGates(featureGates map[string]bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for name, enabled := range featureGates {
+		if err := features.ExtensionFeatureGate.DeepCopy().SetFromMap(map[string]bool{name: enabled}); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(name), enabled, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+// validateMachineClassExtraAllowedKeys validates that the allowed keys of the MachineClassExtra of the WorkerConfig are
+// not empty and not set by the extension itself.
+func validateMachineClassExtraAllowedKeys(keys []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	reserved := sets.New(api.ReservedMachineClassExtraKeys...)
+	for i, key := range keys {
+		switch {
+		case key == "":
+			allErrs = append(allErrs, field.Required(fldPath.Index(i), "key must not be empty"))
+		case reserved.Has(key):
+			allErrs = append(allErrs, field.Forbidden(fldPath.Index(i), "key is set by the extension"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateBastionDNS validates that the DNS records of the bastion hosts are registered in a zone with a positive TTL.
+func validateBastionDNS(dns *config.BastionDNS, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if dns == nil {
+		return allErrs
+	}
+	if dns.Zone == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("zone"), "zone must not be empty"))
+	}
+	if dns.TTL != nil && *dns.TTL <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("ttl"), *dns.TTL, "ttl must be positive"))
+	}
+
+	return allErrs
+}
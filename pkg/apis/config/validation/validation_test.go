@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
+
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/config"
+	. "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/config/validation"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/features"
+)
+
+var _ = Describe("ControllerConfiguration validation", func() {
+	var cfg *config.ControllerConfiguration
+
+	BeforeEach(func() {
+		cfg = &config.ControllerConfiguration{}
+	})
+
+	It("should allow an empty configuration", func() {
+		Expect(ValidateControllerConfiguration(cfg)).To(BeEmpty())
+	})
+
+	It("should allow known feature gates", func() {
+		cfg.FeatureGates = map[string]bool{string(features.InfrastructureFlow): true}
+
+		Expect(ValidateControllerConfiguration(cfg)).To(BeEmpty())
+	})
+
+	It("should forbid unknown feature gates", func() {
+		cfg.FeatureGates = map[string]bool{
+			string(features.InfrastructureFlow): false,
+			"Foo":                               true,
+		}
+
+		Expect(ValidateControllerConfiguration(cfg)).To(ConsistOfFields(Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("featureGates[Foo]"),
+		}))
+	})
+
+	It("should not change the feature gate of the extension", func() {
+		cfg.FeatureGates = map[string]bool{string(features.InfrastructureFlow): true}
+
+		Expect(ValidateControllerConfiguration(cfg)).To(BeEmpty())
+		Expect(features.ExtensionFeatureGate.Enabled(features.InfrastructureFlow)).To(BeFalse())
+	})
+
+	It("should allow keys of the machine class extra values which are not set by the extension", func() {
+		cfg.MachineClassExtraAllowedKeys = []string{"bootFromVolume", "serverGroupPolicy"}
+
+		Expect(ValidateControllerConfiguration(cfg)).To(BeEmpty())
+	})
+
+	It("should forbid empty keys and keys of the machine class extra values which are set by the extension", func() {
+		cfg.MachineClassExtraAllowedKeys = []string{"bootFromVolume", "", "imageID"}
+
+		Expect(ValidateControllerConfiguration(cfg)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("machineClassExtraAllowedKeys[1]"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("machineClassExtraAllowedKeys[2]"),
+			})),
+		))
+	})
+	It("should allow a positive parallelism of the Terraformer", func() {
+		cfg.Terraformer = &config.Terraformer{Parallelism: pointer.Int32(20)}
+
+		Expect(ValidateControllerConfiguration(cfg)).To(BeEmpty())
+	})
+
+	It("should forbid a non-positive parallelism of the Terraformer", func() {
+		cfg.Terraformer = &config.Terraformer{Parallelism: pointer.Int32(0)}
+
+		Expect(ValidateControllerConfiguration(cfg)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("terraformer.parallelism"),
+			})),
+		))
+	})
+
+	It("should allow a DNS zone for the bastion hosts", func() {
+		cfg.BastionConfig = &config.BastionConfig{DNS: &config.BastionDNS{Zone: "bastion.example.com", TTL: pointer.Int32(60)}}
+
+		Expect(ValidateControllerConfiguration(cfg)).To(BeEmpty())
+	})
+
+	It("should forbid an empty DNS zone and a non-positive TTL for the bastion hosts", func() {
+		cfg.BastionConfig = &config.BastionConfig{DNS: &config.BastionDNS{TTL: pointer.Int32(0)}}
+
+		Expect(ValidateControllerConfiguration(cfg)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("bastionConfig.dns.zone"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("bastionConfig.dns.ttl"),
+			})),
+		))
+	})
+
+	It("should allow a zone-redundant load balancer with an Octavia flavor", func() {
+		cfg.ZoneRedundantLoadBalancer = &config.ZoneRedundantLoadBalancer{FlavorID: pointer.String("active-standby")}
+
+		Expect(ValidateControllerConfiguration(cfg)).To(BeEmpty())
+	})
+
+	It("should forbid a zone-redundant load balancer without flavor and availability zone", func() {
+		cfg.ZoneRedundantLoadBalancer = &config.ZoneRedundantLoadBalancer{}
+
+		Expect(ValidateControllerConfiguration(cfg)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("zoneRedundantLoadBalancer"),
+			})),
+		))
+	})
+})
@@ -23,13 +23,20 @@ package config
 
 import (
 	apisconfig "github.com/gardener/gardener/extensions/pkg/apis/config"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 	componentbaseconfig "k8s.io/component-base/config"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BastionConfig) DeepCopyInto(out *BastionConfig) {
 	*out = *in
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(BastionDNS)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -43,6 +50,58 @@ func (in *BastionConfig) DeepCopy() *BastionConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BastionDNS) DeepCopyInto(out *BastionDNS) {
+	*out = *in
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BastionDNS.
+func (in *BastionDNS) DeepCopy() *BastionDNS {
+	if in == nil {
+		return nil
+	}
+	out := new(BastionDNS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneScheduling) DeepCopyInto(out *ControlPlaneScheduling) {
+	*out = *in
+	if in.PriorityClassName != nil {
+		in, out := &in.PriorityClassName, &out.PriorityClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.TopologySpreadKeys != nil {
+		in, out := &in.TopologySpreadKeys, &out.TopologySpreadKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneScheduling.
+func (in *ControlPlaneScheduling) DeepCopy() *ControlPlaneScheduling {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneScheduling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 	*out = *in
@@ -61,7 +120,44 @@ func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 	if in.BastionConfig != nil {
 		in, out := &in.BastionConfig, &out.BastionConfig
 		*out = new(BastionConfig)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EgressRestriction != nil {
+		in, out := &in.EgressRestriction, &out.EgressRestriction
+		*out = new(EgressRestriction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ControlPlaneScheduling != nil {
+		in, out := &in.ControlPlaneScheduling, &out.ControlPlaneScheduling
+		*out = new(ControlPlaneScheduling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.QuotaMonitoring != nil {
+		in, out := &in.QuotaMonitoring, &out.QuotaMonitoring
+		*out = new(QuotaMonitoring)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Terraformer != nil {
+		in, out := &in.Terraformer, &out.Terraformer
+		*out = new(Terraformer)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MachineClassExtraAllowedKeys != nil {
+		in, out := &in.MachineClassExtraAllowedKeys, &out.MachineClassExtraAllowedKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ZoneRedundantLoadBalancer != nil {
+		in, out := &in.ZoneRedundantLoadBalancer, &out.ZoneRedundantLoadBalancer
+		*out = new(ZoneRedundantLoadBalancer)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FeatureGates != nil {
+		in, out := &in.FeatureGates, &out.FeatureGates
+		*out = make(map[string]bool, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
 	return
 }
@@ -148,3 +244,97 @@ func (in *ETCDStorage) DeepCopy() *ETCDStorage {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressRestriction) DeepCopyInto(out *EgressRestriction) {
+	*out = *in
+	if in.AdditionalCIDRs != nil {
+		in, out := &in.AdditionalCIDRs, &out.AdditionalCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressRestriction.
+func (in *EgressRestriction) DeepCopy() *EgressRestriction {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressRestriction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaMonitoring) DeepCopyInto(out *QuotaMonitoring) {
+	*out = *in
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Thresholds != nil {
+		in, out := &in.Thresholds, &out.Thresholds
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaMonitoring.
+func (in *QuotaMonitoring) DeepCopy() *QuotaMonitoring {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaMonitoring)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Terraformer) DeepCopyInto(out *Terraformer) {
+	*out = *in
+	if in.Parallelism != nil {
+		in, out := &in.Parallelism, &out.Parallelism
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Terraformer.
+func (in *Terraformer) DeepCopy() *Terraformer {
+	if in == nil {
+		return nil
+	}
+	out := new(Terraformer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneRedundantLoadBalancer) DeepCopyInto(out *ZoneRedundantLoadBalancer) {
+	*out = *in
+	if in.FlavorID != nil {
+		in, out := &in.FlavorID, &out.FlavorID
+		*out = new(string)
+		**out = **in
+	}
+	if in.AvailabilityZone != nil {
+		in, out := &in.AvailabilityZone, &out.AvailabilityZone
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneRedundantLoadBalancer.
+func (in *ZoneRedundantLoadBalancer) DeepCopy() *ZoneRedundantLoadBalancer {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneRedundantLoadBalancer)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -8,24 +8,40 @@ import (
 	"fmt"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/utils/pointer"
 
 	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/utils"
 )
 
 // FindSubnetByPurpose takes a list of subnets and tries to find the first entry
-// whose purpose matches with the given purpose. If no such entry is found then an error will be
-// returned.
+// whose purpose matches with the given purpose. Named node subnets are skipped. If no such entry is found then an error
+// will be returned.
 func FindSubnetByPurpose(subnets []api.Subnet, purpose api.Purpose) (*api.Subnet, error) {
 	for _, subnet := range subnets {
-		if subnet.Purpose == purpose {
+		if subnet.Purpose == purpose && subnet.Name == nil {
 			return &subnet, nil
 		}
 	}
 	return nil, fmt.Errorf("cannot find subnet with purpose %q", purpose)
 }
 
+// FindNodeSubnet takes a list of subnets and tries to find the node subnet with the given name. If the name is nil,
+// the subnet given by the workers CIDR is returned. If no such entry is found then an error will be returned.
+func FindNodeSubnet(subnets []api.Subnet, name *string) (*api.Subnet, error) {
+	if name == nil {
+		return FindSubnetByPurpose(subnets, api.PurposeNodes)
+	}
+	for _, subnet := range subnets {
+		if subnet.Purpose == api.PurposeNodes && subnet.Name != nil && *subnet.Name == *name {
+			return &subnet, nil
+		}
+	}
+	return nil, fmt.Errorf("cannot find node subnet with name %q", *name)
+}
+
 // FindSecurityGroupByPurpose takes a list of security groups and tries to find the first entry
 // whose purpose matches with the given purpose. If no such entry is found then an error will be
 // returned.
@@ -72,6 +88,7 @@ func FindImageFromCloudProfile(cloudProfileConfig *api.CloudProfileConfig, image
 							Version:      imageVersion,
 							Architecture: &architecture,
 							ID:           region.ID,
+							Capabilities: version.Capabilities,
 						}, nil
 					}
 				}
@@ -88,6 +105,7 @@ func FindImageFromCloudProfile(cloudProfileConfig *api.CloudProfileConfig, image
 						Version:      imageVersion,
 						Architecture: pointer.String(v1beta1constants.ArchitectureAMD64),
 						Image:        version.Image,
+						Capabilities: version.Capabilities,
 					}, nil
 				}
 			}
@@ -127,6 +145,47 @@ func FindKeyStoneCACert(keyStoneURLs []api.KeyStoneURL, keystoneCABundle *string
 	return keystoneCABundle
 }
 
+// FindEndpointOverrides returns the endpoints of the given overrides which apply to the given region, mapped by the
+// type of their service. Overrides for the region take precedence over the ones without a region.
+func FindEndpointOverrides(overrides []api.EndpointOverride, region string) map[string]string {
+	var result map[string]string
+	for _, regional := range []bool{false, true} {
+		for _, override := range overrides {
+			if (override.Region != nil) != regional || (regional && *override.Region != region) {
+				continue
+			}
+			if result == nil {
+				result = map[string]string{}
+			}
+			result[override.Service] = override.URL
+		}
+	}
+	return result
+}
+
+// FindRegionConfig returns the config of the given region, or nil if the cloud profile has none for it.
+func FindRegionConfig(regions []api.RegionConfig, region string) *api.RegionConfig {
+	for i := range regions {
+		if regions[i].Name == region {
+			return &regions[i]
+		}
+	}
+	return nil
+}
+
+// AllowedFlavorFamilies returns the flavor families which may be used for worker pools in the given region, including
+// the ones of its reserved aggregates. An empty result means that all flavors may be used.
+func AllowedFlavorFamilies(regionConfig *api.RegionConfig) []string {
+	if regionConfig == nil {
+		return nil
+	}
+	families := append([]string{}, regionConfig.FlavorFamilies...)
+	for _, aggregate := range regionConfig.ReservedAggregates {
+		families = append(families, aggregate.FlavorFamilies...)
+	}
+	return families
+}
+
 // FindFloatingPool receives a list of floating pools and tries to find the best
 // match for a given `floatingPoolNamePattern` considering constraints like
 // `region` and `domain`. If no matching floating pool was found then an error will be returned.
@@ -174,6 +233,30 @@ func FindFloatingPool(floatingPools []api.FloatingPool, floatingPoolNamePattern,
 	return nil, fmt.Errorf("cannot find a matching floating pool for pattern %q", floatingPoolNamePattern)
 }
 
+// IsCinderStorageClass returns true if the volumes of the given storage class are provisioned by the Cinder CSI driver.
+func IsCinderStorageClass(storageClass api.StorageClassDefinition) bool {
+	return storageClass.Provisioner == nil || *storageClass.Provisioner == "" || *storageClass.Provisioner == openstack.CSIStorageProvisioner
+}
+
+// StorageClassAvailabilityZone returns the availability zone the volumes of the given storage class are pinned to via the
+// `availability` parameter of the Cinder CSI driver. An empty string is returned if the volumes are not pinned.
+func StorageClassAvailabilityZone(storageClass api.StorageClassDefinition) string {
+	if !IsCinderStorageClass(storageClass) {
+		return ""
+	}
+	return storageClass.Parameters[openstack.CSIStorageAvailabilityParameter]
+}
+
+// BackupCredentialsSecretRef returns the reference to the secret with the credentials the Swift container of a backup
+// bucket is accessed with. It is the given secret of the BackupBucket or BackupEntry unless the config of the backup
+// bucket refers to the credentials of a dedicated project, which live in the same namespace.
+func BackupCredentialsSecretRef(config *api.BackupBucketConfig, secretRef corev1.SecretReference) corev1.SecretReference {
+	if config == nil || config.CredentialsSecretName == nil {
+		return secretRef
+	}
+	return corev1.SecretReference{Name: *config.CredentialsSecretName, Namespace: secretRef.Namespace}
+}
+
 func checkFloatingPoolCandidate(floatingPool *api.FloatingPool, floatingPoolNamePattern, region string, domain *string) (*api.FloatingPool, int) {
 	// If the domain should be considered then only floating pools
 	// in the same domain will be considered.
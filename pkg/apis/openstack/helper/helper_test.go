@@ -7,6 +7,7 @@ package helper_test
 import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/utils/pointer"
 
 	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
@@ -29,6 +30,19 @@ var _ = Describe("Helper", func() {
 		Entry("empty list", []api.Subnet{}, purpose, nil, true),
 		Entry("entry not found", []api.Subnet{{ID: "bar", Purpose: purposeWrong}}, purpose, nil, true),
 		Entry("entry exists", []api.Subnet{{ID: "bar", Purpose: purpose}}, purpose, &api.Subnet{ID: "bar", Purpose: purpose}, false),
+		Entry("named entry is skipped", []api.Subnet{{ID: "foo", Purpose: purpose, Name: pointer.String("zone-a")}, {ID: "bar", Purpose: purpose}}, purpose, &api.Subnet{ID: "bar", Purpose: purpose}, false),
+	)
+
+	DescribeTable("#FindNodeSubnet",
+		func(subnets []api.Subnet, name *string, expectedSubnet *api.Subnet, expectErr bool) {
+			subnet, err := FindNodeSubnet(subnets, name)
+			expectResults(subnet, expectedSubnet, err, expectErr)
+		},
+
+		Entry("list is nil", nil, pointer.String("zone-a"), nil, true),
+		Entry("entry not found", []api.Subnet{{ID: "bar", Purpose: api.PurposeNodes}}, pointer.String("zone-a"), nil, true),
+		Entry("unnamed entry", []api.Subnet{{ID: "foo", Purpose: api.PurposeNodes, Name: pointer.String("zone-a")}, {ID: "bar", Purpose: api.PurposeNodes}}, nil, &api.Subnet{ID: "bar", Purpose: api.PurposeNodes}, false),
+		Entry("named entry", []api.Subnet{{ID: "bar", Purpose: api.PurposeNodes}, {ID: "foo", Purpose: api.PurposeNodes, Name: pointer.String("zone-a")}}, pointer.String("zone-a"), &api.Subnet{ID: "foo", Purpose: api.PurposeNodes, Name: pointer.String("zone-a")}, false),
 	)
 
 	DescribeTable("#FindSecurityGroupByPurpose",
@@ -225,6 +239,14 @@ var _ = Describe("Helper", func() {
 				}))
 			})
 
+			It("should return the capabilities of the version", func() {
+				cfg.MachineImages[0].Versions[1].Capabilities = []api.MachineImageCapability{api.MachineImageCapabilityPrebaked}
+
+				image, err := FindImageFromCloudProfile(cfg, "flatcar", "2.0", "eu01", "amd64")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(image.Capabilities).To(ConsistOf(api.MachineImageCapabilityPrebaked))
+			})
+
 			It("should not find image because of non-amd64 architecture", func() {
 				image, err := FindImageFromCloudProfile(cfg, "flatcar", "2.0", "eu01", "arm64")
 				Expect(image).To(BeNil())
@@ -272,6 +294,36 @@ var _ = Describe("Helper", func() {
 		Entry("no default URL", []api.KeyStoneURL{{URL: "bar", Region: "europe"}}, "", "asia", "", true),
 	)
 
+	DescribeTable("#FindEndpointOverrides",
+		func(overrides []api.EndpointOverride, region string, expected map[string]string) {
+			Expect(FindEndpointOverrides(overrides, region)).To(Equal(expected))
+		},
+
+		Entry("list is nil", nil, "europe", nil),
+		Entry("global override", []api.EndpointOverride{{Service: "volumev3", URL: "https://cinder"}}, "europe",
+			map[string]string{"volumev3": "https://cinder"}),
+		Entry("override of another region", []api.EndpointOverride{{Region: pointer.String("asia"), Service: "volumev3", URL: "https://cinder"}}, "europe", nil),
+		Entry("regional override takes precedence", []api.EndpointOverride{
+			{Region: pointer.String("europe"), Service: "volumev3", URL: "https://cinder.europe"},
+			{Service: "volumev3", URL: "https://cinder"},
+			{Service: "load-balancer", URL: "https://octavia"},
+			{Region: pointer.String("asia"), Service: "load-balancer", URL: "https://octavia.asia"},
+		}, "europe", map[string]string{"volumev3": "https://cinder.europe", "load-balancer": "https://octavia"}),
+	)
+
+	DescribeTable("#AllowedFlavorFamilies",
+		func(regions []api.RegionConfig, region string, expected []string) {
+			Expect(AllowedFlavorFamilies(FindRegionConfig(regions, region))).To(Equal(expected))
+		},
+
+		Entry("list is nil", nil, "europe", nil),
+		Entry("region without config", []api.RegionConfig{{Name: "asia", FlavorFamilies: []string{"g_c"}}}, "europe", nil),
+		Entry("families of the region and its reserved aggregates", []api.RegionConfig{
+			{Name: "asia", FlavorFamilies: []string{"m1"}},
+			{Name: "europe", FlavorFamilies: []string{"g_c"}, ReservedAggregates: []api.ReservedAggregate{{Name: "hana", FlavorFamilies: []string{"hana_"}}}},
+		}, "europe", []string{"g_c", "hana_"}),
+	)
+
 	DescribeTable("#FindFloatingPool",
 		func(floatingPools []api.FloatingPool, floatingPoolNamePattern, region string, domain, expectedFloatingPoolName *string) {
 			result, err := FindFloatingPool(floatingPools, floatingPoolNamePattern, region, domain)
@@ -290,6 +342,15 @@ var _ = Describe("Helper", func() {
 		Entry("return fip even if there is a non-constraing fip with better score", []api.FloatingPool{{Name: "fip-*", Region: &regionName}, {Name: "fip-1", Region: &regionName, NonConstraining: pointer.Bool(true)}}, "fip-1", regionName, nil, pointer.String("fip-*")),
 		Entry("return non-constraing fip as there is no other matching fip", []api.FloatingPool{{Name: "nofip-1", Region: &regionName}, {Name: "fip-1", Region: &regionName, NonConstraining: pointer.Bool(true)}}, "fip-1", regionName, nil, pointer.String("fip-1")),
 	)
+	DescribeTable("#BackupCredentialsSecretRef",
+		func(config *api.BackupBucketConfig, expected corev1.SecretReference) {
+			Expect(BackupCredentialsSecretRef(config, corev1.SecretReference{Name: "bucket", Namespace: "garden"})).To(Equal(expected))
+		},
+
+		Entry("secret of the bucket without config", nil, corev1.SecretReference{Name: "bucket", Namespace: "garden"}),
+		Entry("secret of the bucket without dedicated credentials", &api.BackupBucketConfig{}, corev1.SecretReference{Name: "bucket", Namespace: "garden"}),
+		Entry("secret with the dedicated credentials", &api.BackupBucketConfig{CredentialsSecretName: pointer.String("backup-project")}, corev1.SecretReference{Name: "backup-project", Namespace: "garden"}),
+	)
 })
 
 func expectResults(result, expected interface{}, err error, expectErr bool) {
@@ -57,16 +57,45 @@ func InfrastructureConfigFromRawExtension(providerConfig *runtime.RawExtension)
 // InfrastructureStatusFromRaw extracts the InfrastructureStatus from the
 // ProviderStatus section of the given Infrastructure.
 func InfrastructureStatusFromRaw(raw *runtime.RawExtension) (*api.InfrastructureStatus, error) {
-	config := &api.InfrastructureStatus{}
+	status := &api.InfrastructureStatus{}
 	if raw != nil && raw.Raw != nil {
-		if _, _, err := lenientDecoder.Decode(raw.Raw, nil, config); err != nil {
+		if err := decodeProviderStatus(raw.Raw, status); err != nil {
 			return nil, err
 		}
-		return config, nil
+		return status, nil
 	}
 	return nil, fmt.Errorf("provider status is not set on the infrastructure resource")
 }
 
+// WorkerStatusFromRaw extracts the WorkerStatus from the ProviderStatus section of the given Worker.
+// An empty WorkerStatus is returned if no provider status has been written yet.
+func WorkerStatusFromRaw(raw *runtime.RawExtension) (*api.WorkerStatus, error) {
+	status := &api.WorkerStatus{}
+	if raw == nil {
+		return status, nil
+	}
+
+	marshalled, err := raw.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	if len(marshalled) == 0 || string(marshalled) == "null" {
+		return status, nil
+	}
+	if err := decodeProviderStatus(marshalled, status); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// decodeProviderStatus decodes a provider status into the given internal object. Provider status is written by
+// the extension itself and may originate from much older extension versions which still had fields that have been
+// removed since. Hence, it is decoded leniently and unknown fields are dropped instead of failing the reconciliation.
+func decodeProviderStatus(data []byte, into runtime.Object) error {
+	_, _, err := lenientDecoder.Decode(data, nil, into)
+	return err
+}
+
 // CloudProfileConfigFromCluster decodes the provider specific cloud profile configuration for a cluster
 func CloudProfileConfigFromCluster(cluster *controller.Cluster) (*api.CloudProfileConfig, error) {
 	var cloudProfileConfig *api.CloudProfileConfig
@@ -79,6 +108,17 @@ func CloudProfileConfigFromCluster(cluster *controller.Cluster) (*api.CloudProfi
 	return cloudProfileConfig, nil
 }
 
+// ControlPlaneConfigFromCluster decodes the provider specific control plane configuration of the shoot of a cluster.
+func ControlPlaneConfigFromCluster(cluster *controller.Cluster) (*api.ControlPlaneConfig, error) {
+	cpConfig := &api.ControlPlaneConfig{}
+	if cluster != nil && cluster.Shoot != nil && cluster.Shoot.Spec.Provider.ControlPlaneConfig != nil && cluster.Shoot.Spec.Provider.ControlPlaneConfig.Raw != nil {
+		if _, _, err := decoder.Decode(cluster.Shoot.Spec.Provider.ControlPlaneConfig.Raw, nil, cpConfig); err != nil {
+			return nil, fmt.Errorf("could not decode controlPlaneConfig of shoot '%s': %w", kutil.ObjectName(cluster.Shoot), err)
+		}
+	}
+	return cpConfig, nil
+}
+
 // WorkerConfigFromRawExtension extracts the provider specific configuration for a worker pool.
 func WorkerConfigFromRawExtension(raw *runtime.RawExtension) (*api.WorkerConfig, error) {
 	poolConfig := &api.WorkerConfig{}
@@ -96,3 +136,14 @@ func WorkerConfigFromRawExtension(raw *runtime.RawExtension) (*api.WorkerConfig,
 
 	return poolConfig, nil
 }
+
+// BackupBucketConfigFromRawExtension extracts the provider specific configuration of a backup bucket or entry.
+func BackupBucketConfigFromRawExtension(raw *runtime.RawExtension) (*api.BackupBucketConfig, error) {
+	config := &api.BackupBucketConfig{}
+	if raw != nil && raw.Raw != nil {
+		if _, _, err := decoder.Decode(raw.Raw, nil, config); err != nil {
+			return nil, fmt.Errorf("could not decode backup bucket config: %w", err)
+		}
+	}
+	return config, nil
+}
@@ -0,0 +1,167 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper_test
+
+import (
+	"github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	. "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/helper"
+)
+
+var _ = Describe("Scheme", func() {
+	Describe("#InfrastructureConfigFromRawExtension", func() {
+		It("should reject unknown fields", func() {
+			_, err := InfrastructureConfigFromRawExtension(&runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "openstack.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "InfrastructureConfig",
+"floatingPooName": "fip",
+"networks": {"workers": "10.250.0.0/16"}
+}`)})
+			Expect(runtime.IsStrictDecodingError(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring(`unknown field "floatingPooName"`)))
+		})
+	})
+
+	Describe("#ControlPlaneConfigFromCluster", func() {
+		It("should decode the control plane config of the shoot", func() {
+			cpConfig, err := ControlPlaneConfigFromCluster(&controller.Cluster{Shoot: &gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{Provider: gardencorev1beta1.Provider{ControlPlaneConfig: &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "openstack.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "ControlPlaneConfig",
+"loadBalancerProvider": "amphora",
+"exposure": {"zoneRedundant": true}
+}`)}}},
+			}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cpConfig.LoadBalancerProvider).To(Equal("amphora"))
+			Expect(cpConfig.Exposure).To(Equal(&api.ExposureConfig{ZoneRedundant: true}))
+		})
+
+		It("should return an empty control plane config if the shoot has none", func() {
+			cpConfig, err := ControlPlaneConfigFromCluster(&controller.Cluster{Shoot: &gardencorev1beta1.Shoot{}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cpConfig).To(Equal(&api.ControlPlaneConfig{}))
+		})
+
+		It("should reject unknown fields", func() {
+			_, err := ControlPlaneConfigFromCluster(&controller.Cluster{Shoot: &gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{Provider: gardencorev1beta1.Provider{ControlPlaneConfig: &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "openstack.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "ControlPlaneConfig",
+"loadBalancerProvder": "amphora"
+}`)}}},
+			}})
+			Expect(err).To(MatchError(ContainSubstring(`unknown field "loadBalancerProvder"`)))
+		})
+	})
+
+	Describe("#CloudProfileConfigFromCluster", func() {
+		It("should reject unknown fields", func() {
+			_, err := CloudProfileConfigFromCluster(&controller.Cluster{CloudProfile: &gardencorev1beta1.CloudProfile{
+				Spec: gardencorev1beta1.CloudProfileSpec{ProviderConfig: &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "openstack.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "CloudProfileConfig",
+"keystoneUrl": "https://keystone.example.com"
+}`)}},
+			}})
+			Expect(err).To(MatchError(ContainSubstring(`unknown field "keystoneUrl"`)))
+		})
+	})
+
+	Describe("#WorkerConfigFromRawExtension", func() {
+		It("should reject unknown nested fields", func() {
+			_, err := WorkerConfigFromRawExtension(&runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "openstack.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "WorkerConfig",
+"serverGroup": {"polcy": "soft-anti-affinity"}
+}`)})
+			Expect(runtime.IsStrictDecodingError(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring(`unknown field "serverGroup.polcy"`)))
+		})
+	})
+
+	Describe("#InfrastructureStatusFromRaw", func() {
+		It("should fail if no provider status is set", func() {
+			_, err := InfrastructureStatusFromRaw(nil)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should drop fields written by older extension versions", func() {
+			status, err := InfrastructureStatusFromRaw(&runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "openstack.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "InfrastructureStatus",
+"networks": {"id": "net", "legacyField": "foo", "subnets": [{"purpose": "nodes", "id": "subnet"}]},
+"removedField": {"id": "bar"}
+}`)})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.Networks.ID).To(Equal("net"))
+			Expect(status.Networks.Subnets).To(ConsistOf(api.Subnet{Purpose: api.PurposeNodes, ID: "subnet"}))
+		})
+
+		It("should assign subnets and security groups recorded without purpose to the nodes", func() {
+			status, err := InfrastructureStatusFromRaw(&runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "openstack.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "InfrastructureStatus",
+"networks": {"id": "net", "subnets": [{"id": "subnet"}]},
+"securityGroups": [{"id": "sg", "name": "shoot--foo--bar"}]
+}`)})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.Networks.Subnets).To(ConsistOf(api.Subnet{Purpose: api.PurposeNodes, ID: "subnet"}))
+			Expect(status.SecurityGroups).To(ConsistOf(api.SecurityGroup{Purpose: api.PurposeNodes, ID: "sg", Name: "shoot--foo--bar"}))
+		})
+
+		It("should keep the purposes of subnets and security groups", func() {
+			status, err := InfrastructureStatusFromRaw(&runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "openstack.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "InfrastructureStatus",
+"networks": {"id": "net", "subnets": [{"purpose": "foo", "id": "subnet"}]},
+"securityGroups": [{"purpose": "foo", "id": "sg", "name": "shoot--foo--bar"}]
+}`)})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.Networks.Subnets).To(ConsistOf(api.Subnet{Purpose: "foo", ID: "subnet"}))
+			Expect(status.SecurityGroups).To(ConsistOf(api.SecurityGroup{Purpose: "foo", ID: "sg", Name: "shoot--foo--bar"}))
+		})
+	})
+
+	Describe("#WorkerStatusFromRaw", func() {
+		It("should return an empty status if no provider status is set", func() {
+			status, err := WorkerStatusFromRaw(nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status).To(Equal(&api.WorkerStatus{}))
+		})
+
+		It("should drop fields and complete machine images written by older extension versions", func() {
+			status, err := WorkerStatusFromRaw(&runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "openstack.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "WorkerStatus",
+"machineImages": [{"name": "foo", "version": "1.0", "image": "foo-1.0", "legacyField": true}],
+"removedField": "bar"
+}`)})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.MachineImages).To(ConsistOf(api.MachineImage{Name: "foo", Version: "1.0", Image: "foo-1.0", Architecture: pointer.String("amd64")}))
+		})
+
+		It("should keep the architecture of machine images", func() {
+			status, err := WorkerStatusFromRaw(&runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "openstack.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "WorkerStatus",
+"machineImages": [{"name": "foo", "version": "1.0", "id": "image-id", "architecture": "arm64"}]
+}`)})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.MachineImages).To(ConsistOf(api.MachineImage{Name: "foo", Version: "1.0", ID: "image-id", Architecture: pointer.String("arm64")}))
+		})
+
+		It("should fail for invalid data", func() {
+			_, err := WorkerStatusFromRaw(&runtime.RawExtension{Raw: []byte(`{"kind": 1}`)})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -7,6 +7,7 @@ package openstack
 import (
 	"fmt"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/utils"
@@ -33,6 +34,9 @@ type CloudProfileConfig struct {
 	KeyStoneForceInsecure bool
 	// KeyStoneURLs is a region-URL mapping for auth{n,z} in OpenStack (pointing to KeyStone).
 	KeyStoneURLs []KeyStoneURL
+	// EndpointOverrides override the endpoints of OpenStack services in the service catalog of Keystone, e.g. in clouds
+	// with broken or split-horizon catalogs.
+	EndpointOverrides []EndpointOverride
 	// MachineImages is the list of machine images that are understood by the controller. It maps
 	// logical names and versions to provider-specific identifiers.
 	MachineImages []MachineImages
@@ -57,6 +61,17 @@ type CloudProfileConfig struct {
 	// StorageClasses defines storageclasses for the shoot
 	// +optional
 	StorageClasses []StorageClassDefinition
+	// Regions contains provider-specific metadata of the regions of the cloud profile, e.g. the flavors which may be
+	// used for worker pools in a region.
+	Regions []RegionConfig
+	// Pricing is the pricing model of the cloud, which is used to annotate shoots with an estimation of the monthly cost
+	// of their worker pools and volumes.
+	Pricing *Pricing
+	// Bastion contains the configuration of the bastion hosts of the shoots.
+	Bastion *BastionConfig
+	// GPU configures how the GPUs of the flavors are added to the node templates of the worker pools, which allows the
+	// cluster-autoscaler to scale worker pools with GPUs from zero.
+	GPU *GPUConfig
 }
 
 // Constraints is an object containing constraints for the shoots.
@@ -65,6 +80,21 @@ type Constraints struct {
 	FloatingPools []FloatingPool
 	// LoadBalancerProviders contains constraints regarding allowed values of the 'loadBalancerProvider' block in the control plane config.
 	LoadBalancerProviders []LoadBalancerProvider
+	// LoadBalancerClassPolicies restrict the shoots which may use the load balancer classes of the floating pools.
+	LoadBalancerClassPolicies []LoadBalancerClassPolicy
+}
+
+// LoadBalancerClassPolicy restricts the shoots which may use load balancer classes of the floating pools. A load
+// balancer class referenced by policies may only be used by shoots matching at least one of them.
+type LoadBalancerClassPolicy struct {
+	// LoadBalancerClasses are the names of the load balancer classes of the floating pools the policy applies to.
+	LoadBalancerClasses []string
+	// ShootPurposes are the purposes of the shoots which may use the load balancer classes. If empty, the purpose of
+	// the shoots is not restricted.
+	ShootPurposes []string
+	// ShootSelector selects the shoots which may use the load balancer classes by their labels. If not set, the labels
+	// of the shoots are not restricted.
+	ShootSelector *metav1.LabelSelector
 }
 
 // FloatingPool contains constraints regarding allowed values of the 'floatingPoolName' block in the control plane config.
@@ -81,6 +111,9 @@ type FloatingPool struct {
 	NonConstraining *bool
 	// LoadBalancerClasses contains a list of supported labeled load balancer network settings.
 	LoadBalancerClasses []LoadBalancerClass
+	// Deprecated indicates that the floating pool should no longer be used. Shoots selecting it are still admitted,
+	// but a warning is returned.
+	Deprecated *bool
 }
 
 // KeyStoneURL is a region-URL mapping for auth{n,z} in OpenStack (pointing to KeyStone).
@@ -93,6 +126,102 @@ type KeyStoneURL struct {
 	CACert *string
 }
 
+// RegionConfig contains provider-specific metadata of a region.
+type RegionConfig struct {
+	// Name is the name of the region.
+	Name string
+	// FlavorFamilies restrict the flavors which may be used for worker pools in the region to the given families. A
+	// flavor belongs to a family if its name starts with the family, e.g. `g_c` for `g_c4_m16`. The families of the
+	// reserved aggregates are allowed as well. If neither are given, all flavors may be used.
+	FlavorFamilies []string
+	// ReservedAggregates are the Nova host aggregates of the region which are reserved for certain flavor families,
+	// e.g. by capacity partitioning agreements.
+	ReservedAggregates []ReservedAggregate
+	// RootVolumeTypes maps availability zones of the region to the Cinder volume type of the root volumes created in the
+	// zone, e.g. if the zones are backed by different Cinder backends. They are used for worker pools booting from
+	// volumes which specify a volume type neither for the worker pool nor for its root volume.
+	RootVolumeTypes map[string]string
+}
+
+// ReservedAggregate is a Nova host aggregate which is reserved for certain flavor families.
+type ReservedAggregate struct {
+	// Name is the name of the host aggregate.
+	Name string
+	// FlavorFamilies are the families of the flavors whose servers are placed on the host aggregate.
+	FlavorFamilies []string
+}
+
+// Pricing is the pricing model of the cloud.
+type Pricing struct {
+	// Currency is the currency of the prices, e.g. `EUR`.
+	Currency string
+	// MachineTypes are the prices of the machine types.
+	MachineTypes []MachineTypePrice
+	// VolumeTypes are the prices of the volume types.
+	VolumeTypes []VolumeTypePrice
+}
+
+// DefaultGPUResourceName is the default name of the GPU resource in the node templates of the worker pools. The
+// cluster-autoscaler translates it to the `nvidia.com/gpu` extended resource advertised by the NVIDIA device plugin.
+const DefaultGPUResourceName = "gpu"
+
+// GPUConfig configures how the GPUs of the flavors are added to the node templates of the worker pools.
+type GPUConfig struct {
+	// ResourceName is the name of the GPU resource in the node templates. It defaults to `gpu`.
+	ResourceName *string
+	// PCIAliases are the aliases of the PCI devices passed through via the `pci_passthrough:alias` extra spec of the
+	// flavors which are GPUs. The vGPUs requested via the `resources:VGPU` extra spec are always added.
+	PCIAliases []string
+}
+
+// DefaultBastionAuditLogLevel is the default log level of the SSH daemon of audited bastion hosts.
+const DefaultBastionAuditLogLevel = "VERBOSE"
+
+// BastionConfig contains the configuration of the bastion hosts of the shoots.
+type BastionConfig struct {
+	// Audit configures the auditing of the SSH sessions via the bastion hosts.
+	Audit *BastionAudit
+}
+
+// BastionAudit configures the auditing of the SSH sessions via the bastion hosts.
+type BastionAudit struct {
+	// LogLevel is the log level of the SSH daemon of the bastion hosts. It defaults to `VERBOSE`, which logs the
+	// fingerprints of the keys used to log in.
+	LogLevel *string
+	// ForceCommand is the command executed for the SSH sessions instead of the one requested by the client, e.g. a
+	// wrapper recording the sessions. The requested command is passed in the `SSH_ORIGINAL_COMMAND` variable.
+	ForceCommand *string
+	// UserData is a shell script executed on the bastion hosts after the SSH daemon is configured, e.g. to forward its
+	// logs to a central audit log.
+	UserData *string
+}
+
+// MachineTypePrice is the price of a machine type.
+type MachineTypePrice struct {
+	// Name is the name of the machine type.
+	Name string
+	// PricePerHour is the price of a machine of the machine type per hour.
+	PricePerHour resource.Quantity
+}
+
+// VolumeTypePrice is the price of a volume type.
+type VolumeTypePrice struct {
+	// Name is the name of the volume type.
+	Name string
+	// PricePerGiBMonth is the price of a GiB of a volume of the volume type per month.
+	PricePerGiBMonth resource.Quantity
+}
+
+// EndpointOverride overrides the endpoint of an OpenStack service in the service catalog of Keystone.
+type EndpointOverride struct {
+	// Region is the name of the region the override applies to. Overrides without a region apply to all regions.
+	Region *string
+	// Service is the type of the service in the service catalog, e.g. `volumev3` or `load-balancer`.
+	Service string
+	// URL is the URL of the endpoint used instead of the one in the service catalog.
+	URL string
+}
+
 // LoadBalancerClass defines a restricted network setting for generic LoadBalancer classes.
 type LoadBalancerClass struct {
 	// Name is the name of the LB class
@@ -180,8 +309,23 @@ type MachineImageVersion struct {
 	Image string
 	// Regions is an optional mapping to the correct Image ID for the machine image in the supported regions.
 	Regions []RegionIDMapping
+
+	// Capabilities are the capabilities of the image, which adjust how the machines using it are managed.
+	Capabilities []MachineImageCapability
 }
 
+// MachineImageCapability is a capability of a machine image.
+type MachineImageCapability string
+
+const (
+	// MachineImageCapabilityPrebaked marks images which contain the kubelet and the container images of the node
+	// components already, so that their machines join the cluster considerably faster.
+	MachineImageCapabilityPrebaked MachineImageCapability = "prebaked"
+	// MachineImageCapabilityIgnition marks images which are provisioned with Ignition instead of cloud-init, so that
+	// worker pools using them can deliver their user data as Ignition config.
+	MachineImageCapabilityIgnition MachineImageCapability = "ignition"
+)
+
 // RegionIDMapping is a mapping to the correct ID for the machine image in the given region.
 type RegionIDMapping struct {
 	// Name is the name of the region.
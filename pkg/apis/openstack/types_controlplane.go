@@ -25,8 +25,63 @@ type ControlPlaneConfig struct {
 	Zone *string
 	// Storage contains configuration for storage in the cluster.
 	Storage *Storage
+	// LoadBalancer contains defaults for the load balancers created by the cloud-controller-manager.
+	LoadBalancer *LoadBalancerConfig
+	// KubeProxyReplacement specifies that the CNI of the shoot replaces kube-proxy (e.g. Cilium with kube-proxy
+	// replacement). If enabled, the cloud-controller-manager manages security groups allowing the traffic to the member
+	// ports of the load balancers itself. The rules of the nodes security group are not changed.
+	KubeProxyReplacement *bool
+	// NodeLabeler contains configuration settings for the node labeler, which labels the nodes with their placement in
+	// OpenStack.
+	NodeLabeler *NodeLabelerConfig
+	// Exposure contains settings for the load balancer exposing the kube-apiserver of the shoot in the seed, which only
+	// exists if the seed exposes the kube-apiservers with a load balancer per shoot.
+	Exposure *ExposureConfig
 }
 
+// ExposureConfig contains settings for the load balancer exposing the kube-apiserver of the shoot.
+type ExposureConfig struct {
+	// ZoneRedundant requests a zone-redundant load balancer, which keeps the kube-apiserver reachable during the outage
+	// of a zone. The Octavia flavor and availability zone of such load balancers are configured by the operator of the
+	// seed.
+	ZoneRedundant bool
+}
+
+// NodeLabelerConfig contains configuration settings for the node labeler.
+type NodeLabelerConfig struct {
+	// Enabled deploys the node labeler into the shoot.
+	Enabled bool
+	// SyncPeriod is the period in which the labels of all nodes are updated. Defaults to 10m.
+	SyncPeriod *metav1.Duration
+}
+
+// LoadBalancerConfig contains defaults for the load balancers created by the cloud-controller-manager.
+type LoadBalancerConfig struct {
+	// Algorithm is the load balancing algorithm used for the pools of the load balancers.
+	Algorithm *LoadBalancerAlgorithm
+	// AvailabilityZone is the name of the Octavia availability zone the load balancers are created in, e.g. to run
+	// the amphorae close to the nodes of the shoot.
+	AvailabilityZone *string
+	// FlavorID is the ID of the Octavia flavor the load balancers are created with, e.g. to pin the compute flavor or
+	// the topology of the amphorae.
+	FlavorID *string
+}
+
+// LoadBalancerAlgorithm is a load balancing algorithm of an Octavia pool.
+type LoadBalancerAlgorithm string
+
+const (
+	// LoadBalancerAlgorithmRoundRobin distributes the connections in turn to all members.
+	LoadBalancerAlgorithmRoundRobin LoadBalancerAlgorithm = "ROUND_ROBIN"
+	// LoadBalancerAlgorithmLeastConnections distributes the connections to the member with the least connections.
+	LoadBalancerAlgorithmLeastConnections LoadBalancerAlgorithm = "LEAST_CONNECTIONS"
+	// LoadBalancerAlgorithmSourceIP distributes the connections of a client IP always to the same member.
+	LoadBalancerAlgorithmSourceIP LoadBalancerAlgorithm = "SOURCE_IP"
+	// LoadBalancerAlgorithmSourceIPPort distributes the connections of a client IP and port always to the same member.
+	// It is the only algorithm supported by the ovn load balancer provider.
+	LoadBalancerAlgorithmSourceIPPort LoadBalancerAlgorithm = "SOURCE_IP_PORT"
+)
+
 const (
 	// DefaultLoadBalancerClass defines the default load balancer class.
 	DefaultLoadBalancerClass = "default"
@@ -52,4 +107,7 @@ type Storage struct {
 type CSIManila struct {
 	// Enabled is the switch to enable the CSI Manila driver support
 	Enabled bool
+	// ShareClient is the CIDR the NFS shares provisioned by the CSI Manila driver are exported to. Defaults to the CIDR
+	// of the worker nodes of the shoot.
+	ShareClient *string
 }
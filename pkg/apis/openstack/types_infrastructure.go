@@ -20,6 +20,126 @@ type InfrastructureConfig struct {
 	FloatingPoolSubnetName *string
 	// Networks is the OpenStack specific network configuration
 	Networks Networks
+	// Adopt indicates that all infrastructure resources (network, subnet, router, security group and SSH key pair)
+	// already exist and are only referenced by this configuration. In this mode, the resources are validated and
+	// recorded, but neither created, updated nor deleted.
+	Adopt *bool
+	// SecurityGroupID is the ID of an existing security group used for the worker nodes. Only allowed if `adopt` is set.
+	SecurityGroupID *string
+	// KeyPairName is the name of an existing SSH key pair used for the worker nodes. Only allowed if `adopt` is set.
+	KeyPairName *string
+	// AdditionalFloatingPools are floating pools in addition to the one given by `floatingPoolName` from which
+	// load balancers of the mapped LoadBalancerClasses get the floating IPs of their VIPs. The router of the shoot is
+	// not attached to them, hence they require a router connecting their networks with the subnet of the workers.
+	AdditionalFloatingPools []AdditionalFloatingPool
+	// NodePorts contains the configuration of the ingress rules of the security group of the nodes for the NodePort range.
+	NodePorts *NodePorts
+	// Egress contains the configuration of the egress rules of the security group of the nodes.
+	Egress *Egress
+	// SecurityGroupRules contains the configuration of the rules of the security group of the nodes.
+	SecurityGroupRules *SecurityGroupRules
+	// DedicatedProject contains the configuration of a project dedicated to the shoot. If set, the cloud provider
+	// secret must contain domain-scoped credentials, which are used to create the project and a technical user in the
+	// domain. All resources of the shoot are created in this project.
+	DedicatedProject *DedicatedProject
+	// AuxiliaryStack contains the configuration of a Heat stack of site-specific resources which do not fit the built-in
+	// model. The stack is created, updated and deleted alongside the infrastructure.
+	AuxiliaryStack *AuxiliaryStack
+	// ApplicationCredential contains the configuration of an application credential managed for the shoot. If set, the
+	// resources of the shoot are managed with an application credential of the user of the cloud provider secret or of
+	// the dedicated project, which is rotated together with the other credentials of the shoot.
+	ApplicationCredential *ApplicationCredential
+}
+
+// AuxiliaryStack contains the configuration of a Heat stack managed alongside the infrastructure of a shoot.
+type AuxiliaryStack struct {
+	// TemplateRef references the Heat template of the stack.
+	TemplateRef TemplateReference
+	// Parameters are passed to the template. The parameters `network_id`, `subnet_id`, `router_id`,
+	// `security_group_id` and `floating_network_id` are reserved, they are set to the ids of the infrastructure if the
+	// template declares them.
+	Parameters map[string]string
+}
+
+// TemplateReference references a key of a ConfigMap in the resources of the shoot.
+type TemplateReference struct {
+	// ResourceName is the name of the ConfigMap in the `spec.resources` of the shoot.
+	ResourceName string
+	// Key is the key of the template in the ConfigMap. Defaults to `template.yaml`.
+	Key *string
+}
+
+const (
+	// DefaultAuxiliaryStackTemplateKey is the default key of the template of an auxiliary stack in its ConfigMap.
+	DefaultAuxiliaryStackTemplateKey = "template.yaml"
+
+	// AuxiliaryStackParameterNetworkID is the template parameter set to the id of the network of the shoot.
+	AuxiliaryStackParameterNetworkID = "network_id"
+	// AuxiliaryStackParameterSubnetID is the template parameter set to the id of the subnet of the worker nodes.
+	AuxiliaryStackParameterSubnetID = "subnet_id"
+	// AuxiliaryStackParameterRouterID is the template parameter set to the id of the router of the shoot.
+	AuxiliaryStackParameterRouterID = "router_id"
+	// AuxiliaryStackParameterSecurityGroupID is the template parameter set to the id of the security group of the nodes.
+	AuxiliaryStackParameterSecurityGroupID = "security_group_id"
+	// AuxiliaryStackParameterFloatingNetworkID is the template parameter set to the id of the floating network.
+	AuxiliaryStackParameterFloatingNetworkID = "floating_network_id"
+)
+
+// DedicatedProject contains the configuration of the project dedicated to a shoot.
+type DedicatedProject struct {
+	// Roles are the names of the roles the technical user of the shoot gets in the project. Defaults to `member`.
+	Roles []string
+}
+
+// ApplicationCredential contains the configuration of the application credential managed for a shoot.
+type ApplicationCredential struct {
+	// Roles are the names of the roles of the user the application credential is restricted to. Defaults to all roles
+	// of the user in the project.
+	Roles []string
+}
+
+// NodePorts contains the configuration of the ingress rules for the NodePort range (30000-32767) of the nodes.
+type NodePorts struct {
+	// Enabled indicates whether ingress traffic to the NodePort range is allowed. Defaults to true.
+	Enabled *bool
+	// SourceCIDRs are the IPv4 or IPv6 CIDRs from which ingress traffic to the NodePort range is allowed. Defaults to
+	// `0.0.0.0/0`, and additionally to `::/0` if IPv6 rules are managed.
+	SourceCIDRs []string
+}
+
+// Egress contains the configuration of the egress rules of the security group of the nodes.
+type Egress struct {
+	// Restricted replaces the rules allowing all outgoing traffic of the nodes by rules which only allow the traffic
+	// needed by the cluster, i.e. to the API server and the VPN tunnel endpoint of the shoot in the seed, the DNS servers,
+	// the metadata service, the worker and pod networks and the given CIDRs. Defaults to false.
+	Restricted bool
+	// SeedCIDRs are the IPv4 or IPv6 CIDRs of the load balancers in the seed exposing the API server and the VPN tunnel
+	// endpoint of the shoot. Required if egress traffic is restricted.
+	SeedCIDRs []string
+	// CIDRs are further IPv4 or IPv6 CIDRs to which all outgoing traffic is allowed if egress traffic is restricted,
+	// e.g. of container registries or NTP servers.
+	CIDRs []string
+}
+
+// SecurityGroupRules contains the configuration of the rules of the security group of the nodes.
+type SecurityGroupRules struct {
+	// IPv6 indicates whether a matching IPv6 rule is managed for every IPv4 rule of the security group of the nodes which
+	// is not restricted to IPv4 CIDRs, e.g. for the traffic within the security group, for the ingress traffic to the
+	// NodePort range from any address and for the egress traffic to the metadata service. IPv6 CIDRs in the node
+	// ports and egress configuration are only allowed if it is enabled. Defaults to false.
+	IPv6 *bool
+}
+
+// AdditionalFloatingPool is a floating pool in addition to the main floating pool of the shoot, which is only used for
+// the floating IPs of the VIPs of load balancers.
+type AdditionalFloatingPool struct {
+	// Name is the name of the floating pool.
+	Name string
+	// LoadBalancerClass is the name of the LoadBalancerClass whose load balancers get floating IPs from this pool.
+	LoadBalancerClass string
+	// FloatingSubnetName is the fixed name or a matching name pattern of the subnets in the floating pool from which
+	// the floating IPs are allocated. Defaults to the default floating subnet of the pool in the CloudProfile.
+	FloatingSubnetName *string
 }
 
 // Networks holds information about the Kubernetes and infrastructure networks.
@@ -33,8 +153,42 @@ type Networks struct {
 	Workers string
 	// ID is the ID of an existing private network.
 	ID *string
+	// SubnetID is the ID of an existing subnet of the private network given by `id` used for the worker nodes.
+	// Only allowed if `adopt` is set.
+	SubnetID *string
 	// ShareNetwork holds information about the share network (used for shared file systems like NFS)
 	ShareNetwork *ShareNetwork
+	// Shared attaches the worker nodes to an existing network shared by multiple shoots. The subnet of the worker nodes
+	// is created in the shared network with a CIDR allocated from the given range, which does not overlap with the
+	// subnets of the other shoots. Mutually exclusive with `id` and `workers`.
+	Shared *SharedNetwork
+	// ShareWithProjects are the IDs of projects the network of the worker nodes is shared with, e.g. to attach
+	// monitoring appliances of sibling projects to it. The network is shared by RBAC policies which are managed and
+	// cleaned up with the shoot. Not allowed if a shared network is used.
+	ShareWithProjects []string
+	// Subnets are node subnets created in addition to the subnet given by `workers`, e.g. one per zone or per purpose.
+	// Worker pools join one of them by referring to its name in their WorkerConfig, all other worker pools join the
+	// subnet given by `workers`. Not allowed if resources are adopted or a shared network is used.
+	Subnets []NodeSubnet
+}
+
+// NodeSubnet is a subnet of the worker nodes in addition to the subnet given by `workers`.
+type NodeSubnet struct {
+	// Name is the name of the subnet, which worker pools refer to.
+	Name string
+	// CIDR is the CIDR of the subnet. It must be contained in the nodes CIDR of the shoot and must not overlap with
+	// the workers CIDR and the other subnets.
+	CIDR string
+}
+
+// SharedNetwork is an existing network shared by multiple shoots.
+type SharedNetwork struct {
+	// ID is the ID of the shared network.
+	ID string
+	// CIDR is the range from which the CIDR of the subnet of the worker nodes is allocated.
+	CIDR string
+	// PrefixLength is the prefix length of the allocated CIDR. Defaults to 24.
+	PrefixLength *int32
 }
 
 // Router indicates whether to use an existing router or create a new one.
@@ -60,6 +214,16 @@ type InfrastructureStatus struct {
 	Node NodeStatus
 	// SecurityGroups is a list of security groups that have been created.
 	SecurityGroups []SecurityGroup
+	// Project contains information about the project dedicated to the shoot, if any.
+	Project *ProjectStatus
+}
+
+// ProjectStatus contains information about the project dedicated to a shoot.
+type ProjectStatus struct {
+	// ID is the project id.
+	ID string
+	// Name is the project name.
+	Name string
 }
 
 // NodeStatus contains information about Node related resources.
@@ -122,6 +286,9 @@ type Subnet struct {
 	Purpose Purpose
 	// ID is the subnet id.
 	ID string
+	// Name is the name of the node subnet in `networks.subnets` of the InfrastructureConfig. It is empty for the subnet
+	// given by `networks.workers`.
+	Name *string
 }
 
 // SecurityGroup is an OpenStack security group related to a Network.
@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package openstack
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OpenStackMaintenance requests a provider-level maintenance operation for the shoot whose control plane runs in its
+// namespace of the seed. The operation is executed once per generation of the object, which is kept to report the
+// outcome in its status.
+type OpenStackMaintenance struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	// Spec contains the requested operation.
+	Spec OpenStackMaintenanceSpec
+	// Status contains the outcome of the operation.
+	Status OpenStackMaintenanceStatus
+}
+
+// OpenStackMaintenanceSpec contains the requested maintenance operation.
+type OpenStackMaintenanceSpec struct {
+	// Operation is the maintenance operation to execute.
+	Operation MaintenanceOperation
+	// Node is the name of the node whose server is recycled. It is required for the RecycleServer operation.
+	Node *string
+}
+
+// MaintenanceOperation is a provider-level maintenance operation.
+type MaintenanceOperation string
+
+const (
+	// MaintenanceOperationRecycleServer replaces the Nova server of a node. The machine of the node is deleted, so that
+	// the machine-controller-manager drains the node, deletes the server and creates a new machine.
+	MaintenanceOperationRecycleServer MaintenanceOperation = "RecycleServer"
+	// MaintenanceOperationReconcileSecurityGroups reconciles the infrastructure of the shoot, which restores the rules of
+	// the security groups of the shoot.
+	MaintenanceOperationReconcileSecurityGroups MaintenanceOperation = "ReconcileSecurityGroups"
+	// MaintenanceOperationRefreshImageCache discards the images verified to exist for the worker pools of the shoot and
+	// reconciles the worker, so that the images are looked up again.
+	MaintenanceOperationRefreshImageCache MaintenanceOperation = "RefreshImageCache"
+)
+
+// OpenStackMaintenanceStatus contains the outcome of a maintenance operation.
+type OpenStackMaintenanceStatus struct {
+	// ObservedGeneration is the generation of the object the operation was executed for.
+	ObservedGeneration int64
+	// Phase is the phase of the operation.
+	Phase MaintenancePhase
+	// Message describes the outcome of the operation.
+	Message string
+	// LastUpdateTime is the time the status was last updated.
+	LastUpdateTime *metav1.Time
+}
+
+// MaintenancePhase is the phase of a maintenance operation.
+type MaintenancePhase string
+
+const (
+	// MaintenancePhaseSucceeded means that the operation was executed.
+	MaintenancePhaseSucceeded MaintenancePhase = "Succeeded"
+	// MaintenancePhaseFailed means that the operation could not be executed.
+	MaintenancePhaseFailed MaintenancePhase = "Failed"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OpenStackMaintenanceList is a list of OpenStackMaintenances.
+type OpenStackMaintenanceList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+
+	// Items is the list of OpenStackMaintenances.
+	Items []OpenStackMaintenance
+}
@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"k8s.io/apimachinery/pkg/conversion"
+	"k8s.io/utils/pointer"
+
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+)
+
+// The provider status is embedded into the extension resources as raw extension, hence it is not converted by the
+// API server but whenever it is decoded by the extension. The conversion functions below upgrade provider status
+// written by old extension versions to the shape expected by the current version.
+
+// Convert_v1alpha1_InfrastructureStatus_To_openstack_InfrastructureStatus converts an InfrastructureStatus to the
+// internal version. Subnets and security groups which were recorded without purpose are the ones of the nodes.
+func Convert_v1alpha1_InfrastructureStatus_To_openstack_InfrastructureStatus(in *InfrastructureStatus, out *openstack.InfrastructureStatus, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_InfrastructureStatus_To_openstack_InfrastructureStatus(in, out, s); err != nil {
+		return err
+	}
+
+	if out.Networks.Subnets != nil {
+		subnets := make([]openstack.Subnet, 0, len(out.Networks.Subnets))
+		for _, subnet := range out.Networks.Subnets {
+			if subnet.Purpose == "" {
+				subnet.Purpose = openstack.PurposeNodes
+			}
+			subnets = append(subnets, subnet)
+		}
+		out.Networks.Subnets = subnets
+	}
+
+	if out.SecurityGroups != nil {
+		securityGroups := make([]openstack.SecurityGroup, 0, len(out.SecurityGroups))
+		for _, securityGroup := range out.SecurityGroups {
+			if securityGroup.Purpose == "" {
+				securityGroup.Purpose = openstack.PurposeNodes
+			}
+			securityGroups = append(securityGroups, securityGroup)
+		}
+		out.SecurityGroups = securityGroups
+	}
+
+	return nil
+}
+
+// Convert_v1alpha1_WorkerStatus_To_openstack_WorkerStatus converts a WorkerStatus to the internal version. Machine
+// images which were recorded without architecture stem from versions which only supported amd64 machines.
+func Convert_v1alpha1_WorkerStatus_To_openstack_WorkerStatus(in *WorkerStatus, out *openstack.WorkerStatus, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_WorkerStatus_To_openstack_WorkerStatus(in, out, s); err != nil {
+		return err
+	}
+
+	if out.MachineImages != nil {
+		machineImages := make([]openstack.MachineImage, 0, len(out.MachineImages))
+		for _, machineImage := range out.MachineImages {
+			if machineImage.Architecture == nil {
+				machineImage.Architecture = pointer.String(v1beta1constants.ArchitectureAMD64)
+			}
+			machineImages = append(machineImages, machineImage)
+		}
+		out.MachineImages = machineImages
+	}
+
+	return nil
+}
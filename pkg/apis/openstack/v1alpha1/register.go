@@ -38,12 +38,17 @@ func init() {
 // Adds the list of known types to api.Scheme.
 func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
+		&BackupBucketConfig{},
 		&CloudProfileConfig{},
 		&InfrastructureConfig{},
 		&InfrastructureStatus{},
 		&ControlPlaneConfig{},
 		&WorkerStatus{},
 		&WorkerConfig{},
+		&NetworkAllocation{},
+		&NetworkAllocationList{},
+		&OpenStackMaintenance{},
+		&OpenStackMaintenanceList{},
 	)
 	return nil
 }
@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BackupBucketConfig contains configuration settings for the backup bucket.
+type BackupBucketConfig struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// SegmentSize is the size of the segments in which large objects are uploaded to the Swift container.
+	// +optional
+	SegmentSize *resource.Quantity `json:"segmentSize,omitempty"`
+	// TempURL configures temporary URLs for the objects in the Swift container, e.g. for restore tooling.
+	// +optional
+	TempURL *TempURLConfig `json:"tempURL,omitempty"`
+	// IntegrityCheck configures a cron job periodically verifying the checksums and the segments of the recent backups
+	// in the Swift container. Its result is reported as condition of the BackupBucket.
+	// +optional
+	IntegrityCheck *IntegrityCheckConfig `json:"integrityCheck,omitempty"`
+
+	// CredentialsSecretName is the name of a secret with the credentials of a dedicated OpenStack project the Swift
+	// container is created in, so that the backups live in a different project than the compute resources. The secret
+	// must exist in the namespace of the secret of the BackupBucket. Defaults to the credentials of the BackupBucket.
+	// +optional
+	CredentialsSecretName *string `json:"credentialsSecretName,omitempty"`
+}
+
+// IntegrityCheckConfig contains configuration settings for the verification of the backups in a Swift container.
+type IntegrityCheckConfig struct {
+	// Schedule is the cron schedule of the verification. Defaults to once a day.
+	// +optional
+	Schedule *string `json:"schedule,omitempty"`
+	// MaxAge is the maximum age of the verified backups. Defaults to 24h.
+	// +optional
+	MaxAge *metav1.Duration `json:"maxAge,omitempty"`
+}
+
+// TempURLConfig contains configuration settings for temporary URLs of objects in a Swift container.
+type TempURLConfig struct {
+	// TTL is the validity of generated temporary URLs. Defaults to 1h.
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+}
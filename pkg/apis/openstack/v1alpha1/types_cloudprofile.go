@@ -5,6 +5,7 @@
 package v1alpha1
 
 import (
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -36,6 +37,10 @@ type CloudProfileConfig struct {
 	// KeyStoneURLs is a region-URL mapping for auth{n,z} in OpenStack (pointing to KeyStone).
 	// +optional
 	KeyStoneURLs []KeyStoneURL `json:"keystoneURLs,omitempty"`
+	// EndpointOverrides override the endpoints of OpenStack services in the service catalog of Keystone, e.g. in clouds
+	// with broken or split-horizon catalogs.
+	// +optional
+	EndpointOverrides []EndpointOverride `json:"endpointOverrides,omitempty"`
 	// MachineImages is the list of machine images that are understood by the controller. It maps
 	// logical names and versions to provider-specific identifiers.
 	MachineImages []MachineImages `json:"machineImages"`
@@ -68,6 +73,21 @@ type CloudProfileConfig struct {
 	// StorageClasses defines storageclasses for the shoot
 	// +optional
 	StorageClasses []StorageClassDefinition `json:"storageClasses,omitempty"`
+	// Regions contains provider-specific metadata of the regions of the cloud profile, e.g. the flavors which may be
+	// used for worker pools in a region.
+	// +optional
+	Regions []RegionConfig `json:"regions,omitempty"`
+	// Pricing is the pricing model of the cloud, which is used to annotate shoots with an estimation of the monthly cost
+	// of their worker pools and volumes.
+	// +optional
+	Pricing *Pricing `json:"pricing,omitempty"`
+	// Bastion contains the configuration of the bastion hosts of the shoots.
+	// +optional
+	Bastion *BastionConfig `json:"bastion,omitempty"`
+	// GPU configures how the GPUs of the flavors are added to the node templates of the worker pools, which allows the
+	// cluster-autoscaler to scale worker pools with GPUs from zero.
+	// +optional
+	GPU *GPUConfig `json:"gpu,omitempty"`
 }
 
 // Constraints is an object containing constraints for the shoots.
@@ -76,6 +96,24 @@ type Constraints struct {
 	FloatingPools []FloatingPool `json:"floatingPools"`
 	// LoadBalancerProviders contains constraints regarding allowed values of the 'loadBalancerProvider' block in the control plane config.
 	LoadBalancerProviders []LoadBalancerProvider `json:"loadBalancerProviders"`
+	// LoadBalancerClassPolicies restrict the shoots which may use the load balancer classes of the floating pools.
+	// +optional
+	LoadBalancerClassPolicies []LoadBalancerClassPolicy `json:"loadBalancerClassPolicies,omitempty"`
+}
+
+// LoadBalancerClassPolicy restricts the shoots which may use load balancer classes of the floating pools. A load
+// balancer class referenced by policies may only be used by shoots matching at least one of them.
+type LoadBalancerClassPolicy struct {
+	// LoadBalancerClasses are the names of the load balancer classes of the floating pools the policy applies to.
+	LoadBalancerClasses []string `json:"loadBalancerClasses"`
+	// ShootPurposes are the purposes of the shoots which may use the load balancer classes. If empty, the purpose of
+	// the shoots is not restricted.
+	// +optional
+	ShootPurposes []string `json:"shootPurposes,omitempty"`
+	// ShootSelector selects the shoots which may use the load balancer classes by their labels. If not set, the labels
+	// of the shoots are not restricted.
+	// +optional
+	ShootSelector *metav1.LabelSelector `json:"shootSelector,omitempty"`
 }
 
 // FloatingPool contains constraints regarding allowed values of the 'floatingPoolName' block in the control plane config.
@@ -97,6 +135,10 @@ type FloatingPool struct {
 	// LoadBalancerClasses contains a list of supported labeled load balancer network settings.
 	// +optional
 	LoadBalancerClasses []LoadBalancerClass `json:"loadBalancerClasses,omitempty"`
+	// Deprecated indicates that the floating pool should no longer be used. Shoots selecting it are still admitted,
+	// but a warning is returned.
+	// +optional
+	Deprecated *bool `json:"deprecated,omitempty"`
 }
 
 // KeyStoneURL is a region-URL mapping for auth{n,z} in OpenStack (pointing to KeyStone).
@@ -109,6 +151,108 @@ type KeyStoneURL struct {
 	CACert *string `json:"caCert,omitempty"`
 }
 
+// RegionConfig contains provider-specific metadata of a region.
+type RegionConfig struct {
+	// Name is the name of the region.
+	Name string `json:"name"`
+	// FlavorFamilies restrict the flavors which may be used for worker pools in the region to the given families. A
+	// flavor belongs to a family if its name starts with the family, e.g. `g_c` for `g_c4_m16`. The families of the
+	// reserved aggregates are allowed as well. If neither are given, all flavors may be used.
+	// +optional
+	FlavorFamilies []string `json:"flavorFamilies,omitempty"`
+	// ReservedAggregates are the Nova host aggregates of the region which are reserved for certain flavor families,
+	// e.g. by capacity partitioning agreements.
+	// +optional
+	ReservedAggregates []ReservedAggregate `json:"reservedAggregates,omitempty"`
+	// RootVolumeTypes maps availability zones of the region to the Cinder volume type of the root volumes created in the
+	// zone, e.g. if the zones are backed by different Cinder backends. They are used for worker pools booting from
+	// volumes which specify a volume type neither for the worker pool nor for its root volume.
+	// +optional
+	RootVolumeTypes map[string]string `json:"rootVolumeTypes,omitempty"`
+}
+
+// ReservedAggregate is a Nova host aggregate which is reserved for certain flavor families.
+type ReservedAggregate struct {
+	// Name is the name of the host aggregate.
+	Name string `json:"name"`
+	// FlavorFamilies are the families of the flavors whose servers are placed on the host aggregate.
+	FlavorFamilies []string `json:"flavorFamilies"`
+}
+
+// Pricing is the pricing model of the cloud.
+type Pricing struct {
+	// Currency is the currency of the prices, e.g. `EUR`.
+	Currency string `json:"currency"`
+	// MachineTypes are the prices of the machine types.
+	// +optional
+	MachineTypes []MachineTypePrice `json:"machineTypes,omitempty"`
+	// VolumeTypes are the prices of the volume types.
+	// +optional
+	VolumeTypes []VolumeTypePrice `json:"volumeTypes,omitempty"`
+}
+
+// GPUConfig configures how the GPUs of the flavors are added to the node templates of the worker pools.
+type GPUConfig struct {
+	// ResourceName is the name of the GPU resource in the node templates. It defaults to `gpu`, which the
+	// cluster-autoscaler translates to the `nvidia.com/gpu` extended resource.
+	// +optional
+	ResourceName *string `json:"resourceName,omitempty"`
+	// PCIAliases are the aliases of the PCI devices passed through via the `pci_passthrough:alias` extra spec of the
+	// flavors which are GPUs. The vGPUs requested via the `resources:VGPU` extra spec are always added.
+	// +optional
+	PCIAliases []string `json:"pciAliases,omitempty"`
+}
+
+// BastionConfig contains the configuration of the bastion hosts of the shoots.
+type BastionConfig struct {
+	// Audit configures the auditing of the SSH sessions via the bastion hosts.
+	// +optional
+	Audit *BastionAudit `json:"audit,omitempty"`
+}
+
+// BastionAudit configures the auditing of the SSH sessions via the bastion hosts.
+type BastionAudit struct {
+	// LogLevel is the log level of the SSH daemon of the bastion hosts. It defaults to `VERBOSE`, which logs the
+	// fingerprints of the keys used to log in.
+	// +optional
+	LogLevel *string `json:"logLevel,omitempty"`
+	// ForceCommand is the command executed for the SSH sessions instead of the one requested by the client, e.g. a
+	// wrapper recording the sessions. The requested command is passed in the `SSH_ORIGINAL_COMMAND` variable.
+	// +optional
+	ForceCommand *string `json:"forceCommand,omitempty"`
+	// UserData is a shell script executed on the bastion hosts after the SSH daemon is configured, e.g. to forward its
+	// logs to a central audit log.
+	// +optional
+	UserData *string `json:"userData,omitempty"`
+}
+
+// MachineTypePrice is the price of a machine type.
+type MachineTypePrice struct {
+	// Name is the name of the machine type.
+	Name string `json:"name"`
+	// PricePerHour is the price of a machine of the machine type per hour.
+	PricePerHour resource.Quantity `json:"pricePerHour"`
+}
+
+// VolumeTypePrice is the price of a volume type.
+type VolumeTypePrice struct {
+	// Name is the name of the volume type.
+	Name string `json:"name"`
+	// PricePerGiBMonth is the price of a GiB of a volume of the volume type per month.
+	PricePerGiBMonth resource.Quantity `json:"pricePerGiBMonth"`
+}
+
+// EndpointOverride overrides the endpoint of an OpenStack service in the service catalog of Keystone.
+type EndpointOverride struct {
+	// Region is the name of the region the override applies to. Overrides without a region apply to all regions.
+	// +optional
+	Region *string `json:"region,omitempty"`
+	// Service is the type of the service in the service catalog, e.g. `volumev3` or `load-balancer`.
+	Service string `json:"service"`
+	// URL is the URL of the endpoint used instead of the one in the service catalog.
+	URL string `json:"url"`
+}
+
 // LoadBalancerClass defines a restricted network setting for generic LoadBalancer classes.
 type LoadBalancerClass struct {
 	// Name is the name of the LB class
@@ -159,8 +303,24 @@ type MachineImageVersion struct {
 	Image string `json:"image,omitempty"`
 	// Regions is an optional mapping to the correct Image ID for the machine image in the supported regions.
 	Regions []RegionIDMapping `json:"regions,omitempty"`
+
+	// Capabilities are the capabilities of the image, which adjust how the machines using it are managed.
+	// +optional
+	Capabilities []MachineImageCapability `json:"capabilities,omitempty"`
 }
 
+// MachineImageCapability is a capability of a machine image.
+type MachineImageCapability string
+
+const (
+	// MachineImageCapabilityPrebaked marks images which contain the kubelet and the container images of the node
+	// components already, so that their machines join the cluster considerably faster.
+	MachineImageCapabilityPrebaked MachineImageCapability = "prebaked"
+	// MachineImageCapabilityIgnition marks images which are provisioned with Ignition instead of cloud-init, so that
+	// worker pools using them can deliver their user data as Ignition config.
+	MachineImageCapabilityIgnition MachineImageCapability = "ignition"
+)
+
 // RegionIDMapping is a mapping to the correct ID for the machine image in the given region.
 type RegionIDMapping struct {
 	// Name is the name of the region.
@@ -22,6 +22,131 @@ type InfrastructureConfig struct {
 	FloatingPoolSubnetName *string `json:"floatingPoolSubnetName,omitempty"`
 	// Networks is the OpenStack specific network configuration
 	Networks Networks `json:"networks"`
+	// Adopt indicates that all infrastructure resources (network, subnet, router, security group and SSH key pair)
+	// already exist and are only referenced by this configuration. In this mode, the resources are validated and
+	// recorded, but neither created, updated nor deleted.
+	// +optional
+	Adopt *bool `json:"adopt,omitempty"`
+	// SecurityGroupID is the ID of an existing security group used for the worker nodes. Only allowed if `adopt` is set.
+	// +optional
+	SecurityGroupID *string `json:"securityGroupID,omitempty"`
+	// KeyPairName is the name of an existing SSH key pair used for the worker nodes. Only allowed if `adopt` is set.
+	// +optional
+	KeyPairName *string `json:"keyPairName,omitempty"`
+	// AdditionalFloatingPools are floating pools in addition to the one given by `floatingPoolName` from which
+	// load balancers of the mapped LoadBalancerClasses get the floating IPs of their VIPs. The router of the shoot is
+	// not attached to them, hence they require a router connecting their networks with the subnet of the workers.
+	// +optional
+	AdditionalFloatingPools []AdditionalFloatingPool `json:"additionalFloatingPools,omitempty"`
+	// NodePorts contains the configuration of the ingress rules of the security group of the nodes for the NodePort range.
+	// +optional
+	NodePorts *NodePorts `json:"nodePorts,omitempty"`
+	// Egress contains the configuration of the egress rules of the security group of the nodes.
+	// +optional
+	Egress *Egress `json:"egress,omitempty"`
+	// SecurityGroupRules contains the configuration of the rules of the security group of the nodes.
+	// +optional
+	SecurityGroupRules *SecurityGroupRules `json:"securityGroupRules,omitempty"`
+	// DedicatedProject contains the configuration of a project dedicated to the shoot. If set, the cloud provider
+	// secret must contain domain-scoped credentials, which are used to create the project and a technical user in the
+	// domain. All resources of the shoot are created in this project.
+	// +optional
+	DedicatedProject *DedicatedProject `json:"dedicatedProject,omitempty"`
+	// AuxiliaryStack contains the configuration of a Heat stack of site-specific resources which do not fit the built-in
+	// model. The stack is created, updated and deleted alongside the infrastructure.
+	// +optional
+	AuxiliaryStack *AuxiliaryStack `json:"auxiliaryStack,omitempty"`
+	// ApplicationCredential contains the configuration of an application credential managed for the shoot. If set, the
+	// resources of the shoot are managed with an application credential of the user of the cloud provider secret or of
+	// the dedicated project, which is rotated together with the other credentials of the shoot.
+	// +optional
+	ApplicationCredential *ApplicationCredential `json:"applicationCredential,omitempty"`
+}
+
+// AuxiliaryStack contains the configuration of a Heat stack managed alongside the infrastructure of a shoot.
+type AuxiliaryStack struct {
+	// TemplateRef references the Heat template of the stack.
+	TemplateRef TemplateReference `json:"templateRef"`
+	// Parameters are passed to the template. The parameters `network_id`, `subnet_id`, `router_id`,
+	// `security_group_id` and `floating_network_id` are reserved, they are set to the ids of the infrastructure if the
+	// template declares them.
+	// +optional
+	Parameters map[string]string `json:"parameters,omitempty"`
+}
+
+// TemplateReference references a key of a ConfigMap in the resources of the shoot.
+type TemplateReference struct {
+	// ResourceName is the name of the ConfigMap in the `spec.resources` of the shoot.
+	ResourceName string `json:"resourceName"`
+	// Key is the key of the template in the ConfigMap. Defaults to `template.yaml`.
+	// +optional
+	Key *string `json:"key,omitempty"`
+}
+
+// DedicatedProject contains the configuration of the project dedicated to a shoot.
+type DedicatedProject struct {
+	// Roles are the names of the roles the technical user of the shoot gets in the project. Defaults to `member`.
+	// +optional
+	Roles []string `json:"roles,omitempty"`
+}
+
+// ApplicationCredential contains the configuration of the application credential managed for a shoot.
+type ApplicationCredential struct {
+	// Roles are the names of the roles of the user the application credential is restricted to. Defaults to all roles
+	// of the user in the project.
+	// +optional
+	Roles []string `json:"roles,omitempty"`
+}
+
+// NodePorts contains the configuration of the ingress rules for the NodePort range (30000-32767) of the nodes.
+type NodePorts struct {
+	// Enabled indicates whether ingress traffic to the NodePort range is allowed. Defaults to true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// SourceCIDRs are the IPv4 or IPv6 CIDRs from which ingress traffic to the NodePort range is allowed. Defaults to
+	// `0.0.0.0/0`, and additionally to `::/0` if IPv6 rules are managed.
+	// +optional
+	SourceCIDRs []string `json:"sourceCIDRs,omitempty"`
+}
+
+// Egress contains the configuration of the egress rules of the security group of the nodes.
+type Egress struct {
+	// Restricted replaces the rules allowing all outgoing traffic of the nodes by rules which only allow the traffic
+	// needed by the cluster, i.e. to the API server and the VPN tunnel endpoint of the shoot in the seed, the DNS servers,
+	// the metadata service, the worker and pod networks and the given CIDRs. Defaults to false.
+	// +optional
+	Restricted bool `json:"restricted,omitempty"`
+	// SeedCIDRs are the IPv4 or IPv6 CIDRs of the load balancers in the seed exposing the API server and the VPN tunnel
+	// endpoint of the shoot. Required if egress traffic is restricted.
+	// +optional
+	SeedCIDRs []string `json:"seedCIDRs,omitempty"`
+	// CIDRs are further IPv4 or IPv6 CIDRs to which all outgoing traffic is allowed if egress traffic is restricted,
+	// e.g. of container registries or NTP servers.
+	// +optional
+	CIDRs []string `json:"cidrs,omitempty"`
+}
+
+// SecurityGroupRules contains the configuration of the rules of the security group of the nodes.
+type SecurityGroupRules struct {
+	// IPv6 indicates whether a matching IPv6 rule is managed for every IPv4 rule of the security group of the nodes which
+	// is not restricted to IPv4 CIDRs, e.g. for the traffic within the security group, for the ingress traffic to the
+	// NodePort range from any address and for the egress traffic to the metadata service. IPv6 CIDRs in the node
+	// ports and egress configuration are only allowed if it is enabled. Defaults to false.
+	// +optional
+	IPv6 *bool `json:"ipv6,omitempty"`
+}
+
+// AdditionalFloatingPool is a floating pool in addition to the main floating pool of the shoot, which is only used for
+// the floating IPs of the VIPs of load balancers.
+type AdditionalFloatingPool struct {
+	// Name is the name of the floating pool.
+	Name string `json:"name"`
+	// LoadBalancerClass is the name of the LoadBalancerClass whose load balancers get floating IPs from this pool.
+	LoadBalancerClass string `json:"loadBalancerClass"`
+	// FloatingSubnetName is the fixed name or a matching name pattern of the subnets in the floating pool from which
+	// the floating IPs are allocated. Defaults to the default floating subnet of the pool in the CloudProfile.
+	// +optional
+	FloatingSubnetName *string `json:"floatingSubnetName,omitempty"`
 }
 
 // Networks holds information about the Kubernetes and infrastructure networks.
@@ -37,9 +162,48 @@ type Networks struct {
 	// ID is the ID of an existing private network.
 	// +optional
 	ID *string `json:"id,omitempty"`
+	// SubnetID is the ID of an existing subnet of the private network given by `id` used for the worker nodes.
+	// Only allowed if `adopt` is set.
+	// +optional
+	SubnetID *string `json:"subnetID,omitempty"`
 	// ShareNetwork holds information about the share network (used for shared file systems like NFS)
 	// +optional
 	ShareNetwork *ShareNetwork `json:"shareNetwork,omitempty"`
+	// Shared attaches the worker nodes to an existing network shared by multiple shoots. The subnet of the worker nodes
+	// is created in the shared network with a CIDR allocated from the given range, which does not overlap with the
+	// subnets of the other shoots. Mutually exclusive with `id` and `workers`.
+	// +optional
+	Shared *SharedNetwork `json:"shared,omitempty"`
+	// ShareWithProjects are the IDs of projects the network of the worker nodes is shared with, e.g. to attach
+	// monitoring appliances of sibling projects to it. The network is shared by RBAC policies which are managed and
+	// cleaned up with the shoot. Not allowed if a shared network is used.
+	// +optional
+	ShareWithProjects []string `json:"shareWithProjects,omitempty"`
+	// Subnets are node subnets created in addition to the subnet given by `workers`, e.g. one per zone or per purpose.
+	// Worker pools join one of them by referring to its name in their WorkerConfig, all other worker pools join the
+	// subnet given by `workers`. Not allowed if resources are adopted or a shared network is used.
+	// +optional
+	Subnets []NodeSubnet `json:"subnets,omitempty"`
+}
+
+// NodeSubnet is a subnet of the worker nodes in addition to the subnet given by `workers`.
+type NodeSubnet struct {
+	// Name is the name of the subnet, which worker pools refer to.
+	Name string `json:"name"`
+	// CIDR is the CIDR of the subnet. It must be contained in the nodes CIDR of the shoot and must not overlap with
+	// the workers CIDR and the other subnets.
+	CIDR string `json:"cidr"`
+}
+
+// SharedNetwork is an existing network shared by multiple shoots.
+type SharedNetwork struct {
+	// ID is the ID of the shared network.
+	ID string `json:"id"`
+	// CIDR is the range from which the CIDR of the subnet of the worker nodes is allocated.
+	CIDR string `json:"cidr"`
+	// PrefixLength is the prefix length of the allocated CIDR. Defaults to 24.
+	// +optional
+	PrefixLength *int32 `json:"prefixLength,omitempty"`
 }
 
 // Router indicates whether to use an existing router or create a new one.
@@ -65,6 +229,17 @@ type InfrastructureStatus struct {
 	Node NodeStatus `json:"node"`
 	// SecurityGroups is a list of security groups that have been created.
 	SecurityGroups []SecurityGroup `json:"securityGroups"`
+	// Project contains information about the project dedicated to the shoot, if any.
+	// +optional
+	Project *ProjectStatus `json:"project,omitempty"`
+}
+
+// ProjectStatus contains information about the project dedicated to a shoot.
+type ProjectStatus struct {
+	// ID is the project id.
+	ID string `json:"id"`
+	// Name is the project name.
+	Name string `json:"name"`
 }
 
 // NodeStatus contains information about Node related resources.
@@ -128,6 +303,10 @@ type Subnet struct {
 	Purpose Purpose `json:"purpose"`
 	// ID is the subnet id.
 	ID string `json:"id"`
+	// Name is the name of the node subnet in `networks.subnets` of the InfrastructureConfig. It is empty for the subnet
+	// given by `networks.workers`.
+	// +optional
+	Name *string `json:"name,omitempty"`
 }
 
 // SecurityGroup is an OpenStack security group related to a Network.
@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OpenStackMaintenance requests a provider-level maintenance operation for the shoot whose control plane runs in its
+// namespace of the seed. The operation is executed once per generation of the object, which is kept to report the
+// outcome in its status.
+type OpenStackMaintenance struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec contains the requested operation.
+	Spec OpenStackMaintenanceSpec `json:"spec"`
+	// Status contains the outcome of the operation.
+	// +optional
+	Status OpenStackMaintenanceStatus `json:"status,omitempty"`
+}
+
+// OpenStackMaintenanceSpec contains the requested maintenance operation.
+type OpenStackMaintenanceSpec struct {
+	// Operation is the maintenance operation to execute.
+	Operation MaintenanceOperation `json:"operation"`
+	// Node is the name of the node whose server is recycled. It is required for the RecycleServer operation.
+	// +optional
+	Node *string `json:"node,omitempty"`
+}
+
+// MaintenanceOperation is a provider-level maintenance operation.
+type MaintenanceOperation string
+
+const (
+	// MaintenanceOperationRecycleServer replaces the Nova server of a node. The machine of the node is deleted, so that
+	// the machine-controller-manager drains the node, deletes the server and creates a new machine.
+	MaintenanceOperationRecycleServer MaintenanceOperation = "RecycleServer"
+	// MaintenanceOperationReconcileSecurityGroups reconciles the infrastructure of the shoot, which restores the rules of
+	// the security groups of the shoot.
+	MaintenanceOperationReconcileSecurityGroups MaintenanceOperation = "ReconcileSecurityGroups"
+	// MaintenanceOperationRefreshImageCache discards the images verified to exist for the worker pools of the shoot and
+	// reconciles the worker, so that the images are looked up again.
+	MaintenanceOperationRefreshImageCache MaintenanceOperation = "RefreshImageCache"
+)
+
+// OpenStackMaintenanceStatus contains the outcome of a maintenance operation.
+type OpenStackMaintenanceStatus struct {
+	// ObservedGeneration is the generation of the object the operation was executed for.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Phase is the phase of the operation.
+	// +optional
+	Phase MaintenancePhase `json:"phase,omitempty"`
+	// Message describes the outcome of the operation.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// LastUpdateTime is the time the status was last updated.
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// MaintenancePhase is the phase of a maintenance operation.
+type MaintenancePhase string
+
+const (
+	// MaintenancePhaseSucceeded means that the operation was executed.
+	MaintenancePhaseSucceeded MaintenancePhase = "Succeeded"
+	// MaintenancePhaseFailed means that the operation could not be executed.
+	MaintenancePhaseFailed MaintenancePhase = "Failed"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OpenStackMaintenanceList is a list of OpenStackMaintenances.
+type OpenStackMaintenanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of OpenStackMaintenances.
+	Items []OpenStackMaintenance `json:"items"`
+}
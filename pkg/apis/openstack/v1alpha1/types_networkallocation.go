@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NetworkAllocation records the CIDRs allocated to the shoots of a seed attached to a shared network. It is cluster
+// scoped and named after the ID of the shared network.
+type NetworkAllocation struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec contains the allocations in the shared network.
+	Spec NetworkAllocationSpec `json:"spec"`
+}
+
+// NetworkAllocationSpec contains the allocations in a shared network.
+type NetworkAllocationSpec struct {
+	// Allocations are the CIDRs allocated to the shoots.
+	// +optional
+	Allocations []CIDRAllocation `json:"allocations,omitempty"`
+}
+
+// CIDRAllocation is a CIDR allocated to a shoot.
+type CIDRAllocation struct {
+	// Namespace is the namespace of the shoot in the seed.
+	Namespace string `json:"namespace"`
+	// CIDR is the CIDR allocated to the subnet of the worker nodes of the shoot.
+	CIDR string `json:"cidr"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NetworkAllocationList is a list of NetworkAllocations.
+type NetworkAllocationList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of NetworkAllocations.
+	Items []NetworkAllocation `json:"items"`
+}
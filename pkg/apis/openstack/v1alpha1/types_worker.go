@@ -6,6 +6,8 @@ package v1alpha1
 
 import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -27,6 +29,59 @@ type WorkerStatus struct {
 	// ServerGroupDependencies is a list of external server group dependencies.
 	// +optional
 	ServerGroupDependencies []ServerGroupDependency `json:"serverGroupDependencies,omitempty"`
+
+	// FailedMachines is a summary of the machines of this worker that are in a failed state.
+	// +optional
+	FailedMachines []FailedMachine `json:"failedMachines,omitempty"`
+
+	// VerifiedImages is a list of the images of the machine images in use which have been verified to exist in the
+	// region of the worker. The machines of a worker pool are only rolled once its image has been verified.
+	// +optional
+	VerifiedImages []VerifiedImage `json:"verifiedImages,omitempty"`
+
+	// WorkerPools are the provider parameters resolved for the machines of the worker pools.
+	// +optional
+	WorkerPools []WorkerPoolStatus `json:"workerPools,omitempty"`
+}
+
+// WorkerPoolStatus contains the provider parameters resolved for the machines of a worker pool.
+type WorkerPoolStatus struct {
+	// Name is the name of the worker pool.
+	Name string `json:"name"`
+	// Flavor is the name of the flavor of the servers.
+	Flavor string `json:"flavor"`
+	// ImageID is the id of the image of the servers, if it is known.
+	// +optional
+	ImageID string `json:"imageID,omitempty"`
+	// ImageName is the name of the image of the servers, if the machine image refers to the image by name.
+	// +optional
+	ImageName string `json:"imageName,omitempty"`
+	// ServerGroupID is the id of the server group of the servers, unless the worker pool has a server group per zone.
+	// +optional
+	ServerGroupID *string `json:"serverGroupID,omitempty"`
+	// SecurityGroups are the names of the security groups of the servers.
+	SecurityGroups []string `json:"securityGroups"`
+	// NetworkID is the id of the network of the servers.
+	NetworkID string `json:"networkID"`
+	// SubnetID is the id of the subnet of the servers.
+	SubnetID string `json:"subnetID"`
+	// AvailabilityZones are the availability zones the servers are created in, including the hosts they are pinned to.
+	AvailabilityZones []string `json:"availabilityZones"`
+
+	// AdditionalNetworks are the additional networks the servers are attached to.
+	// +optional
+	AdditionalNetworks []WorkerNetwork `json:"additionalNetworks,omitempty"`
+}
+
+// VerifiedImage is an image which has been verified to exist in a region.
+type VerifiedImage struct {
+	// Region is the region in which the image exists.
+	Region string `json:"region"`
+	// Image is the name of the image, if the machine image refers to the image by name.
+	// +optional
+	Image string `json:"image,omitempty"`
+	// ID is the id of the image.
+	ID string `json:"id"`
 }
 
 // MachineImage is a mapping from logical names and versions to provider-specific machine image data.
@@ -42,6 +97,35 @@ type MachineImage struct {
 	// Architecture is the CPU architecture of the machine image
 	// +optional
 	Architecture *string `json:"architecture,omitempty"`
+
+	// Capabilities are the capabilities of the machine image.
+	// +optional
+	Capabilities []MachineImageCapability `json:"capabilities,omitempty"`
+}
+
+// FailedMachine summarizes a machine that is in a failed state.
+type FailedMachine struct {
+	// Name is the name of the machine.
+	Name string `json:"name"`
+	// ServerID is the id of the server backing the machine.
+	// +optional
+	ServerID *string `json:"serverID,omitempty"`
+	// Message is the description of the last operation of the machine.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// Fault is the fault message reported by Nova for the server backing the machine.
+	// +optional
+	Fault *string `json:"fault,omitempty"`
+	// Preempted indicates that the machine belongs to a preemptible worker pool and its server was reclaimed by the
+	// cloud. The machine-controller-manager replaces such machines.
+	// +optional
+	Preempted bool `json:"preempted,omitempty"`
+	// ProvisionState is the provision state of the Ironic node backing the machine, if it is a bare metal machine.
+	// +optional
+	ProvisionState *string `json:"provisionState,omitempty"`
+	// LastError is the last error reported by Ironic for the node backing the machine.
+	// +optional
+	LastError *string `json:"lastError,omitempty"`
 }
 
 // ServerGroupDependency is a reference to an external machine dependency of OpenStack server groups.
@@ -52,6 +136,10 @@ type ServerGroupDependency struct {
 	ID string `json:"id"`
 	// Name is the name of the server group
 	Name string `json:"name"`
+	// Zone is the availability zone of the worker pool the server group belongs to, if the worker pool has a server
+	// group per zone.
+	// +optional
+	Zone *string `json:"zone,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -69,6 +157,302 @@ type WorkerConfig struct {
 
 	// MachineLabels define key value pairs to add to machines.
 	MachineLabels []MachineLabel `json:"machineLabels,omitempty"`
+
+	// Canary configures a canary rollout for the worker pool. If set, the machines of the canary zone are rolled first
+	// and the remaining zones are only rolled once the canary zone has been updated successfully and stayed healthy
+	// for the configured soak time.
+	Canary *CanaryRollout `json:"canary,omitempty"`
+
+	// ZoneRollout configures a rollout of the worker pool zone by zone. If set, the zones are rolled one after another
+	// in their order in the worker pool, each with the maxSurge and maxUnavailable of the whole worker pool. A zone is
+	// only rolled once the previous zone has been updated successfully and stayed healthy for the grace period.
+	// +optional
+	ZoneRollout *ZoneRollout `json:"zoneRollout,omitempty"`
+
+	// UpdateStrategy is the strategy used to replace the machines of the worker pool. Defaults to RollingUpdate.
+	// +optional
+	UpdateStrategy *UpdateStrategyType `json:"updateStrategy,omitempty"`
+
+	// VGPU configures the nodes of the worker pool for the vGPUs of its flavor. If set, the vGPUs are rendered as
+	// extended resource into the node template and the nodes are labeled and tainted for the NVIDIA GPU operator.
+	// +optional
+	VGPU *VGPU `json:"vgpu,omitempty"`
+
+	// MachineClassExtra are additional values merged into the provider spec of the machine classes of the worker pool,
+	// e.g. fields of the machine-controller-manager provider which are not supported by the WorkerConfig yet. They are
+	// only applied if the MachineClassExtra feature gate is enabled and their keys are allowed in the configuration of
+	// the extension.
+	// +optional
+	MachineClassExtra map[string]apiextensionsv1.JSON `json:"machineClassExtra,omitempty"`
+
+	// HostPinning pins the machines of zones of the worker pool to hypervisor hosts or nodes with the `zone:host:node`
+	// syntax of the availability zone of Nova servers, e.g. for edge or appliance setups in which the operator controls
+	// the mapping of the worker pools to the hardware. It is only applied if the HostPinning feature gate is enabled.
+	// +optional
+	HostPinning []HostPinning `json:"hostPinning,omitempty"`
+
+	// RootVolume configures the Cinder volume the machines of the worker pool boot from instead of the ephemeral disk
+	// of their flavor. Its size and type default to the volume of the worker pool.
+	// +optional
+	RootVolume *RootVolume `json:"rootVolume,omitempty"`
+
+	// DataVolumes are additional Cinder volumes which are attached to every machine of the worker pool.
+	// +optional
+	DataVolumes []DataVolume `json:"dataVolumes,omitempty"`
+
+	// ConfigDrive indicates whether Nova provides the user data of the machines of the worker pool on a config drive
+	// instead of the metadata service, e.g. on clouds where the metadata service is unreliable or disabled. Defaults to
+	// the configuration of Nova.
+	// +optional
+	ConfigDrive *bool `json:"configDrive,omitempty"`
+
+	// ServerMetadata is additional metadata of the Nova servers of the machines of the worker pool, which is merged with
+	// the labels of the worker pool and the machine labels.
+	// +optional
+	ServerMetadata *ServerMetadata `json:"serverMetadata,omitempty"`
+
+	// Networks are additional Neutron networks the machines of the worker pool are attached to with one port each, in
+	// addition to the network of the shoot.
+	// +optional
+	Networks []WorkerNetwork `json:"networks,omitempty"`
+
+	// AllowedAddressPairs are additional IP addresses or CIDRs, optionally together with a MAC address, from which the
+	// port security of the ports of the machines of the worker pool in the network of the shoot accepts traffic, e.g. for
+	// the virtual IPs announced by MetalLB, kube-vip or egress gateways. They are added to the ports by the port
+	// controller once the servers of the machines exist.
+	// +optional
+	AllowedAddressPairs []AllowedAddressPair `json:"allowedAddressPairs,omitempty"`
+
+	// AdditionalSecurityGroups are pre-existing security groups, given by name or id, the machines of the worker pool are
+	// assigned to in addition to the security group of the nodes of the shoot.
+	// +optional
+	AdditionalSecurityGroups []string `json:"additionalSecurityGroups,omitempty"`
+
+	// PortSecurityEnabled disables the port security of the ports of the machines of the worker pool if set to false.
+	// The ports are neither assigned to security groups nor filtered by Neutron then, e.g. for clusters enforcing their
+	// own firewalling with Calico eBPF or where the port security breaks the overlay traffic. The port security is
+	// disabled by the port controller once the servers of the machines exist. Defaults to true.
+	// +optional
+	PortSecurityEnabled *bool `json:"portSecurityEnabled,omitempty"`
+
+	// QoSPolicyID is the id of a pre-existing Neutron QoS policy, e.g. with bandwidth limit or minimum bandwidth rules,
+	// which is applied to all ports of the machines of the worker pool by the port controller, also to the ports of
+	// existing machines.
+	// +optional
+	QoSPolicyID *string `json:"qosPolicyID,omitempty"`
+
+	// Subnet is the name of a node subnet in `networks.subnets` of the InfrastructureConfig the machines of the worker
+	// pool join. Defaults to the subnet given by `networks.workers`.
+	// +optional
+	Subnet *string `json:"subnet,omitempty"`
+
+	// SchedulerHints are hints passed to the Nova scheduler when the servers of the worker pool are created, e.g.
+	// `different_host` with the ids of servers the machines must not share a hypervisor with or custom hints evaluated by
+	// the filters of the scheduler to place the machines on dedicated host aggregates. The `group` hint is reserved for
+	// the server group of the worker pool.
+	// +optional
+	SchedulerHints map[string][]string `json:"schedulerHints,omitempty"`
+
+	// Preemptible marks the machines of the worker pool as preemptible, i.e. the cloud may reclaim their servers at any
+	// time. The flavor of the worker pool must be one the cloud offers as preemptible, e.g. via its extra specs. The
+	// nodes are labeled and tainted accordingly and preempted machines are not reported as failures.
+	// +optional
+	Preemptible *bool `json:"preemptible,omitempty"`
+
+	// KeyName is the name of a pre-existing Nova key pair which is injected into the machines of the worker pool instead
+	// of the key pair of the infrastructure. As key pairs belong to users, it must be owned by the user of the shoot's
+	// credentials.
+	// +optional
+	KeyName *string `json:"keyName,omitempty"`
+
+	// TrunkPort makes the ports of the machines of the worker pool in the network of the shoot the parent ports of
+	// Neutron trunks, to which VLAN subports can be attached, e.g. by CNFs running on the nodes. The trunks are created
+	// by the port controller once the servers of the machines exist and deleted before the machines.
+	// +optional
+	TrunkPort *bool `json:"trunkPort,omitempty"`
+
+	// TuningProfile is a profile of kernel settings applied to the machines of the worker pool, e.g. a larger ephemeral
+	// port range and backlogs for worker pools facing load balancers. Kernel settings of the worker pool take
+	// precedence over the settings of the profile.
+	// +optional
+	TuningProfile *TuningProfile `json:"tuningProfile,omitempty"`
+
+	// UserDataFormat is the format the user data is delivered to the machines of the worker pool in. Ignition requires
+	// a machine image which is provisioned with Ignition, e.g. Flatcar, and the user data is wrapped into an Ignition
+	// config if necessary. Defaults to cloud-init.
+	// +optional
+	UserDataFormat *UserDataFormat `json:"userDataFormat,omitempty"`
+
+	// BareMetal contains the configuration of worker pools of bare metal flavors backed by Ironic nodes.
+	// +optional
+	BareMetal *BareMetal `json:"bareMetal,omitempty"`
+}
+
+// UserDataFormat is the format of the user data of the machines of a worker pool.
+type UserDataFormat string
+
+const (
+	// UserDataFormatCloudInit delivers the user data as is to be processed by cloud-init. This is the default.
+	UserDataFormatCloudInit UserDataFormat = "cloud-init"
+	// UserDataFormatIgnition delivers the user data as Ignition config.
+	UserDataFormatIgnition UserDataFormat = "ignition"
+)
+
+// TuningProfile is a profile of kernel settings for the machines of a worker pool.
+type TuningProfile string
+
+const (
+	// TuningProfileHighConnectionCount is a profile for machines handling many concurrent connections, e.g. ingress
+	// nodes behind load balancers. It widens the ephemeral port range and enlarges the backlogs and connection tracking.
+	TuningProfileHighConnectionCount TuningProfile = "high-connection-count"
+	// TuningProfileHighThroughput is a profile for machines with a high network throughput over virtio NICs. It enlarges
+	// the socket buffers and the queues of the network devices.
+	TuningProfileHighThroughput TuningProfile = "high-throughput"
+)
+
+// WorkerNetwork is an additional Neutron network the machines of a worker pool are attached to.
+type WorkerNetwork struct {
+	// ID is the id of the network. Either the id or the name of the network must be given.
+	// +optional
+	ID *string `json:"id,omitempty"`
+	// Name is the name of the network. It must be unique in the project.
+	// +optional
+	Name *string `json:"name,omitempty"`
+}
+
+// AllowedAddressPair is an IP address or CIDR, optionally together with a MAC address, from which the port security of
+// a Neutron port accepts traffic in addition to the fixed IPs and the MAC address of the port.
+type AllowedAddressPair struct {
+	// IPAddress is the IP address or CIDR.
+	IPAddress string `json:"ipAddress"`
+	// MACAddress is the MAC address. Defaults to the MAC address of the port.
+	// +optional
+	MACAddress *string `json:"macAddress,omitempty"`
+}
+
+// ServerMetadata contains additional metadata of the Nova servers of the machines of a worker pool.
+type ServerMetadata struct {
+	// Items are the key/value pairs of the metadata. The keys must not collide with the metadata maintained by the
+	// extension.
+	Items map[string]string `json:"items"`
+	// TriggerRollingOnUpdate controls if the machines are rolled if the metadata changes. Otherwise, the metadata is
+	// only applied to new machines.
+	// +optional
+	TriggerRollingOnUpdate bool `json:"triggerRollingOnUpdate,omitempty"`
+}
+
+// HostPinning pins the machines of a zone of a worker pool to a hypervisor host or node.
+type HostPinning struct {
+	// Zone is the zone of the worker pool whose machines are pinned.
+	Zone string `json:"zone"`
+	// Host is the name of the compute host the machines are created on.
+	// +optional
+	Host *string `json:"host,omitempty"`
+	// Node is the name of the hypervisor node the machines are created on.
+	// +optional
+	Node *string `json:"node,omitempty"`
+}
+
+// RootVolume contains the configuration of the Cinder volume the machines of a worker pool boot from.
+type RootVolume struct {
+	// Size is the size of the root volume. It must be a multiple of 1Gi and defaults to the volume size of the worker
+	// pool.
+	// +optional
+	Size *resource.Quantity `json:"size,omitempty"`
+	// Type is the Cinder volume type of the root volume. Defaults to the volume type of the worker pool, or to the root
+	// volume type of the zone in the CloudProfile if the worker pool does not specify a volume type either.
+	// +optional
+	Type *string `json:"type,omitempty"`
+	// ZoneTypes maps availability zones of the worker pool to the Cinder volume type of the root volumes of the machines
+	// in the zone, e.g. if the zones are backed by different Cinder backends. It takes precedence over `type`.
+	// +optional
+	ZoneTypes map[string]string `json:"zoneTypes,omitempty"`
+	// DeleteOnTermination indicates whether the root volume is deleted together with its server. Defaults to true.
+	// +optional
+	DeleteOnTermination *bool `json:"deleteOnTermination,omitempty"`
+}
+
+// DataVolume contains the configuration of an additional Cinder volume attached to the machines of a worker pool.
+type DataVolume struct {
+	// Name is the name of the data volume, which must be unique in the worker pool.
+	Name string `json:"name"`
+	// Size is the size of the data volume. It must be a multiple of 1Gi.
+	Size resource.Quantity `json:"size"`
+	// Type is the Cinder volume type of the data volume. Defaults to the default volume type of the cloud.
+	// +optional
+	Type *string `json:"type,omitempty"`
+	// InheritAvailabilityZone indicates whether the data volume is created in the availability zone of its server.
+	// Otherwise, it is created in the default availability zone of Cinder. Defaults to true.
+	// +optional
+	InheritAvailabilityZone *bool `json:"inheritAvailabilityZone,omitempty"`
+	// DeleteOnTermination indicates whether the data volume is deleted together with its server. Defaults to true.
+	// +optional
+	DeleteOnTermination *bool `json:"deleteOnTermination,omitempty"`
+}
+
+// BareMetal contains the configuration of a worker pool of a bare metal flavor.
+type BareMetal struct {
+	// IgnoreAvailabilityZones creates the servers of the worker pool without an availability zone, e.g. if the Ironic
+	// nodes are not assigned to the availability zones of the cloud. The machines are still distributed across the zones
+	// of the worker pool, which are only used for labeling the nodes.
+	// +optional
+	IgnoreAvailabilityZones *bool `json:"ignoreAvailabilityZones,omitempty"`
+	// Capabilities are the capabilities of the Ironic nodes the machines of the worker pool are scheduled to, e.g.
+	// `boot_mode: uefi`. Nova places servers by the `capabilities:<name>` extra specs of their flavor, hence the
+	// reconciliation of the worker fails if the flavor of the worker pool does not request these capabilities.
+	// +optional
+	Capabilities map[string]string `json:"capabilities,omitempty"`
+}
+
+// VGPU contains the configuration of the vGPUs of the flavor of a worker pool.
+type VGPU struct {
+	// TimeSlicingReplicas is the number of replicas each vGPU is advertised as by the NVIDIA device plugin if
+	// time-slicing is configured. Defaults to 1.
+	// +optional
+	TimeSlicingReplicas *int32 `json:"timeSlicingReplicas,omitempty"`
+	// DevicePluginConfig is the name of the configuration of the NVIDIA device plugin used for the nodes of the
+	// worker pool, e.g. the name of a time-slicing configuration.
+	// +optional
+	DevicePluginConfig *string `json:"devicePluginConfig,omitempty"`
+	// Taint indicates whether the nodes of the worker pool are tainted so that only GPU workloads are scheduled to
+	// them. Defaults to true.
+	// +optional
+	Taint *bool `json:"taint,omitempty"`
+}
+
+// UpdateStrategyType is the type of strategy used to replace the machines of a worker pool.
+type UpdateStrategyType string
+
+const (
+	// UpdateStrategyRollingUpdate replaces the machines of a worker pool respecting the maxSurge and maxUnavailable
+	// settings of the worker pool.
+	UpdateStrategyRollingUpdate UpdateStrategyType = "RollingUpdate"
+	// UpdateStrategyRecreate deletes the machines of a worker pool before their replacements are created. It is meant
+	// for worker pools that cannot tolerate surge capacity, e.g. due to a tight quota or licenses bound to the machines.
+	UpdateStrategyRecreate UpdateStrategyType = "Recreate"
+	// UpdateStrategyInPlaceUpdate updates the machines of a worker pool in-place without replacing them.
+	UpdateStrategyInPlaceUpdate UpdateStrategyType = "InPlaceUpdate"
+)
+
+// CanaryRollout contains the configuration of a canary rollout of a worker pool.
+type CanaryRollout struct {
+	// Zone is the zone of the worker pool which is rolled first. Defaults to the first zone of the worker pool.
+	// +optional
+	Zone *string `json:"zone,omitempty"`
+	// Machines is the number of machines of the canary zone which are rolled in parallel.
+	// +optional
+	Machines *int32 `json:"machines,omitempty"`
+	// SoakTime is the duration the canary zone must stay healthy after it has been rolled before the remaining
+	// zones are rolled.
+	// +optional
+	SoakTime *metav1.Duration `json:"soakTime,omitempty"`
+}
+
+// ZoneRollout contains the configuration of a rollout of a worker pool zone by zone.
+type ZoneRollout struct {
+	// GracePeriod is the duration a zone must stay healthy after it has been rolled before the next zone is rolled.
+	// +optional
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
 }
 
 // MachineLabel define key value pair to label machines.
@@ -86,4 +470,9 @@ type ServerGroup struct {
 	// Policy describes the kind of affinity policy for instances of the server group.
 	// https://docs.openstack.org/python-openstackclient/ussuri/cli/command-objects/server-group.html
 	Policy string `json:"policy"`
+	// PerZone indicates that a separate server group is created for each availability zone of the worker pool instead
+	// of one for the whole worker pool, e.g. as the number of hosts in a server group with an anti-affinity policy is
+	// limited.
+	// +optional
+	PerZone *bool `json:"perZone,omitempty"`
 }
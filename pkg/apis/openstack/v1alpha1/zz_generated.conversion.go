@@ -26,6 +26,8 @@ import (
 
 	openstack "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	resource "k8s.io/apimachinery/pkg/api/resource"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
@@ -38,6 +40,96 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*AdditionalFloatingPool)(nil), (*openstack.AdditionalFloatingPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AdditionalFloatingPool_To_openstack_AdditionalFloatingPool(a.(*AdditionalFloatingPool), b.(*openstack.AdditionalFloatingPool), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.AdditionalFloatingPool)(nil), (*AdditionalFloatingPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_AdditionalFloatingPool_To_v1alpha1_AdditionalFloatingPool(a.(*openstack.AdditionalFloatingPool), b.(*AdditionalFloatingPool), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AllowedAddressPair)(nil), (*openstack.AllowedAddressPair)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AllowedAddressPair_To_openstack_AllowedAddressPair(a.(*AllowedAddressPair), b.(*openstack.AllowedAddressPair), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.AllowedAddressPair)(nil), (*AllowedAddressPair)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_AllowedAddressPair_To_v1alpha1_AllowedAddressPair(a.(*openstack.AllowedAddressPair), b.(*AllowedAddressPair), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ApplicationCredential)(nil), (*openstack.ApplicationCredential)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ApplicationCredential_To_openstack_ApplicationCredential(a.(*ApplicationCredential), b.(*openstack.ApplicationCredential), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.ApplicationCredential)(nil), (*ApplicationCredential)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_ApplicationCredential_To_v1alpha1_ApplicationCredential(a.(*openstack.ApplicationCredential), b.(*ApplicationCredential), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AuxiliaryStack)(nil), (*openstack.AuxiliaryStack)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AuxiliaryStack_To_openstack_AuxiliaryStack(a.(*AuxiliaryStack), b.(*openstack.AuxiliaryStack), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.AuxiliaryStack)(nil), (*AuxiliaryStack)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_AuxiliaryStack_To_v1alpha1_AuxiliaryStack(a.(*openstack.AuxiliaryStack), b.(*AuxiliaryStack), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BackupBucketConfig)(nil), (*openstack.BackupBucketConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BackupBucketConfig_To_openstack_BackupBucketConfig(a.(*BackupBucketConfig), b.(*openstack.BackupBucketConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.BackupBucketConfig)(nil), (*BackupBucketConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(a.(*openstack.BackupBucketConfig), b.(*BackupBucketConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BareMetal)(nil), (*openstack.BareMetal)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BareMetal_To_openstack_BareMetal(a.(*BareMetal), b.(*openstack.BareMetal), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.BareMetal)(nil), (*BareMetal)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_BareMetal_To_v1alpha1_BareMetal(a.(*openstack.BareMetal), b.(*BareMetal), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BastionAudit)(nil), (*openstack.BastionAudit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BastionAudit_To_openstack_BastionAudit(a.(*BastionAudit), b.(*openstack.BastionAudit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.BastionAudit)(nil), (*BastionAudit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_BastionAudit_To_v1alpha1_BastionAudit(a.(*openstack.BastionAudit), b.(*BastionAudit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BastionConfig)(nil), (*openstack.BastionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BastionConfig_To_openstack_BastionConfig(a.(*BastionConfig), b.(*openstack.BastionConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.BastionConfig)(nil), (*BastionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_BastionConfig_To_v1alpha1_BastionConfig(a.(*openstack.BastionConfig), b.(*BastionConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CIDRAllocation)(nil), (*openstack.CIDRAllocation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CIDRAllocation_To_openstack_CIDRAllocation(a.(*CIDRAllocation), b.(*openstack.CIDRAllocation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.CIDRAllocation)(nil), (*CIDRAllocation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_CIDRAllocation_To_v1alpha1_CIDRAllocation(a.(*openstack.CIDRAllocation), b.(*CIDRAllocation), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CSIManila)(nil), (*openstack.CSIManila)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CSIManila_To_openstack_CSIManila(a.(*CSIManila), b.(*openstack.CSIManila), scope)
 	}); err != nil {
@@ -48,6 +140,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CanaryRollout)(nil), (*openstack.CanaryRollout)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CanaryRollout_To_openstack_CanaryRollout(a.(*CanaryRollout), b.(*openstack.CanaryRollout), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.CanaryRollout)(nil), (*CanaryRollout)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_CanaryRollout_To_v1alpha1_CanaryRollout(a.(*openstack.CanaryRollout), b.(*CanaryRollout), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CloudControllerManagerConfig)(nil), (*openstack.CloudControllerManagerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CloudControllerManagerConfig_To_openstack_CloudControllerManagerConfig(a.(*CloudControllerManagerConfig), b.(*openstack.CloudControllerManagerConfig), scope)
 	}); err != nil {
@@ -88,6 +190,66 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DataVolume)(nil), (*openstack.DataVolume)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DataVolume_To_openstack_DataVolume(a.(*DataVolume), b.(*openstack.DataVolume), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.DataVolume)(nil), (*DataVolume)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_DataVolume_To_v1alpha1_DataVolume(a.(*openstack.DataVolume), b.(*DataVolume), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DedicatedProject)(nil), (*openstack.DedicatedProject)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DedicatedProject_To_openstack_DedicatedProject(a.(*DedicatedProject), b.(*openstack.DedicatedProject), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.DedicatedProject)(nil), (*DedicatedProject)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_DedicatedProject_To_v1alpha1_DedicatedProject(a.(*openstack.DedicatedProject), b.(*DedicatedProject), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Egress)(nil), (*openstack.Egress)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Egress_To_openstack_Egress(a.(*Egress), b.(*openstack.Egress), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.Egress)(nil), (*Egress)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_Egress_To_v1alpha1_Egress(a.(*openstack.Egress), b.(*Egress), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EndpointOverride)(nil), (*openstack.EndpointOverride)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EndpointOverride_To_openstack_EndpointOverride(a.(*EndpointOverride), b.(*openstack.EndpointOverride), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.EndpointOverride)(nil), (*EndpointOverride)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_EndpointOverride_To_v1alpha1_EndpointOverride(a.(*openstack.EndpointOverride), b.(*EndpointOverride), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ExposureConfig)(nil), (*openstack.ExposureConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ExposureConfig_To_openstack_ExposureConfig(a.(*ExposureConfig), b.(*openstack.ExposureConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.ExposureConfig)(nil), (*ExposureConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_ExposureConfig_To_v1alpha1_ExposureConfig(a.(*openstack.ExposureConfig), b.(*ExposureConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FailedMachine)(nil), (*openstack.FailedMachine)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FailedMachine_To_openstack_FailedMachine(a.(*FailedMachine), b.(*openstack.FailedMachine), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.FailedMachine)(nil), (*FailedMachine)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_FailedMachine_To_v1alpha1_FailedMachine(a.(*openstack.FailedMachine), b.(*FailedMachine), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*FloatingPool)(nil), (*openstack.FloatingPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_FloatingPool_To_openstack_FloatingPool(a.(*FloatingPool), b.(*openstack.FloatingPool), scope)
 	}); err != nil {
@@ -108,6 +270,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*GPUConfig)(nil), (*openstack.GPUConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_GPUConfig_To_openstack_GPUConfig(a.(*GPUConfig), b.(*openstack.GPUConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.GPUConfig)(nil), (*GPUConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_GPUConfig_To_v1alpha1_GPUConfig(a.(*openstack.GPUConfig), b.(*GPUConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*HostPinning)(nil), (*openstack.HostPinning)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_HostPinning_To_openstack_HostPinning(a.(*HostPinning), b.(*openstack.HostPinning), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.HostPinning)(nil), (*HostPinning)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_HostPinning_To_v1alpha1_HostPinning(a.(*openstack.HostPinning), b.(*HostPinning), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*InfrastructureConfig)(nil), (*openstack.InfrastructureConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_InfrastructureConfig_To_openstack_InfrastructureConfig(a.(*InfrastructureConfig), b.(*openstack.InfrastructureConfig), scope)
 	}); err != nil {
@@ -118,13 +300,18 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*InfrastructureStatus)(nil), (*openstack.InfrastructureStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_InfrastructureStatus_To_openstack_InfrastructureStatus(a.(*InfrastructureStatus), b.(*openstack.InfrastructureStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*openstack.InfrastructureStatus)(nil), (*InfrastructureStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(a.(*openstack.InfrastructureStatus), b.(*InfrastructureStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*openstack.InfrastructureStatus)(nil), (*InfrastructureStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_openstack_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(a.(*openstack.InfrastructureStatus), b.(*InfrastructureStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*IntegrityCheckConfig)(nil), (*openstack.IntegrityCheckConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IntegrityCheckConfig_To_openstack_IntegrityCheckConfig(a.(*IntegrityCheckConfig), b.(*openstack.IntegrityCheckConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.IntegrityCheckConfig)(nil), (*IntegrityCheckConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_IntegrityCheckConfig_To_v1alpha1_IntegrityCheckConfig(a.(*openstack.IntegrityCheckConfig), b.(*IntegrityCheckConfig), scope)
 	}); err != nil {
 		return err
 	}
@@ -148,6 +335,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*LoadBalancerClassPolicy)(nil), (*openstack.LoadBalancerClassPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LoadBalancerClassPolicy_To_openstack_LoadBalancerClassPolicy(a.(*LoadBalancerClassPolicy), b.(*openstack.LoadBalancerClassPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.LoadBalancerClassPolicy)(nil), (*LoadBalancerClassPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_LoadBalancerClassPolicy_To_v1alpha1_LoadBalancerClassPolicy(a.(*openstack.LoadBalancerClassPolicy), b.(*LoadBalancerClassPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LoadBalancerConfig)(nil), (*openstack.LoadBalancerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LoadBalancerConfig_To_openstack_LoadBalancerConfig(a.(*LoadBalancerConfig), b.(*openstack.LoadBalancerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.LoadBalancerConfig)(nil), (*LoadBalancerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig(a.(*openstack.LoadBalancerConfig), b.(*LoadBalancerConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*LoadBalancerProvider)(nil), (*openstack.LoadBalancerProvider)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_LoadBalancerProvider_To_openstack_LoadBalancerProvider(a.(*LoadBalancerProvider), b.(*openstack.LoadBalancerProvider), scope)
 	}); err != nil {
@@ -198,6 +405,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MachineTypePrice)(nil), (*openstack.MachineTypePrice)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineTypePrice_To_openstack_MachineTypePrice(a.(*MachineTypePrice), b.(*openstack.MachineTypePrice), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.MachineTypePrice)(nil), (*MachineTypePrice)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_MachineTypePrice_To_v1alpha1_MachineTypePrice(a.(*openstack.MachineTypePrice), b.(*MachineTypePrice), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkAllocation)(nil), (*openstack.NetworkAllocation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkAllocation_To_openstack_NetworkAllocation(a.(*NetworkAllocation), b.(*openstack.NetworkAllocation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.NetworkAllocation)(nil), (*NetworkAllocation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_NetworkAllocation_To_v1alpha1_NetworkAllocation(a.(*openstack.NetworkAllocation), b.(*NetworkAllocation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkAllocationList)(nil), (*openstack.NetworkAllocationList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkAllocationList_To_openstack_NetworkAllocationList(a.(*NetworkAllocationList), b.(*openstack.NetworkAllocationList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.NetworkAllocationList)(nil), (*NetworkAllocationList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_NetworkAllocationList_To_v1alpha1_NetworkAllocationList(a.(*openstack.NetworkAllocationList), b.(*NetworkAllocationList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkAllocationSpec)(nil), (*openstack.NetworkAllocationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkAllocationSpec_To_openstack_NetworkAllocationSpec(a.(*NetworkAllocationSpec), b.(*openstack.NetworkAllocationSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.NetworkAllocationSpec)(nil), (*NetworkAllocationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_NetworkAllocationSpec_To_v1alpha1_NetworkAllocationSpec(a.(*openstack.NetworkAllocationSpec), b.(*NetworkAllocationSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NetworkStatus)(nil), (*openstack.NetworkStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NetworkStatus_To_openstack_NetworkStatus(a.(*NetworkStatus), b.(*openstack.NetworkStatus), scope)
 	}); err != nil {
@@ -218,6 +465,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NodeLabelerConfig)(nil), (*openstack.NodeLabelerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NodeLabelerConfig_To_openstack_NodeLabelerConfig(a.(*NodeLabelerConfig), b.(*openstack.NodeLabelerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.NodeLabelerConfig)(nil), (*NodeLabelerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_NodeLabelerConfig_To_v1alpha1_NodeLabelerConfig(a.(*openstack.NodeLabelerConfig), b.(*NodeLabelerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NodePorts)(nil), (*openstack.NodePorts)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NodePorts_To_openstack_NodePorts(a.(*NodePorts), b.(*openstack.NodePorts), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.NodePorts)(nil), (*NodePorts)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_NodePorts_To_v1alpha1_NodePorts(a.(*openstack.NodePorts), b.(*NodePorts), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NodeStatus)(nil), (*openstack.NodeStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NodeStatus_To_openstack_NodeStatus(a.(*NodeStatus), b.(*openstack.NodeStatus), scope)
 	}); err != nil {
@@ -228,6 +495,86 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NodeSubnet)(nil), (*openstack.NodeSubnet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NodeSubnet_To_openstack_NodeSubnet(a.(*NodeSubnet), b.(*openstack.NodeSubnet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.NodeSubnet)(nil), (*NodeSubnet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_NodeSubnet_To_v1alpha1_NodeSubnet(a.(*openstack.NodeSubnet), b.(*NodeSubnet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OpenStackMaintenance)(nil), (*openstack.OpenStackMaintenance)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OpenStackMaintenance_To_openstack_OpenStackMaintenance(a.(*OpenStackMaintenance), b.(*openstack.OpenStackMaintenance), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.OpenStackMaintenance)(nil), (*OpenStackMaintenance)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_OpenStackMaintenance_To_v1alpha1_OpenStackMaintenance(a.(*openstack.OpenStackMaintenance), b.(*OpenStackMaintenance), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OpenStackMaintenanceList)(nil), (*openstack.OpenStackMaintenanceList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OpenStackMaintenanceList_To_openstack_OpenStackMaintenanceList(a.(*OpenStackMaintenanceList), b.(*openstack.OpenStackMaintenanceList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.OpenStackMaintenanceList)(nil), (*OpenStackMaintenanceList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_OpenStackMaintenanceList_To_v1alpha1_OpenStackMaintenanceList(a.(*openstack.OpenStackMaintenanceList), b.(*OpenStackMaintenanceList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OpenStackMaintenanceSpec)(nil), (*openstack.OpenStackMaintenanceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OpenStackMaintenanceSpec_To_openstack_OpenStackMaintenanceSpec(a.(*OpenStackMaintenanceSpec), b.(*openstack.OpenStackMaintenanceSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.OpenStackMaintenanceSpec)(nil), (*OpenStackMaintenanceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_OpenStackMaintenanceSpec_To_v1alpha1_OpenStackMaintenanceSpec(a.(*openstack.OpenStackMaintenanceSpec), b.(*OpenStackMaintenanceSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OpenStackMaintenanceStatus)(nil), (*openstack.OpenStackMaintenanceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OpenStackMaintenanceStatus_To_openstack_OpenStackMaintenanceStatus(a.(*OpenStackMaintenanceStatus), b.(*openstack.OpenStackMaintenanceStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.OpenStackMaintenanceStatus)(nil), (*OpenStackMaintenanceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_OpenStackMaintenanceStatus_To_v1alpha1_OpenStackMaintenanceStatus(a.(*openstack.OpenStackMaintenanceStatus), b.(*OpenStackMaintenanceStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Pricing)(nil), (*openstack.Pricing)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Pricing_To_openstack_Pricing(a.(*Pricing), b.(*openstack.Pricing), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.Pricing)(nil), (*Pricing)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_Pricing_To_v1alpha1_Pricing(a.(*openstack.Pricing), b.(*Pricing), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProjectStatus)(nil), (*openstack.ProjectStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ProjectStatus_To_openstack_ProjectStatus(a.(*ProjectStatus), b.(*openstack.ProjectStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.ProjectStatus)(nil), (*ProjectStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_ProjectStatus_To_v1alpha1_ProjectStatus(a.(*openstack.ProjectStatus), b.(*ProjectStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RegionConfig)(nil), (*openstack.RegionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RegionConfig_To_openstack_RegionConfig(a.(*RegionConfig), b.(*openstack.RegionConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.RegionConfig)(nil), (*RegionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_RegionConfig_To_v1alpha1_RegionConfig(a.(*openstack.RegionConfig), b.(*RegionConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*RegionIDMapping)(nil), (*openstack.RegionIDMapping)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_RegionIDMapping_To_openstack_RegionIDMapping(a.(*RegionIDMapping), b.(*openstack.RegionIDMapping), scope)
 	}); err != nil {
@@ -238,6 +585,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ReservedAggregate)(nil), (*openstack.ReservedAggregate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ReservedAggregate_To_openstack_ReservedAggregate(a.(*ReservedAggregate), b.(*openstack.ReservedAggregate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.ReservedAggregate)(nil), (*ReservedAggregate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_ReservedAggregate_To_v1alpha1_ReservedAggregate(a.(*openstack.ReservedAggregate), b.(*ReservedAggregate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RootVolume)(nil), (*openstack.RootVolume)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RootVolume_To_openstack_RootVolume(a.(*RootVolume), b.(*openstack.RootVolume), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.RootVolume)(nil), (*RootVolume)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_RootVolume_To_v1alpha1_RootVolume(a.(*openstack.RootVolume), b.(*RootVolume), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Router)(nil), (*openstack.Router)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Router_To_openstack_Router(a.(*Router), b.(*openstack.Router), scope)
 	}); err != nil {
@@ -268,6 +635,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SecurityGroupRules)(nil), (*openstack.SecurityGroupRules)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SecurityGroupRules_To_openstack_SecurityGroupRules(a.(*SecurityGroupRules), b.(*openstack.SecurityGroupRules), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.SecurityGroupRules)(nil), (*SecurityGroupRules)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_SecurityGroupRules_To_v1alpha1_SecurityGroupRules(a.(*openstack.SecurityGroupRules), b.(*SecurityGroupRules), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ServerGroup)(nil), (*openstack.ServerGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ServerGroup_To_openstack_ServerGroup(a.(*ServerGroup), b.(*openstack.ServerGroup), scope)
 	}); err != nil {
@@ -288,6 +665,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ServerMetadata)(nil), (*openstack.ServerMetadata)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ServerMetadata_To_openstack_ServerMetadata(a.(*ServerMetadata), b.(*openstack.ServerMetadata), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.ServerMetadata)(nil), (*ServerMetadata)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_ServerMetadata_To_v1alpha1_ServerMetadata(a.(*openstack.ServerMetadata), b.(*ServerMetadata), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShareNetwork)(nil), (*openstack.ShareNetwork)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ShareNetwork_To_openstack_ShareNetwork(a.(*ShareNetwork), b.(*openstack.ShareNetwork), scope)
 	}); err != nil {
@@ -308,6 +695,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SharedNetwork)(nil), (*openstack.SharedNetwork)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SharedNetwork_To_openstack_SharedNetwork(a.(*SharedNetwork), b.(*openstack.SharedNetwork), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.SharedNetwork)(nil), (*SharedNetwork)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_SharedNetwork_To_v1alpha1_SharedNetwork(a.(*openstack.SharedNetwork), b.(*SharedNetwork), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Storage)(nil), (*openstack.Storage)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Storage_To_openstack_Storage(a.(*Storage), b.(*openstack.Storage), scope)
 	}); err != nil {
@@ -338,33 +735,325 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*WorkerConfig)(nil), (*openstack.WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_WorkerConfig_To_openstack_WorkerConfig(a.(*WorkerConfig), b.(*openstack.WorkerConfig), scope)
+	if err := s.AddGeneratedConversionFunc((*TempURLConfig)(nil), (*openstack.TempURLConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TempURLConfig_To_openstack_TempURLConfig(a.(*TempURLConfig), b.(*openstack.TempURLConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*openstack.WorkerConfig)(nil), (*WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_openstack_WorkerConfig_To_v1alpha1_WorkerConfig(a.(*openstack.WorkerConfig), b.(*WorkerConfig), scope)
+	if err := s.AddGeneratedConversionFunc((*openstack.TempURLConfig)(nil), (*TempURLConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_TempURLConfig_To_v1alpha1_TempURLConfig(a.(*openstack.TempURLConfig), b.(*TempURLConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*WorkerStatus)(nil), (*openstack.WorkerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_WorkerStatus_To_openstack_WorkerStatus(a.(*WorkerStatus), b.(*openstack.WorkerStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*TemplateReference)(nil), (*openstack.TemplateReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TemplateReference_To_openstack_TemplateReference(a.(*TemplateReference), b.(*openstack.TemplateReference), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*openstack.WorkerStatus)(nil), (*WorkerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_openstack_WorkerStatus_To_v1alpha1_WorkerStatus(a.(*openstack.WorkerStatus), b.(*WorkerStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*openstack.TemplateReference)(nil), (*TemplateReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_TemplateReference_To_v1alpha1_TemplateReference(a.(*openstack.TemplateReference), b.(*TemplateReference), scope)
 	}); err != nil {
 		return err
 	}
-	return nil
-}
-
-func autoConvert_v1alpha1_CSIManila_To_openstack_CSIManila(in *CSIManila, out *openstack.CSIManila, s conversion.Scope) error {
-	out.Enabled = in.Enabled
-	return nil
-}
+	if err := s.AddGeneratedConversionFunc((*VGPU)(nil), (*openstack.VGPU)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VGPU_To_openstack_VGPU(a.(*VGPU), b.(*openstack.VGPU), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.VGPU)(nil), (*VGPU)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_VGPU_To_v1alpha1_VGPU(a.(*openstack.VGPU), b.(*VGPU), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VerifiedImage)(nil), (*openstack.VerifiedImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VerifiedImage_To_openstack_VerifiedImage(a.(*VerifiedImage), b.(*openstack.VerifiedImage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.VerifiedImage)(nil), (*VerifiedImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_VerifiedImage_To_v1alpha1_VerifiedImage(a.(*openstack.VerifiedImage), b.(*VerifiedImage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VolumeTypePrice)(nil), (*openstack.VolumeTypePrice)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VolumeTypePrice_To_openstack_VolumeTypePrice(a.(*VolumeTypePrice), b.(*openstack.VolumeTypePrice), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.VolumeTypePrice)(nil), (*VolumeTypePrice)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_VolumeTypePrice_To_v1alpha1_VolumeTypePrice(a.(*openstack.VolumeTypePrice), b.(*VolumeTypePrice), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerConfig)(nil), (*openstack.WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerConfig_To_openstack_WorkerConfig(a.(*WorkerConfig), b.(*openstack.WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.WorkerConfig)(nil), (*WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_WorkerConfig_To_v1alpha1_WorkerConfig(a.(*openstack.WorkerConfig), b.(*WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerNetwork)(nil), (*openstack.WorkerNetwork)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerNetwork_To_openstack_WorkerNetwork(a.(*WorkerNetwork), b.(*openstack.WorkerNetwork), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.WorkerNetwork)(nil), (*WorkerNetwork)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_WorkerNetwork_To_v1alpha1_WorkerNetwork(a.(*openstack.WorkerNetwork), b.(*WorkerNetwork), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerPoolStatus)(nil), (*openstack.WorkerPoolStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerPoolStatus_To_openstack_WorkerPoolStatus(a.(*WorkerPoolStatus), b.(*openstack.WorkerPoolStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.WorkerPoolStatus)(nil), (*WorkerPoolStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_WorkerPoolStatus_To_v1alpha1_WorkerPoolStatus(a.(*openstack.WorkerPoolStatus), b.(*WorkerPoolStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.WorkerStatus)(nil), (*WorkerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_WorkerStatus_To_v1alpha1_WorkerStatus(a.(*openstack.WorkerStatus), b.(*WorkerStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ZoneRollout)(nil), (*openstack.ZoneRollout)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ZoneRollout_To_openstack_ZoneRollout(a.(*ZoneRollout), b.(*openstack.ZoneRollout), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.ZoneRollout)(nil), (*ZoneRollout)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_ZoneRollout_To_v1alpha1_ZoneRollout(a.(*openstack.ZoneRollout), b.(*ZoneRollout), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*InfrastructureStatus)(nil), (*openstack.InfrastructureStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InfrastructureStatus_To_openstack_InfrastructureStatus(a.(*InfrastructureStatus), b.(*openstack.InfrastructureStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*WorkerStatus)(nil), (*openstack.WorkerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerStatus_To_openstack_WorkerStatus(a.(*WorkerStatus), b.(*openstack.WorkerStatus), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_AdditionalFloatingPool_To_openstack_AdditionalFloatingPool(in *AdditionalFloatingPool, out *openstack.AdditionalFloatingPool, s conversion.Scope) error {
+	out.Name = in.Name
+	out.LoadBalancerClass = in.LoadBalancerClass
+	out.FloatingSubnetName = (*string)(unsafe.Pointer(in.FloatingSubnetName))
+	return nil
+}
+
+// Convert_v1alpha1_AdditionalFloatingPool_To_openstack_AdditionalFloatingPool is an autogenerated conversion function.
+func Convert_v1alpha1_AdditionalFloatingPool_To_openstack_AdditionalFloatingPool(in *AdditionalFloatingPool, out *openstack.AdditionalFloatingPool, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AdditionalFloatingPool_To_openstack_AdditionalFloatingPool(in, out, s)
+}
+
+func autoConvert_openstack_AdditionalFloatingPool_To_v1alpha1_AdditionalFloatingPool(in *openstack.AdditionalFloatingPool, out *AdditionalFloatingPool, s conversion.Scope) error {
+	out.Name = in.Name
+	out.LoadBalancerClass = in.LoadBalancerClass
+	out.FloatingSubnetName = (*string)(unsafe.Pointer(in.FloatingSubnetName))
+	return nil
+}
+
+// Convert_openstack_AdditionalFloatingPool_To_v1alpha1_AdditionalFloatingPool is an autogenerated conversion function.
+func Convert_openstack_AdditionalFloatingPool_To_v1alpha1_AdditionalFloatingPool(in *openstack.AdditionalFloatingPool, out *AdditionalFloatingPool, s conversion.Scope) error {
+	return autoConvert_openstack_AdditionalFloatingPool_To_v1alpha1_AdditionalFloatingPool(in, out, s)
+}
+
+func autoConvert_v1alpha1_AllowedAddressPair_To_openstack_AllowedAddressPair(in *AllowedAddressPair, out *openstack.AllowedAddressPair, s conversion.Scope) error {
+	out.IPAddress = in.IPAddress
+	out.MACAddress = (*string)(unsafe.Pointer(in.MACAddress))
+	return nil
+}
+
+// Convert_v1alpha1_AllowedAddressPair_To_openstack_AllowedAddressPair is an autogenerated conversion function.
+func Convert_v1alpha1_AllowedAddressPair_To_openstack_AllowedAddressPair(in *AllowedAddressPair, out *openstack.AllowedAddressPair, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AllowedAddressPair_To_openstack_AllowedAddressPair(in, out, s)
+}
+
+func autoConvert_openstack_AllowedAddressPair_To_v1alpha1_AllowedAddressPair(in *openstack.AllowedAddressPair, out *AllowedAddressPair, s conversion.Scope) error {
+	out.IPAddress = in.IPAddress
+	out.MACAddress = (*string)(unsafe.Pointer(in.MACAddress))
+	return nil
+}
+
+// Convert_openstack_AllowedAddressPair_To_v1alpha1_AllowedAddressPair is an autogenerated conversion function.
+func Convert_openstack_AllowedAddressPair_To_v1alpha1_AllowedAddressPair(in *openstack.AllowedAddressPair, out *AllowedAddressPair, s conversion.Scope) error {
+	return autoConvert_openstack_AllowedAddressPair_To_v1alpha1_AllowedAddressPair(in, out, s)
+}
+
+func autoConvert_v1alpha1_ApplicationCredential_To_openstack_ApplicationCredential(in *ApplicationCredential, out *openstack.ApplicationCredential, s conversion.Scope) error {
+	out.Roles = *(*[]string)(unsafe.Pointer(&in.Roles))
+	return nil
+}
+
+// Convert_v1alpha1_ApplicationCredential_To_openstack_ApplicationCredential is an autogenerated conversion function.
+func Convert_v1alpha1_ApplicationCredential_To_openstack_ApplicationCredential(in *ApplicationCredential, out *openstack.ApplicationCredential, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ApplicationCredential_To_openstack_ApplicationCredential(in, out, s)
+}
+
+func autoConvert_openstack_ApplicationCredential_To_v1alpha1_ApplicationCredential(in *openstack.ApplicationCredential, out *ApplicationCredential, s conversion.Scope) error {
+	out.Roles = *(*[]string)(unsafe.Pointer(&in.Roles))
+	return nil
+}
+
+// Convert_openstack_ApplicationCredential_To_v1alpha1_ApplicationCredential is an autogenerated conversion function.
+func Convert_openstack_ApplicationCredential_To_v1alpha1_ApplicationCredential(in *openstack.ApplicationCredential, out *ApplicationCredential, s conversion.Scope) error {
+	return autoConvert_openstack_ApplicationCredential_To_v1alpha1_ApplicationCredential(in, out, s)
+}
+
+func autoConvert_v1alpha1_AuxiliaryStack_To_openstack_AuxiliaryStack(in *AuxiliaryStack, out *openstack.AuxiliaryStack, s conversion.Scope) error {
+	if err := Convert_v1alpha1_TemplateReference_To_openstack_TemplateReference(&in.TemplateRef, &out.TemplateRef, s); err != nil {
+		return err
+	}
+	out.Parameters = *(*map[string]string)(unsafe.Pointer(&in.Parameters))
+	return nil
+}
+
+// Convert_v1alpha1_AuxiliaryStack_To_openstack_AuxiliaryStack is an autogenerated conversion function.
+func Convert_v1alpha1_AuxiliaryStack_To_openstack_AuxiliaryStack(in *AuxiliaryStack, out *openstack.AuxiliaryStack, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AuxiliaryStack_To_openstack_AuxiliaryStack(in, out, s)
+}
+
+func autoConvert_openstack_AuxiliaryStack_To_v1alpha1_AuxiliaryStack(in *openstack.AuxiliaryStack, out *AuxiliaryStack, s conversion.Scope) error {
+	if err := Convert_openstack_TemplateReference_To_v1alpha1_TemplateReference(&in.TemplateRef, &out.TemplateRef, s); err != nil {
+		return err
+	}
+	out.Parameters = *(*map[string]string)(unsafe.Pointer(&in.Parameters))
+	return nil
+}
+
+// Convert_openstack_AuxiliaryStack_To_v1alpha1_AuxiliaryStack is an autogenerated conversion function.
+func Convert_openstack_AuxiliaryStack_To_v1alpha1_AuxiliaryStack(in *openstack.AuxiliaryStack, out *AuxiliaryStack, s conversion.Scope) error {
+	return autoConvert_openstack_AuxiliaryStack_To_v1alpha1_AuxiliaryStack(in, out, s)
+}
+
+func autoConvert_v1alpha1_BackupBucketConfig_To_openstack_BackupBucketConfig(in *BackupBucketConfig, out *openstack.BackupBucketConfig, s conversion.Scope) error {
+	out.SegmentSize = (*resource.Quantity)(unsafe.Pointer(in.SegmentSize))
+	out.TempURL = (*openstack.TempURLConfig)(unsafe.Pointer(in.TempURL))
+	out.IntegrityCheck = (*openstack.IntegrityCheckConfig)(unsafe.Pointer(in.IntegrityCheck))
+	out.CredentialsSecretName = (*string)(unsafe.Pointer(in.CredentialsSecretName))
+	return nil
+}
+
+// Convert_v1alpha1_BackupBucketConfig_To_openstack_BackupBucketConfig is an autogenerated conversion function.
+func Convert_v1alpha1_BackupBucketConfig_To_openstack_BackupBucketConfig(in *BackupBucketConfig, out *openstack.BackupBucketConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BackupBucketConfig_To_openstack_BackupBucketConfig(in, out, s)
+}
+
+func autoConvert_openstack_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in *openstack.BackupBucketConfig, out *BackupBucketConfig, s conversion.Scope) error {
+	out.SegmentSize = (*resource.Quantity)(unsafe.Pointer(in.SegmentSize))
+	out.TempURL = (*TempURLConfig)(unsafe.Pointer(in.TempURL))
+	out.IntegrityCheck = (*IntegrityCheckConfig)(unsafe.Pointer(in.IntegrityCheck))
+	out.CredentialsSecretName = (*string)(unsafe.Pointer(in.CredentialsSecretName))
+	return nil
+}
+
+// Convert_openstack_BackupBucketConfig_To_v1alpha1_BackupBucketConfig is an autogenerated conversion function.
+func Convert_openstack_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in *openstack.BackupBucketConfig, out *BackupBucketConfig, s conversion.Scope) error {
+	return autoConvert_openstack_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_BareMetal_To_openstack_BareMetal(in *BareMetal, out *openstack.BareMetal, s conversion.Scope) error {
+	out.IgnoreAvailabilityZones = (*bool)(unsafe.Pointer(in.IgnoreAvailabilityZones))
+	out.Capabilities = *(*map[string]string)(unsafe.Pointer(&in.Capabilities))
+	return nil
+}
+
+// Convert_v1alpha1_BareMetal_To_openstack_BareMetal is an autogenerated conversion function.
+func Convert_v1alpha1_BareMetal_To_openstack_BareMetal(in *BareMetal, out *openstack.BareMetal, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BareMetal_To_openstack_BareMetal(in, out, s)
+}
+
+func autoConvert_openstack_BareMetal_To_v1alpha1_BareMetal(in *openstack.BareMetal, out *BareMetal, s conversion.Scope) error {
+	out.IgnoreAvailabilityZones = (*bool)(unsafe.Pointer(in.IgnoreAvailabilityZones))
+	out.Capabilities = *(*map[string]string)(unsafe.Pointer(&in.Capabilities))
+	return nil
+}
+
+// Convert_openstack_BareMetal_To_v1alpha1_BareMetal is an autogenerated conversion function.
+func Convert_openstack_BareMetal_To_v1alpha1_BareMetal(in *openstack.BareMetal, out *BareMetal, s conversion.Scope) error {
+	return autoConvert_openstack_BareMetal_To_v1alpha1_BareMetal(in, out, s)
+}
+
+func autoConvert_v1alpha1_BastionAudit_To_openstack_BastionAudit(in *BastionAudit, out *openstack.BastionAudit, s conversion.Scope) error {
+	out.LogLevel = (*string)(unsafe.Pointer(in.LogLevel))
+	out.ForceCommand = (*string)(unsafe.Pointer(in.ForceCommand))
+	out.UserData = (*string)(unsafe.Pointer(in.UserData))
+	return nil
+}
+
+// Convert_v1alpha1_BastionAudit_To_openstack_BastionAudit is an autogenerated conversion function.
+func Convert_v1alpha1_BastionAudit_To_openstack_BastionAudit(in *BastionAudit, out *openstack.BastionAudit, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BastionAudit_To_openstack_BastionAudit(in, out, s)
+}
+
+func autoConvert_openstack_BastionAudit_To_v1alpha1_BastionAudit(in *openstack.BastionAudit, out *BastionAudit, s conversion.Scope) error {
+	out.LogLevel = (*string)(unsafe.Pointer(in.LogLevel))
+	out.ForceCommand = (*string)(unsafe.Pointer(in.ForceCommand))
+	out.UserData = (*string)(unsafe.Pointer(in.UserData))
+	return nil
+}
+
+// Convert_openstack_BastionAudit_To_v1alpha1_BastionAudit is an autogenerated conversion function.
+func Convert_openstack_BastionAudit_To_v1alpha1_BastionAudit(in *openstack.BastionAudit, out *BastionAudit, s conversion.Scope) error {
+	return autoConvert_openstack_BastionAudit_To_v1alpha1_BastionAudit(in, out, s)
+}
+
+func autoConvert_v1alpha1_BastionConfig_To_openstack_BastionConfig(in *BastionConfig, out *openstack.BastionConfig, s conversion.Scope) error {
+	out.Audit = (*openstack.BastionAudit)(unsafe.Pointer(in.Audit))
+	return nil
+}
+
+// Convert_v1alpha1_BastionConfig_To_openstack_BastionConfig is an autogenerated conversion function.
+func Convert_v1alpha1_BastionConfig_To_openstack_BastionConfig(in *BastionConfig, out *openstack.BastionConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BastionConfig_To_openstack_BastionConfig(in, out, s)
+}
+
+func autoConvert_openstack_BastionConfig_To_v1alpha1_BastionConfig(in *openstack.BastionConfig, out *BastionConfig, s conversion.Scope) error {
+	out.Audit = (*BastionAudit)(unsafe.Pointer(in.Audit))
+	return nil
+}
+
+// Convert_openstack_BastionConfig_To_v1alpha1_BastionConfig is an autogenerated conversion function.
+func Convert_openstack_BastionConfig_To_v1alpha1_BastionConfig(in *openstack.BastionConfig, out *BastionConfig, s conversion.Scope) error {
+	return autoConvert_openstack_BastionConfig_To_v1alpha1_BastionConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_CIDRAllocation_To_openstack_CIDRAllocation(in *CIDRAllocation, out *openstack.CIDRAllocation, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.CIDR = in.CIDR
+	return nil
+}
+
+// Convert_v1alpha1_CIDRAllocation_To_openstack_CIDRAllocation is an autogenerated conversion function.
+func Convert_v1alpha1_CIDRAllocation_To_openstack_CIDRAllocation(in *CIDRAllocation, out *openstack.CIDRAllocation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CIDRAllocation_To_openstack_CIDRAllocation(in, out, s)
+}
+
+func autoConvert_openstack_CIDRAllocation_To_v1alpha1_CIDRAllocation(in *openstack.CIDRAllocation, out *CIDRAllocation, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.CIDR = in.CIDR
+	return nil
+}
+
+// Convert_openstack_CIDRAllocation_To_v1alpha1_CIDRAllocation is an autogenerated conversion function.
+func Convert_openstack_CIDRAllocation_To_v1alpha1_CIDRAllocation(in *openstack.CIDRAllocation, out *CIDRAllocation, s conversion.Scope) error {
+	return autoConvert_openstack_CIDRAllocation_To_v1alpha1_CIDRAllocation(in, out, s)
+}
+
+func autoConvert_v1alpha1_CSIManila_To_openstack_CSIManila(in *CSIManila, out *openstack.CSIManila, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.ShareClient = (*string)(unsafe.Pointer(in.ShareClient))
+	return nil
+}
 
 // Convert_v1alpha1_CSIManila_To_openstack_CSIManila is an autogenerated conversion function.
 func Convert_v1alpha1_CSIManila_To_openstack_CSIManila(in *CSIManila, out *openstack.CSIManila, s conversion.Scope) error {
@@ -373,6 +1062,7 @@ func Convert_v1alpha1_CSIManila_To_openstack_CSIManila(in *CSIManila, out *opens
 
 func autoConvert_openstack_CSIManila_To_v1alpha1_CSIManila(in *openstack.CSIManila, out *CSIManila, s conversion.Scope) error {
 	out.Enabled = in.Enabled
+	out.ShareClient = (*string)(unsafe.Pointer(in.ShareClient))
 	return nil
 }
 
@@ -381,6 +1071,30 @@ func Convert_openstack_CSIManila_To_v1alpha1_CSIManila(in *openstack.CSIManila,
 	return autoConvert_openstack_CSIManila_To_v1alpha1_CSIManila(in, out, s)
 }
 
+func autoConvert_v1alpha1_CanaryRollout_To_openstack_CanaryRollout(in *CanaryRollout, out *openstack.CanaryRollout, s conversion.Scope) error {
+	out.Zone = (*string)(unsafe.Pointer(in.Zone))
+	out.Machines = (*int32)(unsafe.Pointer(in.Machines))
+	out.SoakTime = (*v1.Duration)(unsafe.Pointer(in.SoakTime))
+	return nil
+}
+
+// Convert_v1alpha1_CanaryRollout_To_openstack_CanaryRollout is an autogenerated conversion function.
+func Convert_v1alpha1_CanaryRollout_To_openstack_CanaryRollout(in *CanaryRollout, out *openstack.CanaryRollout, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CanaryRollout_To_openstack_CanaryRollout(in, out, s)
+}
+
+func autoConvert_openstack_CanaryRollout_To_v1alpha1_CanaryRollout(in *openstack.CanaryRollout, out *CanaryRollout, s conversion.Scope) error {
+	out.Zone = (*string)(unsafe.Pointer(in.Zone))
+	out.Machines = (*int32)(unsafe.Pointer(in.Machines))
+	out.SoakTime = (*v1.Duration)(unsafe.Pointer(in.SoakTime))
+	return nil
+}
+
+// Convert_openstack_CanaryRollout_To_v1alpha1_CanaryRollout is an autogenerated conversion function.
+func Convert_openstack_CanaryRollout_To_v1alpha1_CanaryRollout(in *openstack.CanaryRollout, out *CanaryRollout, s conversion.Scope) error {
+	return autoConvert_openstack_CanaryRollout_To_v1alpha1_CanaryRollout(in, out, s)
+}
+
 func autoConvert_v1alpha1_CloudControllerManagerConfig_To_openstack_CloudControllerManagerConfig(in *CloudControllerManagerConfig, out *openstack.CloudControllerManagerConfig, s conversion.Scope) error {
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
 	return nil
@@ -411,6 +1125,7 @@ func autoConvert_v1alpha1_CloudProfileConfig_To_openstack_CloudProfileConfig(in
 	out.KeyStoneCACert = (*string)(unsafe.Pointer(in.KeyStoneCACert))
 	out.KeyStoneForceInsecure = in.KeyStoneForceInsecure
 	out.KeyStoneURLs = *(*[]openstack.KeyStoneURL)(unsafe.Pointer(&in.KeyStoneURLs))
+	out.EndpointOverrides = *(*[]openstack.EndpointOverride)(unsafe.Pointer(&in.EndpointOverrides))
 	out.MachineImages = *(*[]openstack.MachineImages)(unsafe.Pointer(&in.MachineImages))
 	out.RequestTimeout = (*v1.Duration)(unsafe.Pointer(in.RequestTimeout))
 	out.RescanBlockStorageOnResize = (*bool)(unsafe.Pointer(in.RescanBlockStorageOnResize))
@@ -421,6 +1136,10 @@ func autoConvert_v1alpha1_CloudProfileConfig_To_openstack_CloudProfileConfig(in
 	out.ServerGroupPolicies = *(*[]string)(unsafe.Pointer(&in.ServerGroupPolicies))
 	out.ResolvConfOptions = *(*[]string)(unsafe.Pointer(&in.ResolvConfOptions))
 	out.StorageClasses = *(*[]openstack.StorageClassDefinition)(unsafe.Pointer(&in.StorageClasses))
+	out.Regions = *(*[]openstack.RegionConfig)(unsafe.Pointer(&in.Regions))
+	out.Pricing = (*openstack.Pricing)(unsafe.Pointer(in.Pricing))
+	out.Bastion = (*openstack.BastionConfig)(unsafe.Pointer(in.Bastion))
+	out.GPU = (*openstack.GPUConfig)(unsafe.Pointer(in.GPU))
 	return nil
 }
 
@@ -439,6 +1158,7 @@ func autoConvert_openstack_CloudProfileConfig_To_v1alpha1_CloudProfileConfig(in
 	out.KeyStoneCACert = (*string)(unsafe.Pointer(in.KeyStoneCACert))
 	out.KeyStoneForceInsecure = in.KeyStoneForceInsecure
 	out.KeyStoneURLs = *(*[]KeyStoneURL)(unsafe.Pointer(&in.KeyStoneURLs))
+	out.EndpointOverrides = *(*[]EndpointOverride)(unsafe.Pointer(&in.EndpointOverrides))
 	out.MachineImages = *(*[]MachineImages)(unsafe.Pointer(&in.MachineImages))
 	out.RequestTimeout = (*v1.Duration)(unsafe.Pointer(in.RequestTimeout))
 	out.RescanBlockStorageOnResize = (*bool)(unsafe.Pointer(in.RescanBlockStorageOnResize))
@@ -449,6 +1169,10 @@ func autoConvert_openstack_CloudProfileConfig_To_v1alpha1_CloudProfileConfig(in
 	out.ServerGroupPolicies = *(*[]string)(unsafe.Pointer(&in.ServerGroupPolicies))
 	out.ResolvConfOptions = *(*[]string)(unsafe.Pointer(&in.ResolvConfOptions))
 	out.StorageClasses = *(*[]StorageClassDefinition)(unsafe.Pointer(&in.StorageClasses))
+	out.Regions = *(*[]RegionConfig)(unsafe.Pointer(&in.Regions))
+	out.Pricing = (*Pricing)(unsafe.Pointer(in.Pricing))
+	out.Bastion = (*BastionConfig)(unsafe.Pointer(in.Bastion))
+	out.GPU = (*GPUConfig)(unsafe.Pointer(in.GPU))
 	return nil
 }
 
@@ -460,6 +1184,7 @@ func Convert_openstack_CloudProfileConfig_To_v1alpha1_CloudProfileConfig(in *ope
 func autoConvert_v1alpha1_Constraints_To_openstack_Constraints(in *Constraints, out *openstack.Constraints, s conversion.Scope) error {
 	out.FloatingPools = *(*[]openstack.FloatingPool)(unsafe.Pointer(&in.FloatingPools))
 	out.LoadBalancerProviders = *(*[]openstack.LoadBalancerProvider)(unsafe.Pointer(&in.LoadBalancerProviders))
+	out.LoadBalancerClassPolicies = *(*[]openstack.LoadBalancerClassPolicy)(unsafe.Pointer(&in.LoadBalancerClassPolicies))
 	return nil
 }
 
@@ -471,6 +1196,7 @@ func Convert_v1alpha1_Constraints_To_openstack_Constraints(in *Constraints, out
 func autoConvert_openstack_Constraints_To_v1alpha1_Constraints(in *openstack.Constraints, out *Constraints, s conversion.Scope) error {
 	out.FloatingPools = *(*[]FloatingPool)(unsafe.Pointer(&in.FloatingPools))
 	out.LoadBalancerProviders = *(*[]LoadBalancerProvider)(unsafe.Pointer(&in.LoadBalancerProviders))
+	out.LoadBalancerClassPolicies = *(*[]LoadBalancerClassPolicy)(unsafe.Pointer(&in.LoadBalancerClassPolicies))
 	return nil
 }
 
@@ -485,6 +1211,10 @@ func autoConvert_v1alpha1_ControlPlaneConfig_To_openstack_ControlPlaneConfig(in
 	out.LoadBalancerProvider = in.LoadBalancerProvider
 	out.Zone = (*string)(unsafe.Pointer(in.Zone))
 	out.Storage = (*openstack.Storage)(unsafe.Pointer(in.Storage))
+	out.LoadBalancer = (*openstack.LoadBalancerConfig)(unsafe.Pointer(in.LoadBalancer))
+	out.KubeProxyReplacement = (*bool)(unsafe.Pointer(in.KubeProxyReplacement))
+	out.NodeLabeler = (*openstack.NodeLabelerConfig)(unsafe.Pointer(in.NodeLabeler))
+	out.Exposure = (*openstack.ExposureConfig)(unsafe.Pointer(in.Exposure))
 	return nil
 }
 
@@ -499,6 +1229,10 @@ func autoConvert_openstack_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in
 	out.LoadBalancerProvider = in.LoadBalancerProvider
 	out.Zone = (*string)(unsafe.Pointer(in.Zone))
 	out.Storage = (*Storage)(unsafe.Pointer(in.Storage))
+	out.LoadBalancer = (*LoadBalancerConfig)(unsafe.Pointer(in.LoadBalancer))
+	out.KubeProxyReplacement = (*bool)(unsafe.Pointer(in.KubeProxyReplacement))
+	out.NodeLabeler = (*NodeLabelerConfig)(unsafe.Pointer(in.NodeLabeler))
+	out.Exposure = (*ExposureConfig)(unsafe.Pointer(in.Exposure))
 	return nil
 }
 
@@ -507,6 +1241,154 @@ func Convert_openstack_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *ope
 	return autoConvert_openstack_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_DataVolume_To_openstack_DataVolume(in *DataVolume, out *openstack.DataVolume, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Size = in.Size
+	out.Type = (*string)(unsafe.Pointer(in.Type))
+	out.InheritAvailabilityZone = (*bool)(unsafe.Pointer(in.InheritAvailabilityZone))
+	out.DeleteOnTermination = (*bool)(unsafe.Pointer(in.DeleteOnTermination))
+	return nil
+}
+
+// Convert_v1alpha1_DataVolume_To_openstack_DataVolume is an autogenerated conversion function.
+func Convert_v1alpha1_DataVolume_To_openstack_DataVolume(in *DataVolume, out *openstack.DataVolume, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DataVolume_To_openstack_DataVolume(in, out, s)
+}
+
+func autoConvert_openstack_DataVolume_To_v1alpha1_DataVolume(in *openstack.DataVolume, out *DataVolume, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Size = in.Size
+	out.Type = (*string)(unsafe.Pointer(in.Type))
+	out.InheritAvailabilityZone = (*bool)(unsafe.Pointer(in.InheritAvailabilityZone))
+	out.DeleteOnTermination = (*bool)(unsafe.Pointer(in.DeleteOnTermination))
+	return nil
+}
+
+// Convert_openstack_DataVolume_To_v1alpha1_DataVolume is an autogenerated conversion function.
+func Convert_openstack_DataVolume_To_v1alpha1_DataVolume(in *openstack.DataVolume, out *DataVolume, s conversion.Scope) error {
+	return autoConvert_openstack_DataVolume_To_v1alpha1_DataVolume(in, out, s)
+}
+
+func autoConvert_v1alpha1_DedicatedProject_To_openstack_DedicatedProject(in *DedicatedProject, out *openstack.DedicatedProject, s conversion.Scope) error {
+	out.Roles = *(*[]string)(unsafe.Pointer(&in.Roles))
+	return nil
+}
+
+// Convert_v1alpha1_DedicatedProject_To_openstack_DedicatedProject is an autogenerated conversion function.
+func Convert_v1alpha1_DedicatedProject_To_openstack_DedicatedProject(in *DedicatedProject, out *openstack.DedicatedProject, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DedicatedProject_To_openstack_DedicatedProject(in, out, s)
+}
+
+func autoConvert_openstack_DedicatedProject_To_v1alpha1_DedicatedProject(in *openstack.DedicatedProject, out *DedicatedProject, s conversion.Scope) error {
+	out.Roles = *(*[]string)(unsafe.Pointer(&in.Roles))
+	return nil
+}
+
+// Convert_openstack_DedicatedProject_To_v1alpha1_DedicatedProject is an autogenerated conversion function.
+func Convert_openstack_DedicatedProject_To_v1alpha1_DedicatedProject(in *openstack.DedicatedProject, out *DedicatedProject, s conversion.Scope) error {
+	return autoConvert_openstack_DedicatedProject_To_v1alpha1_DedicatedProject(in, out, s)
+}
+
+func autoConvert_v1alpha1_Egress_To_openstack_Egress(in *Egress, out *openstack.Egress, s conversion.Scope) error {
+	out.Restricted = in.Restricted
+	out.SeedCIDRs = *(*[]string)(unsafe.Pointer(&in.SeedCIDRs))
+	out.CIDRs = *(*[]string)(unsafe.Pointer(&in.CIDRs))
+	return nil
+}
+
+// Convert_v1alpha1_Egress_To_openstack_Egress is an autogenerated conversion function.
+func Convert_v1alpha1_Egress_To_openstack_Egress(in *Egress, out *openstack.Egress, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Egress_To_openstack_Egress(in, out, s)
+}
+
+func autoConvert_openstack_Egress_To_v1alpha1_Egress(in *openstack.Egress, out *Egress, s conversion.Scope) error {
+	out.Restricted = in.Restricted
+	out.SeedCIDRs = *(*[]string)(unsafe.Pointer(&in.SeedCIDRs))
+	out.CIDRs = *(*[]string)(unsafe.Pointer(&in.CIDRs))
+	return nil
+}
+
+// Convert_openstack_Egress_To_v1alpha1_Egress is an autogenerated conversion function.
+func Convert_openstack_Egress_To_v1alpha1_Egress(in *openstack.Egress, out *Egress, s conversion.Scope) error {
+	return autoConvert_openstack_Egress_To_v1alpha1_Egress(in, out, s)
+}
+
+func autoConvert_v1alpha1_EndpointOverride_To_openstack_EndpointOverride(in *EndpointOverride, out *openstack.EndpointOverride, s conversion.Scope) error {
+	out.Region = (*string)(unsafe.Pointer(in.Region))
+	out.Service = in.Service
+	out.URL = in.URL
+	return nil
+}
+
+// Convert_v1alpha1_EndpointOverride_To_openstack_EndpointOverride is an autogenerated conversion function.
+func Convert_v1alpha1_EndpointOverride_To_openstack_EndpointOverride(in *EndpointOverride, out *openstack.EndpointOverride, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EndpointOverride_To_openstack_EndpointOverride(in, out, s)
+}
+
+func autoConvert_openstack_EndpointOverride_To_v1alpha1_EndpointOverride(in *openstack.EndpointOverride, out *EndpointOverride, s conversion.Scope) error {
+	out.Region = (*string)(unsafe.Pointer(in.Region))
+	out.Service = in.Service
+	out.URL = in.URL
+	return nil
+}
+
+// Convert_openstack_EndpointOverride_To_v1alpha1_EndpointOverride is an autogenerated conversion function.
+func Convert_openstack_EndpointOverride_To_v1alpha1_EndpointOverride(in *openstack.EndpointOverride, out *EndpointOverride, s conversion.Scope) error {
+	return autoConvert_openstack_EndpointOverride_To_v1alpha1_EndpointOverride(in, out, s)
+}
+
+func autoConvert_v1alpha1_ExposureConfig_To_openstack_ExposureConfig(in *ExposureConfig, out *openstack.ExposureConfig, s conversion.Scope) error {
+	out.ZoneRedundant = in.ZoneRedundant
+	return nil
+}
+
+// Convert_v1alpha1_ExposureConfig_To_openstack_ExposureConfig is an autogenerated conversion function.
+func Convert_v1alpha1_ExposureConfig_To_openstack_ExposureConfig(in *ExposureConfig, out *openstack.ExposureConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ExposureConfig_To_openstack_ExposureConfig(in, out, s)
+}
+
+func autoConvert_openstack_ExposureConfig_To_v1alpha1_ExposureConfig(in *openstack.ExposureConfig, out *ExposureConfig, s conversion.Scope) error {
+	out.ZoneRedundant = in.ZoneRedundant
+	return nil
+}
+
+// Convert_openstack_ExposureConfig_To_v1alpha1_ExposureConfig is an autogenerated conversion function.
+func Convert_openstack_ExposureConfig_To_v1alpha1_ExposureConfig(in *openstack.ExposureConfig, out *ExposureConfig, s conversion.Scope) error {
+	return autoConvert_openstack_ExposureConfig_To_v1alpha1_ExposureConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_FailedMachine_To_openstack_FailedMachine(in *FailedMachine, out *openstack.FailedMachine, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ServerID = (*string)(unsafe.Pointer(in.ServerID))
+	out.Message = in.Message
+	out.Fault = (*string)(unsafe.Pointer(in.Fault))
+	out.Preempted = in.Preempted
+	out.ProvisionState = (*string)(unsafe.Pointer(in.ProvisionState))
+	out.LastError = (*string)(unsafe.Pointer(in.LastError))
+	return nil
+}
+
+// Convert_v1alpha1_FailedMachine_To_openstack_FailedMachine is an autogenerated conversion function.
+func Convert_v1alpha1_FailedMachine_To_openstack_FailedMachine(in *FailedMachine, out *openstack.FailedMachine, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FailedMachine_To_openstack_FailedMachine(in, out, s)
+}
+
+func autoConvert_openstack_FailedMachine_To_v1alpha1_FailedMachine(in *openstack.FailedMachine, out *FailedMachine, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ServerID = (*string)(unsafe.Pointer(in.ServerID))
+	out.Message = in.Message
+	out.Fault = (*string)(unsafe.Pointer(in.Fault))
+	out.Preempted = in.Preempted
+	out.ProvisionState = (*string)(unsafe.Pointer(in.ProvisionState))
+	out.LastError = (*string)(unsafe.Pointer(in.LastError))
+	return nil
+}
+
+// Convert_openstack_FailedMachine_To_v1alpha1_FailedMachine is an autogenerated conversion function.
+func Convert_openstack_FailedMachine_To_v1alpha1_FailedMachine(in *openstack.FailedMachine, out *FailedMachine, s conversion.Scope) error {
+	return autoConvert_openstack_FailedMachine_To_v1alpha1_FailedMachine(in, out, s)
+}
+
 func autoConvert_v1alpha1_FloatingPool_To_openstack_FloatingPool(in *FloatingPool, out *openstack.FloatingPool, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Region = (*string)(unsafe.Pointer(in.Region))
@@ -514,6 +1396,7 @@ func autoConvert_v1alpha1_FloatingPool_To_openstack_FloatingPool(in *FloatingPoo
 	out.DefaultFloatingSubnet = (*string)(unsafe.Pointer(in.DefaultFloatingSubnet))
 	out.NonConstraining = (*bool)(unsafe.Pointer(in.NonConstraining))
 	out.LoadBalancerClasses = *(*[]openstack.LoadBalancerClass)(unsafe.Pointer(&in.LoadBalancerClasses))
+	out.Deprecated = (*bool)(unsafe.Pointer(in.Deprecated))
 	return nil
 }
 
@@ -529,6 +1412,7 @@ func autoConvert_openstack_FloatingPool_To_v1alpha1_FloatingPool(in *openstack.F
 	out.DefaultFloatingSubnet = (*string)(unsafe.Pointer(in.DefaultFloatingSubnet))
 	out.NonConstraining = (*bool)(unsafe.Pointer(in.NonConstraining))
 	out.LoadBalancerClasses = *(*[]LoadBalancerClass)(unsafe.Pointer(&in.LoadBalancerClasses))
+	out.Deprecated = (*bool)(unsafe.Pointer(in.Deprecated))
 	return nil
 }
 
@@ -559,26 +1443,92 @@ func Convert_openstack_FloatingPoolStatus_To_v1alpha1_FloatingPoolStatus(in *ope
 	return autoConvert_openstack_FloatingPoolStatus_To_v1alpha1_FloatingPoolStatus(in, out, s)
 }
 
-func autoConvert_v1alpha1_InfrastructureConfig_To_openstack_InfrastructureConfig(in *InfrastructureConfig, out *openstack.InfrastructureConfig, s conversion.Scope) error {
-	out.FloatingPoolName = in.FloatingPoolName
-	out.FloatingPoolSubnetName = (*string)(unsafe.Pointer(in.FloatingPoolSubnetName))
-	if err := Convert_v1alpha1_Networks_To_openstack_Networks(&in.Networks, &out.Networks, s); err != nil {
-		return err
-	}
+func autoConvert_v1alpha1_GPUConfig_To_openstack_GPUConfig(in *GPUConfig, out *openstack.GPUConfig, s conversion.Scope) error {
+	out.ResourceName = (*string)(unsafe.Pointer(in.ResourceName))
+	out.PCIAliases = *(*[]string)(unsafe.Pointer(&in.PCIAliases))
 	return nil
 }
 
-// Convert_v1alpha1_InfrastructureConfig_To_openstack_InfrastructureConfig is an autogenerated conversion function.
-func Convert_v1alpha1_InfrastructureConfig_To_openstack_InfrastructureConfig(in *InfrastructureConfig, out *openstack.InfrastructureConfig, s conversion.Scope) error {
-	return autoConvert_v1alpha1_InfrastructureConfig_To_openstack_InfrastructureConfig(in, out, s)
+// Convert_v1alpha1_GPUConfig_To_openstack_GPUConfig is an autogenerated conversion function.
+func Convert_v1alpha1_GPUConfig_To_openstack_GPUConfig(in *GPUConfig, out *openstack.GPUConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_GPUConfig_To_openstack_GPUConfig(in, out, s)
 }
 
-func autoConvert_openstack_InfrastructureConfig_To_v1alpha1_InfrastructureConfig(in *openstack.InfrastructureConfig, out *InfrastructureConfig, s conversion.Scope) error {
+func autoConvert_openstack_GPUConfig_To_v1alpha1_GPUConfig(in *openstack.GPUConfig, out *GPUConfig, s conversion.Scope) error {
+	out.ResourceName = (*string)(unsafe.Pointer(in.ResourceName))
+	out.PCIAliases = *(*[]string)(unsafe.Pointer(&in.PCIAliases))
+	return nil
+}
+
+// Convert_openstack_GPUConfig_To_v1alpha1_GPUConfig is an autogenerated conversion function.
+func Convert_openstack_GPUConfig_To_v1alpha1_GPUConfig(in *openstack.GPUConfig, out *GPUConfig, s conversion.Scope) error {
+	return autoConvert_openstack_GPUConfig_To_v1alpha1_GPUConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_HostPinning_To_openstack_HostPinning(in *HostPinning, out *openstack.HostPinning, s conversion.Scope) error {
+	out.Zone = in.Zone
+	out.Host = (*string)(unsafe.Pointer(in.Host))
+	out.Node = (*string)(unsafe.Pointer(in.Node))
+	return nil
+}
+
+// Convert_v1alpha1_HostPinning_To_openstack_HostPinning is an autogenerated conversion function.
+func Convert_v1alpha1_HostPinning_To_openstack_HostPinning(in *HostPinning, out *openstack.HostPinning, s conversion.Scope) error {
+	return autoConvert_v1alpha1_HostPinning_To_openstack_HostPinning(in, out, s)
+}
+
+func autoConvert_openstack_HostPinning_To_v1alpha1_HostPinning(in *openstack.HostPinning, out *HostPinning, s conversion.Scope) error {
+	out.Zone = in.Zone
+	out.Host = (*string)(unsafe.Pointer(in.Host))
+	out.Node = (*string)(unsafe.Pointer(in.Node))
+	return nil
+}
+
+// Convert_openstack_HostPinning_To_v1alpha1_HostPinning is an autogenerated conversion function.
+func Convert_openstack_HostPinning_To_v1alpha1_HostPinning(in *openstack.HostPinning, out *HostPinning, s conversion.Scope) error {
+	return autoConvert_openstack_HostPinning_To_v1alpha1_HostPinning(in, out, s)
+}
+
+func autoConvert_v1alpha1_InfrastructureConfig_To_openstack_InfrastructureConfig(in *InfrastructureConfig, out *openstack.InfrastructureConfig, s conversion.Scope) error {
+	out.FloatingPoolName = in.FloatingPoolName
+	out.FloatingPoolSubnetName = (*string)(unsafe.Pointer(in.FloatingPoolSubnetName))
+	if err := Convert_v1alpha1_Networks_To_openstack_Networks(&in.Networks, &out.Networks, s); err != nil {
+		return err
+	}
+	out.Adopt = (*bool)(unsafe.Pointer(in.Adopt))
+	out.SecurityGroupID = (*string)(unsafe.Pointer(in.SecurityGroupID))
+	out.KeyPairName = (*string)(unsafe.Pointer(in.KeyPairName))
+	out.AdditionalFloatingPools = *(*[]openstack.AdditionalFloatingPool)(unsafe.Pointer(&in.AdditionalFloatingPools))
+	out.NodePorts = (*openstack.NodePorts)(unsafe.Pointer(in.NodePorts))
+	out.Egress = (*openstack.Egress)(unsafe.Pointer(in.Egress))
+	out.SecurityGroupRules = (*openstack.SecurityGroupRules)(unsafe.Pointer(in.SecurityGroupRules))
+	out.DedicatedProject = (*openstack.DedicatedProject)(unsafe.Pointer(in.DedicatedProject))
+	out.AuxiliaryStack = (*openstack.AuxiliaryStack)(unsafe.Pointer(in.AuxiliaryStack))
+	out.ApplicationCredential = (*openstack.ApplicationCredential)(unsafe.Pointer(in.ApplicationCredential))
+	return nil
+}
+
+// Convert_v1alpha1_InfrastructureConfig_To_openstack_InfrastructureConfig is an autogenerated conversion function.
+func Convert_v1alpha1_InfrastructureConfig_To_openstack_InfrastructureConfig(in *InfrastructureConfig, out *openstack.InfrastructureConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InfrastructureConfig_To_openstack_InfrastructureConfig(in, out, s)
+}
+
+func autoConvert_openstack_InfrastructureConfig_To_v1alpha1_InfrastructureConfig(in *openstack.InfrastructureConfig, out *InfrastructureConfig, s conversion.Scope) error {
 	out.FloatingPoolName = in.FloatingPoolName
 	out.FloatingPoolSubnetName = (*string)(unsafe.Pointer(in.FloatingPoolSubnetName))
 	if err := Convert_openstack_Networks_To_v1alpha1_Networks(&in.Networks, &out.Networks, s); err != nil {
 		return err
 	}
+	out.Adopt = (*bool)(unsafe.Pointer(in.Adopt))
+	out.SecurityGroupID = (*string)(unsafe.Pointer(in.SecurityGroupID))
+	out.KeyPairName = (*string)(unsafe.Pointer(in.KeyPairName))
+	out.AdditionalFloatingPools = *(*[]AdditionalFloatingPool)(unsafe.Pointer(&in.AdditionalFloatingPools))
+	out.NodePorts = (*NodePorts)(unsafe.Pointer(in.NodePorts))
+	out.Egress = (*Egress)(unsafe.Pointer(in.Egress))
+	out.SecurityGroupRules = (*SecurityGroupRules)(unsafe.Pointer(in.SecurityGroupRules))
+	out.DedicatedProject = (*DedicatedProject)(unsafe.Pointer(in.DedicatedProject))
+	out.AuxiliaryStack = (*AuxiliaryStack)(unsafe.Pointer(in.AuxiliaryStack))
+	out.ApplicationCredential = (*ApplicationCredential)(unsafe.Pointer(in.ApplicationCredential))
 	return nil
 }
 
@@ -595,14 +1545,10 @@ func autoConvert_v1alpha1_InfrastructureStatus_To_openstack_InfrastructureStatus
 		return err
 	}
 	out.SecurityGroups = *(*[]openstack.SecurityGroup)(unsafe.Pointer(&in.SecurityGroups))
+	out.Project = (*openstack.ProjectStatus)(unsafe.Pointer(in.Project))
 	return nil
 }
 
-// Convert_v1alpha1_InfrastructureStatus_To_openstack_InfrastructureStatus is an autogenerated conversion function.
-func Convert_v1alpha1_InfrastructureStatus_To_openstack_InfrastructureStatus(in *InfrastructureStatus, out *openstack.InfrastructureStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha1_InfrastructureStatus_To_openstack_InfrastructureStatus(in, out, s)
-}
-
 func autoConvert_openstack_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in *openstack.InfrastructureStatus, out *InfrastructureStatus, s conversion.Scope) error {
 	if err := Convert_openstack_NetworkStatus_To_v1alpha1_NetworkStatus(&in.Networks, &out.Networks, s); err != nil {
 		return err
@@ -611,6 +1557,7 @@ func autoConvert_openstack_InfrastructureStatus_To_v1alpha1_InfrastructureStatus
 		return err
 	}
 	out.SecurityGroups = *(*[]SecurityGroup)(unsafe.Pointer(&in.SecurityGroups))
+	out.Project = (*ProjectStatus)(unsafe.Pointer(in.Project))
 	return nil
 }
 
@@ -619,6 +1566,28 @@ func Convert_openstack_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in
 	return autoConvert_openstack_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_IntegrityCheckConfig_To_openstack_IntegrityCheckConfig(in *IntegrityCheckConfig, out *openstack.IntegrityCheckConfig, s conversion.Scope) error {
+	out.Schedule = (*string)(unsafe.Pointer(in.Schedule))
+	out.MaxAge = (*v1.Duration)(unsafe.Pointer(in.MaxAge))
+	return nil
+}
+
+// Convert_v1alpha1_IntegrityCheckConfig_To_openstack_IntegrityCheckConfig is an autogenerated conversion function.
+func Convert_v1alpha1_IntegrityCheckConfig_To_openstack_IntegrityCheckConfig(in *IntegrityCheckConfig, out *openstack.IntegrityCheckConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IntegrityCheckConfig_To_openstack_IntegrityCheckConfig(in, out, s)
+}
+
+func autoConvert_openstack_IntegrityCheckConfig_To_v1alpha1_IntegrityCheckConfig(in *openstack.IntegrityCheckConfig, out *IntegrityCheckConfig, s conversion.Scope) error {
+	out.Schedule = (*string)(unsafe.Pointer(in.Schedule))
+	out.MaxAge = (*v1.Duration)(unsafe.Pointer(in.MaxAge))
+	return nil
+}
+
+// Convert_openstack_IntegrityCheckConfig_To_v1alpha1_IntegrityCheckConfig is an autogenerated conversion function.
+func Convert_openstack_IntegrityCheckConfig_To_v1alpha1_IntegrityCheckConfig(in *openstack.IntegrityCheckConfig, out *IntegrityCheckConfig, s conversion.Scope) error {
+	return autoConvert_openstack_IntegrityCheckConfig_To_v1alpha1_IntegrityCheckConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_KeyStoneURL_To_openstack_KeyStoneURL(in *KeyStoneURL, out *openstack.KeyStoneURL, s conversion.Scope) error {
 	out.Region = in.Region
 	out.URL = in.URL
@@ -675,6 +1644,54 @@ func Convert_openstack_LoadBalancerClass_To_v1alpha1_LoadBalancerClass(in *opens
 	return autoConvert_openstack_LoadBalancerClass_To_v1alpha1_LoadBalancerClass(in, out, s)
 }
 
+func autoConvert_v1alpha1_LoadBalancerClassPolicy_To_openstack_LoadBalancerClassPolicy(in *LoadBalancerClassPolicy, out *openstack.LoadBalancerClassPolicy, s conversion.Scope) error {
+	out.LoadBalancerClasses = *(*[]string)(unsafe.Pointer(&in.LoadBalancerClasses))
+	out.ShootPurposes = *(*[]string)(unsafe.Pointer(&in.ShootPurposes))
+	out.ShootSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ShootSelector))
+	return nil
+}
+
+// Convert_v1alpha1_LoadBalancerClassPolicy_To_openstack_LoadBalancerClassPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_LoadBalancerClassPolicy_To_openstack_LoadBalancerClassPolicy(in *LoadBalancerClassPolicy, out *openstack.LoadBalancerClassPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LoadBalancerClassPolicy_To_openstack_LoadBalancerClassPolicy(in, out, s)
+}
+
+func autoConvert_openstack_LoadBalancerClassPolicy_To_v1alpha1_LoadBalancerClassPolicy(in *openstack.LoadBalancerClassPolicy, out *LoadBalancerClassPolicy, s conversion.Scope) error {
+	out.LoadBalancerClasses = *(*[]string)(unsafe.Pointer(&in.LoadBalancerClasses))
+	out.ShootPurposes = *(*[]string)(unsafe.Pointer(&in.ShootPurposes))
+	out.ShootSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ShootSelector))
+	return nil
+}
+
+// Convert_openstack_LoadBalancerClassPolicy_To_v1alpha1_LoadBalancerClassPolicy is an autogenerated conversion function.
+func Convert_openstack_LoadBalancerClassPolicy_To_v1alpha1_LoadBalancerClassPolicy(in *openstack.LoadBalancerClassPolicy, out *LoadBalancerClassPolicy, s conversion.Scope) error {
+	return autoConvert_openstack_LoadBalancerClassPolicy_To_v1alpha1_LoadBalancerClassPolicy(in, out, s)
+}
+
+func autoConvert_v1alpha1_LoadBalancerConfig_To_openstack_LoadBalancerConfig(in *LoadBalancerConfig, out *openstack.LoadBalancerConfig, s conversion.Scope) error {
+	out.Algorithm = (*openstack.LoadBalancerAlgorithm)(unsafe.Pointer(in.Algorithm))
+	out.AvailabilityZone = (*string)(unsafe.Pointer(in.AvailabilityZone))
+	out.FlavorID = (*string)(unsafe.Pointer(in.FlavorID))
+	return nil
+}
+
+// Convert_v1alpha1_LoadBalancerConfig_To_openstack_LoadBalancerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_LoadBalancerConfig_To_openstack_LoadBalancerConfig(in *LoadBalancerConfig, out *openstack.LoadBalancerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LoadBalancerConfig_To_openstack_LoadBalancerConfig(in, out, s)
+}
+
+func autoConvert_openstack_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig(in *openstack.LoadBalancerConfig, out *LoadBalancerConfig, s conversion.Scope) error {
+	out.Algorithm = (*LoadBalancerAlgorithm)(unsafe.Pointer(in.Algorithm))
+	out.AvailabilityZone = (*string)(unsafe.Pointer(in.AvailabilityZone))
+	out.FlavorID = (*string)(unsafe.Pointer(in.FlavorID))
+	return nil
+}
+
+// Convert_openstack_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig is an autogenerated conversion function.
+func Convert_openstack_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig(in *openstack.LoadBalancerConfig, out *LoadBalancerConfig, s conversion.Scope) error {
+	return autoConvert_openstack_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_LoadBalancerProvider_To_openstack_LoadBalancerProvider(in *LoadBalancerProvider, out *openstack.LoadBalancerProvider, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Region = (*string)(unsafe.Pointer(in.Region))
@@ -703,6 +1720,7 @@ func autoConvert_v1alpha1_MachineImage_To_openstack_MachineImage(in *MachineImag
 	out.Image = in.Image
 	out.ID = in.ID
 	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	out.Capabilities = *(*[]openstack.MachineImageCapability)(unsafe.Pointer(&in.Capabilities))
 	return nil
 }
 
@@ -717,6 +1735,7 @@ func autoConvert_openstack_MachineImage_To_v1alpha1_MachineImage(in *openstack.M
 	out.Image = in.Image
 	out.ID = in.ID
 	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	out.Capabilities = *(*[]MachineImageCapability)(unsafe.Pointer(&in.Capabilities))
 	return nil
 }
 
@@ -725,160 +1744,500 @@ func Convert_openstack_MachineImage_To_v1alpha1_MachineImage(in *openstack.Machi
 	return autoConvert_openstack_MachineImage_To_v1alpha1_MachineImage(in, out, s)
 }
 
-func autoConvert_v1alpha1_MachineImageVersion_To_openstack_MachineImageVersion(in *MachineImageVersion, out *openstack.MachineImageVersion, s conversion.Scope) error {
-	out.Version = in.Version
-	out.Image = in.Image
-	out.Regions = *(*[]openstack.RegionIDMapping)(unsafe.Pointer(&in.Regions))
+func autoConvert_v1alpha1_MachineImageVersion_To_openstack_MachineImageVersion(in *MachineImageVersion, out *openstack.MachineImageVersion, s conversion.Scope) error {
+	out.Version = in.Version
+	out.Image = in.Image
+	out.Regions = *(*[]openstack.RegionIDMapping)(unsafe.Pointer(&in.Regions))
+	out.Capabilities = *(*[]openstack.MachineImageCapability)(unsafe.Pointer(&in.Capabilities))
+	return nil
+}
+
+// Convert_v1alpha1_MachineImageVersion_To_openstack_MachineImageVersion is an autogenerated conversion function.
+func Convert_v1alpha1_MachineImageVersion_To_openstack_MachineImageVersion(in *MachineImageVersion, out *openstack.MachineImageVersion, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineImageVersion_To_openstack_MachineImageVersion(in, out, s)
+}
+
+func autoConvert_openstack_MachineImageVersion_To_v1alpha1_MachineImageVersion(in *openstack.MachineImageVersion, out *MachineImageVersion, s conversion.Scope) error {
+	out.Version = in.Version
+	out.Image = in.Image
+	out.Regions = *(*[]RegionIDMapping)(unsafe.Pointer(&in.Regions))
+	out.Capabilities = *(*[]MachineImageCapability)(unsafe.Pointer(&in.Capabilities))
+	return nil
+}
+
+// Convert_openstack_MachineImageVersion_To_v1alpha1_MachineImageVersion is an autogenerated conversion function.
+func Convert_openstack_MachineImageVersion_To_v1alpha1_MachineImageVersion(in *openstack.MachineImageVersion, out *MachineImageVersion, s conversion.Scope) error {
+	return autoConvert_openstack_MachineImageVersion_To_v1alpha1_MachineImageVersion(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachineImages_To_openstack_MachineImages(in *MachineImages, out *openstack.MachineImages, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Versions = *(*[]openstack.MachineImageVersion)(unsafe.Pointer(&in.Versions))
+	return nil
+}
+
+// Convert_v1alpha1_MachineImages_To_openstack_MachineImages is an autogenerated conversion function.
+func Convert_v1alpha1_MachineImages_To_openstack_MachineImages(in *MachineImages, out *openstack.MachineImages, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineImages_To_openstack_MachineImages(in, out, s)
+}
+
+func autoConvert_openstack_MachineImages_To_v1alpha1_MachineImages(in *openstack.MachineImages, out *MachineImages, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Versions = *(*[]MachineImageVersion)(unsafe.Pointer(&in.Versions))
+	return nil
+}
+
+// Convert_openstack_MachineImages_To_v1alpha1_MachineImages is an autogenerated conversion function.
+func Convert_openstack_MachineImages_To_v1alpha1_MachineImages(in *openstack.MachineImages, out *MachineImages, s conversion.Scope) error {
+	return autoConvert_openstack_MachineImages_To_v1alpha1_MachineImages(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachineLabel_To_openstack_MachineLabel(in *MachineLabel, out *openstack.MachineLabel, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	out.TriggerRollingOnUpdate = in.TriggerRollingOnUpdate
+	return nil
+}
+
+// Convert_v1alpha1_MachineLabel_To_openstack_MachineLabel is an autogenerated conversion function.
+func Convert_v1alpha1_MachineLabel_To_openstack_MachineLabel(in *MachineLabel, out *openstack.MachineLabel, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineLabel_To_openstack_MachineLabel(in, out, s)
+}
+
+func autoConvert_openstack_MachineLabel_To_v1alpha1_MachineLabel(in *openstack.MachineLabel, out *MachineLabel, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	out.TriggerRollingOnUpdate = in.TriggerRollingOnUpdate
+	return nil
+}
+
+// Convert_openstack_MachineLabel_To_v1alpha1_MachineLabel is an autogenerated conversion function.
+func Convert_openstack_MachineLabel_To_v1alpha1_MachineLabel(in *openstack.MachineLabel, out *MachineLabel, s conversion.Scope) error {
+	return autoConvert_openstack_MachineLabel_To_v1alpha1_MachineLabel(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachineTypePrice_To_openstack_MachineTypePrice(in *MachineTypePrice, out *openstack.MachineTypePrice, s conversion.Scope) error {
+	out.Name = in.Name
+	out.PricePerHour = in.PricePerHour
+	return nil
+}
+
+// Convert_v1alpha1_MachineTypePrice_To_openstack_MachineTypePrice is an autogenerated conversion function.
+func Convert_v1alpha1_MachineTypePrice_To_openstack_MachineTypePrice(in *MachineTypePrice, out *openstack.MachineTypePrice, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineTypePrice_To_openstack_MachineTypePrice(in, out, s)
+}
+
+func autoConvert_openstack_MachineTypePrice_To_v1alpha1_MachineTypePrice(in *openstack.MachineTypePrice, out *MachineTypePrice, s conversion.Scope) error {
+	out.Name = in.Name
+	out.PricePerHour = in.PricePerHour
+	return nil
+}
+
+// Convert_openstack_MachineTypePrice_To_v1alpha1_MachineTypePrice is an autogenerated conversion function.
+func Convert_openstack_MachineTypePrice_To_v1alpha1_MachineTypePrice(in *openstack.MachineTypePrice, out *MachineTypePrice, s conversion.Scope) error {
+	return autoConvert_openstack_MachineTypePrice_To_v1alpha1_MachineTypePrice(in, out, s)
+}
+
+func autoConvert_v1alpha1_NetworkAllocation_To_openstack_NetworkAllocation(in *NetworkAllocation, out *openstack.NetworkAllocation, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_NetworkAllocationSpec_To_openstack_NetworkAllocationSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_NetworkAllocation_To_openstack_NetworkAllocation is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkAllocation_To_openstack_NetworkAllocation(in *NetworkAllocation, out *openstack.NetworkAllocation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkAllocation_To_openstack_NetworkAllocation(in, out, s)
+}
+
+func autoConvert_openstack_NetworkAllocation_To_v1alpha1_NetworkAllocation(in *openstack.NetworkAllocation, out *NetworkAllocation, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_openstack_NetworkAllocationSpec_To_v1alpha1_NetworkAllocationSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_openstack_NetworkAllocation_To_v1alpha1_NetworkAllocation is an autogenerated conversion function.
+func Convert_openstack_NetworkAllocation_To_v1alpha1_NetworkAllocation(in *openstack.NetworkAllocation, out *NetworkAllocation, s conversion.Scope) error {
+	return autoConvert_openstack_NetworkAllocation_To_v1alpha1_NetworkAllocation(in, out, s)
+}
+
+func autoConvert_v1alpha1_NetworkAllocationList_To_openstack_NetworkAllocationList(in *NetworkAllocationList, out *openstack.NetworkAllocationList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]openstack.NetworkAllocation)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_NetworkAllocationList_To_openstack_NetworkAllocationList is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkAllocationList_To_openstack_NetworkAllocationList(in *NetworkAllocationList, out *openstack.NetworkAllocationList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkAllocationList_To_openstack_NetworkAllocationList(in, out, s)
+}
+
+func autoConvert_openstack_NetworkAllocationList_To_v1alpha1_NetworkAllocationList(in *openstack.NetworkAllocationList, out *NetworkAllocationList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]NetworkAllocation)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_openstack_NetworkAllocationList_To_v1alpha1_NetworkAllocationList is an autogenerated conversion function.
+func Convert_openstack_NetworkAllocationList_To_v1alpha1_NetworkAllocationList(in *openstack.NetworkAllocationList, out *NetworkAllocationList, s conversion.Scope) error {
+	return autoConvert_openstack_NetworkAllocationList_To_v1alpha1_NetworkAllocationList(in, out, s)
+}
+
+func autoConvert_v1alpha1_NetworkAllocationSpec_To_openstack_NetworkAllocationSpec(in *NetworkAllocationSpec, out *openstack.NetworkAllocationSpec, s conversion.Scope) error {
+	out.Allocations = *(*[]openstack.CIDRAllocation)(unsafe.Pointer(&in.Allocations))
+	return nil
+}
+
+// Convert_v1alpha1_NetworkAllocationSpec_To_openstack_NetworkAllocationSpec is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkAllocationSpec_To_openstack_NetworkAllocationSpec(in *NetworkAllocationSpec, out *openstack.NetworkAllocationSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkAllocationSpec_To_openstack_NetworkAllocationSpec(in, out, s)
+}
+
+func autoConvert_openstack_NetworkAllocationSpec_To_v1alpha1_NetworkAllocationSpec(in *openstack.NetworkAllocationSpec, out *NetworkAllocationSpec, s conversion.Scope) error {
+	out.Allocations = *(*[]CIDRAllocation)(unsafe.Pointer(&in.Allocations))
+	return nil
+}
+
+// Convert_openstack_NetworkAllocationSpec_To_v1alpha1_NetworkAllocationSpec is an autogenerated conversion function.
+func Convert_openstack_NetworkAllocationSpec_To_v1alpha1_NetworkAllocationSpec(in *openstack.NetworkAllocationSpec, out *NetworkAllocationSpec, s conversion.Scope) error {
+	return autoConvert_openstack_NetworkAllocationSpec_To_v1alpha1_NetworkAllocationSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_NetworkStatus_To_openstack_NetworkStatus(in *NetworkStatus, out *openstack.NetworkStatus, s conversion.Scope) error {
+	out.ID = in.ID
+	out.Name = in.Name
+	if err := Convert_v1alpha1_FloatingPoolStatus_To_openstack_FloatingPoolStatus(&in.FloatingPool, &out.FloatingPool, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_RouterStatus_To_openstack_RouterStatus(&in.Router, &out.Router, s); err != nil {
+		return err
+	}
+	out.Subnets = *(*[]openstack.Subnet)(unsafe.Pointer(&in.Subnets))
+	out.ShareNetwork = (*openstack.ShareNetworkStatus)(unsafe.Pointer(in.ShareNetwork))
+	return nil
+}
+
+// Convert_v1alpha1_NetworkStatus_To_openstack_NetworkStatus is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkStatus_To_openstack_NetworkStatus(in *NetworkStatus, out *openstack.NetworkStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkStatus_To_openstack_NetworkStatus(in, out, s)
+}
+
+func autoConvert_openstack_NetworkStatus_To_v1alpha1_NetworkStatus(in *openstack.NetworkStatus, out *NetworkStatus, s conversion.Scope) error {
+	out.ID = in.ID
+	out.Name = in.Name
+	if err := Convert_openstack_FloatingPoolStatus_To_v1alpha1_FloatingPoolStatus(&in.FloatingPool, &out.FloatingPool, s); err != nil {
+		return err
+	}
+	if err := Convert_openstack_RouterStatus_To_v1alpha1_RouterStatus(&in.Router, &out.Router, s); err != nil {
+		return err
+	}
+	out.Subnets = *(*[]Subnet)(unsafe.Pointer(&in.Subnets))
+	out.ShareNetwork = (*ShareNetworkStatus)(unsafe.Pointer(in.ShareNetwork))
+	return nil
+}
+
+// Convert_openstack_NetworkStatus_To_v1alpha1_NetworkStatus is an autogenerated conversion function.
+func Convert_openstack_NetworkStatus_To_v1alpha1_NetworkStatus(in *openstack.NetworkStatus, out *NetworkStatus, s conversion.Scope) error {
+	return autoConvert_openstack_NetworkStatus_To_v1alpha1_NetworkStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_Networks_To_openstack_Networks(in *Networks, out *openstack.Networks, s conversion.Scope) error {
+	out.Router = (*openstack.Router)(unsafe.Pointer(in.Router))
+	out.Worker = in.Worker
+	out.Workers = in.Workers
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	out.SubnetID = (*string)(unsafe.Pointer(in.SubnetID))
+	out.ShareNetwork = (*openstack.ShareNetwork)(unsafe.Pointer(in.ShareNetwork))
+	out.Shared = (*openstack.SharedNetwork)(unsafe.Pointer(in.Shared))
+	out.ShareWithProjects = *(*[]string)(unsafe.Pointer(&in.ShareWithProjects))
+	out.Subnets = *(*[]openstack.NodeSubnet)(unsafe.Pointer(&in.Subnets))
+	return nil
+}
+
+// Convert_v1alpha1_Networks_To_openstack_Networks is an autogenerated conversion function.
+func Convert_v1alpha1_Networks_To_openstack_Networks(in *Networks, out *openstack.Networks, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Networks_To_openstack_Networks(in, out, s)
+}
+
+func autoConvert_openstack_Networks_To_v1alpha1_Networks(in *openstack.Networks, out *Networks, s conversion.Scope) error {
+	out.Router = (*Router)(unsafe.Pointer(in.Router))
+	out.Worker = in.Worker
+	out.Workers = in.Workers
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	out.SubnetID = (*string)(unsafe.Pointer(in.SubnetID))
+	out.ShareNetwork = (*ShareNetwork)(unsafe.Pointer(in.ShareNetwork))
+	out.Shared = (*SharedNetwork)(unsafe.Pointer(in.Shared))
+	out.ShareWithProjects = *(*[]string)(unsafe.Pointer(&in.ShareWithProjects))
+	out.Subnets = *(*[]NodeSubnet)(unsafe.Pointer(&in.Subnets))
+	return nil
+}
+
+// Convert_openstack_Networks_To_v1alpha1_Networks is an autogenerated conversion function.
+func Convert_openstack_Networks_To_v1alpha1_Networks(in *openstack.Networks, out *Networks, s conversion.Scope) error {
+	return autoConvert_openstack_Networks_To_v1alpha1_Networks(in, out, s)
+}
+
+func autoConvert_v1alpha1_NodeLabelerConfig_To_openstack_NodeLabelerConfig(in *NodeLabelerConfig, out *openstack.NodeLabelerConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	return nil
+}
+
+// Convert_v1alpha1_NodeLabelerConfig_To_openstack_NodeLabelerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_NodeLabelerConfig_To_openstack_NodeLabelerConfig(in *NodeLabelerConfig, out *openstack.NodeLabelerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NodeLabelerConfig_To_openstack_NodeLabelerConfig(in, out, s)
+}
+
+func autoConvert_openstack_NodeLabelerConfig_To_v1alpha1_NodeLabelerConfig(in *openstack.NodeLabelerConfig, out *NodeLabelerConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	return nil
+}
+
+// Convert_openstack_NodeLabelerConfig_To_v1alpha1_NodeLabelerConfig is an autogenerated conversion function.
+func Convert_openstack_NodeLabelerConfig_To_v1alpha1_NodeLabelerConfig(in *openstack.NodeLabelerConfig, out *NodeLabelerConfig, s conversion.Scope) error {
+	return autoConvert_openstack_NodeLabelerConfig_To_v1alpha1_NodeLabelerConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_NodePorts_To_openstack_NodePorts(in *NodePorts, out *openstack.NodePorts, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.SourceCIDRs = *(*[]string)(unsafe.Pointer(&in.SourceCIDRs))
+	return nil
+}
+
+// Convert_v1alpha1_NodePorts_To_openstack_NodePorts is an autogenerated conversion function.
+func Convert_v1alpha1_NodePorts_To_openstack_NodePorts(in *NodePorts, out *openstack.NodePorts, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NodePorts_To_openstack_NodePorts(in, out, s)
+}
+
+func autoConvert_openstack_NodePorts_To_v1alpha1_NodePorts(in *openstack.NodePorts, out *NodePorts, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.SourceCIDRs = *(*[]string)(unsafe.Pointer(&in.SourceCIDRs))
+	return nil
+}
+
+// Convert_openstack_NodePorts_To_v1alpha1_NodePorts is an autogenerated conversion function.
+func Convert_openstack_NodePorts_To_v1alpha1_NodePorts(in *openstack.NodePorts, out *NodePorts, s conversion.Scope) error {
+	return autoConvert_openstack_NodePorts_To_v1alpha1_NodePorts(in, out, s)
+}
+
+func autoConvert_v1alpha1_NodeStatus_To_openstack_NodeStatus(in *NodeStatus, out *openstack.NodeStatus, s conversion.Scope) error {
+	out.KeyName = in.KeyName
+	return nil
+}
+
+// Convert_v1alpha1_NodeStatus_To_openstack_NodeStatus is an autogenerated conversion function.
+func Convert_v1alpha1_NodeStatus_To_openstack_NodeStatus(in *NodeStatus, out *openstack.NodeStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NodeStatus_To_openstack_NodeStatus(in, out, s)
+}
+
+func autoConvert_openstack_NodeStatus_To_v1alpha1_NodeStatus(in *openstack.NodeStatus, out *NodeStatus, s conversion.Scope) error {
+	out.KeyName = in.KeyName
+	return nil
+}
+
+// Convert_openstack_NodeStatus_To_v1alpha1_NodeStatus is an autogenerated conversion function.
+func Convert_openstack_NodeStatus_To_v1alpha1_NodeStatus(in *openstack.NodeStatus, out *NodeStatus, s conversion.Scope) error {
+	return autoConvert_openstack_NodeStatus_To_v1alpha1_NodeStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_NodeSubnet_To_openstack_NodeSubnet(in *NodeSubnet, out *openstack.NodeSubnet, s conversion.Scope) error {
+	out.Name = in.Name
+	out.CIDR = in.CIDR
+	return nil
+}
+
+// Convert_v1alpha1_NodeSubnet_To_openstack_NodeSubnet is an autogenerated conversion function.
+func Convert_v1alpha1_NodeSubnet_To_openstack_NodeSubnet(in *NodeSubnet, out *openstack.NodeSubnet, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NodeSubnet_To_openstack_NodeSubnet(in, out, s)
+}
+
+func autoConvert_openstack_NodeSubnet_To_v1alpha1_NodeSubnet(in *openstack.NodeSubnet, out *NodeSubnet, s conversion.Scope) error {
+	out.Name = in.Name
+	out.CIDR = in.CIDR
+	return nil
+}
+
+// Convert_openstack_NodeSubnet_To_v1alpha1_NodeSubnet is an autogenerated conversion function.
+func Convert_openstack_NodeSubnet_To_v1alpha1_NodeSubnet(in *openstack.NodeSubnet, out *NodeSubnet, s conversion.Scope) error {
+	return autoConvert_openstack_NodeSubnet_To_v1alpha1_NodeSubnet(in, out, s)
+}
+
+func autoConvert_v1alpha1_OpenStackMaintenance_To_openstack_OpenStackMaintenance(in *OpenStackMaintenance, out *openstack.OpenStackMaintenance, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_OpenStackMaintenanceSpec_To_openstack_OpenStackMaintenanceSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_OpenStackMaintenanceStatus_To_openstack_OpenStackMaintenanceStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_OpenStackMaintenance_To_openstack_OpenStackMaintenance is an autogenerated conversion function.
+func Convert_v1alpha1_OpenStackMaintenance_To_openstack_OpenStackMaintenance(in *OpenStackMaintenance, out *openstack.OpenStackMaintenance, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OpenStackMaintenance_To_openstack_OpenStackMaintenance(in, out, s)
+}
+
+func autoConvert_openstack_OpenStackMaintenance_To_v1alpha1_OpenStackMaintenance(in *openstack.OpenStackMaintenance, out *OpenStackMaintenance, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_openstack_OpenStackMaintenanceSpec_To_v1alpha1_OpenStackMaintenanceSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_openstack_OpenStackMaintenanceStatus_To_v1alpha1_OpenStackMaintenanceStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_openstack_OpenStackMaintenance_To_v1alpha1_OpenStackMaintenance is an autogenerated conversion function.
+func Convert_openstack_OpenStackMaintenance_To_v1alpha1_OpenStackMaintenance(in *openstack.OpenStackMaintenance, out *OpenStackMaintenance, s conversion.Scope) error {
+	return autoConvert_openstack_OpenStackMaintenance_To_v1alpha1_OpenStackMaintenance(in, out, s)
+}
+
+func autoConvert_v1alpha1_OpenStackMaintenanceList_To_openstack_OpenStackMaintenanceList(in *OpenStackMaintenanceList, out *openstack.OpenStackMaintenanceList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]openstack.OpenStackMaintenance)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_v1alpha1_MachineImageVersion_To_openstack_MachineImageVersion is an autogenerated conversion function.
-func Convert_v1alpha1_MachineImageVersion_To_openstack_MachineImageVersion(in *MachineImageVersion, out *openstack.MachineImageVersion, s conversion.Scope) error {
-	return autoConvert_v1alpha1_MachineImageVersion_To_openstack_MachineImageVersion(in, out, s)
+// Convert_v1alpha1_OpenStackMaintenanceList_To_openstack_OpenStackMaintenanceList is an autogenerated conversion function.
+func Convert_v1alpha1_OpenStackMaintenanceList_To_openstack_OpenStackMaintenanceList(in *OpenStackMaintenanceList, out *openstack.OpenStackMaintenanceList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OpenStackMaintenanceList_To_openstack_OpenStackMaintenanceList(in, out, s)
 }
 
-func autoConvert_openstack_MachineImageVersion_To_v1alpha1_MachineImageVersion(in *openstack.MachineImageVersion, out *MachineImageVersion, s conversion.Scope) error {
-	out.Version = in.Version
-	out.Image = in.Image
-	out.Regions = *(*[]RegionIDMapping)(unsafe.Pointer(&in.Regions))
+func autoConvert_openstack_OpenStackMaintenanceList_To_v1alpha1_OpenStackMaintenanceList(in *openstack.OpenStackMaintenanceList, out *OpenStackMaintenanceList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]OpenStackMaintenance)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_openstack_MachineImageVersion_To_v1alpha1_MachineImageVersion is an autogenerated conversion function.
-func Convert_openstack_MachineImageVersion_To_v1alpha1_MachineImageVersion(in *openstack.MachineImageVersion, out *MachineImageVersion, s conversion.Scope) error {
-	return autoConvert_openstack_MachineImageVersion_To_v1alpha1_MachineImageVersion(in, out, s)
+// Convert_openstack_OpenStackMaintenanceList_To_v1alpha1_OpenStackMaintenanceList is an autogenerated conversion function.
+func Convert_openstack_OpenStackMaintenanceList_To_v1alpha1_OpenStackMaintenanceList(in *openstack.OpenStackMaintenanceList, out *OpenStackMaintenanceList, s conversion.Scope) error {
+	return autoConvert_openstack_OpenStackMaintenanceList_To_v1alpha1_OpenStackMaintenanceList(in, out, s)
 }
 
-func autoConvert_v1alpha1_MachineImages_To_openstack_MachineImages(in *MachineImages, out *openstack.MachineImages, s conversion.Scope) error {
-	out.Name = in.Name
-	out.Versions = *(*[]openstack.MachineImageVersion)(unsafe.Pointer(&in.Versions))
+func autoConvert_v1alpha1_OpenStackMaintenanceSpec_To_openstack_OpenStackMaintenanceSpec(in *OpenStackMaintenanceSpec, out *openstack.OpenStackMaintenanceSpec, s conversion.Scope) error {
+	out.Operation = openstack.MaintenanceOperation(in.Operation)
+	out.Node = (*string)(unsafe.Pointer(in.Node))
 	return nil
 }
 
-// Convert_v1alpha1_MachineImages_To_openstack_MachineImages is an autogenerated conversion function.
-func Convert_v1alpha1_MachineImages_To_openstack_MachineImages(in *MachineImages, out *openstack.MachineImages, s conversion.Scope) error {
-	return autoConvert_v1alpha1_MachineImages_To_openstack_MachineImages(in, out, s)
+// Convert_v1alpha1_OpenStackMaintenanceSpec_To_openstack_OpenStackMaintenanceSpec is an autogenerated conversion function.
+func Convert_v1alpha1_OpenStackMaintenanceSpec_To_openstack_OpenStackMaintenanceSpec(in *OpenStackMaintenanceSpec, out *openstack.OpenStackMaintenanceSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OpenStackMaintenanceSpec_To_openstack_OpenStackMaintenanceSpec(in, out, s)
 }
 
-func autoConvert_openstack_MachineImages_To_v1alpha1_MachineImages(in *openstack.MachineImages, out *MachineImages, s conversion.Scope) error {
-	out.Name = in.Name
-	out.Versions = *(*[]MachineImageVersion)(unsafe.Pointer(&in.Versions))
+func autoConvert_openstack_OpenStackMaintenanceSpec_To_v1alpha1_OpenStackMaintenanceSpec(in *openstack.OpenStackMaintenanceSpec, out *OpenStackMaintenanceSpec, s conversion.Scope) error {
+	out.Operation = MaintenanceOperation(in.Operation)
+	out.Node = (*string)(unsafe.Pointer(in.Node))
 	return nil
 }
 
-// Convert_openstack_MachineImages_To_v1alpha1_MachineImages is an autogenerated conversion function.
-func Convert_openstack_MachineImages_To_v1alpha1_MachineImages(in *openstack.MachineImages, out *MachineImages, s conversion.Scope) error {
-	return autoConvert_openstack_MachineImages_To_v1alpha1_MachineImages(in, out, s)
+// Convert_openstack_OpenStackMaintenanceSpec_To_v1alpha1_OpenStackMaintenanceSpec is an autogenerated conversion function.
+func Convert_openstack_OpenStackMaintenanceSpec_To_v1alpha1_OpenStackMaintenanceSpec(in *openstack.OpenStackMaintenanceSpec, out *OpenStackMaintenanceSpec, s conversion.Scope) error {
+	return autoConvert_openstack_OpenStackMaintenanceSpec_To_v1alpha1_OpenStackMaintenanceSpec(in, out, s)
 }
 
-func autoConvert_v1alpha1_MachineLabel_To_openstack_MachineLabel(in *MachineLabel, out *openstack.MachineLabel, s conversion.Scope) error {
-	out.Name = in.Name
-	out.Value = in.Value
-	out.TriggerRollingOnUpdate = in.TriggerRollingOnUpdate
+func autoConvert_v1alpha1_OpenStackMaintenanceStatus_To_openstack_OpenStackMaintenanceStatus(in *OpenStackMaintenanceStatus, out *openstack.OpenStackMaintenanceStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Phase = openstack.MaintenancePhase(in.Phase)
+	out.Message = in.Message
+	out.LastUpdateTime = (*v1.Time)(unsafe.Pointer(in.LastUpdateTime))
 	return nil
 }
 
-// Convert_v1alpha1_MachineLabel_To_openstack_MachineLabel is an autogenerated conversion function.
-func Convert_v1alpha1_MachineLabel_To_openstack_MachineLabel(in *MachineLabel, out *openstack.MachineLabel, s conversion.Scope) error {
-	return autoConvert_v1alpha1_MachineLabel_To_openstack_MachineLabel(in, out, s)
+// Convert_v1alpha1_OpenStackMaintenanceStatus_To_openstack_OpenStackMaintenanceStatus is an autogenerated conversion function.
+func Convert_v1alpha1_OpenStackMaintenanceStatus_To_openstack_OpenStackMaintenanceStatus(in *OpenStackMaintenanceStatus, out *openstack.OpenStackMaintenanceStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OpenStackMaintenanceStatus_To_openstack_OpenStackMaintenanceStatus(in, out, s)
 }
 
-func autoConvert_openstack_MachineLabel_To_v1alpha1_MachineLabel(in *openstack.MachineLabel, out *MachineLabel, s conversion.Scope) error {
-	out.Name = in.Name
-	out.Value = in.Value
-	out.TriggerRollingOnUpdate = in.TriggerRollingOnUpdate
+func autoConvert_openstack_OpenStackMaintenanceStatus_To_v1alpha1_OpenStackMaintenanceStatus(in *openstack.OpenStackMaintenanceStatus, out *OpenStackMaintenanceStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Phase = MaintenancePhase(in.Phase)
+	out.Message = in.Message
+	out.LastUpdateTime = (*v1.Time)(unsafe.Pointer(in.LastUpdateTime))
 	return nil
 }
 
-// Convert_openstack_MachineLabel_To_v1alpha1_MachineLabel is an autogenerated conversion function.
-func Convert_openstack_MachineLabel_To_v1alpha1_MachineLabel(in *openstack.MachineLabel, out *MachineLabel, s conversion.Scope) error {
-	return autoConvert_openstack_MachineLabel_To_v1alpha1_MachineLabel(in, out, s)
+// Convert_openstack_OpenStackMaintenanceStatus_To_v1alpha1_OpenStackMaintenanceStatus is an autogenerated conversion function.
+func Convert_openstack_OpenStackMaintenanceStatus_To_v1alpha1_OpenStackMaintenanceStatus(in *openstack.OpenStackMaintenanceStatus, out *OpenStackMaintenanceStatus, s conversion.Scope) error {
+	return autoConvert_openstack_OpenStackMaintenanceStatus_To_v1alpha1_OpenStackMaintenanceStatus(in, out, s)
 }
 
-func autoConvert_v1alpha1_NetworkStatus_To_openstack_NetworkStatus(in *NetworkStatus, out *openstack.NetworkStatus, s conversion.Scope) error {
-	out.ID = in.ID
-	out.Name = in.Name
-	if err := Convert_v1alpha1_FloatingPoolStatus_To_openstack_FloatingPoolStatus(&in.FloatingPool, &out.FloatingPool, s); err != nil {
-		return err
-	}
-	if err := Convert_v1alpha1_RouterStatus_To_openstack_RouterStatus(&in.Router, &out.Router, s); err != nil {
-		return err
-	}
-	out.Subnets = *(*[]openstack.Subnet)(unsafe.Pointer(&in.Subnets))
-	out.ShareNetwork = (*openstack.ShareNetworkStatus)(unsafe.Pointer(in.ShareNetwork))
+func autoConvert_v1alpha1_Pricing_To_openstack_Pricing(in *Pricing, out *openstack.Pricing, s conversion.Scope) error {
+	out.Currency = in.Currency
+	out.MachineTypes = *(*[]openstack.MachineTypePrice)(unsafe.Pointer(&in.MachineTypes))
+	out.VolumeTypes = *(*[]openstack.VolumeTypePrice)(unsafe.Pointer(&in.VolumeTypes))
 	return nil
 }
 
-// Convert_v1alpha1_NetworkStatus_To_openstack_NetworkStatus is an autogenerated conversion function.
-func Convert_v1alpha1_NetworkStatus_To_openstack_NetworkStatus(in *NetworkStatus, out *openstack.NetworkStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha1_NetworkStatus_To_openstack_NetworkStatus(in, out, s)
+// Convert_v1alpha1_Pricing_To_openstack_Pricing is an autogenerated conversion function.
+func Convert_v1alpha1_Pricing_To_openstack_Pricing(in *Pricing, out *openstack.Pricing, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Pricing_To_openstack_Pricing(in, out, s)
 }
 
-func autoConvert_openstack_NetworkStatus_To_v1alpha1_NetworkStatus(in *openstack.NetworkStatus, out *NetworkStatus, s conversion.Scope) error {
-	out.ID = in.ID
-	out.Name = in.Name
-	if err := Convert_openstack_FloatingPoolStatus_To_v1alpha1_FloatingPoolStatus(&in.FloatingPool, &out.FloatingPool, s); err != nil {
-		return err
-	}
-	if err := Convert_openstack_RouterStatus_To_v1alpha1_RouterStatus(&in.Router, &out.Router, s); err != nil {
-		return err
-	}
-	out.Subnets = *(*[]Subnet)(unsafe.Pointer(&in.Subnets))
-	out.ShareNetwork = (*ShareNetworkStatus)(unsafe.Pointer(in.ShareNetwork))
+func autoConvert_openstack_Pricing_To_v1alpha1_Pricing(in *openstack.Pricing, out *Pricing, s conversion.Scope) error {
+	out.Currency = in.Currency
+	out.MachineTypes = *(*[]MachineTypePrice)(unsafe.Pointer(&in.MachineTypes))
+	out.VolumeTypes = *(*[]VolumeTypePrice)(unsafe.Pointer(&in.VolumeTypes))
 	return nil
 }
 
-// Convert_openstack_NetworkStatus_To_v1alpha1_NetworkStatus is an autogenerated conversion function.
-func Convert_openstack_NetworkStatus_To_v1alpha1_NetworkStatus(in *openstack.NetworkStatus, out *NetworkStatus, s conversion.Scope) error {
-	return autoConvert_openstack_NetworkStatus_To_v1alpha1_NetworkStatus(in, out, s)
+// Convert_openstack_Pricing_To_v1alpha1_Pricing is an autogenerated conversion function.
+func Convert_openstack_Pricing_To_v1alpha1_Pricing(in *openstack.Pricing, out *Pricing, s conversion.Scope) error {
+	return autoConvert_openstack_Pricing_To_v1alpha1_Pricing(in, out, s)
 }
 
-func autoConvert_v1alpha1_Networks_To_openstack_Networks(in *Networks, out *openstack.Networks, s conversion.Scope) error {
-	out.Router = (*openstack.Router)(unsafe.Pointer(in.Router))
-	out.Worker = in.Worker
-	out.Workers = in.Workers
-	out.ID = (*string)(unsafe.Pointer(in.ID))
-	out.ShareNetwork = (*openstack.ShareNetwork)(unsafe.Pointer(in.ShareNetwork))
+func autoConvert_v1alpha1_ProjectStatus_To_openstack_ProjectStatus(in *ProjectStatus, out *openstack.ProjectStatus, s conversion.Scope) error {
+	out.ID = in.ID
+	out.Name = in.Name
 	return nil
 }
 
-// Convert_v1alpha1_Networks_To_openstack_Networks is an autogenerated conversion function.
-func Convert_v1alpha1_Networks_To_openstack_Networks(in *Networks, out *openstack.Networks, s conversion.Scope) error {
-	return autoConvert_v1alpha1_Networks_To_openstack_Networks(in, out, s)
+// Convert_v1alpha1_ProjectStatus_To_openstack_ProjectStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ProjectStatus_To_openstack_ProjectStatus(in *ProjectStatus, out *openstack.ProjectStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ProjectStatus_To_openstack_ProjectStatus(in, out, s)
 }
 
-func autoConvert_openstack_Networks_To_v1alpha1_Networks(in *openstack.Networks, out *Networks, s conversion.Scope) error {
-	out.Router = (*Router)(unsafe.Pointer(in.Router))
-	out.Worker = in.Worker
-	out.Workers = in.Workers
-	out.ID = (*string)(unsafe.Pointer(in.ID))
-	out.ShareNetwork = (*ShareNetwork)(unsafe.Pointer(in.ShareNetwork))
+func autoConvert_openstack_ProjectStatus_To_v1alpha1_ProjectStatus(in *openstack.ProjectStatus, out *ProjectStatus, s conversion.Scope) error {
+	out.ID = in.ID
+	out.Name = in.Name
 	return nil
 }
 
-// Convert_openstack_Networks_To_v1alpha1_Networks is an autogenerated conversion function.
-func Convert_openstack_Networks_To_v1alpha1_Networks(in *openstack.Networks, out *Networks, s conversion.Scope) error {
-	return autoConvert_openstack_Networks_To_v1alpha1_Networks(in, out, s)
+// Convert_openstack_ProjectStatus_To_v1alpha1_ProjectStatus is an autogenerated conversion function.
+func Convert_openstack_ProjectStatus_To_v1alpha1_ProjectStatus(in *openstack.ProjectStatus, out *ProjectStatus, s conversion.Scope) error {
+	return autoConvert_openstack_ProjectStatus_To_v1alpha1_ProjectStatus(in, out, s)
 }
 
-func autoConvert_v1alpha1_NodeStatus_To_openstack_NodeStatus(in *NodeStatus, out *openstack.NodeStatus, s conversion.Scope) error {
-	out.KeyName = in.KeyName
+func autoConvert_v1alpha1_RegionConfig_To_openstack_RegionConfig(in *RegionConfig, out *openstack.RegionConfig, s conversion.Scope) error {
+	out.Name = in.Name
+	out.FlavorFamilies = *(*[]string)(unsafe.Pointer(&in.FlavorFamilies))
+	out.ReservedAggregates = *(*[]openstack.ReservedAggregate)(unsafe.Pointer(&in.ReservedAggregates))
+	out.RootVolumeTypes = *(*map[string]string)(unsafe.Pointer(&in.RootVolumeTypes))
 	return nil
 }
 
-// Convert_v1alpha1_NodeStatus_To_openstack_NodeStatus is an autogenerated conversion function.
-func Convert_v1alpha1_NodeStatus_To_openstack_NodeStatus(in *NodeStatus, out *openstack.NodeStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha1_NodeStatus_To_openstack_NodeStatus(in, out, s)
+// Convert_v1alpha1_RegionConfig_To_openstack_RegionConfig is an autogenerated conversion function.
+func Convert_v1alpha1_RegionConfig_To_openstack_RegionConfig(in *RegionConfig, out *openstack.RegionConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RegionConfig_To_openstack_RegionConfig(in, out, s)
 }
 
-func autoConvert_openstack_NodeStatus_To_v1alpha1_NodeStatus(in *openstack.NodeStatus, out *NodeStatus, s conversion.Scope) error {
-	out.KeyName = in.KeyName
+func autoConvert_openstack_RegionConfig_To_v1alpha1_RegionConfig(in *openstack.RegionConfig, out *RegionConfig, s conversion.Scope) error {
+	out.Name = in.Name
+	out.FlavorFamilies = *(*[]string)(unsafe.Pointer(&in.FlavorFamilies))
+	out.ReservedAggregates = *(*[]ReservedAggregate)(unsafe.Pointer(&in.ReservedAggregates))
+	out.RootVolumeTypes = *(*map[string]string)(unsafe.Pointer(&in.RootVolumeTypes))
 	return nil
 }
 
-// Convert_openstack_NodeStatus_To_v1alpha1_NodeStatus is an autogenerated conversion function.
-func Convert_openstack_NodeStatus_To_v1alpha1_NodeStatus(in *openstack.NodeStatus, out *NodeStatus, s conversion.Scope) error {
-	return autoConvert_openstack_NodeStatus_To_v1alpha1_NodeStatus(in, out, s)
+// Convert_openstack_RegionConfig_To_v1alpha1_RegionConfig is an autogenerated conversion function.
+func Convert_openstack_RegionConfig_To_v1alpha1_RegionConfig(in *openstack.RegionConfig, out *RegionConfig, s conversion.Scope) error {
+	return autoConvert_openstack_RegionConfig_To_v1alpha1_RegionConfig(in, out, s)
 }
 
 func autoConvert_v1alpha1_RegionIDMapping_To_openstack_RegionIDMapping(in *RegionIDMapping, out *openstack.RegionIDMapping, s conversion.Scope) error {
@@ -905,6 +2264,54 @@ func Convert_openstack_RegionIDMapping_To_v1alpha1_RegionIDMapping(in *openstack
 	return autoConvert_openstack_RegionIDMapping_To_v1alpha1_RegionIDMapping(in, out, s)
 }
 
+func autoConvert_v1alpha1_ReservedAggregate_To_openstack_ReservedAggregate(in *ReservedAggregate, out *openstack.ReservedAggregate, s conversion.Scope) error {
+	out.Name = in.Name
+	out.FlavorFamilies = *(*[]string)(unsafe.Pointer(&in.FlavorFamilies))
+	return nil
+}
+
+// Convert_v1alpha1_ReservedAggregate_To_openstack_ReservedAggregate is an autogenerated conversion function.
+func Convert_v1alpha1_ReservedAggregate_To_openstack_ReservedAggregate(in *ReservedAggregate, out *openstack.ReservedAggregate, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ReservedAggregate_To_openstack_ReservedAggregate(in, out, s)
+}
+
+func autoConvert_openstack_ReservedAggregate_To_v1alpha1_ReservedAggregate(in *openstack.ReservedAggregate, out *ReservedAggregate, s conversion.Scope) error {
+	out.Name = in.Name
+	out.FlavorFamilies = *(*[]string)(unsafe.Pointer(&in.FlavorFamilies))
+	return nil
+}
+
+// Convert_openstack_ReservedAggregate_To_v1alpha1_ReservedAggregate is an autogenerated conversion function.
+func Convert_openstack_ReservedAggregate_To_v1alpha1_ReservedAggregate(in *openstack.ReservedAggregate, out *ReservedAggregate, s conversion.Scope) error {
+	return autoConvert_openstack_ReservedAggregate_To_v1alpha1_ReservedAggregate(in, out, s)
+}
+
+func autoConvert_v1alpha1_RootVolume_To_openstack_RootVolume(in *RootVolume, out *openstack.RootVolume, s conversion.Scope) error {
+	out.Size = (*resource.Quantity)(unsafe.Pointer(in.Size))
+	out.Type = (*string)(unsafe.Pointer(in.Type))
+	out.ZoneTypes = *(*map[string]string)(unsafe.Pointer(&in.ZoneTypes))
+	out.DeleteOnTermination = (*bool)(unsafe.Pointer(in.DeleteOnTermination))
+	return nil
+}
+
+// Convert_v1alpha1_RootVolume_To_openstack_RootVolume is an autogenerated conversion function.
+func Convert_v1alpha1_RootVolume_To_openstack_RootVolume(in *RootVolume, out *openstack.RootVolume, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RootVolume_To_openstack_RootVolume(in, out, s)
+}
+
+func autoConvert_openstack_RootVolume_To_v1alpha1_RootVolume(in *openstack.RootVolume, out *RootVolume, s conversion.Scope) error {
+	out.Size = (*resource.Quantity)(unsafe.Pointer(in.Size))
+	out.Type = (*string)(unsafe.Pointer(in.Type))
+	out.ZoneTypes = *(*map[string]string)(unsafe.Pointer(&in.ZoneTypes))
+	out.DeleteOnTermination = (*bool)(unsafe.Pointer(in.DeleteOnTermination))
+	return nil
+}
+
+// Convert_openstack_RootVolume_To_v1alpha1_RootVolume is an autogenerated conversion function.
+func Convert_openstack_RootVolume_To_v1alpha1_RootVolume(in *openstack.RootVolume, out *RootVolume, s conversion.Scope) error {
+	return autoConvert_openstack_RootVolume_To_v1alpha1_RootVolume(in, out, s)
+}
+
 func autoConvert_v1alpha1_Router_To_openstack_Router(in *Router, out *openstack.Router, s conversion.Scope) error {
 	out.ID = in.ID
 	return nil
@@ -971,8 +2378,29 @@ func Convert_openstack_SecurityGroup_To_v1alpha1_SecurityGroup(in *openstack.Sec
 	return autoConvert_openstack_SecurityGroup_To_v1alpha1_SecurityGroup(in, out, s)
 }
 
+func autoConvert_v1alpha1_SecurityGroupRules_To_openstack_SecurityGroupRules(in *SecurityGroupRules, out *openstack.SecurityGroupRules, s conversion.Scope) error {
+	out.IPv6 = (*bool)(unsafe.Pointer(in.IPv6))
+	return nil
+}
+
+// Convert_v1alpha1_SecurityGroupRules_To_openstack_SecurityGroupRules is an autogenerated conversion function.
+func Convert_v1alpha1_SecurityGroupRules_To_openstack_SecurityGroupRules(in *SecurityGroupRules, out *openstack.SecurityGroupRules, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SecurityGroupRules_To_openstack_SecurityGroupRules(in, out, s)
+}
+
+func autoConvert_openstack_SecurityGroupRules_To_v1alpha1_SecurityGroupRules(in *openstack.SecurityGroupRules, out *SecurityGroupRules, s conversion.Scope) error {
+	out.IPv6 = (*bool)(unsafe.Pointer(in.IPv6))
+	return nil
+}
+
+// Convert_openstack_SecurityGroupRules_To_v1alpha1_SecurityGroupRules is an autogenerated conversion function.
+func Convert_openstack_SecurityGroupRules_To_v1alpha1_SecurityGroupRules(in *openstack.SecurityGroupRules, out *SecurityGroupRules, s conversion.Scope) error {
+	return autoConvert_openstack_SecurityGroupRules_To_v1alpha1_SecurityGroupRules(in, out, s)
+}
+
 func autoConvert_v1alpha1_ServerGroup_To_openstack_ServerGroup(in *ServerGroup, out *openstack.ServerGroup, s conversion.Scope) error {
 	out.Policy = in.Policy
+	out.PerZone = (*bool)(unsafe.Pointer(in.PerZone))
 	return nil
 }
 
@@ -983,6 +2411,7 @@ func Convert_v1alpha1_ServerGroup_To_openstack_ServerGroup(in *ServerGroup, out
 
 func autoConvert_openstack_ServerGroup_To_v1alpha1_ServerGroup(in *openstack.ServerGroup, out *ServerGroup, s conversion.Scope) error {
 	out.Policy = in.Policy
+	out.PerZone = (*bool)(unsafe.Pointer(in.PerZone))
 	return nil
 }
 
@@ -995,6 +2424,7 @@ func autoConvert_v1alpha1_ServerGroupDependency_To_openstack_ServerGroupDependen
 	out.PoolName = in.PoolName
 	out.ID = in.ID
 	out.Name = in.Name
+	out.Zone = (*string)(unsafe.Pointer(in.Zone))
 	return nil
 }
 
@@ -1007,6 +2437,7 @@ func autoConvert_openstack_ServerGroupDependency_To_v1alpha1_ServerGroupDependen
 	out.PoolName = in.PoolName
 	out.ID = in.ID
 	out.Name = in.Name
+	out.Zone = (*string)(unsafe.Pointer(in.Zone))
 	return nil
 }
 
@@ -1015,6 +2446,28 @@ func Convert_openstack_ServerGroupDependency_To_v1alpha1_ServerGroupDependency(i
 	return autoConvert_openstack_ServerGroupDependency_To_v1alpha1_ServerGroupDependency(in, out, s)
 }
 
+func autoConvert_v1alpha1_ServerMetadata_To_openstack_ServerMetadata(in *ServerMetadata, out *openstack.ServerMetadata, s conversion.Scope) error {
+	out.Items = *(*map[string]string)(unsafe.Pointer(&in.Items))
+	out.TriggerRollingOnUpdate = in.TriggerRollingOnUpdate
+	return nil
+}
+
+// Convert_v1alpha1_ServerMetadata_To_openstack_ServerMetadata is an autogenerated conversion function.
+func Convert_v1alpha1_ServerMetadata_To_openstack_ServerMetadata(in *ServerMetadata, out *openstack.ServerMetadata, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ServerMetadata_To_openstack_ServerMetadata(in, out, s)
+}
+
+func autoConvert_openstack_ServerMetadata_To_v1alpha1_ServerMetadata(in *openstack.ServerMetadata, out *ServerMetadata, s conversion.Scope) error {
+	out.Items = *(*map[string]string)(unsafe.Pointer(&in.Items))
+	out.TriggerRollingOnUpdate = in.TriggerRollingOnUpdate
+	return nil
+}
+
+// Convert_openstack_ServerMetadata_To_v1alpha1_ServerMetadata is an autogenerated conversion function.
+func Convert_openstack_ServerMetadata_To_v1alpha1_ServerMetadata(in *openstack.ServerMetadata, out *ServerMetadata, s conversion.Scope) error {
+	return autoConvert_openstack_ServerMetadata_To_v1alpha1_ServerMetadata(in, out, s)
+}
+
 func autoConvert_v1alpha1_ShareNetwork_To_openstack_ShareNetwork(in *ShareNetwork, out *openstack.ShareNetwork, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	return nil
@@ -1057,6 +2510,30 @@ func Convert_openstack_ShareNetworkStatus_To_v1alpha1_ShareNetworkStatus(in *ope
 	return autoConvert_openstack_ShareNetworkStatus_To_v1alpha1_ShareNetworkStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_SharedNetwork_To_openstack_SharedNetwork(in *SharedNetwork, out *openstack.SharedNetwork, s conversion.Scope) error {
+	out.ID = in.ID
+	out.CIDR = in.CIDR
+	out.PrefixLength = (*int32)(unsafe.Pointer(in.PrefixLength))
+	return nil
+}
+
+// Convert_v1alpha1_SharedNetwork_To_openstack_SharedNetwork is an autogenerated conversion function.
+func Convert_v1alpha1_SharedNetwork_To_openstack_SharedNetwork(in *SharedNetwork, out *openstack.SharedNetwork, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SharedNetwork_To_openstack_SharedNetwork(in, out, s)
+}
+
+func autoConvert_openstack_SharedNetwork_To_v1alpha1_SharedNetwork(in *openstack.SharedNetwork, out *SharedNetwork, s conversion.Scope) error {
+	out.ID = in.ID
+	out.CIDR = in.CIDR
+	out.PrefixLength = (*int32)(unsafe.Pointer(in.PrefixLength))
+	return nil
+}
+
+// Convert_openstack_SharedNetwork_To_v1alpha1_SharedNetwork is an autogenerated conversion function.
+func Convert_openstack_SharedNetwork_To_v1alpha1_SharedNetwork(in *openstack.SharedNetwork, out *SharedNetwork, s conversion.Scope) error {
+	return autoConvert_openstack_SharedNetwork_To_v1alpha1_SharedNetwork(in, out, s)
+}
+
 func autoConvert_v1alpha1_Storage_To_openstack_Storage(in *Storage, out *openstack.Storage, s conversion.Scope) error {
 	out.CSIManila = (*openstack.CSIManila)(unsafe.Pointer(in.CSIManila))
 	return nil
@@ -1114,6 +2591,7 @@ func Convert_openstack_StorageClassDefinition_To_v1alpha1_StorageClassDefinition
 func autoConvert_v1alpha1_Subnet_To_openstack_Subnet(in *Subnet, out *openstack.Subnet, s conversion.Scope) error {
 	out.Purpose = openstack.Purpose(in.Purpose)
 	out.ID = in.ID
+	out.Name = (*string)(unsafe.Pointer(in.Name))
 	return nil
 }
 
@@ -1125,6 +2603,7 @@ func Convert_v1alpha1_Subnet_To_openstack_Subnet(in *Subnet, out *openstack.Subn
 func autoConvert_openstack_Subnet_To_v1alpha1_Subnet(in *openstack.Subnet, out *Subnet, s conversion.Scope) error {
 	out.Purpose = Purpose(in.Purpose)
 	out.ID = in.ID
+	out.Name = (*string)(unsafe.Pointer(in.Name))
 	return nil
 }
 
@@ -1133,10 +2612,145 @@ func Convert_openstack_Subnet_To_v1alpha1_Subnet(in *openstack.Subnet, out *Subn
 	return autoConvert_openstack_Subnet_To_v1alpha1_Subnet(in, out, s)
 }
 
+func autoConvert_v1alpha1_TempURLConfig_To_openstack_TempURLConfig(in *TempURLConfig, out *openstack.TempURLConfig, s conversion.Scope) error {
+	out.TTL = (*v1.Duration)(unsafe.Pointer(in.TTL))
+	return nil
+}
+
+// Convert_v1alpha1_TempURLConfig_To_openstack_TempURLConfig is an autogenerated conversion function.
+func Convert_v1alpha1_TempURLConfig_To_openstack_TempURLConfig(in *TempURLConfig, out *openstack.TempURLConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TempURLConfig_To_openstack_TempURLConfig(in, out, s)
+}
+
+func autoConvert_openstack_TempURLConfig_To_v1alpha1_TempURLConfig(in *openstack.TempURLConfig, out *TempURLConfig, s conversion.Scope) error {
+	out.TTL = (*v1.Duration)(unsafe.Pointer(in.TTL))
+	return nil
+}
+
+// Convert_openstack_TempURLConfig_To_v1alpha1_TempURLConfig is an autogenerated conversion function.
+func Convert_openstack_TempURLConfig_To_v1alpha1_TempURLConfig(in *openstack.TempURLConfig, out *TempURLConfig, s conversion.Scope) error {
+	return autoConvert_openstack_TempURLConfig_To_v1alpha1_TempURLConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_TemplateReference_To_openstack_TemplateReference(in *TemplateReference, out *openstack.TemplateReference, s conversion.Scope) error {
+	out.ResourceName = in.ResourceName
+	out.Key = (*string)(unsafe.Pointer(in.Key))
+	return nil
+}
+
+// Convert_v1alpha1_TemplateReference_To_openstack_TemplateReference is an autogenerated conversion function.
+func Convert_v1alpha1_TemplateReference_To_openstack_TemplateReference(in *TemplateReference, out *openstack.TemplateReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TemplateReference_To_openstack_TemplateReference(in, out, s)
+}
+
+func autoConvert_openstack_TemplateReference_To_v1alpha1_TemplateReference(in *openstack.TemplateReference, out *TemplateReference, s conversion.Scope) error {
+	out.ResourceName = in.ResourceName
+	out.Key = (*string)(unsafe.Pointer(in.Key))
+	return nil
+}
+
+// Convert_openstack_TemplateReference_To_v1alpha1_TemplateReference is an autogenerated conversion function.
+func Convert_openstack_TemplateReference_To_v1alpha1_TemplateReference(in *openstack.TemplateReference, out *TemplateReference, s conversion.Scope) error {
+	return autoConvert_openstack_TemplateReference_To_v1alpha1_TemplateReference(in, out, s)
+}
+
+func autoConvert_v1alpha1_VGPU_To_openstack_VGPU(in *VGPU, out *openstack.VGPU, s conversion.Scope) error {
+	out.TimeSlicingReplicas = (*int32)(unsafe.Pointer(in.TimeSlicingReplicas))
+	out.DevicePluginConfig = (*string)(unsafe.Pointer(in.DevicePluginConfig))
+	out.Taint = (*bool)(unsafe.Pointer(in.Taint))
+	return nil
+}
+
+// Convert_v1alpha1_VGPU_To_openstack_VGPU is an autogenerated conversion function.
+func Convert_v1alpha1_VGPU_To_openstack_VGPU(in *VGPU, out *openstack.VGPU, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VGPU_To_openstack_VGPU(in, out, s)
+}
+
+func autoConvert_openstack_VGPU_To_v1alpha1_VGPU(in *openstack.VGPU, out *VGPU, s conversion.Scope) error {
+	out.TimeSlicingReplicas = (*int32)(unsafe.Pointer(in.TimeSlicingReplicas))
+	out.DevicePluginConfig = (*string)(unsafe.Pointer(in.DevicePluginConfig))
+	out.Taint = (*bool)(unsafe.Pointer(in.Taint))
+	return nil
+}
+
+// Convert_openstack_VGPU_To_v1alpha1_VGPU is an autogenerated conversion function.
+func Convert_openstack_VGPU_To_v1alpha1_VGPU(in *openstack.VGPU, out *VGPU, s conversion.Scope) error {
+	return autoConvert_openstack_VGPU_To_v1alpha1_VGPU(in, out, s)
+}
+
+func autoConvert_v1alpha1_VerifiedImage_To_openstack_VerifiedImage(in *VerifiedImage, out *openstack.VerifiedImage, s conversion.Scope) error {
+	out.Region = in.Region
+	out.Image = in.Image
+	out.ID = in.ID
+	return nil
+}
+
+// Convert_v1alpha1_VerifiedImage_To_openstack_VerifiedImage is an autogenerated conversion function.
+func Convert_v1alpha1_VerifiedImage_To_openstack_VerifiedImage(in *VerifiedImage, out *openstack.VerifiedImage, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VerifiedImage_To_openstack_VerifiedImage(in, out, s)
+}
+
+func autoConvert_openstack_VerifiedImage_To_v1alpha1_VerifiedImage(in *openstack.VerifiedImage, out *VerifiedImage, s conversion.Scope) error {
+	out.Region = in.Region
+	out.Image = in.Image
+	out.ID = in.ID
+	return nil
+}
+
+// Convert_openstack_VerifiedImage_To_v1alpha1_VerifiedImage is an autogenerated conversion function.
+func Convert_openstack_VerifiedImage_To_v1alpha1_VerifiedImage(in *openstack.VerifiedImage, out *VerifiedImage, s conversion.Scope) error {
+	return autoConvert_openstack_VerifiedImage_To_v1alpha1_VerifiedImage(in, out, s)
+}
+
+func autoConvert_v1alpha1_VolumeTypePrice_To_openstack_VolumeTypePrice(in *VolumeTypePrice, out *openstack.VolumeTypePrice, s conversion.Scope) error {
+	out.Name = in.Name
+	out.PricePerGiBMonth = in.PricePerGiBMonth
+	return nil
+}
+
+// Convert_v1alpha1_VolumeTypePrice_To_openstack_VolumeTypePrice is an autogenerated conversion function.
+func Convert_v1alpha1_VolumeTypePrice_To_openstack_VolumeTypePrice(in *VolumeTypePrice, out *openstack.VolumeTypePrice, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VolumeTypePrice_To_openstack_VolumeTypePrice(in, out, s)
+}
+
+func autoConvert_openstack_VolumeTypePrice_To_v1alpha1_VolumeTypePrice(in *openstack.VolumeTypePrice, out *VolumeTypePrice, s conversion.Scope) error {
+	out.Name = in.Name
+	out.PricePerGiBMonth = in.PricePerGiBMonth
+	return nil
+}
+
+// Convert_openstack_VolumeTypePrice_To_v1alpha1_VolumeTypePrice is an autogenerated conversion function.
+func Convert_openstack_VolumeTypePrice_To_v1alpha1_VolumeTypePrice(in *openstack.VolumeTypePrice, out *VolumeTypePrice, s conversion.Scope) error {
+	return autoConvert_openstack_VolumeTypePrice_To_v1alpha1_VolumeTypePrice(in, out, s)
+}
+
 func autoConvert_v1alpha1_WorkerConfig_To_openstack_WorkerConfig(in *WorkerConfig, out *openstack.WorkerConfig, s conversion.Scope) error {
 	out.NodeTemplate = (*extensionsv1alpha1.NodeTemplate)(unsafe.Pointer(in.NodeTemplate))
 	out.ServerGroup = (*openstack.ServerGroup)(unsafe.Pointer(in.ServerGroup))
 	out.MachineLabels = *(*[]openstack.MachineLabel)(unsafe.Pointer(&in.MachineLabels))
+	out.Canary = (*openstack.CanaryRollout)(unsafe.Pointer(in.Canary))
+	out.ZoneRollout = (*openstack.ZoneRollout)(unsafe.Pointer(in.ZoneRollout))
+	out.UpdateStrategy = (*openstack.UpdateStrategyType)(unsafe.Pointer(in.UpdateStrategy))
+	out.VGPU = (*openstack.VGPU)(unsafe.Pointer(in.VGPU))
+	out.MachineClassExtra = *(*map[string]apiextensionsv1.JSON)(unsafe.Pointer(&in.MachineClassExtra))
+	out.HostPinning = *(*[]openstack.HostPinning)(unsafe.Pointer(&in.HostPinning))
+	out.RootVolume = (*openstack.RootVolume)(unsafe.Pointer(in.RootVolume))
+	out.DataVolumes = *(*[]openstack.DataVolume)(unsafe.Pointer(&in.DataVolumes))
+	out.ConfigDrive = (*bool)(unsafe.Pointer(in.ConfigDrive))
+	out.ServerMetadata = (*openstack.ServerMetadata)(unsafe.Pointer(in.ServerMetadata))
+	out.Networks = *(*[]openstack.WorkerNetwork)(unsafe.Pointer(&in.Networks))
+	out.AllowedAddressPairs = *(*[]openstack.AllowedAddressPair)(unsafe.Pointer(&in.AllowedAddressPairs))
+	out.AdditionalSecurityGroups = *(*[]string)(unsafe.Pointer(&in.AdditionalSecurityGroups))
+	out.PortSecurityEnabled = (*bool)(unsafe.Pointer(in.PortSecurityEnabled))
+	out.QoSPolicyID = (*string)(unsafe.Pointer(in.QoSPolicyID))
+	out.Subnet = (*string)(unsafe.Pointer(in.Subnet))
+	out.SchedulerHints = *(*map[string][]string)(unsafe.Pointer(&in.SchedulerHints))
+	out.Preemptible = (*bool)(unsafe.Pointer(in.Preemptible))
+	out.KeyName = (*string)(unsafe.Pointer(in.KeyName))
+	out.TrunkPort = (*bool)(unsafe.Pointer(in.TrunkPort))
+	out.TuningProfile = (*openstack.TuningProfile)(unsafe.Pointer(in.TuningProfile))
+	out.UserDataFormat = (*openstack.UserDataFormat)(unsafe.Pointer(in.UserDataFormat))
+	out.BareMetal = (*openstack.BareMetal)(unsafe.Pointer(in.BareMetal))
 	return nil
 }
 
@@ -1149,6 +2763,29 @@ func autoConvert_openstack_WorkerConfig_To_v1alpha1_WorkerConfig(in *openstack.W
 	out.NodeTemplate = (*extensionsv1alpha1.NodeTemplate)(unsafe.Pointer(in.NodeTemplate))
 	out.ServerGroup = (*ServerGroup)(unsafe.Pointer(in.ServerGroup))
 	out.MachineLabels = *(*[]MachineLabel)(unsafe.Pointer(&in.MachineLabels))
+	out.Canary = (*CanaryRollout)(unsafe.Pointer(in.Canary))
+	out.ZoneRollout = (*ZoneRollout)(unsafe.Pointer(in.ZoneRollout))
+	out.UpdateStrategy = (*UpdateStrategyType)(unsafe.Pointer(in.UpdateStrategy))
+	out.VGPU = (*VGPU)(unsafe.Pointer(in.VGPU))
+	out.MachineClassExtra = *(*map[string]apiextensionsv1.JSON)(unsafe.Pointer(&in.MachineClassExtra))
+	out.HostPinning = *(*[]HostPinning)(unsafe.Pointer(&in.HostPinning))
+	out.RootVolume = (*RootVolume)(unsafe.Pointer(in.RootVolume))
+	out.DataVolumes = *(*[]DataVolume)(unsafe.Pointer(&in.DataVolumes))
+	out.ConfigDrive = (*bool)(unsafe.Pointer(in.ConfigDrive))
+	out.ServerMetadata = (*ServerMetadata)(unsafe.Pointer(in.ServerMetadata))
+	out.Networks = *(*[]WorkerNetwork)(unsafe.Pointer(&in.Networks))
+	out.AllowedAddressPairs = *(*[]AllowedAddressPair)(unsafe.Pointer(&in.AllowedAddressPairs))
+	out.AdditionalSecurityGroups = *(*[]string)(unsafe.Pointer(&in.AdditionalSecurityGroups))
+	out.PortSecurityEnabled = (*bool)(unsafe.Pointer(in.PortSecurityEnabled))
+	out.QoSPolicyID = (*string)(unsafe.Pointer(in.QoSPolicyID))
+	out.Subnet = (*string)(unsafe.Pointer(in.Subnet))
+	out.SchedulerHints = *(*map[string][]string)(unsafe.Pointer(&in.SchedulerHints))
+	out.Preemptible = (*bool)(unsafe.Pointer(in.Preemptible))
+	out.KeyName = (*string)(unsafe.Pointer(in.KeyName))
+	out.TrunkPort = (*bool)(unsafe.Pointer(in.TrunkPort))
+	out.TuningProfile = (*TuningProfile)(unsafe.Pointer(in.TuningProfile))
+	out.UserDataFormat = (*UserDataFormat)(unsafe.Pointer(in.UserDataFormat))
+	out.BareMetal = (*BareMetal)(unsafe.Pointer(in.BareMetal))
 	return nil
 }
 
@@ -1157,20 +2794,81 @@ func Convert_openstack_WorkerConfig_To_v1alpha1_WorkerConfig(in *openstack.Worke
 	return autoConvert_openstack_WorkerConfig_To_v1alpha1_WorkerConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_WorkerNetwork_To_openstack_WorkerNetwork(in *WorkerNetwork, out *openstack.WorkerNetwork, s conversion.Scope) error {
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	out.Name = (*string)(unsafe.Pointer(in.Name))
+	return nil
+}
+
+// Convert_v1alpha1_WorkerNetwork_To_openstack_WorkerNetwork is an autogenerated conversion function.
+func Convert_v1alpha1_WorkerNetwork_To_openstack_WorkerNetwork(in *WorkerNetwork, out *openstack.WorkerNetwork, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkerNetwork_To_openstack_WorkerNetwork(in, out, s)
+}
+
+func autoConvert_openstack_WorkerNetwork_To_v1alpha1_WorkerNetwork(in *openstack.WorkerNetwork, out *WorkerNetwork, s conversion.Scope) error {
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	out.Name = (*string)(unsafe.Pointer(in.Name))
+	return nil
+}
+
+// Convert_openstack_WorkerNetwork_To_v1alpha1_WorkerNetwork is an autogenerated conversion function.
+func Convert_openstack_WorkerNetwork_To_v1alpha1_WorkerNetwork(in *openstack.WorkerNetwork, out *WorkerNetwork, s conversion.Scope) error {
+	return autoConvert_openstack_WorkerNetwork_To_v1alpha1_WorkerNetwork(in, out, s)
+}
+
+func autoConvert_v1alpha1_WorkerPoolStatus_To_openstack_WorkerPoolStatus(in *WorkerPoolStatus, out *openstack.WorkerPoolStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Flavor = in.Flavor
+	out.ImageID = in.ImageID
+	out.ImageName = in.ImageName
+	out.ServerGroupID = (*string)(unsafe.Pointer(in.ServerGroupID))
+	out.SecurityGroups = *(*[]string)(unsafe.Pointer(&in.SecurityGroups))
+	out.NetworkID = in.NetworkID
+	out.SubnetID = in.SubnetID
+	out.AvailabilityZones = *(*[]string)(unsafe.Pointer(&in.AvailabilityZones))
+	out.AdditionalNetworks = *(*[]openstack.WorkerNetwork)(unsafe.Pointer(&in.AdditionalNetworks))
+	return nil
+}
+
+// Convert_v1alpha1_WorkerPoolStatus_To_openstack_WorkerPoolStatus is an autogenerated conversion function.
+func Convert_v1alpha1_WorkerPoolStatus_To_openstack_WorkerPoolStatus(in *WorkerPoolStatus, out *openstack.WorkerPoolStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkerPoolStatus_To_openstack_WorkerPoolStatus(in, out, s)
+}
+
+func autoConvert_openstack_WorkerPoolStatus_To_v1alpha1_WorkerPoolStatus(in *openstack.WorkerPoolStatus, out *WorkerPoolStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Flavor = in.Flavor
+	out.ImageID = in.ImageID
+	out.ImageName = in.ImageName
+	out.ServerGroupID = (*string)(unsafe.Pointer(in.ServerGroupID))
+	out.SecurityGroups = *(*[]string)(unsafe.Pointer(&in.SecurityGroups))
+	out.NetworkID = in.NetworkID
+	out.SubnetID = in.SubnetID
+	out.AvailabilityZones = *(*[]string)(unsafe.Pointer(&in.AvailabilityZones))
+	out.AdditionalNetworks = *(*[]WorkerNetwork)(unsafe.Pointer(&in.AdditionalNetworks))
+	return nil
+}
+
+// Convert_openstack_WorkerPoolStatus_To_v1alpha1_WorkerPoolStatus is an autogenerated conversion function.
+func Convert_openstack_WorkerPoolStatus_To_v1alpha1_WorkerPoolStatus(in *openstack.WorkerPoolStatus, out *WorkerPoolStatus, s conversion.Scope) error {
+	return autoConvert_openstack_WorkerPoolStatus_To_v1alpha1_WorkerPoolStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_WorkerStatus_To_openstack_WorkerStatus(in *WorkerStatus, out *openstack.WorkerStatus, s conversion.Scope) error {
 	out.MachineImages = *(*[]openstack.MachineImage)(unsafe.Pointer(&in.MachineImages))
 	out.ServerGroupDependencies = *(*[]openstack.ServerGroupDependency)(unsafe.Pointer(&in.ServerGroupDependencies))
+	out.FailedMachines = *(*[]openstack.FailedMachine)(unsafe.Pointer(&in.FailedMachines))
+	out.VerifiedImages = *(*[]openstack.VerifiedImage)(unsafe.Pointer(&in.VerifiedImages))
+	out.WorkerPools = *(*[]openstack.WorkerPoolStatus)(unsafe.Pointer(&in.WorkerPools))
 	return nil
 }
 
-// Convert_v1alpha1_WorkerStatus_To_openstack_WorkerStatus is an autogenerated conversion function.
-func Convert_v1alpha1_WorkerStatus_To_openstack_WorkerStatus(in *WorkerStatus, out *openstack.WorkerStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha1_WorkerStatus_To_openstack_WorkerStatus(in, out, s)
-}
-
 func autoConvert_openstack_WorkerStatus_To_v1alpha1_WorkerStatus(in *openstack.WorkerStatus, out *WorkerStatus, s conversion.Scope) error {
 	out.MachineImages = *(*[]MachineImage)(unsafe.Pointer(&in.MachineImages))
 	out.ServerGroupDependencies = *(*[]ServerGroupDependency)(unsafe.Pointer(&in.ServerGroupDependencies))
+	out.FailedMachines = *(*[]FailedMachine)(unsafe.Pointer(&in.FailedMachines))
+	out.VerifiedImages = *(*[]VerifiedImage)(unsafe.Pointer(&in.VerifiedImages))
+	out.WorkerPools = *(*[]WorkerPoolStatus)(unsafe.Pointer(&in.WorkerPools))
 	return nil
 }
 
@@ -1178,3 +2876,23 @@ func autoConvert_openstack_WorkerStatus_To_v1alpha1_WorkerStatus(in *openstack.W
 func Convert_openstack_WorkerStatus_To_v1alpha1_WorkerStatus(in *openstack.WorkerStatus, out *WorkerStatus, s conversion.Scope) error {
 	return autoConvert_openstack_WorkerStatus_To_v1alpha1_WorkerStatus(in, out, s)
 }
+
+func autoConvert_v1alpha1_ZoneRollout_To_openstack_ZoneRollout(in *ZoneRollout, out *openstack.ZoneRollout, s conversion.Scope) error {
+	out.GracePeriod = (*v1.Duration)(unsafe.Pointer(in.GracePeriod))
+	return nil
+}
+
+// Convert_v1alpha1_ZoneRollout_To_openstack_ZoneRollout is an autogenerated conversion function.
+func Convert_v1alpha1_ZoneRollout_To_openstack_ZoneRollout(in *ZoneRollout, out *openstack.ZoneRollout, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ZoneRollout_To_openstack_ZoneRollout(in, out, s)
+}
+
+func autoConvert_openstack_ZoneRollout_To_v1alpha1_ZoneRollout(in *openstack.ZoneRollout, out *ZoneRollout, s conversion.Scope) error {
+	out.GracePeriod = (*v1.Duration)(unsafe.Pointer(in.GracePeriod))
+	return nil
+}
+
+// Convert_openstack_ZoneRollout_To_v1alpha1_ZoneRollout is an autogenerated conversion function.
+func Convert_openstack_ZoneRollout_To_v1alpha1_ZoneRollout(in *openstack.ZoneRollout, out *ZoneRollout, s conversion.Scope) error {
+	return autoConvert_openstack_ZoneRollout_To_v1alpha1_ZoneRollout(in, out, s)
+}
@@ -23,13 +23,247 @@ package v1alpha1
 
 import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdditionalFloatingPool) DeepCopyInto(out *AdditionalFloatingPool) {
+	*out = *in
+	if in.FloatingSubnetName != nil {
+		in, out := &in.FloatingSubnetName, &out.FloatingSubnetName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdditionalFloatingPool.
+func (in *AdditionalFloatingPool) DeepCopy() *AdditionalFloatingPool {
+	if in == nil {
+		return nil
+	}
+	out := new(AdditionalFloatingPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AllowedAddressPair) DeepCopyInto(out *AllowedAddressPair) {
+	*out = *in
+	if in.MACAddress != nil {
+		in, out := &in.MACAddress, &out.MACAddress
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AllowedAddressPair.
+func (in *AllowedAddressPair) DeepCopy() *AllowedAddressPair {
+	if in == nil {
+		return nil
+	}
+	out := new(AllowedAddressPair)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationCredential) DeepCopyInto(out *ApplicationCredential) {
+	*out = *in
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationCredential.
+func (in *ApplicationCredential) DeepCopy() *ApplicationCredential {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationCredential)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuxiliaryStack) DeepCopyInto(out *AuxiliaryStack) {
+	*out = *in
+	in.TemplateRef.DeepCopyInto(&out.TemplateRef)
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuxiliaryStack.
+func (in *AuxiliaryStack) DeepCopy() *AuxiliaryStack {
+	if in == nil {
+		return nil
+	}
+	out := new(AuxiliaryStack)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupBucketConfig) DeepCopyInto(out *BackupBucketConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.SegmentSize != nil {
+		in, out := &in.SegmentSize, &out.SegmentSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.TempURL != nil {
+		in, out := &in.TempURL, &out.TempURL
+		*out = new(TempURLConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IntegrityCheck != nil {
+		in, out := &in.IntegrityCheck, &out.IntegrityCheck
+		*out = new(IntegrityCheckConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CredentialsSecretName != nil {
+		in, out := &in.CredentialsSecretName, &out.CredentialsSecretName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupBucketConfig.
+func (in *BackupBucketConfig) DeepCopy() *BackupBucketConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupBucketConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackupBucketConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BareMetal) DeepCopyInto(out *BareMetal) {
+	*out = *in
+	if in.IgnoreAvailabilityZones != nil {
+		in, out := &in.IgnoreAvailabilityZones, &out.IgnoreAvailabilityZones
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Capabilities != nil {
+		in, out := &in.Capabilities, &out.Capabilities
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BareMetal.
+func (in *BareMetal) DeepCopy() *BareMetal {
+	if in == nil {
+		return nil
+	}
+	out := new(BareMetal)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BastionAudit) DeepCopyInto(out *BastionAudit) {
+	*out = *in
+	if in.LogLevel != nil {
+		in, out := &in.LogLevel, &out.LogLevel
+		*out = new(string)
+		**out = **in
+	}
+	if in.ForceCommand != nil {
+		in, out := &in.ForceCommand, &out.ForceCommand
+		*out = new(string)
+		**out = **in
+	}
+	if in.UserData != nil {
+		in, out := &in.UserData, &out.UserData
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BastionAudit.
+func (in *BastionAudit) DeepCopy() *BastionAudit {
+	if in == nil {
+		return nil
+	}
+	out := new(BastionAudit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BastionConfig) DeepCopyInto(out *BastionConfig) {
+	*out = *in
+	if in.Audit != nil {
+		in, out := &in.Audit, &out.Audit
+		*out = new(BastionAudit)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BastionConfig.
+func (in *BastionConfig) DeepCopy() *BastionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(BastionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CIDRAllocation) DeepCopyInto(out *CIDRAllocation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CIDRAllocation.
+func (in *CIDRAllocation) DeepCopy() *CIDRAllocation {
+	if in == nil {
+		return nil
+	}
+	out := new(CIDRAllocation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CSIManila) DeepCopyInto(out *CSIManila) {
 	*out = *in
+	if in.ShareClient != nil {
+		in, out := &in.ShareClient, &out.ShareClient
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -43,6 +277,37 @@ func (in *CSIManila) DeepCopy() *CSIManila {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryRollout) DeepCopyInto(out *CanaryRollout) {
+	*out = *in
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(string)
+		**out = **in
+	}
+	if in.Machines != nil {
+		in, out := &in.Machines, &out.Machines
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SoakTime != nil {
+		in, out := &in.SoakTime, &out.SoakTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryRollout.
+func (in *CanaryRollout) DeepCopy() *CanaryRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryRollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudControllerManagerConfig) DeepCopyInto(out *CloudControllerManagerConfig) {
 	*out = *in
@@ -93,6 +358,13 @@ func (in *CloudProfileConfig) DeepCopyInto(out *CloudProfileConfig) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.EndpointOverrides != nil {
+		in, out := &in.EndpointOverrides, &out.EndpointOverrides
+		*out = make([]EndpointOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.MachineImages != nil {
 		in, out := &in.MachineImages, &out.MachineImages
 		*out = make([]MachineImages, len(*in))
@@ -147,6 +419,28 @@ func (in *CloudProfileConfig) DeepCopyInto(out *CloudProfileConfig) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Regions != nil {
+		in, out := &in.Regions, &out.Regions
+		*out = make([]RegionConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Pricing != nil {
+		in, out := &in.Pricing, &out.Pricing
+		*out = new(Pricing)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Bastion != nil {
+		in, out := &in.Bastion, &out.Bastion
+		*out = new(BastionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GPU != nil {
+		in, out := &in.GPU, &out.GPU
+		*out = new(GPUConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -185,6 +479,13 @@ func (in *Constraints) DeepCopyInto(out *Constraints) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.LoadBalancerClassPolicies != nil {
+		in, out := &in.LoadBalancerClassPolicies, &out.LoadBalancerClassPolicies
+		*out = make([]LoadBalancerClassPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -224,6 +525,26 @@ func (in *ControlPlaneConfig) DeepCopyInto(out *ControlPlaneConfig) {
 		*out = new(Storage)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = new(LoadBalancerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubeProxyReplacement != nil {
+		in, out := &in.KubeProxyReplacement, &out.KubeProxyReplacement
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NodeLabeler != nil {
+		in, out := &in.NodeLabeler, &out.NodeLabeler
+		*out = new(NodeLabelerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Exposure != nil {
+		in, out := &in.Exposure, &out.Exposure
+		*out = new(ExposureConfig)
+		**out = **in
+	}
 	return
 }
 
@@ -246,265 +567,1035 @@ func (in *ControlPlaneConfig) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FloatingPool) DeepCopyInto(out *FloatingPool) {
+func (in *DataVolume) DeepCopyInto(out *DataVolume) {
 	*out = *in
-	if in.Region != nil {
-		in, out := &in.Region, &out.Region
-		*out = new(string)
-		**out = **in
-	}
-	if in.Domain != nil {
-		in, out := &in.Domain, &out.Domain
+	out.Size = in.Size.DeepCopy()
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
 		*out = new(string)
 		**out = **in
 	}
-	if in.DefaultFloatingSubnet != nil {
-		in, out := &in.DefaultFloatingSubnet, &out.DefaultFloatingSubnet
-		*out = new(string)
+	if in.InheritAvailabilityZone != nil {
+		in, out := &in.InheritAvailabilityZone, &out.InheritAvailabilityZone
+		*out = new(bool)
 		**out = **in
 	}
-	if in.NonConstraining != nil {
-		in, out := &in.NonConstraining, &out.NonConstraining
+	if in.DeleteOnTermination != nil {
+		in, out := &in.DeleteOnTermination, &out.DeleteOnTermination
 		*out = new(bool)
 		**out = **in
 	}
-	if in.LoadBalancerClasses != nil {
-		in, out := &in.LoadBalancerClasses, &out.LoadBalancerClasses
-		*out = make([]LoadBalancerClass, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FloatingPool.
-func (in *FloatingPool) DeepCopy() *FloatingPool {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataVolume.
+func (in *DataVolume) DeepCopy() *DataVolume {
 	if in == nil {
 		return nil
 	}
-	out := new(FloatingPool)
+	out := new(DataVolume)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FloatingPoolStatus) DeepCopyInto(out *FloatingPoolStatus) {
+func (in *DedicatedProject) DeepCopyInto(out *DedicatedProject) {
 	*out = *in
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FloatingPoolStatus.
-func (in *FloatingPoolStatus) DeepCopy() *FloatingPoolStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DedicatedProject.
+func (in *DedicatedProject) DeepCopy() *DedicatedProject {
 	if in == nil {
 		return nil
 	}
-	out := new(FloatingPoolStatus)
+	out := new(DedicatedProject)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InfrastructureConfig) DeepCopyInto(out *InfrastructureConfig) {
+func (in *Egress) DeepCopyInto(out *Egress) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	if in.FloatingPoolSubnetName != nil {
-		in, out := &in.FloatingPoolSubnetName, &out.FloatingPoolSubnetName
-		*out = new(string)
-		**out = **in
+	if in.SeedCIDRs != nil {
+		in, out := &in.SeedCIDRs, &out.SeedCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	in.Networks.DeepCopyInto(&out.Networks)
-	return
+	if in.CIDRs != nil {
+		in, out := &in.CIDRs, &out.CIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Egress.
+func (in *Egress) DeepCopy() *Egress {
+	if in == nil {
+		return nil
+	}
+	out := new(Egress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointOverride) DeepCopyInto(out *EndpointOverride) {
+	*out = *in
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointOverride.
+func (in *EndpointOverride) DeepCopy() *EndpointOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExposureConfig) DeepCopyInto(out *ExposureConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExposureConfig.
+func (in *ExposureConfig) DeepCopy() *ExposureConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ExposureConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailedMachine) DeepCopyInto(out *FailedMachine) {
+	*out = *in
+	if in.ServerID != nil {
+		in, out := &in.ServerID, &out.ServerID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Fault != nil {
+		in, out := &in.Fault, &out.Fault
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProvisionState != nil {
+		in, out := &in.ProvisionState, &out.ProvisionState
+		*out = new(string)
+		**out = **in
+	}
+	if in.LastError != nil {
+		in, out := &in.LastError, &out.LastError
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailedMachine.
+func (in *FailedMachine) DeepCopy() *FailedMachine {
+	if in == nil {
+		return nil
+	}
+	out := new(FailedMachine)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FloatingPool) DeepCopyInto(out *FloatingPool) {
+	*out = *in
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
+	}
+	if in.Domain != nil {
+		in, out := &in.Domain, &out.Domain
+		*out = new(string)
+		**out = **in
+	}
+	if in.DefaultFloatingSubnet != nil {
+		in, out := &in.DefaultFloatingSubnet, &out.DefaultFloatingSubnet
+		*out = new(string)
+		**out = **in
+	}
+	if in.NonConstraining != nil {
+		in, out := &in.NonConstraining, &out.NonConstraining
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LoadBalancerClasses != nil {
+		in, out := &in.LoadBalancerClasses, &out.LoadBalancerClasses
+		*out = make([]LoadBalancerClass, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Deprecated != nil {
+		in, out := &in.Deprecated, &out.Deprecated
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FloatingPool.
+func (in *FloatingPool) DeepCopy() *FloatingPool {
+	if in == nil {
+		return nil
+	}
+	out := new(FloatingPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FloatingPoolStatus) DeepCopyInto(out *FloatingPoolStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FloatingPoolStatus.
+func (in *FloatingPoolStatus) DeepCopy() *FloatingPoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FloatingPoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GPUConfig) DeepCopyInto(out *GPUConfig) {
+	*out = *in
+	if in.ResourceName != nil {
+		in, out := &in.ResourceName, &out.ResourceName
+		*out = new(string)
+		**out = **in
+	}
+	if in.PCIAliases != nil {
+		in, out := &in.PCIAliases, &out.PCIAliases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GPUConfig.
+func (in *GPUConfig) DeepCopy() *GPUConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GPUConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostPinning) DeepCopyInto(out *HostPinning) {
+	*out = *in
+	if in.Host != nil {
+		in, out := &in.Host, &out.Host
+		*out = new(string)
+		**out = **in
+	}
+	if in.Node != nil {
+		in, out := &in.Node, &out.Node
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostPinning.
+func (in *HostPinning) DeepCopy() *HostPinning {
+	if in == nil {
+		return nil
+	}
+	out := new(HostPinning)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfrastructureConfig) DeepCopyInto(out *InfrastructureConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.FloatingPoolSubnetName != nil {
+		in, out := &in.FloatingPoolSubnetName, &out.FloatingPoolSubnetName
+		*out = new(string)
+		**out = **in
+	}
+	in.Networks.DeepCopyInto(&out.Networks)
+	if in.Adopt != nil {
+		in, out := &in.Adopt, &out.Adopt
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SecurityGroupID != nil {
+		in, out := &in.SecurityGroupID, &out.SecurityGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeyPairName != nil {
+		in, out := &in.KeyPairName, &out.KeyPairName
+		*out = new(string)
+		**out = **in
+	}
+	if in.AdditionalFloatingPools != nil {
+		in, out := &in.AdditionalFloatingPools, &out.AdditionalFloatingPools
+		*out = make([]AdditionalFloatingPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NodePorts != nil {
+		in, out := &in.NodePorts, &out.NodePorts
+		*out = new(NodePorts)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = new(Egress)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityGroupRules != nil {
+		in, out := &in.SecurityGroupRules, &out.SecurityGroupRules
+		*out = new(SecurityGroupRules)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DedicatedProject != nil {
+		in, out := &in.DedicatedProject, &out.DedicatedProject
+		*out = new(DedicatedProject)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AuxiliaryStack != nil {
+		in, out := &in.AuxiliaryStack, &out.AuxiliaryStack
+		*out = new(AuxiliaryStack)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ApplicationCredential != nil {
+		in, out := &in.ApplicationCredential, &out.ApplicationCredential
+		*out = new(ApplicationCredential)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfrastructureConfig.
+func (in *InfrastructureConfig) DeepCopy() *InfrastructureConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(InfrastructureConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InfrastructureConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfrastructureStatus) DeepCopyInto(out *InfrastructureStatus) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.Networks.DeepCopyInto(&out.Networks)
+	out.Node = in.Node
+	if in.SecurityGroups != nil {
+		in, out := &in.SecurityGroups, &out.SecurityGroups
+		*out = make([]SecurityGroup, len(*in))
+		copy(*out, *in)
+	}
+	if in.Project != nil {
+		in, out := &in.Project, &out.Project
+		*out = new(ProjectStatus)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfrastructureStatus.
+func (in *InfrastructureStatus) DeepCopy() *InfrastructureStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InfrastructureStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InfrastructureStatus) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntegrityCheckConfig) DeepCopyInto(out *IntegrityCheckConfig) {
+	*out = *in
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrityCheckConfig.
+func (in *IntegrityCheckConfig) DeepCopy() *IntegrityCheckConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(IntegrityCheckConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyStoneURL) DeepCopyInto(out *KeyStoneURL) {
+	*out = *in
+	if in.CACert != nil {
+		in, out := &in.CACert, &out.CACert
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyStoneURL.
+func (in *KeyStoneURL) DeepCopy() *KeyStoneURL {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyStoneURL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerClass) DeepCopyInto(out *LoadBalancerClass) {
+	*out = *in
+	if in.Purpose != nil {
+		in, out := &in.Purpose, &out.Purpose
+		*out = new(string)
+		**out = **in
+	}
+	if in.FloatingSubnetID != nil {
+		in, out := &in.FloatingSubnetID, &out.FloatingSubnetID
+		*out = new(string)
+		**out = **in
+	}
+	if in.FloatingSubnetTags != nil {
+		in, out := &in.FloatingSubnetTags, &out.FloatingSubnetTags
+		*out = new(string)
+		**out = **in
+	}
+	if in.FloatingSubnetName != nil {
+		in, out := &in.FloatingSubnetName, &out.FloatingSubnetName
+		*out = new(string)
+		**out = **in
+	}
+	if in.FloatingNetworkID != nil {
+		in, out := &in.FloatingNetworkID, &out.FloatingNetworkID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubnetID != nil {
+		in, out := &in.SubnetID, &out.SubnetID
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerClass.
+func (in *LoadBalancerClass) DeepCopy() *LoadBalancerClass {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerClass)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerClassPolicy) DeepCopyInto(out *LoadBalancerClassPolicy) {
+	*out = *in
+	if in.LoadBalancerClasses != nil {
+		in, out := &in.LoadBalancerClasses, &out.LoadBalancerClasses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ShootPurposes != nil {
+		in, out := &in.ShootPurposes, &out.ShootPurposes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ShootSelector != nil {
+		in, out := &in.ShootSelector, &out.ShootSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerClassPolicy.
+func (in *LoadBalancerClassPolicy) DeepCopy() *LoadBalancerClassPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerClassPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerConfig) DeepCopyInto(out *LoadBalancerConfig) {
+	*out = *in
+	if in.Algorithm != nil {
+		in, out := &in.Algorithm, &out.Algorithm
+		*out = new(LoadBalancerAlgorithm)
+		**out = **in
+	}
+	if in.AvailabilityZone != nil {
+		in, out := &in.AvailabilityZone, &out.AvailabilityZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.FlavorID != nil {
+		in, out := &in.FlavorID, &out.FlavorID
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerConfig.
+func (in *LoadBalancerConfig) DeepCopy() *LoadBalancerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerProvider) DeepCopyInto(out *LoadBalancerProvider) {
+	*out = *in
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerProvider.
+func (in *LoadBalancerProvider) DeepCopy() *LoadBalancerProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineImage) DeepCopyInto(out *MachineImage) {
+	*out = *in
+	if in.Architecture != nil {
+		in, out := &in.Architecture, &out.Architecture
+		*out = new(string)
+		**out = **in
+	}
+	if in.Capabilities != nil {
+		in, out := &in.Capabilities, &out.Capabilities
+		*out = make([]MachineImageCapability, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImage.
+func (in *MachineImage) DeepCopy() *MachineImage {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineImageVersion) DeepCopyInto(out *MachineImageVersion) {
+	*out = *in
+	if in.Regions != nil {
+		in, out := &in.Regions, &out.Regions
+		*out = make([]RegionIDMapping, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Capabilities != nil {
+		in, out := &in.Capabilities, &out.Capabilities
+		*out = make([]MachineImageCapability, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImageVersion.
+func (in *MachineImageVersion) DeepCopy() *MachineImageVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineImageVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineImages) DeepCopyInto(out *MachineImages) {
+	*out = *in
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make([]MachineImageVersion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImages.
+func (in *MachineImages) DeepCopy() *MachineImages {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineImages)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineLabel) DeepCopyInto(out *MachineLabel) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineLabel.
+func (in *MachineLabel) DeepCopy() *MachineLabel {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineLabel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineTypePrice) DeepCopyInto(out *MachineTypePrice) {
+	*out = *in
+	out.PricePerHour = in.PricePerHour.DeepCopy()
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineTypePrice.
+func (in *MachineTypePrice) DeepCopy() *MachineTypePrice {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineTypePrice)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkAllocation) DeepCopyInto(out *NetworkAllocation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkAllocation.
+func (in *NetworkAllocation) DeepCopy() *NetworkAllocation {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkAllocation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkAllocation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkAllocationList) DeepCopyInto(out *NetworkAllocationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NetworkAllocation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkAllocationList.
+func (in *NetworkAllocationList) DeepCopy() *NetworkAllocationList {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkAllocationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkAllocationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkAllocationSpec) DeepCopyInto(out *NetworkAllocationSpec) {
+	*out = *in
+	if in.Allocations != nil {
+		in, out := &in.Allocations, &out.Allocations
+		*out = make([]CIDRAllocation, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkAllocationSpec.
+func (in *NetworkAllocationSpec) DeepCopy() *NetworkAllocationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkAllocationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkStatus) DeepCopyInto(out *NetworkStatus) {
+	*out = *in
+	out.FloatingPool = in.FloatingPool
+	out.Router = in.Router
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]Subnet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ShareNetwork != nil {
+		in, out := &in.ShareNetwork, &out.ShareNetwork
+		*out = new(ShareNetworkStatus)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkStatus.
+func (in *NetworkStatus) DeepCopy() *NetworkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Networks) DeepCopyInto(out *Networks) {
+	*out = *in
+	if in.Router != nil {
+		in, out := &in.Router, &out.Router
+		*out = new(Router)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubnetID != nil {
+		in, out := &in.SubnetID, &out.SubnetID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ShareNetwork != nil {
+		in, out := &in.ShareNetwork, &out.ShareNetwork
+		*out = new(ShareNetwork)
+		**out = **in
+	}
+	if in.Shared != nil {
+		in, out := &in.Shared, &out.Shared
+		*out = new(SharedNetwork)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ShareWithProjects != nil {
+		in, out := &in.ShareWithProjects, &out.ShareWithProjects
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]NodeSubnet, len(*in))
+		copy(*out, *in)
+	}
+	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfrastructureConfig.
-func (in *InfrastructureConfig) DeepCopy() *InfrastructureConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Networks.
+func (in *Networks) DeepCopy() *Networks {
 	if in == nil {
 		return nil
 	}
-	out := new(InfrastructureConfig)
+	out := new(Networks)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *InfrastructureConfig) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeLabelerConfig) DeepCopyInto(out *NodeLabelerConfig) {
+	*out = *in
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
 	}
-	return nil
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeLabelerConfig.
+func (in *NodeLabelerConfig) DeepCopy() *NodeLabelerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeLabelerConfig)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InfrastructureStatus) DeepCopyInto(out *InfrastructureStatus) {
+func (in *NodePorts) DeepCopyInto(out *NodePorts) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.Networks.DeepCopyInto(&out.Networks)
-	out.Node = in.Node
-	if in.SecurityGroups != nil {
-		in, out := &in.SecurityGroups, &out.SecurityGroups
-		*out = make([]SecurityGroup, len(*in))
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SourceCIDRs != nil {
+		in, out := &in.SourceCIDRs, &out.SourceCIDRs
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfrastructureStatus.
-func (in *InfrastructureStatus) DeepCopy() *InfrastructureStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePorts.
+func (in *NodePorts) DeepCopy() *NodePorts {
 	if in == nil {
 		return nil
 	}
-	out := new(InfrastructureStatus)
+	out := new(NodePorts)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *InfrastructureStatus) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeStatus) DeepCopyInto(out *NodeStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeStatus.
+func (in *NodeStatus) DeepCopy() *NodeStatus {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(NodeStatus)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KeyStoneURL) DeepCopyInto(out *KeyStoneURL) {
+func (in *NodeSubnet) DeepCopyInto(out *NodeSubnet) {
 	*out = *in
-	if in.CACert != nil {
-		in, out := &in.CACert, &out.CACert
-		*out = new(string)
-		**out = **in
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyStoneURL.
-func (in *KeyStoneURL) DeepCopy() *KeyStoneURL {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeSubnet.
+func (in *NodeSubnet) DeepCopy() *NodeSubnet {
 	if in == nil {
 		return nil
 	}
-	out := new(KeyStoneURL)
+	out := new(NodeSubnet)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LoadBalancerClass) DeepCopyInto(out *LoadBalancerClass) {
+func (in *OpenStackMaintenance) DeepCopyInto(out *OpenStackMaintenance) {
 	*out = *in
-	if in.Purpose != nil {
-		in, out := &in.Purpose, &out.Purpose
-		*out = new(string)
-		**out = **in
-	}
-	if in.FloatingSubnetID != nil {
-		in, out := &in.FloatingSubnetID, &out.FloatingSubnetID
-		*out = new(string)
-		**out = **in
-	}
-	if in.FloatingSubnetTags != nil {
-		in, out := &in.FloatingSubnetTags, &out.FloatingSubnetTags
-		*out = new(string)
-		**out = **in
-	}
-	if in.FloatingSubnetName != nil {
-		in, out := &in.FloatingSubnetName, &out.FloatingSubnetName
-		*out = new(string)
-		**out = **in
-	}
-	if in.FloatingNetworkID != nil {
-		in, out := &in.FloatingNetworkID, &out.FloatingNetworkID
-		*out = new(string)
-		**out = **in
-	}
-	if in.SubnetID != nil {
-		in, out := &in.SubnetID, &out.SubnetID
-		*out = new(string)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerClass.
-func (in *LoadBalancerClass) DeepCopy() *LoadBalancerClass {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackMaintenance.
+func (in *OpenStackMaintenance) DeepCopy() *OpenStackMaintenance {
 	if in == nil {
 		return nil
 	}
-	out := new(LoadBalancerClass)
+	out := new(OpenStackMaintenance)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenStackMaintenance) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LoadBalancerProvider) DeepCopyInto(out *LoadBalancerProvider) {
+func (in *OpenStackMaintenanceList) DeepCopyInto(out *OpenStackMaintenanceList) {
 	*out = *in
-	if in.Region != nil {
-		in, out := &in.Region, &out.Region
-		*out = new(string)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenStackMaintenance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerProvider.
-func (in *LoadBalancerProvider) DeepCopy() *LoadBalancerProvider {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackMaintenanceList.
+func (in *OpenStackMaintenanceList) DeepCopy() *OpenStackMaintenanceList {
 	if in == nil {
 		return nil
 	}
-	out := new(LoadBalancerProvider)
+	out := new(OpenStackMaintenanceList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenStackMaintenanceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineImage) DeepCopyInto(out *MachineImage) {
+func (in *OpenStackMaintenanceSpec) DeepCopyInto(out *OpenStackMaintenanceSpec) {
 	*out = *in
-	if in.Architecture != nil {
-		in, out := &in.Architecture, &out.Architecture
+	if in.Node != nil {
+		in, out := &in.Node, &out.Node
 		*out = new(string)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImage.
-func (in *MachineImage) DeepCopy() *MachineImage {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackMaintenanceSpec.
+func (in *OpenStackMaintenanceSpec) DeepCopy() *OpenStackMaintenanceSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineImage)
+	out := new(OpenStackMaintenanceSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineImageVersion) DeepCopyInto(out *MachineImageVersion) {
+func (in *OpenStackMaintenanceStatus) DeepCopyInto(out *OpenStackMaintenanceStatus) {
 	*out = *in
-	if in.Regions != nil {
-		in, out := &in.Regions, &out.Regions
-		*out = make([]RegionIDMapping, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImageVersion.
-func (in *MachineImageVersion) DeepCopy() *MachineImageVersion {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackMaintenanceStatus.
+func (in *OpenStackMaintenanceStatus) DeepCopy() *OpenStackMaintenanceStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineImageVersion)
+	out := new(OpenStackMaintenanceStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineImages) DeepCopyInto(out *MachineImages) {
+func (in *Pricing) DeepCopyInto(out *Pricing) {
 	*out = *in
-	if in.Versions != nil {
-		in, out := &in.Versions, &out.Versions
-		*out = make([]MachineImageVersion, len(*in))
+	if in.MachineTypes != nil {
+		in, out := &in.MachineTypes, &out.MachineTypes
+		*out = make([]MachineTypePrice, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VolumeTypes != nil {
+		in, out := &in.VolumeTypes, &out.VolumeTypes
+		*out = make([]VolumeTypePrice, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -512,124 +1603,143 @@ func (in *MachineImages) DeepCopyInto(out *MachineImages) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImages.
-func (in *MachineImages) DeepCopy() *MachineImages {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Pricing.
+func (in *Pricing) DeepCopy() *Pricing {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineImages)
+	out := new(Pricing)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineLabel) DeepCopyInto(out *MachineLabel) {
+func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineLabel.
-func (in *MachineLabel) DeepCopy() *MachineLabel {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectStatus.
+func (in *ProjectStatus) DeepCopy() *ProjectStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineLabel)
+	out := new(ProjectStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkStatus) DeepCopyInto(out *NetworkStatus) {
+func (in *RegionConfig) DeepCopyInto(out *RegionConfig) {
 	*out = *in
-	out.FloatingPool = in.FloatingPool
-	out.Router = in.Router
-	if in.Subnets != nil {
-		in, out := &in.Subnets, &out.Subnets
-		*out = make([]Subnet, len(*in))
+	if in.FlavorFamilies != nil {
+		in, out := &in.FlavorFamilies, &out.FlavorFamilies
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.ShareNetwork != nil {
-		in, out := &in.ShareNetwork, &out.ShareNetwork
-		*out = new(ShareNetworkStatus)
-		**out = **in
+	if in.ReservedAggregates != nil {
+		in, out := &in.ReservedAggregates, &out.ReservedAggregates
+		*out = make([]ReservedAggregate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RootVolumeTypes != nil {
+		in, out := &in.RootVolumeTypes, &out.RootVolumeTypes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkStatus.
-func (in *NetworkStatus) DeepCopy() *NetworkStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegionConfig.
+func (in *RegionConfig) DeepCopy() *RegionConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkStatus)
+	out := new(RegionConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Networks) DeepCopyInto(out *Networks) {
+func (in *RegionIDMapping) DeepCopyInto(out *RegionIDMapping) {
 	*out = *in
-	if in.Router != nil {
-		in, out := &in.Router, &out.Router
-		*out = new(Router)
-		**out = **in
-	}
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
+	if in.Architecture != nil {
+		in, out := &in.Architecture, &out.Architecture
 		*out = new(string)
 		**out = **in
 	}
-	if in.ShareNetwork != nil {
-		in, out := &in.ShareNetwork, &out.ShareNetwork
-		*out = new(ShareNetwork)
-		**out = **in
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Networks.
-func (in *Networks) DeepCopy() *Networks {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegionIDMapping.
+func (in *RegionIDMapping) DeepCopy() *RegionIDMapping {
 	if in == nil {
 		return nil
 	}
-	out := new(Networks)
+	out := new(RegionIDMapping)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NodeStatus) DeepCopyInto(out *NodeStatus) {
+func (in *ReservedAggregate) DeepCopyInto(out *ReservedAggregate) {
 	*out = *in
+	if in.FlavorFamilies != nil {
+		in, out := &in.FlavorFamilies, &out.FlavorFamilies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeStatus.
-func (in *NodeStatus) DeepCopy() *NodeStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservedAggregate.
+func (in *ReservedAggregate) DeepCopy() *ReservedAggregate {
 	if in == nil {
 		return nil
 	}
-	out := new(NodeStatus)
+	out := new(ReservedAggregate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RegionIDMapping) DeepCopyInto(out *RegionIDMapping) {
+func (in *RootVolume) DeepCopyInto(out *RootVolume) {
 	*out = *in
-	if in.Architecture != nil {
-		in, out := &in.Architecture, &out.Architecture
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
 		*out = new(string)
 		**out = **in
 	}
+	if in.ZoneTypes != nil {
+		in, out := &in.ZoneTypes, &out.ZoneTypes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.DeleteOnTermination != nil {
+		in, out := &in.DeleteOnTermination, &out.DeleteOnTermination
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegionIDMapping.
-func (in *RegionIDMapping) DeepCopy() *RegionIDMapping {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RootVolume.
+func (in *RootVolume) DeepCopy() *RootVolume {
 	if in == nil {
 		return nil
 	}
-	out := new(RegionIDMapping)
+	out := new(RootVolume)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -682,34 +1792,88 @@ func (in *SecurityGroup) DeepCopy() *SecurityGroup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupRules) DeepCopyInto(out *SecurityGroupRules) {
+	*out = *in
+	if in.IPv6 != nil {
+		in, out := &in.IPv6, &out.IPv6
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRules.
+func (in *SecurityGroupRules) DeepCopy() *SecurityGroupRules {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupRules)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServerGroup) DeepCopyInto(out *ServerGroup) {
 	*out = *in
+	if in.PerZone != nil {
+		in, out := &in.PerZone, &out.PerZone
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerGroup.
+func (in *ServerGroup) DeepCopy() *ServerGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerGroupDependency) DeepCopyInto(out *ServerGroupDependency) {
+	*out = *in
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerGroup.
-func (in *ServerGroup) DeepCopy() *ServerGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerGroupDependency.
+func (in *ServerGroupDependency) DeepCopy() *ServerGroupDependency {
 	if in == nil {
 		return nil
 	}
-	out := new(ServerGroup)
+	out := new(ServerGroupDependency)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServerGroupDependency) DeepCopyInto(out *ServerGroupDependency) {
+func (in *ServerMetadata) DeepCopyInto(out *ServerMetadata) {
 	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerGroupDependency.
-func (in *ServerGroupDependency) DeepCopy() *ServerGroupDependency {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerMetadata.
+func (in *ServerMetadata) DeepCopy() *ServerMetadata {
 	if in == nil {
 		return nil
 	}
-	out := new(ServerGroupDependency)
+	out := new(ServerMetadata)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -746,13 +1910,34 @@ func (in *ShareNetworkStatus) DeepCopy() *ShareNetworkStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SharedNetwork) DeepCopyInto(out *SharedNetwork) {
+	*out = *in
+	if in.PrefixLength != nil {
+		in, out := &in.PrefixLength, &out.PrefixLength
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SharedNetwork.
+func (in *SharedNetwork) DeepCopy() *SharedNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(SharedNetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Storage) DeepCopyInto(out *Storage) {
 	*out = *in
 	if in.CSIManila != nil {
 		in, out := &in.CSIManila, &out.CSIManila
 		*out = new(CSIManila)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -827,6 +2012,11 @@ func (in *StorageClassDefinition) DeepCopy() *StorageClassDefinition {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Subnet) DeepCopyInto(out *Subnet) {
 	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -840,6 +2030,112 @@ func (in *Subnet) DeepCopy() *Subnet {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TempURLConfig) DeepCopyInto(out *TempURLConfig) {
+	*out = *in
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TempURLConfig.
+func (in *TempURLConfig) DeepCopy() *TempURLConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TempURLConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TemplateReference) DeepCopyInto(out *TemplateReference) {
+	*out = *in
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplateReference.
+func (in *TemplateReference) DeepCopy() *TemplateReference {
+	if in == nil {
+		return nil
+	}
+	out := new(TemplateReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VGPU) DeepCopyInto(out *VGPU) {
+	*out = *in
+	if in.TimeSlicingReplicas != nil {
+		in, out := &in.TimeSlicingReplicas, &out.TimeSlicingReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DevicePluginConfig != nil {
+		in, out := &in.DevicePluginConfig, &out.DevicePluginConfig
+		*out = new(string)
+		**out = **in
+	}
+	if in.Taint != nil {
+		in, out := &in.Taint, &out.Taint
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VGPU.
+func (in *VGPU) DeepCopy() *VGPU {
+	if in == nil {
+		return nil
+	}
+	out := new(VGPU)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerifiedImage) DeepCopyInto(out *VerifiedImage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerifiedImage.
+func (in *VerifiedImage) DeepCopy() *VerifiedImage {
+	if in == nil {
+		return nil
+	}
+	out := new(VerifiedImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeTypePrice) DeepCopyInto(out *VolumeTypePrice) {
+	*out = *in
+	out.PricePerGiBMonth = in.PricePerGiBMonth.DeepCopy()
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeTypePrice.
+func (in *VolumeTypePrice) DeepCopy() *VolumeTypePrice {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeTypePrice)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkerConfig) DeepCopyInto(out *WorkerConfig) {
 	*out = *in
@@ -852,13 +2148,148 @@ func (in *WorkerConfig) DeepCopyInto(out *WorkerConfig) {
 	if in.ServerGroup != nil {
 		in, out := &in.ServerGroup, &out.ServerGroup
 		*out = new(ServerGroup)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.MachineLabels != nil {
 		in, out := &in.MachineLabels, &out.MachineLabels
 		*out = make([]MachineLabel, len(*in))
 		copy(*out, *in)
 	}
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(CanaryRollout)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ZoneRollout != nil {
+		in, out := &in.ZoneRollout, &out.ZoneRollout
+		*out = new(ZoneRollout)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UpdateStrategy != nil {
+		in, out := &in.UpdateStrategy, &out.UpdateStrategy
+		*out = new(UpdateStrategyType)
+		**out = **in
+	}
+	if in.VGPU != nil {
+		in, out := &in.VGPU, &out.VGPU
+		*out = new(VGPU)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MachineClassExtra != nil {
+		in, out := &in.MachineClassExtra, &out.MachineClassExtra
+		*out = make(map[string]apiextensionsv1.JSON, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.HostPinning != nil {
+		in, out := &in.HostPinning, &out.HostPinning
+		*out = make([]HostPinning, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RootVolume != nil {
+		in, out := &in.RootVolume, &out.RootVolume
+		*out = new(RootVolume)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DataVolumes != nil {
+		in, out := &in.DataVolumes, &out.DataVolumes
+		*out = make([]DataVolume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ConfigDrive != nil {
+		in, out := &in.ConfigDrive, &out.ConfigDrive
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ServerMetadata != nil {
+		in, out := &in.ServerMetadata, &out.ServerMetadata
+		*out = new(ServerMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Networks != nil {
+		in, out := &in.Networks, &out.Networks
+		*out = make([]WorkerNetwork, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AllowedAddressPairs != nil {
+		in, out := &in.AllowedAddressPairs, &out.AllowedAddressPairs
+		*out = make([]AllowedAddressPair, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AdditionalSecurityGroups != nil {
+		in, out := &in.AdditionalSecurityGroups, &out.AdditionalSecurityGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PortSecurityEnabled != nil {
+		in, out := &in.PortSecurityEnabled, &out.PortSecurityEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.QoSPolicyID != nil {
+		in, out := &in.QoSPolicyID, &out.QoSPolicyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Subnet != nil {
+		in, out := &in.Subnet, &out.Subnet
+		*out = new(string)
+		**out = **in
+	}
+	if in.SchedulerHints != nil {
+		in, out := &in.SchedulerHints, &out.SchedulerHints
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.Preemptible != nil {
+		in, out := &in.Preemptible, &out.Preemptible
+		*out = new(bool)
+		**out = **in
+	}
+	if in.KeyName != nil {
+		in, out := &in.KeyName, &out.KeyName
+		*out = new(string)
+		**out = **in
+	}
+	if in.TrunkPort != nil {
+		in, out := &in.TrunkPort, &out.TrunkPort
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TuningProfile != nil {
+		in, out := &in.TuningProfile, &out.TuningProfile
+		*out = new(TuningProfile)
+		**out = **in
+	}
+	if in.UserDataFormat != nil {
+		in, out := &in.UserDataFormat, &out.UserDataFormat
+		*out = new(UserDataFormat)
+		**out = **in
+	}
+	if in.BareMetal != nil {
+		in, out := &in.BareMetal, &out.BareMetal
+		*out = new(BareMetal)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -880,6 +2311,70 @@ func (in *WorkerConfig) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerNetwork) DeepCopyInto(out *WorkerNetwork) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerNetwork.
+func (in *WorkerNetwork) DeepCopy() *WorkerNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerNetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerPoolStatus) DeepCopyInto(out *WorkerPoolStatus) {
+	*out = *in
+	if in.ServerGroupID != nil {
+		in, out := &in.ServerGroupID, &out.ServerGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecurityGroups != nil {
+		in, out := &in.SecurityGroups, &out.SecurityGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AvailabilityZones != nil {
+		in, out := &in.AvailabilityZones, &out.AvailabilityZones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AdditionalNetworks != nil {
+		in, out := &in.AdditionalNetworks, &out.AdditionalNetworks
+		*out = make([]WorkerNetwork, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerPoolStatus.
+func (in *WorkerPoolStatus) DeepCopy() *WorkerPoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerPoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkerStatus) DeepCopyInto(out *WorkerStatus) {
 	*out = *in
@@ -894,8 +2389,29 @@ func (in *WorkerStatus) DeepCopyInto(out *WorkerStatus) {
 	if in.ServerGroupDependencies != nil {
 		in, out := &in.ServerGroupDependencies, &out.ServerGroupDependencies
 		*out = make([]ServerGroupDependency, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FailedMachines != nil {
+		in, out := &in.FailedMachines, &out.FailedMachines
+		*out = make([]FailedMachine, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VerifiedImages != nil {
+		in, out := &in.VerifiedImages, &out.VerifiedImages
+		*out = make([]VerifiedImage, len(*in))
 		copy(*out, *in)
 	}
+	if in.WorkerPools != nil {
+		in, out := &in.WorkerPools, &out.WorkerPools
+		*out = make([]WorkerPoolStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -916,3 +2432,24 @@ func (in *WorkerStatus) DeepCopyObject() runtime.Object {
 	}
 	return nil
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneRollout) DeepCopyInto(out *ZoneRollout) {
+	*out = *in
+	if in.GracePeriod != nil {
+		in, out := &in.GracePeriod, &out.GracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneRollout.
+func (in *ZoneRollout) DeepCopy() *ZoneRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneRollout)
+	in.DeepCopyInto(out)
+	return out
+}
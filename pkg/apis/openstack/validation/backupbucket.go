@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+)
+
+var (
+	// minSegmentSize is the minimum size of a segment of a large object.
+	minSegmentSize = resource.MustParse("1Mi")
+	// maxSegmentSize is the maximum size of a segment of a large object, i.e. the maximum object size of Swift.
+	maxSegmentSize = resource.MustParse("5Gi")
+)
+
+// ValidateBackupBucketConfig validates a BackupBucketConfig object.
+func ValidateBackupBucketConfig(config *api.BackupBucketConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if config.SegmentSize != nil {
+		if config.SegmentSize.Cmp(minSegmentSize) < 0 || config.SegmentSize.Cmp(maxSegmentSize) > 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("segmentSize"), config.SegmentSize.String(), "must be between 1Mi and 5Gi"))
+		}
+	}
+
+	if config.TempURL != nil && config.TempURL.TTL != nil && config.TempURL.TTL.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("tempURL", "ttl"), config.TempURL.TTL.Duration.String(), "must be positive"))
+	}
+
+	if config.IntegrityCheck != nil {
+		allErrs = append(allErrs, validateIntegrityCheck(config.IntegrityCheck, fldPath.Child("integrityCheck"))...)
+	}
+
+	if config.CredentialsSecretName != nil && *config.CredentialsSecretName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("credentialsSecretName"), "must not be empty"))
+	}
+
+	return allErrs
+}
+
+func validateIntegrityCheck(config *api.IntegrityCheckConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	// the schedule is parsed by the kube-controller-manager, only its format is checked here
+	if config.Schedule != nil && len(strings.Fields(*config.Schedule)) != 5 && !strings.HasPrefix(*config.Schedule, "@") {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("schedule"), *config.Schedule, "must be a cron schedule with five fields or a predefined schedule like @daily"))
+	}
+	if config.MaxAge != nil && config.MaxAge.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxAge"), config.MaxAge.Duration.String(), "must be positive"))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"time"
+
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	. "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/validation"
+)
+
+var _ = Describe("BackupBucketConfig validation", func() {
+	var (
+		fldPath = field.NewPath("providerConfig")
+		config  *api.BackupBucketConfig
+	)
+
+	BeforeEach(func() {
+		segmentSize := resource.MustParse("100Mi")
+		config = &api.BackupBucketConfig{
+			SegmentSize: &segmentSize,
+			TempURL:     &api.TempURLConfig{TTL: &metav1.Duration{Duration: time.Hour}},
+		}
+	})
+
+	It("should allow a valid config", func() {
+		Expect(ValidateBackupBucketConfig(config, fldPath)).To(BeEmpty())
+	})
+
+	It("should allow an empty config", func() {
+		Expect(ValidateBackupBucketConfig(&api.BackupBucketConfig{}, fldPath)).To(BeEmpty())
+	})
+
+	It("should forbid too small and too large segment sizes", func() {
+		for _, size := range []string{"512Ki", "6Gi"} {
+			segmentSize := resource.MustParse(size)
+			config.SegmentSize = &segmentSize
+
+			Expect(ValidateBackupBucketConfig(config, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providerConfig.segmentSize"),
+			}))))
+		}
+	})
+
+	It("should forbid a non-positive temp URL ttl", func() {
+		config.TempURL.TTL.Duration = 0
+
+		Expect(ValidateBackupBucketConfig(config, fldPath)).To(ConsistOfFields(Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("providerConfig.tempURL.ttl"),
+		}))
+	})
+
+	It("should allow valid integrity checks", func() {
+		for _, schedule := range []string{"0 3 * * *", "*/30 1-5 * * 1", "@daily"} {
+			config.IntegrityCheck = &api.IntegrityCheckConfig{
+				Schedule: pointer.String(schedule),
+				MaxAge:   &metav1.Duration{Duration: 12 * time.Hour},
+			}
+
+			Expect(ValidateBackupBucketConfig(config, fldPath)).To(BeEmpty())
+		}
+	})
+
+	It("should forbid an invalid integrity check schedule and a non-positive max age", func() {
+		config.IntegrityCheck = &api.IntegrityCheckConfig{
+			Schedule: pointer.String("0 3 * *"),
+			MaxAge:   &metav1.Duration{Duration: -time.Hour},
+		}
+
+		Expect(ValidateBackupBucketConfig(config, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providerConfig.integrityCheck.schedule"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providerConfig.integrityCheck.maxAge"),
+			})),
+		))
+	})
+	It("should forbid an empty credentials secret name", func() {
+		config.CredentialsSecretName = pointer.String("")
+
+		Expect(ValidateBackupBucketConfig(config, fldPath)).To(ConsistOfFields(Fields{
+			"Type":  Equal(field.ErrorTypeRequired),
+			"Field": Equal("providerConfig.credentialsSecretName"),
+		}))
+	})
+})
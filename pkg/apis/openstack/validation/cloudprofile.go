@@ -7,11 +7,16 @@ package validation
 import (
 	"fmt"
 	"net"
+	"net/url"
 	"slices"
+	"strings"
 
+	"github.com/gardener/gardener/pkg/apis/core"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/utils"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/pointer"
 
@@ -86,6 +91,8 @@ func ValidateCloudProfileConfig(cloudProfile *api.CloudProfileConfig, fldPath *f
 		}
 	}
 
+	allErrs = append(allErrs, validateLoadBalancerClassPolicies(cloudProfile.Constraints.LoadBalancerClassPolicies, fldPath.Child("constraints", "loadBalancerClassPolicies"))...)
+
 	machineImagesPath := fldPath.Child("machineImages")
 	if len(cloudProfile.MachineImages) == 0 {
 		allErrs = append(allErrs, field.Required(machineImagesPath, "must provide at least one machine image"))
@@ -120,6 +127,18 @@ func ValidateCloudProfileConfig(cloudProfile *api.CloudProfileConfig, fldPath *f
 					allErrs = append(allErrs, field.NotSupported(kdxPath.Child("architecture"), *region.Architecture, v1beta1constants.ValidArchitectures))
 				}
 			}
+
+			capabilities := sets.New[api.MachineImageCapability]()
+			for k, capability := range version.Capabilities {
+				kdxPath := jdxPath.Child("capabilities").Index(k)
+
+				if !supportedMachineImageCapabilities.Has(string(capability)) {
+					allErrs = append(allErrs, field.NotSupported(kdxPath, capability, sets.List(supportedMachineImageCapabilities)))
+				} else if capabilities.Has(capability) {
+					allErrs = append(allErrs, field.Duplicate(kdxPath, capability))
+				}
+				capabilities.Insert(capability)
+			}
 		}
 	}
 
@@ -158,6 +177,24 @@ func ValidateCloudProfileConfig(cloudProfile *api.CloudProfileConfig, fldPath *f
 		regionsFound.Insert(val.Region)
 	}
 
+	overridesFound := sets.New[string]()
+	for i, override := range cloudProfile.EndpointOverrides {
+		idxPath := fldPath.Child("endpointOverrides").Index(i)
+
+		if len(override.Service) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("service"), "must provide the type of the service"))
+		}
+		if err := validateEndpointURL(override.URL); err != nil {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("url"), override.URL, err.Error()))
+		}
+
+		key := pointer.StringDeref(override.Region, "") + "/" + override.Service
+		if overridesFound.Has(key) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("service"), override.Service))
+		}
+		overridesFound.Insert(key)
+	}
+
 	for i, ip := range cloudProfile.DNSServers {
 		if net.ParseIP(ip) == nil {
 			allErrs = append(allErrs, field.Invalid(fldPath.Child("dnsServers").Index(i), ip, "must provide a valid IP"))
@@ -168,6 +205,59 @@ func ValidateCloudProfileConfig(cloudProfile *api.CloudProfileConfig, fldPath *f
 		allErrs = append(allErrs, field.Required(fldPath.Child("dhcpDomain"), "must provide a dhcp domain when the key is specified"))
 	}
 
+	regionNames := sets.NewString()
+	for i, region := range cloudProfile.Regions {
+		idxPath := fldPath.Child("regions").Index(i)
+
+		if len(region.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide the name of the region"))
+		} else if regionNames.Has(region.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), region.Name))
+		}
+		regionNames.Insert(region.Name)
+
+		allErrs = append(allErrs, validateFlavorFamilies(region.FlavorFamilies, idxPath.Child("flavorFamilies"))...)
+
+		aggregateNames := sets.NewString()
+		for j, aggregate := range region.ReservedAggregates {
+			aggregatePath := idxPath.Child("reservedAggregates").Index(j)
+
+			if len(aggregate.Name) == 0 {
+				allErrs = append(allErrs, field.Required(aggregatePath.Child("name"), "must provide the name of the host aggregate"))
+			} else if aggregateNames.Has(aggregate.Name) {
+				allErrs = append(allErrs, field.Duplicate(aggregatePath.Child("name"), aggregate.Name))
+			}
+			aggregateNames.Insert(aggregate.Name)
+
+			if len(aggregate.FlavorFamilies) == 0 {
+				allErrs = append(allErrs, field.Required(aggregatePath.Child("flavorFamilies"), "must provide at least one flavor family"))
+			}
+			allErrs = append(allErrs, validateFlavorFamilies(aggregate.FlavorFamilies, aggregatePath.Child("flavorFamilies"))...)
+		}
+
+		for _, zone := range sets.List(sets.KeySet(region.RootVolumeTypes)) {
+			zonePath := idxPath.Child("rootVolumeTypes").Key(zone)
+			switch {
+			case zone == "":
+				allErrs = append(allErrs, field.Invalid(zonePath, zone, "zone must not be empty"))
+			case region.RootVolumeTypes[zone] == "":
+				allErrs = append(allErrs, field.Required(zonePath, "must provide the volume type of the zone"))
+			}
+		}
+	}
+
+	if cloudProfile.Pricing != nil {
+		allErrs = append(allErrs, validatePricing(cloudProfile.Pricing, fldPath.Child("pricing"))...)
+	}
+
+	if cloudProfile.GPU != nil {
+		allErrs = append(allErrs, validateGPUConfig(cloudProfile.GPU, fldPath.Child("gpu"))...)
+	}
+
+	if cloudProfile.Bastion != nil && cloudProfile.Bastion.Audit != nil {
+		allErrs = append(allErrs, validateBastionAudit(cloudProfile.Bastion.Audit, fldPath.Child("bastion", "audit"))...)
+	}
+
 	serverGroupPath := fldPath.Child("serverGroupPolicies")
 	for i, policy := range cloudProfile.ServerGroupPolicies {
 		idxPath := serverGroupPath.Index(i)
@@ -180,6 +270,110 @@ func ValidateCloudProfileConfig(cloudProfile *api.CloudProfileConfig, fldPath *f
 	return allErrs
 }
 
+func validatePricing(pricing *api.Pricing, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(pricing.Currency) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("currency"), "must provide the currency of the prices"))
+	}
+
+	machineTypeNames := sets.New[string]()
+	for i, price := range pricing.MachineTypes {
+		idxPath := fldPath.Child("machineTypes").Index(i)
+
+		if len(price.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide the name of the machine type"))
+		} else if machineTypeNames.Has(price.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), price.Name))
+		}
+		machineTypeNames.Insert(price.Name)
+
+		if price.PricePerHour.Sign() < 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("pricePerHour"), price.PricePerHour.String(), "price must not be negative"))
+		}
+	}
+
+	volumeTypeNames := sets.New[string]()
+	for i, price := range pricing.VolumeTypes {
+		idxPath := fldPath.Child("volumeTypes").Index(i)
+
+		if len(price.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide the name of the volume type"))
+		} else if volumeTypeNames.Has(price.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), price.Name))
+		}
+		volumeTypeNames.Insert(price.Name)
+
+		if price.PricePerGiBMonth.Sign() < 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("pricePerGiBMonth"), price.PricePerGiBMonth.String(), "price must not be negative"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateGPUConfig(gpu *api.GPUConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if gpu.ResourceName != nil {
+		for _, msg := range validation.IsQualifiedName(*gpu.ResourceName) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("resourceName"), *gpu.ResourceName, msg))
+		}
+	}
+
+	aliases := sets.New[string]()
+	for i, alias := range gpu.PCIAliases {
+		idxPath := fldPath.Child("pciAliases").Index(i)
+
+		if len(alias) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath, "alias cannot be empty"))
+		} else if aliases.Has(alias) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, alias))
+		}
+		aliases.Insert(alias)
+	}
+
+	return allErrs
+}
+
+// supportedMachineImageCapabilities are the capabilities machine images can have.
+var supportedMachineImageCapabilities = sets.New(string(api.MachineImageCapabilityPrebaked), string(api.MachineImageCapabilityIgnition))
+
+// sshLogLevels are the log levels supported by the SSH daemon.
+var sshLogLevels = []string{"QUIET", "FATAL", "ERROR", "INFO", "VERBOSE", "DEBUG", "DEBUG1", "DEBUG2", "DEBUG3"}
+
+func validateBastionAudit(audit *api.BastionAudit, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if audit.LogLevel != nil && !slices.Contains(sshLogLevels, *audit.LogLevel) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("logLevel"), *audit.LogLevel, sshLogLevels))
+	}
+	if audit.ForceCommand != nil {
+		if len(strings.TrimSpace(*audit.ForceCommand)) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("forceCommand"), "must provide a command if set"))
+		} else if strings.ContainsAny(*audit.ForceCommand, "\n\r") {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("forceCommand"), *audit.ForceCommand, "must not contain line breaks"))
+		}
+	}
+	if audit.UserData != nil && len(strings.TrimSpace(*audit.UserData)) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("userData"), "must provide a script if set"))
+	}
+
+	return allErrs
+}
+
+func validateFlavorFamilies(families []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, family := range families {
+		if len(family) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Index(i), "flavor family cannot be empty"))
+		}
+	}
+
+	return allErrs
+}
+
 // validateLoadBalancerClass validates LoadBalancerClass object.
 func validateLoadBalancerClass(lbClass api.LoadBalancerClass, fldPath *field.Path) field.ErrorList {
 	var allErrs = field.ErrorList{}
@@ -248,3 +442,54 @@ func ValidateLoadBalancerClasses(loadBalancerClasses []api.LoadBalancerClass, fl
 
 	return allErrs
 }
+
+var validShootPurposes = []string{
+	string(core.ShootPurposeEvaluation),
+	string(core.ShootPurposeTesting),
+	string(core.ShootPurposeDevelopment),
+	string(core.ShootPurposeInfrastructure),
+	string(core.ShootPurposeProduction),
+}
+
+func validateLoadBalancerClassPolicies(policies []api.LoadBalancerClassPolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, policy := range policies {
+		idxPath := fldPath.Index(i)
+
+		if len(policy.LoadBalancerClasses) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("loadBalancerClasses"), "must provide at least one load balancer class"))
+		}
+		for j, class := range policy.LoadBalancerClasses {
+			if len(class) == 0 {
+				allErrs = append(allErrs, field.Required(idxPath.Child("loadBalancerClasses").Index(j), "must provide a name"))
+			}
+		}
+
+		if len(policy.ShootPurposes) == 0 && policy.ShootSelector == nil {
+			allErrs = append(allErrs, field.Required(idxPath, "must provide shoot purposes or a shoot selector"))
+		}
+		for j, purpose := range policy.ShootPurposes {
+			if !slices.Contains(validShootPurposes, purpose) {
+				allErrs = append(allErrs, field.NotSupported(idxPath.Child("shootPurposes").Index(j), purpose, validShootPurposes))
+			}
+		}
+		if policy.ShootSelector != nil {
+			allErrs = append(allErrs, metav1validation.ValidateLabelSelector(policy.ShootSelector, metav1validation.LabelSelectorValidationOptions{}, idxPath.Child("shootSelector"))...)
+		}
+	}
+
+	return allErrs
+}
+
+// validateEndpointURL validates that the given URL of an endpoint of an OpenStack service is an absolute HTTP(S) URL.
+func validateEndpointURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("must be an absolute http or https URL")
+	}
+	return nil
+}
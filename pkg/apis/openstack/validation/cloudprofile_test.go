@@ -8,6 +8,8 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/pointer"
 
@@ -190,6 +192,56 @@ var _ = Describe("CloudProfileConfig validation", func() {
 			})
 		})
 
+		Context("load balancer class policies", func() {
+			It("should allow valid policies", func() {
+				cloudProfileConfig.Constraints.LoadBalancerClassPolicies = []api.LoadBalancerClassPolicy{
+					{
+						LoadBalancerClasses: []string{"internet"},
+						ShootPurposes:       []string{"production", "infrastructure"},
+						ShootSelector:       &metav1.LabelSelector{MatchLabels: map[string]string{"internet-facing": "true"}},
+					},
+				}
+
+				Expect(ValidateCloudProfileConfig(cloudProfileConfig, fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid invalid policies", func() {
+				cloudProfileConfig.Constraints.LoadBalancerClassPolicies = []api.LoadBalancerClassPolicy{
+					{},
+					{
+						LoadBalancerClasses: []string{""},
+						ShootPurposes:       []string{"staging"},
+						ShootSelector:       &metav1.LabelSelector{MatchLabels: map[string]string{"-": "true"}},
+					},
+				}
+
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, fldPath)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("root.constraints.loadBalancerClassPolicies[0].loadBalancerClasses"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("root.constraints.loadBalancerClassPolicies[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("root.constraints.loadBalancerClassPolicies[1].loadBalancerClasses[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("root.constraints.loadBalancerClassPolicies[1].shootPurposes[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("root.constraints.loadBalancerClassPolicies[1].shootSelector.matchLabels"),
+					})),
+				))
+			})
+		})
+
 		Context("keystone url validation", func() {
 			It("should forbid keystone urls with unsupported format", func() {
 				cloudProfileConfig.KeyStoneURL = ""
@@ -240,6 +292,50 @@ var _ = Describe("CloudProfileConfig validation", func() {
 			})
 		})
 
+		Context("endpoint override validation", func() {
+			It("should allow valid endpoint overrides", func() {
+				cloudProfileConfig.EndpointOverrides = []api.EndpointOverride{
+					{Service: "volumev3", URL: "https://cinder.internal:8776/v3"},
+					{Region: pointer.String("foo"), Service: "volumev3", URL: "https://cinder.foo.internal:8776/v3"},
+				}
+
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, fldPath)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid endpoint overrides without service or with invalid URLs", func() {
+				cloudProfileConfig.EndpointOverrides = []api.EndpointOverride{
+					{URL: "https://octavia.internal"},
+					{Service: "load-balancer", URL: "octavia.internal"},
+				}
+
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, fldPath)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("root.endpointOverrides[0].service"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("root.endpointOverrides[1].url"),
+				}))))
+			})
+
+			It("should forbid duplicate endpoint overrides for a service in a region", func() {
+				cloudProfileConfig.EndpointOverrides = []api.EndpointOverride{
+					{Region: pointer.String("foo"), Service: "volumev3", URL: "https://cinder.internal"},
+					{Region: pointer.String("foo"), Service: "volumev3", URL: "https://cinder.internal"},
+				}
+
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, fldPath)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("root.endpointOverrides[1].service"),
+				}))))
+			})
+		})
+
 		It("should forbid invalid keystone CA Certs", func() {
 			cloudProfileConfig.KeyStoneCACert = pointer.String("foo")
 
@@ -396,6 +492,214 @@ var _ = Describe("CloudProfileConfig validation", func() {
 						"Field": Equal("root.machineImages[0].versions[0].regions[2].architecture"),
 					}))))
 				})
+
+				It("should allow the supported capabilities", func() {
+					cloudProfileConfig.MachineImages = []api.MachineImages{
+						{
+							Name: "abc",
+							Versions: []api.MachineImageVersion{{
+								Version:      "foo",
+								Image:        "abc-foo",
+								Capabilities: []api.MachineImageCapability{"prebaked", "ignition"},
+							}},
+						},
+					}
+
+					Expect(ValidateCloudProfileConfig(cloudProfileConfig, fldPath)).To(BeEmpty())
+				})
+
+				It("should forbid unknown and duplicate capabilities", func() {
+					cloudProfileConfig.MachineImages = []api.MachineImages{
+						{
+							Name: "abc",
+							Versions: []api.MachineImageVersion{{
+								Version:      "foo",
+								Image:        "abc-foo",
+								Capabilities: []api.MachineImageCapability{"prebaked", "gpu", "prebaked"},
+							}},
+						},
+					}
+
+					errorList := ValidateCloudProfileConfig(cloudProfileConfig, fldPath)
+
+					Expect(errorList).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal("root.machineImages[0].versions[0].capabilities[1]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("root.machineImages[0].versions[0].capabilities[2]"),
+						})),
+					))
+				})
+			})
+		})
+
+		Context("region validation", func() {
+			It("should allow regions with flavor families and reserved aggregates", func() {
+				cloudProfileConfig.Regions = []api.RegionConfig{
+					{Name: "europe", FlavorFamilies: []string{"g_c"}, ReservedAggregates: []api.ReservedAggregate{{Name: "hana", FlavorFamilies: []string{"hana_"}}}},
+					{Name: "asia", RootVolumeTypes: map[string]string{"asia-a": "ssd-a", "asia-b": "ssd-b"}},
+				}
+
+				Expect(ValidateCloudProfileConfig(cloudProfileConfig, fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid invalid regions", func() {
+				cloudProfileConfig.Regions = []api.RegionConfig{
+					{Name: "europe", FlavorFamilies: []string{""}},
+					{Name: "europe", ReservedAggregates: []api.ReservedAggregate{{Name: "hana"}, {Name: "hana", FlavorFamilies: []string{"hana_"}}}},
+					{RootVolumeTypes: map[string]string{"": "ssd", "asia-a": ""}},
+				}
+
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, fldPath)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("root.regions[0].flavorFamilies[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("root.regions[1].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("root.regions[1].reservedAggregates[0].flavorFamilies"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("root.regions[1].reservedAggregates[1].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("root.regions[2].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("root.regions[2].rootVolumeTypes[]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("root.regions[2].rootVolumeTypes[asia-a]"),
+					})),
+				))
+			})
+		})
+
+		Context("pricing validation", func() {
+			It("should allow a pricing model", func() {
+				cloudProfileConfig.Pricing = &api.Pricing{
+					Currency:     "EUR",
+					MachineTypes: []api.MachineTypePrice{{Name: "g_c4_m16", PricePerHour: resource.MustParse("0.12")}},
+					VolumeTypes:  []api.VolumeTypePrice{{Name: "standard", PricePerGiBMonth: resource.MustParse("0.05")}},
+				}
+
+				Expect(ValidateCloudProfileConfig(cloudProfileConfig, fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid invalid pricing models", func() {
+				cloudProfileConfig.Pricing = &api.Pricing{
+					MachineTypes: []api.MachineTypePrice{
+						{Name: "g_c4_m16", PricePerHour: resource.MustParse("0.12")},
+						{Name: "g_c4_m16", PricePerHour: resource.MustParse("-1")},
+					},
+					VolumeTypes: []api.VolumeTypePrice{{PricePerGiBMonth: resource.MustParse("0.05")}},
+				}
+
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, fldPath)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("root.pricing.currency"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("root.pricing.machineTypes[1].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("root.pricing.machineTypes[1].pricePerHour"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("root.pricing.volumeTypes[0].name"),
+					})),
+				))
+			})
+		})
+
+		Context("gpu validation", func() {
+			It("should allow a GPU configuration", func() {
+				cloudProfileConfig.GPU = &api.GPUConfig{
+					ResourceName: pointer.String("nvidia.com/gpu"),
+					PCIAliases:   []string{"a100", "h100"},
+				}
+
+				Expect(ValidateCloudProfileConfig(cloudProfileConfig, fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid invalid GPU configurations", func() {
+				cloudProfileConfig.GPU = &api.GPUConfig{
+					ResourceName: pointer.String("nvidia.com/gpu/a100"),
+					PCIAliases:   []string{"a100", "", "a100"},
+				}
+
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, fldPath)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("root.gpu.resourceName"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("root.gpu.pciAliases[1]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("root.gpu.pciAliases[2]"),
+					})),
+				))
+			})
+		})
+
+		Context("bastion audit validation", func() {
+			It("should allow an audit configuration", func() {
+				cloudProfileConfig.Bastion = &api.BastionConfig{Audit: &api.BastionAudit{
+					LogLevel:     pointer.String("VERBOSE"),
+					ForceCommand: pointer.String("/usr/local/bin/record-session"),
+					UserData:     pointer.String("systemctl enable --now audit-forwarder"),
+				}}
+
+				Expect(ValidateCloudProfileConfig(cloudProfileConfig, fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid invalid audit configurations", func() {
+				cloudProfileConfig.Bastion = &api.BastionConfig{Audit: &api.BastionAudit{
+					LogLevel:     pointer.String("TRACE"),
+					ForceCommand: pointer.String("record-session\nPermitTTY no"),
+					UserData:     pointer.String(" "),
+				}}
+
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, fldPath)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("root.bastion.audit.logLevel"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("root.bastion.audit.forceCommand"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("root.bastion.audit.userData"),
+					})),
+				))
 			})
 		})
 
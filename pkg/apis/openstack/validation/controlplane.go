@@ -6,12 +6,21 @@ package validation
 
 import (
 	"fmt"
+	"slices"
+	"time"
 
+	"github.com/gardener/gardener/pkg/apis/core"
+	cidrvalidation "github.com/gardener/gardener/pkg/utils/validation/cidr"
 	featurevalidation "github.com/gardener/gardener/pkg/utils/validation/features"
 	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
 
 	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/helper"
 )
 
 // ValidateControlPlaneConfig validates a ControlPlaneConfig object.
@@ -37,6 +46,99 @@ func ValidateControlPlaneConfig(controlPlaneConfig *api.ControlPlaneConfig, infr
 	}
 
 	allErrs = append(allErrs, validateStorage(controlPlaneConfig.Storage, infraConfig.Networks.ShareNetwork, fldPath.Child("storage"))...)
+	allErrs = append(allErrs, validateLoadBalancerConfig(controlPlaneConfig.LoadBalancer, controlPlaneConfig.LoadBalancerProvider, fldPath.Child("loadBalancer"))...)
+	allErrs = append(allErrs, validateNodeLabeler(controlPlaneConfig.NodeLabeler, fldPath.Child("nodeLabeler"))...)
+
+	return allErrs
+}
+
+// minNodeLabelerSyncPeriod is the minimum sync period of the node labeler, which lists the servers, server groups and
+// host aggregates of the project in every sync.
+const minNodeLabelerSyncPeriod = time.Minute
+
+func validateNodeLabeler(config *api.NodeLabelerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if config == nil || config.SyncPeriod == nil {
+		return allErrs
+	}
+
+	if !config.Enabled {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("syncPeriod"), "sync period must not be provided if the node labeler is disabled"))
+	} else if config.SyncPeriod.Duration < minNodeLabelerSyncPeriod {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("syncPeriod"), config.SyncPeriod.Duration.String(), fmt.Sprintf("sync period must be at least %s", minNodeLabelerSyncPeriod)))
+	}
+
+	return allErrs
+}
+
+// ValidateKubeProxyReplacement validates that kube-proxy is disabled for the given shoot kubernetes settings if the
+// ControlPlaneConfig specifies that the CNI replaces kube-proxy. As the cloud-controller-manager then attaches the
+// security groups of the load balancers to the ports of the nodes, the port security of the worker pools must not be
+// disabled.
+func ValidateKubeProxyReplacement(controlPlaneConfig *api.ControlPlaneConfig, kubernetes core.Kubernetes, workers []core.Worker, fldPath, workersFldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if controlPlaneConfig.KubeProxyReplacement == nil || !*controlPlaneConfig.KubeProxyReplacement {
+		return allErrs
+	}
+	if kubernetes.KubeProxy == nil || kubernetes.KubeProxy.Enabled == nil || *kubernetes.KubeProxy.Enabled {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "kube-proxy replacement requires kube-proxy to be disabled in spec.kubernetes.kubeProxy.enabled"))
+	}
+
+	for i, worker := range workers {
+		if worker.ProviderConfig == nil {
+			continue
+		}
+		// Worker configs which cannot be decoded are reported by the validation of the workers.
+		workerConfig, err := helper.WorkerConfigFromRawExtension(worker.ProviderConfig)
+		if err != nil {
+			continue
+		}
+		if !pointer.BoolDeref(workerConfig.PortSecurityEnabled, true) {
+			allErrs = append(allErrs, field.Forbidden(workersFldPath.Index(i).Child("providerConfig", "portSecurityEnabled"), "kube-proxy replacement requires the port security of the worker pools, as the cloud-controller-manager attaches the security groups of the load balancers to the ports of the nodes"))
+		}
+	}
+
+	return allErrs
+}
+
+const ovnLoadBalancerProvider = "ovn"
+
+var supportedLoadBalancerAlgorithms = sets.New(
+	string(api.LoadBalancerAlgorithmRoundRobin),
+	string(api.LoadBalancerAlgorithmLeastConnections),
+	string(api.LoadBalancerAlgorithmSourceIP),
+	string(api.LoadBalancerAlgorithmSourceIPPort),
+)
+
+func validateLoadBalancerConfig(config *api.LoadBalancerConfig, provider string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if config == nil {
+		return allErrs
+	}
+
+	if config.Algorithm != nil {
+		algorithmPath := fldPath.Child("algorithm")
+		algorithm := *config.Algorithm
+		if !supportedLoadBalancerAlgorithms.Has(string(algorithm)) {
+			allErrs = append(allErrs, field.NotSupported(algorithmPath, algorithm, sets.List(supportedLoadBalancerAlgorithms)))
+		} else if provider == ovnLoadBalancerProvider && algorithm != api.LoadBalancerAlgorithmSourceIPPort {
+			allErrs = append(allErrs, field.Invalid(algorithmPath, algorithm, fmt.Sprintf("the %s load balancer provider only supports %s", ovnLoadBalancerProvider, api.LoadBalancerAlgorithmSourceIPPort)))
+		} else if provider != ovnLoadBalancerProvider && algorithm == api.LoadBalancerAlgorithmSourceIPPort {
+			allErrs = append(allErrs, field.Invalid(algorithmPath, algorithm, fmt.Sprintf("only supported by the %s load balancer provider", ovnLoadBalancerProvider)))
+		}
+	}
+
+	if config.AvailabilityZone != nil && len(*config.AvailabilityZone) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("availabilityZone"), *config.AvailabilityZone, "availability zone must not be empty"))
+	}
+	if config.FlavorID != nil {
+		if len(*config.FlavorID) == 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("flavorID"), *config.FlavorID, "flavor ID must not be empty"))
+		} else if provider == ovnLoadBalancerProvider {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("flavorID"), fmt.Sprintf("flavors are not supported by the %s load balancer provider", ovnLoadBalancerProvider)))
+		}
+	}
 
 	return allErrs
 }
@@ -139,6 +241,85 @@ func validateLoadBalancerClassesConstraints(floatingPools []api.FloatingPool, sh
 	return allErrs
 }
 
+// ValidateLoadBalancerClassesAgainstPolicies validates that the shoot may use its load balancer classes according to the
+// load balancer class policies of the cloud profile. If the shoot does not specify load balancer classes, all classes
+// of its floating pool are used and hence validated.
+func ValidateLoadBalancerClassesAgainstPolicies(cpConfig *api.ControlPlaneConfig, shoot *core.Shoot, domain, floatingPoolName string, cloudProfileConfig *api.CloudProfileConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	policies := cloudProfileConfig.Constraints.LoadBalancerClassPolicies
+	if len(policies) == 0 {
+		return allErrs
+	}
+	// an unknown floating pool is reported by the validation of the infrastructure config
+	fp, errs := FindFloatingPool(cloudProfileConfig.Constraints.FloatingPools, domain, shoot.Spec.Region, floatingPoolName, fldPath)
+	if len(errs) > 0 {
+		return allErrs
+	}
+
+	var (
+		restricted = sets.New[string]()
+		allowed    = sets.New[string]()
+	)
+	for _, policy := range policies {
+		restricted.Insert(policy.LoadBalancerClasses...)
+		if shootMatchesLoadBalancerClassPolicy(shoot, policy) {
+			allowed.Insert(policy.LoadBalancerClasses...)
+		}
+	}
+	usable := func(fpClass api.LoadBalancerClass) bool {
+		return !restricted.Has(fpClass.Name) || allowed.Has(fpClass.Name)
+	}
+
+	if len(cpConfig.LoadBalancerClasses) == 0 {
+		for _, fpClass := range fp.LoadBalancerClasses {
+			if !usable(fpClass) {
+				allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("load balancer class %q of floating pool %q is not allowed for this shoot, the load balancer classes must be specified explicitly without it", fpClass.Name, fp.Name)))
+			}
+		}
+		return allErrs
+	}
+
+	for i, class := range cpConfig.LoadBalancerClasses {
+		var matching, forbidden []string
+		for _, fpClass := range fp.LoadBalancerClasses {
+			if !class.IsSemanticallyEqual(fpClass) {
+				continue
+			}
+			matching = append(matching, fpClass.Name)
+			if !usable(fpClass) {
+				forbidden = append(forbidden, fpClass.Name)
+			}
+		}
+		// the class may be used if it equals at least one load balancer class of the floating pool which is not
+		// restricted for the shoot
+		if len(matching) > 0 && len(forbidden) == len(matching) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Index(i), fmt.Sprintf("load balancer class %q of floating pool %q is not allowed for this shoot", forbidden[0], fp.Name)))
+		}
+	}
+
+	return allErrs
+}
+
+func shootMatchesLoadBalancerClassPolicy(shoot *core.Shoot, policy api.LoadBalancerClassPolicy) bool {
+	if len(policy.ShootPurposes) > 0 {
+		purpose := core.ShootPurposeEvaluation
+		if shoot.Spec.Purpose != nil {
+			purpose = *shoot.Spec.Purpose
+		}
+		if !slices.Contains(policy.ShootPurposes, string(purpose)) {
+			return false
+		}
+	}
+	if policy.ShootSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(policy.ShootSelector)
+		if err != nil || !selector.Matches(labels.Set(shoot.Labels)) {
+			return false
+		}
+	}
+	return true
+}
+
 func validateStorage(storage *api.Storage, shareNetwork *api.ShareNetwork, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 	if storage == nil || storage.CSIManila == nil || !storage.CSIManila.Enabled {
@@ -147,5 +328,10 @@ func validateStorage(storage *api.Storage, shareNetwork *api.ShareNetwork, fldPa
 	if shareNetwork == nil || !shareNetwork.Enabled {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("csiManila", "enabled"), storage.CSIManila.Enabled, "share network must be created if CSI manila driver is enabled"))
 	}
+	if shareClient := storage.CSIManila.ShareClient; shareClient != nil {
+		shareClientPath := fldPath.Child("csiManila", "shareClient")
+		allErrs = append(allErrs, cidrvalidation.ValidateCIDRParse(cidrvalidation.NewCIDR(*shareClient, shareClientPath))...)
+		allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(shareClientPath, *shareClient)...)
+	}
 	return allErrs
 }
@@ -5,10 +5,16 @@
 package validation_test
 
 import (
+	"time"
+
+	"github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	"github.com/onsi/gomega/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/pointer"
 
@@ -88,6 +94,145 @@ var _ = Describe("ControlPlaneConfig validation", func() {
 
 			Expect(errorList).To(BeEmpty())
 		})
+
+		It("should allow a valid share client of CSI Manila", func() {
+			controlPlane.Storage = &api.Storage{CSIManila: &api.CSIManila{Enabled: true, ShareClient: pointer.String("10.250.0.0/16")}}
+			infraConfig.Networks.ShareNetwork = &api.ShareNetwork{Enabled: true}
+
+			errorList := ValidateControlPlaneConfig(controlPlane, infraConfig, "1.24.8", nilPath)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should fail for an invalid share client of CSI Manila", func() {
+			controlPlane.Storage = &api.Storage{CSIManila: &api.CSIManila{Enabled: true, ShareClient: pointer.String("10.250.0.1/16")}}
+			infraConfig.Networks.ShareNetwork = &api.ShareNetwork{Enabled: true}
+
+			errorList := ValidateControlPlaneConfig(controlPlane, infraConfig, "1.24.8", nilPath)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("storage.csiManila.shareClient"),
+				})),
+			))
+		})
+
+		DescribeTable("load balancer algorithm",
+			func(provider string, algorithm api.LoadBalancerAlgorithm, matcher types.GomegaMatcher) {
+				controlPlane.LoadBalancerProvider = provider
+				controlPlane.LoadBalancer = &api.LoadBalancerConfig{Algorithm: &algorithm}
+
+				Expect(ValidateControlPlaneConfig(controlPlane, infraConfig, "", nilPath)).To(matcher)
+			},
+			Entry("should allow source IP for amphora", "amphora", api.LoadBalancerAlgorithmSourceIP, BeEmpty()),
+			Entry("should allow source IP port for ovn", "ovn", api.LoadBalancerAlgorithmSourceIPPort, BeEmpty()),
+			Entry("should forbid unknown algorithms", "amphora", api.LoadBalancerAlgorithm("RANDOM"), ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("loadBalancer.algorithm"),
+			})))),
+			Entry("should forbid source IP for ovn", "ovn", api.LoadBalancerAlgorithmSourceIP, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("loadBalancer.algorithm"),
+			})))),
+			Entry("should forbid source IP port for amphora", "amphora", api.LoadBalancerAlgorithmSourceIPPort, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("loadBalancer.algorithm"),
+			})))),
+		)
+
+		DescribeTable("load balancer availability zone and flavor",
+			func(provider string, availabilityZone, flavorID *string, matcher types.GomegaMatcher) {
+				controlPlane.LoadBalancerProvider = provider
+				controlPlane.LoadBalancer = &api.LoadBalancerConfig{AvailabilityZone: availabilityZone, FlavorID: flavorID}
+
+				Expect(ValidateControlPlaneConfig(controlPlane, infraConfig, "", nilPath)).To(matcher)
+			},
+			Entry("should allow an availability zone and flavor for amphora", "amphora", pointer.String("az-1"), pointer.String("flavor-id"), BeEmpty()),
+			Entry("should allow an availability zone for ovn", "ovn", pointer.String("az-1"), nil, BeEmpty()),
+			Entry("should forbid empty values", "amphora", pointer.String(""), pointer.String(""), ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("loadBalancer.availabilityZone"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("loadBalancer.flavorID"),
+				})),
+			)),
+			Entry("should forbid a flavor for ovn", "ovn", nil, pointer.String("flavor-id"), ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("loadBalancer.flavorID"),
+			})))),
+		)
+
+		DescribeTable("node labeler",
+			func(config *api.NodeLabelerConfig, matcher types.GomegaMatcher) {
+				controlPlane.NodeLabeler = config
+
+				Expect(ValidateControlPlaneConfig(controlPlane, infraConfig, "", nilPath)).To(matcher)
+			},
+			Entry("should allow enabling the node labeler", &api.NodeLabelerConfig{Enabled: true}, BeEmpty()),
+			Entry("should allow a sync period", &api.NodeLabelerConfig{Enabled: true, SyncPeriod: &metav1.Duration{Duration: 5 * time.Minute}}, BeEmpty()),
+			Entry("should forbid a too short sync period", &api.NodeLabelerConfig{Enabled: true, SyncPeriod: &metav1.Duration{Duration: 30 * time.Second}}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("nodeLabeler.syncPeriod"),
+			})))),
+			Entry("should forbid a sync period if disabled", &api.NodeLabelerConfig{SyncPeriod: &metav1.Duration{Duration: 5 * time.Minute}}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("nodeLabeler.syncPeriod"),
+			})))),
+		)
+	})
+
+	Describe("#ValidateKubeProxyReplacement", func() {
+		var (
+			fldPath     = field.NewPath("kubeProxyReplacement")
+			workersPath = field.NewPath("workers")
+			kubernetes  = core.Kubernetes{KubeProxy: &core.KubeProxyConfig{Enabled: pointer.Bool(false)}}
+		)
+
+		It("should allow kube-proxy if the kube-proxy replacement is not enabled", func() {
+			Expect(ValidateKubeProxyReplacement(controlPlane, core.Kubernetes{}, nil, fldPath, workersPath)).To(BeEmpty())
+		})
+
+		It("should allow the kube-proxy replacement if kube-proxy is disabled", func() {
+			controlPlane.KubeProxyReplacement = pointer.Bool(true)
+
+			Expect(ValidateKubeProxyReplacement(controlPlane, core.Kubernetes{KubeProxy: &core.KubeProxyConfig{Enabled: pointer.Bool(false)}}, nil, fldPath, workersPath)).To(BeEmpty())
+		})
+
+		It("should forbid the kube-proxy replacement if kube-proxy is not disabled", func() {
+			controlPlane.KubeProxyReplacement = pointer.Bool(true)
+
+			Expect(ValidateKubeProxyReplacement(controlPlane, core.Kubernetes{}, nil, fldPath, workersPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("kubeProxyReplacement"),
+			}))))
+			Expect(ValidateKubeProxyReplacement(controlPlane, core.Kubernetes{KubeProxy: &core.KubeProxyConfig{Enabled: pointer.Bool(true)}}, nil, fldPath, workersPath)).To(HaveLen(1))
+		})
+
+		It("should forbid the kube-proxy replacement for worker pools without port security", func() {
+			controlPlane.KubeProxyReplacement = pointer.Bool(true)
+			workers := []core.Worker{
+				{Name: "default"},
+				{Name: "port-security", ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"openstack.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","portSecurityEnabled":true}`)}},
+				{Name: "no-port-security", ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"openstack.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","portSecurityEnabled":false}`)}},
+			}
+
+			Expect(ValidateKubeProxyReplacement(controlPlane, kubernetes, workers, fldPath, workersPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("workers[2].providerConfig.portSecurityEnabled"),
+			}))))
+		})
+
+		It("should allow worker pools without port security if the kube-proxy replacement is not enabled", func() {
+			workers := []core.Worker{
+				{Name: "no-port-security", ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"openstack.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","portSecurityEnabled":false}`)}},
+			}
+
+			Expect(ValidateKubeProxyReplacement(controlPlane, kubernetes, workers, fldPath, workersPath)).To(BeEmpty())
+		})
 	})
 
 	Describe("#ValidateControlPlaneConfigUpdate", func() {
@@ -463,4 +608,98 @@ var _ = Describe("ControlPlaneConfig validation", func() {
 			Expect(errorList).To(BeEmpty())
 		})
 	})
+
+	Describe("#ValidateLoadBalancerClassesAgainstPolicies", func() {
+		var (
+			region       = "foo"
+			domain       = "dummy"
+			floatingPool = "fp"
+
+			internetClass = api.LoadBalancerClass{
+				Name:              "internet",
+				FloatingNetworkID: pointer.String("fip-1"),
+			}
+			internalClass = api.LoadBalancerClass{
+				Name:     "internal",
+				SubnetID: pointer.String("subnet-1"),
+			}
+
+			fldPath            = field.NewPath("loadBalancerClasses")
+			cloudProfileConfig *api.CloudProfileConfig
+			shoot              *core.Shoot
+		)
+
+		BeforeEach(func() {
+			cloudProfileConfig = &api.CloudProfileConfig{
+				Constraints: api.Constraints{
+					FloatingPools: []api.FloatingPool{
+						{
+							Name:                floatingPool,
+							Region:              &region,
+							LoadBalancerClasses: []api.LoadBalancerClass{internetClass, internalClass},
+						},
+					},
+					LoadBalancerClassPolicies: []api.LoadBalancerClassPolicy{
+						{
+							LoadBalancerClasses: []string{"internet"},
+							ShootPurposes:       []string{"production"},
+						},
+						{
+							LoadBalancerClasses: []string{"internet"},
+							ShootSelector:       &metav1.LabelSelector{MatchLabels: map[string]string{"internet-facing": "true"}},
+						},
+					},
+				},
+			}
+			shoot = &core.Shoot{
+				Spec: core.ShootSpec{
+					Region: region,
+				},
+			}
+		})
+
+		It("should allow restricted classes for shoots matching one of the policies", func() {
+			controlPlane.LoadBalancerClasses = []api.LoadBalancerClass{{Name: "public", FloatingNetworkID: pointer.String("fip-1")}}
+
+			production := core.ShootPurposeProduction
+			shoot.Spec.Purpose = &production
+			Expect(ValidateLoadBalancerClassesAgainstPolicies(controlPlane, shoot, domain, floatingPool, cloudProfileConfig, fldPath)).To(BeEmpty())
+
+			shoot.Spec.Purpose = nil
+			shoot.Labels = map[string]string{"internet-facing": "true"}
+			Expect(ValidateLoadBalancerClassesAgainstPolicies(controlPlane, shoot, domain, floatingPool, cloudProfileConfig, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid restricted classes for shoots not matching any policy", func() {
+			controlPlane.LoadBalancerClasses = []api.LoadBalancerClass{
+				{Name: "private", SubnetID: pointer.String("subnet-1")},
+				{Name: "public", FloatingNetworkID: pointer.String("fip-1")},
+			}
+			development := core.ShootPurposeDevelopment
+			shoot.Spec.Purpose = &development
+
+			errorList := ValidateLoadBalancerClassesAgainstPolicies(controlPlane, shoot, domain, floatingPool, cloudProfileConfig, fldPath)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("loadBalancerClasses[1]"),
+			}))))
+		})
+
+		It("should validate the classes of the floating pool if the shoot does not specify classes", func() {
+			errorList := ValidateLoadBalancerClassesAgainstPolicies(controlPlane, shoot, domain, floatingPool, cloudProfileConfig, fldPath)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeForbidden),
+				"Field":  Equal("loadBalancerClasses"),
+				"Detail": ContainSubstring(`"internet"`),
+			}))))
+		})
+
+		It("should allow all classes if there are no policies", func() {
+			cloudProfileConfig.Constraints.LoadBalancerClassPolicies = nil
+
+			Expect(ValidateLoadBalancerClassesAgainstPolicies(controlPlane, shoot, domain, floatingPool, cloudProfileConfig, fldPath)).To(BeEmpty())
+		})
+	})
 })
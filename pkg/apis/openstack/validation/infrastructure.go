@@ -5,16 +5,20 @@
 package validation
 
 import (
+	"fmt"
 	"reflect"
 	"sort"
 
+	"github.com/gardener/gardener/pkg/apis/core"
 	cidrvalidation "github.com/gardener/gardener/pkg/utils/validation/cidr"
 	"github.com/google/uuid"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/utils"
 )
 
@@ -33,7 +37,7 @@ func ValidateInfrastructureConfig(infra *api.InfrastructureConfig, nodesCIDR *st
 	}
 
 	networksPath := fldPath.Child("networks")
-	if len(infra.Networks.Worker) == 0 && len(infra.Networks.Workers) == 0 {
+	if infra.Networks.Shared == nil && len(infra.Networks.Worker) == 0 && len(infra.Networks.Workers) == 0 {
 		allErrs = append(allErrs, field.Required(networksPath.Child("workers"), "must specify the network range for the worker network"))
 	}
 
@@ -67,6 +71,407 @@ func ValidateInfrastructureConfig(infra *api.InfrastructureConfig, nodesCIDR *st
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("floatingPoolSubnetName"), infra.FloatingPoolSubnetName, "router id must be empty when a floating subnet name is provided"))
 	}
 
+	allErrs = append(allErrs, validateSharedNetwork(infra.Networks, nodes, networksPath)...)
+	allErrs = append(allErrs, validateShareWithProjects(infra.Networks, networksPath)...)
+	allErrs = append(allErrs, validateNodeSubnets(infra.Networks, workerCIDR, nodes, networksPath.Child("subnets"))...)
+	allErrs = append(allErrs, validateAdoptedResources(infra, fldPath)...)
+	allErrs = append(allErrs, validateAdditionalFloatingPools(infra, fldPath.Child("additionalFloatingPools"))...)
+	allowIPv6 := isIPv6SecurityGroupRulesEnabled(infra.SecurityGroupRules)
+	allErrs = append(allErrs, validateNodePorts(infra.NodePorts, allowIPv6, fldPath.Child("nodePorts"))...)
+	allErrs = append(allErrs, validateEgress(infra.Egress, allowIPv6, fldPath.Child("egress"))...)
+	allErrs = append(allErrs, validateDedicatedProject(infra, fldPath)...)
+	allErrs = append(allErrs, validateAuxiliaryStack(infra.AuxiliaryStack, fldPath.Child("auxiliaryStack"))...)
+	allErrs = append(allErrs, validateApplicationCredential(infra.ApplicationCredential, fldPath.Child("applicationCredential"))...)
+
+	return allErrs
+}
+
+var reservedAuxiliaryStackParameters = sets.New(
+	api.AuxiliaryStackParameterNetworkID,
+	api.AuxiliaryStackParameterSubnetID,
+	api.AuxiliaryStackParameterRouterID,
+	api.AuxiliaryStackParameterSecurityGroupID,
+	api.AuxiliaryStackParameterFloatingNetworkID,
+)
+
+func validateAuxiliaryStack(stack *api.AuxiliaryStack, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if stack == nil {
+		return allErrs
+	}
+
+	templateRefPath := fldPath.Child("templateRef")
+	if len(stack.TemplateRef.ResourceName) == 0 {
+		allErrs = append(allErrs, field.Required(templateRefPath.Child("resourceName"), "must provide the name of the resource containing the template"))
+	}
+	if key := stack.TemplateRef.Key; key != nil {
+		for _, msg := range validation.IsConfigMapKey(*key) {
+			allErrs = append(allErrs, field.Invalid(templateRefPath.Child("key"), *key, msg))
+		}
+	}
+
+	for name := range stack.Parameters {
+		if len(name) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("parameters"), "must not contain parameters without name"))
+		} else if reservedAuxiliaryStackParameters.Has(name) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("parameters", name), "parameter is reserved for the ids of the infrastructure"))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateInfrastructureConfigAgainstResources validates that the resources referenced by the InfrastructureConfig
+// are contained in the resources of the shoot.
+func ValidateInfrastructureConfigAgainstResources(infra *api.InfrastructureConfig, resources []core.NamedResourceReference, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if infra.AuxiliaryStack == nil || len(infra.AuxiliaryStack.TemplateRef.ResourceName) == 0 {
+		return allErrs
+	}
+
+	resourceNamePath := fldPath.Child("auxiliaryStack", "templateRef", "resourceName")
+	resourceName := infra.AuxiliaryStack.TemplateRef.ResourceName
+	for _, resource := range resources {
+		if resource.Name != resourceName {
+			continue
+		}
+		if resource.ResourceRef.Kind != "ConfigMap" || resource.ResourceRef.APIVersion != "v1" {
+			allErrs = append(allErrs, field.Invalid(resourceNamePath, resourceName, "must reference a ConfigMap"))
+		}
+		return allErrs
+	}
+	return append(allErrs, field.NotFound(resourceNamePath, resourceName))
+}
+
+func validateDedicatedProject(infra *api.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if infra.DedicatedProject == nil {
+		return allErrs
+	}
+
+	// existing resources are located in other projects than the dedicated one
+	if infra.Adopt != nil && *infra.Adopt {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("adopt"), "existing resources cannot be adopted in a dedicated project"))
+	}
+	if infra.Networks.ID != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("networks", "id"), "an existing network cannot be used in a dedicated project"))
+	}
+	if infra.Networks.Router != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("networks", "router"), "an existing router cannot be used in a dedicated project"))
+	}
+	if infra.Networks.Shared != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("networks", "shared"), "a shared network cannot be used in a dedicated project"))
+	}
+
+	allErrs = append(allErrs, validateRoleNames(infra.DedicatedProject.Roles, fldPath.Child("dedicatedProject", "roles"))...)
+
+	return allErrs
+}
+
+func validateApplicationCredential(applicationCredential *api.ApplicationCredential, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if applicationCredential == nil {
+		return allErrs
+	}
+
+	allErrs = append(allErrs, validateRoleNames(applicationCredential.Roles, fldPath.Child("roles"))...)
+
+	return allErrs
+}
+
+func validateRoleNames(names []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	roles := sets.New[string]()
+	for i, role := range names {
+		idxPath := fldPath.Index(i)
+		if len(role) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath, "must provide the name of a role"))
+		} else if roles.Has(role) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, role))
+		}
+		roles.Insert(role)
+	}
+
+	return allErrs
+}
+
+func validateSharedNetwork(networks api.Networks, nodes cidrvalidation.CIDR, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if networks.Shared == nil {
+		return allErrs
+	}
+	sharedPath := fldPath.Child("shared")
+
+	// the subnet of the worker nodes is created in the shared network with the allocated CIDR
+	if networks.ID != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("id"), "network ID must not be provided if a shared network is used"))
+	}
+	if networks.Worker != "" {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("worker"), "workers CIDR must not be provided if a shared network is used"))
+	}
+	if networks.Workers != "" {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("workers"), "workers CIDR must not be provided if a shared network is used"))
+	}
+	if len(networks.Subnets) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("subnets"), "node subnets cannot be created if a shared network is used"))
+	}
+
+	if _, err := uuid.Parse(networks.Shared.ID); err != nil {
+		allErrs = append(allErrs, field.Invalid(sharedPath.Child("id"), networks.Shared.ID, "shared network ID must be a valid OpenStack UUID"))
+	}
+
+	cidr := cidrvalidation.NewCIDR(networks.Shared.CIDR, sharedPath.Child("cidr"))
+	if errs := cidrvalidation.ValidateCIDRParse(cidr); len(errs) > 0 {
+		return append(allErrs, errs...)
+	}
+	allErrs = append(allErrs, cidr.ValidateIPFamily(cidrvalidation.IPFamilyIPv4)...)
+	allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(sharedPath.Child("cidr"), networks.Shared.CIDR)...)
+
+	if prefixLength := networks.Shared.PrefixLength; prefixLength != nil {
+		if rangeLength, _ := cidr.GetIPNet().Mask.Size(); *prefixLength < int32(rangeLength) || *prefixLength > maxSharedNetworkPrefixLength {
+			allErrs = append(allErrs, field.Invalid(sharedPath.Child("prefixLength"), *prefixLength, fmt.Sprintf("prefix length must be between %d and %d", rangeLength, maxSharedNetworkPrefixLength)))
+		}
+	}
+
+	// the nodes CIDR of the shoot is allocated if given
+	if nodes != nil {
+		allErrs = append(allErrs, cidr.ValidateSubset(nodes)...)
+	}
+
+	return allErrs
+}
+
+func validateNodeSubnets(networks api.Networks, workerCIDR, nodes cidrvalidation.CIDR, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(networks.Subnets) == 0 {
+		return allErrs
+	}
+
+	names := sets.New[string]()
+	cidrs := []cidrvalidation.CIDR{workerCIDR}
+	for i, subnet := range networks.Subnets {
+		idxPath := fldPath.Index(i)
+		if len(subnet.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide the name of the subnet"))
+		} else if names.Has(subnet.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), subnet.Name))
+		} else {
+			for _, msg := range validation.IsDNS1123Label(subnet.Name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), subnet.Name, msg))
+			}
+		}
+		names.Insert(subnet.Name)
+
+		cidr := cidrvalidation.NewCIDR(subnet.CIDR, idxPath.Child("cidr"))
+		if errs := cidrvalidation.ValidateCIDRParse(cidr); len(errs) > 0 {
+			allErrs = append(allErrs, errs...)
+			continue
+		}
+		allErrs = append(allErrs, cidr.ValidateIPFamily(cidrvalidation.IPFamilyIPv4)...)
+		allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(idxPath.Child("cidr"), subnet.CIDR)...)
+		if nodes != nil {
+			allErrs = append(allErrs, nodes.ValidateSubset(cidr)...)
+		}
+		cidrs = append(cidrs, cidr)
+	}
+	allErrs = append(allErrs, cidrvalidation.ValidateCIDROverlap(cidrs, false)...)
+
+	return allErrs
+}
+
+func validateShareWithProjects(networks api.Networks, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(networks.ShareWithProjects) == 0 {
+		return allErrs
+	}
+	projectsPath := fldPath.Child("shareWithProjects")
+
+	// a shared network is owned by someone else, its RBAC policies are not managed per shoot
+	if networks.Shared != nil {
+		allErrs = append(allErrs, field.Forbidden(projectsPath, "the network cannot be shared with projects if a shared network is used"))
+	}
+
+	projects := sets.New[string]()
+	for i, project := range networks.ShareWithProjects {
+		idxPath := projectsPath.Index(i)
+		if len(project) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath, "must provide the ID of a project"))
+		} else if project == "*" {
+			allErrs = append(allErrs, field.Invalid(idxPath, project, "the network must not be shared with all projects"))
+		} else if projects.Has(project) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, project))
+		}
+		projects.Insert(project)
+	}
+
+	return allErrs
+}
+
+// isIPv6SecurityGroupRulesEnabled returns true if IPv6 rules of the security group of the nodes are enabled.
+func isIPv6SecurityGroupRulesEnabled(securityGroupRules *api.SecurityGroupRules) bool {
+	return securityGroupRules != nil && securityGroupRules.IPv6 != nil && *securityGroupRules.IPv6
+}
+
+func validateNodePorts(nodePorts *api.NodePorts, allowIPv6 bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if nodePorts == nil {
+		return allErrs
+	}
+
+	if nodePorts.Enabled != nil && !*nodePorts.Enabled && len(nodePorts.SourceCIDRs) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("sourceCIDRs"), "source CIDRs must not be provided if node ports are disabled"))
+	}
+
+	allErrs = append(allErrs, validateRuleCIDRs(nodePorts.SourceCIDRs, allowIPv6, fldPath.Child("sourceCIDRs"))...)
+
+	return allErrs
+}
+
+func validateEgress(egress *api.Egress, allowIPv6 bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if egress == nil {
+		return allErrs
+	}
+
+	if !egress.Restricted {
+		if len(egress.SeedCIDRs) > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("seedCIDRs"), "seed CIDRs must not be provided if egress traffic is not restricted"))
+		}
+		if len(egress.CIDRs) > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("cidrs"), "CIDRs must not be provided if egress traffic is not restricted"))
+		}
+	} else if len(egress.SeedCIDRs) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("seedCIDRs"), "must provide the CIDRs of the seed if egress traffic is restricted"))
+	}
+
+	allErrs = append(allErrs, validateRuleCIDRs(egress.SeedCIDRs, allowIPv6, fldPath.Child("seedCIDRs"))...)
+	allErrs = append(allErrs, validateRuleCIDRs(egress.CIDRs, allowIPv6, fldPath.Child("cidrs"))...)
+
+	return allErrs
+}
+
+// validateRuleCIDRs validates that the given CIDRs of security group rules are canonical CIDRs without duplicates. IPv6
+// CIDRs are only allowed if IPv6 rules are enabled.
+func validateRuleCIDRs(cidrs []string, allowIPv6 bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	seen := sets.New[string]()
+	for i, value := range cidrs {
+		idxPath := fldPath.Index(i)
+		cidr := cidrvalidation.NewCIDR(value, idxPath)
+		if errs := cidrvalidation.ValidateCIDRParse(cidr); len(errs) > 0 {
+			allErrs = append(allErrs, errs...)
+			continue
+		}
+		if !allowIPv6 {
+			allErrs = append(allErrs, cidr.ValidateIPFamily(cidrvalidation.IPFamilyIPv4)...)
+		}
+		allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(idxPath, value)...)
+		if seen.Has(value) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, value))
+		}
+		seen.Insert(value)
+	}
+
+	return allErrs
+}
+
+// maxSharedNetworkPrefixLength is the maximum prefix length of the CIDRs allocated in a shared network, leaving room for
+// the addresses reserved by OpenStack in each subnet.
+const maxSharedNetworkPrefixLength = 29
+
+var reservedLoadBalancerClassNames = sets.New(api.DefaultLoadBalancerClass, api.PrivateLoadBalancerClass, api.VPNLoadBalancerClass)
+
+func validateAdditionalFloatingPools(infra *api.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs      = field.ErrorList{}
+		poolNames    = sets.New(infra.FloatingPoolName)
+		lbClassNames = sets.New[string]()
+	)
+
+	for i, pool := range infra.AdditionalFloatingPools {
+		idxPath := fldPath.Index(i)
+
+		if len(pool.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide the name of a floating pool"))
+		} else if poolNames.Has(pool.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), pool.Name))
+		}
+		poolNames.Insert(pool.Name)
+
+		if len(pool.LoadBalancerClass) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("loadBalancerClass"), "must provide the name of a load balancer class"))
+		} else if reservedLoadBalancerClassNames.Has(pool.LoadBalancerClass) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("loadBalancerClass"), pool.LoadBalancerClass, "name is reserved for the load balancer classes of the main floating pool"))
+		} else if lbClassNames.Has(pool.LoadBalancerClass) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("loadBalancerClass"), pool.LoadBalancerClass))
+		}
+		lbClassNames.Insert(pool.LoadBalancerClass)
+
+		if pool.FloatingSubnetName != nil && len(*pool.FloatingSubnetName) == 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("floatingSubnetName"), *pool.FloatingSubnetName, "floating subnet name must not be empty if provided"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateAdoptedResources(infra *api.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	networksPath := fldPath.Child("networks")
+
+	if infra.Adopt == nil || !*infra.Adopt {
+		if infra.Networks.SubnetID != nil {
+			allErrs = append(allErrs, field.Forbidden(networksPath.Child("subnetID"), "subnet ID is only allowed if existing resources are adopted"))
+		}
+		if infra.SecurityGroupID != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("securityGroupID"), "security group ID is only allowed if existing resources are adopted"))
+		}
+		if infra.KeyPairName != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("keyPairName"), "key pair name is only allowed if existing resources are adopted"))
+		}
+		return allErrs
+	}
+
+	if infra.Networks.ID == nil {
+		allErrs = append(allErrs, field.Required(networksPath.Child("id"), "must provide the ID of an existing network when adopting existing resources"))
+	}
+	if infra.Networks.Router == nil {
+		allErrs = append(allErrs, field.Required(networksPath.Child("router"), "must provide an existing router when adopting existing resources"))
+	}
+	if infra.Networks.SubnetID == nil {
+		allErrs = append(allErrs, field.Required(networksPath.Child("subnetID"), "must provide the ID of an existing subnet when adopting existing resources"))
+	} else if _, err := uuid.Parse(*infra.Networks.SubnetID); err != nil {
+		allErrs = append(allErrs, field.Invalid(networksPath.Child("subnetID"), *infra.Networks.SubnetID, "subnet ID must be a valid OpenStack UUID"))
+	}
+	if infra.SecurityGroupID == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("securityGroupID"), "must provide the ID of an existing security group when adopting existing resources"))
+	} else if _, err := uuid.Parse(*infra.SecurityGroupID); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("securityGroupID"), *infra.SecurityGroupID, "security group ID must be a valid OpenStack UUID"))
+	}
+	if infra.KeyPairName == nil || len(*infra.KeyPairName) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("keyPairName"), "must provide the name of an existing key pair when adopting existing resources"))
+	}
+	if infra.Networks.ShareNetwork != nil && infra.Networks.ShareNetwork.Enabled {
+		allErrs = append(allErrs, field.Forbidden(networksPath.Child("shareNetwork"), "share network creation is not supported when adopting existing resources"))
+	}
+	if infra.NodePorts != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("nodePorts"), "the rules of an adopted security group are not managed"))
+	}
+	if infra.Egress != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("egress"), "the rules of an adopted security group are not managed"))
+	}
+	if infra.SecurityGroupRules != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("securityGroupRules"), "the rules of an adopted security group are not managed"))
+	}
+	if infra.Networks.Shared != nil {
+		allErrs = append(allErrs, field.Forbidden(networksPath.Child("shared"), "a shared network cannot be used when adopting existing resources"))
+	}
+	if len(infra.Networks.Subnets) > 0 {
+		allErrs = append(allErrs, field.Forbidden(networksPath.Child("subnets"), "node subnets cannot be created when adopting existing resources"))
+	}
+
 	return allErrs
 }
 
@@ -76,12 +481,56 @@ func ValidateInfrastructureConfigUpdate(oldConfig, newConfig *api.Infrastructure
 
 	newNetworks := newConfig.Networks
 	oldNetworks := oldConfig.Networks
-	// share network changes are allowed, therefore ignore them on comparing
+	// share network changes, the projects the network is shared with and added or removed node subnets are allowed,
+	// therefore ignore them on comparing
 	newNetworks.ShareNetwork = nil
 	oldNetworks.ShareNetwork = nil
+	newNetworks.ShareWithProjects = nil
+	oldNetworks.ShareWithProjects = nil
+	newNetworks.Subnets = nil
+	oldNetworks.Subnets = nil
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newNetworks, oldNetworks, fldPath.Child("networks"))...)
+	allErrs = append(allErrs, validateNodeSubnetsUpdate(oldConfig.Networks.Subnets, newConfig.Networks.Subnets, fldPath.Child("networks", "subnets"))...)
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.FloatingPoolName, oldConfig.FloatingPoolName, fldPath.Child("floatingPoolName"))...)
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.FloatingPoolSubnetName, oldConfig.FloatingPoolSubnetName, fldPath.Child("floatingPoolSubnetName"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.Adopt, oldConfig.Adopt, fldPath.Child("adopt"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.SecurityGroupID, oldConfig.SecurityGroupID, fldPath.Child("securityGroupID"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.KeyPairName, oldConfig.KeyPairName, fldPath.Child("keyPairName"))...)
+	if (oldConfig.DedicatedProject == nil) != (newConfig.DedicatedProject == nil) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("dedicatedProject"), "a dedicated project cannot be enabled or disabled for existing shoots"))
+	}
+
+	return allErrs
+}
+
+// validateNodeSubnetsUpdate validates that the CIDRs of the node subnets are not changed.
+func validateNodeSubnetsUpdate(oldSubnets, newSubnets []api.NodeSubnet, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	oldCIDRs := make(map[string]string, len(oldSubnets))
+	for _, subnet := range oldSubnets {
+		oldCIDRs[subnet.Name] = subnet.CIDR
+	}
+	for i, subnet := range newSubnets {
+		if oldCIDR, ok := oldCIDRs[subnet.Name]; ok {
+			allErrs = append(allErrs, apivalidation.ValidateImmutableField(subnet.CIDR, oldCIDR, fldPath.Index(i).Child("cidr"))...)
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateInfrastructureConfigAgainstCredentials validates the given InfrastructureConfig against the credentials of the shoot.
+func ValidateInfrastructureConfigAgainstCredentials(infra *api.InfrastructureConfig, credentials *openstack.Credentials, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	// the resources of a shoot are created in a project, domain-scoped credentials are only used to create a dedicated one
+	switch {
+	case infra.DedicatedProject != nil && !credentials.IsDomainScoped():
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("dedicatedProject"), "a dedicated project can only be created with domain-scoped credentials"))
+	case infra.DedicatedProject == nil && credentials.IsDomainScoped():
+		allErrs = append(allErrs, field.Required(fldPath.Child("dedicatedProject"), "domain-scoped credentials can only be used for shoots with a dedicated project"))
+	}
 
 	return allErrs
 }
@@ -94,6 +543,20 @@ func ValidateInfrastructureConfigAgainstCloudProfile(oldInfra, infra *api.Infras
 		allErrs = append(allErrs, validateFloatingPoolNameConstraints(cloudProfileConfig.Constraints.FloatingPools, domain, shootRegion, infra.FloatingPoolName, fldPath)...)
 	}
 
+	oldAdditionalPoolNames := sets.New[string]()
+	if oldInfra != nil {
+		for _, pool := range oldInfra.AdditionalFloatingPools {
+			oldAdditionalPoolNames.Insert(pool.Name)
+		}
+	}
+	for i, pool := range infra.AdditionalFloatingPools {
+		if oldAdditionalPoolNames.Has(pool.Name) {
+			continue
+		}
+		_, errs := FindFloatingPool(cloudProfileConfig.Constraints.FloatingPools, domain, shootRegion, pool.Name, fldPath.Child("additionalFloatingPools").Index(i).Child("name"))
+		allErrs = append(allErrs, errs...)
+	}
+
 	return allErrs
 }
 
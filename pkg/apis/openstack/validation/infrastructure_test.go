@@ -7,16 +7,19 @@ package validation_test
 import (
 	"strings"
 
+	"github.com/gardener/gardener/pkg/apis/core"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/pointer"
 
 	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
 	. "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/validation"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
 )
 
 var _ = Describe("InfrastructureConfig validation", func() {
@@ -154,6 +157,703 @@ var _ = Describe("InfrastructureConfig validation", func() {
 		})
 	})
 
+	Context("adopt", func() {
+		It("should forbid references to existing resources if resources are not adopted", func() {
+			infrastructureConfig.Networks.SubnetID = pointer.String(uuid.NewString())
+			infrastructureConfig.SecurityGroupID = pointer.String(uuid.NewString())
+			infrastructureConfig.KeyPairName = pointer.String("key")
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)
+
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("networks.subnetID"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("securityGroupID"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("keyPairName"),
+			}))
+		})
+
+		It("should require references to all existing resources", func() {
+			infrastructureConfig.Adopt = pointer.Bool(true)
+			infrastructureConfig.Networks.Router = nil
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)
+
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("networks.id"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("networks.router"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("networks.subnetID"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("securityGroupID"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("keyPairName"),
+			}))
+		})
+
+		It("should forbid invalid IDs and share network creation", func() {
+			infrastructureConfig.Adopt = pointer.Bool(true)
+			infrastructureConfig.Networks.ID = pointer.String(uuid.NewString())
+			infrastructureConfig.Networks.SubnetID = pointer.String("invalid")
+			infrastructureConfig.Networks.ShareNetwork = &api.ShareNetwork{Enabled: true}
+			infrastructureConfig.SecurityGroupID = pointer.String("invalid")
+			infrastructureConfig.KeyPairName = pointer.String("key")
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)
+
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.subnetID"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("securityGroupID"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("networks.shareNetwork"),
+			}))
+		})
+
+		It("should allow adopting existing resources", func() {
+			infrastructureConfig.Adopt = pointer.Bool(true)
+			infrastructureConfig.Networks.ID = pointer.String(uuid.NewString())
+			infrastructureConfig.Networks.SubnetID = pointer.String(uuid.NewString())
+			infrastructureConfig.SecurityGroupID = pointer.String(uuid.NewString())
+			infrastructureConfig.KeyPairName = pointer.String("key")
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)
+
+			Expect(errorList).To(BeEmpty())
+		})
+	})
+
+	Context("additional floating pools", func() {
+		It("should allow additional floating pools", func() {
+			infrastructureConfig.AdditionalFloatingPools = []api.AdditionalFloatingPool{
+				{Name: "dmz", LoadBalancerClass: "dmz"},
+				{Name: "internal", LoadBalancerClass: "internal", FloatingSubnetName: pointer.String("internal-*")},
+			}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should require the name and the load balancer class", func() {
+			infrastructureConfig.AdditionalFloatingPools = []api.AdditionalFloatingPool{{FloatingSubnetName: pointer.String("")}}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("additionalFloatingPools[0].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("additionalFloatingPools[0].loadBalancerClass"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("additionalFloatingPools[0].floatingSubnetName"),
+				})),
+			))
+		})
+
+		It("should forbid duplicate pools and load balancer classes", func() {
+			infrastructureConfig.AdditionalFloatingPools = []api.AdditionalFloatingPool{
+				{Name: floatingPoolName1, LoadBalancerClass: "dmz"},
+				{Name: "dmz", LoadBalancerClass: "dmz"},
+				{Name: "dmz", LoadBalancerClass: "other"},
+			}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("additionalFloatingPools[0].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("additionalFloatingPools[1].loadBalancerClass"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("additionalFloatingPools[2].name"),
+				})),
+			))
+		})
+
+		It("should forbid reserved load balancer class names", func() {
+			infrastructureConfig.AdditionalFloatingPools = []api.AdditionalFloatingPool{{Name: "dmz", LoadBalancerClass: api.VPNLoadBalancerClass}}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)
+
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("additionalFloatingPools[0].loadBalancerClass"),
+			}))
+		})
+	})
+
+	Context("node ports", func() {
+		It("should allow restricting the source CIDRs of node ports", func() {
+			infrastructureConfig.NodePorts = &api.NodePorts{SourceCIDRs: []string{"10.0.0.0/8", "192.168.0.0/16"}}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should allow IPv6 source CIDRs of node ports if IPv6 rules are enabled", func() {
+			infrastructureConfig.SecurityGroupRules = &api.SecurityGroupRules{IPv6: pointer.Bool(true)}
+			infrastructureConfig.NodePorts = &api.NodePorts{SourceCIDRs: []string{"10.0.0.0/8", "2001:db8::/32"}}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid IPv6 source CIDRs of node ports if IPv6 rules are not enabled", func() {
+			infrastructureConfig.NodePorts = &api.NodePorts{SourceCIDRs: []string{"10.0.0.0/8", "2001:db8::/32"}}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)
+
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("nodePorts.sourceCIDRs[1]"),
+			}))
+		})
+
+		It("should forbid invalid, non-canonical and duplicate source CIDRs", func() {
+			infrastructureConfig.SecurityGroupRules = &api.SecurityGroupRules{IPv6: pointer.Bool(true)}
+			infrastructureConfig.NodePorts = &api.NodePorts{SourceCIDRs: []string{"invalid", "10.0.0.1/8", "2001:db8::1/32", "192.168.0.0/16", "192.168.0.0/16"}}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)
+
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("nodePorts.sourceCIDRs[0]"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("nodePorts.sourceCIDRs[1]"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("nodePorts.sourceCIDRs[2]"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("nodePorts.sourceCIDRs[4]"),
+			}))
+		})
+
+		It("should forbid source CIDRs if node ports are disabled", func() {
+			infrastructureConfig.NodePorts = &api.NodePorts{Enabled: pointer.Bool(false), SourceCIDRs: []string{"10.0.0.0/8"}}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)
+
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("nodePorts.sourceCIDRs"),
+			}))
+		})
+
+		It("should forbid configuring node ports if resources are adopted", func() {
+			infrastructureConfig.Adopt = pointer.Bool(true)
+			infrastructureConfig.Networks.ID = pointer.String(uuid.NewString())
+			infrastructureConfig.Networks.SubnetID = pointer.String(uuid.NewString())
+			infrastructureConfig.SecurityGroupID = pointer.String(uuid.NewString())
+			infrastructureConfig.KeyPairName = pointer.String("key")
+			infrastructureConfig.NodePorts = &api.NodePorts{Enabled: pointer.Bool(false)}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)
+
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("nodePorts"),
+			}))
+		})
+	})
+
+	Context("egress", func() {
+		It("should allow restricting egress traffic", func() {
+			infrastructureConfig.Egress = &api.Egress{
+				Restricted: true,
+				SeedCIDRs:  []string{"203.0.113.10/32"},
+				CIDRs:      []string{"198.51.100.0/24"},
+			}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should require seed CIDRs if egress traffic is restricted", func() {
+			infrastructureConfig.Egress = &api.Egress{Restricted: true}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)
+
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("egress.seedCIDRs"),
+			}))
+		})
+
+		It("should forbid CIDRs if egress traffic is not restricted", func() {
+			infrastructureConfig.Egress = &api.Egress{SeedCIDRs: []string{"203.0.113.10/32"}, CIDRs: []string{"198.51.100.0/24"}}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)
+
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("egress.seedCIDRs"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("egress.cidrs"),
+			}))
+		})
+
+		It("should forbid invalid and duplicate CIDRs and IPv6 CIDRs if IPv6 rules are disabled", func() {
+			infrastructureConfig.SecurityGroupRules = &api.SecurityGroupRules{IPv6: pointer.Bool(false)}
+			infrastructureConfig.Egress = &api.Egress{
+				Restricted: true,
+				SeedCIDRs:  []string{"invalid", "203.0.113.10/32", "203.0.113.10/32"},
+				CIDRs:      []string{"2001:db8::/32"},
+			}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)
+
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("egress.seedCIDRs[0]"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("egress.seedCIDRs[2]"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("egress.cidrs[0]"),
+			}))
+		})
+
+		It("should forbid configuring egress rules if resources are adopted", func() {
+			infrastructureConfig.Adopt = pointer.Bool(true)
+			infrastructureConfig.Networks.ID = pointer.String(uuid.NewString())
+			infrastructureConfig.Networks.SubnetID = pointer.String(uuid.NewString())
+			infrastructureConfig.SecurityGroupID = pointer.String(uuid.NewString())
+			infrastructureConfig.KeyPairName = pointer.String("key")
+			infrastructureConfig.Egress = &api.Egress{Restricted: true, SeedCIDRs: []string{"203.0.113.10/32"}}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)
+
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("egress"),
+			}))
+		})
+	})
+
+	Context("securityGroupRules", func() {
+		It("should forbid configuring the rules if resources are adopted", func() {
+			infrastructureConfig.Adopt = pointer.Bool(true)
+			infrastructureConfig.Networks.ID = pointer.String(uuid.NewString())
+			infrastructureConfig.Networks.SubnetID = pointer.String(uuid.NewString())
+			infrastructureConfig.SecurityGroupID = pointer.String(uuid.NewString())
+			infrastructureConfig.KeyPairName = pointer.String("key")
+			infrastructureConfig.SecurityGroupRules = &api.SecurityGroupRules{IPv6: pointer.Bool(true)}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)
+
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("securityGroupRules"),
+			}))
+		})
+	})
+
+	Context("shared network", func() {
+		BeforeEach(func() {
+			infrastructureConfig.Networks.Workers = ""
+			infrastructureConfig.Networks.Shared = &api.SharedNetwork{
+				ID:   uuid.NewString(),
+				CIDR: "10.250.0.0/16",
+			}
+		})
+
+		It("should allow a shared network", func() {
+			infrastructureConfig.Networks.Shared.PrefixLength = pointer.Int32(20)
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, nil, nilPath)).To(BeEmpty())
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, pointer.String("10.250.16.0/20"), nilPath)).To(BeEmpty())
+		})
+
+		It("should forbid a network ID and workers CIDR", func() {
+			infrastructureConfig.Networks.ID = pointer.String(uuid.NewString())
+			infrastructureConfig.Networks.Workers = "10.250.0.0/24"
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, nil, nilPath)
+
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("networks.id"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("networks.workers"),
+			}))
+		})
+
+		It("should forbid an invalid ID, CIDR and prefix length", func() {
+			infrastructureConfig.Networks.Shared = &api.SharedNetwork{
+				ID:           "network",
+				CIDR:         "10.250.0.1/16",
+				PrefixLength: pointer.Int32(8),
+			}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, nil, nilPath)
+
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.shared.id"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.shared.cidr"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.shared.prefixLength"),
+			}))
+		})
+
+		It("should forbid a prefix length leaving no room for nodes", func() {
+			infrastructureConfig.Networks.Shared.PrefixLength = pointer.Int32(30)
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, nil, nilPath)
+
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("networks.shared.prefixLength"),
+				"Detail": Equal("prefix length must be between 16 and 29"),
+			}))
+		})
+
+		It("should forbid a nodes CIDR outside of the range of the shared network", func() {
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, pointer.String("10.251.0.0/24"), nilPath)
+
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networking.nodes"),
+			}))
+		})
+
+		It("should forbid a shared network if resources are adopted", func() {
+			infrastructureConfig.Adopt = pointer.Bool(true)
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, nil, nilPath)
+
+			Expect(errorList).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("networks.shared"),
+			}))))
+		})
+
+		It("should forbid a shared network in a dedicated project", func() {
+			infrastructureConfig.Networks.Router = nil
+			infrastructureConfig.DedicatedProject = &api.DedicatedProject{}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, nil, nilPath)
+
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("networks.shared"),
+			}))
+		})
+	})
+
+	Context("share with projects", func() {
+		It("should allow sharing the network with projects", func() {
+			infrastructureConfig.Networks.ShareWithProjects = []string{"project-1", "project-2"}
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)).To(BeEmpty())
+		})
+
+		It("should forbid empty, wildcard and duplicate projects", func() {
+			infrastructureConfig.Networks.ShareWithProjects = []string{"project-1", "", "*", "project-1"}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)
+
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("networks.shareWithProjects[1]"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.shareWithProjects[2]"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("networks.shareWithProjects[3]"),
+			}))
+		})
+
+		It("should forbid sharing a shared network", func() {
+			infrastructureConfig.Networks.Workers = ""
+			infrastructureConfig.Networks.Shared = &api.SharedNetwork{
+				ID:   uuid.NewString(),
+				CIDR: "10.250.0.0/16",
+			}
+			infrastructureConfig.Networks.ShareWithProjects = []string{"project-1"}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, nil, nilPath)
+
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("networks.shareWithProjects"),
+			}))
+		})
+	})
+
+	Context("node subnets", func() {
+		BeforeEach(func() {
+			infrastructureConfig.Networks.Workers = "10.250.0.0/18"
+		})
+
+		It("should allow node subnets", func() {
+			infrastructureConfig.Networks.Subnets = []api.NodeSubnet{
+				{Name: "zone-a", CIDR: "10.250.64.0/18"},
+				{Name: "zone-b", CIDR: "10.250.128.0/18"},
+			}
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)).To(BeEmpty())
+		})
+
+		It("should forbid invalid and duplicate names", func() {
+			infrastructureConfig.Networks.Subnets = []api.NodeSubnet{
+				{Name: "", CIDR: "10.250.64.0/20"},
+				{Name: "Zone_A", CIDR: "10.250.80.0/20"},
+				{Name: "zone-b", CIDR: "10.250.96.0/20"},
+				{Name: "zone-b", CIDR: "10.250.112.0/20"},
+			}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)
+
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("networks.subnets[0].name"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.subnets[1].name"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("networks.subnets[3].name"),
+			}))
+		})
+
+		It("should forbid invalid CIDRs and CIDRs outside of the nodes CIDR", func() {
+			infrastructureConfig.Networks.Subnets = []api.NodeSubnet{
+				{Name: "zone-a", CIDR: invalidCIDR},
+				{Name: "zone-b", CIDR: "10.250.64.1/18"},
+				{Name: "zone-c", CIDR: "10.251.0.0/18"},
+			}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)
+
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.subnets[0].cidr"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.subnets[1].cidr"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.subnets[2].cidr"),
+			}))
+		})
+
+		It("should forbid CIDRs overlapping with the workers CIDR or each other", func() {
+			infrastructureConfig.Networks.Subnets = []api.NodeSubnet{
+				{Name: "zone-a", CIDR: "10.250.0.0/20"},
+				{Name: "zone-b", CIDR: "10.250.64.0/18"},
+				{Name: "zone-c", CIDR: "10.250.64.0/20"},
+			}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)
+
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.subnets[0].cidr"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.subnets[2].cidr"),
+			}))
+		})
+
+		It("should forbid node subnets in a shared network", func() {
+			infrastructureConfig.Networks.Workers = ""
+			infrastructureConfig.Networks.Shared = &api.SharedNetwork{
+				ID:   uuid.NewString(),
+				CIDR: "10.250.0.0/16",
+			}
+			infrastructureConfig.Networks.Subnets = []api.NodeSubnet{{Name: "zone-a", CIDR: "10.250.64.0/18"}}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, nil, nilPath)
+
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("networks.subnets"),
+			}))
+		})
+	})
+
+	Context("application credential", func() {
+		It("should allow an application credential", func() {
+			infrastructureConfig.ApplicationCredential = &api.ApplicationCredential{Roles: []string{"member"}}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid empty and duplicate roles", func() {
+			infrastructureConfig.ApplicationCredential = &api.ApplicationCredential{Roles: []string{"member", "", "member"}}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)
+
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("applicationCredential.roles[1]"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("applicationCredential.roles[2]"),
+			}))
+		})
+	})
+
+	Context("dedicated project", func() {
+		BeforeEach(func() {
+			infrastructureConfig.Networks.Router = nil
+		})
+
+		It("should allow a dedicated project", func() {
+			infrastructureConfig.DedicatedProject = &api.DedicatedProject{Roles: []string{"member", "load-balancer_member"}}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid empty and duplicate roles", func() {
+			infrastructureConfig.DedicatedProject = &api.DedicatedProject{Roles: []string{"member", "", "member"}}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)
+
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("dedicatedProject.roles[1]"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("dedicatedProject.roles[2]"),
+			}))
+		})
+
+		It("should forbid existing resources in a dedicated project", func() {
+			infrastructureConfig.DedicatedProject = &api.DedicatedProject{}
+			infrastructureConfig.Networks.ID = pointer.String(uuid.NewString())
+			infrastructureConfig.Networks.Router = &api.Router{ID: "router"}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)
+
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("networks.id"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("networks.router"),
+			}))
+		})
+
+		It("should forbid adopting resources in a dedicated project", func() {
+			infrastructureConfig.DedicatedProject = &api.DedicatedProject{}
+			infrastructureConfig.Adopt = pointer.Bool(true)
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)
+
+			Expect(errorList).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("adopt"),
+			}))))
+		})
+	})
+
+	Context("auxiliary stack", func() {
+		It("should allow an auxiliary stack", func() {
+			infrastructureConfig.AuxiliaryStack = &api.AuxiliaryStack{
+				TemplateRef: api.TemplateReference{ResourceName: "heat-template", Key: pointer.String("stack.yaml")},
+				Parameters:  map[string]string{"flavor": "m1.small"},
+			}
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)).To(BeEmpty())
+		})
+
+		It("should forbid an invalid template reference and reserved parameters", func() {
+			infrastructureConfig.AuxiliaryStack = &api.AuxiliaryStack{
+				TemplateRef: api.TemplateReference{Key: pointer.String("stack/yaml")},
+				Parameters:  map[string]string{"network_id": "foo"},
+			}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)
+
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("auxiliaryStack.templateRef.resourceName"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("auxiliaryStack.templateRef.key"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("auxiliaryStack.parameters.network_id"),
+			}))
+		})
+	})
+
+	Describe("#ValidateInfrastructureConfigAgainstResources", func() {
+		var resources []core.NamedResourceReference
+
+		BeforeEach(func() {
+			infrastructureConfig.AuxiliaryStack = &api.AuxiliaryStack{
+				TemplateRef: api.TemplateReference{ResourceName: "heat-template"},
+			}
+			resources = []core.NamedResourceReference{{
+				Name:        "heat-template",
+				ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "ConfigMap", Name: "my-template"},
+			}}
+		})
+
+		It("should allow a template in a referenced ConfigMap", func() {
+			Expect(ValidateInfrastructureConfigAgainstResources(infrastructureConfig, resources, nilPath)).To(BeEmpty())
+		})
+
+		It("should forbid a template in a resource which is not referenced", func() {
+			Expect(ValidateInfrastructureConfigAgainstResources(infrastructureConfig, nil, nilPath)).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeNotFound),
+				"Field": Equal("auxiliaryStack.templateRef.resourceName"),
+			}))
+		})
+
+		It("should forbid a template in a referenced Secret", func() {
+			resources[0].ResourceRef.Kind = "Secret"
+
+			Expect(ValidateInfrastructureConfigAgainstResources(infrastructureConfig, resources, nilPath)).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("auxiliaryStack.templateRef.resourceName"),
+			}))
+		})
+	})
+
 	Describe("#ValidateInfrastructureConfigUpdate", func() {
 		It("should return no errors for an unchanged config", func() {
 			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, infrastructureConfig, nilPath)).To(BeEmpty())
@@ -180,6 +880,63 @@ var _ = Describe("InfrastructureConfig validation", func() {
 			Expect(errorList).To(BeEmpty())
 		})
 
+		It("should allow changing the projects the network is shared with", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.ShareWithProjects = []string{"project-1"}
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, nilPath)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should allow adding and removing node subnets", func() {
+			infrastructureConfig.Networks.Workers = "10.250.0.0/18"
+			infrastructureConfig.Networks.Subnets = []api.NodeSubnet{{Name: "zone-a", CIDR: "10.250.64.0/18"}}
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.Subnets = []api.NodeSubnet{{Name: "zone-b", CIDR: "10.250.128.0/18"}}
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, nilPath)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid changing the CIDR of a node subnet", func() {
+			infrastructureConfig.Networks.Workers = "10.250.0.0/18"
+			infrastructureConfig.Networks.Subnets = []api.NodeSubnet{{Name: "zone-a", CIDR: "10.250.64.0/18"}}
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.Subnets = []api.NodeSubnet{
+				{Name: "zone-b", CIDR: "10.250.128.0/18"},
+				{Name: "zone-a", CIDR: "10.250.64.0/19"},
+			}
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, nilPath)
+
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.subnets[1].cidr"),
+			}))
+		})
+
+		It("should forbid changing the adopted resources", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Adopt = pointer.Bool(true)
+			newInfrastructureConfig.SecurityGroupID = pointer.String("sg")
+			newInfrastructureConfig.KeyPairName = pointer.String("key")
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, nilPath)
+
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("adopt"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("securityGroupID"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("keyPairName"),
+			}))
+		})
+
 		It("should forbid changing the floating pool", func() {
 			newInfrastructureConfig := infrastructureConfig.DeepCopy()
 			newInfrastructureConfig.FloatingPoolName = "test"
@@ -203,6 +960,58 @@ var _ = Describe("InfrastructureConfig validation", func() {
 				"Field": Equal("floatingPoolSubnetName"),
 			}))))
 		})
+
+		It("should forbid enabling or disabling the dedicated project", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.DedicatedProject = &api.DedicatedProject{}
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, nilPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("dedicatedProject"),
+			}))))
+			Expect(ValidateInfrastructureConfigUpdate(newInfrastructureConfig, infrastructureConfig, nilPath)).To(HaveLen(1))
+		})
+
+		It("should allow changing the roles of the dedicated project", func() {
+			infrastructureConfig.DedicatedProject = &api.DedicatedProject{}
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.DedicatedProject.Roles = []string{"member", "load-balancer_member"}
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, nilPath)).To(BeEmpty())
+		})
+	})
+
+	Describe("#ValidateInfrastructureConfigAgainstCredentials", func() {
+		var (
+			projectCredentials = &openstack.Credentials{DomainName: "domain", TenantName: "project"}
+			domainCredentials  = &openstack.Credentials{DomainName: "domain", AuthScope: openstack.AuthScopeDomain}
+		)
+
+		It("should allow project-scoped credentials without a dedicated project", func() {
+			Expect(ValidateInfrastructureConfigAgainstCredentials(infrastructureConfig, projectCredentials, nilPath)).To(BeEmpty())
+		})
+
+		It("should allow domain-scoped credentials with a dedicated project", func() {
+			infrastructureConfig.DedicatedProject = &api.DedicatedProject{}
+
+			Expect(ValidateInfrastructureConfigAgainstCredentials(infrastructureConfig, domainCredentials, nilPath)).To(BeEmpty())
+		})
+
+		It("should forbid a dedicated project with project-scoped credentials", func() {
+			infrastructureConfig.DedicatedProject = &api.DedicatedProject{}
+
+			Expect(ValidateInfrastructureConfigAgainstCredentials(infrastructureConfig, projectCredentials, nilPath)).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("dedicatedProject"),
+			}))
+		})
+
+		It("should require a dedicated project with domain-scoped credentials", func() {
+			Expect(ValidateInfrastructureConfigAgainstCredentials(infrastructureConfig, domainCredentials, nilPath)).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("dedicatedProject"),
+			}))
+		})
 	})
 
 	Describe("#ValidateInfrastructureConfigAgainstCloudProfile", func() {
@@ -431,6 +1240,24 @@ var _ = Describe("InfrastructureConfig validation", func() {
 			errorList := ValidateInfrastructureConfigAgainstCloudProfile(oldInfrastructureConfig, infrastructureConfig, domain, region, cloudProfileConfig, nilPath)
 			Expect(errorList).To(BeEmpty())
 		})
+
+		It("should validate added additional floating pools against the constraints", func() {
+			cloudProfileConfig.Constraints.FloatingPools = append(cloudProfileConfig.Constraints.FloatingPools, api.FloatingPool{Name: "dmz", Region: &region})
+			oldInfrastructureConfig := infrastructureConfig.DeepCopy()
+			oldInfrastructureConfig.AdditionalFloatingPools = []api.AdditionalFloatingPool{{Name: "unchanged", LoadBalancerClass: "unchanged"}}
+			infrastructureConfig.AdditionalFloatingPools = []api.AdditionalFloatingPool{
+				{Name: "unchanged", LoadBalancerClass: "unchanged"},
+				{Name: "dmz", LoadBalancerClass: "dmz"},
+				{Name: "not-allowed", LoadBalancerClass: "not-allowed"},
+			}
+
+			errorList := ValidateInfrastructureConfigAgainstCloudProfile(oldInfrastructureConfig, infrastructureConfig, domain, region, cloudProfileConfig, nilPath)
+
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("additionalFloatingPools[2].name"),
+			}))
+		})
 	})
 
 	Describe("#FindFloatingPool", func() {
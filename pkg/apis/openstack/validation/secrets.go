@@ -16,21 +16,32 @@ import (
 
 const (
 	tenantNameMaxLen = 64
+	idMaxLen         = 64
 )
 
 // ValidateCloudProviderSecret checks whether the given secret contains a valid OpenStack credentials.
 func ValidateCloudProviderSecret(secret *corev1.Secret) error {
+	secretKey := fmt.Sprintf("%s/%s", secret.Namespace, secret.Name)
+
+	// the credentials aliased by the secret are validated against the shoots referencing the centrally managed secret
+	if name, ok := openstack.CredentialsResourceNameOf(secret); ok {
+		if strings.TrimSpace(name) == "" {
+			return fmt.Errorf("field %q in secret %s must not be empty", openstack.CredentialsResourceName, secretKey)
+		}
+		return nil
+	}
+
 	credentials, err := openstack.ExtractCredentials(secret, false)
 	if err != nil {
 		return err
 	}
 
-	secretKey := fmt.Sprintf("%s/%s", secret.Namespace, secret.Name)
-
-	// domainName, tenantName, and userName must not contain leading or trailing whitespace
+	// domainName, domainID, tenantName, tenantID and userName must not contain leading or trailing whitespace
 	for key, value := range map[string]string{
 		openstack.DomainName:                  credentials.DomainName,
+		openstack.DomainID:                    credentials.DomainID,
 		openstack.TenantName:                  credentials.TenantName,
+		openstack.TenantID:                    credentials.TenantID,
 		openstack.UserName:                    credentials.Username,
 		openstack.ApplicationCredentialID:     credentials.ApplicationCredentialID,
 		openstack.ApplicationCredentialName:   credentials.ApplicationCredentialName,
@@ -46,6 +57,16 @@ func ValidateCloudProviderSecret(secret *corev1.Secret) error {
 		return fmt.Errorf("field %q in secret %s cannot be longer than %d characters", openstack.TenantName, secretKey, tenantNameMaxLen)
 	}
 
+	// ids must not be longer than 64 characters, see https://docs.openstack.org/api-ref/identity/v3/?expanded=show-project-details-detail
+	for key, value := range map[string]string{
+		openstack.DomainID: credentials.DomainID,
+		openstack.TenantID: credentials.TenantID,
+	} {
+		if len(value) > idMaxLen {
+			return fmt.Errorf("field %q in secret %s cannot be longer than %d characters", key, secretKey, idMaxLen)
+		}
+	}
+
 	// password must not contain leading or trailing new lines, as they are known to cause issues
 	// Other whitespace characters such as spaces are intentionally not checked for,
 	// since there is no documentation indicating that they would not be valid
@@ -60,5 +81,31 @@ func ValidateCloudProviderSecret(secret *corev1.Secret) error {
 		}
 	}
 
+	// endpoint overrides are only accepted from the cloud profile, as they receive the tokens of the credentials
+	if _, ok := secret.Data[openstack.EndpointOverrides]; ok {
+		return fmt.Errorf("field %q in secret %s is not allowed, endpoint overrides can only be configured in the cloud profile", openstack.EndpointOverrides, secretKey)
+	}
+
+	// the read-only credentials share the domain, project and auth URL with the credentials of the secret
+	if openstack.HasReadOnlyCredentials(secret) {
+		readOnlyCredentials, err := openstack.ExtractReadOnlyCredentials(secret)
+		if err != nil {
+			return err
+		}
+		for key, value := range map[string]string{
+			openstack.ReadOnlyUserName:                    readOnlyCredentials.Username,
+			openstack.ReadOnlyApplicationCredentialID:     readOnlyCredentials.ApplicationCredentialID,
+			openstack.ReadOnlyApplicationCredentialName:   readOnlyCredentials.ApplicationCredentialName,
+			openstack.ReadOnlyApplicationCredentialSecret: readOnlyCredentials.ApplicationCredentialSecret,
+		} {
+			if strings.TrimSpace(value) != value {
+				return fmt.Errorf("field %q in secret %s must not contain leading or traling whitespace", key, secretKey)
+			}
+		}
+		if strings.Trim(readOnlyCredentials.Password, "\n\r") != readOnlyCredentials.Password {
+			return fmt.Errorf("field %q in secret %s must not contain leading or traling new lines", openstack.ReadOnlyPassword, secretKey)
+		}
+	}
+
 	return nil
 }
@@ -186,6 +186,17 @@ var _ = Describe("Secret validation", func() {
 			BeNil(),
 		),
 
+		Entry("should return error when the secret contains endpoint overrides",
+			map[string][]byte{
+				openstack.DomainName:        []byte("domain"),
+				openstack.TenantName:        []byte("tenant"),
+				openstack.UserName:          []byte("user"),
+				openstack.Password:          []byte("password"),
+				openstack.EndpointOverrides: []byte(`{"volumev3": "https://cinder.internal:8776/v3"}`),
+			},
+			HaveOccurred(),
+		),
+
 		Entry("should return error when the application credential id contains a trailing new line",
 			map[string][]byte{
 				openstack.DomainName:                  []byte("domain"),
@@ -289,5 +300,131 @@ var _ = Describe("Secret validation", func() {
 			},
 			BeNil(),
 		),
+
+		Entry("should succeed when the project is identified by id",
+			map[string][]byte{
+				openstack.DomainName: []byte("domain"),
+				openstack.TenantID:   []byte("0123456789abcdef0123456789abcdef"),
+				openstack.UserName:   []byte("user"),
+				openstack.Password:   []byte("password"),
+			},
+			BeNil(),
+		),
+
+		Entry("should succeed when domain and project are identified by id",
+			map[string][]byte{
+				openstack.DomainID: []byte("default"),
+				openstack.TenantID: []byte("0123456789abcdef0123456789abcdef"),
+				openstack.UserName: []byte("user"),
+				openstack.Password: []byte("password"),
+			},
+			BeNil(),
+		),
+
+		Entry("should return error when the tenant id contains a trailing space",
+			map[string][]byte{
+				openstack.DomainName: []byte("domain"),
+				openstack.TenantID:   []byte("0123456789abcdef "),
+				openstack.UserName:   []byte("user"),
+				openstack.Password:   []byte("password"),
+			},
+			HaveOccurred(),
+		),
+
+		Entry("should return error when the tenant id is too long",
+			map[string][]byte{
+				openstack.DomainName: []byte("domain"),
+				openstack.TenantID:   []byte(strings.Repeat("a", 65)),
+				openstack.UserName:   []byte("user"),
+				openstack.Password:   []byte("password"),
+			},
+			HaveOccurred(),
+		),
+
+		Entry("should return error when the auth scope is invalid",
+			map[string][]byte{
+				openstack.DomainName: []byte("domain"),
+				openstack.TenantName: []byte("tenant"),
+				openstack.UserName:   []byte("user"),
+				openstack.Password:   []byte("password"),
+				openstack.AuthScope:  []byte("system"),
+			},
+			HaveOccurred(),
+		),
+
+		Entry("should succeed when the auth scope is domain",
+			map[string][]byte{
+				openstack.DomainName: []byte("domain"),
+				openstack.UserName:   []byte("user"),
+				openstack.Password:   []byte("password"),
+				openstack.AuthScope:  []byte("domain"),
+			},
+			BeNil(),
+		),
+
+		Entry("should succeed when the read-only credentials are valid (user)",
+			map[string][]byte{
+				openstack.DomainName:       []byte("domain"),
+				openstack.TenantName:       []byte("tenant"),
+				openstack.UserName:         []byte("user"),
+				openstack.Password:         []byte("password"),
+				openstack.ReadOnlyUserName: []byte("reader"),
+				openstack.ReadOnlyPassword: []byte("reader-password"),
+			},
+			BeNil(),
+		),
+
+		Entry("should succeed when the read-only credentials are valid (application credential)",
+			map[string][]byte{
+				openstack.DomainName:                          []byte("domain"),
+				openstack.TenantName:                          []byte("tenant"),
+				openstack.UserName:                            []byte("user"),
+				openstack.Password:                            []byte("password"),
+				openstack.ReadOnlyApplicationCredentialID:     []byte("app-id"),
+				openstack.ReadOnlyApplicationCredentialSecret: []byte("app-secret"),
+			},
+			BeNil(),
+		),
+
+		Entry("should return error when the read-only credentials are incomplete",
+			map[string][]byte{
+				openstack.DomainName:       []byte("domain"),
+				openstack.TenantName:       []byte("tenant"),
+				openstack.UserName:         []byte("user"),
+				openstack.Password:         []byte("password"),
+				openstack.ReadOnlyUserName: []byte("reader"),
+			},
+			MatchError(ContainSubstring("invalid read-only credentials")),
+		),
+
+		Entry("should return error when the read-only password contains a trailing new line",
+			map[string][]byte{
+				openstack.DomainName:       []byte("domain"),
+				openstack.TenantName:       []byte("tenant"),
+				openstack.UserName:         []byte("user"),
+				openstack.Password:         []byte("password"),
+				openstack.ReadOnlyUserName: []byte("reader"),
+				openstack.ReadOnlyPassword: []byte("reader-password\n"),
+			},
+			HaveOccurred(),
+		),
+
+		Entry("should succeed when the secret aliases the credentials of a referenced resource",
+			map[string][]byte{
+				openstack.CredentialsResourceName: []byte("shared-credentials"),
+			},
+			BeNil(),
+		),
+
+		Entry("should return error when the aliased credentials resource name is empty",
+			map[string][]byte{
+				openstack.CredentialsResourceName: {},
+				openstack.DomainName:              []byte("domain"),
+				openstack.TenantName:              []byte("tenant"),
+				openstack.UserName:                []byte("user"),
+				openstack.Password:                []byte("password"),
+			},
+			MatchError(ContainSubstring("must not be empty")),
+		),
 	)
 })
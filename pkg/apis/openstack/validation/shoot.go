@@ -5,16 +5,26 @@
 package validation
 
 import (
+	"encoding/json"
 	"fmt"
+	"net"
+	"regexp"
+	"slices"
+	"strings"
 
 	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	validationutils "github.com/gardener/gardener/pkg/utils/validation"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/groups"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
 
 	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/helper"
@@ -79,6 +89,219 @@ func ValidateWorkersUpdate(oldWorkers, newWorkers []core.Worker, fldPath *field.
 	return allErrs
 }
 
+// ValidateWorkersAgainstStorageClasses validates that each availability zone of the region which the volumes of a Cinder
+// storage class of the CloudProfile are pinned to is used by at least one worker pool, as pods using such volumes could
+// not be scheduled otherwise. Zones of other regions are ignored as the storage classes are shared across all regions.
+func ValidateWorkersAgainstStorageClasses(workers []core.Worker, cloudProfile *gardencorev1beta1.CloudProfile, region string, cloudProfileConfig *api.CloudProfileConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	// Without worker pools no volumes can be used at all, and if the volume AZ is ignored, the availability parameter
+	// refers to Cinder zones which are unrelated to the zones of the worker pools.
+	if len(workers) == 0 || cloudProfileConfig == nil || (cloudProfileConfig.IgnoreVolumeAZ != nil && *cloudProfileConfig.IgnoreVolumeAZ) {
+		return allErrs
+	}
+
+	regionZoneNames := sets.New[string]()
+	for _, zone := range regionZones(cloudProfile, region) {
+		regionZoneNames.Insert(zone.Name)
+	}
+	workerZones := sets.New[string]()
+	for _, worker := range workers {
+		workerZones.Insert(worker.Zones...)
+	}
+
+	for _, storageClass := range cloudProfileConfig.StorageClasses {
+		zone := helper.StorageClassAvailabilityZone(storageClass)
+		if zone == "" || !regionZoneNames.Has(zone) || workerZones.Has(zone) {
+			continue
+		}
+		allErrs = append(allErrs, field.Invalid(fldPath, sets.List(workerZones), fmt.Sprintf("storage class %q pins its volumes to zone %q which is not used by any worker pool", storageClass.Name, zone)))
+	}
+
+	return allErrs
+}
+
+// ValidateWorkersAgainstRegionFlavors validates that the flavors of the worker pools belong to the flavor families which
+// are allowed in the region of the shoot. Only worker pools whose flavor was changed are validated, so that running
+// shoots won't break after the allowed flavor families of the region were restricted.
+func ValidateWorkersAgainstRegionFlavors(oldWorkers, workers []core.Worker, region string, cloudProfileConfig *api.CloudProfileConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cloudProfileConfig == nil {
+		return allErrs
+	}
+	families := helper.AllowedFlavorFamilies(helper.FindRegionConfig(cloudProfileConfig.Regions, region))
+	if len(families) == 0 {
+		return allErrs
+	}
+
+	oldFlavors := make(map[string]string, len(oldWorkers))
+	for _, worker := range oldWorkers {
+		oldFlavors[worker.Name] = worker.Machine.Type
+	}
+
+	for i, worker := range workers {
+		if oldFlavor, ok := oldFlavors[worker.Name]; ok && oldFlavor == worker.Machine.Type {
+			continue
+		}
+		if !hasFlavorFamily(worker.Machine.Type, families) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Index(i).Child("machine", "type"), worker.Machine.Type, families))
+		}
+	}
+
+	return allErrs
+}
+
+func hasFlavorFamily(flavor string, families []string) bool {
+	for _, family := range families {
+		if strings.HasPrefix(flavor, family) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateWorkersAgainstSecurityGroups validates that the additional security groups of the worker pools exist in the
+// project of the shoot, either by id or by a name which is unique in the project. The security groups of the project are
+// only listed with the given function if a worker pool references a security group it did not reference before, so that
+// running shoots won't break after a security group was removed. An error is returned if they cannot be listed.
+func ValidateWorkersAgainstSecurityGroups(oldWorkers, workers []core.Worker, listSecurityGroups func() ([]groups.SecGroup, error), fldPath *field.Path) (field.ErrorList, error) {
+	allErrs := field.ErrorList{}
+
+	oldSecurityGroups := make(map[string]sets.Set[string], len(oldWorkers))
+	for _, worker := range oldWorkers {
+		oldSecurityGroups[worker.Name] = sets.New(additionalSecurityGroupsOf(worker)...)
+	}
+
+	var (
+		ids   sets.Set[string]
+		names map[string]int
+	)
+	for i, worker := range workers {
+		for j, securityGroup := range additionalSecurityGroupsOf(worker) {
+			if oldSecurityGroups[worker.Name].Has(securityGroup) {
+				continue
+			}
+
+			if ids == nil {
+				securityGroups, err := listSecurityGroups()
+				if err != nil {
+					return nil, err
+				}
+				ids, names = sets.New[string](), make(map[string]int, len(securityGroups))
+				for _, group := range securityGroups {
+					ids.Insert(group.ID)
+					names[group.Name]++
+				}
+			}
+
+			idxPath := fldPath.Index(i).Child("providerConfig", "additionalSecurityGroups").Index(j)
+			switch {
+			case ids.Has(securityGroup), names[securityGroup] == 1:
+			case names[securityGroup] > 1:
+				allErrs = append(allErrs, field.Invalid(idxPath, securityGroup, "name of the security group is not unique in the project, use its id instead"))
+			default:
+				allErrs = append(allErrs, field.NotFound(idxPath, securityGroup))
+			}
+		}
+	}
+
+	return allErrs, nil
+}
+
+// ValidateWorkersAgainstKeyPairs validates that the key pairs of the worker pools exist. The existence of a key pair is
+// only checked with the given function if a worker pool references a key pair it did not reference before, so that
+// running shoots won't break after a key pair was removed. An error is returned if the existence cannot be checked.
+func ValidateWorkersAgainstKeyPairs(oldWorkers, workers []core.Worker, keyPairExists func(name string) (bool, error), fldPath *field.Path) (field.ErrorList, error) {
+	allErrs := field.ErrorList{}
+
+	oldKeyNames := make(map[string]string, len(oldWorkers))
+	for _, worker := range oldWorkers {
+		oldKeyNames[worker.Name] = keyNameOf(worker)
+	}
+
+	checked := map[string]bool{}
+	for i, worker := range workers {
+		keyName := keyNameOf(worker)
+		if keyName == "" || oldKeyNames[worker.Name] == keyName {
+			continue
+		}
+
+		exists, ok := checked[keyName]
+		if !ok {
+			var err error
+			if exists, err = keyPairExists(keyName); err != nil {
+				return nil, err
+			}
+			checked[keyName] = exists
+		}
+		if !exists {
+			allErrs = append(allErrs, field.NotFound(fldPath.Index(i).Child("providerConfig", "keyName"), keyName))
+		}
+	}
+
+	return allErrs, nil
+}
+
+// ValidateWorkersAgainstInfrastructureConfig validates that the node subnets the worker pools join are defined in the
+// InfrastructureConfig.
+func ValidateWorkersAgainstInfrastructureConfig(workers []core.Worker, infraConfig *api.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	subnets := sets.New[string]()
+	for _, subnet := range infraConfig.Networks.Subnets {
+		subnets.Insert(subnet.Name)
+	}
+	for i, worker := range workers {
+		subnet := subnetOf(worker)
+		if subnet == "" || subnets.Has(subnet) {
+			continue
+		}
+		allErrs = append(allErrs, field.NotFound(fldPath.Index(i).Child("providerConfig", "subnet"), subnet))
+	}
+
+	return allErrs
+}
+
+// subnetOf returns the node subnet the given worker pool joins, or an empty string if it joins the subnet given by the
+// workers CIDR. Invalid provider configs are reported by ValidateWorkers.
+func subnetOf(worker core.Worker) string {
+	if worker.ProviderConfig == nil {
+		return ""
+	}
+	workerConfig, err := helper.WorkerConfigFromRawExtension(worker.ProviderConfig)
+	if err != nil {
+		return ""
+	}
+	return pointer.StringDeref(workerConfig.Subnet, "")
+}
+
+// keyNameOf returns the key pair of the given worker pool, or an empty string if it uses the key pair of the
+// infrastructure. Invalid provider configs are reported by ValidateWorkers.
+func keyNameOf(worker core.Worker) string {
+	if worker.ProviderConfig == nil {
+		return ""
+	}
+	workerConfig, err := helper.WorkerConfigFromRawExtension(worker.ProviderConfig)
+	if err != nil {
+		return ""
+	}
+	return pointer.StringDeref(workerConfig.KeyName, "")
+}
+
+// additionalSecurityGroupsOf returns the additional security groups of the given worker pool. Invalid provider configs
+// are reported by ValidateWorkers.
+func additionalSecurityGroupsOf(worker core.Worker) []string {
+	if worker.ProviderConfig == nil {
+		return nil
+	}
+	workerConfig, err := helper.WorkerConfigFromRawExtension(worker.ProviderConfig)
+	if err != nil {
+		return nil
+	}
+	return workerConfig.AdditionalSecurityGroups
+}
+
 // validateWorkerConfig validates the providerConfig section of a Worker resource.
 func validateWorkerConfig(worker *core.Worker, workerConfig *api.WorkerConfig, cloudProfileConfig *api.CloudProfileConfig, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -86,6 +309,44 @@ func validateWorkerConfig(worker *core.Worker, workerConfig *api.WorkerConfig, c
 	allErrs = append(allErrs, validateServerGroup(worker, workerConfig.ServerGroup, cloudProfileConfig, fldPath.Child("serverGroup"))...)
 	allErrs = append(allErrs, validateNodeTemplate(workerConfig.NodeTemplate, fldPath.Child("nodeTemplate"))...)
 	allErrs = append(allErrs, validateMachineLabels(worker, workerConfig, fldPath.Child("machineLabels"))...)
+	allErrs = append(allErrs, validateCanaryRollout(worker, workerConfig.Canary, fldPath.Child("canary"))...)
+	allErrs = append(allErrs, validateZoneRollout(workerConfig, fldPath.Child("zoneRollout"))...)
+	allErrs = append(allErrs, validateUpdateStrategy(workerConfig, fldPath.Child("updateStrategy"))...)
+	allErrs = append(allErrs, validateVGPU(workerConfig.VGPU, fldPath.Child("vgpu"))...)
+	allErrs = append(allErrs, validateMachineClassExtra(workerConfig.MachineClassExtra, fldPath.Child("machineClassExtra"))...)
+	allErrs = append(allErrs, validateHostPinning(worker, workerConfig.HostPinning, fldPath.Child("hostPinning"))...)
+	allErrs = append(allErrs, validateRootVolume(worker, workerConfig.RootVolume, fldPath.Child("rootVolume"))...)
+	allErrs = append(allErrs, validateDataVolumes(workerConfig.DataVolumes, fldPath.Child("dataVolumes"))...)
+	allErrs = append(allErrs, validateServerMetadata(worker, workerConfig, fldPath.Child("serverMetadata"))...)
+	allErrs = append(allErrs, validateWorkerNetworks(workerConfig.Networks, fldPath.Child("networks"))...)
+	allErrs = append(allErrs, validateAllowedAddressPairs(workerConfig.AllowedAddressPairs, fldPath.Child("allowedAddressPairs"))...)
+	allErrs = append(allErrs, validateAdditionalSecurityGroups(workerConfig.AdditionalSecurityGroups, fldPath.Child("additionalSecurityGroups"))...)
+	allErrs = append(allErrs, validateSchedulerHints(workerConfig.SchedulerHints, fldPath.Child("schedulerHints"))...)
+	if workerConfig.KeyName != nil && len(*workerConfig.KeyName) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("keyName"), "key name must not be empty"))
+	}
+	if !pointer.BoolDeref(workerConfig.PortSecurityEnabled, true) {
+		if len(workerConfig.AllowedAddressPairs) > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("allowedAddressPairs"), "allowed address pairs require the port security to be enabled"))
+		}
+		if len(workerConfig.AdditionalSecurityGroups) > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("additionalSecurityGroups"), "security groups require the port security to be enabled"))
+		}
+	}
+	if workerConfig.QoSPolicyID != nil && len(*workerConfig.QoSPolicyID) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("qosPolicyID"), "must provide the id of the QoS policy"))
+	}
+	if workerConfig.Subnet != nil && len(*workerConfig.Subnet) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("subnet"), "must provide the name of the subnet"))
+	}
+	if workerConfig.Subnet != nil && len(workerConfig.Networks) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("subnet"), "machines attached to additional networks cannot join a node subnet"))
+	}
+	if profile := workerConfig.TuningProfile; profile != nil && !supportedTuningProfiles.Has(string(*profile)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("tuningProfile"), *profile, sets.List(supportedTuningProfiles)))
+	}
+	allErrs = append(allErrs, validateUserDataFormat(worker, workerConfig.UserDataFormat, cloudProfileConfig, fldPath.Child("userDataFormat"))...)
+	allErrs = append(allErrs, validateBareMetal(workerConfig, fldPath)...)
 
 	return allErrs
 }
@@ -120,8 +381,9 @@ func validateServerGroup(worker *core.Worker, sg *api.ServerGroup, cloudProfileC
 		return allErrs
 	}
 
-	if len(worker.Zones) > 1 && sg.Policy == openstackclient.ServerGroupPolicyAffinity {
-		allErrs = append(allErrs, field.Forbidden(fldPath.Child("policy"), fmt.Sprintf("using %q policy with multiple availability zones is not allowed", openstackclient.ServerGroupPolicyAffinity)))
+	// the servers of different zones cannot share a host, unless each zone has its own server group
+	if len(worker.Zones) > 1 && sg.Policy == openstackclient.ServerGroupPolicyAffinity && !pointer.BoolDeref(sg.PerZone, false) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("policy"), fmt.Sprintf("using %q policy with multiple availability zones is only allowed with a server group per zone", openstackclient.ServerGroupPolicyAffinity)))
 	}
 
 	return allErrs
@@ -173,3 +435,425 @@ func validateMachineLabels(worker *core.Worker, workerConfig *api.WorkerConfig,
 
 	return allErrs
 }
+
+func validateCanaryRollout(worker *core.Worker, canary *api.CanaryRollout, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if canary == nil {
+		return allErrs
+	}
+
+	if canary.Zone != nil && !sets.New(worker.Zones...).Has(*canary.Zone) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("zone"), *canary.Zone, worker.Zones))
+	}
+	if canary.Machines != nil && *canary.Machines <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("machines"), *canary.Machines, "number of machines must be positive"))
+	}
+	if canary.SoakTime != nil && canary.SoakTime.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("soakTime"), canary.SoakTime.Duration.String(), "soak time must not be negative"))
+	}
+
+	return allErrs
+}
+
+func validateZoneRollout(workerConfig *api.WorkerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if workerConfig.ZoneRollout == nil {
+		return allErrs
+	}
+
+	if workerConfig.Canary != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "a zone rollout cannot be combined with a canary rollout"))
+	}
+	if gracePeriod := workerConfig.ZoneRollout.GracePeriod; gracePeriod != nil && gracePeriod.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("gracePeriod"), gracePeriod.Duration.String(), "grace period must not be negative"))
+	}
+
+	return allErrs
+}
+
+func validateUpdateStrategy(workerConfig *api.WorkerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if workerConfig.UpdateStrategy == nil {
+		return allErrs
+	}
+
+	switch strategy := *workerConfig.UpdateStrategy; strategy {
+	case api.UpdateStrategyRollingUpdate:
+	case api.UpdateStrategyRecreate:
+		if workerConfig.Canary != nil && workerConfig.Canary.Machines != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("the %q strategy cannot be combined with a canary rollout surging machines", strategy)))
+		}
+	case api.UpdateStrategyInPlaceUpdate:
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("the %q strategy is not supported by the deployed machine-controller-manager", strategy)))
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath, strategy, []string{string(api.UpdateStrategyRollingUpdate), string(api.UpdateStrategyRecreate)}))
+	}
+
+	return allErrs
+}
+
+func validateVGPU(vgpu *api.VGPU, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if vgpu == nil {
+		return allErrs
+	}
+
+	if vgpu.TimeSlicingReplicas != nil && *vgpu.TimeSlicingReplicas < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("timeSlicingReplicas"), *vgpu.TimeSlicingReplicas, "must be at least 1"))
+	}
+	if vgpu.DevicePluginConfig != nil {
+		configPath := fldPath.Child("devicePluginConfig")
+		if len(*vgpu.DevicePluginConfig) == 0 {
+			allErrs = append(allErrs, field.Invalid(configPath, *vgpu.DevicePluginConfig, "must not be empty if provided"))
+		}
+		for _, msg := range validation.IsValidLabelValue(*vgpu.DevicePluginConfig) {
+			allErrs = append(allErrs, field.Invalid(configPath, *vgpu.DevicePluginConfig, msg))
+		}
+	}
+
+	return allErrs
+}
+
+func validateMachineClassExtra(extra map[string]apiextensionsv1.JSON, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	reserved := sets.New(api.ReservedMachineClassExtraKeys...)
+	for _, key := range sets.List(sets.KeySet(extra)) {
+		switch {
+		case key == "":
+			allErrs = append(allErrs, field.Required(fldPath, "keys must not be empty"))
+		case reserved.Has(key):
+			allErrs = append(allErrs, field.Forbidden(fldPath.Key(key), "key is managed by the extension"))
+		case !json.Valid(extra[key].Raw):
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(key), string(extra[key].Raw), "value must be valid JSON"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateHostPinning(worker *core.Worker, hostPinning []api.HostPinning, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	zones := sets.New[string]()
+	for i, pinning := range hostPinning {
+		idxPath := fldPath.Index(i)
+
+		if !sets.New(worker.Zones...).Has(pinning.Zone) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("zone"), pinning.Zone, worker.Zones))
+		} else if zones.Has(pinning.Zone) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("zone"), pinning.Zone))
+		}
+		zones.Insert(pinning.Zone)
+
+		if pointer.StringDeref(pinning.Host, "") == "" && pointer.StringDeref(pinning.Node, "") == "" {
+			allErrs = append(allErrs, field.Required(idxPath, "either host or node must be set"))
+		}
+		if pinning.Host != nil && strings.Contains(*pinning.Host, ":") {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("host"), *pinning.Host, "host must not contain ':'"))
+		}
+		if pinning.Node != nil && strings.Contains(*pinning.Node, ":") {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("node"), *pinning.Node, "node must not contain ':'"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateRootVolume(worker *core.Worker, rootVolume *api.RootVolume, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if rootVolume == nil {
+		return allErrs
+	}
+
+	if rootVolume.Size == nil {
+		if worker.Volume == nil || worker.Volume.VolumeSize == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("size"), "size is required if the worker pool does not specify a volume size"))
+		}
+	} else if gibibyte := resource.MustParse("1Gi"); rootVolume.Size.Cmp(gibibyte) < 0 || rootVolume.Size.Value()%gibibyte.Value() != 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("size"), rootVolume.Size.String(), "must be a positive multiple of 1Gi"))
+	}
+
+	if rootVolume.Type != nil && *rootVolume.Type == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("type"), "type must not be empty"))
+	}
+
+	zones := sets.New(worker.Zones...)
+	for _, zone := range sets.List(sets.KeySet(rootVolume.ZoneTypes)) {
+		zonePath := fldPath.Child("zoneTypes").Key(zone)
+		switch {
+		case !zones.Has(zone):
+			allErrs = append(allErrs, field.NotSupported(zonePath, zone, worker.Zones))
+		case rootVolume.ZoneTypes[zone] == "":
+			allErrs = append(allErrs, field.Required(zonePath, "must provide the volume type of the zone"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateDataVolumes(dataVolumes []api.DataVolume, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	names := sets.New[string]()
+	for i, dataVolume := range dataVolumes {
+		idxPath := fldPath.Index(i)
+
+		if dataVolume.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide the name of the data volume"))
+		} else if names.Has(dataVolume.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), dataVolume.Name))
+		} else {
+			for _, msg := range validation.IsDNS1123Label(dataVolume.Name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), dataVolume.Name, msg))
+			}
+		}
+		names.Insert(dataVolume.Name)
+
+		if gibibyte := resource.MustParse("1Gi"); dataVolume.Size.Cmp(gibibyte) < 0 || dataVolume.Size.Value()%gibibyte.Value() != 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("size"), dataVolume.Size.String(), "must be a positive multiple of 1Gi"))
+		}
+
+		if dataVolume.Type != nil && *dataVolume.Type == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("type"), "type must not be empty"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateWorkerNetworks(networks []api.WorkerNetwork, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	ids, names := sets.New[string](), sets.New[string]()
+	for i, network := range networks {
+		idxPath := fldPath.Index(i)
+
+		switch {
+		case network.ID == nil && network.Name == nil:
+			allErrs = append(allErrs, field.Required(idxPath, "must provide either the id or the name of the network"))
+		case network.ID != nil && network.Name != nil:
+			allErrs = append(allErrs, field.Forbidden(idxPath, "must not provide both the id and the name of the network"))
+		case network.ID != nil:
+			if *network.ID == "" {
+				allErrs = append(allErrs, field.Required(idxPath.Child("id"), "id must not be empty"))
+			} else if ids.Has(*network.ID) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("id"), *network.ID))
+			}
+			ids.Insert(*network.ID)
+		default:
+			if *network.Name == "" {
+				allErrs = append(allErrs, field.Required(idxPath.Child("name"), "name must not be empty"))
+			} else if names.Has(*network.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), *network.Name))
+			}
+			names.Insert(*network.Name)
+		}
+	}
+
+	return allErrs
+}
+
+func validateAllowedAddressPairs(allowedAddressPairs []api.AllowedAddressPair, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	seen := sets.New[string]()
+	for i, pair := range allowedAddressPairs {
+		idxPath := fldPath.Index(i)
+
+		if pair.IPAddress == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("ipAddress"), "ip address must not be empty"))
+		} else if _, _, err := net.ParseCIDR(pair.IPAddress); err != nil && net.ParseIP(pair.IPAddress) == nil {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("ipAddress"), pair.IPAddress, "must be a valid IP address or CIDR"))
+		}
+		if pair.MACAddress != nil {
+			if _, err := net.ParseMAC(*pair.MACAddress); err != nil {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("macAddress"), *pair.MACAddress, "must be a valid MAC address"))
+			}
+		}
+
+		key := pair.IPAddress + "," + pointer.StringDeref(pair.MACAddress, "")
+		if seen.Has(key) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, key))
+		}
+		seen.Insert(key)
+	}
+
+	return allErrs
+}
+
+func validateAdditionalSecurityGroups(securityGroups []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	seen := sets.New[string]()
+	for i, securityGroup := range securityGroups {
+		switch {
+		case securityGroup == "":
+			allErrs = append(allErrs, field.Required(fldPath.Index(i), "security group must not be empty"))
+		case seen.Has(securityGroup):
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i), securityGroup))
+		}
+		seen.Insert(securityGroup)
+	}
+
+	return allErrs
+}
+
+// schedulerHintGroup is the scheduler hint placing a server into a server group, which is managed by the extension.
+const schedulerHintGroup = "group"
+
+func validateSchedulerHints(schedulerHints map[string][]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for _, key := range sets.List(sets.KeySet(schedulerHints)) {
+		keyPath := fldPath.Key(key)
+		switch {
+		case key == "":
+			allErrs = append(allErrs, field.Invalid(keyPath, key, "scheduler hint must not be empty"))
+		case key == schedulerHintGroup:
+			allErrs = append(allErrs, field.Forbidden(keyPath, "the server group of the worker pool must be configured with serverGroup"))
+		case len(schedulerHints[key]) == 0:
+			allErrs = append(allErrs, field.Required(keyPath, "must provide at least one value"))
+		}
+		for i, value := range schedulerHints[key] {
+			if value == "" {
+				allErrs = append(allErrs, field.Required(keyPath.Index(i), "value must not be empty"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// bareMetalForbiddenSchedulerHints are the scheduler hints which compare the compute hosts of servers. All Ironic nodes
+// are managed by the same compute service, hence these hints cannot be fulfilled for bare metal worker pools.
+var bareMetalForbiddenSchedulerHints = sets.New("same_host", "different_host")
+
+// validateBareMetal validates the bareMetal section of the WorkerConfig and the options of the WorkerConfig which do
+// not apply to Ironic nodes. The given path is the path of the WorkerConfig.
+func validateBareMetal(workerConfig *api.WorkerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	bareMetal := workerConfig.BareMetal
+	if bareMetal == nil {
+		return allErrs
+	}
+	bareMetalPath := fldPath.Child("bareMetal")
+
+	if pointer.BoolDeref(bareMetal.IgnoreAvailabilityZones, false) && len(workerConfig.HostPinning) > 0 {
+		allErrs = append(allErrs, field.Forbidden(bareMetalPath.Child("ignoreAvailabilityZones"), "availability zones cannot be ignored for worker pools pinned to hosts"))
+	}
+	for _, name := range sets.List(sets.KeySet(bareMetal.Capabilities)) {
+		namePath := bareMetalPath.Child("capabilities").Key(name)
+		value := bareMetal.Capabilities[name]
+		switch {
+		case name == "":
+			allErrs = append(allErrs, field.Invalid(namePath, name, "capability must not be empty"))
+		case strings.ContainsAny(name, ":,"):
+			allErrs = append(allErrs, field.Invalid(namePath, name, "capability must not contain ':' or ','"))
+		case value == "":
+			allErrs = append(allErrs, field.Required(namePath, "must provide the value of the capability"))
+		case strings.ContainsAny(value, ":,"):
+			allErrs = append(allErrs, field.Invalid(namePath, value, "value of the capability must not contain ':' or ','"))
+		}
+	}
+
+	if workerConfig.ServerGroup != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("serverGroup"), "server groups are not supported for bare metal worker pools, as Nova cannot apply their policies to Ironic nodes"))
+	}
+	if workerConfig.VGPU != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("vgpu"), "vGPUs are not supported for bare metal worker pools"))
+	}
+	for _, key := range sets.List(sets.KeySet(workerConfig.SchedulerHints)) {
+		if bareMetalForbiddenSchedulerHints.Has(key) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("schedulerHints").Key(key), "scheduler hints comparing the compute hosts of servers are not supported for bare metal worker pools"))
+		}
+	}
+
+	return allErrs
+}
+
+const (
+	// maxServerMetadataItems is the default quota of Nova for the number of metadata items of a server.
+	maxServerMetadataItems = 128
+	// maxServerMetadataValueLength is the maximum length of the values of the metadata of a server.
+	maxServerMetadataValueLength = 255
+	// reservedServerMetadataItems is the number of metadata items the extension adds to every server.
+	reservedServerMetadataItems = 2
+)
+
+// serverMetadataKeyRegex is the pattern of the keys of the metadata of a server accepted by Nova.
+var serverMetadataKeyRegex = regexp.MustCompile(`^[a-zA-Z0-9-_:. ]{1,255}$`)
+
+// supportedTuningProfiles are the tuning profiles which can be applied to the machines of a worker pool.
+var supportedTuningProfiles = sets.New(string(api.TuningProfileHighConnectionCount), string(api.TuningProfileHighThroughput))
+
+// supportedUserDataFormats are the formats the user data can be delivered to the machines of a worker pool in.
+var supportedUserDataFormats = sets.New(string(api.UserDataFormatCloudInit), string(api.UserDataFormatIgnition))
+
+func validateUserDataFormat(worker *core.Worker, format *api.UserDataFormat, cloudProfileConfig *api.CloudProfileConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if format == nil {
+		return allErrs
+	}
+	if !supportedUserDataFormats.Has(string(*format)) {
+		return append(allErrs, field.NotSupported(fldPath, *format, sets.List(supportedUserDataFormats)))
+	}
+
+	// the capabilities of machine images which are not contained in the cloud profile are checked by the worker controller
+	if *format == api.UserDataFormatIgnition && cloudProfileConfig != nil && worker.Machine.Image != nil {
+		if version := findMachineImageVersion(cloudProfileConfig, worker.Machine.Image.Name, worker.Machine.Image.Version); version != nil && !slices.Contains(version.Capabilities, api.MachineImageCapabilityIgnition) {
+			allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("machine image %q in version %q is not provisioned with Ignition", worker.Machine.Image.Name, version.Version)))
+		}
+	}
+
+	return allErrs
+}
+
+func findMachineImageVersion(cloudProfileConfig *api.CloudProfileConfig, name, version string) *api.MachineImageVersion {
+	for _, machineImage := range cloudProfileConfig.MachineImages {
+		if machineImage.Name != name {
+			continue
+		}
+		for _, machineImageVersion := range machineImage.Versions {
+			if machineImageVersion.Version == version {
+				return &machineImageVersion
+			}
+		}
+	}
+	return nil
+}
+
+func validateServerMetadata(worker *core.Worker, workerConfig *api.WorkerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if workerConfig.ServerMetadata == nil {
+		return allErrs
+	}
+
+	itemsPath := fldPath.Child("items")
+	for _, key := range sets.List(sets.KeySet(workerConfig.ServerMetadata.Items)) {
+		value := workerConfig.ServerMetadata.Items[key]
+		if !serverMetadataKeyRegex.MatchString(key) {
+			allErrs = append(allErrs, field.Invalid(itemsPath.Key(key), key, fmt.Sprintf("key must match the regex %s", serverMetadataKeyRegex)))
+		} else if key == "kubernetes.io-role-node" || strings.HasPrefix(key, "kubernetes.io-cluster-") {
+			allErrs = append(allErrs, field.Forbidden(itemsPath.Key(key), "key is reserved for the metadata maintained by the extension"))
+		}
+		if len(value) > maxServerMetadataValueLength {
+			allErrs = append(allErrs, field.TooLong(itemsPath.Key(key), value, maxServerMetadataValueLength))
+		}
+	}
+
+	// the metadata of the servers also contains the labels of the worker pool and the machine labels
+	if items := len(workerConfig.ServerMetadata.Items) + len(worker.Labels) + len(workerConfig.MachineLabels) + reservedServerMetadataItems; items > maxServerMetadataItems {
+		allErrs = append(allErrs, field.TooMany(itemsPath, items, maxServerMetadataItems))
+	}
+
+	return allErrs
+}
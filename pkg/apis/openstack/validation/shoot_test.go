@@ -6,13 +6,19 @@ package validation_test
 
 import (
 	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/groups"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -221,55 +227,1089 @@ var _ = Describe("Shoot validation", func() {
 						})),
 					))
 				})
+
+				It("should allow hard affinity policy with multiple availability zones if each zone has its own server group", func() {
+					workers[0].ProviderConfig = &runtime.RawExtension{
+						Object: &apiv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       "WorkerConfig",
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							},
+							ServerGroup: &apiv1alpha1.ServerGroup{
+								Policy:  openstackclient.ServerGroupPolicyAffinity,
+								PerZone: pointer.Bool(true),
+							},
+						},
+					}
+
+					errorList := ValidateWorkers(workers, cloudProfileConfig, nilPath)
+					Expect(errorList).To(BeEmpty())
+				})
+			})
+
+			Context("#ValidateMachineLabels", func() {
+				It("should pass if some machine labels are defined", func() {
+					workers[0].ProviderConfig = &runtime.RawExtension{
+						Object: &apiv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       "WorkerConfig",
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							},
+							MachineLabels: []apiv1alpha1.MachineLabel{
+								{Name: "m1", Value: "v1"},
+								{Name: "m2", Value: "v2"},
+							},
+						},
+					}
+
+					errorList := ValidateWorkers(workers, nil, nilPath)
+
+					Expect(errorList).To(BeEmpty())
+				})
+
+				It("should fail on duplicate labels", func() {
+					workers[0].Labels = map[string]string{"l1": "x", "l2": "x"}
+					workers[0].ProviderConfig = &runtime.RawExtension{
+						Object: &apiv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       "WorkerConfig",
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							},
+							MachineLabels: []apiv1alpha1.MachineLabel{
+								{Name: "m1", Value: "v1"},
+								{Name: "m1", Value: "v2"},
+								{Name: "l1", Value: "v2"},
+							},
+						},
+					}
+					errorList := ValidateWorkers(workers, nil, nilPath)
+					Expect(errorList).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeDuplicate),
+							"BadValue": Equal("m1"),
+							"Field":    Equal("[0].providerConfig.machineLabels[1].name"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("[0].providerConfig.machineLabels[2].name"),
+							"BadValue": Equal("l1"),
+							"Detail":   Equal("label name already defined as pool label"),
+						})),
+					))
+				})
+			})
+
+			Context("#ValidateCanaryRollout", func() {
+				It("should pass for a valid canary configuration", func() {
+					workers[0].ProviderConfig = &runtime.RawExtension{
+						Object: &apiv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       "WorkerConfig",
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							},
+							Canary: &apiv1alpha1.CanaryRollout{
+								Zone:     pointer.String("2"),
+								Machines: pointer.Int32(1),
+								SoakTime: &metav1.Duration{Duration: 10 * time.Minute},
+							},
+						},
+					}
+
+					errorList := ValidateWorkers(workers, nil, nilPath)
+
+					Expect(errorList).To(BeEmpty())
+				})
+
+				It("should fail for an invalid canary configuration", func() {
+					workers[0].ProviderConfig = &runtime.RawExtension{
+						Object: &apiv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       "WorkerConfig",
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							},
+							Canary: &apiv1alpha1.CanaryRollout{
+								Zone:     pointer.String("3"),
+								Machines: pointer.Int32(0),
+								SoakTime: &metav1.Duration{Duration: -time.Minute},
+							},
+						},
+					}
+
+					errorList := ValidateWorkers(workers, nil, nilPath)
+
+					Expect(errorList).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal("[0].providerConfig.canary.zone"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("[0].providerConfig.canary.machines"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("[0].providerConfig.canary.soakTime"),
+						})),
+					))
+				})
+			})
+
+			Context("#ValidateZoneRollout", func() {
+				It("should pass for a valid zone rollout configuration", func() {
+					workers[0].ProviderConfig = &runtime.RawExtension{
+						Object: &apiv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       "WorkerConfig",
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							},
+							ZoneRollout: &apiv1alpha1.ZoneRollout{
+								GracePeriod: &metav1.Duration{Duration: 10 * time.Minute},
+							},
+						},
+					}
+
+					errorList := ValidateWorkers(workers, nil, nilPath)
+
+					Expect(errorList).To(BeEmpty())
+				})
+
+				It("should fail for an invalid zone rollout configuration", func() {
+					workers[0].ProviderConfig = &runtime.RawExtension{
+						Object: &apiv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       "WorkerConfig",
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							},
+							Canary: &apiv1alpha1.CanaryRollout{},
+							ZoneRollout: &apiv1alpha1.ZoneRollout{
+								GracePeriod: &metav1.Duration{Duration: -time.Minute},
+							},
+						},
+					}
+
+					errorList := ValidateWorkers(workers, nil, nilPath)
+
+					Expect(errorList).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("[0].providerConfig.zoneRollout"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("[0].providerConfig.zoneRollout.gracePeriod"),
+						})),
+					))
+				})
+			})
+
+			Context("#ValidateUpdateStrategy", func() {
+				workerConfig := func(strategy apiv1alpha1.UpdateStrategyType, canary *apiv1alpha1.CanaryRollout) *runtime.RawExtension {
+					return &runtime.RawExtension{
+						Object: &apiv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       "WorkerConfig",
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							},
+							Canary:         canary,
+							UpdateStrategy: &strategy,
+						},
+					}
+				}
+
+				It("should pass for the supported strategies", func() {
+					workers[0].ProviderConfig = workerConfig(apiv1alpha1.UpdateStrategyRollingUpdate, &apiv1alpha1.CanaryRollout{Machines: pointer.Int32(1)})
+					workers[1].ProviderConfig = workerConfig(apiv1alpha1.UpdateStrategyRecreate, &apiv1alpha1.CanaryRollout{SoakTime: &metav1.Duration{Duration: time.Minute}})
+
+					errorList := ValidateWorkers(workers, nil, nilPath)
+
+					Expect(errorList).To(BeEmpty())
+				})
+
+				It("should fail for unknown or unsupported strategies", func() {
+					workers[0].ProviderConfig = workerConfig("Foo", nil)
+					workers[1].ProviderConfig = workerConfig(apiv1alpha1.UpdateStrategyInPlaceUpdate, nil)
+
+					errorList := ValidateWorkers(workers, nil, nilPath)
+
+					Expect(errorList).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal("[0].providerConfig.updateStrategy"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("[1].providerConfig.updateStrategy"),
+						})),
+					))
+				})
+
+				It("should forbid the Recreate strategy for canary rollouts surging machines", func() {
+					workers[0].ProviderConfig = workerConfig(apiv1alpha1.UpdateStrategyRecreate, &apiv1alpha1.CanaryRollout{Machines: pointer.Int32(1)})
+
+					errorList := ValidateWorkers(workers, nil, nilPath)
+
+					Expect(errorList).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("[0].providerConfig.updateStrategy"),
+						})),
+					))
+				})
+			})
+
+			Context("#ValidateVGPU", func() {
+				workerConfig := func(vgpu *apiv1alpha1.VGPU) *runtime.RawExtension {
+					return &runtime.RawExtension{
+						Object: &apiv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       "WorkerConfig",
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							},
+							VGPU: vgpu,
+						},
+					}
+				}
+
+				It("should pass for a valid vGPU configuration", func() {
+					workers[0].ProviderConfig = workerConfig(&apiv1alpha1.VGPU{})
+					workers[1].ProviderConfig = workerConfig(&apiv1alpha1.VGPU{
+						TimeSlicingReplicas: pointer.Int32(4),
+						DevicePluginConfig:  pointer.String("time-sliced-4"),
+						Taint:               pointer.Bool(false),
+					})
+
+					errorList := ValidateWorkers(workers, nil, nilPath)
+
+					Expect(errorList).To(BeEmpty())
+				})
+
+				It("should fail for invalid replicas and device plugin configs", func() {
+					workers[0].ProviderConfig = workerConfig(&apiv1alpha1.VGPU{
+						TimeSlicingReplicas: pointer.Int32(0),
+						DevicePluginConfig:  pointer.String("no/label/value"),
+					})
+
+					errorList := ValidateWorkers(workers, nil, nilPath)
+
+					Expect(errorList).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("[0].providerConfig.vgpu.timeSlicingReplicas"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("[0].providerConfig.vgpu.devicePluginConfig"),
+						})),
+					))
+				})
+			})
+
+			Context("#ValidateMachineClassExtra", func() {
+				workerConfig := func(extra map[string]apiextensionsv1.JSON) *runtime.RawExtension {
+					return &runtime.RawExtension{
+						Object: &apiv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       "WorkerConfig",
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							},
+							MachineClassExtra: extra,
+						},
+					}
+				}
+
+				It("should pass for extra values of keys not managed by the extension", func() {
+					workers[0].ProviderConfig = workerConfig(map[string]apiextensionsv1.JSON{
+						"bootFromVolume": {Raw: []byte(`true`)},
+						"serverMetadata": {Raw: []byte(`{"foo":"bar"}`)},
+					})
+
+					errorList := ValidateWorkers(workers, nil, nilPath)
+
+					Expect(errorList).To(BeEmpty())
+				})
+
+				It("should forbid keys managed by the extension", func() {
+					workers[0].ProviderConfig = workerConfig(map[string]apiextensionsv1.JSON{
+						"flavorName":     {Raw: []byte(`"large"`)},
+						"bootFromVolume": {Raw: []byte(`true`)},
+					})
+
+					errorList := ValidateWorkers(workers, nil, nilPath)
+
+					Expect(errorList).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("[0].providerConfig.machineClassExtra[flavorName]"),
+						})),
+					))
+				})
+			})
+
+			Context("#ValidateHostPinning", func() {
+				workerConfig := func(hostPinning ...apiv1alpha1.HostPinning) *runtime.RawExtension {
+					return &runtime.RawExtension{
+						Object: &apiv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       "WorkerConfig",
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							},
+							HostPinning: hostPinning,
+						},
+					}
+				}
+
+				It("should pass for hosts and nodes of zones of the worker pool", func() {
+					workers[0].ProviderConfig = workerConfig(
+						apiv1alpha1.HostPinning{Zone: "1", Host: pointer.String("compute-1")},
+						apiv1alpha1.HostPinning{Zone: "2", Host: pointer.String("compute-2"), Node: pointer.String("node-2")},
+					)
+
+					Expect(ValidateWorkers(workers, nil, nilPath)).To(BeEmpty())
+				})
+
+				It("should forbid unknown and duplicate zones, missing hosts and invalid names", func() {
+					workers[0].ProviderConfig = workerConfig(
+						apiv1alpha1.HostPinning{Zone: "3", Host: pointer.String("compute-1")},
+						apiv1alpha1.HostPinning{Zone: "1"},
+						apiv1alpha1.HostPinning{Zone: "1", Host: pointer.String("compute:1"), Node: pointer.String("node:1")},
+					)
+
+					errorList := ValidateWorkers(workers, nil, nilPath)
+
+					Expect(errorList).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal("[0].providerConfig.hostPinning[0].zone"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("[0].providerConfig.hostPinning[1]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("[0].providerConfig.hostPinning[2].zone"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("[0].providerConfig.hostPinning[2].host"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("[0].providerConfig.hostPinning[2].node"),
+						})),
+					))
+				})
+			})
+
+			Context("#ValidateRootVolume", func() {
+				workerConfig := func(rootVolume *apiv1alpha1.RootVolume) *runtime.RawExtension {
+					return &runtime.RawExtension{
+						Object: &apiv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       "WorkerConfig",
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							},
+							RootVolume: rootVolume,
+						},
+					}
+				}
+
+				It("should pass for root volumes with a size or defaulting the size to the volume of the worker pool", func() {
+					size := resource.MustParse("50Gi")
+					workers[0].ProviderConfig = workerConfig(&apiv1alpha1.RootVolume{Size: &size, Type: pointer.String("ssd"), DeleteOnTermination: pointer.Bool(false)})
+					workers[1].ProviderConfig = workerConfig(&apiv1alpha1.RootVolume{DeleteOnTermination: pointer.Bool(true)})
+
+					Expect(ValidateWorkers(workers, nil, nilPath)).To(BeEmpty())
+				})
+
+				It("should forbid missing and invalid sizes and empty types", func() {
+					size := resource.MustParse("1.5Gi")
+					workers[0].ProviderConfig = workerConfig(&apiv1alpha1.RootVolume{Size: &size, Type: pointer.String("")})
+					workers[1].Volume = nil
+					workers[1].ProviderConfig = workerConfig(&apiv1alpha1.RootVolume{})
+
+					errorList := ValidateWorkers(workers, nil, nilPath)
+
+					Expect(errorList).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("[0].providerConfig.rootVolume.size"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("[0].providerConfig.rootVolume.type"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("[1].providerConfig.rootVolume.size"),
+						})),
+					))
+				})
+
+				It("should forbid volume types of unknown zones and empty volume types", func() {
+					workers[0].ProviderConfig = workerConfig(&apiv1alpha1.RootVolume{ZoneTypes: map[string]string{workers[0].Zones[0]: "ssd", "unknown": "ssd"}})
+					workers[1].ProviderConfig = workerConfig(&apiv1alpha1.RootVolume{ZoneTypes: map[string]string{workers[1].Zones[0]: ""}})
+
+					errorList := ValidateWorkers(workers, nil, nilPath)
+
+					Expect(errorList).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal("[0].providerConfig.rootVolume.zoneTypes[unknown]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal(fmt.Sprintf("[1].providerConfig.rootVolume.zoneTypes[%s]", workers[1].Zones[0])),
+						})),
+					))
+				})
+			})
+
+			Context("#ValidateDataVolumes", func() {
+				workerConfig := func(dataVolumes ...apiv1alpha1.DataVolume) *runtime.RawExtension {
+					return &runtime.RawExtension{
+						Object: &apiv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       "WorkerConfig",
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							},
+							DataVolumes: dataVolumes,
+						},
+					}
+				}
+
+				It("should pass for valid data volumes", func() {
+					workers[0].ProviderConfig = workerConfig(
+						apiv1alpha1.DataVolume{Name: "data", Size: resource.MustParse("100Gi"), Type: pointer.String("ssd"), InheritAvailabilityZone: pointer.Bool(false)},
+						apiv1alpha1.DataVolume{Name: "logs", Size: resource.MustParse("10Gi"), DeleteOnTermination: pointer.Bool(false)},
+					)
+
+					Expect(ValidateWorkers(workers, nil, nilPath)).To(BeEmpty())
+				})
+
+				It("should forbid invalid names, sizes and types", func() {
+					workers[0].ProviderConfig = workerConfig(
+						apiv1alpha1.DataVolume{Name: "data", Size: resource.MustParse("1.5Gi")},
+						apiv1alpha1.DataVolume{Name: "data", Size: resource.MustParse("1Gi"), Type: pointer.String("")},
+						apiv1alpha1.DataVolume{Name: "Data_1", Size: resource.MustParse("0")},
+						apiv1alpha1.DataVolume{Size: resource.MustParse("1Gi")},
+					)
+
+					errorList := ValidateWorkers(workers, nil, nilPath)
+
+					Expect(errorList).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("[0].providerConfig.dataVolumes[0].size"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("[0].providerConfig.dataVolumes[1].name"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("[0].providerConfig.dataVolumes[1].type"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("[0].providerConfig.dataVolumes[2].name"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("[0].providerConfig.dataVolumes[2].size"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("[0].providerConfig.dataVolumes[3].name"),
+						})),
+					))
+				})
+			})
+
+			Context("#ValidateServerMetadata", func() {
+				workerConfig := func(items map[string]string) *runtime.RawExtension {
+					return &runtime.RawExtension{
+						Object: &apiv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       "WorkerConfig",
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							},
+							ServerMetadata: &apiv1alpha1.ServerMetadata{Items: items, TriggerRollingOnUpdate: true},
+						},
+					}
+				}
+
+				It("should pass for valid server metadata", func() {
+					workers[0].ProviderConfig = workerConfig(map[string]string{"cost-center": "1234", "backup:policy": "daily"})
+
+					Expect(ValidateWorkers(workers, nil, nilPath)).To(BeEmpty())
+				})
+
+				It("should forbid invalid and reserved keys and too long values", func() {
+					workers[0].ProviderConfig = workerConfig(map[string]string{
+						"foo/bar":                 "baz",
+						"kubernetes.io-role-node": "1",
+						"kubernetes.io-cluster-x": "1",
+						"description":             strings.Repeat("a", 256),
+					})
+
+					errorList := ValidateWorkers(workers, nil, nilPath)
+
+					Expect(errorList).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("[0].providerConfig.serverMetadata.items[foo/bar]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("[0].providerConfig.serverMetadata.items[kubernetes.io-role-node]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("[0].providerConfig.serverMetadata.items[kubernetes.io-cluster-x]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeTooLong),
+							"Field": Equal("[0].providerConfig.serverMetadata.items[description]"),
+						})),
+					))
+				})
+
+				It("should forbid more metadata items than allowed by Nova", func() {
+					items := map[string]string{}
+					for i := 0; i < 120; i++ {
+						items[fmt.Sprintf("key-%d", i)] = "value"
+					}
+					workers[0].Labels = map[string]string{}
+					for i := 0; i < 10; i++ {
+						workers[0].Labels[fmt.Sprintf("label-%d", i)] = "value"
+					}
+					workers[0].ProviderConfig = workerConfig(items)
+
+					Expect(ValidateWorkers(workers, nil, nilPath)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeTooMany),
+							"Field": Equal("[0].providerConfig.serverMetadata.items"),
+						})),
+					))
+				})
+			})
+
+			Context("#ValidateWorkerNetworks", func() {
+				workerConfig := func(networks ...apiv1alpha1.WorkerNetwork) *runtime.RawExtension {
+					return &runtime.RawExtension{
+						Object: &apiv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       "WorkerConfig",
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							},
+							Networks: networks,
+						},
+					}
+				}
+
+				It("should pass for networks given by id or name", func() {
+					workers[0].ProviderConfig = workerConfig(
+						apiv1alpha1.WorkerNetwork{ID: pointer.String("network-id")},
+						apiv1alpha1.WorkerNetwork{Name: pointer.String("storage")},
+					)
+
+					Expect(ValidateWorkers(workers, nil, nilPath)).To(BeEmpty())
+				})
+
+				It("should forbid missing, ambiguous, empty and duplicate networks", func() {
+					workers[0].ProviderConfig = workerConfig(
+						apiv1alpha1.WorkerNetwork{},
+						apiv1alpha1.WorkerNetwork{ID: pointer.String("network-id"), Name: pointer.String("storage")},
+						apiv1alpha1.WorkerNetwork{ID: pointer.String("network-id")},
+						apiv1alpha1.WorkerNetwork{ID: pointer.String("network-id")},
+						apiv1alpha1.WorkerNetwork{Name: pointer.String("")},
+					)
+
+					errorList := ValidateWorkers(workers, nil, nilPath)
+
+					Expect(errorList).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("[0].providerConfig.networks[0]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("[0].providerConfig.networks[1]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("[0].providerConfig.networks[3].id"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("[0].providerConfig.networks[4].name"),
+						})),
+					))
+				})
+
+				It("should forbid joining a node subnet", func() {
+					workers[0].ProviderConfig = workerConfig(apiv1alpha1.WorkerNetwork{Name: pointer.String("storage")})
+					workers[0].ProviderConfig.Object.(*apiv1alpha1.WorkerConfig).Subnet = pointer.String("zone-a")
+
+					Expect(ValidateWorkers(workers, nil, nilPath)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("[0].providerConfig.subnet"),
+						})),
+					))
+				})
+			})
+
+			Context("#ValidateAdditionalSecurityGroups", func() {
+				workerConfig := func(securityGroups ...string) *runtime.RawExtension {
+					return &runtime.RawExtension{
+						Object: &apiv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       "WorkerConfig",
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							},
+							AdditionalSecurityGroups: securityGroups,
+						},
+					}
+				}
+
+				It("should pass for security groups given by name or id", func() {
+					workers[0].ProviderConfig = workerConfig("monitoring", "5f0c3b4e-7a2d-4c1e-9b8f-3d6a1e2c4b7a")
+
+					Expect(ValidateWorkers(workers, nil, nilPath)).To(BeEmpty())
+				})
+
+				It("should forbid empty and duplicate security groups", func() {
+					workers[0].ProviderConfig = workerConfig("monitoring", "", "monitoring")
+
+					Expect(ValidateWorkers(workers, nil, nilPath)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("[0].providerConfig.additionalSecurityGroups[1]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("[0].providerConfig.additionalSecurityGroups[2]"),
+						})),
+					))
+				})
+			})
+
+			Context("#ValidateSchedulerHints", func() {
+				workerConfig := func(schedulerHints map[string][]string) *runtime.RawExtension {
+					return &runtime.RawExtension{
+						Object: &apiv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       "WorkerConfig",
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							},
+							SchedulerHints: schedulerHints,
+						},
+					}
+				}
+
+				It("should pass for valid scheduler hints", func() {
+					workers[0].ProviderConfig = workerConfig(map[string][]string{
+						"different_host": {"a0cf03a5-d921-4877-bb5c-86d26cf818e1", "8c19174f-4220-44f0-824a-cd1eeef10287"},
+						"aggregate":      {"nfv"},
+					})
+
+					Expect(ValidateWorkers(workers, nil, nilPath)).To(BeEmpty())
+				})
+
+				It("should forbid the group hint, hints without values and empty values", func() {
+					workers[0].ProviderConfig = workerConfig(map[string][]string{
+						"group":     {"a0cf03a5-d921-4877-bb5c-86d26cf818e1"},
+						"same_host": {},
+						"aggregate": {""},
+					})
+
+					Expect(ValidateWorkers(workers, nil, nilPath)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("[0].providerConfig.schedulerHints[group]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("[0].providerConfig.schedulerHints[same_host]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("[0].providerConfig.schedulerHints[aggregate][0]"),
+						})),
+					))
+				})
+			})
+
+			Context("#ValidateBareMetal", func() {
+				It("should pass for valid capabilities", func() {
+					workers[0].ProviderConfig = &runtime.RawExtension{
+						Object: &apiv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       "WorkerConfig",
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							},
+							SchedulerHints: map[string][]string{"aggregate": {"ironic"}},
+							BareMetal: &apiv1alpha1.BareMetal{
+								IgnoreAvailabilityZones: pointer.Bool(true),
+								Capabilities:            map[string]string{"boot_mode": "uefi"},
+							},
+						},
+					}
+
+					Expect(ValidateWorkers(workers, nil, nilPath)).To(BeEmpty())
+				})
+
+				It("should forbid invalid capabilities and ignoring the availability zones of pinned worker pools", func() {
+					workers[0].ProviderConfig = &runtime.RawExtension{
+						Object: &apiv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       "WorkerConfig",
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							},
+							HostPinning: []apiv1alpha1.HostPinning{{Zone: "1", Host: pointer.String("compute-1")}},
+							BareMetal: &apiv1alpha1.BareMetal{
+								IgnoreAvailabilityZones: pointer.Bool(true),
+								Capabilities:            map[string]string{"": "x", "boot_mode": "uefi", "raid_level": ""},
+							},
+						},
+					}
+
+					Expect(ValidateWorkers(workers, nil, nilPath)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("[0].providerConfig.bareMetal.ignoreAvailabilityZones"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("[0].providerConfig.bareMetal.capabilities[]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("[0].providerConfig.bareMetal.capabilities[raid_level]"),
+						})),
+					))
+				})
+
+				It("should forbid capabilities which cannot be matched against the capabilities of Ironic nodes", func() {
+					workers[0].ProviderConfig = &runtime.RawExtension{
+						Object: &apiv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       "WorkerConfig",
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							},
+							BareMetal: &apiv1alpha1.BareMetal{
+								Capabilities: map[string]string{"boot:mode": "uefi", "raid_level": "1,0"},
+							},
+						},
+					}
+
+					Expect(ValidateWorkers(workers, nil, nilPath)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("[0].providerConfig.bareMetal.capabilities[boot:mode]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("[0].providerConfig.bareMetal.capabilities[raid_level]"),
+							"BadValue": Equal("1,0"),
+						})),
+					))
+				})
+
+				It("should forbid options which do not apply to Ironic nodes", func() {
+					workers[0].ProviderConfig = &runtime.RawExtension{
+						Object: &apiv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       "WorkerConfig",
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							},
+							ServerGroup:    &apiv1alpha1.ServerGroup{Policy: "soft-anti-affinity"},
+							VGPU:           &apiv1alpha1.VGPU{},
+							SchedulerHints: map[string][]string{"aggregate": {"ironic"}, "different_host": {"server-id"}},
+							BareMetal:      &apiv1alpha1.BareMetal{},
+						},
+					}
+
+					Expect(ValidateWorkers(workers, nil, nilPath)).To(ContainElements(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("[0].providerConfig.serverGroup"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("[0].providerConfig.vgpu"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("[0].providerConfig.schedulerHints[different_host]"),
+						})),
+					))
+					Expect(ValidateWorkers(workers, nil, nilPath)).NotTo(ContainElement(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Field": Equal("[0].providerConfig.schedulerHints[aggregate]"),
+						})),
+					))
+				})
+
+				It("should allow these options for worker pools without bare metal configuration", func() {
+					workers[0].ProviderConfig = &runtime.RawExtension{
+						Object: &apiv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       "WorkerConfig",
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							},
+							VGPU:           &apiv1alpha1.VGPU{},
+							SchedulerHints: map[string][]string{"different_host": {"server-id"}},
+						},
+					}
+
+					Expect(ValidateWorkers(workers, nil, nilPath)).To(BeEmpty())
+				})
+			})
+
+			Context("#ValidateKeyName", func() {
+				It("should forbid an empty key name", func() {
+					workers[0].ProviderConfig = &runtime.RawExtension{
+						Object: &apiv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       "WorkerConfig",
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							},
+							KeyName: pointer.String(""),
+						},
+					}
+
+					Expect(ValidateWorkers(workers, nil, nilPath)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("[0].providerConfig.keyName"),
+						})),
+					))
+				})
 			})
 
-			Context("#ValidateMachineLabels", func() {
-				It("should pass if some machine labels are defined", func() {
-					workers[0].ProviderConfig = &runtime.RawExtension{
+			Context("#ValidateTuningProfile", func() {
+				workerConfig := func(profile string) *runtime.RawExtension {
+					return &runtime.RawExtension{
 						Object: &apiv1alpha1.WorkerConfig{
 							TypeMeta: metav1.TypeMeta{
 								Kind:       "WorkerConfig",
 								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
 							},
-							MachineLabels: []apiv1alpha1.MachineLabel{
-								{Name: "m1", Value: "v1"},
-								{Name: "m2", Value: "v2"},
+							TuningProfile: (*apiv1alpha1.TuningProfile)(&profile),
+						},
+					}
+				}
+
+				It("should allow the supported tuning profiles", func() {
+					workers[0].ProviderConfig = workerConfig("high-connection-count")
+
+					Expect(ValidateWorkers(workers, nil, nilPath)).To(BeEmpty())
+				})
+
+				It("should forbid unsupported tuning profiles", func() {
+					workers[0].ProviderConfig = workerConfig("low-latency")
+
+					Expect(ValidateWorkers(workers, nil, nilPath)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal("[0].providerConfig.tuningProfile"),
+						})),
+					))
+				})
+			})
+
+			Context("#ValidateUserDataFormat", func() {
+				var cloudProfileConfig *openstack.CloudProfileConfig
+
+				workerConfig := func(format string) *runtime.RawExtension {
+					return &runtime.RawExtension{
+						Object: &apiv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       "WorkerConfig",
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
 							},
+							UserDataFormat: (*apiv1alpha1.UserDataFormat)(&format),
 						},
 					}
+				}
 
-					errorList := ValidateWorkers(workers, nil, nilPath)
+				BeforeEach(func() {
+					cloudProfileConfig = &openstack.CloudProfileConfig{
+						MachineImages: []openstack.MachineImages{{
+							Name: "flatcar",
+							Versions: []openstack.MachineImageVersion{
+								{Version: "1.0.0", Capabilities: []openstack.MachineImageCapability{openstack.MachineImageCapabilityIgnition}},
+								{Version: "0.9.0"},
+							},
+						}},
+					}
+					workers = workers[:1]
+					workers[0].Machine.Image = &core.ShootMachineImage{Name: "flatcar", Version: "1.0.0"}
+				})
 
-					Expect(errorList).To(BeEmpty())
+				It("should allow Ignition for machine images provisioned with Ignition", func() {
+					workers[0].ProviderConfig = workerConfig("ignition")
+
+					Expect(ValidateWorkers(workers, cloudProfileConfig, nilPath)).To(BeEmpty())
 				})
 
-				It("should fail on duplicate labels", func() {
-					workers[0].Labels = map[string]string{"l1": "x", "l2": "x"}
-					workers[0].ProviderConfig = &runtime.RawExtension{
+				It("should allow cloud-init for all machine images", func() {
+					workers[0].ProviderConfig = workerConfig("cloud-init")
+					workers[0].Machine.Image.Version = "0.9.0"
+
+					Expect(ValidateWorkers(workers, cloudProfileConfig, nilPath)).To(BeEmpty())
+				})
+
+				It("should forbid Ignition for machine images not provisioned with Ignition", func() {
+					workers[0].ProviderConfig = workerConfig("ignition")
+					workers[0].Machine.Image.Version = "0.9.0"
+
+					Expect(ValidateWorkers(workers, cloudProfileConfig, nilPath)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("[0].providerConfig.userDataFormat"),
+						})),
+					))
+				})
+
+				It("should forbid unsupported formats", func() {
+					workers[0].ProviderConfig = workerConfig("butane")
+
+					Expect(ValidateWorkers(workers, cloudProfileConfig, nilPath)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal("[0].providerConfig.userDataFormat"),
+						})),
+					))
+				})
+			})
+
+			Context("#ValidatePortSecurityEnabled", func() {
+				workerConfig := func(portSecurityEnabled bool) *apiv1alpha1.WorkerConfig {
+					return &apiv1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							Kind:       "WorkerConfig",
+							APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+						},
+						PortSecurityEnabled:      pointer.Bool(portSecurityEnabled),
+						AllowedAddressPairs:      []apiv1alpha1.AllowedAddressPair{{IPAddress: "10.250.0.100"}},
+						AdditionalSecurityGroups: []string{"sg-1"},
+					}
+				}
+
+				It("should allow allowed address pairs and security groups with port security", func() {
+					workers[0].ProviderConfig = &runtime.RawExtension{Object: workerConfig(true)}
+
+					Expect(ValidateWorkers(workers, nil, nilPath)).To(BeEmpty())
+				})
+
+				It("should allow disabling the port security", func() {
+					config := workerConfig(false)
+					config.AllowedAddressPairs = nil
+					config.AdditionalSecurityGroups = nil
+					workers[0].ProviderConfig = &runtime.RawExtension{Object: config}
+
+					Expect(ValidateWorkers(workers, nil, nilPath)).To(BeEmpty())
+				})
+
+				It("should forbid allowed address pairs and security groups without port security", func() {
+					workers[0].ProviderConfig = &runtime.RawExtension{Object: workerConfig(false)}
+
+					Expect(ValidateWorkers(workers, nil, nilPath)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("[0].providerConfig.allowedAddressPairs"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("[0].providerConfig.additionalSecurityGroups"),
+						})),
+					))
+				})
+			})
+
+			Context("#ValidateQoSPolicyID", func() {
+				workerConfig := func(qosPolicyID string) *runtime.RawExtension {
+					return &runtime.RawExtension{
 						Object: &apiv1alpha1.WorkerConfig{
 							TypeMeta: metav1.TypeMeta{
 								Kind:       "WorkerConfig",
 								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
 							},
-							MachineLabels: []apiv1alpha1.MachineLabel{
-								{Name: "m1", Value: "v1"},
-								{Name: "m1", Value: "v2"},
-								{Name: "l1", Value: "v2"},
+							QoSPolicyID: pointer.String(qosPolicyID),
+						},
+					}
+				}
+
+				It("should allow a QoS policy", func() {
+					workers[0].ProviderConfig = workerConfig("qos-policy-1")
+
+					Expect(ValidateWorkers(workers, nil, nilPath)).To(BeEmpty())
+				})
+
+				It("should forbid an empty QoS policy", func() {
+					workers[0].ProviderConfig = workerConfig("")
+
+					Expect(ValidateWorkers(workers, nil, nilPath)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("[0].providerConfig.qosPolicyID"),
+						})),
+					))
+				})
+			})
+
+			Context("#ValidateAllowedAddressPairs", func() {
+				workerConfig := func(pairs ...apiv1alpha1.AllowedAddressPair) *runtime.RawExtension {
+					return &runtime.RawExtension{
+						Object: &apiv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       "WorkerConfig",
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
 							},
+							AllowedAddressPairs: pairs,
 						},
 					}
-					errorList := ValidateWorkers(workers, nil, nilPath)
-					Expect(errorList).To(ConsistOf(
+				}
+
+				It("should allow IP addresses and CIDRs with and without MAC addresses", func() {
+					workers[0].ProviderConfig = workerConfig(
+						apiv1alpha1.AllowedAddressPair{IPAddress: "10.250.0.100"},
+						apiv1alpha1.AllowedAddressPair{IPAddress: "10.250.128.0/24", MACAddress: pointer.String("fa:16:3e:00:00:01")},
+						apiv1alpha1.AllowedAddressPair{IPAddress: "2001:db8::/64"},
+					)
+
+					Expect(ValidateWorkers(workers, nil, nilPath)).To(BeEmpty())
+				})
+
+				It("should forbid invalid and duplicate allowed address pairs", func() {
+					workers[0].ProviderConfig = workerConfig(
+						apiv1alpha1.AllowedAddressPair{IPAddress: ""},
+						apiv1alpha1.AllowedAddressPair{IPAddress: "10.250.0.300"},
+						apiv1alpha1.AllowedAddressPair{IPAddress: "10.250.0.100", MACAddress: pointer.String("fa:16:3e")},
+						apiv1alpha1.AllowedAddressPair{IPAddress: "10.250.0.0/16"},
+						apiv1alpha1.AllowedAddressPair{IPAddress: "10.250.0.0/16"},
+					)
+
+					Expect(ValidateWorkers(workers, nil, nilPath)).To(ConsistOf(
 						PointTo(MatchFields(IgnoreExtras, Fields{
-							"Type":     Equal(field.ErrorTypeDuplicate),
-							"BadValue": Equal("m1"),
-							"Field":    Equal("[0].providerConfig.machineLabels[1].name"),
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("[0].providerConfig.allowedAddressPairs[0].ipAddress"),
 						})),
 						PointTo(MatchFields(IgnoreExtras, Fields{
-							"Type":     Equal(field.ErrorTypeInvalid),
-							"Field":    Equal("[0].providerConfig.machineLabels[2].name"),
-							"BadValue": Equal("l1"),
-							"Detail":   Equal("label name already defined as pool label"),
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("[0].providerConfig.allowedAddressPairs[1].ipAddress"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("[0].providerConfig.allowedAddressPairs[2].macAddress"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("[0].providerConfig.allowedAddressPairs[4]"),
 						})),
 					))
 				})
@@ -423,6 +1463,287 @@ var _ = Describe("Shoot validation", func() {
 		})
 
 	})
+
+	Describe("#ValidateWorkersAgainstStorageClasses", func() {
+		const region = "eu-1"
+
+		var (
+			fldPath            = field.NewPath("spec", "provider", "workers")
+			cloudProfile       *gardencorev1beta1.CloudProfile
+			cloudProfileConfig *openstack.CloudProfileConfig
+			workers            []core.Worker
+		)
+
+		BeforeEach(func() {
+			cloudProfile = &gardencorev1beta1.CloudProfile{
+				Spec: gardencorev1beta1.CloudProfileSpec{
+					Regions: []gardencorev1beta1.Region{
+						{Name: region, Zones: []gardencorev1beta1.AvailabilityZone{{Name: "eu-1a"}, {Name: "eu-1b"}}},
+						{Name: "eu-2", Zones: []gardencorev1beta1.AvailabilityZone{{Name: "eu-2a"}}},
+					},
+				},
+			}
+			cloudProfileConfig = &openstack.CloudProfileConfig{
+				StorageClasses: []openstack.StorageClassDefinition{
+					{Name: "default", Default: pointer.Bool(true)},
+					{Name: "eu-1b", Parameters: map[string]string{"availability": "eu-1b"}},
+					{Name: "eu-2a", Parameters: map[string]string{"availability": "eu-2a"}},
+					{Name: "nfs", Provisioner: pointer.String("nfs.manila.csi.openstack.org"), Parameters: map[string]string{"availability": "eu-1b"}},
+				},
+			}
+			workers = []core.Worker{
+				{Name: "worker1", Zones: []string{"eu-1a"}},
+				{Name: "worker2", Zones: []string{"eu-1a", "eu-1b"}},
+			}
+		})
+
+		It("should allow storage classes pinned to zones used by worker pools or to zones of other regions", func() {
+			Expect(ValidateWorkersAgainstStorageClasses(workers, cloudProfile, region, cloudProfileConfig, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid storage classes pinned to zones not used by any worker pool", func() {
+			workers = workers[:1]
+
+			Expect(ValidateWorkersAgainstStorageClasses(workers, cloudProfile, region, cloudProfileConfig, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("spec.provider.workers"),
+					"Detail": Equal(`storage class "eu-1b" pins its volumes to zone "eu-1b" which is not used by any worker pool`),
+				})),
+			))
+		})
+
+		It("should allow shoots without worker pools", func() {
+			Expect(ValidateWorkersAgainstStorageClasses(nil, cloudProfile, region, cloudProfileConfig, fldPath)).To(BeEmpty())
+		})
+
+		It("should ignore the pinned zones if the volume AZ is ignored", func() {
+			workers = workers[:1]
+			cloudProfileConfig.IgnoreVolumeAZ = pointer.Bool(true)
+
+			Expect(ValidateWorkersAgainstStorageClasses(workers, cloudProfile, region, cloudProfileConfig, fldPath)).To(BeEmpty())
+		})
+	})
+	Describe("#ValidateWorkersAgainstRegionFlavors", func() {
+		var (
+			fldPath            = field.NewPath("spec", "provider", "workers")
+			cloudProfileConfig *openstack.CloudProfileConfig
+		)
+
+		workerWithFlavor := func(name, flavor string) core.Worker {
+			return core.Worker{Name: name, Machine: core.Machine{Type: flavor}}
+		}
+
+		BeforeEach(func() {
+			cloudProfileConfig = &openstack.CloudProfileConfig{
+				Regions: []openstack.RegionConfig{{
+					Name:               "europe",
+					FlavorFamilies:     []string{"g_c"},
+					ReservedAggregates: []openstack.ReservedAggregate{{Name: "hana", FlavorFamilies: []string{"hana_"}}},
+				}},
+			}
+		})
+
+		It("should allow flavors of the allowed families and reserved aggregates", func() {
+			workers := []core.Worker{workerWithFlavor("worker1", "g_c4_m16"), workerWithFlavor("worker2", "hana_c96_m1536")}
+
+			Expect(ValidateWorkersAgainstRegionFlavors(nil, workers, "europe", cloudProfileConfig, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid flavors of other families", func() {
+			workers := []core.Worker{workerWithFlavor("worker1", "g_c4_m16"), workerWithFlavor("worker2", "m1.large")}
+
+			Expect(ValidateWorkersAgainstRegionFlavors(nil, workers, "europe", cloudProfileConfig, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("spec.provider.workers[1].machine.type"),
+				})),
+			))
+		})
+
+		It("should allow any flavor in regions without restrictions", func() {
+			workers := []core.Worker{workerWithFlavor("worker1", "m1.large")}
+
+			Expect(ValidateWorkersAgainstRegionFlavors(nil, workers, "asia", cloudProfileConfig, fldPath)).To(BeEmpty())
+			Expect(ValidateWorkersAgainstRegionFlavors(nil, workers, "europe", nil, fldPath)).To(BeEmpty())
+		})
+
+		It("should not validate worker pools whose flavor is unchanged", func() {
+			oldWorkers := []core.Worker{workerWithFlavor("worker1", "m1.large")}
+			workers := []core.Worker{workerWithFlavor("worker1", "m1.large")}
+
+			Expect(ValidateWorkersAgainstRegionFlavors(oldWorkers, workers, "europe", cloudProfileConfig, fldPath)).To(BeEmpty())
+		})
+	})
+
+	Describe("#ValidateWorkersAgainstInfrastructureConfig", func() {
+		var (
+			fldPath     = field.NewPath("spec", "provider", "workers")
+			infraConfig = &openstack.InfrastructureConfig{
+				Networks: openstack.Networks{
+					Workers: "10.250.0.0/18",
+					Subnets: []openstack.NodeSubnet{{Name: "zone-a", CIDR: "10.250.64.0/18"}},
+				},
+			}
+		)
+
+		workerWithSubnet := func(name, subnet string) core.Worker {
+			return core.Worker{
+				Name: name,
+				ProviderConfig: &runtime.RawExtension{
+					Raw: []byte(fmt.Sprintf(`{"apiVersion":"openstack.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","subnet":"%s"}`, subnet)),
+				},
+			}
+		}
+
+		It("should allow worker pools joining the subnet given by the workers CIDR or a node subnet", func() {
+			workers := []core.Worker{{Name: "worker1"}, workerWithSubnet("worker2", "zone-a")}
+
+			Expect(ValidateWorkersAgainstInfrastructureConfig(workers, infraConfig, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid unknown node subnets", func() {
+			workers := []core.Worker{workerWithSubnet("worker1", "zone-a"), workerWithSubnet("worker2", "zone-b")}
+
+			Expect(ValidateWorkersAgainstInfrastructureConfig(workers, infraConfig, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotFound),
+					"Field": Equal("spec.provider.workers[1].providerConfig.subnet"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateWorkersAgainstKeyPairs", func() {
+		var (
+			fldPath   = field.NewPath("spec", "provider", "workers")
+			lookups   []string
+			keyExists func(string) (bool, error)
+		)
+
+		workerWithKeyName := func(name, keyName string) core.Worker {
+			return core.Worker{
+				Name: name,
+				ProviderConfig: &runtime.RawExtension{
+					Raw: []byte(fmt.Sprintf(`{"apiVersion":"openstack.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","keyName":"%s"}`, keyName)),
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			lookups = nil
+			keyExists = func(name string) (bool, error) {
+				lookups = append(lookups, name)
+				return name == "operators", nil
+			}
+		})
+
+		It("should allow existing key pairs and look each of them up once", func() {
+			workers := []core.Worker{{Name: "worker1"}, workerWithKeyName("worker2", "operators"), workerWithKeyName("worker3", "operators")}
+
+			Expect(ValidateWorkersAgainstKeyPairs(nil, workers, keyExists, fldPath)).To(BeEmpty())
+			Expect(lookups).To(Equal([]string{"operators"}))
+		})
+
+		It("should forbid unknown key pairs", func() {
+			workers := []core.Worker{workerWithKeyName("worker1", "operators"), workerWithKeyName("worker2", "unknown")}
+
+			Expect(ValidateWorkersAgainstKeyPairs(nil, workers, keyExists, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotFound),
+					"Field": Equal("spec.provider.workers[1].providerConfig.keyName"),
+				})),
+			))
+		})
+
+		It("should not look up the key pairs if the worker pools reference no new key pairs", func() {
+			oldWorkers := []core.Worker{workerWithKeyName("worker1", "removed")}
+			workers := []core.Worker{workerWithKeyName("worker1", "removed"), {Name: "worker2"}}
+
+			Expect(ValidateWorkersAgainstKeyPairs(oldWorkers, workers, keyExists, fldPath)).To(BeEmpty())
+			Expect(lookups).To(BeEmpty())
+		})
+
+		It("should return an error if the key pairs cannot be looked up", func() {
+			workers := []core.Worker{workerWithKeyName("worker1", "operators")}
+
+			_, err := ValidateWorkersAgainstKeyPairs(nil, workers, func(string) (bool, error) {
+				return false, fmt.Errorf("fake")
+			}, fldPath)
+			Expect(err).To(MatchError("fake"))
+		})
+	})
+
+	Describe("#ValidateWorkersAgainstSecurityGroups", func() {
+		var (
+			fldPath   = field.NewPath("spec", "provider", "workers")
+			listCalls int
+			list      func() ([]groups.SecGroup, error)
+		)
+
+		workerWithSecurityGroups := func(name string, securityGroups ...string) core.Worker {
+			return core.Worker{
+				Name: name,
+				ProviderConfig: &runtime.RawExtension{
+					Raw: []byte(fmt.Sprintf(`{"apiVersion":"openstack.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","additionalSecurityGroups":["%s"]}`, strings.Join(securityGroups, `","`))),
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			listCalls = 0
+			list = func() ([]groups.SecGroup, error) {
+				listCalls++
+				return []groups.SecGroup{
+					{ID: "monitoring-id", Name: "monitoring"},
+					{ID: "shared-1", Name: "shared"},
+					{ID: "shared-2", Name: "shared"},
+				}, nil
+			}
+		})
+
+		It("should allow security groups given by id or unique name", func() {
+			workers := []core.Worker{workerWithSecurityGroups("worker1", "monitoring", "shared-2")}
+
+			Expect(ValidateWorkersAgainstSecurityGroups(nil, workers, list, fldPath)).To(BeEmpty())
+			Expect(listCalls).To(Equal(1))
+		})
+
+		It("should forbid unknown and ambiguous security groups", func() {
+			workers := []core.Worker{
+				{Name: "worker1"},
+				workerWithSecurityGroups("worker2", "unknown", "shared"),
+			}
+
+			Expect(ValidateWorkersAgainstSecurityGroups(nil, workers, list, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotFound),
+					"Field": Equal("spec.provider.workers[1].providerConfig.additionalSecurityGroups[0]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.provider.workers[1].providerConfig.additionalSecurityGroups[1]"),
+				})),
+			))
+		})
+
+		It("should not list the security groups if the worker pools reference no new security groups", func() {
+			oldWorkers := []core.Worker{workerWithSecurityGroups("worker1", "removed", "monitoring")}
+			workers := []core.Worker{workerWithSecurityGroups("worker1", "removed"), {Name: "worker2"}}
+
+			Expect(ValidateWorkersAgainstSecurityGroups(oldWorkers, workers, list, fldPath)).To(BeEmpty())
+			Expect(listCalls).To(BeZero())
+		})
+
+		It("should return an error if the security groups cannot be listed", func() {
+			workers := []core.Worker{workerWithSecurityGroups("worker1", "monitoring")}
+
+			_, err := ValidateWorkersAgainstSecurityGroups(nil, workers, func() ([]groups.SecGroup, error) {
+				return nil, fmt.Errorf("fake")
+			}, fldPath)
+			Expect(err).To(MatchError("fake"))
+		})
+	})
 })
 
 func copyWorkers(workers []core.Worker) []core.Worker {
@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+)
+
+// minRecommendedRootDiskSize is the minimal size of the local root disk of a flavor that is recommended for worker
+// nodes, which have to store the container images and logs on it.
+var minRecommendedRootDiskSize = resource.MustParse("20Gi")
+
+// WarningsForWorkers returns warnings for risky but valid configurations of the given workers. Only workers that are
+// new or whose zones, machine type or volume have changed compared to the given old workers are considered.
+func WarningsForWorkers(oldWorkers, workers []core.Worker, cloudProfile *gardencorev1beta1.CloudProfile, region string, fldPath *field.Path) []string {
+	var (
+		warnings         []string
+		oldWorkersByName = make(map[string]core.Worker, len(oldWorkers))
+	)
+	for _, worker := range oldWorkers {
+		oldWorkersByName[worker.Name] = worker
+	}
+
+	for i, worker := range workers {
+		if oldWorker, ok := oldWorkersByName[worker.Name]; ok &&
+			oldWorker.Machine.Type == worker.Machine.Type &&
+			equality.Semantic.DeepEqual(oldWorker.Zones, worker.Zones) &&
+			equality.Semantic.DeepEqual(oldWorker.Volume, worker.Volume) {
+			continue
+		}
+
+		idxPath := fldPath.Index(i)
+		if zones := regionZones(cloudProfile, region); len(worker.Zones) == 1 && len(zones) > 1 && worker.Maximum > 1 {
+			warnings = append(warnings, fmt.Sprintf("%s: all nodes of the worker pool are placed in the single zone %q although region %q offers %d zones, consider spreading the worker pool over multiple zones to tolerate zone outages",
+				idxPath.Child("zones"), worker.Zones[0], region, len(zones)))
+		}
+
+		if worker.Volume == nil {
+			if rootDiskSize := machineTypeRootDiskSize(cloudProfile, worker.Machine.Type); rootDiskSize != nil && rootDiskSize.Cmp(minRecommendedRootDiskSize) < 0 {
+				warnings = append(warnings, fmt.Sprintf("%s: machine type %q has a root disk of only %s, consider configuring a volume to boot the nodes from",
+					idxPath.Child("volume"), worker.Machine.Type, rootDiskSize.String()))
+			}
+		}
+	}
+
+	return warnings
+}
+
+// WarningsForInfrastructureConfig returns warnings for risky but valid configurations of the given InfrastructureConfig.
+// Only floating pools that are new compared to the given old InfrastructureConfig are considered, as the floating pool
+// of a shoot cannot be changed later on.
+func WarningsForInfrastructureConfig(oldInfra, infra *api.InfrastructureConfig, domain, region string, cloudProfileConfig *api.CloudProfileConfig, fldPath *field.Path) []string {
+	var warnings []string
+
+	if oldInfra == nil || oldInfra.FloatingPoolName != infra.FloatingPoolName {
+		poolPath := fldPath.Child("floatingPoolName")
+		if isDeprecatedFloatingPool(cloudProfileConfig.Constraints.FloatingPools, domain, region, infra.FloatingPoolName, poolPath) {
+			warnings = append(warnings, fmt.Sprintf("%s: floating pool %q is deprecated", poolPath, infra.FloatingPoolName))
+		}
+	}
+
+	oldAdditionalPoolNames := sets.New[string]()
+	if oldInfra != nil {
+		for _, pool := range oldInfra.AdditionalFloatingPools {
+			oldAdditionalPoolNames.Insert(pool.Name)
+		}
+	}
+	for i, pool := range infra.AdditionalFloatingPools {
+		if oldAdditionalPoolNames.Has(pool.Name) {
+			continue
+		}
+		poolPath := fldPath.Child("additionalFloatingPools").Index(i).Child("name")
+		if isDeprecatedFloatingPool(cloudProfileConfig.Constraints.FloatingPools, domain, region, pool.Name, poolPath) {
+			warnings = append(warnings, fmt.Sprintf("%s: floating pool %q is deprecated", poolPath, pool.Name))
+		}
+	}
+
+	return warnings
+}
+
+func isDeprecatedFloatingPool(floatingPools []api.FloatingPool, domain, region, name string, fldPath *field.Path) bool {
+	floatingPool, errs := FindFloatingPool(floatingPools, domain, region, name, fldPath)
+	return len(errs) == 0 && floatingPool != nil && floatingPool.Deprecated != nil && *floatingPool.Deprecated
+}
+
+func regionZones(cloudProfile *gardencorev1beta1.CloudProfile, region string) []gardencorev1beta1.AvailabilityZone {
+	for _, r := range cloudProfile.Spec.Regions {
+		if r.Name == region {
+			return r.Zones
+		}
+	}
+	return nil
+}
+
+func machineTypeRootDiskSize(cloudProfile *gardencorev1beta1.CloudProfile, machineType string) *resource.Quantity {
+	for _, mt := range cloudProfile.Spec.MachineTypes {
+		if mt.Name == machineType && mt.Storage != nil {
+			return mt.Storage.StorageSize
+		}
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	. "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/validation"
+)
+
+var _ = Describe("Warnings", func() {
+	var fldPath *field.Path
+
+	BeforeEach(func() {
+		fldPath = field.NewPath("spec")
+	})
+
+	Describe("#WarningsForWorkers", func() {
+		const region = "eu-1"
+
+		var (
+			cloudProfile *gardencorev1beta1.CloudProfile
+			workers      []core.Worker
+		)
+
+		BeforeEach(func() {
+			tinyRootDisk := resource.MustParse("10Gi")
+			largeRootDisk := resource.MustParse("64Gi")
+
+			cloudProfile = &gardencorev1beta1.CloudProfile{
+				Spec: gardencorev1beta1.CloudProfileSpec{
+					MachineTypes: []gardencorev1beta1.MachineType{
+						{Name: "tiny", Storage: &gardencorev1beta1.MachineTypeStorage{StorageSize: &tinyRootDisk}},
+						{Name: "large", Storage: &gardencorev1beta1.MachineTypeStorage{StorageSize: &largeRootDisk}},
+						{Name: "unknown"},
+					},
+					Regions: []gardencorev1beta1.Region{
+						{Name: region, Zones: []gardencorev1beta1.AvailabilityZone{{Name: "eu-1a"}, {Name: "eu-1b"}}},
+						{Name: "eu-2", Zones: []gardencorev1beta1.AvailabilityZone{{Name: "eu-2a"}}},
+					},
+				},
+			}
+
+			workers = []core.Worker{{
+				Name:    "worker",
+				Machine: core.Machine{Type: "large"},
+				Maximum: 3,
+				Zones:   []string{"eu-1a", "eu-1b"},
+			}}
+		})
+
+		It("should not return warnings for workers following the best practices", func() {
+			Expect(WarningsForWorkers(nil, workers, cloudProfile, region, fldPath)).To(BeEmpty())
+		})
+
+		It("should warn about workers in a single zone of a region with multiple zones", func() {
+			workers[0].Zones = []string{"eu-1a"}
+
+			Expect(WarningsForWorkers(nil, workers, cloudProfile, region, fldPath)).To(ConsistOf(
+				ContainSubstring(`spec[0].zones: all nodes of the worker pool are placed in the single zone "eu-1a"`),
+			))
+		})
+
+		It("should not warn about workers in a single zone of a region with a single zone or with a single node", func() {
+			workers[0].Zones = []string{"eu-2a"}
+			Expect(WarningsForWorkers(nil, workers, cloudProfile, "eu-2", fldPath)).To(BeEmpty())
+
+			workers[0].Zones = []string{"eu-1a"}
+			workers[0].Maximum = 1
+			Expect(WarningsForWorkers(nil, workers, cloudProfile, region, fldPath)).To(BeEmpty())
+		})
+
+		It("should warn about machine types with a tiny root disk unless a volume is configured", func() {
+			workers[0].Machine.Type = "tiny"
+
+			Expect(WarningsForWorkers(nil, workers, cloudProfile, region, fldPath)).To(ConsistOf(
+				ContainSubstring(`spec[0].volume: machine type "tiny" has a root disk of only 10Gi`),
+			))
+
+			workers[0].Volume = &core.Volume{VolumeSize: "50Gi"}
+			Expect(WarningsForWorkers(nil, workers, cloudProfile, region, fldPath)).To(BeEmpty())
+		})
+
+		It("should not warn about machine types with an unknown root disk size", func() {
+			workers[0].Machine.Type = "unknown"
+
+			Expect(WarningsForWorkers(nil, workers, cloudProfile, region, fldPath)).To(BeEmpty())
+		})
+
+		It("should only warn about new or changed workers", func() {
+			workers[0].Zones = []string{"eu-1a"}
+			workers[0].Machine.Type = "tiny"
+			oldWorkers := []core.Worker{*workers[0].DeepCopy()}
+
+			Expect(WarningsForWorkers(oldWorkers, workers, cloudProfile, region, fldPath)).To(BeEmpty())
+
+			workers = append(workers, *workers[0].DeepCopy())
+			workers[1].Name = "worker2"
+			Expect(WarningsForWorkers(oldWorkers, workers, cloudProfile, region, fldPath)).To(HaveLen(2))
+		})
+	})
+
+	Describe("#WarningsForInfrastructureConfig", func() {
+		const (
+			domain = "domain"
+			region = "eu-1"
+		)
+
+		var (
+			cloudProfileConfig *api.CloudProfileConfig
+			infraConfig        *api.InfrastructureConfig
+		)
+
+		BeforeEach(func() {
+			cloudProfileConfig = &api.CloudProfileConfig{
+				Constraints: api.Constraints{
+					FloatingPools: []api.FloatingPool{
+						{Name: "fp-new"},
+						{Name: "fp-old", Deprecated: pointer.Bool(true)},
+						{Name: "fp-dmz-*", Deprecated: pointer.Bool(true), NonConstraining: pointer.Bool(true)},
+					},
+				},
+			}
+			infraConfig = &api.InfrastructureConfig{FloatingPoolName: "fp-new"}
+		})
+
+		It("should not return warnings for floating pools which are not deprecated", func() {
+			Expect(WarningsForInfrastructureConfig(nil, infraConfig, domain, region, cloudProfileConfig, fldPath)).To(BeEmpty())
+		})
+
+		It("should warn about deprecated floating pools", func() {
+			infraConfig.FloatingPoolName = "fp-old"
+			infraConfig.AdditionalFloatingPools = []api.AdditionalFloatingPool{{Name: "fp-dmz-1", LoadBalancerClass: "dmz"}}
+
+			Expect(WarningsForInfrastructureConfig(nil, infraConfig, domain, region, cloudProfileConfig, fldPath)).To(ConsistOf(
+				`spec.floatingPoolName: floating pool "fp-old" is deprecated`,
+				`spec.additionalFloatingPools[0].name: floating pool "fp-dmz-1" is deprecated`,
+			))
+		})
+
+		It("should only warn about new floating pools", func() {
+			infraConfig.FloatingPoolName = "fp-old"
+			infraConfig.AdditionalFloatingPools = []api.AdditionalFloatingPool{{Name: "fp-dmz-1", LoadBalancerClass: "dmz"}}
+			oldInfraConfig := infraConfig.DeepCopy()
+			infraConfig.AdditionalFloatingPools = append(infraConfig.AdditionalFloatingPools, api.AdditionalFloatingPool{Name: "fp-dmz-2", LoadBalancerClass: "dmz2"})
+
+			Expect(WarningsForInfrastructureConfig(oldInfraConfig, infraConfig, domain, region, cloudProfileConfig, fldPath)).To(ConsistOf(
+				`spec.additionalFloatingPools[1].name: floating pool "fp-dmz-2" is deprecated`,
+			))
+		})
+
+		It("should not warn about unknown floating pools", func() {
+			infraConfig.FloatingPoolName = "unknown"
+
+			Expect(WarningsForInfrastructureConfig(nil, infraConfig, domain, region, cloudProfileConfig, fldPath)).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// AnnotationKeyAuditMode is the annotation of a shoot enabling the audit mode. In audit mode, the controllers only
+	// compare the desired with the actual state of the resources of the shoot and report the differences in a condition
+	// instead of changing them.
+	AnnotationKeyAuditMode = "openstack.provider.extensions.gardener.cloud/audit-mode"
+
+	// ConditionTypeAuditReport is the type of the condition of an extension resource reporting the differences between
+	// the desired and the actual state found by the last reconciliation in audit mode.
+	ConditionTypeAuditReport gardencorev1beta1.ConditionType = "AuditReport"
+
+	// ReasonNoDifferences is the reason of the condition if the actual state matches the desired state.
+	ReasonNoDifferences = "NoDifferences"
+	// ReasonDifferencesFound is the reason of the condition if the actual state differs from the desired state.
+	ReasonDifferencesFound = "DifferencesFound"
+	// ReasonAuditFailed is the reason of the condition if the states could not be compared.
+	ReasonAuditFailed = "AuditFailed"
+
+	// maxReportedDifferences is the maximum number of differences listed in the message of the condition.
+	maxReportedDifferences = 10
+)
+
+// IsEnabled returns true if the audit mode is enabled for the shoot of the given cluster.
+func IsEnabled(cluster *extensionscontroller.Cluster) bool {
+	return cluster != nil && cluster.Shoot != nil && strings.EqualFold(cluster.Shoot.Annotations[AnnotationKeyAuditMode], "true")
+}
+
+// ReportCondition sets the condition reporting the given differences between the desired and the actual state, or the
+// error which prevented comparing them, on the given extension resource.
+func ReportCondition(ctx context.Context, c client.Client, clock clock.Clock, obj extensionsv1alpha1.Object, differences []string, auditErr error) error {
+	status, reason, message := conditionFor(differences, auditErr)
+	condition := v1beta1helper.GetOrInitConditionWithClock(clock, obj.GetExtensionStatus().GetConditions(), ConditionTypeAuditReport)
+	condition = v1beta1helper.UpdatedConditionWithClock(clock, condition, status, reason, message)
+
+	patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+	obj.GetExtensionStatus().SetConditions(v1beta1helper.MergeConditions(obj.GetExtensionStatus().GetConditions(), condition))
+	return c.Status().Patch(ctx, obj, patch)
+}
+
+func conditionFor(differences []string, auditErr error) (gardencorev1beta1.ConditionStatus, string, string) {
+	if auditErr != nil {
+		return gardencorev1beta1.ConditionUnknown, ReasonAuditFailed, fmt.Sprintf("Desired and actual state could not be compared: %v", auditErr)
+	}
+	if len(differences) == 0 {
+		return gardencorev1beta1.ConditionTrue, ReasonNoDifferences, "Actual state matches the desired state, no changes are required."
+	}
+
+	reported := differences
+	if len(reported) > maxReportedDifferences {
+		reported = append(reported[:maxReportedDifferences:maxReportedDifferences], fmt.Sprintf("and %d more", len(differences)-maxReportedDifferences))
+	}
+	return gardencorev1beta1.ConditionFalse, ReasonDifferencesFound, fmt.Sprintf("%d differences between the desired and the actual state have not been reconciled: %s",
+		len(differences), strings.Join(reported, "; "))
+}
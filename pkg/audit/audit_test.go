@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package audit_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/gardener/gardener-extension-provider-openstack/pkg/audit"
+)
+
+var _ = Describe("Audit", func() {
+	Describe("#IsEnabled", func() {
+		It("should only be enabled if the shoot is annotated", func() {
+			Expect(IsEnabled(nil)).To(BeFalse())
+			Expect(IsEnabled(&extensionscontroller.Cluster{})).To(BeFalse())
+			Expect(IsEnabled(&extensionscontroller.Cluster{Shoot: &gardencorev1beta1.Shoot{}})).To(BeFalse())
+			Expect(IsEnabled(&extensionscontroller.Cluster{Shoot: &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{AnnotationKeyAuditMode: "false"}},
+			}})).To(BeFalse())
+			Expect(IsEnabled(&extensionscontroller.Cluster{Shoot: &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{AnnotationKeyAuditMode: "True"}},
+			}})).To(BeTrue())
+		})
+	})
+
+	Describe("#ReportCondition", func() {
+		var (
+			ctx   = context.TODO()
+			clock = testclock.NewFakeClock(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))
+
+			c     client.Client
+			infra *extensionsv1alpha1.Infrastructure
+		)
+
+		BeforeEach(func() {
+			infra = &extensionsv1alpha1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: "shoot--foo--bar"},
+				Status: extensionsv1alpha1.InfrastructureStatus{
+					DefaultStatus: extensionsv1alpha1.DefaultStatus{
+						Conditions: []gardencorev1beta1.Condition{{Type: "Other", Status: gardencorev1beta1.ConditionTrue}},
+					},
+				},
+			}
+			c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(infra).WithStatusSubresource(infra).Build()
+		})
+
+		getCondition := func() gardencorev1beta1.Condition {
+			stored := &extensionsv1alpha1.Infrastructure{}
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(infra), stored)).To(Succeed())
+			Expect(stored.Status.Conditions).To(HaveLen(2))
+			Expect(stored.Status.Conditions[0].Type).To(Equal(gardencorev1beta1.ConditionType("Other")))
+			return stored.Status.Conditions[1]
+		}
+
+		It("should report that there are no differences", func() {
+			Expect(ReportCondition(ctx, c, clock, infra, nil, nil)).To(Succeed())
+
+			condition := getCondition()
+			Expect(condition.Type).To(Equal(ConditionTypeAuditReport))
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(ReasonNoDifferences))
+		})
+
+		It("should report the differences and limit the listed differences", func() {
+			var differences []string
+			for i := 0; i < 12; i++ {
+				differences = append(differences, fmt.Sprintf("resource-%d is missing", i))
+			}
+			Expect(ReportCondition(ctx, c, clock, infra, differences, nil)).To(Succeed())
+
+			condition := getCondition()
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(ReasonDifferencesFound))
+			Expect(condition.Message).To(HavePrefix("12 differences between the desired and the actual state have not been reconciled: resource-0 is missing;"))
+			Expect(condition.Message).To(HaveSuffix("resource-9 is missing; and 2 more"))
+			Expect(differences).To(HaveLen(12))
+		})
+
+		It("should report a failed audit as unknown", func() {
+			Expect(ReportCondition(ctx, c, clock, infra, nil, errors.New("unauthorized"))).To(Succeed())
+
+			condition := getCondition()
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionUnknown))
+			Expect(condition.Reason).To(Equal(ReasonAuditFailed))
+			Expect(condition.Message).To(ContainSubstring("unauthorized"))
+		})
+	})
+})
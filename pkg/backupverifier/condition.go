@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupverifier
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ConditionTypeBackupIntegrityVerified is the type of the condition of a BackupBucket reporting the result of the
+	// last verification of its backups.
+	ConditionTypeBackupIntegrityVerified gardencorev1beta1.ConditionType = "BackupIntegrityVerified"
+
+	// ReasonBackupsIntact is the reason of the condition if all verified backups are intact.
+	ReasonBackupsIntact = "BackupsIntact"
+	// ReasonBackupsCorrupted is the reason of the condition if at least one verified backup is corrupted.
+	ReasonBackupsCorrupted = "BackupsCorrupted"
+	// ReasonVerificationFailed is the reason of the condition if the backups could not be verified.
+	ReasonVerificationFailed = "VerificationFailed"
+
+	// maxReportedFailures is the maximum number of failures listed in the message of the condition.
+	maxReportedFailures = 5
+)
+
+// ReportCondition sets the condition reporting the given result of the verification, or the error which prevented it,
+// on the BackupBucket with the given name.
+func ReportCondition(ctx context.Context, c client.Client, clock clock.Clock, name string, result *Result, verifyErr error) error {
+	backupBucket := &extensionsv1alpha1.BackupBucket{}
+	if err := c.Get(ctx, client.ObjectKey{Name: name}, backupBucket); err != nil {
+		return fmt.Errorf("could not get backup bucket %s: %w", name, err)
+	}
+
+	status, reason, message := conditionFor(result, verifyErr)
+	condition := v1beta1helper.GetOrInitConditionWithClock(clock, backupBucket.Status.Conditions, ConditionTypeBackupIntegrityVerified)
+	condition = v1beta1helper.UpdatedConditionWithClock(clock, condition, status, reason, message)
+
+	patch := client.MergeFrom(backupBucket.DeepCopy())
+	backupBucket.Status.Conditions = v1beta1helper.MergeConditions(backupBucket.Status.Conditions, condition)
+	return c.Status().Patch(ctx, backupBucket, patch)
+}
+
+func conditionFor(result *Result, verifyErr error) (gardencorev1beta1.ConditionStatus, string, string) {
+	if verifyErr != nil {
+		return gardencorev1beta1.ConditionUnknown, ReasonVerificationFailed, fmt.Sprintf("Backups could not be verified: %v", verifyErr)
+	}
+	if len(result.Failures) == 0 {
+		return gardencorev1beta1.ConditionTrue, ReasonBackupsIntact, fmt.Sprintf("All %d recent backup objects are intact.", result.Verified)
+	}
+
+	failures := result.Failures
+	if len(failures) > maxReportedFailures {
+		failures = append(failures[:maxReportedFailures:maxReportedFailures], fmt.Sprintf("and %d more", len(result.Failures)-maxReportedFailures))
+	}
+	return gardencorev1beta1.ConditionFalse, ReasonBackupsCorrupted, fmt.Sprintf("%d of %d recent backup objects are corrupted: %s",
+		len(result.Failures), result.Verified, strings.Join(failures, "; "))
+}
@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupverifier_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/gardener/gardener-extension-provider-openstack/pkg/backupverifier"
+)
+
+var _ = Describe("ReportCondition", func() {
+	var (
+		ctx   = context.TODO()
+		clock = testclock.NewFakeClock(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))
+
+		c            client.Client
+		backupBucket *extensionsv1alpha1.BackupBucket
+	)
+
+	BeforeEach(func() {
+		backupBucket = &extensionsv1alpha1.BackupBucket{
+			ObjectMeta: metav1.ObjectMeta{Name: "bucket"},
+			Status: extensionsv1alpha1.BackupBucketStatus{
+				DefaultStatus: extensionsv1alpha1.DefaultStatus{
+					Conditions: []gardencorev1beta1.Condition{{Type: "Other", Status: gardencorev1beta1.ConditionTrue}},
+				},
+			},
+		}
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(backupBucket).WithStatusSubresource(backupBucket).Build()
+	})
+
+	getCondition := func() gardencorev1beta1.Condition {
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(backupBucket), backupBucket)).To(Succeed())
+		Expect(backupBucket.Status.Conditions).To(HaveLen(2))
+		Expect(backupBucket.Status.Conditions[0].Type).To(Equal(gardencorev1beta1.ConditionType("Other")))
+		return backupBucket.Status.Conditions[1]
+	}
+
+	It("should report intact backups", func() {
+		Expect(ReportCondition(ctx, c, clock, "bucket", &Result{Verified: 3}, nil)).To(Succeed())
+
+		condition := getCondition()
+		Expect(condition.Type).To(Equal(ConditionTypeBackupIntegrityVerified))
+		Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(ReasonBackupsIntact))
+		Expect(condition.Message).To(Equal("All 3 recent backup objects are intact."))
+	})
+
+	It("should report corrupted backups and limit the listed failures", func() {
+		result := &Result{Verified: 10}
+		for i := 0; i < 7; i++ {
+			result.Failures = append(result.Failures, fmt.Sprintf("object-%d: corrupted", i))
+		}
+		Expect(ReportCondition(ctx, c, clock, "bucket", result, nil)).To(Succeed())
+
+		condition := getCondition()
+		Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(ReasonBackupsCorrupted))
+		Expect(condition.Message).To(HavePrefix("7 of 10 recent backup objects are corrupted: object-0: corrupted;"))
+		Expect(condition.Message).To(HaveSuffix("object-4: corrupted; and 2 more"))
+	})
+
+	It("should report a failed verification as unknown", func() {
+		Expect(ReportCondition(ctx, c, clock, "bucket", nil, errors.New("unauthorized"))).To(Succeed())
+
+		condition := getCondition()
+		Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionUnknown))
+		Expect(condition.Reason).To(Equal(ReasonVerificationFailed))
+		Expect(condition.Message).To(ContainSubstring("unauthorized"))
+	})
+})
@@ -0,0 +1,214 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupverifier
+
+import (
+	"context"
+	"crypto/md5" // #nosec G501 -- Swift uses MD5 for the checksums of objects.
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
+
+	openstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
+)
+
+// Result is the result of the verification of the backups in a Swift container.
+type Result struct {
+	// Verified is the number of verified objects.
+	Verified int
+	// Failures describe the objects which failed the verification.
+	Failures []string
+}
+
+// Verify verifies the objects in the given container which have been modified after <since>. The content of plain
+// objects must match the checksum and size Swift recorded for them. Dynamic large objects, which are uploaded by the
+// etcd backup-restore sidecar for large snapshots, must consist of a gapless sequence of segments matching the checksum
+// of the manifest. Static large objects must be downloadable completely, as Swift verifies their segments on download.
+func Verify(ctx context.Context, log logr.Logger, storage openstackclient.Storage, container string, since time.Time) (*Result, error) {
+	list, err := storage.ListObjects(ctx, container, "")
+	if err != nil {
+		return nil, fmt.Errorf("could not list objects of container %s: %w", container, err)
+	}
+
+	result := &Result{}
+	for _, object := range list {
+		if !object.LastModified.After(since) {
+			continue
+		}
+
+		header, err := storage.GetObjectHeader(ctx, container, object.Name)
+		if err != nil {
+			return nil, fmt.Errorf("could not get header of object %s: %w", object.Name, err)
+		}
+		if header == nil {
+			// the object has been deleted in the meantime, e.g. by the garbage collection of the backups
+			continue
+		}
+
+		var failure string
+		switch {
+		case header.ObjectManifest != "":
+			failure, err = verifyDynamicLargeObject(ctx, storage, object, header)
+		case header.StaticLargeObject:
+			failure, err = verifyContent(ctx, storage, container, object.Name, header.ContentLength, "")
+		default:
+			failure, err = verifyContent(ctx, storage, container, object.Name, object.Bytes, object.Hash)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		result.Verified++
+		if failure != "" {
+			log.Info("Backup object failed verification", "object", object.Name, "failure", failure)
+			result.Failures = append(result.Failures, fmt.Sprintf("%s: %s", object.Name, failure))
+		}
+	}
+	return result, nil
+}
+
+// verifyDynamicLargeObject verifies that the segments of a dynamic large object form a gapless sequence of segments of
+// the same size, except for the last one, and that their checksums match the checksum of the manifest, which is the MD5
+// sum of the concatenated checksums of the segments. As Swift computes the checksum and size of the manifest from the
+// listing of the segments, the content of every segment is downloaded and compared with its checksum and size as well.
+func verifyDynamicLargeObject(ctx context.Context, storage openstackclient.Storage, object objects.Object, header *objects.GetHeader) (string, error) {
+	segmentContainer, prefix, ok := strings.Cut(header.ObjectManifest, "/")
+	if !ok {
+		return fmt.Sprintf("invalid manifest %q", header.ObjectManifest), nil
+	}
+	segments, err := storage.ListObjects(ctx, segmentContainer, prefix)
+	if err != nil {
+		return "", fmt.Errorf("could not list segments of object %s: %w", object.Name, err)
+	}
+	if len(segments) == 0 {
+		return "no segments found", nil
+	}
+
+	// Swift concatenates all objects with the prefix of the manifest in the order of their names
+	sort.Slice(segments, func(i, j int) bool { return segments[i].Name < segments[j].Name })
+	hash := md5.New() // #nosec G401 -- Swift uses MD5 for the checksums of objects.
+	var size int64
+	for _, segment := range segments {
+		hash.Write([]byte(segment.Hash))
+		size += segment.Bytes
+	}
+	if missing := missingSegment(segments, prefix); missing != "" {
+		return fmt.Sprintf("segment %s is missing", missing), nil
+	}
+
+	if size != header.ContentLength {
+		return fmt.Sprintf("segments have %d bytes, but the manifest %d bytes", size, header.ContentLength), nil
+	}
+	if checksum := hex.EncodeToString(hash.Sum(nil)); checksum != strings.Trim(header.ETag, `"`) {
+		return fmt.Sprintf("checksum of segments %s does not match checksum of manifest %s", checksum, strings.Trim(header.ETag, `"`)), nil
+	}
+
+	// the manifest itself is listed as well if it is stored in the segment container
+	var chunks []objects.Object
+	for _, segment := range segments {
+		if segmentSuffix(segment.Name, prefix) != "" {
+			chunks = append(chunks, segment)
+		}
+	}
+	if failure := chunkSizeFailure(chunks); failure != "" {
+		return failure, nil
+	}
+	for _, chunk := range chunks {
+		failure, err := verifyContent(ctx, storage, segmentContainer, chunk.Name, chunk.Bytes, chunk.Hash)
+		if err != nil {
+			return "", err
+		}
+		if failure != "" {
+			return fmt.Sprintf("segment %s: %s", chunk.Name, failure), nil
+		}
+	}
+	return "", nil
+}
+
+// chunkSizeFailure returns why the sizes of the given segments of a dynamic large object are inconsistent, or an empty
+// string if they are consistent. The etcd backup-restore sidecar splits snapshots into chunks of the same size, hence
+// all segments but the last one must have the size of the largest segment, and none must be empty.
+func chunkSizeFailure(chunks []objects.Object) string {
+	if len(chunks) == 0 {
+		return "no segments found"
+	}
+	var chunkSize int64
+	for _, chunk := range chunks {
+		if chunk.Bytes > chunkSize {
+			chunkSize = chunk.Bytes
+		}
+	}
+	for i, chunk := range chunks {
+		if chunk.Bytes == 0 {
+			return fmt.Sprintf("segment %s is empty", chunk.Name)
+		}
+		if chunk.Bytes < chunkSize && i < len(chunks)-1 {
+			return fmt.Sprintf("segment %s has %d bytes, but is not the last of %d segments of up to %d bytes", chunk.Name, chunk.Bytes, len(chunks), chunkSize)
+		}
+	}
+	return ""
+}
+
+// segmentSuffix returns the part of the name of a segment after the prefix of the manifest, which is empty for the
+// manifest itself.
+func segmentSuffix(name, prefix string) string {
+	return strings.TrimPrefix(strings.TrimPrefix(name, prefix), "/")
+}
+
+// missingSegment returns the name of the first missing segment if the segments are numbered like the ones uploaded by
+// the etcd backup-restore sidecar, i.e. with increasing numbers starting at 1 after the prefix of the manifest. Segments
+// which are not numbered cannot be checked for gaps.
+func missingSegment(segments []objects.Object, prefix string) string {
+	next := 1
+	for _, segment := range segments {
+		suffix := segmentSuffix(segment.Name, prefix)
+		if suffix == "" {
+			// the manifest itself, if it is stored in the segment container
+			continue
+		}
+		number, err := strconv.Atoi(suffix)
+		if err != nil {
+			return ""
+		}
+		if number != next {
+			return fmt.Sprintf("%s/%0*d", prefix, len(suffix), next)
+		}
+		next++
+	}
+	return ""
+}
+
+// verifyContent downloads the given object and compares its size and, if given, its checksum.
+func verifyContent(ctx context.Context, storage openstackclient.Storage, container, name string, expectedSize int64, expectedChecksum string) (string, error) {
+	body, err := storage.DownloadObject(ctx, container, name)
+	if err != nil {
+		if openstackclient.IsNotFoundError(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("could not download object %s: %w", name, err)
+	}
+	defer body.Close()
+
+	hash := md5.New() // #nosec G401 -- Swift uses MD5 for the checksums of objects.
+	size, err := io.Copy(hash, body)
+	if err != nil {
+		return fmt.Sprintf("download failed after %d bytes: %v", size, err), nil
+	}
+
+	if size != expectedSize {
+		return fmt.Sprintf("downloaded %d bytes, but expected %d bytes", size, expectedSize), nil
+	}
+	if checksum := hex.EncodeToString(hash.Sum(nil)); expectedChecksum != "" && checksum != expectedChecksum {
+		return fmt.Sprintf("checksum %s does not match expected checksum %s", checksum, expectedChecksum), nil
+	}
+	return "", nil
+}
@@ -0,0 +1,231 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupverifier_test
+
+import (
+	"context"
+	"crypto/md5" // #nosec G501 -- Swift uses MD5 for the checksums of objects.
+	"encoding/hex"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	. "github.com/gardener/gardener-extension-provider-openstack/pkg/backupverifier"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client/mocks"
+)
+
+var _ = Describe("Verify", func() {
+	const container = "backup-bucket"
+
+	var (
+		ctx = context.TODO()
+		now = time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+
+		ctrl    *gomock.Controller
+		storage *mocks.MockStorage
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		storage = mocks.NewMockStorage(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	checksum := func(content string) string {
+		sum := md5.Sum([]byte(content)) // #nosec G401 -- Swift uses MD5 for the checksums of objects.
+		return hex.EncodeToString(sum[:])
+	}
+
+	object := func(name, content string) objects.Object {
+		return objects.Object{Name: name, Bytes: int64(len(content)), Hash: checksum(content), LastModified: now}
+	}
+
+	expectDownload := func(name, content string) {
+		storage.EXPECT().DownloadObject(ctx, container, name).Return(io.NopCloser(strings.NewReader(content)), nil)
+	}
+
+	It("should only verify objects modified after the given time", func() {
+		old := object("v2/Full-old", "old")
+		old.LastModified = now.Add(-48 * time.Hour)
+		storage.EXPECT().ListObjects(ctx, container, "").Return([]objects.Object{old, object("v2/Full-new", "new")}, nil)
+		storage.EXPECT().GetObjectHeader(ctx, container, "v2/Full-new").Return(&objects.GetHeader{}, nil)
+		expectDownload("v2/Full-new", "new")
+
+		result, err := Verify(ctx, logr.Discard(), storage, container, now.Add(-24*time.Hour))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(&Result{Verified: 1}))
+	})
+
+	It("should report plain objects whose content does not match their checksum or size", func() {
+		storage.EXPECT().ListObjects(ctx, container, "").Return([]objects.Object{object("v2/Full-1", "snapshot"), object("v2/Incr-2", "delta")}, nil)
+		storage.EXPECT().GetObjectHeader(ctx, container, "v2/Full-1").Return(&objects.GetHeader{}, nil)
+		storage.EXPECT().GetObjectHeader(ctx, container, "v2/Incr-2").Return(&objects.GetHeader{}, nil)
+		expectDownload("v2/Full-1", "snapshoT")
+		expectDownload("v2/Incr-2", "delt")
+
+		result, err := Verify(ctx, logr.Discard(), storage, container, now.Add(-time.Hour))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Verified).To(Equal(2))
+		Expect(result.Failures).To(ConsistOf(
+			ContainSubstring("v2/Full-1: checksum"),
+			ContainSubstring("v2/Incr-2: downloaded 4 bytes, but expected 5 bytes"),
+		))
+	})
+
+	It("should skip objects deleted in the meantime", func() {
+		storage.EXPECT().ListObjects(ctx, container, "").Return([]objects.Object{object("v2/Full-1", "snapshot")}, nil)
+		storage.EXPECT().GetObjectHeader(ctx, container, "v2/Full-1").Return(nil, nil)
+
+		result, err := Verify(ctx, logr.Discard(), storage, container, now.Add(-time.Hour))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(&Result{}))
+	})
+
+	It("should return an error if the objects cannot be listed", func() {
+		storage.EXPECT().ListObjects(ctx, container, "").Return(nil, gophercloud.ErrDefault500{})
+
+		_, err := Verify(ctx, logr.Discard(), storage, container, now.Add(-time.Hour))
+		Expect(err).To(HaveOccurred())
+	})
+
+	Context("dynamic large objects", func() {
+		var (
+			manifest objects.Object
+			segments []objects.Object
+		)
+
+		BeforeEach(func() {
+			manifest = object("v2/Full-1", "")
+			segments = []objects.Object{
+				object("v2/Full-1", ""),
+				object("v2/Full-1/0000000001", "snap"),
+				object("v2/Full-1/0000000002", "shot"),
+			}
+			segments[1].LastModified = now.Add(-48 * time.Hour)
+			segments[2].LastModified = now.Add(-48 * time.Hour)
+		})
+
+		manifestHeader := func(segments []objects.Object) *objects.GetHeader {
+			var hashes string
+			var size int64
+			for _, segment := range segments {
+				hashes += segment.Hash
+				size += segment.Bytes
+			}
+			return &objects.GetHeader{ObjectManifest: container + "/v2/Full-1", ContentLength: size, ETag: `"` + checksum(hashes) + `"`}
+		}
+
+		It("should accept a manifest whose segments match its checksum", func() {
+			storage.EXPECT().ListObjects(ctx, container, "").Return([]objects.Object{manifest}, nil)
+			storage.EXPECT().GetObjectHeader(ctx, container, "v2/Full-1").Return(manifestHeader(segments), nil)
+			storage.EXPECT().ListObjects(ctx, container, "v2/Full-1").Return([]objects.Object{segments[2], segments[0], segments[1]}, nil)
+			expectDownload("v2/Full-1/0000000001", "snap")
+			expectDownload("v2/Full-1/0000000002", "shot")
+
+			result, err := Verify(ctx, logr.Discard(), storage, container, now.Add(-time.Hour))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(&Result{Verified: 1}))
+		})
+
+		It("should accept a last segment smaller than the others", func() {
+			segments[2] = object("v2/Full-1/0000000002", "sh")
+			storage.EXPECT().ListObjects(ctx, container, "").Return([]objects.Object{manifest}, nil)
+			storage.EXPECT().GetObjectHeader(ctx, container, "v2/Full-1").Return(manifestHeader(segments), nil)
+			storage.EXPECT().ListObjects(ctx, container, "v2/Full-1").Return(segments, nil)
+			expectDownload("v2/Full-1/0000000001", "snap")
+			expectDownload("v2/Full-1/0000000002", "sh")
+
+			result, err := Verify(ctx, logr.Discard(), storage, container, now.Add(-time.Hour))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(&Result{Verified: 1}))
+		})
+
+		It("should report a segment which is smaller than the others but not the last one", func() {
+			segments[1] = object("v2/Full-1/0000000001", "sn")
+			storage.EXPECT().ListObjects(ctx, container, "").Return([]objects.Object{manifest}, nil)
+			storage.EXPECT().GetObjectHeader(ctx, container, "v2/Full-1").Return(manifestHeader(segments), nil)
+			storage.EXPECT().ListObjects(ctx, container, "v2/Full-1").Return(segments, nil)
+
+			result, err := Verify(ctx, logr.Discard(), storage, container, now.Add(-time.Hour))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Failures).To(ConsistOf("v2/Full-1: segment v2/Full-1/0000000001 has 2 bytes, but is not the last of 2 segments of up to 4 bytes"))
+		})
+
+		It("should report a last segment which is larger than the others", func() {
+			segments[2] = object("v2/Full-1/0000000002", "shots")
+			storage.EXPECT().ListObjects(ctx, container, "").Return([]objects.Object{manifest}, nil)
+			storage.EXPECT().GetObjectHeader(ctx, container, "v2/Full-1").Return(manifestHeader(segments), nil)
+			storage.EXPECT().ListObjects(ctx, container, "v2/Full-1").Return(segments, nil)
+
+			result, err := Verify(ctx, logr.Discard(), storage, container, now.Add(-time.Hour))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Failures).To(ConsistOf("v2/Full-1: segment v2/Full-1/0000000001 has 4 bytes, but is not the last of 2 segments of up to 5 bytes"))
+		})
+
+		It("should report an empty segment", func() {
+			segments[2] = object("v2/Full-1/0000000002", "")
+			storage.EXPECT().ListObjects(ctx, container, "").Return([]objects.Object{manifest}, nil)
+			storage.EXPECT().GetObjectHeader(ctx, container, "v2/Full-1").Return(manifestHeader(segments), nil)
+			storage.EXPECT().ListObjects(ctx, container, "v2/Full-1").Return(segments, nil)
+
+			result, err := Verify(ctx, logr.Discard(), storage, container, now.Add(-time.Hour))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Failures).To(ConsistOf("v2/Full-1: segment v2/Full-1/0000000002 is empty"))
+		})
+
+		It("should report a segment whose content does not match its checksum", func() {
+			storage.EXPECT().ListObjects(ctx, container, "").Return([]objects.Object{manifest}, nil)
+			storage.EXPECT().GetObjectHeader(ctx, container, "v2/Full-1").Return(manifestHeader(segments), nil)
+			storage.EXPECT().ListObjects(ctx, container, "v2/Full-1").Return(segments, nil)
+			expectDownload("v2/Full-1/0000000001", "snaP")
+
+			result, err := Verify(ctx, logr.Discard(), storage, container, now.Add(-time.Hour))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Failures).To(ConsistOf(HavePrefix("v2/Full-1: segment v2/Full-1/0000000001: checksum")))
+		})
+
+		It("should report a missing segment", func() {
+			storage.EXPECT().ListObjects(ctx, container, "").Return([]objects.Object{manifest}, nil)
+			storage.EXPECT().GetObjectHeader(ctx, container, "v2/Full-1").Return(manifestHeader(segments), nil)
+			storage.EXPECT().ListObjects(ctx, container, "v2/Full-1").Return([]objects.Object{segments[0], segments[2]}, nil)
+
+			result, err := Verify(ctx, logr.Discard(), storage, container, now.Add(-time.Hour))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Failures).To(ConsistOf("v2/Full-1: segment v2/Full-1/0000000001 is missing"))
+		})
+
+		It("should report segments not matching the checksum of the manifest", func() {
+			header := manifestHeader(segments)
+			segments[2].Hash = checksum("shoT")
+			storage.EXPECT().ListObjects(ctx, container, "").Return([]objects.Object{manifest}, nil)
+			storage.EXPECT().GetObjectHeader(ctx, container, "v2/Full-1").Return(header, nil)
+			storage.EXPECT().ListObjects(ctx, container, "v2/Full-1").Return(segments, nil)
+
+			result, err := Verify(ctx, logr.Discard(), storage, container, now.Add(-time.Hour))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Failures).To(ConsistOf(ContainSubstring("does not match checksum of manifest")))
+		})
+
+		It("should report a manifest without segments", func() {
+			storage.EXPECT().ListObjects(ctx, container, "").Return([]objects.Object{manifest}, nil)
+			storage.EXPECT().GetObjectHeader(ctx, container, "v2/Full-1").Return(manifestHeader(segments), nil)
+			storage.EXPECT().ListObjects(ctx, container, "v2/Full-1").Return(nil, nil)
+
+			result, err := Verify(ctx, logr.Discard(), storage, container, now.Add(-time.Hour))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Failures).To(ConsistOf("v2/Full-1: no segments found"))
+		})
+	})
+})
@@ -0,0 +1,220 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package circuitbreaker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
+	"github.com/gophercloud/gophercloud"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/helper"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+)
+
+const (
+	// ConditionTypeRegionAvailable is the type of the condition of an extension resource reporting whether its
+	// reconciliation is paused because the OpenStack APIs of its region failed repeatedly.
+	ConditionTypeRegionAvailable gardencorev1beta1.ConditionType = "OpenStackRegionAvailable"
+
+	// ReasonCircuitClosed is the reason of the condition if the reconciliation is not paused.
+	ReasonCircuitClosed = "CircuitClosed"
+	// ReasonCircuitOpen is the reason of the condition if the reconciliation is paused.
+	ReasonCircuitOpen = "CircuitOpen"
+
+	// DefaultFailureThreshold is the default number of consecutive API failures in a region after which the circuit
+	// breaker of the region opens.
+	DefaultFailureThreshold = 5
+	// DefaultOpenDuration is the default duration for which the reconciliations in a region are paused once its
+	// circuit breaker opened.
+	DefaultOpenDuration = 5 * time.Minute
+)
+
+// Default is the circuit breaker shared by the controllers of the extension.
+var Default = New(clock.RealClock{}, DefaultFailureThreshold, DefaultOpenDuration)
+
+// Breaker detects sustained failures of the OpenStack APIs per region, e.g. Keystone outages or storms of server
+// errors. A region is identified by its Keystone URL and its name, as region names are only unique within an OpenStack
+// installation. Once the given number of consecutive failures is recorded for a region, its circuit opens and the
+// reconciliations of the resources in the region are paused for the given duration. Every failure recorded while the
+// circuit is open extends the pause, a single success closes the circuit again.
+type Breaker struct {
+	clock            clock.Clock
+	failureThreshold int
+	openDuration     time.Duration
+
+	lock    sync.Mutex
+	regions map[regionKey]*regionState
+}
+
+type regionKey struct {
+	authURL string
+	region  string
+}
+
+type regionState struct {
+	failures  int
+	lastError error
+	openUntil time.Time
+}
+
+// New creates a new circuit breaker.
+func New(clock clock.Clock, failureThreshold int, openDuration time.Duration) *Breaker {
+	return &Breaker{
+		clock:            clock,
+		failureThreshold: failureThreshold,
+		openDuration:     openDuration,
+		regions:          map[regionKey]*regionState{},
+	}
+}
+
+// Record records the result of a call of the OpenStack APIs of the given region of the OpenStack installation with the
+// given Keystone URL. Errors which do not indicate an outage of the APIs, e.g. invalid credentials of a single shoot,
+// are ignored.
+func (b *Breaker) Record(authURL, region string, err error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	key := regionKey{authURL: authURL, region: region}
+	if err == nil {
+		delete(b.regions, key)
+		return
+	}
+	if !IsOutage(err) {
+		return
+	}
+
+	state, ok := b.regions[key]
+	if !ok {
+		state = &regionState{}
+		b.regions[key] = state
+	}
+	state.failures++
+	state.lastError = err
+	if state.failures >= b.failureThreshold {
+		state.openUntil = b.clock.Now().Add(b.openDuration)
+	}
+}
+
+// Allow returns an *OpenError if the circuit of the given region of the OpenStack installation with the given Keystone
+// URL is open, i.e. the reconciliations in the region are paused, and nil otherwise.
+func (b *Breaker) Allow(authURL, region string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	state, ok := b.regions[regionKey{authURL: authURL, region: region}]
+	if !ok {
+		return nil
+	}
+	if remaining := state.openUntil.Sub(b.clock.Now()); remaining > 0 {
+		return &OpenError{Region: region, Failures: state.failures, LastError: state.lastError, RetryAfter: remaining}
+	}
+	return nil
+}
+
+// Guard reports the state of the circuit of the given region in the condition of the given extension resource. If the
+// circuit is open, it returns an error requeueing the reconciliation of the resource once the pause ends. The error is
+// classified as retryable so that the paused reconciliations of all shoots in the region do not alarm individually.
+func (b *Breaker) Guard(ctx context.Context, c client.Client, obj extensionsv1alpha1.Object, authURL, region string) error {
+	allowErr := b.Allow(authURL, region)
+	if err := b.reportCondition(ctx, c, obj, allowErr); err != nil {
+		return fmt.Errorf("could not update condition %s: %w", ConditionTypeRegionAvailable, err)
+	}
+
+	var openErr *OpenError
+	if !errors.As(allowErr, &openErr) {
+		return nil
+	}
+	return &reconcilerutils.RequeueAfterError{
+		Cause:        v1beta1helper.NewErrorWithCodes(openErr, gardencorev1beta1.ErrorRetryableInfraDependencies),
+		RequeueAfter: openErr.RetryAfter,
+	}
+}
+
+// reportCondition sets the condition of the given extension resource if the circuit is open or the condition is present
+// already, so that resources in healthy regions are not patched.
+func (b *Breaker) reportCondition(ctx context.Context, c client.Client, obj extensionsv1alpha1.Object, allowErr error) error {
+	conditions := obj.GetExtensionStatus().GetConditions()
+	if allowErr == nil && v1beta1helper.GetCondition(conditions, ConditionTypeRegionAvailable) == nil {
+		return nil
+	}
+
+	status, reason, message := gardencorev1beta1.ConditionTrue, ReasonCircuitClosed, "The OpenStack APIs of the region are available."
+	if allowErr != nil {
+		status, reason, message = gardencorev1beta1.ConditionFalse, ReasonCircuitOpen, allowErr.Error()
+	}
+
+	condition := v1beta1helper.GetOrInitConditionWithClock(b.clock, conditions, ConditionTypeRegionAvailable)
+	if condition.Status == status && condition.Reason == reason && condition.Message == message {
+		return nil
+	}
+
+	patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+	condition = v1beta1helper.UpdatedConditionWithClock(b.clock, condition, status, reason, message)
+	obj.GetExtensionStatus().SetConditions(v1beta1helper.MergeConditions(conditions, condition))
+	return c.Status().Patch(ctx, obj, patch)
+}
+
+// OpenError is returned if the circuit of a region is open.
+type OpenError struct {
+	// Region is the region of the circuit.
+	Region string
+	// Failures is the number of consecutive failures recorded for the region.
+	Failures int
+	// LastError is the last failure recorded for the region.
+	LastError error
+	// RetryAfter is the remaining duration of the pause.
+	RetryAfter time.Duration
+}
+
+func (e *OpenError) Error() string {
+	return fmt.Sprintf("reconciliation is paused for %s as the OpenStack APIs of region %q failed %d times in a row, last error: %v",
+		e.RetryAfter.Round(time.Second), e.Region, e.Failures, e.LastError)
+}
+
+// KeystoneURL returns the Keystone URL used by the shoot of the given cluster in the given region, i.e. the one of its
+// credentials or otherwise the one of its cloud profile.
+func KeystoneURL(ctx context.Context, c client.Client, secretRef corev1.SecretReference, cluster *extensionscontroller.Cluster, region string) (string, error) {
+	credentials, err := openstack.GetShootCredentials(ctx, c, secretRef)
+	if err != nil {
+		return "", fmt.Errorf("could not get OpenStack credentials: %w", err)
+	}
+	if authURL := strings.TrimSpace(credentials.AuthURL); len(authURL) > 0 {
+		return authURL, nil
+	}
+
+	cloudProfileConfig, err := helper.CloudProfileConfigFromCluster(cluster)
+	if err != nil {
+		return "", err
+	}
+	return helper.FindKeyStoneURL(cloudProfileConfig.KeyStoneURLs, cloudProfileConfig.KeyStoneURL, region)
+}
+
+// IsOutage returns whether the given error indicates an outage of the OpenStack APIs, i.e. a server error, an error
+// connecting to the API or a call which timed out.
+func IsOutage(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var statusCodeErr gophercloud.StatusCodeError
+	if errors.As(err, &statusCodeErr) {
+		return statusCodeErr.GetStatusCode() >= 500
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
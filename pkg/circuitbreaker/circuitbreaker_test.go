@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package circuitbreaker_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
+	"github.com/gophercloud/gophercloud"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/circuitbreaker"
+)
+
+var _ = Describe("Breaker", func() {
+	const (
+		authURL = "https://keystone.example.com/v3"
+		region  = "eu-1"
+	)
+
+	var (
+		clock   *testclock.FakeClock
+		breaker *circuitbreaker.Breaker
+
+		outage = gophercloud.ErrDefault500{ErrUnexpectedResponseCode: gophercloud.ErrUnexpectedResponseCode{Actual: 500}}
+	)
+
+	BeforeEach(func() {
+		clock = testclock.NewFakeClock(time.Now())
+		breaker = circuitbreaker.New(clock, 3, 5*time.Minute)
+	})
+
+	Describe("#IsOutage", func() {
+		It("should only consider server and connection errors as outages", func() {
+			Expect(circuitbreaker.IsOutage(outage)).To(BeTrue())
+			Expect(circuitbreaker.IsOutage(fmt.Errorf("wrapped: %w", gophercloud.ErrDefault503{ErrUnexpectedResponseCode: gophercloud.ErrUnexpectedResponseCode{Actual: 503}}))).To(BeTrue())
+			Expect(circuitbreaker.IsOutage(&net.OpError{Op: "dial", Err: fmt.Errorf("connection refused")})).To(BeTrue())
+			Expect(circuitbreaker.IsOutage(fmt.Errorf("wrapped: %w", context.DeadlineExceeded))).To(BeTrue())
+			Expect(circuitbreaker.IsOutage(gophercloud.ErrDefault401{ErrUnexpectedResponseCode: gophercloud.ErrUnexpectedResponseCode{Actual: 401}})).To(BeFalse())
+			Expect(circuitbreaker.IsOutage(gophercloud.ErrDefault404{ErrUnexpectedResponseCode: gophercloud.ErrUnexpectedResponseCode{Actual: 404}})).To(BeFalse())
+			Expect(circuitbreaker.IsOutage(fmt.Errorf("error"))).To(BeFalse())
+		})
+	})
+
+	Describe("#Record", func() {
+		It("should open the circuit after consecutive outages until the pause ends", func() {
+			breaker.Record(authURL, region, outage)
+			breaker.Record(authURL, region, outage)
+			Expect(breaker.Allow(authURL, region)).To(Succeed())
+
+			breaker.Record(authURL, region, outage)
+			err := breaker.Allow(authURL, region)
+			Expect(err).To(BeAssignableToTypeOf(&circuitbreaker.OpenError{}))
+			Expect(err.(*circuitbreaker.OpenError).RetryAfter).To(Equal(5 * time.Minute))
+			Expect(breaker.Allow(authURL, "eu-2")).To(Succeed())
+			Expect(breaker.Allow("https://other-keystone.example.com/v3", region)).To(Succeed())
+
+			clock.Step(5 * time.Minute)
+			Expect(breaker.Allow(authURL, region)).To(Succeed())
+
+			// the circuit opens again with the next outage as long as no success is recorded
+			breaker.Record(authURL, region, outage)
+			Expect(breaker.Allow(authURL, region)).To(HaveOccurred())
+		})
+
+		It("should close the circuit on success and ignore errors which are no outages", func() {
+			breaker.Record(authURL, region, outage)
+			breaker.Record(authURL, region, outage)
+			breaker.Record(authURL, region, gophercloud.ErrDefault401{})
+			breaker.Record(authURL, region, nil)
+			breaker.Record(authURL, region, outage)
+			Expect(breaker.Allow(authURL, region)).To(Succeed())
+
+			breaker.Record(authURL, region, outage)
+			breaker.Record(authURL, region, outage)
+			Expect(breaker.Allow(authURL, region)).To(HaveOccurred())
+
+			breaker.Record(authURL, region, nil)
+			Expect(breaker.Allow(authURL, region)).To(Succeed())
+		})
+	})
+
+	Describe("#Guard", func() {
+		var (
+			ctx   = context.Background()
+			c     client.Client
+			infra *extensionsv1alpha1.Infrastructure
+
+			condition = func() *gardencorev1beta1.Condition {
+				obj := &extensionsv1alpha1.Infrastructure{}
+				ExpectWithOffset(1, c.Get(ctx, client.ObjectKeyFromObject(infra), obj)).To(Succeed())
+				return v1beta1helper.GetCondition(obj.Status.Conditions, circuitbreaker.ConditionTypeRegionAvailable)
+			}
+		)
+
+		BeforeEach(func() {
+			infra = &extensionsv1alpha1.Infrastructure{ObjectMeta: metav1.ObjectMeta{Namespace: "shoot--foo--bar", Name: "infrastructure"}}
+			c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithStatusSubresource(&extensionsv1alpha1.Infrastructure{}).WithObjects(infra).Build()
+		})
+
+		It("should not set the condition if the circuit was never open", func() {
+			Expect(breaker.Guard(ctx, c, infra, authURL, region)).To(Succeed())
+			Expect(condition()).To(BeNil())
+		})
+
+		It("should pause the reconciliation with a retryable error and report the condition while the circuit is open", func() {
+			for i := 0; i < 3; i++ {
+				breaker.Record(authURL, region, outage)
+			}
+
+			err := breaker.Guard(ctx, c, infra, authURL, region)
+			Expect(err).To(BeAssignableToTypeOf(&reconcilerutils.RequeueAfterError{}))
+			requeueAfterErr := err.(*reconcilerutils.RequeueAfterError)
+			Expect(requeueAfterErr.RequeueAfter).To(Equal(5 * time.Minute))
+			Expect(v1beta1helper.ExtractErrorCodes(requeueAfterErr.Cause)).To(ConsistOf(gardencorev1beta1.ErrorRetryableInfraDependencies))
+
+			cond := condition()
+			Expect(cond).NotTo(BeNil())
+			Expect(cond.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(cond.Reason).To(Equal(circuitbreaker.ReasonCircuitOpen))
+			Expect(cond.Message).To(ContainSubstring(`OpenStack APIs of region "eu-1" failed 3 times in a row`))
+
+			breaker.Record(authURL, region, nil)
+			Expect(breaker.Guard(ctx, c, infra, authURL, region)).To(Succeed())
+
+			cond = condition()
+			Expect(cond).NotTo(BeNil())
+			Expect(cond.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+			Expect(cond.Reason).To(Equal(circuitbreaker.ReasonCircuitClosed))
+		})
+	})
+})
@@ -9,9 +9,11 @@ import (
 
 	healthcheckconfig "github.com/gardener/gardener/extensions/pkg/apis/config"
 	"github.com/spf13/pflag"
+	"k8s.io/component-base/featuregate"
 
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/config"
 	configloader "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/config/loader"
+	configvalidation "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/config/validation"
 )
 
 // ConfigOptions are command line options that can be set for config.ControllerConfiguration.
@@ -41,6 +43,9 @@ func (c *ConfigOptions) Complete() error {
 	if err != nil {
 		return err
 	}
+	if errs := configvalidation.ValidateControllerConfiguration(config); len(errs) > 0 {
+		return fmt.Errorf("invalid controller configuration: %w", errs.ToAggregate())
+	}
 
 	c.config = &Config{config}
 	return nil
@@ -91,3 +96,42 @@ func (c *Config) ApplyBastionConfig(config *config.BastionConfig) {
 		*config = *c.Config.BastionConfig
 	}
 }
+
+// ApplyEgressRestriction applies the EgressRestriction to the config
+func (c *Config) ApplyEgressRestriction(config *config.EgressRestriction) {
+	if c.Config.EgressRestriction != nil {
+		*config = *c.Config.EgressRestriction
+	}
+}
+
+// ApplyControlPlaneScheduling applies the ControlPlaneScheduling to the config
+func (c *Config) ApplyControlPlaneScheduling(config *config.ControlPlaneScheduling) {
+	if c.Config.ControlPlaneScheduling != nil {
+		*config = *c.Config.ControlPlaneScheduling
+	}
+}
+
+// ApplyQuotaMonitoring applies the QuotaMonitoring to the config
+func (c *Config) ApplyQuotaMonitoring(config **config.QuotaMonitoring) {
+	*config = c.Config.QuotaMonitoring
+}
+
+// ApplyTerraformer applies the Terraformer config to the config
+func (c *Config) ApplyTerraformer(config **config.Terraformer) {
+	*config = c.Config.Terraformer
+}
+
+// ApplyMachineClassExtraAllowedKeys applies the MachineClassExtraAllowedKeys to the config
+func (c *Config) ApplyMachineClassExtraAllowedKeys(config *[]string) {
+	*config = c.Config.MachineClassExtraAllowedKeys
+}
+
+// ApplyZoneRedundantLoadBalancer applies the ZoneRedundantLoadBalancer to the config
+func (c *Config) ApplyZoneRedundantLoadBalancer(config **config.ZoneRedundantLoadBalancer) {
+	*config = c.Config.ZoneRedundantLoadBalancer
+}
+
+// ApplyFeatureGates applies the FeatureGates of the config to the given feature gate.
+func (c *Config) ApplyFeatureGates(featureGate featuregate.MutableFeatureGate) error {
+	return featureGate.SetFromMap(c.Config.FeatureGates)
+}
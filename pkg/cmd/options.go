@@ -5,6 +5,8 @@
 package cmd
 
 import (
+	"fmt"
+
 	extensionsbackupbucketcontroller "github.com/gardener/gardener/extensions/pkg/controller/backupbucket"
 	extensionsbackupentrycontroller "github.com/gardener/gardener/extensions/pkg/controller/backupentry"
 	extensionsbastioncontroller "github.com/gardener/gardener/extensions/pkg/controller/bastion"
@@ -18,23 +20,32 @@ import (
 	extensionscloudproviderwebhook "github.com/gardener/gardener/extensions/pkg/webhook/cloudprovider"
 	webhookcmd "github.com/gardener/gardener/extensions/pkg/webhook/cmd"
 	extensioncontrolplanewebhook "github.com/gardener/gardener/extensions/pkg/webhook/controlplane"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	backupbucketcontroller "github.com/gardener/gardener-extension-provider-openstack/pkg/controller/backupbucket"
 	backupentrycontroller "github.com/gardener/gardener-extension-provider-openstack/pkg/controller/backupentry"
 	bastioncontroller "github.com/gardener/gardener-extension-provider-openstack/pkg/controller/bastion"
 	controlplanecontroller "github.com/gardener/gardener-extension-provider-openstack/pkg/controller/controlplane"
+	credentialsaliascontroller "github.com/gardener/gardener-extension-provider-openstack/pkg/controller/credentialsalias"
 	dnsrecordcontroller "github.com/gardener/gardener-extension-provider-openstack/pkg/controller/dnsrecord"
+	endpointprobecontroller "github.com/gardener/gardener-extension-provider-openstack/pkg/controller/endpointprobe"
 	healthcheckcontroller "github.com/gardener/gardener-extension-provider-openstack/pkg/controller/healthcheck"
 	infrastructurecontroller "github.com/gardener/gardener-extension-provider-openstack/pkg/controller/infrastructure"
+	loadbalancerstatuscontroller "github.com/gardener/gardener-extension-provider-openstack/pkg/controller/loadbalancerstatus"
+	maintenancecontroller "github.com/gardener/gardener-extension-provider-openstack/pkg/controller/maintenance"
+	portcontroller "github.com/gardener/gardener-extension-provider-openstack/pkg/controller/port"
+	quotacontroller "github.com/gardener/gardener-extension-provider-openstack/pkg/controller/quota"
 	workercontroller "github.com/gardener/gardener-extension-provider-openstack/pkg/controller/worker"
 	cloudproviderwebhook "github.com/gardener/gardener-extension-provider-openstack/pkg/webhook/cloudprovider"
 	controlplanewebhook "github.com/gardener/gardener-extension-provider-openstack/pkg/webhook/controlplane"
 	controlplaneexposurewebhook "github.com/gardener/gardener-extension-provider-openstack/pkg/webhook/controlplaneexposure"
+	tuningwebhook "github.com/gardener/gardener-extension-provider-openstack/pkg/webhook/tuning"
 )
 
-// ControllerSwitchOptions are the controllercmd.SwitchOptions for the provider controllers.
-func ControllerSwitchOptions() *controllercmd.SwitchOptions {
-	return controllercmd.NewSwitchOptions(
+// controllerSwitches are the provider controllers with their names.
+func controllerSwitches() []controllercmd.NameToAddToManagerFunc {
+	return []controllercmd.NameToAddToManagerFunc{
 		controllercmd.Switch(extensionsbackupbucketcontroller.ControllerName, backupbucketcontroller.AddToManager),
 		controllercmd.Switch(extensionsbackupentrycontroller.ControllerName, backupentrycontroller.AddToManager),
 		controllercmd.Switch(extensionsbastioncontroller.ControllerName, bastioncontroller.AddToManager),
@@ -42,9 +53,88 @@ func ControllerSwitchOptions() *controllercmd.SwitchOptions {
 		controllercmd.Switch(extensionsdnsrecordcontroller.ControllerName, dnsrecordcontroller.AddToManager),
 		controllercmd.Switch(extensionsinfrastructurecontroller.ControllerName, infrastructurecontroller.AddToManager),
 		controllercmd.Switch(extensionsworkercontroller.ControllerName, workercontroller.AddToManager),
+		controllercmd.Switch(loadbalancerstatuscontroller.ControllerName, loadbalancerstatuscontroller.AddToManager),
+		controllercmd.Switch(quotacontroller.ControllerName, quotacontroller.AddToManager),
+		controllercmd.Switch(endpointprobecontroller.ControllerName, endpointprobecontroller.AddToManager),
+		controllercmd.Switch(maintenancecontroller.ControllerName, maintenancecontroller.AddToManager),
+		controllercmd.Switch(credentialsaliascontroller.ControllerName, credentialsaliascontroller.AddToManager),
+		controllercmd.Switch(portcontroller.ControllerName, portcontroller.AddToManager),
 		controllercmd.Switch(extensionshealthcheckcontroller.ControllerName, healthcheckcontroller.AddToManager),
 		controllercmd.Switch(extensionsheartbeatcontroller.ControllerName, extensionsheartbeatcontroller.AddToManager),
-	)
+	}
+}
+
+// ControllerSwitchOptions are the controllercmd.SwitchOptions for the provider controllers.
+func ControllerSwitchOptions() *controllercmd.SwitchOptions {
+	return controllercmd.NewSwitchOptions(controllerSwitches()...)
+}
+
+// ControllerNames returns the names of the provider controllers.
+func ControllerNames() []string {
+	var names []string
+	for _, pair := range controllerSwitches() {
+		names = append(names, pair.Name)
+	}
+	return names
+}
+
+const (
+	// ControllersFlag is the name of the command line flag to specify the controllers to run.
+	ControllersFlag = "controllers"
+	// WebhooksFlag is the name of the command line flag to specify whether the webhooks are served.
+	WebhooksFlag = "webhooks"
+)
+
+// SelectionOptions are command line options selecting the controllers and webhooks run by an instance of the extension,
+// so that the controllers can be split across several deployments which are scaled independently.
+type SelectionOptions struct {
+	// Controllers are the controllers to run. All controllers which are not disabled are run if empty.
+	Controllers []string
+	// Webhooks indicates whether the webhooks are served and their configurations are managed. Only one of the
+	// deployments of the extension must serve the webhooks.
+	Webhooks bool
+
+	switches *controllercmd.SwitchOptions
+	config   *SelectionConfig
+}
+
+// NewSelectionOptions creates new SelectionOptions which disable all controllers of the given switches which are not
+// selected. They must be completed before the switches.
+func NewSelectionOptions(switches *controllercmd.SwitchOptions) *SelectionOptions {
+	return &SelectionOptions{Webhooks: true, switches: switches}
+}
+
+// AddFlags implements Flagger.AddFlags.
+func (o *SelectionOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringSliceVar(&o.Controllers, ControllersFlag, o.Controllers, fmt.Sprintf("List of controllers to run, all controllers which are not disabled are run if empty %v", ControllerNames()))
+	fs.BoolVar(&o.Webhooks, WebhooksFlag, o.Webhooks, "Whether to serve the webhooks and manage their configurations")
+}
+
+// Complete implements Completer.Complete.
+func (o *SelectionOptions) Complete() error {
+	if len(o.Controllers) > 0 {
+		names := sets.New(ControllerNames()...)
+		selected := sets.New(o.Controllers...)
+		if unknown := selected.Difference(names); unknown.Len() > 0 {
+			return fmt.Errorf("cannot run unknown controllers %v", sets.List(unknown))
+		}
+		disabled := sets.New(o.switches.Disabled...).Union(names.Difference(selected))
+		o.switches.Disabled = sets.List(disabled)
+	}
+
+	o.config = &SelectionConfig{Webhooks: o.Webhooks}
+	return nil
+}
+
+// Completed returns the completed SelectionConfig. Only call this if `Complete` was successful.
+func (o *SelectionOptions) Completed() *SelectionConfig {
+	return o.config
+}
+
+// SelectionConfig is the completed configuration of SelectionOptions.
+type SelectionConfig struct {
+	// Webhooks indicates whether the webhooks are served and their configurations are managed.
+	Webhooks bool
 }
 
 // WebhookSwitchOptions are the webhookcmd.SwitchOptions for the provider webhooks.
@@ -53,5 +143,6 @@ func WebhookSwitchOptions(gardenerVersion *string) *webhookcmd.SwitchOptions {
 		webhookcmd.Switch(extensioncontrolplanewebhook.WebhookName, controlplanewebhook.AddToManager),
 		webhookcmd.Switch(extensioncontrolplanewebhook.ExposureWebhookName, controlplaneexposurewebhook.AddToManager),
 		webhookcmd.Switch(extensionscloudproviderwebhook.WebhookName, cloudproviderwebhook.AddToManager(gardenerVersion)),
+		webhookcmd.Switch(tuningwebhook.WebhookName, tuningwebhook.AddToManager),
 	)
 }
@@ -6,15 +6,18 @@ package backupbucket
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/backupbucket"
 	"github.com/gardener/gardener/extensions/pkg/util"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/helper"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/validation"
 	openstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
 )
 
@@ -30,16 +33,44 @@ func newActuator(mgr manager.Manager) backupbucket.Actuator {
 }
 
 func (a *actuator) Reconcile(ctx context.Context, _ logr.Logger, bb *extensionsv1alpha1.BackupBucket) error {
-	openstackClient, err := openstackclient.NewStorageClientFromSecretRef(ctx, a.client, bb.Spec.SecretRef, bb.Spec.Region)
+	config, err := helper.BackupBucketConfigFromRawExtension(bb.Spec.ProviderConfig)
 	if err != nil {
+		return err
+	}
+	if errs := validation.ValidateBackupBucketConfig(config, field.NewPath("spec", "providerConfig")); len(errs) > 0 {
+		return fmt.Errorf("invalid backup bucket config: %w", errs.ToAggregate())
+	}
+
+	credentialsSecretRef := helper.BackupCredentialsSecretRef(config, bb.Spec.SecretRef)
+	openstackClient, err := openstackclient.NewStorageClientFromSecretRef(ctx, a.client, credentialsSecretRef, bb.Spec.Region)
+	if err != nil {
+		return util.DetermineError(err, helper.KnownCodes)
+	}
+
+	if err := openstackClient.CreateContainerIfNotExists(ctx, bb.Name); err != nil {
 		return util.DetermineError(err, helper.KnownCodes)
 	}
 
-	return util.DetermineError(openstackClient.CreateContainerIfNotExists(ctx, bb.Name), helper.KnownCodes)
+	if config.TempURL != nil {
+		if _, err := openstackClient.EnsureContainerTempURLKey(ctx, bb.Name); err != nil {
+			return util.DetermineError(fmt.Errorf("failed to ensure key for temporary URLs: %w", err), helper.KnownCodes)
+		}
+	}
+
+	return ensureIntegrityCheck(ctx, a.client, bb, config.IntegrityCheck, credentialsSecretRef)
 }
 
 func (a *actuator) Delete(ctx context.Context, _ logr.Logger, bb *extensionsv1alpha1.BackupBucket) error {
-	openstackClient, err := openstackclient.NewStorageClientFromSecretRef(ctx, a.client, bb.Spec.SecretRef, bb.Spec.Region)
+	config, err := helper.BackupBucketConfigFromRawExtension(bb.Spec.ProviderConfig)
+	if err != nil {
+		return err
+	}
+
+	if err := deleteIntegrityCheck(ctx, a.client, bb); err != nil {
+		return err
+	}
+
+	openstackClient, err := openstackclient.NewStorageClientFromSecretRef(ctx, a.client, helper.BackupCredentialsSecretRef(config, bb.Spec.SecretRef), bb.Spec.Region)
 	if err != nil {
 		return util.DetermineError(err, helper.KnownCodes)
 	}
@@ -0,0 +1,210 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupbucket
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/component-base/version"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-provider-openstack/imagevector"
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+)
+
+const (
+	// defaultIntegrityCheckSchedule is the default schedule of the verification of the backups, once a day.
+	defaultIntegrityCheckSchedule = "0 3 * * *"
+	// defaultIntegrityCheckMaxAge is the default maximum age of the verified backups.
+	defaultIntegrityCheckMaxAge = 24 * time.Hour
+
+	// integrityCheckNamePrefix is the prefix of the names of the resources of the verification of a backup bucket.
+	integrityCheckNamePrefix = "backup-verifier-"
+	// maxCronJobNameLength is the maximum length of the name of a cron job, so that the names of its jobs are valid.
+	maxCronJobNameLength = 52
+	// credentialsMountPath is the path the backup secret is mounted at in the pods of the verification.
+	credentialsMountPath = "/srv/openstack/credentials"
+)
+
+// ImageVector is exposed for testing.
+var ImageVector = imagevector.ImageVector()
+
+// integrityCheckName returns the name of the resources of the verification of the given backup bucket. Names which are
+// too long for a cron job are shortened with a hash of the name of the backup bucket.
+func integrityCheckName(bb *extensionsv1alpha1.BackupBucket) string {
+	name := integrityCheckNamePrefix + bb.Name
+	if len(name) <= maxCronJobNameLength {
+		return name
+	}
+	hash := sha256.Sum256([]byte(bb.Name))
+	return integrityCheckNamePrefix + hex.EncodeToString(hash[:])[:maxCronJobNameLength-len(integrityCheckNamePrefix)]
+}
+
+// integrityCheckObjects returns the (empty) resources of the verification of the given backup bucket. The cron job and
+// its service account live in the namespace of the backup secret, whose credentials are mounted into its pods.
+func integrityCheckObjects(bb *extensionsv1alpha1.BackupBucket) (*corev1.ServiceAccount, *rbacv1.ClusterRole, *rbacv1.ClusterRoleBinding, *batchv1.CronJob) {
+	var (
+		name              = integrityCheckName(bb)
+		clusterObjectName = "extensions.gardener.cloud:provider-openstack:" + name
+	)
+	return &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: bb.Spec.SecretRef.Namespace}},
+		&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: clusterObjectName}},
+		&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: clusterObjectName}},
+		&batchv1.CronJob{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: bb.Spec.SecretRef.Namespace}}
+}
+
+// ensureIntegrityCheck deploys the cron job verifying the backups of the given backup bucket with the credentials of the
+// given secret, or removes it if the verification is not configured.
+func ensureIntegrityCheck(ctx context.Context, c client.Client, bb *extensionsv1alpha1.BackupBucket, config *api.IntegrityCheckConfig, credentialsSecretRef corev1.SecretReference) error {
+	if config == nil {
+		return deleteIntegrityCheck(ctx, c, bb)
+	}
+
+	schedule := defaultIntegrityCheckSchedule
+	if config.Schedule != nil {
+		schedule = *config.Schedule
+	}
+	maxAge := defaultIntegrityCheckMaxAge
+	if config.MaxAge != nil {
+		maxAge = config.MaxAge.Duration
+	}
+
+	image, err := ImageVector.FindImage(openstack.BackupVerifierImageName)
+	if err != nil {
+		return err
+	}
+	image.WithOptionalTag(version.Get().GitVersion)
+
+	serviceAccount, clusterRole, clusterRoleBinding, cronJob := integrityCheckObjects(bb)
+	labels := map[string]string{v1beta1constants.LabelApp: openstack.BackupVerifierName}
+
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, c, serviceAccount, func() error {
+		serviceAccount.Labels = labels
+		serviceAccount.AutomountServiceAccountToken = pointer.Bool(false)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, c, clusterRole, func() error {
+		clusterRole.Labels = labels
+		clusterRole.Rules = []rbacv1.PolicyRule{
+			{
+				APIGroups:     []string{extensionsv1alpha1.SchemeGroupVersion.Group},
+				Resources:     []string{"backupbuckets"},
+				ResourceNames: []string{bb.Name},
+				Verbs:         []string{"get"},
+			},
+			{
+				APIGroups:     []string{extensionsv1alpha1.SchemeGroupVersion.Group},
+				Resources:     []string{"backupbuckets/status"},
+				ResourceNames: []string{bb.Name},
+				Verbs:         []string{"patch"},
+			},
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, c, clusterRoleBinding, func() error {
+		clusterRoleBinding.Labels = labels
+		clusterRoleBinding.RoleRef = rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     clusterRole.Name,
+		}
+		clusterRoleBinding.Subjects = []rbacv1.Subject{{
+			Kind:      rbacv1.ServiceAccountKind,
+			Name:      serviceAccount.Name,
+			Namespace: serviceAccount.Namespace,
+		}}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	_, err = controllerutils.GetAndCreateOrMergePatch(ctx, c, cronJob, func() error {
+		cronJob.Labels = labels
+		cronJob.Spec = batchv1.CronJobSpec{
+			Schedule:                   schedule,
+			ConcurrencyPolicy:          batchv1.ForbidConcurrent,
+			SuccessfulJobsHistoryLimit: pointer.Int32(1),
+			FailedJobsHistoryLimit:     pointer.Int32(1),
+			JobTemplate: batchv1.JobTemplateSpec{
+				Spec: batchv1.JobSpec{
+					BackoffLimit: pointer.Int32(0),
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels: map[string]string{
+								v1beta1constants.LabelApp:                             openstack.BackupVerifierName,
+								v1beta1constants.LabelNetworkPolicyToDNS:              v1beta1constants.LabelNetworkPolicyAllowed,
+								v1beta1constants.LabelNetworkPolicyToPublicNetworks:   v1beta1constants.LabelNetworkPolicyAllowed,
+								v1beta1constants.LabelNetworkPolicyToPrivateNetworks:  v1beta1constants.LabelNetworkPolicyAllowed,
+								v1beta1constants.LabelNetworkPolicyToRuntimeAPIServer: v1beta1constants.LabelNetworkPolicyAllowed,
+							},
+						},
+						Spec: corev1.PodSpec{
+							ServiceAccountName:           serviceAccount.Name,
+							AutomountServiceAccountToken: pointer.Bool(true),
+							RestartPolicy:                corev1.RestartPolicyNever,
+							SecurityContext: &corev1.PodSecurityContext{
+								RunAsNonRoot: pointer.Bool(true),
+								RunAsUser:    pointer.Int64(65532),
+							},
+							Containers: []corev1.Container{{
+								Name:            openstack.BackupVerifierName,
+								Image:           image.String(),
+								ImagePullPolicy: corev1.PullIfNotPresent,
+								Args: []string{
+									"--credentials-dir=" + credentialsMountPath,
+									"--region=" + bb.Spec.Region,
+									"--backup-bucket=" + bb.Name,
+									"--max-age=" + maxAge.String(),
+								},
+								VolumeMounts: []corev1.VolumeMount{{
+									Name:      "credentials",
+									MountPath: credentialsMountPath,
+									ReadOnly:  true,
+								}},
+							}},
+							Volumes: []corev1.Volume{{
+								Name: "credentials",
+								VolumeSource: corev1.VolumeSource{
+									Secret: &corev1.SecretVolumeSource{SecretName: credentialsSecretRef.Name},
+								},
+							}},
+						},
+					},
+				},
+			},
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("could not deploy verification of backups: %w", err)
+	}
+	return nil
+}
+
+// deleteIntegrityCheck deletes the resources of the verification of the backups of the given backup bucket.
+func deleteIntegrityCheck(ctx context.Context, c client.Client, bb *extensionsv1alpha1.BackupBucket) error {
+	serviceAccount, clusterRole, clusterRoleBinding, cronJob := integrityCheckObjects(bb)
+	return kubernetesutils.DeleteObjects(ctx, c, cronJob, clusterRoleBinding, clusterRole, serviceAccount)
+}
@@ -7,11 +7,16 @@ package backupentry
 import (
 	"context"
 	"fmt"
+	"maps"
+	"strconv"
+	"time"
 
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/controller/backupentry/genericactuator"
 	"github.com/gardener/gardener/extensions/pkg/util"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
@@ -20,6 +25,9 @@ import (
 	openstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
 )
 
+// defaultTempURLTTL is the default validity of temporary URLs.
+const defaultTempURLTTL = time.Hour
+
 type actuator struct {
 	client client.Client
 }
@@ -30,13 +38,67 @@ func newActuator(mgr manager.Manager) genericactuator.BackupEntryDelegate {
 	}
 }
 
-func (a *actuator) GetETCDSecretData(_ context.Context, _ logr.Logger, be *extensionsv1alpha1.BackupEntry, backupSecretData map[string][]byte) (map[string][]byte, error) {
+func (a *actuator) GetETCDSecretData(ctx context.Context, _ logr.Logger, be *extensionsv1alpha1.BackupEntry, backupSecretData map[string][]byte) (map[string][]byte, error) {
+	config, err := helper.BackupBucketConfigFromRawExtension(be.Spec.ProviderConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	// the backups of etcd are written with the credentials of the dedicated project of the Swift container
+	credentialsSecretRef := helper.BackupCredentialsSecretRef(config, be.Spec.SecretRef)
+	if config.CredentialsSecretName != nil {
+		if backupSecretData, err = a.credentialsSecretData(ctx, credentialsSecretRef); err != nil {
+			return nil, err
+		}
+	}
+
 	backupSecretData[openstack.Region] = []byte(be.Spec.Region)
+
+	if config.SegmentSize != nil {
+		backupSecretData[openstack.SegmentSize] = []byte(strconv.FormatInt(config.SegmentSize.Value(), 10))
+	}
+
+	if config.TempURL != nil {
+		openstackClient, err := openstackclient.NewStorageClientFromSecretRef(ctx, a.client, credentialsSecretRef, be.Spec.Region)
+		if err != nil {
+			return nil, util.DetermineError(err, helper.KnownCodes)
+		}
+
+		key, err := openstackClient.EnsureContainerTempURLKey(ctx, be.Spec.BucketName)
+		if err != nil {
+			return nil, util.DetermineError(fmt.Errorf("failed to get key for temporary URLs: %w", err), helper.KnownCodes)
+		}
+
+		ttl := defaultTempURLTTL
+		if config.TempURL.TTL != nil {
+			ttl = config.TempURL.TTL.Duration
+		}
+		backupSecretData[openstack.TempURLKey] = []byte(key)
+		backupSecretData[openstack.TempURLTTL] = []byte(ttl.String())
+	}
+
 	return backupSecretData, nil
 }
 
+// credentialsSecretData returns a copy of the data of the given secret with the credentials of a dedicated project.
+func (a *actuator) credentialsSecretData(ctx context.Context, secretRef corev1.SecretReference) (map[string][]byte, error) {
+	secret, err := extensionscontroller.GetSecretByReference(ctx, a.client, &secretRef)
+	if err != nil {
+		return nil, fmt.Errorf("could not read secret with the credentials of the backup project: %w", err)
+	}
+	if _, err := openstack.ExtractCredentials(secret, false); err != nil {
+		return nil, fmt.Errorf("invalid credentials of the backup project in secret %s/%s: %w", secretRef.Namespace, secretRef.Name, err)
+	}
+	return maps.Clone(secret.Data), nil
+}
+
 func (a *actuator) Delete(ctx context.Context, _ logr.Logger, be *extensionsv1alpha1.BackupEntry) error {
-	openstackClient, err := openstackclient.NewStorageClientFromSecretRef(ctx, a.client, be.Spec.SecretRef, be.Spec.Region)
+	config, err := helper.BackupBucketConfigFromRawExtension(be.Spec.ProviderConfig)
+	if err != nil {
+		return err
+	}
+
+	openstackClient, err := openstackclient.NewStorageClientFromSecretRef(ctx, a.client, helper.BackupCredentialsSecretRef(config, be.Spec.SecretRef), be.Spec.Region)
 	if err != nil {
 		return util.DetermineError(err, helper.KnownCodes)
 	}
@@ -16,6 +16,7 @@ import (
 	ctrlerror "github.com/gardener/gardener/pkg/controllerutils/reconciler"
 	"github.com/go-logr/logr"
 
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/config"
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/helper"
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
 	openstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
@@ -27,7 +28,7 @@ func (a *actuator) Delete(ctx context.Context, log logr.Logger, bastion *extensi
 		return err
 	}
 
-	credentials, err := openstack.GetCredentials(ctx, a.client, opt.SecretReference, false)
+	credentials, err := openstack.GetShootCredentials(ctx, a.client, opt.SecretReference)
 	if err != nil {
 		return fmt.Errorf("could not get Openstack credentials: %w", err)
 	}
@@ -47,6 +48,12 @@ func (a *actuator) Delete(ctx context.Context, log logr.Logger, bastion *extensi
 		return util.DetermineError(err, helper.KnownCodes)
 	}
 
+	if a.bastionConfig != nil && a.bastionConfig.DNS != nil {
+		if err := removeDNSRecord(ctx, log, openstackClientFactory, a.bastionConfig.DNS, opt); err != nil {
+			return util.DetermineError(fmt.Errorf("failed to remove DNS record: %w", err), helper.KnownCodes)
+		}
+	}
+
 	err = removeBastionInstance(log, computeClient, opt)
 	if err != nil {
 		return util.DetermineError(fmt.Errorf("failed to remove bastion instance: %w", err), helper.KnownCodes)
@@ -77,6 +84,20 @@ func (a *actuator) ForceDelete(_ context.Context, _ logr.Logger, _ *extensionsv1
 	return nil
 }
 
+func removeDNSRecord(ctx context.Context, log logr.Logger, factory openstackclient.Factory, dnsConfig *config.BastionDNS, opt *Options) error {
+	dnsClient, zoneID, err := getDNSZone(ctx, log, factory, dnsConfig)
+	if err != nil || dnsClient == nil || zoneID == "" {
+		return err
+	}
+
+	hostname := dnsRecordName(opt, dnsConfig)
+	if err := dnsClient.DeleteRecordSet(ctx, zoneID, hostname, "A"); err != nil {
+		return err
+	}
+	log.Info("DNS record removed", "hostname", hostname)
+	return nil
+}
+
 func removeBastionInstance(log logr.Logger, client openstackclient.Compute, opt *Options) error {
 	instances, err := getBastionInstance(client, opt.BastionInstanceName)
 	if openstackclient.IgnoreNotFoundError(err) != nil {
@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/gardener/gardener/extensions/pkg/controller"
@@ -23,6 +24,7 @@ import (
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/rules"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/config"
@@ -57,7 +59,7 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, bastion *exte
 		return err
 	}
 
-	credentials, err := openstack.GetCredentials(ctx, a.client, opt.SecretReference, false)
+	credentials, err := openstack.GetShootCredentials(ctx, a.client, opt.SecretReference)
 	if err != nil {
 		return fmt.Errorf("could not get Openstack credentials: %w", err)
 	}
@@ -147,6 +149,14 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, bastion *exte
 		}
 	}
 
+	if a.bastionConfig.DNS != nil {
+		hostname, err := ensureDNSRecord(ctx, log, openstackClientFactory, a.bastionConfig.DNS, opt, endpoints.public.IP)
+		if err != nil {
+			return util.DetermineError(err, helper.KnownCodes)
+		}
+		endpoints.public.Hostname = hostname
+	}
+
 	// once a public endpoint is available, publish the endpoint on the
 	// Bastion resource to notify upstream about the ready instance
 	patch := client.MergeFrom(bastion.DeepCopy())
@@ -303,6 +313,45 @@ func addressToIngress(dnsName *string, ipAddress *string) *corev1.LoadBalancerIn
 	return ingress
 }
 
+// ensureDNSRecord registers an A record for the given public IP of the bastion host in the Designate zone of the given
+// config and returns its hostname. No record is registered and an empty hostname is returned if Designate is not
+// available.
+func ensureDNSRecord(ctx context.Context, log logr.Logger, factory openstackclient.Factory, dnsConfig *config.BastionDNS, opt *Options, ip string) (string, error) {
+	dnsClient, zoneID, err := getDNSZone(ctx, log, factory, dnsConfig)
+	if err != nil || dnsClient == nil {
+		return "", err
+	}
+	if zoneID == "" {
+		return "", fmt.Errorf("DNS zone %q for bastions is not visible to the project of the shoot", dnsConfig.Zone)
+	}
+
+	hostname := dnsRecordName(opt, dnsConfig)
+	if err := dnsClient.CreateOrUpdateRecordSet(ctx, zoneID, hostname, "A", []string{ip}, int(pointer.Int32Deref(dnsConfig.TTL, defaultDNSRecordTTL))); err != nil {
+		return "", fmt.Errorf("could not register DNS record %q for bastion: %w", hostname, err)
+	}
+	log.Info("DNS record registered", "hostname", hostname, "ip", ip)
+	return hostname, nil
+}
+
+// getDNSZone returns a DNS client and the id of the Designate zone of the given config, which is empty if the zone is not
+// visible to the project of the shoot. A nil client is returned if Designate is not available.
+func getDNSZone(ctx context.Context, log logr.Logger, factory openstackclient.Factory, dnsConfig *config.BastionDNS) (openstackclient.DNS, string, error) {
+	dnsClient, err := factory.DNS()
+	if err != nil {
+		if openstackclient.IsEndpointNotFoundError(err) {
+			log.Info("Designate is not available, the bastion is published without DNS record")
+			return nil, "", nil
+		}
+		return nil, "", err
+	}
+
+	zones, err := dnsClient.GetZones(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("could not get DNS zones: %w", err)
+	}
+	return dnsClient, zones[strings.TrimSuffix(dnsConfig.Zone, ".")], nil
+}
+
 func ensureAssociateFIPWithInstance(client openstackclient.Compute, instance *servers.Server, floatingIP *floatingips.FloatingIP) error {
 	fipid, err := findFloatingIDByInstanceID(client, instance.ID)
 	if err != nil {
@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bastion
+
+import (
+	"bytes"
+	"fmt"
+
+	"k8s.io/utils/pointer"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+)
+
+// sshdAuditConfigPath is the path of the configuration file of the SSH daemon of audited bastion hosts. It is included
+// first, so that its settings take precedence over the ones of the image.
+const sshdAuditConfigPath = "/etc/ssh/sshd_config.d/00-gardener-audit.conf"
+
+// auditedUserData appends the configuration of the auditing of the SSH sessions to the given user data of a bastion
+// host, which gardener renders as shell script. The user data is returned unchanged if no audit is configured.
+func auditedUserData(userData []byte, audit *api.BastionAudit) ([]byte, error) {
+	if audit == nil {
+		return userData, nil
+	}
+	if !bytes.HasPrefix(userData, []byte("#!")) {
+		return nil, fmt.Errorf("bastion user data must be a shell script to configure the audit of the SSH sessions")
+	}
+
+	buf := bytes.NewBuffer(bytes.TrimRight(userData, "\n"))
+	buf.WriteString("\n\n# audit of the SSH sessions via the bastion host\n")
+	buf.WriteString("mkdir -p /etc/ssh/sshd_config.d\n")
+	fmt.Fprintf(buf, "cat > %s <<'EOF'\n", sshdAuditConfigPath)
+	fmt.Fprintf(buf, "LogLevel %s\n", pointer.StringDeref(audit.LogLevel, api.DefaultBastionAuditLogLevel))
+	if audit.ForceCommand != nil {
+		fmt.Fprintf(buf, "ForceCommand %s\n", *audit.ForceCommand)
+	}
+	buf.WriteString("EOF\n")
+	buf.WriteString("grep -q '^Include /etc/ssh/sshd_config.d/\\*.conf' /etc/ssh/sshd_config || sed -i '1i Include /etc/ssh/sshd_config.d/*.conf' /etc/ssh/sshd_config\n")
+	buf.WriteString("systemctl restart ssh.service || systemctl restart sshd.service\n")
+	if audit.UserData != nil {
+		buf.WriteString("\n")
+		buf.WriteString(*audit.UserData)
+		buf.WriteString("\n")
+	}
+	return buf.Bytes(), nil
+}
@@ -5,6 +5,8 @@
 package bastion
 
 import (
+	"context"
+	"encoding/base64"
 	"testing"
 
 	"github.com/gardener/gardener/extensions/pkg/controller"
@@ -12,16 +14,21 @@ import (
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/extensions"
+	"github.com/go-logr/logr"
+	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/rules"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/pointer"
 
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/config"
 	openstackv1alpha1 "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/v1alpha1"
+	mockopenstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client/mocks"
 )
 
 func TestBastion(t *testing.T) {
@@ -55,6 +62,49 @@ var _ = Describe("Bastion", func() {
 			}))
 			Expect(options.Region).To(Equal("eu-nl-1"))
 		})
+
+		It("should append the audit of the SSH sessions to the user data", func() {
+			bastion.Spec.UserData = []byte("#!/bin/bash -eu\nid gardener || useradd gardener -mU\n")
+			cluster.CloudProfile.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "openstack.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "CloudProfileConfig",
+"keystoneURL": "https://keystone.example.com/v3/",
+"bastion": {"audit": {"forceCommand": "/usr/local/bin/record-session", "userData": "systemctl enable --now audit-forwarder"}}
+}`)}
+
+			options, err := DetermineOptions(bastion, cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			userData, err := base64.StdEncoding.DecodeString(string(options.UserData))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(userData)).To(Equal(`#!/bin/bash -eu
+id gardener || useradd gardener -mU
+
+# audit of the SSH sessions via the bastion host
+mkdir -p /etc/ssh/sshd_config.d
+cat > /etc/ssh/sshd_config.d/00-gardener-audit.conf <<'EOF'
+LogLevel VERBOSE
+ForceCommand /usr/local/bin/record-session
+EOF
+grep -q '^Include /etc/ssh/sshd_config.d/\*.conf' /etc/ssh/sshd_config || sed -i '1i Include /etc/ssh/sshd_config.d/*.conf' /etc/ssh/sshd_config
+systemctl restart ssh.service || systemctl restart sshd.service
+
+systemctl enable --now audit-forwarder
+`))
+		})
+
+		It("should fail to audit the SSH sessions if the user data is no shell script", func() {
+			bastion.Spec.UserData = []byte("#cloud-config\n")
+			cluster.CloudProfile.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "openstack.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "CloudProfileConfig",
+"keystoneURL": "https://keystone.example.com/v3/",
+"bastion": {"audit": {}}
+}`)}
+
+			_, err := DetermineOptions(bastion, cluster)
+			Expect(err).To(MatchError(ContainSubstring("must be a shell script")))
+		})
 	})
 
 	Describe("#ingressPermissions", func() {
@@ -200,6 +250,70 @@ var _ = Describe("Bastion", func() {
 			Expect(ruleEqual(c, b)).To(BeFalse())
 		})
 	})
+
+	Describe("#ensureDNSRecord,#removeDNSRecord", func() {
+		var (
+			ctx       = context.TODO()
+			log       = logr.Discard()
+			ctrl      *gomock.Controller
+			factory   *mockopenstackclient.MockFactory
+			dnsClient *mockopenstackclient.MockDNS
+			dnsConfig *config.BastionDNS
+			opt       *Options
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			factory = mockopenstackclient.NewMockFactory(ctrl)
+			dnsClient = mockopenstackclient.NewMockDNS(ctrl)
+			dnsConfig = &config.BastionDNS{Zone: "bastion.example.com.", TTL: pointer.Int32(60)}
+
+			var err error
+			opt, err = DetermineOptions(bastion, cluster)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should register the public IP in the zone", func() {
+			factory.EXPECT().DNS().Return(dnsClient, nil)
+			dnsClient.EXPECT().GetZones(ctx).Return(map[string]string{"example.com": "zone-1", "bastion.example.com": "zone-2"}, nil)
+			dnsClient.EXPECT().CreateOrUpdateRecordSet(ctx, "zone-2", "cluster1-bastionname1-bastion-1cdc8.bastion.example.com", "A", []string{"1.2.3.4"}, 60)
+
+			hostname, err := ensureDNSRecord(ctx, log, factory, dnsConfig, opt, "1.2.3.4")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hostname).To(Equal("cluster1-bastionname1-bastion-1cdc8.bastion.example.com"))
+		})
+
+		It("should not register a record if Designate is not available", func() {
+			factory.EXPECT().DNS().Return(nil, &gophercloud.ErrEndpointNotFound{})
+
+			hostname, err := ensureDNSRecord(ctx, log, factory, dnsConfig, opt, "1.2.3.4")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hostname).To(BeEmpty())
+		})
+
+		It("should fail if the zone is not visible", func() {
+			factory.EXPECT().DNS().Return(dnsClient, nil)
+			dnsClient.EXPECT().GetZones(ctx).Return(map[string]string{"example.com": "zone-1"}, nil)
+
+			_, err := ensureDNSRecord(ctx, log, factory, dnsConfig, opt, "1.2.3.4")
+			Expect(err).To(MatchError(ContainSubstring("not visible")))
+		})
+
+		It("should remove the record", func() {
+			factory.EXPECT().DNS().Return(dnsClient, nil)
+			dnsClient.EXPECT().GetZones(ctx).Return(map[string]string{"bastion.example.com": "zone-2"}, nil)
+			dnsClient.EXPECT().DeleteRecordSet(ctx, "zone-2", "cluster1-bastionname1-bastion-1cdc8.bastion.example.com", "A")
+
+			Expect(removeDNSRecord(ctx, log, factory, dnsConfig, opt)).To(Succeed())
+		})
+
+		It("should not fail the removal if the zone is not visible", func() {
+			factory.EXPECT().DNS().Return(dnsClient, nil)
+			dnsClient.EXPECT().GetZones(ctx).Return(map[string]string{}, nil)
+
+			Expect(removeDNSRecord(ctx, log, factory, dnsConfig, opt)).To(Succeed())
+		})
+	})
 })
 
 func createTestBastion() *extensionsv1alpha1.Bastion {
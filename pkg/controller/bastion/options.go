@@ -9,17 +9,23 @@ import (
 	"encoding/base64"
 	"fmt"
 	"net"
+	"strings"
 
 	"github.com/gardener/gardener/extensions/pkg/controller"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/rules"
 	corev1 "k8s.io/api/core/v1"
+
+	controllerconfig "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/config"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/helper"
 )
 
 const (
 	//maxLengthForBaseName for "base" name due to fact that we use this name to name other openstack resources,
 	maxLengthForBaseName = 33
+	// defaultDNSRecordTTL is the time to live of the DNS records of bastion hosts in seconds if none is configured.
+	defaultDNSRecordTTL = 300
 )
 
 // Options contains provider-related information required for setting up
@@ -46,6 +52,17 @@ func DetermineOptions(bastion *extensionsv1alpha1.Bastion, cluster *controller.C
 		return nil, err
 	}
 
+	cloudProfileConfig, err := helper.CloudProfileConfigFromCluster(cluster)
+	if err != nil {
+		return nil, err
+	}
+	userData := bastion.Spec.UserData
+	if cloudProfileConfig != nil && cloudProfileConfig.Bastion != nil {
+		if userData, err = auditedUserData(userData, cloudProfileConfig.Bastion.Audit); err != nil {
+			return nil, err
+		}
+	}
+
 	secretReference := corev1.SecretReference{
 		Namespace: clusterName,
 		Name:      v1beta1constants.SecretNameCloudProvider,
@@ -57,7 +74,7 @@ func DetermineOptions(bastion *extensionsv1alpha1.Bastion, cluster *controller.C
 		SecretReference:     secretReference,
 		SecurityGroup:       securityGroupName(baseResourceName),
 		Region:              region,
-		UserData:            []byte(base64.StdEncoding.EncodeToString(bastion.Spec.UserData)),
+		UserData:            []byte(base64.StdEncoding.EncodeToString(userData)),
 	}, nil
 }
 
@@ -127,3 +144,8 @@ func ingressAllowSSHResourceName(baseName string) string {
 func egressAllowOnlyResourceName(baseName string) string {
 	return fmt.Sprintf("%s-egress-worker", baseName)
 }
+
+// dnsRecordName is the name of the DNS record of the bastion host in the zone of the given config
+func dnsRecordName(opt *Options, dnsConfig *controllerconfig.BastionDNS) string {
+	return fmt.Sprintf("%s.%s", strings.ToLower(opt.BastionInstanceName), strings.TrimSuffix(dnsConfig.Zone, "."))
+}
@@ -14,8 +14,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
-	"github.com/gardener/gardener-extension-provider-openstack/imagevector"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/config"
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+	openstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
 )
 
 var (
@@ -31,6 +32,10 @@ type AddOptions struct {
 	IgnoreOperationAnnotation bool
 	// WebhookServerNamespace is the namespace in which the webhook server runs.
 	WebhookServerNamespace string
+	// EgressRestriction is the config for restricting the egress traffic of the control plane components.
+	EgressRestriction config.EgressRestriction
+	// ControlPlaneScheduling is the config for the scheduling of the control plane components.
+	ControlPlaneScheduling config.ControlPlaneScheduling
 }
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
@@ -39,8 +44,8 @@ func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, opts AddO
 	genericActuator, err := genericactuator.NewActuator(mgr, openstack.Name,
 		secretConfigsFunc, shootAccessSecretsFunc, nil, nil,
 		configChart, controlPlaneChart, controlPlaneShootChart, controlPlaneShootCRDsChart, storageClassChart, nil,
-		NewValuesProvider(mgr), extensionscontroller.ChartRendererFactoryFunc(util.NewChartRendererForShoot),
-		imagevector.ImageVector(), "", nil, opts.WebhookServerNamespace)
+		NewValuesProvider(mgr, openstackclient.FactoryFactoryFunc(openstackclient.NewOpenstackClientFromCredentials), opts.EgressRestriction, opts.ControlPlaneScheduling), extensionscontroller.ChartRendererFactoryFunc(util.NewChartRendererForShoot),
+		ImageVector, "", nil, opts.WebhookServerNamespace)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"reflect"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/utils/imagevector"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// getWorkerArchitectures returns the sorted architectures of the worker pools of the shoot.
+func getWorkerArchitectures(cluster *extensionscontroller.Cluster) []string {
+	architectures := sets.New[string]()
+	for _, worker := range cluster.Shoot.Spec.Provider.Workers {
+		if worker.Machine.Architecture != nil {
+			architectures.Insert(*worker.Machine.Architecture)
+		} else {
+			architectures.Insert(v1beta1constants.ArchitectureAMD64)
+		}
+	}
+	if architectures.Len() == 0 {
+		architectures.Insert(v1beta1constants.ArchitectureAMD64)
+	}
+	return sets.List(architectures)
+}
+
+// getNodeDaemonSetsValues returns the values for the daemon sets of the node component with the given name and images.
+// The images are looked up for every architecture of the worker pools. All architectures for which the same images as
+// for amd64 are found, e.g. because they are multi-arch images, share a daemon set having the name of the component.
+// Every other architecture gets its own daemon set suffixed with the architecture. The daemon sets are restricted to the
+// nodes of their architectures via node affinity.
+func getNodeDaemonSetsValues(
+	imageVector imagevector.ImageVector,
+	name string,
+	imageNames []string,
+	cluster *extensionscontroller.Cluster,
+) (
+	[]interface{},
+	error,
+) {
+	version := cluster.Shoot.Spec.Kubernetes.Version
+	findImages := func(architecture string) (map[string]interface{}, error) {
+		images, err := imagevector.FindImages(imageVector, imageNames, imagevector.RuntimeVersion(version), imagevector.TargetVersion(version), imagevector.Architecture(architecture))
+		if err != nil {
+			return nil, err
+		}
+		return imagevector.ImageMapToValues(images), nil
+	}
+
+	defaultImages, err := findImages(v1beta1constants.ArchitectureAMD64)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		defaultArchitectures []string
+		daemonSets           []interface{}
+	)
+	for _, architecture := range getWorkerArchitectures(cluster) {
+		images, err := findImages(architecture)
+		if err != nil {
+			return nil, err
+		}
+		if reflect.DeepEqual(images, defaultImages) {
+			defaultArchitectures = append(defaultArchitectures, architecture)
+			continue
+		}
+		daemonSets = append(daemonSets, map[string]interface{}{
+			"name":          name + "-" + architecture,
+			"architecture":  architecture,
+			"architectures": []string{architecture},
+			"images":        images,
+		})
+	}
+
+	if len(defaultArchitectures) > 0 {
+		daemonSets = append([]interface{}{map[string]interface{}{
+			"name":          name,
+			"architectures": defaultArchitectures,
+			"images":        defaultImages,
+		}}, daemonSets...)
+	}
+	return daemonSets, nil
+}
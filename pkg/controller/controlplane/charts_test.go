@@ -0,0 +1,174 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/gardener/gardener/pkg/chartrenderer"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/apimachinery/pkg/version"
+
+	"github.com/gardener/gardener-extension-provider-openstack/charts"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+)
+
+var _ = Describe("Shoot system components chart", func() {
+	var (
+		clusterRoles        map[string]*rbacv1.ClusterRole
+		roles               map[string]*rbacv1.Role
+		clusterRoleBindings map[string]*rbacv1.ClusterRoleBinding
+		roleBindings        map[string]*rbacv1.RoleBinding
+	)
+
+	BeforeEach(func() {
+		renderer := chartrenderer.NewWithServerVersion(&version.Info{GitVersion: "v1.28.0"})
+		release, err := renderer.RenderEmbeddedFS(charts.InternalChart, controlPlaneShootChart.Path, controlPlaneShootChart.Name, "kube-system", map[string]interface{}{
+			openstack.CloudControllerManagerName: map[string]interface{}{"enabled": true},
+			openstack.CSINodeName:                map[string]interface{}{"enabled": true},
+			openstack.CSIDriverManila:            map[string]interface{}{"enabled": true},
+			openstack.NodeLabelerName:            map[string]interface{}{"enabled": true},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		clusterRoles = map[string]*rbacv1.ClusterRole{}
+		roles = map[string]*rbacv1.Role{}
+		clusterRoleBindings = map[string]*rbacv1.ClusterRoleBinding{}
+		roleBindings = map[string]*rbacv1.RoleBinding{}
+
+		decoder := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(release.Manifest()), 1024)
+		for {
+			obj := &unstructured.Unstructured{}
+			if err := decoder.Decode(&obj.Object); err != nil {
+				Expect(errors.Is(err, io.EOF)).To(BeTrue(), err.Error())
+				break
+			}
+
+			var into interface{}
+			switch obj.GetKind() {
+			case "ClusterRole":
+				clusterRoles[obj.GetName()] = &rbacv1.ClusterRole{}
+				into = clusterRoles[obj.GetName()]
+			case "Role":
+				roles[obj.GetName()] = &rbacv1.Role{}
+				into = roles[obj.GetName()]
+			case "ClusterRoleBinding":
+				clusterRoleBindings[obj.GetName()] = &rbacv1.ClusterRoleBinding{}
+				into = clusterRoleBindings[obj.GetName()]
+			case "RoleBinding":
+				roleBindings[obj.GetName()] = &rbacv1.RoleBinding{}
+				into = roleBindings[obj.GetName()]
+			default:
+				continue
+			}
+			Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, into)).To(Succeed())
+		}
+	})
+
+	It("should not grant wildcard permissions", func() {
+		for name, clusterRole := range clusterRoles {
+			Expect(clusterRole.Rules).NotTo(ContainElement(haveWildcard()), "cluster role %s", name)
+		}
+		for name, role := range roles {
+			Expect(role.Rules).NotTo(ContainElement(haveWildcard()), "role %s", name)
+		}
+	})
+
+	It("should only bind the roles of the chart to dedicated service accounts", func() {
+		for name, binding := range clusterRoleBindings {
+			Expect(clusterRoles).To(HaveKey(binding.RoleRef.Name), "cluster role binding %s", name)
+			Expect(binding.Subjects).To(HaveEach(beKubeSystemServiceAccount()), "cluster role binding %s", name)
+		}
+		for name, binding := range roleBindings {
+			Expect(roles).To(HaveKey(binding.RoleRef.Name), "role binding %s", name)
+			Expect(binding.Subjects).To(HaveEach(beKubeSystemServiceAccount()), "role binding %s", name)
+		}
+	})
+
+	It("should bind the cloud-controller-manager to its own cluster role", func() {
+		binding := clusterRoleBindings[openstack.UsernamePrefix+openstack.CloudControllerManagerName]
+		Expect(binding).NotTo(BeNil())
+		Expect(binding.RoleRef.Name).To(Equal(openstack.UsernamePrefix + openstack.CloudControllerManagerName))
+		Expect(binding.Subjects).To(ConsistOf(rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: openstack.CloudControllerManagerName, Namespace: "kube-system"}))
+	})
+
+	DescribeTable("should not grant access to secrets cluster-wide",
+		func(name string) {
+			Expect(clusterRoles).To(HaveKey(openstack.UsernamePrefix + name))
+			Expect(clusterRoles[openstack.UsernamePrefix+name].Rules).NotTo(ContainElement(grantAccessTo("secrets")))
+		},
+		Entry("csi-driver-node", openstack.CSIDriverName),
+		Entry("csi-driver-manila-node", openstack.CSIManilaNodeName),
+		Entry("csi-provisioner", openstack.CSIProvisionerName),
+		Entry("csi-attacher", openstack.CSIAttacherName),
+		Entry("csi-snapshotter", openstack.CSISnapshotterName),
+		Entry("csi-resizer", openstack.CSIResizerName),
+		Entry("csi-snapshot-controller", openstack.CSISnapshotControllerName),
+		Entry("csi-snapshot-validation", openstack.CSISnapshotValidationName),
+		Entry("openstack-node-labeler", openstack.NodeLabelerName),
+	)
+
+	It("should only grant access to the secret of CSI Manila", func() {
+		role := roles[openstack.UsernamePrefix+openstack.CSIManilaSecret]
+		Expect(role).NotTo(BeNil())
+		Expect(role.Rules).To(ConsistOf(rbacv1.PolicyRule{APIGroups: []string{""}, Resources: []string{"secrets"}, Verbs: []string{"get"}, ResourceNames: []string{"manila-csi-plugin"}}))
+	})
+})
+
+var _ = Describe("Shoot access secrets", func() {
+	It("should issue a token for a dedicated service account per component", func() {
+		serviceAccountNames := sets.New[string]()
+		for _, secret := range shootAccessSecretsFunc(namespace) {
+			Expect(serviceAccountNames.Has(secret.ServiceAccountName)).To(BeFalse(), "service account %s", secret.ServiceAccountName)
+			Expect(secret.Secret.Namespace).To(Equal(namespace))
+			Expect(secret.Secret.Name).To(Equal("shoot-access-" + secret.ServiceAccountName))
+			serviceAccountNames.Insert(secret.ServiceAccountName)
+		}
+
+		Expect(sets.List(serviceAccountNames)).To(ConsistOf(
+			openstack.CloudControllerManagerName,
+			openstack.CSIProvisionerName,
+			openstack.CSIAttacherName,
+			openstack.CSISnapshotterName,
+			openstack.CSIResizerName,
+			openstack.CSISnapshotControllerName,
+			openstack.CSISnapshotValidationName,
+			openstack.NodeLabelerName,
+		))
+	})
+})
+
+func haveWildcard() OmegaMatcher {
+	return Satisfy(func(rule rbacv1.PolicyRule) bool {
+		for _, values := range [][]string{rule.APIGroups, rule.Resources, rule.Verbs, rule.NonResourceURLs} {
+			for _, value := range values {
+				if strings.Contains(value, rbacv1.ResourceAll) {
+					return true
+				}
+			}
+		}
+		return false
+	})
+}
+
+func grantAccessTo(resource string) OmegaMatcher {
+	return HaveField("Resources", ContainElement(resource))
+}
+
+func beKubeSystemServiceAccount() OmegaMatcher {
+	return And(
+		HaveField("Kind", rbacv1.ServiceAccountKind),
+		HaveField("Namespace", "kube-system"),
+	)
+}
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"sort"
+
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+	openstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
+)
+
+// lookupIP is exposed for testing.
+var lookupIP = net.LookupIP
+
+// getEgressCIDRs returns the CIDRs the shoot control plane components talking to OpenStack are allowed to egress to,
+// i.e. the addresses of the endpoints in the service catalog of the given region and the given additional CIDRs.
+func getEgressCIDRs(factory openstackclient.FactoryFactory, credentials *openstack.Credentials, region string, additionalCIDRs []string) ([]string, error) {
+	client, err := factory.NewFactory(credentials)
+	if err != nil {
+		return nil, fmt.Errorf("could not create OpenStack client: %w", err)
+	}
+
+	urls, err := client.ServiceEndpoints(openstackclient.WithRegion(region))
+	if err != nil {
+		return nil, fmt.Errorf("could not get endpoints from service catalog: %w", err)
+	}
+
+	return resolveEgressCIDRs(urls, additionalCIDRs)
+}
+
+// resolveEgressCIDRs resolves the hosts of the given URLs and returns a sorted list of the single address CIDRs of the
+// hosts together with the given additional CIDRs.
+func resolveEgressCIDRs(urls []string, additionalCIDRs []string) ([]string, error) {
+	cidrs := map[string]struct{}{}
+	for _, cidr := range additionalCIDRs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid additional CIDR %q: %w", cidr, err)
+		}
+		cidrs[ipNet.String()] = struct{}{}
+	}
+
+	for _, rawURL := range urls {
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid endpoint URL %q: %w", rawURL, err)
+		}
+		if u.Hostname() == "" {
+			continue
+		}
+
+		ips := []net.IP{net.ParseIP(u.Hostname())}
+		if ips[0] == nil {
+			if ips, err = lookupIP(u.Hostname()); err != nil {
+				return nil, fmt.Errorf("could not resolve host of endpoint %q: %w", rawURL, err)
+			}
+		}
+		for _, ip := range ips {
+			cidrs[ipToCIDR(ip)] = struct{}{}
+		}
+	}
+
+	result := make([]string, 0, len(cidrs))
+	for cidr := range cidrs {
+		result = append(result, cidr)
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+func ipToCIDR(ip net.IP) string {
+	if ip4 := ip.To4(); ip4 != nil {
+		return ip4.String() + "/32"
+	}
+	return ip.String() + "/128"
+}
@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"fmt"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/helper"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+	openstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/utils"
+)
+
+// getAdditionalFloatingPools returns the additional floating pools of the InfrastructureConfig of the shoot.
+func getAdditionalFloatingPools(cluster *extensionscontroller.Cluster) ([]api.AdditionalFloatingPool, error) {
+	if cluster.Shoot.Spec.Provider.InfrastructureConfig == nil {
+		return nil, nil
+	}
+	infraConfig, err := helper.InfrastructureConfigFromRawExtension(cluster.Shoot.Spec.Provider.InfrastructureConfig)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode infrastructure config of shoot: %w", err)
+	}
+	return infraConfig.AdditionalFloatingPools, nil
+}
+
+// getAdditionalFloatingClassValues returns the values of the load balancer classes mapped to the given additional
+// floating pools. The cloud provider config only accepts network IDs, hence the networks of the pools are looked up
+// by name.
+func getAdditionalFloatingClassValues(
+	factory openstackclient.FactoryFactory,
+	credentials *openstack.Credentials,
+	region string,
+	pools []api.AdditionalFloatingPool,
+	cloudProfileConfig *api.CloudProfileConfig,
+) (
+	[]map[string]interface{},
+	error,
+) {
+	if len(pools) == 0 {
+		return nil, nil
+	}
+
+	client, err := factory.NewFactory(credentials)
+	if err != nil {
+		return nil, fmt.Errorf("could not create OpenStack client: %w", err)
+	}
+	networking, err := client.Networking(openstackclient.WithRegion(region))
+	if err != nil {
+		return nil, fmt.Errorf("could not create OpenStack networking client: %w", err)
+	}
+
+	var classes []map[string]interface{}
+	for _, pool := range pools {
+		network, err := networking.GetExternalNetworkByName(pool.Name)
+		if err != nil {
+			return nil, fmt.Errorf("could not get external network for floating pool %q: %w", pool.Name, err)
+		}
+		if network == nil {
+			return nil, fmt.Errorf("external network for floating pool %q not found", pool.Name)
+		}
+
+		values := map[string]interface{}{
+			"name":              pool.LoadBalancerClass,
+			"floatingNetworkID": network.ID,
+		}
+		floatingSubnetName := pool.FloatingSubnetName
+		if floatingSubnetName == nil {
+			if floatingPool, err := helper.FindFloatingPool(cloudProfileConfig.Constraints.FloatingPools, pool.Name, region, nil); err == nil {
+				floatingSubnetName = floatingPool.DefaultFloatingSubnet
+			}
+		}
+		utils.SetStringValue(values, "floatingSubnetName", floatingSubnetName)
+
+		classes = append(classes, values)
+	}
+	return classes, nil
+}
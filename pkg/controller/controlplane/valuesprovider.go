@@ -29,6 +29,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	policyv1beta1 "k8s.io/api/policy/v1beta1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	storagev1 "k8s.io/api/storage/v1"
@@ -40,14 +41,19 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/util/sets"
 	autoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
+	"k8s.io/component-base/version"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/gardener/gardener-extension-provider-openstack/charts"
+	"github.com/gardener/gardener-extension-provider-openstack/imagevector"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/config"
 	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/helper"
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/internal/infrastructure"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/nodelabeler"
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+	openstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/utils"
 )
 
@@ -57,6 +63,25 @@ const (
 	csiSnapshotValidationServerName  = openstack.CSISnapshotValidationName + "-server"
 )
 
+var (
+	// ImageVector is exposed for testing.
+	ImageVector = imagevector.ImageVector()
+
+	// csiNodeImages are the images of the csi-driver-node daemon set.
+	csiNodeImages = []string{
+		openstack.CSIDriverCinderImageName,
+		openstack.CSINodeDriverRegistrarImageName,
+		openstack.CSILivenessProbeImageName,
+	}
+	// csiManilaNodeImages are the images of the csi-driver-manila-node daemon set.
+	csiManilaNodeImages = []string{
+		openstack.CSIDriverManilaImageName,
+		openstack.CSIDriverNFSImageName,
+		openstack.CSINodeDriverRegistrarImageName,
+		openstack.CSILivenessProbeImageName,
+	}
+)
+
 func secretConfigsFunc(namespace string) []extensionssecretsmanager.SecretConfigWithOptions {
 	return []extensionssecretsmanager.SecretConfigWithOptions{
 		{
@@ -101,6 +126,7 @@ func shootAccessSecretsFunc(namespace string) []*gutil.AccessSecret {
 		gutil.NewShootAccessSecret(openstack.CSIResizerName, namespace),
 		gutil.NewShootAccessSecret(openstack.CSISnapshotControllerName, namespace),
 		gutil.NewShootAccessSecret(openstack.CSISnapshotValidationName, namespace),
+		gutil.NewShootAccessSecret(openstack.NodeLabelerName, namespace),
 	}
 }
 
@@ -125,6 +151,9 @@ var (
 		Name:       "seed-controlplane",
 		EmbeddedFS: charts.InternalChart,
 		Path:       filepath.Join(charts.InternalChartsPath, "seed-controlplane"),
+		Objects: []*chart.Object{
+			{Type: &networkingv1.NetworkPolicy{}, Name: "allow-to-openstack-endpoints"},
+		},
 		SubCharts: []*chart.Chart{
 			{
 				Name:   openstack.CloudControllerManagerName,
@@ -175,6 +204,13 @@ var (
 					// csi-driver-manila-controller
 					{Type: &appsv1.Deployment{}, Name: openstack.CSIDriverManilaController},
 					{Type: &autoscalingv1.VerticalPodAutoscaler{}, Name: openstack.CSIDriverManilaController},
+					{Type: &policyv1.PodDisruptionBudget{}, Name: openstack.CSIDriverManilaController},
+				},
+			},
+			{
+				Name: openstack.NodeLabelerName,
+				Objects: []*chart.Object{
+					{Type: &appsv1.Deployment{}, Name: openstack.NodeLabelerName},
 				},
 			},
 		},
@@ -193,12 +229,8 @@ var (
 				},
 			},
 			{
-				Name: openstack.CSINodeName,
-				Images: []string{
-					openstack.CSIDriverCinderImageName,
-					openstack.CSINodeDriverRegistrarImageName,
-					openstack.CSILivenessProbeImageName,
-				},
+				Name:   openstack.CSINodeName,
+				Images: csiNodeImages,
 				Objects: []*chart.Object{
 					// csi-driver
 					{Type: &appsv1.DaemonSet{}, Name: openstack.CSINodeName},
@@ -241,13 +273,8 @@ var (
 				},
 			},
 			{
-				Name: openstack.CSIDriverManila,
-				Images: []string{
-					openstack.CSIDriverManilaImageName,
-					openstack.CSIDriverNFSImageName,
-					openstack.CSINodeDriverRegistrarImageName,
-					openstack.CSILivenessProbeImageName,
-				},
+				Name:   openstack.CSIDriverManila,
+				Images: csiManilaNodeImages,
 				Objects: []*chart.Object{
 					{Type: &storagev1.CSIDriver{}, Name: openstack.CSIManilaStorageProvisionerNFS},
 					{Type: &corev1.Secret{}, Name: openstack.CSIManilaNFS},
@@ -268,6 +295,13 @@ var (
 					{Type: extensionscontroller.GetVerticalPodAutoscalerObject(), Name: openstack.CSIManilaNodeName},
 				},
 			},
+			{
+				Name: openstack.NodeLabelerName,
+				Objects: []*chart.Object{
+					{Type: &rbacv1.ClusterRole{}, Name: openstack.UsernamePrefix + openstack.NodeLabelerName},
+					{Type: &rbacv1.ClusterRoleBinding{}, Name: openstack.UsernamePrefix + openstack.NodeLabelerName},
+				},
+			},
 		},
 	}
 
@@ -295,18 +329,41 @@ var (
 )
 
 // NewValuesProvider creates a new ValuesProvider for the generic actuator.
-func NewValuesProvider(mgr manager.Manager) genericactuator.ValuesProvider {
+func NewValuesProvider(
+	mgr manager.Manager,
+	openstackClientFactory openstackclient.FactoryFactory,
+	egressRestriction config.EgressRestriction,
+	scheduling config.ControlPlaneScheduling,
+) genericactuator.ValuesProvider {
+	return NewValuesProviderForClient(mgr.GetClient(), mgr.GetScheme(), openstackClientFactory, egressRestriction, scheduling)
+}
+
+// NewValuesProviderForClient creates a new ValuesProvider reading from the given client, e.g. to render the charts
+// without a manager.
+func NewValuesProviderForClient(
+	c client.Client,
+	scheme *runtime.Scheme,
+	openstackClientFactory openstackclient.FactoryFactory,
+	egressRestriction config.EgressRestriction,
+	scheduling config.ControlPlaneScheduling,
+) genericactuator.ValuesProvider {
 	return &valuesProvider{
-		client:  mgr.GetClient(),
-		decoder: serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
+		client:                 c,
+		decoder:                serializer.NewCodecFactory(scheme, serializer.EnableStrict).UniversalDecoder(),
+		openstackClientFactory: openstackClientFactory,
+		egressRestriction:      egressRestriction,
+		scheduling:             scheduling,
 	}
 }
 
 // valuesProvider is a ValuesProvider that provides OpenStack-specific values for the 2 charts applied by the generic actuator.
 type valuesProvider struct {
 	genericactuator.NoopValuesProvider
-	client  client.Client
-	decoder runtime.Decoder
+	client                 client.Client
+	decoder                runtime.Decoder
+	openstackClientFactory openstackclient.FactoryFactory
+	egressRestriction      config.EgressRestriction
+	scheduling             config.ControlPlaneScheduling
 }
 
 // GetConfigChartValues returns the values for the config chart applied by the generic actuator.
@@ -333,7 +390,7 @@ func (vp *valuesProvider) GetConfigChartValues(
 	}
 
 	// Get credentials
-	credentials, err := openstack.GetCredentials(ctx, vp.client, cp.Spec.SecretRef, false)
+	credentials, err := openstack.GetShootCredentials(ctx, vp.client, cp.Spec.SecretRef)
 	if err != nil {
 		return nil, fmt.Errorf("could not get service account from secret '%s/%s': %w", cp.Spec.SecretRef.Namespace, cp.Spec.SecretRef.Name, err)
 	}
@@ -342,12 +399,24 @@ func (vp *valuesProvider) GetConfigChartValues(
 	if err != nil {
 		return nil, fmt.Errorf("could not determine overlay status: %v", err)
 	}
-	return getConfigChartValues(cpConfig, infraStatus, cloudProfileConfig, overlayEnabled, cp, credentials)
+
+	additionalFloatingPools, err := getAdditionalFloatingPools(cluster)
+	if err != nil {
+		return nil, err
+	}
+	var additionalFloatingClasses []map[string]interface{}
+	if len(additionalFloatingPools) > 0 && cloudProfileConfig != nil {
+		if additionalFloatingClasses, err = getAdditionalFloatingClassValues(vp.openstackClientFactory, credentials, cp.Spec.Region, additionalFloatingPools, cloudProfileConfig); err != nil {
+			return nil, err
+		}
+	}
+
+	return getConfigChartValues(cpConfig, infraStatus, cloudProfileConfig, overlayEnabled, cp, credentials, additionalFloatingClasses)
 }
 
 func (vp *valuesProvider) getInfrastructureStatus(cp *extensionsv1alpha1.ControlPlane) (*api.InfrastructureStatus, error) {
-	infraStatus := &api.InfrastructureStatus{}
-	if _, _, err := vp.decoder.Decode(cp.Spec.InfrastructureProviderStatus.Raw, nil, infraStatus); err != nil {
+	infraStatus, err := helper.InfrastructureStatusFromRaw(cp.Spec.InfrastructureProviderStatus)
+	if err != nil {
 		return nil, fmt.Errorf("could not decode infrastructureProviderStatus of controlplane '%s': %w", kutil.ObjectName(cp), err)
 	}
 	return infraStatus, nil
@@ -393,7 +462,17 @@ func (vp *valuesProvider) GetControlPlaneChartValues(
 	credentials, _ := vp.getCredentials(ctx, cp) // ignore missing credentials
 	userAgentHeaders = vp.getUserAgentHeaders(credentials, cluster)
 
-	return vp.getControlPlaneChartValues(cpConfig, cp, cluster, secretsReader, userAgentHeaders, checksums, scaledDown, credentials)
+	nodeLabelerValues, err := vp.getNodeLabelerChartValues(ctx, cpConfig, cp, cluster, scaledDown)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := vp.getControlPlaneChartValues(cpConfig, cp, cluster, secretsReader, userAgentHeaders, checksums, scaledDown, credentials)
+	if err != nil {
+		return nil, err
+	}
+	values[openstack.NodeLabelerName] = nodeLabelerValues
+	return values, nil
 }
 
 // GetControlPlaneShootChartValues returns the values for the control plane shoot chart applied by the generic actuator.
@@ -427,7 +506,11 @@ func (vp *valuesProvider) GetStorageClassesChartValues(
 			return nil, fmt.Errorf("could not decode providerConfig of controlplane '%s': %w", kutil.ObjectName(controlPlane), err)
 		}
 	}
-	values := make(map[string]interface{})
+	var (
+		values         = make(map[string]interface{})
+		zones          = vp.getAllWorkerPoolsZones(cluster)
+		ignoreVolumeAZ = providerConfig.IgnoreVolumeAZ != nil && *providerConfig.IgnoreVolumeAZ
+	)
 	if providerConfig.StorageClasses != nil && len(providerConfig.StorageClasses) != 0 {
 		allSc := make([]map[string]interface{}, len(providerConfig.StorageClasses))
 		for i, sc := range providerConfig.StorageClasses {
@@ -460,34 +543,50 @@ func (vp *valuesProvider) GetStorageClassesChartValues(
 
 			if sc.VolumeBindingMode != nil && *sc.VolumeBindingMode != "" {
 				storageClassValues["volumeBindingMode"] = sc.VolumeBindingMode
+			} else if helper.IsCinderStorageClass(sc) {
+				storageClassValues["volumeBindingMode"] = storagev1.VolumeBindingWaitForFirstConsumer
+			}
+
+			if helper.IsCinderStorageClass(sc) {
+				allowedZones := zones
+				// The availability zones of volumes can only be matched with the zones of the nodes if Cinder and Nova use
+				// the same zone names.
+				if zone := helper.StorageClassAvailabilityZone(sc); zone != "" && !ignoreVolumeAZ {
+					allowedZones = []string{zone}
+				}
+				storageClassValues["allowedTopologyZones"] = allowedZones
 			}
 
 			allSc[i] = storageClassValues
 		}
 		values["storageclasses"] = allSc
+		values["topologyZoneKey"] = openstack.CSIStorageTopologyZoneKey
 		return values, nil
 	}
 
 	storageclasses := []map[string]interface{}{
 		{
-			"name":              "default",
-			"default":           true,
-			"provisioner":       openstack.CSIStorageProvisioner,
-			"volumeBindingMode": storagev1.VolumeBindingWaitForFirstConsumer,
+			"name":                 "default",
+			"default":              true,
+			"provisioner":          openstack.CSIStorageProvisioner,
+			"volumeBindingMode":    storagev1.VolumeBindingWaitForFirstConsumer,
+			"allowedTopologyZones": zones,
 		},
 		{
-			"name":              "default-class",
-			"provisioner":       openstack.CSIStorageProvisioner,
-			"volumeBindingMode": storagev1.VolumeBindingWaitForFirstConsumer,
+			"name":                 "default-class",
+			"provisioner":          openstack.CSIStorageProvisioner,
+			"volumeBindingMode":    storagev1.VolumeBindingWaitForFirstConsumer,
+			"allowedTopologyZones": zones,
 		}}
 
 	values["storageclasses"] = storageclasses
+	values["topologyZoneKey"] = openstack.CSIStorageTopologyZoneKey
 
 	return values, nil
 }
 
 func (vp *valuesProvider) getCredentials(ctx context.Context, cp *extensionsv1alpha1.ControlPlane) (*openstack.Credentials, error) {
-	return openstack.GetCredentials(ctx, vp.client, cp.Spec.SecretRef, false)
+	return openstack.GetShootCredentials(ctx, vp.client, cp.Spec.SecretRef)
 }
 
 func (vp *valuesProvider) getUserAgentHeaders(
@@ -501,9 +600,13 @@ func (vp *valuesProvider) getUserAgentHeaders(
 	if credentials != nil {
 		if credentials.DomainName != "" {
 			headers = append(headers, credentials.DomainName)
+		} else if credentials.DomainID != "" {
+			headers = append(headers, credentials.DomainID)
 		}
 		if credentials.TenantName != "" {
 			headers = append(headers, credentials.TenantName)
+		} else if credentials.TenantID != "" {
+			headers = append(headers, credentials.TenantID)
 		}
 	}
 
@@ -522,6 +625,7 @@ func getConfigChartValues(
 	isUsingOverlay bool,
 	cp *extensionsv1alpha1.ControlPlane,
 	c *openstack.Credentials,
+	additionalFloatingClasses []map[string]interface{},
 ) (map[string]interface{}, error) {
 	subnet, err := helper.FindSubnetByPurpose(infraStatus.Networks.Subnets, api.PurposeNodes)
 	if err != nil {
@@ -534,7 +638,9 @@ func getConfigChartValues(
 
 	values := map[string]interface{}{
 		"domainName":                  c.DomainName,
+		"domainID":                    c.DomainID,
 		"tenantName":                  c.TenantName,
+		"tenantID":                    c.TenantID,
 		"username":                    c.Username,
 		"password":                    c.Password,
 		"insecure":                    c.Insecure,
@@ -561,6 +667,25 @@ func getConfigChartValues(
 		values["routerID"] = infraStatus.Networks.Router.ID
 	}
 
+	if cpConfig.LoadBalancer != nil {
+		if cpConfig.LoadBalancer.Algorithm != nil {
+			values["lbMethod"] = string(*cpConfig.LoadBalancer.Algorithm)
+		}
+		if cpConfig.LoadBalancer.AvailabilityZone != nil {
+			values["lbAvailabilityZone"] = *cpConfig.LoadBalancer.AvailabilityZone
+		}
+		if cpConfig.LoadBalancer.FlavorID != nil {
+			values["lbFlavorID"] = *cpConfig.LoadBalancer.FlavorID
+		}
+	}
+
+	// Without kube-proxy, the CNI serves the node ports of the load balancer members. Let the cloud-controller-manager
+	// open these ports for the load balancers, so that they do not depend on the NodePort range rules of the nodes
+	// security group.
+	if cpConfig.KubeProxyReplacement != nil && *cpConfig.KubeProxyReplacement {
+		values["manageSecurityGroups"] = true
+	}
+
 	if len(c.CACert) > 0 {
 		values["caCert"] = c.CACert
 	}
@@ -600,7 +725,9 @@ func getConfigChartValues(
 		loadBalancerClasses = append(loadBalancerClasses, *vpnLoadBalancerClass)
 	}
 
-	if loadBalancerClassValues := generateLoadBalancerClassValues(loadBalancerClasses, infraStatus); len(loadBalancerClassValues) > 0 {
+	// The load balancer classes of the additional floating pools are added to the ones of the main floating pool.
+	loadBalancerClassValues := append(generateLoadBalancerClassValues(loadBalancerClasses, infraStatus), additionalFloatingClasses...)
+	if len(loadBalancerClassValues) > 0 {
 		values["floatingClasses"] = loadBalancerClassValues
 	}
 
@@ -693,16 +820,46 @@ func (vp *valuesProvider) getControlPlaneChartValues(
 		return nil, err
 	}
 
+	global := map[string]interface{}{
+		"genericTokenKubeconfigSecretName": extensionscontroller.GenericTokenKubeconfigSecretNameFromCluster(cluster),
+	}
+	if vp.egressRestriction.Enabled {
+		if credentials == nil {
+			return nil, fmt.Errorf("credentials are required to restrict the egress traffic to the OpenStack endpoints")
+		}
+		egressCIDRs, err := getEgressCIDRs(vp.openstackClientFactory, credentials, cp.Spec.Region, vp.egressRestriction.AdditionalCIDRs)
+		if err != nil {
+			return nil, err
+		}
+		global["openstackEndpointCIDRs"] = egressCIDRs
+	}
+	if scheduling := vp.getSchedulingValues(); len(scheduling) > 0 {
+		global["scheduling"] = scheduling
+	}
+
 	return map[string]interface{}{
-		"global": map[string]interface{}{
-			"genericTokenKubeconfigSecretName": extensionscontroller.GenericTokenKubeconfigSecretNameFromCluster(cluster),
-		},
+		"global":                             global,
 		openstack.CloudControllerManagerName: ccm,
 		openstack.CSIControllerName:          csiCinder,
 		openstack.CSIManilaControllerName:    csiManila,
 	}, nil
 }
 
+// getSchedulingValues returns the values for the scheduling of the control plane components as configured by the operator.
+func (vp *valuesProvider) getSchedulingValues() map[string]interface{} {
+	values := map[string]interface{}{}
+	if vp.scheduling.PriorityClassName != nil {
+		values["priorityClassName"] = *vp.scheduling.PriorityClassName
+	}
+	if vp.scheduling.MaxUnavailable != nil {
+		values["maxUnavailable"] = vp.scheduling.MaxUnavailable.String()
+	}
+	if len(vp.scheduling.TopologySpreadKeys) > 0 {
+		values["topologySpreadKeys"] = vp.scheduling.TopologySpreadKeys
+	}
+	return values
+}
+
 // getCCMChartValues collects and returns the CCM chart values.
 func getCCMChartValues(
 	cpConfig *api.ControlPlaneConfig,
@@ -807,7 +964,7 @@ func (vp *valuesProvider) getCSIManilaControllerChartValues(
 		if userAgentHeaders != nil {
 			values["userAgentHeaders"] = userAgentHeaders
 		}
-		if err := vp.addCSIManilaValues(values, cp, cluster, credentials); err != nil {
+		if err := vp.addCSIManilaValues(values, cpConfig, cp, cluster, credentials); err != nil {
 			return nil, err
 		}
 	}
@@ -873,6 +1030,12 @@ func (vp *valuesProvider) getControlPlaneShootChartValues(
 		csiNodeDriverValues["userAgentHeaders"] = userAgentHeader
 	}
 
+	csiNodeDaemonSets, err := getNodeDaemonSetsValues(ImageVector, openstack.CSINodeName, csiNodeImages, cluster)
+	if err != nil {
+		return nil, err
+	}
+	csiNodeDriverValues["daemonSets"] = csiNodeDaemonSets
+
 	csiDriverManilaValues, err := vp.getControlPlaneShootChartCSIManilaValues(cpConfig, cp, cluster, credentials)
 	if err != nil {
 		return nil, err
@@ -882,6 +1045,7 @@ func (vp *valuesProvider) getControlPlaneShootChartValues(
 		openstack.CloudControllerManagerName: map[string]interface{}{"enabled": true},
 		openstack.CSINodeName:                csiNodeDriverValues,
 		openstack.CSIDriverManila:            csiDriverManilaValues,
+		openstack.NodeLabelerName:            map[string]interface{}{"enabled": isNodeLabelerEnabled(cpConfig)},
 	}, nil
 }
 
@@ -928,7 +1092,13 @@ func (vp *valuesProvider) getControlPlaneShootChartCSIManilaValues(
 		values["vpaEnabled"] = gardencorev1beta1helper.ShootWantsVerticalPodAutoscaler(cluster.Shoot)
 		values["pspDisabled"] = gardencorev1beta1helper.IsPSPDisabled(cluster.Shoot)
 
-		if err := vp.addCSIManilaValues(values, cp, cluster, credentials); err != nil {
+		daemonSets, err := getNodeDaemonSetsValues(ImageVector, openstack.CSIManilaNodeName, csiManilaNodeImages, cluster)
+		if err != nil {
+			return nil, err
+		}
+		values["daemonSets"] = daemonSets
+
+		if err := vp.addCSIManilaValues(values, cpConfig, cp, cluster, credentials); err != nil {
 			return nil, err
 		}
 	}
@@ -938,6 +1108,7 @@ func (vp *valuesProvider) getControlPlaneShootChartCSIManilaValues(
 
 func (vp *valuesProvider) addCSIManilaValues(
 	values map[string]interface{},
+	cpConfig *api.ControlPlaneConfig,
 	cp *extensionsv1alpha1.ControlPlane,
 	cluster *extensionscontroller.Cluster,
 	credentials *openstack.Credentials,
@@ -955,13 +1126,15 @@ func (vp *valuesProvider) addCSIManilaValues(
 		return err
 	}
 
-	var authURL, domainName, projectName, username, password,
+	var authURL, domainName, domainID, projectName, projectID, username, password,
 		applicationCredentialID, applicationCredentialName, applicationCredentialSecret,
 		caCert, insecure, shareNetworkID string
 	if credentials != nil {
 		authURL = credentials.AuthURL
 		domainName = credentials.DomainName
+		domainID = credentials.DomainID
 		projectName = credentials.TenantName
+		projectID = credentials.TenantID
 		username = credentials.Username
 		password = credentials.Password
 		applicationCredentialID = credentials.ApplicationCredentialID
@@ -975,14 +1148,26 @@ func (vp *valuesProvider) addCSIManilaValues(
 	if infraStatus.Networks.ShareNetwork != nil {
 		shareNetworkID = infraStatus.Networks.ShareNetwork.ID
 	}
+	// the NFS shares are only exported to the worker nodes, unless a different client is configured explicitly
+	shareClient := infrastructure.WorkersCIDR(infraConfig)
+	// the CIDR of the worker nodes of a shoot attached to a shared network is allocated by the infrastructure controller,
+	// the worker nodes of a shoot with node subnets are spread over the nodes CIDR
+	if (infraConfig.Networks.Shared != nil || len(infraConfig.Networks.Subnets) > 0) && cluster.Shoot.Spec.Networking != nil && cluster.Shoot.Spec.Networking.Nodes != nil {
+		shareClient = *cluster.Shoot.Spec.Networking.Nodes
+	}
+	if cpConfig.Storage.CSIManila.ShareClient != nil {
+		shareClient = *cpConfig.Storage.CSIManila.ShareClient
+	}
 	values["openstack"] = map[string]interface{}{
 		"availabilityZones":           vp.getAllWorkerPoolsZones(cluster),
 		"shareNetworkID":              shareNetworkID,
-		"shareClient":                 infrastructure.WorkersCIDR(infraConfig),
+		"shareClient":                 shareClient,
 		"authURL":                     authURL,
 		"region":                      cp.Spec.Region,
 		"domainName":                  domainName,
+		"domainID":                    domainID,
 		"projectName":                 projectName,
+		"projectID":                   projectID,
 		"userName":                    username,
 		"password":                    password,
 		"applicationCredentialID":     applicationCredentialID,
@@ -995,6 +1180,57 @@ func (vp *valuesProvider) addCSIManilaValues(
 	return nil
 }
 
+func isNodeLabelerEnabled(cpConfig *api.ControlPlaneConfig) bool {
+	return cpConfig.NodeLabeler != nil && cpConfig.NodeLabeler.Enabled
+}
+
+// getNodeLabelerChartValues returns the values of the node labeler, which is built from this repository and therefore
+// deployed in the version of the extension. It runs in the control plane of the shoot, so that the OpenStack credentials
+// never leave the seed, and accesses the nodes of the shoot with a shoot access token.
+func (vp *valuesProvider) getNodeLabelerChartValues(
+	ctx context.Context,
+	cpConfig *api.ControlPlaneConfig,
+	cp *extensionsv1alpha1.ControlPlane,
+	cluster *extensionscontroller.Cluster,
+	scaledDown bool,
+) (map[string]interface{}, error) {
+	if !isNodeLabelerEnabled(cpConfig) {
+		return map[string]interface{}{"enabled": false}, nil
+	}
+
+	credentialsSecretRef, err := openstack.ShootCredentialsSecretRef(ctx, vp.client, cp.Spec.SecretRef)
+	if err != nil {
+		return nil, err
+	}
+	credentialsSecret, err := extensionscontroller.GetSecretByReference(ctx, vp.client, &credentialsSecretRef)
+	if err != nil {
+		return nil, fmt.Errorf("could not find the credentials of controlplane '%s' for the node labeler: %w", kutil.ObjectName(cp), err)
+	}
+
+	image, err := ImageVector.FindImage(openstack.NodeLabelerImageName)
+	if err != nil {
+		return nil, err
+	}
+	image.WithOptionalTag(version.Get().GitVersion)
+
+	syncPeriod := nodelabeler.DefaultSyncPeriod
+	if cpConfig.NodeLabeler.SyncPeriod != nil {
+		syncPeriod = cpConfig.NodeLabeler.SyncPeriod.Duration
+	}
+
+	return map[string]interface{}{
+		"enabled":               true,
+		"replicas":              extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, 1),
+		"image":                 image.String(),
+		"syncPeriod":            syncPeriod.String(),
+		"region":                cp.Spec.Region,
+		"credentialsSecretName": credentialsSecret.Name,
+		"podAnnotations": map[string]interface{}{
+			"checksum/secret-" + credentialsSecret.Name: gardenerutils.ComputeChecksum(credentialsSecret.Data),
+		},
+	}, nil
+}
+
 func (vp *valuesProvider) getAllWorkerPoolsZones(cluster *extensionscontroller.Cluster) []string {
 	zones := sets.NewString()
 	for _, worker := range cluster.Shoot.Spec.Provider.Workers {
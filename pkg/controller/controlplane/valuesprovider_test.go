@@ -7,6 +7,7 @@ package controlplane
 import (
 	"context"
 	"encoding/json"
+	"net"
 	"time"
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
@@ -17,22 +18,31 @@ import (
 	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
 	mockmanager "github.com/gardener/gardener/pkg/mock/controller-runtime/manager"
 	"github.com/gardener/gardener/pkg/utils"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 	fakesecretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager/fake"
+	testutils "github.com/gardener/gardener/pkg/utils/test"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/component-base/version"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/config"
 	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
 	openstackv1alpha1 "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/v1alpha1"
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+	mockopenstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client/mocks"
 )
 
 const (
@@ -294,6 +304,10 @@ var _ = Describe("ValuesProvider", func() {
 		ctrl = gomock.NewController(GinkgoT())
 
 		c = mockclient.NewMockClient(ctrl)
+		c.EXPECT().Get(gomock.Any(), client.ObjectKey{Namespace: namespace, Name: openstack.ApplicationCredentialSecretName}, gomock.AssignableToTypeOf(&corev1.Secret{})).
+			Return(apierrors.NewNotFound(corev1.Resource("secrets"), openstack.ApplicationCredentialSecretName)).AnyTimes()
+		c.EXPECT().Get(gomock.Any(), client.ObjectKey{Namespace: namespace, Name: openstack.DedicatedProjectSecretName}, gomock.AssignableToTypeOf(&corev1.Secret{})).
+			Return(apierrors.NewNotFound(corev1.Resource("secrets"), openstack.DedicatedProjectSecretName)).AnyTimes()
 
 		fakeClient = fakeclient.NewClientBuilder().Build()
 		fakeSecretsManager = fakesecretsmanager.New(fakeClient, namespace)
@@ -301,7 +315,7 @@ var _ = Describe("ValuesProvider", func() {
 		mgr = mockmanager.NewMockManager(ctrl)
 		mgr.EXPECT().GetClient().Return(c)
 		mgr.EXPECT().GetScheme().Return(scheme)
-		vp = NewValuesProvider(mgr)
+		vp = NewValuesProvider(mgr, nil, config.EgressRestriction{}, config.ControlPlaneScheduling{})
 	})
 
 	AfterEach(func() {
@@ -311,7 +325,9 @@ var _ = Describe("ValuesProvider", func() {
 	Describe("#GetConfigChartValues", func() {
 		configChartValues := map[string]interface{}{
 			"domainName":                  "domain-name",
+			"domainID":                    "",
 			"tenantName":                  "tenant-name",
+			"tenantID":                    "",
 			"username":                    "username",
 			"password":                    "password",
 			"region":                      region,
@@ -482,6 +498,121 @@ var _ = Describe("ValuesProvider", func() {
 			Expect(values).To(Equal(expectedValues))
 		})
 
+		It("should add load balancer classes for the additional floating pools", func() {
+			c.EXPECT().Get(ctx, cpSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cpSecret))
+
+			cluster := &extensionscontroller.Cluster{
+				ObjectMeta:   cluster.ObjectMeta,
+				CloudProfile: cluster.CloudProfile,
+				Shoot:        cluster.Shoot.DeepCopy(),
+			}
+			cluster.Shoot.Spec.Provider.InfrastructureConfig = &runtime.RawExtension{
+				Raw: encode(&openstackv1alpha1.InfrastructureConfig{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: openstackv1alpha1.SchemeGroupVersion.String(),
+						Kind:       "InfrastructureConfig",
+					},
+					Networks: openstackv1alpha1.Networks{
+						Workers: "10.200.0.0/19",
+					},
+					AdditionalFloatingPools: []openstackv1alpha1.AdditionalFloatingPool{
+						{Name: "dmz", LoadBalancerClass: "dmz"},
+						{Name: "internal", LoadBalancerClass: "internal", FloatingSubnetName: pointer.String("internal-*")},
+					},
+				}),
+			}
+
+			openstackClientFactory := mockopenstackclient.NewMockFactory(ctrl)
+			openstackClientFactoryFactory := mockopenstackclient.NewMockFactoryFactory(ctrl)
+			networkingClient := mockopenstackclient.NewMockNetworking(ctrl)
+			openstackClientFactoryFactory.EXPECT().NewFactory(gomock.Any()).Return(openstackClientFactory, nil)
+			openstackClientFactory.EXPECT().Networking(gomock.Any()).Return(networkingClient, nil)
+			networkingClient.EXPECT().GetExternalNetworkByName("dmz").Return(&networks.Network{ID: "dmz-id", Name: "dmz"}, nil)
+			networkingClient.EXPECT().GetExternalNetworkByName("internal").Return(&networks.Network{ID: "internal-id", Name: "internal"}, nil)
+
+			mgr.EXPECT().GetClient().Return(c)
+			mgr.EXPECT().GetScheme().Return(scheme)
+			vp = NewValuesProvider(mgr, openstackClientFactoryFactory, config.EgressRestriction{}, config.ControlPlaneScheduling{})
+
+			values, err := vp.GetConfigChartValues(ctx, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(utils.MergeMaps(configChartValues, map[string]interface{}{
+				"floatingClasses": []map[string]interface{}{
+					{
+						"name":              "dmz",
+						"floatingNetworkID": "dmz-id",
+					},
+					{
+						"name":               "internal",
+						"floatingNetworkID":  "internal-id",
+						"floatingSubnetName": "internal-*",
+					},
+				},
+			})))
+		})
+
+		It("should return correct config chart values with the load balancer algorithm", func() {
+			c.EXPECT().Get(ctx, cpSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cpSecret))
+
+			algorithm := api.LoadBalancerAlgorithmSourceIP
+			cp := controlPlane(
+				"floating-network-id",
+				&api.ControlPlaneConfig{
+					LoadBalancerProvider: "load-balancer-provider",
+					LoadBalancer:         &api.LoadBalancerConfig{Algorithm: &algorithm},
+				},
+				nil,
+			)
+
+			values, err := vp.GetConfigChartValues(ctx, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(utils.MergeMaps(configChartValues, map[string]interface{}{
+				"lbMethod": "SOURCE_IP",
+			})))
+		})
+
+		It("should return correct config chart values with the load balancer availability zone and flavor", func() {
+			c.EXPECT().Get(ctx, cpSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cpSecret))
+
+			cp := controlPlane(
+				"floating-network-id",
+				&api.ControlPlaneConfig{
+					LoadBalancerProvider: "load-balancer-provider",
+					LoadBalancer: &api.LoadBalancerConfig{
+						AvailabilityZone: pointer.String("az-1"),
+						FlavorID:         pointer.String("flavor-id"),
+					},
+				},
+				nil,
+			)
+
+			values, err := vp.GetConfigChartValues(ctx, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(utils.MergeMaps(configChartValues, map[string]interface{}{
+				"lbAvailabilityZone": "az-1",
+				"lbFlavorID":         "flavor-id",
+			})))
+		})
+
+		It("should return correct config chart values with kube-proxy replacement", func() {
+			c.EXPECT().Get(ctx, cpSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cpSecret))
+
+			cp := controlPlane(
+				"floating-network-id",
+				&api.ControlPlaneConfig{
+					LoadBalancerProvider: "load-balancer-provider",
+					KubeProxyReplacement: pointer.Bool(true),
+				},
+				nil,
+			)
+
+			values, err := vp.GetConfigChartValues(ctx, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(utils.MergeMaps(configChartValues, map[string]interface{}{
+				"manageSecurityGroups": true,
+			})))
+		})
+
 		It("should return correct config chart values with application credentials", func() {
 			secret2 := *cpSecret
 			secret2.Data = map[string][]byte{
@@ -604,6 +735,7 @@ var _ = Describe("ValuesProvider", func() {
 					},
 				}),
 				openstack.CSIManilaControllerName: enabledFalse,
+				openstack.NodeLabelerName:         enabledFalse,
 			}))
 		})
 
@@ -650,6 +782,7 @@ var _ = Describe("ValuesProvider", func() {
 					},
 					"openstack": map[string]interface{}{
 						"projectName":                 "tenant-name",
+						"projectID":                   "",
 						"userName":                    "username",
 						"password":                    "password",
 						"applicationCredentialID":     "",
@@ -661,10 +794,98 @@ var _ = Describe("ValuesProvider", func() {
 						"shareClient":                 "10.200.0.0/19",
 						"shareNetworkID":              "1111-2222-3333-4444",
 						"domainName":                  "domain-name",
+						"domainID":                    "",
 						"tlsInsecure":                 "",
 						"caCert":                      "",
 					},
 				}),
+				openstack.NodeLabelerName: enabledFalse,
+			}))
+		})
+
+		It("should return correct control plane chart values if the node labeler is enabled", func() {
+			DeferCleanup(testutils.WithVar(&ImageVector, imagevectorutils.ImageVector{
+				{Name: "openstack-node-labeler", Repository: "node-labeler"},
+			}))
+			c.EXPECT().Get(ctx, cpCSIDiskConfigKey, &corev1.Secret{}).DoAndReturn(clientGet(cpCSIDiskConfig))
+			c.EXPECT().Get(ctx, cpSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cpSecret)).Times(2)
+
+			cpNodeLabeler := controlPlane("floating-network-id", &api.ControlPlaneConfig{
+				NodeLabeler: &api.NodeLabelerConfig{
+					Enabled:    true,
+					SyncPeriod: &metav1.Duration{Duration: 5 * time.Minute},
+				},
+			}, nil)
+			values, err := vp.GetControlPlaneChartValues(ctx, cpNodeLabeler, cluster, fakeSecretsManager, checksums, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue(openstack.NodeLabelerName, map[string]interface{}{
+				"enabled":               true,
+				"replicas":              1,
+				"image":                 "node-labeler:" + version.Get().GitVersion,
+				"syncPeriod":            "5m0s",
+				"region":                "europe",
+				"credentialsSecretName": v1beta1constants.SecretNameCloudProvider,
+				"podAnnotations": map[string]interface{}{
+					"checksum/secret-" + v1beta1constants.SecretNameCloudProvider: utils.ComputeChecksum(cpSecret.Data),
+				},
+			}))
+		})
+
+		It("should restrict the egress traffic to the OpenStack endpoints", func() {
+			c.EXPECT().Get(ctx, cpCSIDiskConfigKey, &corev1.Secret{}).DoAndReturn(clientGet(cpCSIDiskConfig))
+			c.EXPECT().Get(ctx, cpSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cpSecret))
+
+			DeferCleanup(testutils.WithVar(&lookupIP, func(host string) ([]net.IP, error) {
+				Expect(host).To(Equal("keystone.example.com"))
+				return []net.IP{net.ParseIP("10.0.0.1"), net.ParseIP("2001:db8::1")}, nil
+			}))
+
+			openstackClientFactory := mockopenstackclient.NewMockFactory(ctrl)
+			openstackClientFactoryFactory := mockopenstackclient.NewMockFactoryFactory(ctrl)
+			openstackClientFactoryFactory.EXPECT().NewFactory(gomock.Any()).Return(openstackClientFactory, nil)
+			openstackClientFactory.EXPECT().ServiceEndpoints(gomock.Any()).Return([]string{
+				"https://keystone.example.com:5000/v3",
+				"https://10.0.0.2:8774/v2.1",
+				"https://10.0.0.2:9696",
+			}, nil)
+
+			mgr.EXPECT().GetClient().Return(c)
+			mgr.EXPECT().GetScheme().Return(scheme)
+			vp = NewValuesProvider(mgr, openstackClientFactoryFactory, config.EgressRestriction{
+				Enabled:         true,
+				AdditionalCIDRs: []string{"192.168.1.0/24"},
+			}, config.ControlPlaneScheduling{})
+
+			values, err := vp.GetControlPlaneChartValues(ctx, cp, cluster, fakeSecretsManager, checksums, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue("global", map[string]interface{}{
+				"genericTokenKubeconfigSecretName": genericTokenKubeconfigSecretName,
+				"openstackEndpointCIDRs":           []string{"10.0.0.1/32", "10.0.0.2/32", "192.168.1.0/24", "2001:db8::1/128"},
+			}))
+		})
+
+		It("should add the scheduling config of the operator", func() {
+			c.EXPECT().Get(ctx, cpCSIDiskConfigKey, &corev1.Secret{}).DoAndReturn(clientGet(cpCSIDiskConfig))
+			c.EXPECT().Get(ctx, cpSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cpSecret))
+
+			maxUnavailable := intstr.FromString("50%")
+			mgr.EXPECT().GetClient().Return(c)
+			mgr.EXPECT().GetScheme().Return(scheme)
+			vp = NewValuesProvider(mgr, nil, config.EgressRestriction{}, config.ControlPlaneScheduling{
+				PriorityClassName:  pointer.String("openstack-control-plane"),
+				MaxUnavailable:     &maxUnavailable,
+				TopologySpreadKeys: []string{"topology.kubernetes.io/zone"},
+			})
+
+			values, err := vp.GetControlPlaneChartValues(ctx, cp, cluster, fakeSecretsManager, checksums, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue("global", map[string]interface{}{
+				"genericTokenKubeconfigSecretName": genericTokenKubeconfigSecretName,
+				"scheduling": map[string]interface{}{
+					"priorityClassName":  "openstack-control-plane",
+					"maxUnavailable":     "50%",
+					"topologySpreadKeys": []string{"topology.kubernetes.io/zone"},
+				},
 			}))
 		})
 
@@ -720,7 +941,39 @@ var _ = Describe("ValuesProvider", func() {
 	})
 
 	Describe("#GetControlPlaneShootChartValues", func() {
+		var (
+			csiNodeDaemonSets = []interface{}{map[string]interface{}{
+				"name":          "csi-driver-node",
+				"architectures": []string{"amd64"},
+				"images": map[string]interface{}{
+					"csi-driver-cinder":         "cinder:v1",
+					"csi-node-driver-registrar": "registrar:v1",
+					"csi-liveness-probe":        "liveness-probe:v1",
+				},
+			}}
+			csiManilaNodeDaemonSets = []interface{}{map[string]interface{}{
+				"name":          "csi-driver-manila-node",
+				"architectures": []string{"amd64"},
+				"images": map[string]interface{}{
+					"csi-driver-manila":         "manila:v1",
+					"csi-driver-nfs":            "nfs:v1",
+					"csi-node-driver-registrar": "registrar:v1",
+					"csi-liveness-probe":        "liveness-probe:v1",
+				},
+			}}
+		)
+
 		BeforeEach(func() {
+			DeferCleanup(testutils.WithVar(&ImageVector, imagevectorutils.ImageVector{
+				{Name: "csi-driver-cinder", Repository: "cinder", Tag: pointer.String("v1")},
+				{Name: "csi-driver-cinder", Repository: "cinder", Tag: pointer.String("v1-arm64"), Architectures: []string{"arm64"}},
+				{Name: "csi-node-driver-registrar", Repository: "registrar", Tag: pointer.String("v1")},
+				{Name: "csi-liveness-probe", Repository: "liveness-probe", Tag: pointer.String("v1")},
+				{Name: "csi-driver-manila", Repository: "manila", Tag: pointer.String("v1")},
+				{Name: "csi-driver-nfs", Repository: "nfs", Tag: pointer.String("v1")},
+				{Name: "openstack-node-labeler", Repository: "node-labeler"},
+			}))
+
 			By("creating secrets managed outside of this package for whose secretsmanager.Get() will be called")
 			Expect(fakeClient.Create(context.TODO(), &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "ca-provider-openstack-controlplane", Namespace: namespace}})).To(Succeed())
 			Expect(fakeClient.Create(context.TODO(), &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "csi-snapshot-validation-server", Namespace: namespace}})).To(Succeed())
@@ -748,8 +1001,10 @@ var _ = Describe("ValuesProvider", func() {
 							"caBundle": "",
 						},
 						"pspDisabled": false,
+						"daemonSets":  csiNodeDaemonSets,
 					}),
 					openstack.CSIDriverManila: enabledFalse,
+					openstack.NodeLabelerName: enabledFalse,
 				}))
 			})
 
@@ -774,6 +1029,7 @@ var _ = Describe("ValuesProvider", func() {
 							"caBundle": "",
 						},
 						"pspDisabled": false,
+						"daemonSets":  csiNodeDaemonSets,
 					}),
 					openstack.CSIDriverManila: utils.MergeMaps(enabledTrue, map[string]interface{}{
 						"csimanila": map[string]interface{}{
@@ -781,6 +1037,7 @@ var _ = Describe("ValuesProvider", func() {
 						},
 						"openstack": map[string]interface{}{
 							"projectName":                 "tenant-name",
+							"projectID":                   "",
 							"userName":                    "username",
 							"password":                    "password",
 							"applicationCredentialID":     "",
@@ -792,12 +1049,76 @@ var _ = Describe("ValuesProvider", func() {
 							"shareClient":                 "10.200.0.0/19",
 							"shareNetworkID":              "1111-2222-3333-4444",
 							"domainName":                  "domain-name",
+							"domainID":                    "",
 							"tlsInsecure":                 "",
 							"caCert":                      "",
 						},
 						"pspDisabled": false,
 						"vpaEnabled":  true,
+						"daemonSets":  csiManilaNodeDaemonSets,
 					}),
+					openstack.NodeLabelerName: enabledFalse,
+				}))
+			})
+
+			It("should export the NFS shares of CSI Manila to the configured share client", func() {
+				c.EXPECT().Get(ctx, cpCSIDiskConfigKey, &corev1.Secret{}).DoAndReturn(clientGet(cpCSIDiskConfig))
+				c.EXPECT().Get(ctx, cpSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cpSecret))
+
+				cpManila := controlPlane("floating-network-id", &api.ControlPlaneConfig{
+					LoadBalancerProvider: "load-balancer-provider",
+					Storage:              &api.Storage{CSIManila: &api.CSIManila{Enabled: true, ShareClient: pointer.String("10.200.8.0/21")}},
+				}, &api.ShareNetworkStatus{ID: "1111-2222-3333-4444", Name: "sharenetwork"})
+				values, err := vp.GetControlPlaneShootChartValues(ctx, cpManila, cluster, fakeSecretsManager, map[string]string{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(values[openstack.CSIDriverManila]).To(HaveKeyWithValue("openstack", HaveKeyWithValue("shareClient", "10.200.8.0/21")))
+			})
+
+			It("should return correct shoot control plane chart if the node labeler is enabled", func() {
+				c.EXPECT().Get(ctx, cpCSIDiskConfigKey, &corev1.Secret{}).DoAndReturn(clientGet(cpCSIDiskConfig))
+				c.EXPECT().Get(ctx, cpSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cpSecret))
+
+				cpNodeLabeler := controlPlane("floating-network-id", &api.ControlPlaneConfig{
+					NodeLabeler: &api.NodeLabelerConfig{Enabled: true},
+				}, nil)
+				values, err := vp.GetControlPlaneShootChartValues(ctx, cpNodeLabeler, cluster, fakeSecretsManager, map[string]string{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(values).To(HaveKeyWithValue(openstack.NodeLabelerName, enabledTrue))
+			})
+		})
+
+		Context("worker architectures", func() {
+			It("should render separate daemon sets for architectures with differing images", func() {
+				c.EXPECT().Get(ctx, cpCSIDiskConfigKey, &corev1.Secret{}).DoAndReturn(clientGet(cpCSIDiskConfig))
+				c.EXPECT().Get(ctx, cpSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cpSecret))
+
+				DeferCleanup(testutils.WithVar(&cluster.Shoot.Spec.Provider.Workers, []gardencorev1beta1.Worker{
+					{Name: "pool-1", Machine: gardencorev1beta1.Machine{Architecture: pointer.String("arm64")}},
+					{Name: "pool-2", Machine: gardencorev1beta1.Machine{Architecture: pointer.String("amd64")}},
+					{Name: "pool-3"},
+				}))
+
+				values, err := vp.GetControlPlaneShootChartValues(ctx, defaultControlPlaneWithManila(true), cluster, fakeSecretsManager, map[string]string{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(values[openstack.CSINodeName]).To(HaveKeyWithValue("daemonSets", []interface{}{
+					csiNodeDaemonSets[0],
+					map[string]interface{}{
+						"name":          "csi-driver-node-arm64",
+						"architecture":  "arm64",
+						"architectures": []string{"arm64"},
+						"images": map[string]interface{}{
+							"csi-driver-cinder":         "cinder:v1-arm64",
+							"csi-node-driver-registrar": "registrar:v1",
+							"csi-liveness-probe":        "liveness-probe:v1",
+						},
+					},
+				}))
+				Expect(values[openstack.CSIDriverManila]).To(HaveKeyWithValue("daemonSets", []interface{}{
+					map[string]interface{}{
+						"name":          "csi-driver-manila-node",
+						"architectures": []string{"amd64", "arm64"},
+						"images":        csiManilaNodeDaemonSets[0].(map[string]interface{})["images"],
+					},
 				}))
 			})
 		})
@@ -830,8 +1151,10 @@ var _ = Describe("ValuesProvider", func() {
 							"caBundle": "",
 						},
 						"pspDisabled": false,
+						"daemonSets":  csiNodeDaemonSets,
 					}),
 					openstack.CSIDriverManila: enabledFalse,
+					openstack.NodeLabelerName: enabledFalse,
 				}))
 			})
 			It("should return correct shoot control plane chart when PodSecurityPolicy admission plugin is disabled in the shoot", func() {
@@ -862,8 +1185,10 @@ var _ = Describe("ValuesProvider", func() {
 							"caBundle": "",
 						},
 						"pspDisabled": true,
+						"daemonSets":  csiNodeDaemonSets,
 					}),
 					openstack.CSIDriverManila: enabledFalse,
+					openstack.NodeLabelerName: enabledFalse,
 				}))
 			})
 		})
@@ -876,6 +1201,50 @@ var _ = Describe("ValuesProvider", func() {
 			Expect(values["storageclasses"]).To(HaveLen(2))
 			Expect(values["storageclasses"].([]map[string]interface{})[0]["provisioner"]).To(Equal(openstack.CSIStorageProvisioner))
 			Expect(values["storageclasses"].([]map[string]interface{})[1]["provisioner"]).To(Equal(openstack.CSIStorageProvisioner))
+			Expect(values["storageclasses"].([]map[string]interface{})[0]["allowedTopologyZones"]).To(Equal([]string{"zone1", "zone2"}))
+			Expect(values["storageclasses"].([]map[string]interface{})[1]["allowedTopologyZones"]).To(Equal([]string{"zone1", "zone2"}))
+			Expect(values["topologyZoneKey"]).To(Equal("topology.cinder.csi.openstack.org/zone"))
+		})
+
+		It("should restrict the topology of the configured storage classes to the zones of the worker pools", func() {
+			config := &api.CloudProfileConfig{
+				StorageClasses: []api.StorageClassDefinition{
+					{Name: "default", Default: pointer.Bool(true)},
+					{Name: "pinned", Parameters: map[string]string{"availability": "zone2"}},
+					{Name: "immediate", VolumeBindingMode: pointer.String("Immediate")},
+					{Name: "nfs", Provisioner: pointer.String(openstack.CSIManilaStorageProvisionerNFS)},
+				},
+			}
+			configJSON, _ := json.Marshal(config)
+			DeferCleanup(testutils.WithVar(&cluster.CloudProfile.Spec.ProviderConfig, &runtime.RawExtension{Raw: configJSON}))
+
+			values, err := vp.GetStorageClassesChartValues(ctx, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			storageClasses := values["storageclasses"].([]map[string]interface{})
+			Expect(storageClasses).To(HaveLen(4))
+			Expect(storageClasses[0]).To(HaveKeyWithValue("volumeBindingMode", storagev1.VolumeBindingWaitForFirstConsumer))
+			Expect(storageClasses[0]).To(HaveKeyWithValue("allowedTopologyZones", []string{"zone1", "zone2"}))
+			Expect(storageClasses[1]).To(HaveKeyWithValue("allowedTopologyZones", []string{"zone2"}))
+			Expect(storageClasses[2]).To(HaveKeyWithValue("volumeBindingMode", pointer.String("Immediate")))
+			Expect(storageClasses[2]).To(HaveKeyWithValue("allowedTopologyZones", []string{"zone1", "zone2"}))
+			Expect(storageClasses[3]).NotTo(HaveKey("volumeBindingMode"))
+			Expect(storageClasses[3]).NotTo(HaveKey("allowedTopologyZones"))
+		})
+
+		It("should not pin the topology of storage classes to their availability zone if the volume AZ is ignored", func() {
+			config := &api.CloudProfileConfig{
+				IgnoreVolumeAZ: pointer.Bool(true),
+				StorageClasses: []api.StorageClassDefinition{
+					{Name: "pinned", Parameters: map[string]string{"availability": "cinder-az"}},
+				},
+			}
+			configJSON, _ := json.Marshal(config)
+			DeferCleanup(testutils.WithVar(&cluster.CloudProfile.Spec.ProviderConfig, &runtime.RawExtension{Raw: configJSON}))
+
+			values, err := vp.GetStorageClassesChartValues(ctx, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values["storageclasses"].([]map[string]interface{})[0]).To(HaveKeyWithValue("allowedTopologyZones", []string{"zone1", "zone2"}))
 		})
 	})
 })
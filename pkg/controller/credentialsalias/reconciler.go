@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package credentialsalias
+
+import (
+	"context"
+	"fmt"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+)
+
+type reconciler struct {
+	client client.Client
+}
+
+// NewReconciler creates a new reconciler which refreshes the cloudprovider secret of a shoot aliasing the credentials
+// of a centrally managed secret whenever the copy of the latter in the namespace of the shoot changes, and requests the
+// reconciliation of the extension resources rendering the credentials into the components of the shoot. The copy is
+// only refreshed by gardenlet when the shoot is reconciled.
+func NewReconciler(c client.Client) reconcile.Reconciler {
+	return &reconciler{
+		client: c,
+	}
+}
+
+func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	credentialsSecret := &corev1.Secret{}
+	if err := r.client.Get(ctx, request.NamespacedName, credentialsSecret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.client.Get(ctx, client.ObjectKey{Namespace: request.Namespace, Name: v1beta1constants.SecretNameCloudProvider}, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+	resourceName, ok := openstack.CredentialsResourceNameOf(secret)
+	if !ok {
+		return reconcile.Result{}, nil
+	}
+
+	cluster, err := extensionscontroller.GetCluster(ctx, r.client, request.Namespace)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+	if cluster.Shoot == nil {
+		return reconcile.Result{}, nil
+	}
+	resource := v1beta1helper.GetResourceByName(cluster.Shoot.Spec.Resources, resourceName)
+	if resource == nil || resource.ResourceRef.Kind != "Secret" ||
+		v1beta1constants.ReferencedResourcesPrefix+resource.ResourceRef.Name != request.Name {
+		return reconcile.Result{}, nil
+	}
+
+	checksum := utils.ComputeSecretChecksum(credentialsSecret.Data)
+	if secret.Annotations[openstack.CredentialsChecksumAnnotation] == checksum {
+		return reconcile.Result{}, nil
+	}
+
+	log.Info("Aliased credentials changed, refreshing cloudprovider secret", "resource", resourceName)
+	// the cloudprovider webhook copies the current credentials into the secret on every update
+	patch := client.MergeFrom(secret.DeepCopy())
+	delete(secret.Annotations, openstack.CredentialsChecksumAnnotation)
+	if err := r.client.Patch(ctx, secret, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not refresh cloudprovider secret: %w", err)
+	}
+	if secret.Annotations[openstack.CredentialsChecksumAnnotation] != checksum {
+		return reconcile.Result{}, fmt.Errorf("aliased credentials of resource %q were not copied into the cloudprovider secret", resourceName)
+	}
+
+	if err := r.requestReconciliations(ctx, request.Namespace); err != nil {
+		return reconcile.Result{}, err
+	}
+	log.Info("Refreshed cloudprovider secret and requested reconciliation of the control plane and workers")
+	return reconcile.Result{}, nil
+}
+
+// requestReconciliations annotates the ControlPlanes and Workers in the given namespace with the reconcile operation,
+// so that the credentials rendered into the components of the shoot are refreshed.
+func (r *reconciler) requestReconciliations(ctx context.Context, namespace string) error {
+	controlPlanes := &extensionsv1alpha1.ControlPlaneList{}
+	if err := r.client.List(ctx, controlPlanes, client.InNamespace(namespace)); err != nil {
+		return err
+	}
+	for i := range controlPlanes.Items {
+		if controlPlanes.Items[i].Spec.Type != openstack.Type {
+			continue
+		}
+		if err := r.requestReconciliation(ctx, &controlPlanes.Items[i]); err != nil {
+			return fmt.Errorf("could not request reconciliation of control plane %q: %w", controlPlanes.Items[i].Name, err)
+		}
+	}
+
+	workers := &extensionsv1alpha1.WorkerList{}
+	if err := r.client.List(ctx, workers, client.InNamespace(namespace)); err != nil {
+		return err
+	}
+	for i := range workers.Items {
+		if workers.Items[i].Spec.Type != openstack.Type {
+			continue
+		}
+		if err := r.requestReconciliation(ctx, &workers.Items[i]); err != nil {
+			return fmt.Errorf("could not request reconciliation of worker %q: %w", workers.Items[i].Name, err)
+		}
+	}
+	return nil
+}
+
+// requestReconciliation annotates the given extension object with the reconcile operation.
+func (r *reconciler) requestReconciliation(ctx context.Context, obj client.Object) error {
+	patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[v1beta1constants.GardenerOperation] = v1beta1constants.GardenerOperationReconcile
+	obj.SetAnnotations(annotations)
+	return r.client.Patch(ctx, obj, patch)
+}
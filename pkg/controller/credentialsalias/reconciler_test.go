@@ -0,0 +1,173 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package credentialsalias_test
+
+import (
+	"context"
+	"encoding/json"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	. "github.com/gardener/gardener-extension-provider-openstack/pkg/controller/credentialsalias"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+)
+
+var _ = Describe("Reconciler", func() {
+	const namespace = "shoot--foo--bar"
+
+	var (
+		ctx = context.Background()
+
+		seedClient client.Client
+		reconciler reconcile.Reconciler
+		request    = reconcile.Request{NamespacedName: client.ObjectKey{Namespace: namespace, Name: "ref-openstack-credentials"}}
+
+		credentials   map[string][]byte
+		webhookActive bool
+
+		extensionSpec = extensionsv1alpha1.DefaultSpec{Type: openstack.Type}
+	)
+
+	BeforeEach(func() {
+		credentials = map[string][]byte{
+			openstack.DomainName: []byte("domain"),
+			openstack.TenantName: []byte("tenant"),
+			openstack.UserName:   []byte("user"),
+			openstack.Password:   []byte("rotated-password"),
+		}
+		webhookActive = true
+
+		shoot, err := json.Marshal(&gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{
+				Resources: []gardencorev1beta1.NamedResourceReference{{
+					Name: "shared-credentials",
+					ResourceRef: autoscalingv1.CrossVersionObjectReference{
+						APIVersion: "v1",
+						Kind:       "Secret",
+						Name:       "openstack-credentials",
+					},
+				}},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		seedClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.SeedScheme).
+			WithObjects(
+				&extensionsv1alpha1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: namespace},
+					Spec: extensionsv1alpha1.ClusterSpec{
+						CloudProfile: runtime.RawExtension{Raw: []byte(`{}`)},
+						Seed:         runtime.RawExtension{Raw: []byte(`{}`)},
+						Shoot:        runtime.RawExtension{Raw: shoot},
+					},
+				},
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "ref-openstack-credentials"},
+					Data:       credentials,
+				},
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   namespace,
+						Name:        v1beta1constants.SecretNameCloudProvider,
+						Annotations: map[string]string{openstack.CredentialsChecksumAnnotation: "outdated"},
+					},
+					Data: map[string][]byte{openstack.CredentialsResourceName: []byte("shared-credentials")},
+				},
+				&extensionsv1alpha1.ControlPlane{
+					ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "bar"},
+					Spec:       extensionsv1alpha1.ControlPlaneSpec{DefaultSpec: extensionSpec},
+				},
+				&extensionsv1alpha1.Worker{
+					ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "bar"},
+					Spec:       extensionsv1alpha1.WorkerSpec{DefaultSpec: extensionSpec},
+				},
+			).
+			WithInterceptorFuncs(interceptor.Funcs{
+				// emulates the cloudprovider webhook recording the checksum of the copied credentials
+				Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+					if err := c.Patch(ctx, obj, patch, opts...); err != nil {
+						return err
+					}
+					if obj.GetName() != v1beta1constants.SecretNameCloudProvider || !webhookActive {
+						return nil
+					}
+					metav1.SetMetaDataAnnotation(&obj.(*corev1.Secret).ObjectMeta, openstack.CredentialsChecksumAnnotation, utils.ComputeSecretChecksum(credentials))
+					return c.Update(ctx, obj)
+				},
+			}).
+			Build()
+		reconciler = NewReconciler(seedClient)
+	})
+
+	expectReconciliationRequested := func(requested bool) {
+		controlPlane := &extensionsv1alpha1.ControlPlane{}
+		Expect(seedClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "bar"}, controlPlane)).To(Succeed())
+		worker := &extensionsv1alpha1.Worker{}
+		Expect(seedClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "bar"}, worker)).To(Succeed())
+
+		for _, obj := range []client.Object{controlPlane, worker} {
+			if requested {
+				Expect(obj.GetAnnotations()).To(HaveKeyWithValue(v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile))
+			} else {
+				Expect(obj.GetAnnotations()).NotTo(HaveKey(v1beta1constants.GardenerOperation))
+			}
+		}
+	}
+
+	It("should refresh the cloudprovider secret and request reconciliations if the credentials changed", func() {
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		secret := &corev1.Secret{}
+		Expect(seedClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: v1beta1constants.SecretNameCloudProvider}, secret)).To(Succeed())
+		Expect(secret.Annotations).To(HaveKeyWithValue(openstack.CredentialsChecksumAnnotation, utils.ComputeSecretChecksum(credentials)))
+		expectReconciliationRequested(true)
+	})
+
+	It("should do nothing if the credentials did not change", func() {
+		secret := &corev1.Secret{}
+		Expect(seedClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: v1beta1constants.SecretNameCloudProvider}, secret)).To(Succeed())
+		secret.Annotations[openstack.CredentialsChecksumAnnotation] = utils.ComputeSecretChecksum(credentials)
+		Expect(seedClient.Update(ctx, secret)).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		expectReconciliationRequested(false)
+	})
+
+	It("should do nothing if the secret is not the one aliased by the cloudprovider secret", func() {
+		Expect(seedClient.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "ref-other"},
+			Data:       map[string][]byte{"foo": []byte("bar")},
+		})).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKey{Namespace: namespace, Name: "ref-other"}})
+		Expect(err).NotTo(HaveOccurred())
+		expectReconciliationRequested(false)
+	})
+
+	It("should fail if the credentials were not copied into the cloudprovider secret", func() {
+		webhookActive = false
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).To(MatchError(ContainSubstring("were not copied into the cloudprovider secret")))
+		expectReconciliationRequested(false)
+	})
+})
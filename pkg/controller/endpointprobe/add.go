@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package endpointprobe
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	extensionspredicate "github.com/gardener/gardener/extensions/pkg/predicate"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/circuitbreaker"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+	openstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/clientbuilder"
+)
+
+// ControllerName is the name of the endpoint probe controller.
+const ControllerName = "endpoint-probe"
+
+var (
+	// DefaultAddOptions are the default AddOptions for AddToManager.
+	DefaultAddOptions = AddOptions{
+		SyncPeriod: time.Minute,
+	}
+)
+
+// AddOptions are options to apply when adding the Openstack endpoint probe controller to the manager.
+type AddOptions struct {
+	// Controller are the controller.Options.
+	Controller controller.Options
+	// SyncPeriod is the period in which the OpenStack endpoints of a shoot are probed.
+	SyncPeriod time.Duration
+}
+
+// AddToManagerWithOptions adds a controller with the given Options to the given manager.
+func AddToManagerWithOptions(_ context.Context, mgr manager.Manager, opts AddOptions) error {
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&extensionsv1alpha1.Infrastructure{}, builder.WithPredicates(
+			extensionspredicate.HasType(openstack.Type),
+			predicate.GenerationChangedPredicate{},
+		)).
+		WithOptions(opts.Controller).
+		Complete(NewReconciler(
+			mgr.GetClient(),
+			openstackclient.FactoryFactoryFunc(func(credentials *openstack.Credentials) (openstackclient.Factory, error) {
+				return openstackclient.NewOpenstackClientFromCredentialsWithOptions(credentials, clientbuilder.WithHTTPClient(&http.Client{Timeout: RequestTimeout}))
+			}),
+			circuitbreaker.Default,
+			clock.RealClock{},
+			opts.SyncPeriod,
+		))
+}
+
+// AddToManager adds a controller with the default Options.
+func AddToManager(ctx context.Context, mgr manager.Manager) error {
+	return AddToManagerWithOptions(ctx, mgr, DefaultAddOptions)
+}
@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package endpointprobe
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	// probeSuccess is whether the last probe of an OpenStack service of a shoot from the seed succeeded.
+	probeSuccess = promauto.With(runtimemetrics.Registry).NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "openstack_endpoint_probe_success",
+			Help: "Whether the last probe of an OpenStack service of a shoot from the seed succeeded (1) or failed (0).",
+		},
+		[]string{"namespace", "service"},
+	)
+
+	// probeDuration is the duration of the last probe of an OpenStack service of a shoot from the seed.
+	probeDuration = promauto.With(runtimemetrics.Registry).NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "openstack_endpoint_probe_duration_seconds",
+			Help: "Duration of the last probe of an OpenStack service of a shoot from the seed in seconds.",
+		},
+		[]string{"namespace", "service"},
+	)
+)
+
+func recordProbes(namespace string, probes []Probe) {
+	deleteProbes(namespace)
+	for _, probe := range probes {
+		success := 0.0
+		if probe.Err == nil {
+			success = 1
+		}
+		probeSuccess.WithLabelValues(namespace, probe.Service).Set(success)
+		probeDuration.WithLabelValues(namespace, probe.Service).Set(probe.Duration.Seconds())
+	}
+}
+
+func deleteProbes(namespace string) {
+	probeSuccess.DeletePartialMatch(prometheus.Labels{"namespace": namespace})
+	probeDuration.DeletePartialMatch(prometheus.Labels{"namespace": namespace})
+}
@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package endpointprobe
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+	openstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
+)
+
+const (
+	// ServiceIdentity is the service type of Keystone.
+	ServiceIdentity = "identity"
+	// ServiceCompute is the service type of Nova.
+	ServiceCompute = "compute"
+
+	// RequestTimeout is the timeout of the single requests of the probes.
+	RequestTimeout = 10 * time.Second
+	// Timeout is the timeout of all probes of the endpoints of a shoot.
+	Timeout = 30 * time.Second
+)
+
+// Probe is the result of probing an OpenStack service from the seed.
+type Probe struct {
+	// Service is the type of the probed service.
+	Service string
+	// Duration is the time the probe took.
+	Duration time.Duration
+	// Err is the error of the probe if it failed.
+	Err error
+}
+
+// ProbeEndpoints probes Keystone by issuing a token for the given credentials and, if that succeeded, Nova by listing
+// the availability zones of the given region. The probes only need the credentials of the shoot, not its kubeconfig.
+// A probe which does not finish within the Timeout or before the given context is cancelled fails, so that hanging
+// endpoints are reported like unreachable ones.
+func ProbeEndpoints(ctx context.Context, clientFactoryFactory openstackclient.FactoryFactory, clock clock.Clock, credentials *openstack.Credentials, region string) []Probe {
+	ctx, cancel := context.WithTimeout(ctx, Timeout)
+	defer cancel()
+
+	// the clients do not take a context, hence the probes run in the background until their requests time out
+	results := make(chan Probe, 2)
+	go func() {
+		defer close(results)
+
+		start := clock.Now()
+		factory, err := clientFactoryFactory.NewFactory(credentials)
+		results <- Probe{Service: ServiceIdentity, Duration: clock.Since(start), Err: err}
+		if err != nil {
+			return
+		}
+
+		start = clock.Now()
+		err = probeCompute(factory, region)
+		results <- Probe{Service: ServiceCompute, Duration: clock.Since(start), Err: err}
+	}()
+
+	var (
+		probes []Probe
+		start  = clock.Now()
+	)
+	for {
+		select {
+		case probe, ok := <-results:
+			if !ok {
+				return probes
+			}
+			probes = append(probes, probe)
+			start = clock.Now()
+		case <-ctx.Done():
+			service := ServiceIdentity
+			if len(probes) > 0 {
+				service = ServiceCompute
+			}
+			return append(probes, Probe{Service: service, Duration: clock.Since(start), Err: fmt.Errorf("probe did not finish in time: %w", ctx.Err())})
+		}
+	}
+}
+
+func probeCompute(factory openstackclient.Factory, region string) error {
+	compute, err := factory.Compute(openstackclient.WithRegion(region))
+	if err != nil {
+		return err
+	}
+	_, err = compute.ListAvailabilityZones()
+	return err
+}
+
+// FailedProbes returns a description of the failed probes, or an empty string if all of them succeeded.
+func FailedProbes(probes []Probe) string {
+	var failed []string
+	for _, probe := range probes {
+		if probe.Err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", probe.Service, probe.Err))
+		}
+	}
+	return strings.Join(failed, "; ")
+}
+
+// firstProbeError returns the error of the first failed probe, or nil if all of them succeeded.
+func firstProbeError(probes []Probe) error {
+	for _, probe := range probes {
+		if probe.Err != nil {
+			return probe.Err
+		}
+	}
+	return nil
+}
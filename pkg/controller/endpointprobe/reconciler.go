@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package endpointprobe
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/helper"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/circuitbreaker"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+	openstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
+)
+
+const (
+	// ConditionTypeEndpointsReachable is the type of the condition of the Infrastructure reporting whether the OpenStack
+	// endpoints of the shoot are reachable from the seed.
+	ConditionTypeEndpointsReachable gardencorev1beta1.ConditionType = "OpenStackEndpointsReachable"
+
+	// ReasonEndpointsReachable is the reason of the condition if all probes succeeded.
+	ReasonEndpointsReachable = "EndpointsReachable"
+	// ReasonEndpointsUnreachable is the reason of the condition if a probe failed.
+	ReasonEndpointsUnreachable = "EndpointsUnreachable"
+)
+
+type reconciler struct {
+	client               client.Client
+	clientFactoryFactory openstackclient.FactoryFactory
+	circuitBreaker       *circuitbreaker.Breaker
+	clock                clock.Clock
+	syncPeriod           time.Duration
+}
+
+// NewReconciler creates a new reconciler which periodically probes the Keystone and Nova endpoints of the shoots from
+// the seed, exposes the results as metrics and reflects them in a condition of the Infrastructure. The results are
+// recorded in the given circuit breaker of the region of the shoot.
+func NewReconciler(c client.Client, clientFactoryFactory openstackclient.FactoryFactory, circuitBreaker *circuitbreaker.Breaker, clock clock.Clock, syncPeriod time.Duration) reconcile.Reconciler {
+	return &reconciler{
+		client:               c,
+		clientFactoryFactory: clientFactoryFactory,
+		circuitBreaker:       circuitBreaker,
+		clock:                clock,
+		syncPeriod:           syncPeriod,
+	}
+}
+
+func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	infra := &extensionsv1alpha1.Infrastructure{}
+	if err := r.client.Get(ctx, request.NamespacedName, infra); err != nil {
+		if apierrors.IsNotFound(err) {
+			deleteProbes(request.Namespace)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if infra.DeletionTimestamp != nil {
+		deleteProbes(infra.Namespace)
+		return reconcile.Result{}, nil
+	}
+
+	cluster, err := extensionscontroller.GetCluster(ctx, r.client, infra.Namespace)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not get cluster: %w", err)
+	}
+	// Hibernated shoots do not use the OpenStack APIs.
+	if extensionscontroller.IsHibernated(cluster) {
+		deleteProbes(infra.Namespace)
+		return reconcile.Result{RequeueAfter: r.syncPeriod}, nil
+	}
+
+	credentials, err := openstack.GetShootReadOnlyCredentials(ctx, r.client, infra.Spec.SecretRef)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not get OpenStack credentials: %w", err)
+	}
+	if len(strings.TrimSpace(credentials.AuthURL)) == 0 {
+		cloudProfileConfig, err := helper.CloudProfileConfigFromCluster(cluster)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		keyStoneURL, err := helper.FindKeyStoneURL(cloudProfileConfig.KeyStoneURLs, cloudProfileConfig.KeyStoneURL, infra.Spec.Region)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		credentials.AuthURL = keyStoneURL
+	}
+
+	probes := ProbeEndpoints(ctx, r.clientFactoryFactory, r.clock, credentials, infra.Spec.Region)
+	log.V(1).Info("Probed OpenStack endpoints", "probes", probes)
+	recordProbes(infra.Namespace, probes)
+	r.circuitBreaker.Record(credentials.AuthURL, infra.Spec.Region, firstProbeError(probes))
+
+	if err := r.updateCondition(ctx, infra, probes); err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not update condition of infrastructure: %w", err)
+	}
+	return reconcile.Result{RequeueAfter: r.syncPeriod}, nil
+}
+
+// updateCondition reflects the given probes in the condition of the Infrastructure. The Infrastructure is only patched if
+// the status, reason or message of the condition changed.
+func (r *reconciler) updateCondition(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, probes []Probe) error {
+	status, reason, message := gardencorev1beta1.ConditionTrue, ReasonEndpointsReachable, "The OpenStack endpoints of the shoot are reachable from the seed."
+	if failed := FailedProbes(probes); failed != "" {
+		status, reason, message = gardencorev1beta1.ConditionFalse, ReasonEndpointsUnreachable,
+			fmt.Sprintf("The OpenStack endpoints of the shoot are not reachable from the seed: %s", failed)
+	}
+
+	condition := v1beta1helper.GetOrInitConditionWithClock(r.clock, infra.Status.Conditions, ConditionTypeEndpointsReachable)
+	if condition.Status == status && condition.Reason == reason && condition.Message == message {
+		return nil
+	}
+
+	patch := client.MergeFrom(infra.DeepCopy())
+	condition = v1beta1helper.UpdatedConditionWithClock(r.clock, condition, status, reason, message)
+	infra.Status.Conditions = v1beta1helper.MergeConditions(infra.Status.Conditions, condition)
+	return r.client.Status().Patch(ctx, infra, patch)
+}
@@ -0,0 +1,198 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package endpointprobe_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gophercloud/gophercloud"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/circuitbreaker"
+	. "github.com/gardener/gardener-extension-provider-openstack/pkg/controller/endpointprobe"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+	openstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
+	mockopenstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client/mocks"
+)
+
+var _ = Describe("Reconciler", func() {
+	const (
+		namespace  = "shoot--foo--bar"
+		region     = "eu-1"
+		syncPeriod = time.Minute
+	)
+
+	var (
+		ctx  = context.Background()
+		ctrl *gomock.Controller
+
+		factoryFactory *mockopenstackclient.MockFactoryFactory
+		factory        *mockopenstackclient.MockFactory
+		compute        *mockopenstackclient.MockCompute
+
+		seedClient     client.Client
+		circuitBreaker *circuitbreaker.Breaker
+		reconciler     reconcile.Reconciler
+		request        = reconcile.Request{NamespacedName: client.ObjectKey{Namespace: namespace, Name: "infrastructure"}}
+
+		newSeedClient = func(shoot *gardencorev1beta1.Shoot) client.Client {
+			rawShoot, err := json.Marshal(shoot)
+			Expect(err).NotTo(HaveOccurred())
+			return fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithStatusSubresource(&extensionsv1alpha1.Infrastructure{}).WithObjects(
+				&extensionsv1alpha1.Infrastructure{
+					ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "infrastructure"},
+					Spec: extensionsv1alpha1.InfrastructureSpec{
+						DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: openstack.Type},
+						Region:      region,
+						SecretRef:   corev1.SecretReference{Namespace: namespace, Name: "cloudprovider"},
+					},
+				},
+				&extensionsv1alpha1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: namespace},
+					Spec: extensionsv1alpha1.ClusterSpec{
+						CloudProfile: runtime.RawExtension{Raw: []byte(`{}`)},
+						Seed:         runtime.RawExtension{Raw: []byte(`{}`)},
+						Shoot:        runtime.RawExtension{Raw: rawShoot},
+					},
+				},
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "cloudprovider"},
+					Data: map[string][]byte{
+						openstack.DomainName: []byte("domain"),
+						openstack.TenantName: []byte("tenant"),
+						openstack.UserName:   []byte("user"),
+						openstack.Password:   []byte("password"),
+						openstack.AuthURL:    []byte("https://keystone"),
+					},
+				},
+			).Build()
+		}
+
+		condition = func() *gardencorev1beta1.Condition {
+			infra := &extensionsv1alpha1.Infrastructure{}
+			ExpectWithOffset(1, seedClient.Get(ctx, request.NamespacedName, infra)).To(Succeed())
+			return v1beta1helper.GetCondition(infra.Status.Conditions, ConditionTypeEndpointsReachable)
+		}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		factoryFactory = mockopenstackclient.NewMockFactoryFactory(ctrl)
+		factory = mockopenstackclient.NewMockFactory(ctrl)
+		compute = mockopenstackclient.NewMockCompute(ctrl)
+
+		seedClient = newSeedClient(&gardencorev1beta1.Shoot{})
+		circuitBreaker = circuitbreaker.New(testclock.NewFakeClock(time.Now()), 2, time.Minute)
+	})
+
+	JustBeforeEach(func() {
+		reconciler = NewReconciler(seedClient, factoryFactory, circuitBreaker, testclock.NewFakeClock(time.Now()), syncPeriod)
+	})
+
+	It("should report reachable endpoints", func() {
+		factoryFactory.EXPECT().NewFactory(gomock.Any()).Return(factory, nil)
+		factory.EXPECT().Compute(gomock.Any()).Return(compute, nil)
+		compute.EXPECT().ListAvailabilityZones().Return(nil, nil)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		cond := condition()
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+		Expect(cond.Reason).To(Equal(ReasonEndpointsReachable))
+	})
+
+	It("should report unreachable endpoints and not probe Nova if Keystone is not reachable", func() {
+		factoryFactory.EXPECT().NewFactory(gomock.Any()).Return(nil, fmt.Errorf("connection refused"))
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		cond := condition()
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		Expect(cond.Reason).To(Equal(ReasonEndpointsUnreachable))
+		Expect(cond.Message).To(ContainSubstring("identity: connection refused"))
+	})
+
+	It("should report unreachable endpoints if Nova is not reachable", func() {
+		factoryFactory.EXPECT().NewFactory(gomock.Any()).Return(factory, nil)
+		factory.EXPECT().Compute(gomock.Any()).Return(compute, nil)
+		compute.EXPECT().ListAvailabilityZones().Return(nil, fmt.Errorf("timeout"))
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		cond := condition()
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		Expect(cond.Message).To(ContainSubstring("compute: timeout"))
+	})
+
+	It("should report unreachable endpoints if a probe hangs", func() {
+		release := make(chan struct{})
+		defer close(release)
+		factoryFactory.EXPECT().NewFactory(gomock.Any()).DoAndReturn(func(_ *openstack.Credentials) (openstackclient.Factory, error) {
+			<-release
+			return nil, fmt.Errorf("released")
+		})
+
+		timeoutCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+		defer cancel()
+		Expect(reconciler.Reconcile(timeoutCtx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		cond := condition()
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		Expect(cond.Message).To(ContainSubstring("identity: probe did not finish in time: context deadline exceeded"))
+	})
+
+	It("should open the circuit breaker of the region if the endpoints fail repeatedly", func() {
+		outage := gophercloud.ErrDefault503{ErrUnexpectedResponseCode: gophercloud.ErrUnexpectedResponseCode{Actual: 503}}
+		factoryFactory.EXPECT().NewFactory(gomock.Any()).Return(nil, outage).Times(2)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(circuitBreaker.Allow("https://keystone", region)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(circuitBreaker.Allow("https://keystone", region)).To(BeAssignableToTypeOf(&circuitbreaker.OpenError{}))
+
+		factoryFactory.EXPECT().NewFactory(gomock.Any()).Return(factory, nil)
+		factory.EXPECT().Compute(gomock.Any()).Return(compute, nil)
+		compute.EXPECT().ListAvailabilityZones().Return(nil, nil)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(circuitBreaker.Allow("https://keystone", region)).To(Succeed())
+	})
+
+	Context("hibernated shoot", func() {
+		BeforeEach(func() {
+			seedClient = newSeedClient(&gardencorev1beta1.Shoot{
+				Spec:   gardencorev1beta1.ShootSpec{Hibernation: &gardencorev1beta1.Hibernation{Enabled: pointer.Bool(true)}},
+				Status: gardencorev1beta1.ShootStatus{IsHibernated: true},
+			})
+		})
+
+		It("should not probe the endpoints", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+			Expect(condition()).To(BeNil())
+		})
+	})
+})
@@ -87,7 +87,7 @@ func RegisterHealthChecks(ctx context.Context, mgr manager.Manager, opts healthc
 		nil,
 		[]healthcheck.ConditionTypeToHealthCheck{{
 			ConditionType: string(gardencorev1beta1.ShootEveryNodeReady),
-			HealthCheck:   worker.NewNodesChecker(),
+			HealthCheck:   NewMachineFreezeAwareNodesChecker(worker.NewNodesChecker()),
 			ErrorCodeCheckFunc: func(err error) []gardencorev1beta1.ErrorCode {
 				return util.DetermineErrorCodes(err, helper.KnownCodes)
 			},
@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// labelFreeze is the label the machine-controller-manager sets on frozen machine deployments.
+	labelFreeze = "freeze"
+	// reasonOverShootingReplicaCount is the reason of the freeze of machine deployments with more machines than allowed.
+	reasonOverShootingReplicaCount = "OverShootingReplicaCount"
+	// reasonMachineDeploymentStateSync is the reason of the freeze of machine deployments with an inconsistent state.
+	reasonMachineDeploymentStateSync = "MachineDeploymentStateSync"
+	// reasonProgressDeadlineExceeded is the reason of the progressing condition of machine deployments which did not
+	// make progress within their deadline.
+	reasonProgressDeadlineExceeded = "ProgressDeadlineExceeded"
+)
+
+// freezeHints explain how to resolve the freeze of a machine deployment per reason.
+var freezeHints = map[string]string{
+	reasonOverShootingReplicaCount: "more machines exist than the worker pool allows, e.g. because machines could not be " +
+		"deleted in OpenStack. No machines are created or deleted until the number of machines is back within the limits, " +
+		"check for servers stuck in deletion",
+	reasonMachineDeploymentStateSync: "the freeze state of the machine deployment and its machine sets was inconsistent. " +
+		"It is lifted as soon as none of its machine sets is frozen anymore",
+	reasonProgressDeadlineExceeded: "no new machine became ready within the progress deadline, check the failed machines " +
+		"and whether the quota of the OpenStack project suffices for the worker pool",
+}
+
+// machineFreezeChecker checks the nodes of a worker with the given nodes checker, but reports machine deployments
+// which are frozen by the machine-controller-manager or did not make progress within their deadline first. Their
+// conditions carry the reason why no machines are rolled out, which is more actionable than the missing nodes.
+type machineFreezeChecker struct {
+	logger       logr.Logger
+	seedClient   client.Client
+	nodesChecker healthcheck.HealthCheck
+}
+
+// NewMachineFreezeAwareNodesChecker returns a health check which reports frozen machine deployments with the reason of
+// their freeze and otherwise delegates to the given nodes checker.
+func NewMachineFreezeAwareNodesChecker(nodesChecker healthcheck.HealthCheck) healthcheck.HealthCheck {
+	return &machineFreezeChecker{nodesChecker: nodesChecker}
+}
+
+// InjectSeedClient injects the seed client.
+func (h *machineFreezeChecker) InjectSeedClient(seedClient client.Client) {
+	h.seedClient = seedClient
+	healthcheck.SeedClientInto(seedClient, h.nodesChecker)
+}
+
+// InjectShootClient injects the shoot client.
+func (h *machineFreezeChecker) InjectShootClient(shootClient client.Client) {
+	healthcheck.ShootClientInto(shootClient, h.nodesChecker)
+}
+
+// SetLoggerSuffix injects the logger.
+func (h *machineFreezeChecker) SetLoggerSuffix(provider, extension string) {
+	h.logger = log.Log.WithName(fmt.Sprintf("%s-%s-healthcheck-machine-freeze", provider, extension))
+	h.nodesChecker.SetLoggerSuffix(provider, extension)
+}
+
+// DeepCopy clones the healthCheck struct by making a copy and returning the pointer to that new copy.
+func (h *machineFreezeChecker) DeepCopy() healthcheck.HealthCheck {
+	shallowCopy := *h
+	shallowCopy.nodesChecker = h.nodesChecker.DeepCopy()
+	return &shallowCopy
+}
+
+// Check executes the health check.
+func (h *machineFreezeChecker) Check(ctx context.Context, request types.NamespacedName) (*healthcheck.SingleCheckResult, error) {
+	machineDeploymentList := &machinev1alpha1.MachineDeploymentList{}
+	if err := h.seedClient.List(ctx, machineDeploymentList, client.InNamespace(request.Namespace)); err != nil {
+		err := fmt.Errorf("unable to check machine deployments. Failed to list machine deployments in namespace %q: %w", request.Namespace, err)
+		h.logger.Error(err, "Health check failed")
+		return nil, err
+	}
+
+	var details []string
+	for _, deployment := range machineDeploymentList.Items {
+		if deployment.DeletionTimestamp != nil {
+			continue
+		}
+		if detail := frozenDetail(&deployment); detail != "" {
+			details = append(details, detail)
+		}
+	}
+	if len(details) > 0 {
+		detail := strings.Join(details, "; ")
+		h.logger.Info("Health check failed", "detail", detail)
+		return &healthcheck.SingleCheckResult{
+			Status: gardencorev1beta1.ConditionFalse,
+			Detail: detail,
+		}, nil
+	}
+
+	return h.nodesChecker.Check(ctx, request)
+}
+
+// frozenDetail returns why the given machine deployment is frozen or does not make progress, or an empty string if it
+// is neither.
+func frozenDetail(deployment *machinev1alpha1.MachineDeployment) string {
+	if condition := getCondition(deployment, machinev1alpha1.MachineDeploymentFrozen); condition != nil && condition.Status == machinev1alpha1.ConditionTrue {
+		return withHint(fmt.Sprintf("machine deployment %q is frozen by the machine-controller-manager (%s): %s",
+			deployment.Name, condition.Reason, strings.TrimSuffix(condition.Message, ".")), condition.Reason)
+	}
+	if deployment.Labels[labelFreeze] == "True" {
+		return fmt.Sprintf("machine deployment %q is frozen by the machine-controller-manager", deployment.Name)
+	}
+	if condition := getCondition(deployment, machinev1alpha1.MachineDeploymentProgressing); condition != nil &&
+		condition.Status == machinev1alpha1.ConditionFalse && condition.Reason == reasonProgressDeadlineExceeded {
+		return withHint(fmt.Sprintf("machine deployment %q did not make progress within its deadline: %s",
+			deployment.Name, strings.TrimSuffix(condition.Message, ".")), condition.Reason)
+	}
+	return ""
+}
+
+func withHint(detail, reason string) string {
+	if hint, ok := freezeHints[reason]; ok {
+		return detail + " - " + hint
+	}
+	return detail
+}
+
+func getCondition(deployment *machinev1alpha1.MachineDeployment, conditionType machinev1alpha1.MachineDeploymentConditionType) *machinev1alpha1.MachineDeploymentCondition {
+	for i := range deployment.Status.Conditions {
+		if deployment.Status.Conditions[i].Type == conditionType {
+			return &deployment.Status.Conditions[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck_test
+
+import (
+	"context"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/gardener/gardener-extension-provider-openstack/pkg/controller/healthcheck"
+)
+
+// fakeNodesChecker is a nodes checker returning a fixed result and recording the injected clients.
+type fakeNodesChecker struct {
+	result      *healthcheck.SingleCheckResult
+	seedClient  client.Client
+	shootClient client.Client
+	checked     bool
+}
+
+func (f *fakeNodesChecker) InjectSeedClient(c client.Client)  { f.seedClient = c }
+func (f *fakeNodesChecker) InjectShootClient(c client.Client) { f.shootClient = c }
+func (f *fakeNodesChecker) SetLoggerSuffix(_, _ string)       {}
+func (f *fakeNodesChecker) DeepCopy() healthcheck.HealthCheck { return f }
+func (f *fakeNodesChecker) Check(_ context.Context, _ types.NamespacedName) (*healthcheck.SingleCheckResult, error) {
+	f.checked = true
+	return f.result, nil
+}
+
+var _ = Describe("MachineFreezeAwareNodesChecker", func() {
+	const namespace = "shoot--foo--bar"
+
+	var (
+		ctx = context.TODO()
+
+		c            client.Client
+		nodesChecker *fakeNodesChecker
+		checker      healthcheck.HealthCheck
+		request      = types.NamespacedName{Namespace: namespace, Name: "worker"}
+	)
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(machinev1alpha1.AddToScheme(scheme)).To(Succeed())
+		c = fakeclient.NewClientBuilder().WithScheme(scheme).Build()
+
+		nodesChecker = &fakeNodesChecker{result: &healthcheck.SingleCheckResult{Status: gardencorev1beta1.ConditionTrue}}
+		checker = NewMachineFreezeAwareNodesChecker(nodesChecker).DeepCopy()
+		checker.SetLoggerSuffix("openstack", "worker")
+		healthcheck.SeedClientInto(c, checker)
+		healthcheck.ShootClientInto(c, checker)
+	})
+
+	createMachineDeployment := func(name string, labels map[string]string, conditions ...machinev1alpha1.MachineDeploymentCondition) {
+		ExpectWithOffset(1, c.Create(ctx, &machinev1alpha1.MachineDeployment{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels},
+			Status:     machinev1alpha1.MachineDeploymentStatus{Conditions: conditions},
+		})).To(Succeed())
+	}
+
+	It("should inject the clients into the nodes checker", func() {
+		Expect(nodesChecker.seedClient).To(BeIdenticalTo(c))
+		Expect(nodesChecker.shootClient).To(BeIdenticalTo(c))
+	})
+
+	It("should delegate to the nodes checker if no machine deployment is frozen", func() {
+		createMachineDeployment("pool-1", nil,
+			machinev1alpha1.MachineDeploymentCondition{Type: machinev1alpha1.MachineDeploymentAvailable, Status: machinev1alpha1.ConditionTrue},
+			machinev1alpha1.MachineDeploymentCondition{Type: machinev1alpha1.MachineDeploymentFrozen, Status: machinev1alpha1.ConditionFalse},
+		)
+
+		Expect(checker.Check(ctx, request)).To(Equal(nodesChecker.result))
+		Expect(nodesChecker.checked).To(BeTrue())
+	})
+
+	It("should report frozen machine deployments with the reason of the freeze", func() {
+		createMachineDeployment("pool-1", map[string]string{"freeze": "True"}, machinev1alpha1.MachineDeploymentCondition{
+			Type:    machinev1alpha1.MachineDeploymentFrozen,
+			Status:  machinev1alpha1.ConditionTrue,
+			Reason:  "OverShootingReplicaCount",
+			Message: "The number of machines backing MachineSet: pool-1-abcde is 5 >= 4 which is the Max-ScaleUp-Limit.",
+		})
+		createMachineDeployment("pool-2", nil)
+
+		result, err := checker.Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		Expect(result.Detail).To(And(
+			HavePrefix(`machine deployment "pool-1" is frozen by the machine-controller-manager (OverShootingReplicaCount): The number of machines backing MachineSet: pool-1-abcde is 5 >= 4 which is the Max-ScaleUp-Limit - `),
+			ContainSubstring("check for servers stuck in deletion"),
+		))
+		Expect(nodesChecker.checked).To(BeFalse())
+	})
+
+	It("should report machine deployments frozen by label only", func() {
+		createMachineDeployment("pool-1", map[string]string{"freeze": "True"})
+
+		result, err := checker.Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(&healthcheck.SingleCheckResult{
+			Status: gardencorev1beta1.ConditionFalse,
+			Detail: `machine deployment "pool-1" is frozen by the machine-controller-manager`,
+		}))
+	})
+
+	It("should report machine deployments which exceeded their progress deadline", func() {
+		createMachineDeployment("pool-1", nil, machinev1alpha1.MachineDeploymentCondition{
+			Type:    machinev1alpha1.MachineDeploymentProgressing,
+			Status:  machinev1alpha1.ConditionFalse,
+			Reason:  "ProgressDeadlineExceeded",
+			Message: "MachineSet \"pool-1-abcde\" has timed out progressing.",
+		})
+		createMachineDeployment("pool-2", map[string]string{"freeze": "True"})
+
+		result, err := checker.Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		Expect(result.Detail).To(And(
+			HavePrefix(`machine deployment "pool-1" did not make progress within its deadline: MachineSet "pool-1-abcde" has timed out progressing - no new machine became ready`),
+			HaveSuffix(`; machine deployment "pool-2" is frozen by the machine-controller-manager`),
+		))
+	})
+
+	It("should ignore machine deployments in deletion", func() {
+		createMachineDeployment("pool-1", map[string]string{"freeze": "True"})
+		deployment := &machinev1alpha1.MachineDeployment{ObjectMeta: metav1.ObjectMeta{Name: "pool-1", Namespace: namespace}}
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+		deployment.Finalizers = []string{"test"}
+		Expect(c.Update(ctx, deployment)).To(Succeed())
+		Expect(c.Delete(ctx, deployment)).To(Succeed())
+
+		Expect(checker.Check(ctx, request)).To(Equal(nodesChecker.result))
+	})
+})
@@ -15,8 +15,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/config"
 	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
 	openstackv1alpha1 "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/circuitbreaker"
 	infrainternal "github.com/gardener/gardener-extension-provider-openstack/pkg/internal/infrastructure"
 )
 
@@ -28,20 +30,32 @@ const (
 type actuator struct {
 	client                     client.Client
 	restConfig                 *rest.Config
+	terraformerConfig          *config.Terraformer
 	disableProjectedTokenMount bool
+	circuitBreaker             *circuitbreaker.Breaker
 }
 
 // NewActuator creates a new Actuator that updates the status of the handled Infrastructure resources.
-func NewActuator(mgr manager.Manager, disableProjectedTokenMount bool) infrastructure.Actuator {
+func NewActuator(mgr manager.Manager, terraformerConfig *config.Terraformer, disableProjectedTokenMount bool) infrastructure.Actuator {
 	return &actuator{
+		terraformerConfig:          terraformerConfig,
 		disableProjectedTokenMount: disableProjectedTokenMount,
 		client:                     mgr.GetClient(),
 		restConfig:                 mgr.GetConfig(),
+		circuitBreaker:             circuitbreaker.Default,
 	}
 }
 
 // Helper functions
 
+// terraformerParallelism returns the configured parallelism of Terraform, or nil if the default shall be used.
+func (a *actuator) terraformerParallelism() *int32 {
+	if a.terraformerConfig == nil {
+		return nil
+	}
+	return a.terraformerConfig.Parallelism
+}
+
 func (a *actuator) updateProviderStatusWithTerraformer(
 	ctx context.Context,
 	tf terraformer.Terraformer,
@@ -63,7 +77,7 @@ func (a *actuator) updateProviderStatusWithTerraformer(
 		return err
 	}
 
-	return a.updateProviderStatus(ctx, infra, status, stateBytes)
+	return a.updateProviderStatus(ctx, infra, status, stateBytes, nil)
 }
 
 func (a *actuator) updateProviderStatus(
@@ -71,9 +85,14 @@ func (a *actuator) updateProviderStatus(
 	infra *extensionsv1alpha1.Infrastructure,
 	status *openstackv1alpha1.InfrastructureStatus,
 	stateBytes []byte,
+	nodesCIDR *string,
 ) error {
 	patch := client.MergeFrom(infra.DeepCopy())
 	infra.Status.ProviderStatus = &runtime.RawExtension{Object: status}
 	infra.Status.State = &runtime.RawExtension{Raw: stateBytes}
+	// the nodes CIDR is only known by the extension for shoots attached to a shared network
+	if nodesCIDR != nil {
+		infra.Status.NodesCIDR = nodesCIDR
+	}
 	return a.client.Status().Patch(ctx, infra, patch)
 }
@@ -0,0 +1,138 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/clock"
+
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/helper"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/audit"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/controller/infrastructure/networkallocation"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/internal/infrastructure"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+	openstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
+)
+
+// auditWithFlow compares the desired with the actual state of the infrastructure of a shoot in audit mode and reports
+// the differences in the audit condition of the infrastructure. Neither the infrastructure resources nor the state and
+// the provider status of the infrastructure are changed, independent of whether it is reconciled by terraform or flow.
+func (a *actuator) auditWithFlow(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	log.Info("Auditing infrastructure, no changes are applied in audit mode")
+
+	differences, err := a.auditInfrastructure(ctx, log, infra, cluster)
+	if err != nil {
+		log.Error(err, "Infrastructure could not be audited")
+	} else {
+		log.Info("Infrastructure audited", "differences", differences)
+	}
+	return audit.ReportCondition(ctx, a.client, clock.RealClock{}, infra, differences, err)
+}
+
+func (a *actuator) auditInfrastructure(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) ([]string, error) {
+	state, err := a.auditState(ctx, infra)
+	if err != nil {
+		return nil, err
+	}
+	credentials, err := a.auditCredentials(ctx, infra)
+	if err != nil {
+		return nil, err
+	}
+	cloudProfileConfig, err := helper.CloudProfileConfigFromCluster(cluster)
+	if err != nil {
+		return nil, err
+	}
+	config, err := helper.InfrastructureConfigFromInfrastructure(infra)
+	if err != nil {
+		return nil, err
+	}
+
+	var differences []string
+	if shared := config.Networks.Shared; shared != nil {
+		// the CIDR of the shoot is only read in audit mode, a missing allocation is reported instead of being made
+		cidr, err := networkallocation.Get(ctx, a.client, infra.Namespace, shared.ID)
+		if err != nil {
+			return nil, err
+		}
+		if cidr == "" {
+			differences = append(differences, fmt.Sprintf("no CIDR is allocated in shared network %s", shared.ID))
+			if cluster.Shoot != nil && cluster.Shoot.Spec.Networking != nil && cluster.Shoot.Spec.Networking.Nodes != nil {
+				cidr = *cluster.Shoot.Spec.Networking.Nodes
+			}
+		}
+		config.Networks.ID = &shared.ID
+		config.Networks.Workers = cidr
+	}
+	auxiliaryStackTemplate, err := a.auxiliaryStackTemplate(ctx, infra, cluster, config)
+	if err != nil {
+		return nil, err
+	}
+
+	clientFactory, err := openstackclient.NewOpenstackClientFromCredentials(credentials)
+	if err != nil {
+		return nil, err
+	}
+	var podsCIDR *string
+	if cluster.Shoot != nil && cluster.Shoot.Spec.Networking != nil {
+		podsCIDR = cluster.Shoot.Spec.Networking.Pods
+	}
+
+	// the flow context has no persistor, as the state must not be changed in audit mode
+	flowContext, err := infraflow.NewFlowContext(log, clientFactory, infra, config, cloudProfileConfig, podsCIDR, auxiliaryStackTemplate, infrastructure.IPv6SecurityGroupRules(config), state.ToFlatMap(), nil)
+	if err != nil {
+		return nil, err
+	}
+	flowDifferences, err := flowContext.Audit(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return append(differences, flowDifferences...), nil
+}
+
+// auditState returns the state the infrastructure resources are identified with in audit mode. Infrastructures
+// reconciled by terraform have no flow state, their resources are identified by the ids in their provider status.
+func (a *actuator) auditState(ctx context.Context, infra *extensionsv1alpha1.Infrastructure) (*infraflow.PersistentState, error) {
+	state, err := a.getStateFromInfraStatus(ctx, infra)
+	if err != nil || state != nil {
+		return state, err
+	}
+	if infra.Status.ProviderStatus == nil {
+		return infraflow.NewPersistentState(), nil
+	}
+	status, err := helper.InfrastructureStatusFromRaw(infra.Status.ProviderStatus)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode provider status: %w", err)
+	}
+	return infraflow.NewPersistentStateFromInfrastructureStatus(status), nil
+}
+
+// auditCredentials returns the credentials the infrastructure resources of the shoot are audited with. The dedicated
+// project and the application credential of a shoot are not reconciled in audit mode, instead the credentials stored
+// for them are used.
+func (a *actuator) auditCredentials(ctx context.Context, infra *extensionsv1alpha1.Infrastructure) (*openstack.Credentials, error) {
+	config, err := helper.InfrastructureConfigFromInfrastructure(infra)
+	if err != nil {
+		return nil, err
+	}
+	secretRef := infra.Spec.SecretRef
+	if config.DedicatedProject != nil {
+		secretRef = corev1.SecretReference{Name: openstack.DedicatedProjectSecretName, Namespace: infra.Namespace}
+	}
+	if config.ApplicationCredential != nil {
+		secretRef = corev1.SecretReference{Name: openstack.ApplicationCredentialSecretName, Namespace: infra.Namespace}
+	}
+	credentials, err := openstack.GetCredentials(ctx, a.client, secretRef, false)
+	if err != nil {
+		return nil, fmt.Errorf("could not get Openstack credentials: %w", err)
+	}
+	return credentials, nil
+}
@@ -14,7 +14,10 @@ import (
 	"github.com/gardener/gardener/extensions/pkg/util"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils/flow"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/helper"
@@ -30,10 +33,18 @@ func (a *actuator) Delete(ctx context.Context, log logr.Logger, infra *extension
 	if err != nil {
 		return err
 	}
+	if state != nil {
+		return util.DetermineError(a.deleteWithFlow(ctx, log, infra, cluster, state), helper.KnownCodes)
+	}
+
+	stateInitializer, state, err := a.recoverTerraformState(ctx, log, infra, true)
+	if err != nil {
+		return util.DetermineError(err, helper.KnownCodes)
+	}
 	if state != nil {
 		err = a.deleteWithFlow(ctx, log, infra, cluster, state)
 	} else {
-		err = a.deleteWithTerraformer(ctx, log, infra, cluster)
+		err = a.deleteWithTerraformer(ctx, log, infra, cluster, stateInitializer)
 	}
 	return util.DetermineError(err, helper.KnownCodes)
 }
@@ -46,18 +57,52 @@ func (a *actuator) deleteWithFlow(ctx context.Context, log logr.Logger, infra *e
 	cluster *extensionscontroller.Cluster, oldState *infraflow.PersistentState) error {
 	log.Info("deleteWithFlow")
 
-	flowContext, err := a.createFlowContext(ctx, log, infra, cluster, oldState)
+	config, err := helper.InfrastructureConfigFromInfrastructure(infra)
 	if err != nil {
 		return err
 	}
-	if err = flowContext.Delete(ctx); err != nil {
-		_ = flowContext.PersistState(ctx, true)
+	credentials, err := openstack.GetShootCredentials(ctx, a.client, infra.Spec.SecretRef)
+	if err != nil {
+		return fmt.Errorf("could not get Openstack credentials: %w", err)
+	}
+
+	// without the credentials of its technical user, the dedicated project contains no resources which must be deleted
+	if config.DedicatedProject == nil || !credentials.IsDomainScoped() {
+		flowContext, err := a.createFlowContext(ctx, log, infra, cluster, oldState, credentials)
+		if err != nil {
+			return err
+		}
+		if err = flowContext.Delete(ctx); err != nil {
+			_ = flowContext.PersistState(ctx, true)
+			return err
+		}
+		if err := flowContext.PersistState(ctx, true); err != nil {
+			return err
+		}
+	}
+
+	if err := a.releaseSharedNetwork(ctx, log, infra, config); err != nil {
 		return err
 	}
-	return flowContext.PersistState(ctx, true)
+
+	// the application credentials of the technical user of a dedicated project are revoked together with the user
+	if config.DedicatedProject != nil {
+		if err := kutil.DeleteObject(ctx, a.client, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: openstack.ApplicationCredentialSecretName, Namespace: infra.Namespace}}); err != nil {
+			return err
+		}
+		return a.deleteDedicatedProject(ctx, log, infra)
+	}
+
+	userCredentials, err := openstack.GetCredentials(ctx, a.client, infra.Spec.SecretRef, false)
+	if err != nil {
+		return fmt.Errorf("could not get Openstack credentials: %w", err)
+	}
+	return a.deleteApplicationCredential(ctx, log, infra, userCredentials)
 }
 
-func (a *actuator) deleteWithTerraformer(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+// deleteWithTerraformer deletes the infrastructure with Terraformer. If the given state initializer is set, it restores
+// a lost terraform state before.
+func (a *actuator) deleteWithTerraformer(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster, stateInitializer terraformer.StateConfigMapInitializer) error {
 	tf, err := internal.NewTerraformer(log, a.restConfig, infrastructure.TerraformerPurpose, infra, a.disableProjectedTokenMount)
 	if err != nil {
 		return util.DetermineError(fmt.Errorf("could not create the Terraformer: %+v", err), helper.KnownCodes)
@@ -70,8 +115,7 @@ func (a *actuator) deleteWithTerraformer(ctx context.Context, log logr.Logger, i
 
 	// If the Terraform state is empty then we can exit early as we didn't create anything. Though, we clean up potentially
 	// created configmaps/secrets related to the Terraformer.
-	stateIsEmpty := tf.IsStateEmpty(ctx)
-	if stateIsEmpty {
+	if stateInitializer == nil && tf.IsStateEmpty(ctx) {
 		log.Info("exiting early as infrastructure state is empty - nothing to do")
 		return tf.CleanupConfiguration(ctx)
 	}
@@ -106,8 +150,11 @@ func (a *actuator) deleteWithTerraformer(ctx context.Context, log logr.Logger, i
 		return util.DetermineError(err, helper.KnownCodes)
 	}
 
-	stateInitializer := terraformer.StateConfigMapInitializerFunc(terraformer.CreateState)
-	tf = tf.InitializeWith(ctx, terraformer.DefaultInitializer(a.client, terraformFiles.Main, terraformFiles.Variables, terraformFiles.TFVars, stateInitializer)).SetEnvVars(internal.TerraformerEnvVars(infra.Spec.SecretRef, credentials)...)
+	if stateInitializer == nil {
+		stateInitializer = terraformer.StateConfigMapInitializerFunc(terraformer.CreateState)
+	}
+	tf = tf.InitializeWith(ctx, terraformer.DefaultInitializer(a.client, terraformFiles.Main, terraformFiles.Variables, terraformFiles.TFVars, stateInitializer)).SetEnvVars(internal.TerraformerEnvVars(infra.Spec.SecretRef, credentials)...).
+		SetEnvVars(internal.TerraformerParallelismEnvVars(a.terraformerParallelism())...)
 
 	configExists, err := tf.ConfigExists(ctx)
 	if err != nil {
@@ -15,12 +15,17 @@ import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/go-logr/logr"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/helper"
 	openstackv1alpha1 "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/audit"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/circuitbreaker"
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/controller/infrastructure/infraflow"
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/controller/infrastructure/infraflow/shared"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/features"
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/internal"
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/internal/infrastructure"
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
@@ -28,6 +33,24 @@ import (
 )
 
 func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	authURL, err := circuitbreaker.KeystoneURL(ctx, a.client, infra.Spec.SecretRef, cluster, infra.Spec.Region)
+	if err != nil {
+		return err
+	}
+	if err := a.circuitBreaker.Guard(ctx, a.client, infra, authURL, infra.Spec.Region); err != nil {
+		return err
+	}
+
+	err = a.reconcile(ctx, log, infra, cluster)
+	a.circuitBreaker.Record(authURL, infra.Spec.Region, err)
+	return err
+}
+
+func (a *actuator) reconcile(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	if audit.IsEnabled(cluster) {
+		return a.auditWithFlow(ctx, log, infra, cluster)
+	}
+
 	flowState, err := a.getStateFromInfraStatus(ctx, infra)
 	if err != nil {
 		return err
@@ -42,10 +65,30 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, infra *extens
 		}
 		return a.reconcileWithFlow(ctx, log, infra, cluster, flowState)
 	}
-	return a.reconcileWithTerraformer(ctx, log, infra, cluster, terraformer.StateConfigMapInitializerFunc(terraformer.CreateState))
+
+	stateInitializer, flowState, err := a.recoverTerraformState(ctx, log, infra, false)
+	if err != nil {
+		return util.DetermineError(err, helper.KnownCodes)
+	}
+	if flowState != nil {
+		return a.reconcileWithFlow(ctx, log, infra, cluster, flowState)
+	}
+	if stateInitializer == nil {
+		stateInitializer = terraformer.StateConfigMapInitializerFunc(terraformer.CreateState)
+	}
+	return a.reconcileWithTerraformer(ctx, log, infra, cluster, stateInitializer)
 }
 
 func (a *actuator) shouldUseFlow(infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) bool {
+	// adopting existing resources, dedicated projects, application credentials, shared networks, sharing the network
+	// with other projects, node subnets and auxiliary stacks are only supported by the flow reconciler
+	if config, err := helper.InfrastructureConfigFromInfrastructure(infrastructure); err == nil && (config.Adopt != nil && *config.Adopt ||
+		config.DedicatedProject != nil || config.ApplicationCredential != nil || config.Networks.Shared != nil || len(config.Networks.ShareWithProjects) > 0 || len(config.Networks.Subnets) > 0 || config.AuxiliaryStack != nil) {
+		return true
+	}
+	if features.ExtensionFeatureGate.Enabled(features.InfrastructureFlow) {
+		return true
+	}
 	return (infrastructure.Annotations != nil && strings.EqualFold(infrastructure.Annotations[AnnotationKeyUseFlow], "true")) ||
 		(cluster.Shoot != nil && cluster.Shoot.Annotations != nil && strings.EqualFold(cluster.Shoot.Annotations[AnnotationKeyUseFlow], "true"))
 }
@@ -63,7 +106,7 @@ func (a *actuator) migrateFromTerraformerState(ctx context.Context, log logr.Log
 	// just explore the infrastructure objects by starting with an empty state
 	state := infraflow.NewPersistentState()
 
-	if err := a.updateStatusState(ctx, infra, state); err != nil {
+	if err := a.updateStatusState(ctx, infra, state, nil); err != nil {
 		return nil, fmt.Errorf("updating status state failed: %w", err)
 	}
 	log.Info("terraform state migrated successfully")
@@ -76,7 +119,15 @@ func (a *actuator) reconcileWithFlow(ctx context.Context, log logr.Logger, infra
 
 	log.Info("reconcileWithFlow")
 
-	flowContext, err := a.createFlowContext(ctx, log, infra, cluster, oldState)
+	credentials, project, err := a.reconcileCredentials(ctx, log, infra, cluster)
+	if err != nil {
+		return util.DetermineError(err, helper.KnownCodes)
+	}
+	if project != nil {
+		oldState.SetProject(project.ID, project.Name)
+	}
+
+	flowContext, err := a.createFlowContext(ctx, log, infra, cluster, oldState, credentials)
 	if err != nil {
 		return err
 	}
@@ -89,7 +140,7 @@ func (a *actuator) reconcileWithFlow(ctx context.Context, log logr.Logger, infra
 
 func (a *actuator) createFlowContext(ctx context.Context, log logr.Logger,
 	infra *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster,
-	oldState *infraflow.PersistentState) (*infraflow.FlowContext, error) {
+	oldState *infraflow.PersistentState, credentials *openstack.Credentials) (*infraflow.FlowContext, error) {
 
 	cloudProfileConfig, err := helper.CloudProfileConfigFromCluster(cluster)
 	if err != nil {
@@ -102,7 +153,7 @@ func (a *actuator) createFlowContext(ctx context.Context, log logr.Logger,
 			return nil, fmt.Errorf("cleaning up terraformer resources failed: %w", err)
 		}
 		oldState.SetTerraformCleanedUp()
-		if err := a.updateStatusState(ctx, infra, oldState); err != nil {
+		if err := a.updateStatusState(ctx, infra, oldState, nil); err != nil {
 			return nil, fmt.Errorf("updating status state failed: %w", err)
 		}
 	}
@@ -111,11 +162,15 @@ func (a *actuator) createFlowContext(ctx context.Context, log logr.Logger,
 	if err != nil {
 		return nil, err
 	}
-
-	credentials, err := openstack.GetCredentials(ctx, a.client, infra.Spec.SecretRef, false)
+	nodesCIDR, err := a.resolveSharedNetwork(ctx, log, infra, cluster, config)
 	if err != nil {
-		return nil, fmt.Errorf("could not get Openstack credentials: %w", err)
+		return nil, err
+	}
+	auxiliaryStackTemplate, err := a.auxiliaryStackTemplate(ctx, infra, cluster, config)
+	if err != nil {
+		return nil, err
 	}
+
 	clientFactory, err := openstackclient.NewOpenstackClientFromCredentials(credentials)
 	if err != nil {
 		return nil, err
@@ -131,7 +186,7 @@ func (a *actuator) createFlowContext(ctx context.Context, log logr.Logger,
 		if err := a.client.Get(ctx, infraObjectKey, infra); err != nil {
 			return err
 		}
-		return a.updateStatusState(ctx, infra, state)
+		return a.updateStatusState(ctx, infra, state, nodesCIDR)
 	}
 
 	var oldFlatState shared.FlatMap
@@ -142,10 +197,15 @@ func (a *actuator) createFlowContext(ctx context.Context, log logr.Logger,
 		oldFlatState = oldState.ToFlatMap()
 	}
 
-	return infraflow.NewFlowContext(log, clientFactory, infra, config, cloudProfileConfig, oldFlatState, persistor)
+	var podsCIDR *string
+	if cluster.Shoot != nil && cluster.Shoot.Spec.Networking != nil {
+		podsCIDR = cluster.Shoot.Spec.Networking.Pods
+	}
+
+	return infraflow.NewFlowContext(log, clientFactory, infra, config, cloudProfileConfig, podsCIDR, auxiliaryStackTemplate, infrastructure.IPv6SecurityGroupRules(config), oldFlatState, persistor)
 }
 
-func (a *actuator) updateStatusState(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, state *infraflow.PersistentState) error {
+func (a *actuator) updateStatusState(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, state *infraflow.PersistentState, nodesCIDR *string) error {
 	status, err := computeProviderStatusFromFlowState(state)
 	if err != nil {
 		return err
@@ -156,7 +216,7 @@ func (a *actuator) updateStatusState(ctx context.Context, infra *extensionsv1alp
 		return err
 	}
 
-	return a.updateProviderStatus(ctx, infra, status, stateBytes)
+	return a.updateProviderStatus(ctx, infra, status, stateBytes, nodesCIDR)
 }
 
 func (a *actuator) cleanupTerraformerResources(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure) error {
@@ -197,6 +257,7 @@ func (a *actuator) reconcileWithTerraformer(ctx context.Context, log logr.Logger
 	if err != nil {
 		return util.DetermineError(err, helper.KnownCodes)
 	}
+	tf = tf.SetEnvVars(internal.TerraformerParallelismEnvVars(a.terraformerParallelism())...)
 
 	if err := tf.
 		InitializeWith(ctx, terraformer.DefaultInitializer(a.client, terraformFiles.Main, terraformFiles.Variables, terraformFiles.TFVars, stateInitializer)).
@@ -241,6 +302,16 @@ func computeProviderStatusFromFlowState(state *infraflow.PersistentState) (*open
 			},
 		}
 	}
+	nodeSubnetsPrefix := infraflow.IdentifierNodeSubnets + shared.Separator
+	for _, key := range sets.List(sets.KeySet(state.Data)) {
+		if name, ok := strings.CutPrefix(key, nodeSubnetsPrefix); ok && shared.IsValidValue(state.Data[key]) {
+			status.Networks.Subnets = append(status.Networks.Subnets, openstackv1alpha1.Subnet{
+				Purpose: openstackv1alpha1.PurposeNodes,
+				ID:      state.Data[key],
+				Name:    pointer.String(name),
+			})
+		}
+	}
 
 	secGroupID := shared.ValidValue(state.Data[infraflow.IdentifierSecGroup])
 	if secGroupID != "" {
@@ -255,5 +326,12 @@ func computeProviderStatusFromFlowState(state *infraflow.PersistentState) (*open
 
 	status.Node.KeyName = shared.ValidValue(state.Data[infraflow.NameKeyPair])
 
+	if v := shared.ValidValue(state.Data[infraflow.IdentifierProject]); v != "" {
+		status.Project = &openstackv1alpha1.ProjectStatus{
+			ID:   v,
+			Name: shared.ValidValue(state.Data[infraflow.NameProject]),
+		}
+	}
+
 	return status, nil
 }
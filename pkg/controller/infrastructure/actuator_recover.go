@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/gardener/extensions/pkg/terraformer"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/helper"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/controller/infrastructure/infraflow/shared"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/internal"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/internal/infrastructure"
+)
+
+// reasonEmptyTerraformState is the reason of a lost terraform state which is intact but contains no resources.
+const reasonEmptyTerraformState = "empty"
+
+// recoverTerraformState checks whether the terraform state of an infrastructure which has been reconciled before is
+// missing or corrupted, e.g. because its config map has been deleted or overwritten. A lost state is recovered from the
+// copy in the status of the infrastructure if it is intact, in which case a state initializer restoring it is returned.
+// Otherwise, a minimal flow state is rebuilt from the ids in the provider status. The flow reconciler re-imports the
+// resources of the shoot with it, and discovers the resources whose ids are unknown by their names, so that they are
+// neither created twice nor leaked on deletion. If the state is intact, neither is returned.
+func (a *actuator) recoverTerraformState(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure, deleting bool) (terraformer.StateConfigMapInitializer, *infraflow.PersistentState, error) {
+	// infrastructures without provider status have never been reconciled successfully, so there is nothing to recover
+	if infra.Status.ProviderStatus == nil {
+		return nil, nil, nil
+	}
+
+	tf, err := internal.NewTerraformer(log, a.restConfig, infrastructure.TerraformerPurpose, infra, a.disableProjectedTokenMount)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not create the Terraformer: %w", err)
+	}
+	state, err := tf.GetState(ctx)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, nil, fmt.Errorf("could not get terraform state: %w", err)
+	}
+
+	stateInitializer, flowState, err := recoverLostTerraformState(log, infra, state, deleting)
+	if err != nil || flowState == nil {
+		return stateInitializer, nil, err
+	}
+	if err := a.updateStatusState(ctx, infra, flowState, nil); err != nil {
+		return nil, nil, fmt.Errorf("updating status state failed: %w", err)
+	}
+	return nil, flowState, nil
+}
+
+// recoverLostTerraformState returns a state initializer restoring the given terraform state of the infrastructure from
+// the copy in its status, or a flow state rebuilt from its provider status, if the given state is lost. An empty state
+// is not recovered on deletion, as it is left behind by a previous destruction with Terraformer.
+func recoverLostTerraformState(log logr.Logger, infra *extensionsv1alpha1.Infrastructure, state []byte, deleting bool) (terraformer.StateConfigMapInitializer, *infraflow.PersistentState, error) {
+	reason := lostTerraformStateReason(state)
+	if reason == "" || (deleting && reason == reasonEmptyTerraformState) {
+		return nil, nil, nil
+	}
+
+	if statusState, err := terraformer.UnmarshalRawState(infra.Status.State); err == nil && lostTerraformStateReason([]byte(statusState.Data)) == "" {
+		log.Info("Terraform state is lost, restoring it from the status of the infrastructure", "reason", reason)
+		return terraformer.CreateOrUpdateState{State: &statusState.Data}, nil, nil
+	}
+
+	status, err := helper.InfrastructureStatusFromRaw(infra.Status.ProviderStatus)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not decode provider status to recover lost terraform state: %w", err)
+	}
+	log.Info("Terraform state is lost, rebuilding it from the provider status and the existing resources", "reason", reason)
+	return nil, infraflow.NewPersistentStateFromInfrastructureStatus(status), nil
+}
+
+// lostTerraformStateReason returns why the given terraform state of an infrastructure which has been reconciled before
+// is considered lost, or an empty string if it is intact.
+func lostTerraformStateReason(state []byte) string {
+	if len(state) == 0 {
+		return "missing"
+	}
+
+	tfState, err := shared.UnmarshalTerraformState(state)
+	if err != nil || tfState.Version == 0 {
+		return "corrupted"
+	}
+	if len(tfState.Resources) == 0 {
+		return reasonEmptyTerraformState
+	}
+	return ""
+}
@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infrastructure
+
+import (
+	"github.com/gardener/gardener/extensions/pkg/terraformer"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/controller/infrastructure/infraflow"
+)
+
+var _ = Describe("Terraform state recovery", func() {
+	const (
+		intactState = `{"version":4,"resources":[{"mode":"managed","type":"openstack_networking_network_v2","name":"cluster","instances":[{"attributes":{"id":"network-id"}}]}]}`
+		emptyState  = `{"version":4,"resources":[]}`
+	)
+
+	Describe("#lostTerraformStateReason", func() {
+		DescribeTable("should determine why the state is lost",
+			func(state []byte, expected string) {
+				Expect(lostTerraformStateReason(state)).To(Equal(expected))
+			},
+			Entry("missing", nil, "missing"),
+			Entry("not JSON", []byte("{"), "corrupted"),
+			Entry("without version", []byte(`{"resources":[]}`), "corrupted"),
+			Entry("without resources", []byte(emptyState), "empty"),
+			Entry("intact", []byte(intactState), ""),
+		)
+	})
+
+	Describe("#recoverLostTerraformState", func() {
+		var infra *extensionsv1alpha1.Infrastructure
+
+		BeforeEach(func() {
+			infra = &extensionsv1alpha1.Infrastructure{
+				Status: extensionsv1alpha1.InfrastructureStatus{
+					DefaultStatus: extensionsv1alpha1.DefaultStatus{
+						ProviderStatus: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"openstack.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureStatus","networks":{"id":"network-id","router":{"id":"router-id"}},"node":{"keyName":"shoot--foo--bar-ssh-publickey"}}`)},
+					},
+				},
+			}
+		})
+
+		It("should recover nothing if the state is intact", func() {
+			stateInitializer, flowState, err := recoverLostTerraformState(log.Log, infra, []byte(intactState), false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stateInitializer).To(BeNil())
+			Expect(flowState).To(BeNil())
+		})
+
+		It("should restore a lost state from the copy in the status", func() {
+			rawState := &terraformer.RawState{Data: intactState, Encoding: terraformer.NoneEncoding}
+			data, err := rawState.Marshal()
+			Expect(err).NotTo(HaveOccurred())
+			infra.Status.State = &runtime.RawExtension{Raw: data}
+
+			stateInitializer, flowState, err := recoverLostTerraformState(log.Log, infra, nil, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stateInitializer).To(Equal(terraformer.CreateOrUpdateState{State: pointer.String(intactState)}))
+			Expect(flowState).To(BeNil())
+		})
+
+		DescribeTable("should rebuild a flow state from the provider status",
+			func(state []byte, deleting bool) {
+				stateInitializer, flowState, err := recoverLostTerraformState(log.Log, infra, state, deleting)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(stateInitializer).To(BeNil())
+				Expect(flowState).NotTo(BeNil())
+				Expect(flowState.Data).To(HaveKeyWithValue(infraflow.IdentifierNetwork, "network-id"))
+				Expect(flowState.Data).To(HaveKeyWithValue(infraflow.IdentifierRouter, "router-id"))
+				Expect(flowState.Data).To(HaveKeyWithValue(infraflow.NameKeyPair, "shoot--foo--bar-ssh-publickey"))
+			},
+			Entry("if the state is missing on reconciliation", nil, false),
+			Entry("if the state is missing on deletion", nil, true),
+			Entry("if the state is corrupted", []byte("{"), false),
+			Entry("if the state is empty on reconciliation", []byte(emptyState), false),
+		)
+
+		It("should rebuild a flow state if the copy in the status is lost as well", func() {
+			rawState := &terraformer.RawState{Data: emptyState, Encoding: terraformer.NoneEncoding}
+			data, err := rawState.Marshal()
+			Expect(err).NotTo(HaveOccurred())
+			infra.Status.State = &runtime.RawExtension{Raw: data}
+
+			stateInitializer, flowState, err := recoverLostTerraformState(log.Log, infra, nil, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stateInitializer).To(BeNil())
+			Expect(flowState.Data).To(HaveKeyWithValue(infraflow.IdentifierNetwork, "network-id"))
+		})
+
+		It("should recover nothing if the state is empty on deletion", func() {
+			stateInitializer, flowState, err := recoverLostTerraformState(log.Log, infra, []byte(emptyState), true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stateInitializer).To(BeNil())
+			Expect(flowState).To(BeNil())
+		})
+
+		It("should fail if the provider status cannot be decoded", func() {
+			infra.Status.ProviderStatus = &runtime.RawExtension{Raw: []byte("{")}
+
+			_, _, err := recoverLostTerraformState(log.Log, infra, nil, false)
+			Expect(err).To(MatchError(ContainSubstring("could not decode provider status")))
+		})
+	})
+})
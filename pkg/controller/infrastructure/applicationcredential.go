@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/controller/infrastructure/applicationcredential"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+	openstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
+)
+
+// ConditionTypeApplicationCredentialRotation is the type of the condition of an infrastructure reporting the rotation
+// phase of the application credential managed for the shoot.
+const ConditionTypeApplicationCredentialRotation gardencorev1beta1.ConditionType = "ApplicationCredentialRotation"
+
+// reconcileApplicationCredential returns the credentials of the application credential managed for the shoot with the
+// given user credentials. The application credential follows the certificate authorities rotation of the shoot.
+func (a *actuator) reconcileApplicationCredential(
+	ctx context.Context,
+	log logr.Logger,
+	infra *extensionsv1alpha1.Infrastructure,
+	cluster *extensionscontroller.Cluster,
+	config *api.ApplicationCredential,
+	userCredentials *openstack.Credentials,
+) (*openstack.Credentials, error) {
+	identity, userID, err := newUserIdentityClient(userCredentials, infra.Spec.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	var rotation applicationcredential.Rotation
+	if cluster != nil && cluster.Shoot != nil {
+		rotation.Phase = v1beta1helper.GetShootCARotationPhase(cluster.Shoot.Status.Credentials)
+		if credentials := cluster.Shoot.Status.Credentials; credentials != nil && credentials.Rotation != nil && credentials.Rotation.CertificateAuthorities != nil {
+			rotation.LastInitiationTime = credentials.Rotation.CertificateAuthorities.LastInitiationTime
+		}
+	}
+
+	log.Info("Reconciling application credential")
+	result, err := applicationcredential.Reconcile(ctx, a.client, identity, userID, infra.Namespace, config, userCredentials, rotation, verifyCredentials)
+	if err != nil {
+		return nil, fmt.Errorf("could not reconcile the application credential: %w", err)
+	}
+
+	message := fmt.Sprintf("Application credential %s is used.", result.ID)
+	if result.PreviousID != "" {
+		message += fmt.Sprintf(" The previous application credential %s is revoked when the rotation is completed and it is no longer used.", result.PreviousID)
+	}
+	if err := a.updateApplicationCredentialCondition(ctx, infra, string(result.Phase), message); err != nil {
+		return nil, err
+	}
+	return result.Credentials, nil
+}
+
+// retireApplicationCredential retires the application credential managed for the shoot with the given user credentials
+// when it is no longer configured, see applicationcredential.Retire.
+func (a *actuator) retireApplicationCredential(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure, userCredentials *openstack.Credentials) error {
+	if err := a.client.Get(ctx, client.ObjectKey{Namespace: infra.Namespace, Name: openstack.ApplicationCredentialSecretName}, &corev1.Secret{}); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	identity, userID, err := newUserIdentityClient(userCredentials, infra.Spec.Region)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Retiring application credential")
+	deleted, err := applicationcredential.Retire(ctx, a.client, identity, userID, infra.Namespace)
+	if err != nil {
+		return fmt.Errorf("could not retire the application credential: %w", err)
+	}
+	if !deleted {
+		log.Info("Application credential is revoked once it is no longer used")
+	}
+	return nil
+}
+
+// deleteApplicationCredential revokes the application credential managed for the shoot with the given user credentials
+// and deletes its secret, if it exists.
+func (a *actuator) deleteApplicationCredential(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure, userCredentials *openstack.Credentials) error {
+	if err := a.client.Get(ctx, client.ObjectKey{Namespace: infra.Namespace, Name: openstack.ApplicationCredentialSecretName}, &corev1.Secret{}); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	identity, userID, err := newUserIdentityClient(userCredentials, infra.Spec.Region)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Deleting application credential")
+	if err := applicationcredential.Delete(ctx, a.client, identity, userID, infra.Namespace); err != nil {
+		return fmt.Errorf("could not delete the application credential: %w", err)
+	}
+	return nil
+}
+
+func (a *actuator) updateApplicationCredentialCondition(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, reason, message string) error {
+	c := clock.RealClock{}
+	condition := v1beta1helper.GetOrInitConditionWithClock(c, infra.Status.Conditions, ConditionTypeApplicationCredentialRotation)
+	condition = v1beta1helper.UpdatedConditionWithClock(c, condition, gardencorev1beta1.ConditionTrue, reason, message)
+
+	patch := client.MergeFrom(infra.DeepCopy())
+	infra.Status.Conditions = v1beta1helper.MergeConditions(infra.Status.Conditions, condition)
+	return a.client.Status().Patch(ctx, infra, patch)
+}
+
+func newUserIdentityClient(credentials *openstack.Credentials, region string) (openstackclient.Identity, string, error) {
+	clientFactory, err := openstackclient.NewOpenstackClientFromCredentials(credentials)
+	if err != nil {
+		return nil, "", err
+	}
+	userID, err := clientFactory.UserID()
+	if err != nil {
+		return nil, "", err
+	}
+	identity, err := clientFactory.Identity(openstackclient.WithRegion(region))
+	if err != nil {
+		return nil, "", fmt.Errorf("creating identity client failed: %w", err)
+	}
+	return identity, userID, nil
+}
+
+// verifyCredentials verifies that a token can be issued with the given credentials.
+func verifyCredentials(credentials *openstack.Credentials) error {
+	_, err := openstackclient.NewOpenstackClientFromCredentials(credentials)
+	return err
+}
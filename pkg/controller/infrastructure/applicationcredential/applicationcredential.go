@@ -0,0 +1,419 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package applicationcredential manages the application credentials of shoots. The application credential of a shoot
+// is created for the user of its credentials and stored in the namespace of the shoot, where it takes precedence over
+// the credentials of the user for all resources of the shoot. It is rotated together with the certificate authorities
+// of the shoot: a new application credential is created when the rotation is prepared, and the previous one is revoked
+// when the rotation is completed, but only once no component of the shoot uses it anymore.
+package applicationcredential
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/utils"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/applicationcredentials"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+	openstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
+)
+
+const (
+	// AnnotationKeyPreviousID is the key of the annotation of the application credential secret containing the id of
+	// the previous application credential, which is revoked when the rotation is completed.
+	AnnotationKeyPreviousID = "openstack.provider.extensions.gardener.cloud/previous-application-credential-id"
+	// AnnotationKeyRotationPhase is the key of the annotation of the application credential secret containing the
+	// phase of its rotation.
+	AnnotationKeyRotationPhase = "openstack.provider.extensions.gardener.cloud/application-credential-rotation-phase"
+	// AnnotationKeyRotationInitiationTime is the key of the annotation of the application credential secret containing
+	// the initiation time of the credentials rotation of the shoot the application credential was last rotated for.
+	AnnotationKeyRotationInitiationTime = "openstack.provider.extensions.gardener.cloud/application-credential-rotation-initiation-time"
+
+	suffixLength  = 5
+	suffixCharset = "0123456789abcdefghijklmnopqrstuvwxyz"
+)
+
+// controlPlaneSecretNames are the names of the secrets in the namespace of a shoot rendered by the control plane with
+// the credentials of the shoot, e.g. for the cloud-controller-manager and the CSI drivers.
+var controlPlaneSecretNames = []string{
+	openstack.CloudProviderConfigName,
+	openstack.CloudProviderDiskConfigName,
+	openstack.CloudProviderCSIDiskConfigName,
+}
+
+// Rotation is the state of the credentials rotation of a shoot.
+type Rotation struct {
+	// Phase is the phase of the rotation.
+	Phase gardencorev1beta1.CredentialsRotationPhase
+	// LastInitiationTime is the time the rotation was last initiated.
+	LastInitiationTime *metav1.Time
+}
+
+// ApplicationCredential is the application credential managed for a shoot.
+type ApplicationCredential struct {
+	// ID is the id of the application credential.
+	ID string
+	// PreviousID is the id of the previous application credential, if it has not been revoked yet.
+	PreviousID string
+	// Phase is the phase of the rotation of the application credential.
+	Phase gardencorev1beta1.CredentialsRotationPhase
+	// Credentials are the credentials of the application credential.
+	Credentials *openstack.Credentials
+}
+
+// VerifyFunc verifies that the given credentials can be used to authenticate.
+type VerifyFunc func(credentials *openstack.Credentials) error
+
+// Reconcile ensures that the application credential of the shoot in the given namespace exists for the user with the
+// given id and follows the given credentials rotation of the shoot. The application credential is stored together with
+// the state of its rotation in the secret openstack.ApplicationCredentialSecretName in the namespace. New application
+// credentials are verified with the given function before they are stored.
+func Reconcile(
+	ctx context.Context,
+	c client.Client,
+	identity openstackclient.Identity,
+	userID, namespace string,
+	config *api.ApplicationCredential,
+	userCredentials *openstack.Credentials,
+	rotation Rotation,
+	verify VerifyFunc,
+) (*ApplicationCredential, error) {
+	if userCredentials.Password == "" {
+		return nil, fmt.Errorf("an application credential can only be managed with the password of a user")
+	}
+	if userCredentials.IsDomainScoped() {
+		return nil, fmt.Errorf("an application credential can only be managed with project-scoped credentials")
+	}
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: openstack.ApplicationCredentialSecretName, Namespace: namespace}}
+	if err := c.Get(ctx, client.ObjectKeyFromObject(secret), secret); client.IgnoreNotFound(err) != nil {
+		return nil, err
+	}
+
+	current := &ApplicationCredential{
+		ID:         string(secret.Data[openstack.ApplicationCredentialID]),
+		PreviousID: secret.Annotations[AnnotationKeyPreviousID],
+		Phase:      gardencorev1beta1.CredentialsRotationPhase(secret.Annotations[AnnotationKeyRotationPhase]),
+		Credentials: applicationCredentials(userCredentials, string(secret.Data[openstack.ApplicationCredentialID]),
+			string(secret.Data[openstack.ApplicationCredentialSecret])),
+	}
+	initiationTime := secret.Annotations[AnnotationKeyRotationInitiationTime]
+	if current.ID != "" {
+		existing, err := identity.GetApplicationCredential(userID, current.ID)
+		if err != nil {
+			return nil, err
+		}
+		if existing == nil {
+			current.ID = ""
+		}
+	}
+
+	var (
+		result  *ApplicationCredential
+		created string
+		err     error
+	)
+	switch {
+	case current.ID == "":
+		// the application credential was never created or was deleted by someone else, its rotation is kept
+		if result, err = create(identity, userID, namespace, config, userCredentials, verify); err != nil {
+			return nil, err
+		}
+		created = result.ID
+		result.PreviousID, result.Phase = current.PreviousID, current.Phase
+		if result.Phase == "" {
+			result.Phase = gardencorev1beta1.RotationCompleted
+		}
+		// a new application credential need not be rotated by a rotation initiated before it was created
+		if initiationTime == "" && rotation.LastInitiationTime != nil {
+			initiationTime = formatTime(rotation.LastInitiationTime)
+		}
+
+	case rotation.Phase == gardencorev1beta1.RotationPreparing && rotation.LastInitiationTime != nil &&
+		initiationTime != formatTime(rotation.LastInitiationTime):
+		// a previous application credential which was not revoked by an incomplete rotation is revoked now, the
+		// components of the shoot have switched to the current one since
+		if current.PreviousID != "" {
+			if err := revokeUnused(ctx, c, identity, userID, namespace, current.PreviousID); err != nil {
+				return nil, err
+			}
+		}
+		if result, err = create(identity, userID, namespace, config, userCredentials, verify); err != nil {
+			return nil, err
+		}
+		created = result.ID
+		result.PreviousID, result.Phase = current.ID, gardencorev1beta1.RotationPrepared
+		initiationTime = formatTime(rotation.LastInitiationTime)
+
+	case current.PreviousID != "" && rotation.Phase != gardencorev1beta1.RotationPreparing && rotation.Phase != gardencorev1beta1.RotationPrepared:
+		// the rotation is completed, the previous application credential is revoked as soon as the components of the
+		// shoot no longer use it, which is checked again with the next reconciliation otherwise
+		if err := verify(current.Credentials); err != nil {
+			return nil, fmt.Errorf("could not verify application credential %q: %w", current.ID, err)
+		}
+		result = current
+		inUse, err := usedByControlPlane(ctx, c, namespace, current.PreviousID)
+		if err != nil {
+			return nil, err
+		}
+		if !inUse {
+			if err := identity.DeleteApplicationCredential(userID, current.PreviousID); err != nil {
+				return nil, fmt.Errorf("could not revoke application credential %q: %w", current.PreviousID, err)
+			}
+			result.PreviousID, result.Phase = "", gardencorev1beta1.RotationCompleted
+		}
+
+	default:
+		result = current
+		if result.PreviousID == "" {
+			result.Phase = gardencorev1beta1.RotationCompleted
+		}
+	}
+
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, c, secret, func() error {
+		secret.Type = corev1.SecretTypeOpaque
+		secret.Data = secretData(result.Credentials)
+		setAnnotation(secret, AnnotationKeyPreviousID, result.PreviousID)
+		setAnnotation(secret, AnnotationKeyRotationPhase, string(result.Phase))
+		setAnnotation(secret, AnnotationKeyRotationInitiationTime, initiationTime)
+		setAnnotation(secret, openstack.ApplicationCredentialRetiredAnnotation, "")
+		return nil
+	}); err != nil {
+		err = fmt.Errorf("could not store the application credential: %w", err)
+		// the secret of a new application credential is only returned on creation, it is useless if it is not stored
+		if created != "" {
+			if revokeErr := identity.DeleteApplicationCredential(userID, created); revokeErr != nil {
+				err = errors.Join(err, fmt.Errorf("could not revoke application credential %q: %w", created, revokeErr))
+			}
+		}
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Retire retires the application credential of the shoot in the given namespace when it is no longer configured. The
+// secret containing it is marked as retired first, so that the components of the shoot switch to the credentials of the
+// user. With a later reconciliation, once no component of the shoot uses the application credential anymore, the
+// application credentials are revoked for the user with the given id and the secret is deleted. It returns whether the
+// secret is deleted.
+func Retire(ctx context.Context, c client.Client, identity openstackclient.Identity, userID, namespace string) (bool, error) {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: openstack.ApplicationCredentialSecretName, Namespace: namespace}}
+	if err := c.Get(ctx, client.ObjectKeyFromObject(secret), secret); err != nil {
+		return true, client.IgnoreNotFound(err)
+	}
+
+	if secret.Annotations[openstack.ApplicationCredentialRetiredAnnotation] != "true" {
+		patch := client.MergeFrom(secret.DeepCopy())
+		metav1.SetMetaDataAnnotation(&secret.ObjectMeta, openstack.ApplicationCredentialRetiredAnnotation, "true")
+		return false, c.Patch(ctx, secret, patch)
+	}
+
+	inUse, err := usedByMachineClasses(ctx, c, namespace)
+	if err != nil || inUse {
+		return false, err
+	}
+	for _, id := range ids(secret) {
+		if inUse, err := usedByControlPlane(ctx, c, namespace, id); err != nil || inUse {
+			return false, err
+		}
+	}
+
+	if err := Delete(ctx, c, identity, userID, namespace); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Delete revokes the application credential of the shoot in the given namespace, as well as the previous one of an
+// incomplete rotation, and deletes the secret containing it. The application credentials are revoked for the user with
+// the given id. It must only be used when the shoot is deleted, see Retire otherwise.
+func Delete(ctx context.Context, c client.Client, identity openstackclient.Identity, userID, namespace string) error {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: openstack.ApplicationCredentialSecretName, Namespace: namespace}}
+	if err := c.Get(ctx, client.ObjectKeyFromObject(secret), secret); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	for _, id := range ids(secret) {
+		if err := identity.DeleteApplicationCredential(userID, id); err != nil {
+			return fmt.Errorf("could not revoke application credential %q: %w", id, err)
+		}
+	}
+
+	return kutil.DeleteObject(ctx, c, secret)
+}
+
+// revokeUnused revokes the application credential with the given id, unless it is still used by the control plane of
+// the shoot in the given namespace.
+func revokeUnused(ctx context.Context, c client.Client, identity openstackclient.Identity, userID, namespace, id string) error {
+	inUse, err := usedByControlPlane(ctx, c, namespace, id)
+	if err != nil {
+		return err
+	}
+	if inUse {
+		return fmt.Errorf("application credential %q is still used by the control plane", id)
+	}
+	if err := identity.DeleteApplicationCredential(userID, id); err != nil {
+		return fmt.Errorf("could not revoke application credential %q: %w", id, err)
+	}
+	return nil
+}
+
+// usedByControlPlane returns whether one of the secrets rendered by the control plane of the shoot in the given namespace
+// still contains the application credential with the given id. The machine classes reference the secret of the
+// application credential instead, hence they always use the current one.
+func usedByControlPlane(ctx context.Context, c client.Client, namespace, id string) (bool, error) {
+	for _, name := range controlPlaneSecretNames {
+		secret := &corev1.Secret{}
+		if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, secret); err != nil {
+			if client.IgnoreNotFound(err) == nil {
+				continue
+			}
+			return false, err
+		}
+		for _, value := range secret.Data {
+			if bytes.Contains(value, []byte(id)) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// usedByMachineClasses returns whether one of the machine classes of the shoot in the given namespace still references
+// the secret of the application credential.
+func usedByMachineClasses(ctx context.Context, c client.Client, namespace string) (bool, error) {
+	machineClasses := &machinev1alpha1.MachineClassList{}
+	if err := c.List(ctx, machineClasses, client.InNamespace(namespace)); err != nil {
+		return false, err
+	}
+	for _, machineClass := range machineClasses.Items {
+		if ref := machineClass.CredentialsSecretRef; ref != nil && ref.Name == openstack.ApplicationCredentialSecretName {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func ids(secret *corev1.Secret) []string {
+	var ids []string
+	for _, id := range []string{secret.Annotations[AnnotationKeyPreviousID], string(secret.Data[openstack.ApplicationCredentialID])} {
+		if id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// create creates a new application credential for the user with the given id and verifies it. Application credential
+// names must be unique per user, hence they are named after the namespace with a random suffix.
+func create(
+	identity openstackclient.Identity,
+	userID, namespace string,
+	config *api.ApplicationCredential,
+	userCredentials *openstack.Credentials,
+	verify VerifyFunc,
+) (*ApplicationCredential, error) {
+	suffix, err := utils.GenerateRandomStringFromCharset(suffixLength, suffixCharset)
+	if err != nil {
+		return nil, err
+	}
+	name := namespace + "-" + suffix
+
+	var roles []applicationcredentials.Role
+	if config != nil {
+		for _, role := range config.Roles {
+			roles = append(roles, applicationcredentials.Role{Name: role})
+		}
+	}
+
+	applicationCredential, err := identity.CreateApplicationCredential(userID, applicationcredentials.CreateOpts{
+		Name:        name,
+		Description: description(namespace),
+		Roles:       roles,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not create application credential %q: %w", name, err)
+	}
+
+	credentials := applicationCredentials(userCredentials, applicationCredential.ID, applicationCredential.Secret)
+	if err := verify(credentials); err != nil {
+		// the secret of the application credential is only returned on creation, it is useless if it is not stored
+		if err := identity.DeleteApplicationCredential(userID, applicationCredential.ID); err != nil {
+			return nil, fmt.Errorf("could not revoke application credential %q: %w", name, err)
+		}
+		return nil, fmt.Errorf("could not verify application credential %q: %w", name, err)
+	}
+
+	return &ApplicationCredential{ID: applicationCredential.ID, Credentials: credentials}, nil
+}
+
+func applicationCredentials(userCredentials *openstack.Credentials, id, secret string) *openstack.Credentials {
+	return &openstack.Credentials{
+		DomainName:                  userCredentials.DomainName,
+		DomainID:                    userCredentials.DomainID,
+		TenantName:                  userCredentials.TenantName,
+		TenantID:                    userCredentials.TenantID,
+		ApplicationCredentialID:     id,
+		ApplicationCredentialSecret: secret,
+		AuthURL:                     userCredentials.AuthURL,
+		CACert:                      userCredentials.CACert,
+		Insecure:                    userCredentials.Insecure,
+		EndpointOverrides:           userCredentials.EndpointOverrides,
+	}
+}
+
+func secretData(credentials *openstack.Credentials) map[string][]byte {
+	data := map[string][]byte{
+		openstack.ApplicationCredentialID:     []byte(credentials.ApplicationCredentialID),
+		openstack.ApplicationCredentialSecret: []byte(credentials.ApplicationCredentialSecret),
+	}
+	for key, value := range map[string]string{
+		openstack.DomainName: credentials.DomainName,
+		openstack.DomainID:   credentials.DomainID,
+		openstack.TenantName: credentials.TenantName,
+		openstack.TenantID:   credentials.TenantID,
+		openstack.AuthURL:    credentials.AuthURL,
+		openstack.CACert:     credentials.CACert,
+	} {
+		if value != "" {
+			data[key] = []byte(value)
+		}
+	}
+	if credentials.Insecure {
+		data[openstack.Insecure] = []byte("true")
+	}
+	if len(credentials.EndpointOverrides) > 0 {
+		data[openstack.EndpointOverrides] = openstack.MarshalEndpointOverrides(credentials.EndpointOverrides)
+	}
+	return data
+}
+
+func setAnnotation(secret *corev1.Secret, key, value string) {
+	if value == "" {
+		delete(secret.Annotations, key)
+		return
+	}
+	metav1.SetMetaDataAnnotation(&secret.ObjectMeta, key, value)
+}
+
+func formatTime(t *metav1.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+func description(namespace string) string {
+	return fmt.Sprintf("Used by the shoot %s, managed by Gardener", namespace)
+}
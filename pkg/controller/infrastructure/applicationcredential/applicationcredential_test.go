@@ -0,0 +1,443 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package applicationcredential_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/applicationcredentials"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	. "github.com/gardener/gardener-extension-provider-openstack/pkg/controller/infrastructure/applicationcredential"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+	mockopenstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client/mocks"
+)
+
+var _ = Describe("ApplicationCredential", func() {
+	const (
+		namespace      = "shoot--foo--bar"
+		userID         = "user-id"
+		description    = "Used by the shoot shoot--foo--bar, managed by Gardener"
+		initiationTime = "2024-01-02T03:04:05Z"
+	)
+
+	var (
+		ctx      context.Context
+		ctrl     *gomock.Controller
+		scheme   *runtime.Scheme
+		c        client.Client
+		identity *mockopenstackclient.MockIdentity
+
+		userCredentials *openstack.Credentials
+		rotation        Rotation
+		verified        []string
+		verify          VerifyFunc
+		secretKey       client.ObjectKey
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		ctrl = gomock.NewController(GinkgoT())
+		scheme = runtime.NewScheme()
+		Expect(kubernetesscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(machinev1alpha1.AddToScheme(scheme)).To(Succeed())
+		c = fakeclient.NewClientBuilder().WithScheme(scheme).Build()
+		identity = mockopenstackclient.NewMockIdentity(ctrl)
+
+		userCredentials = &openstack.Credentials{
+			DomainName: "domain",
+			TenantName: "project",
+			Username:   "user",
+			Password:   "password",
+			AuthURL:    "https://keystone",
+		}
+		rotation = Rotation{}
+		verified = nil
+		verify = func(credentials *openstack.Credentials) error {
+			verified = append(verified, credentials.ApplicationCredentialID)
+			return nil
+		}
+		secretKey = client.ObjectKey{Namespace: namespace, Name: openstack.ApplicationCredentialSecretName}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	expectCreate := func(id string) {
+		identity.EXPECT().CreateApplicationCredential(userID, gomock.Any()).DoAndReturn(func(_ string, opts applicationcredentials.CreateOpts) (*applicationcredentials.ApplicationCredential, error) {
+			Expect(opts.Name).To(HavePrefix(namespace + "-"))
+			Expect(opts.Description).To(Equal(description))
+			Expect(opts.Unrestricted).To(BeFalse())
+			return &applicationcredentials.ApplicationCredential{ID: id, Name: opts.Name, Secret: id + "-secret"}, nil
+		})
+	}
+
+	createSecret := func(id, previousID string, phase gardencorev1beta1.CredentialsRotationPhase) {
+		annotations := map[string]string{
+			AnnotationKeyRotationPhase:          string(phase),
+			AnnotationKeyRotationInitiationTime: initiationTime,
+		}
+		if previousID != "" {
+			annotations[AnnotationKeyPreviousID] = previousID
+		}
+		Expect(c.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: secretKey.Name, Namespace: secretKey.Namespace, Annotations: annotations},
+			Data: map[string][]byte{
+				openstack.ApplicationCredentialID:     []byte(id),
+				openstack.ApplicationCredentialSecret: []byte(id + "-secret"),
+			},
+		})).To(Succeed())
+	}
+
+	createControlPlaneSecret := func(id string) {
+		Expect(c.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: openstack.CloudProviderConfigName, Namespace: namespace},
+			Data:       map[string][]byte{"cloudprovider.conf": []byte(`application-credential-id="` + id + `"`)},
+		})).To(Succeed())
+	}
+
+	startRotation := func(phase gardencorev1beta1.CredentialsRotationPhase) {
+		t, err := time.Parse(time.RFC3339, "2024-02-03T04:05:06Z")
+		Expect(err).NotTo(HaveOccurred())
+		rotation = Rotation{Phase: phase, LastInitiationTime: &metav1.Time{Time: t}}
+	}
+
+	Describe("#Reconcile", func() {
+		It("should create the application credential and the secret", func() {
+			identity.EXPECT().CreateApplicationCredential(userID, gomock.Any()).DoAndReturn(func(_ string, opts applicationcredentials.CreateOpts) (*applicationcredentials.ApplicationCredential, error) {
+				Expect(opts.Roles).To(ConsistOf(applicationcredentials.Role{Name: "member"}))
+				return &applicationcredentials.ApplicationCredential{ID: "new", Secret: "new-secret"}, nil
+			})
+
+			result, err := Reconcile(ctx, c, identity, userID, namespace, &api.ApplicationCredential{Roles: []string{"member"}}, userCredentials, rotation, verify)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.ID).To(Equal("new"))
+			Expect(result.PreviousID).To(BeEmpty())
+			Expect(result.Phase).To(Equal(gardencorev1beta1.RotationCompleted))
+			Expect(result.Credentials.Username).To(BeEmpty())
+			Expect(result.Credentials.Password).To(BeEmpty())
+			Expect(verified).To(Equal([]string{"new"}))
+
+			secret := &corev1.Secret{}
+			Expect(c.Get(ctx, secretKey, secret)).To(Succeed())
+			Expect(secret.Data).To(Equal(map[string][]byte{
+				openstack.ApplicationCredentialID:     []byte("new"),
+				openstack.ApplicationCredentialSecret: []byte("new-secret"),
+				openstack.DomainName:                  []byte("domain"),
+				openstack.TenantName:                  []byte("project"),
+				openstack.AuthURL:                     []byte("https://keystone"),
+			}))
+			Expect(secret.Annotations).To(Equal(map[string]string{AnnotationKeyRotationPhase: "Completed"}))
+
+			credentials, err := openstack.ExtractCredentials(secret, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(credentials).To(Equal(result.Credentials))
+		})
+
+		It("should store the endpoint overrides of the user credentials", func() {
+			userCredentials.EndpointOverrides = map[string]string{"volumev3": "https://cinder.internal"}
+			expectCreate("new")
+
+			result, err := Reconcile(ctx, c, identity, userID, namespace, nil, userCredentials, rotation, verify)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Credentials.EndpointOverrides).To(Equal(userCredentials.EndpointOverrides))
+
+			secret := &corev1.Secret{}
+			Expect(c.Get(ctx, secretKey, secret)).To(Succeed())
+			Expect(secret.Data).To(HaveKeyWithValue(openstack.EndpointOverrides, []byte(`{"volumev3":"https://cinder.internal"}`)))
+
+			credentials, err := openstack.ExtractCredentials(secret, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(credentials).To(Equal(result.Credentials))
+		})
+
+		It("should keep an existing application credential", func() {
+			createSecret("current", "", gardencorev1beta1.RotationCompleted)
+			identity.EXPECT().GetApplicationCredential(userID, "current").Return(&applicationcredentials.ApplicationCredential{ID: "current"}, nil)
+
+			result, err := Reconcile(ctx, c, identity, userID, namespace, &api.ApplicationCredential{}, userCredentials, rotation, verify)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.ID).To(Equal("current"))
+			Expect(result.Credentials.ApplicationCredentialSecret).To(Equal("current-secret"))
+			Expect(verified).To(BeEmpty())
+		})
+
+		It("should recreate an application credential which was deleted", func() {
+			createSecret("current", "", gardencorev1beta1.RotationCompleted)
+			identity.EXPECT().GetApplicationCredential(userID, "current").Return(nil, nil)
+			expectCreate("new")
+
+			result, err := Reconcile(ctx, c, identity, userID, namespace, &api.ApplicationCredential{}, userCredentials, rotation, verify)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.ID).To(Equal("new"))
+			Expect(result.PreviousID).To(BeEmpty())
+		})
+
+		It("should create a new application credential when the rotation is prepared", func() {
+			createSecret("current", "", gardencorev1beta1.RotationCompleted)
+			startRotation(gardencorev1beta1.RotationPreparing)
+			identity.EXPECT().GetApplicationCredential(userID, "current").Return(&applicationcredentials.ApplicationCredential{ID: "current"}, nil)
+			expectCreate("new")
+
+			result, err := Reconcile(ctx, c, identity, userID, namespace, &api.ApplicationCredential{}, userCredentials, rotation, verify)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.ID).To(Equal("new"))
+			Expect(result.PreviousID).To(Equal("current"))
+			Expect(result.Phase).To(Equal(gardencorev1beta1.RotationPrepared))
+
+			secret := &corev1.Secret{}
+			Expect(c.Get(ctx, secretKey, secret)).To(Succeed())
+			Expect(secret.Annotations).To(Equal(map[string]string{
+				AnnotationKeyPreviousID:             "current",
+				AnnotationKeyRotationPhase:          "Prepared",
+				AnnotationKeyRotationInitiationTime: "2024-02-03T04:05:06Z",
+			}))
+
+			By("not rotating the application credential again for the same rotation")
+			identity.EXPECT().GetApplicationCredential(userID, "new").Return(&applicationcredentials.ApplicationCredential{ID: "new"}, nil)
+
+			result, err = Reconcile(ctx, c, identity, userID, namespace, &api.ApplicationCredential{}, userCredentials, rotation, verify)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.ID).To(Equal("new"))
+			Expect(result.PreviousID).To(Equal("current"))
+			Expect(result.Phase).To(Equal(gardencorev1beta1.RotationPrepared))
+		})
+
+		It("should revoke the previous application credential when the rotation is completed", func() {
+			createSecret("new", "current", gardencorev1beta1.RotationPrepared)
+			startRotation(gardencorev1beta1.RotationCompleting)
+			gomock.InOrder(
+				identity.EXPECT().GetApplicationCredential(userID, "new").Return(&applicationcredentials.ApplicationCredential{ID: "new"}, nil),
+				identity.EXPECT().DeleteApplicationCredential(userID, "current").Return(nil),
+			)
+
+			result, err := Reconcile(ctx, c, identity, userID, namespace, &api.ApplicationCredential{}, userCredentials, rotation, verify)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.ID).To(Equal("new"))
+			Expect(result.PreviousID).To(BeEmpty())
+			Expect(result.Phase).To(Equal(gardencorev1beta1.RotationCompleted))
+			Expect(verified).To(Equal([]string{"new"}))
+
+			secret := &corev1.Secret{}
+			Expect(c.Get(ctx, secretKey, secret)).To(Succeed())
+			Expect(secret.Annotations).To(Equal(map[string]string{
+				AnnotationKeyRotationPhase:          "Completed",
+				AnnotationKeyRotationInitiationTime: initiationTime,
+			}))
+		})
+
+		It("should not revoke the previous application credential if the new one cannot be verified", func() {
+			createSecret("new", "current", gardencorev1beta1.RotationPrepared)
+			startRotation(gardencorev1beta1.RotationCompleting)
+			identity.EXPECT().GetApplicationCredential(userID, "new").Return(&applicationcredentials.ApplicationCredential{ID: "new"}, nil)
+
+			_, err := Reconcile(ctx, c, identity, userID, namespace, &api.ApplicationCredential{}, userCredentials, rotation, func(*openstack.Credentials) error {
+				return fmt.Errorf("unauthorized")
+			})
+			Expect(err).To(MatchError(ContainSubstring("unauthorized")))
+		})
+
+		It("should not revoke the previous application credential while the control plane still uses it", func() {
+			createSecret("new", "current", gardencorev1beta1.RotationPrepared)
+			createControlPlaneSecret("current")
+			startRotation(gardencorev1beta1.RotationCompleting)
+			identity.EXPECT().GetApplicationCredential(userID, "new").Return(&applicationcredentials.ApplicationCredential{ID: "new"}, nil)
+
+			result, err := Reconcile(ctx, c, identity, userID, namespace, &api.ApplicationCredential{}, userCredentials, rotation, verify)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.ID).To(Equal("new"))
+			Expect(result.PreviousID).To(Equal("current"))
+			Expect(result.Phase).To(Equal(gardencorev1beta1.RotationPrepared))
+
+			By("revoking it with a later reconciliation once the control plane uses the new one")
+			Expect(c.Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: openstack.CloudProviderConfigName, Namespace: namespace}})).To(Succeed())
+			createControlPlaneSecret("new")
+			startRotation(gardencorev1beta1.RotationCompleted)
+			gomock.InOrder(
+				identity.EXPECT().GetApplicationCredential(userID, "new").Return(&applicationcredentials.ApplicationCredential{ID: "new"}, nil),
+				identity.EXPECT().DeleteApplicationCredential(userID, "current").Return(nil),
+			)
+
+			result, err = Reconcile(ctx, c, identity, userID, namespace, &api.ApplicationCredential{}, userCredentials, rotation, verify)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.PreviousID).To(BeEmpty())
+			Expect(result.Phase).To(Equal(gardencorev1beta1.RotationCompleted))
+		})
+
+		It("should revoke a new application credential which cannot be stored", func() {
+			c = fakeclient.NewClientBuilder().WithScheme(scheme).WithInterceptorFuncs(interceptor.Funcs{
+				Create: func(context.Context, client.WithWatch, client.Object, ...client.CreateOption) error {
+					return fmt.Errorf("conflict")
+				},
+			}).Build()
+			expectCreate("new")
+			identity.EXPECT().DeleteApplicationCredential(userID, "new").Return(nil)
+
+			_, err := Reconcile(ctx, c, identity, userID, namespace, &api.ApplicationCredential{}, userCredentials, rotation, verify)
+			Expect(err).To(MatchError(ContainSubstring("conflict")))
+		})
+
+		It("should not revoke an existing application credential which cannot be stored", func() {
+			createSecret("current", "", gardencorev1beta1.RotationCompleted)
+			c = fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(getSecret(ctx, c, secretKey)).WithInterceptorFuncs(interceptor.Funcs{
+				Patch: func(context.Context, client.WithWatch, client.Object, client.Patch, ...client.PatchOption) error {
+					return fmt.Errorf("conflict")
+				},
+			}).Build()
+			identity.EXPECT().GetApplicationCredential(userID, "current").Return(&applicationcredentials.ApplicationCredential{ID: "current"}, nil)
+
+			_, err := Reconcile(ctx, c, identity, userID, namespace, &api.ApplicationCredential{}, userCredentials, rotation, verify)
+			Expect(err).To(MatchError(ContainSubstring("conflict")))
+		})
+
+		It("should not revoke the previous application credential of an incomplete rotation while the control plane still uses it", func() {
+			createSecret("new", "current", gardencorev1beta1.RotationPrepared)
+			createControlPlaneSecret("current")
+			startRotation(gardencorev1beta1.RotationPreparing)
+			identity.EXPECT().GetApplicationCredential(userID, "new").Return(&applicationcredentials.ApplicationCredential{ID: "new"}, nil)
+
+			_, err := Reconcile(ctx, c, identity, userID, namespace, &api.ApplicationCredential{}, userCredentials, rotation, verify)
+			Expect(err).To(MatchError(ContainSubstring("still used by the control plane")))
+		})
+
+		It("should clear the retired annotation when the application credential is configured again", func() {
+			createSecret("current", "", gardencorev1beta1.RotationCompleted)
+			secret := getSecret(ctx, c, secretKey)
+			metav1.SetMetaDataAnnotation(&secret.ObjectMeta, openstack.ApplicationCredentialRetiredAnnotation, "true")
+			Expect(c.Update(ctx, secret)).To(Succeed())
+			identity.EXPECT().GetApplicationCredential(userID, "current").Return(&applicationcredentials.ApplicationCredential{ID: "current"}, nil)
+
+			_, err := Reconcile(ctx, c, identity, userID, namespace, &api.ApplicationCredential{}, userCredentials, rotation, verify)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(getSecret(ctx, c, secretKey).Annotations).NotTo(HaveKey(openstack.ApplicationCredentialRetiredAnnotation))
+		})
+
+		It("should revoke a new application credential which cannot be verified", func() {
+			expectCreate("new")
+			identity.EXPECT().DeleteApplicationCredential(userID, "new").Return(nil)
+
+			_, err := Reconcile(ctx, c, identity, userID, namespace, &api.ApplicationCredential{}, userCredentials, rotation, func(*openstack.Credentials) error {
+				return fmt.Errorf("unauthorized")
+			})
+			Expect(err).To(MatchError(ContainSubstring("unauthorized")))
+			Expect(c.Get(ctx, secretKey, &corev1.Secret{})).To(BeNotFoundError())
+		})
+
+		It("should fail for application credentials", func() {
+			userCredentials.Password = ""
+			userCredentials.ApplicationCredentialID = "id"
+			userCredentials.ApplicationCredentialSecret = "secret"
+
+			_, err := Reconcile(ctx, c, identity, userID, namespace, &api.ApplicationCredential{}, userCredentials, rotation, verify)
+			Expect(err).To(MatchError(ContainSubstring("password of a user")))
+		})
+
+		It("should fail for domain-scoped credentials", func() {
+			userCredentials.TenantName = ""
+			userCredentials.AuthScope = openstack.AuthScopeDomain
+
+			_, err := Reconcile(ctx, c, identity, userID, namespace, &api.ApplicationCredential{}, userCredentials, rotation, verify)
+			Expect(err).To(MatchError(ContainSubstring("project-scoped credentials")))
+		})
+	})
+
+	Describe("#Retire", func() {
+		It("should mark the secret as retired first", func() {
+			createSecret("new", "current", gardencorev1beta1.RotationPrepared)
+
+			deleted, err := Retire(ctx, c, identity, userID, namespace)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deleted).To(BeFalse())
+			Expect(getSecret(ctx, c, secretKey).Annotations).To(HaveKeyWithValue(openstack.ApplicationCredentialRetiredAnnotation, "true"))
+		})
+
+		Context("retired secret", func() {
+			BeforeEach(func() {
+				createSecret("new", "current", gardencorev1beta1.RotationPrepared)
+				_, err := Retire(ctx, c, identity, userID, namespace)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should keep the application credentials while the machine classes reference the secret", func() {
+				Expect(c.Create(ctx, &machinev1alpha1.MachineClass{
+					ObjectMeta:           metav1.ObjectMeta{Name: "machine-class", Namespace: namespace},
+					CredentialsSecretRef: &corev1.SecretReference{Name: secretKey.Name, Namespace: namespace},
+				})).To(Succeed())
+
+				deleted, err := Retire(ctx, c, identity, userID, namespace)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(deleted).To(BeFalse())
+				Expect(c.Get(ctx, secretKey, &corev1.Secret{})).To(Succeed())
+			})
+
+			It("should keep the application credentials while the control plane uses them", func() {
+				createControlPlaneSecret("new")
+
+				deleted, err := Retire(ctx, c, identity, userID, namespace)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(deleted).To(BeFalse())
+				Expect(c.Get(ctx, secretKey, &corev1.Secret{})).To(Succeed())
+			})
+
+			It("should revoke the application credentials and delete the secret once they are no longer used", func() {
+				createControlPlaneSecret("")
+				gomock.InOrder(
+					identity.EXPECT().DeleteApplicationCredential(userID, "current").Return(nil),
+					identity.EXPECT().DeleteApplicationCredential(userID, "new").Return(nil),
+				)
+
+				deleted, err := Retire(ctx, c, identity, userID, namespace)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(deleted).To(BeTrue())
+				Expect(c.Get(ctx, secretKey, &corev1.Secret{})).To(BeNotFoundError())
+			})
+		})
+
+		It("should succeed if the secret does not exist", func() {
+			deleted, err := Retire(ctx, c, identity, userID, namespace)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deleted).To(BeTrue())
+		})
+	})
+
+	Describe("#Delete", func() {
+		It("should revoke the application credentials and delete the secret", func() {
+			createSecret("new", "current", gardencorev1beta1.RotationPrepared)
+			gomock.InOrder(
+				identity.EXPECT().DeleteApplicationCredential(userID, "current").Return(nil),
+				identity.EXPECT().DeleteApplicationCredential(userID, "new").Return(nil),
+			)
+
+			Expect(Delete(ctx, c, identity, userID, namespace)).To(Succeed())
+			Expect(c.Get(ctx, secretKey, &corev1.Secret{})).To(BeNotFoundError())
+		})
+
+		It("should succeed if the secret does not exist", func() {
+			Expect(Delete(ctx, c, identity, userID, namespace)).To(Succeed())
+		})
+	})
+})
+
+func getSecret(ctx context.Context, c client.Client, key client.ObjectKey) *corev1.Secret {
+	secret := &corev1.Secret{}
+	ExpectWithOffset(1, c.Get(ctx, key, secret)).To(Succeed())
+	return secret
+}
@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/pointer"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+)
+
+// auxiliaryStackTemplate reads the Heat template of the auxiliary stack of the given InfrastructureConfig from the
+// ConfigMap referenced in the resources of the shoot, which gardener copies into the namespace of the shoot. The
+// template is not needed for shoots in deletion, as the stack is deleted by its id.
+func (a *actuator) auxiliaryStackTemplate(ctx context.Context, infra *extensionsv1alpha1.Infrastructure,
+	cluster *extensionscontroller.Cluster, config *api.InfrastructureConfig) (*string, error) {
+	if config.AuxiliaryStack == nil || infra.DeletionTimestamp != nil {
+		return nil, nil
+	}
+
+	templateRef := config.AuxiliaryStack.TemplateRef
+	if cluster.Shoot == nil {
+		return nil, fmt.Errorf("missing shoot in cluster to resolve resource %q", templateRef.ResourceName)
+	}
+	resource := v1beta1helper.GetResourceByName(cluster.Shoot.Spec.Resources, templateRef.ResourceName)
+	if resource == nil {
+		return nil, fmt.Errorf("resource %q of the template of the auxiliary stack not found in the resources of the shoot", templateRef.ResourceName)
+	}
+	if resource.ResourceRef.Kind != "ConfigMap" {
+		return nil, fmt.Errorf("resource %q of the template of the auxiliary stack is not a ConfigMap", templateRef.ResourceName)
+	}
+
+	configMap := &corev1.ConfigMap{}
+	if err := extensionscontroller.GetObjectByReference(ctx, a.client, &resource.ResourceRef, infra.Namespace, configMap); err != nil {
+		return nil, fmt.Errorf("could not get ConfigMap of the template of the auxiliary stack: %w", err)
+	}
+	key := pointer.StringDeref(templateRef.Key, api.DefaultAuxiliaryStackTemplateKey)
+	template, ok := configMap.Data[key]
+	if !ok {
+		return nil, fmt.Errorf("ConfigMap %s of the template of the auxiliary stack has no key %q", configMap.Name, key)
+	}
+	return &template, nil
+}
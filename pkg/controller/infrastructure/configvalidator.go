@@ -51,11 +51,16 @@ func (c *configValidator) Validate(ctx context.Context, infra *extensionsv1alpha
 	}
 
 	// Create openstack networking client
-	credentials, err := openstack.GetCredentials(ctx, c.client, infra.Spec.SecretRef, false)
+	credentials, err := openstack.GetShootCredentials(ctx, c.client, infra.Spec.SecretRef)
 	if err != nil {
 		allErrs = append(allErrs, field.InternalError(nil, fmt.Errorf("could not get Openstack credentials: %+v", err)))
 		return allErrs
 	}
+	if credentials.IsDomainScoped() {
+		// the dedicated project of the shoot does not exist yet, its networks are validated once it has been created
+		logger.Info("Skipping validation of infrastructure configuration with domain-scoped credentials")
+		return allErrs
+	}
 	clientFactory, err := c.clientFactoryFactory.NewFactory(credentials)
 	if err != nil {
 		allErrs = append(allErrs, field.InternalError(nil, fmt.Errorf("could not create Openstack client factory: %+v", err)))
@@ -70,6 +75,9 @@ func (c *configValidator) Validate(ctx context.Context, infra *extensionsv1alpha
 	// Validate infrastructure config
 	logger.Info("Validating infrastructure configuration")
 	allErrs = append(allErrs, c.validateFloatingPoolName(ctx, networkingClient, config.FloatingPoolName, field.NewPath("floatingPoolName"))...)
+	for i, pool := range config.AdditionalFloatingPools {
+		allErrs = append(allErrs, c.validateFloatingPoolName(ctx, networkingClient, pool.Name, field.NewPath("additionalFloatingPools").Index(i).Child("name"))...)
+	}
 
 	return allErrs
 }
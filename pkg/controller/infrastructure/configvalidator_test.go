@@ -21,6 +21,7 @@ import (
 	. "github.com/onsi/gomega/gstruct"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -65,6 +66,10 @@ var _ = Describe("ConfigValidator", func() {
 		ctrl = gomock.NewController(GinkgoT())
 
 		c = mockclient.NewMockClient(ctrl)
+		c.EXPECT().Get(gomock.Any(), kutil.Key(namespace, openstack.ApplicationCredentialSecretName), gomock.AssignableToTypeOf(&corev1.Secret{})).
+			Return(apierrors.NewNotFound(corev1.Resource("secrets"), openstack.ApplicationCredentialSecretName)).AnyTimes()
+		c.EXPECT().Get(gomock.Any(), kutil.Key(namespace, openstack.DedicatedProjectSecretName), gomock.AssignableToTypeOf(&corev1.Secret{})).
+			Return(apierrors.NewNotFound(corev1.Resource("secrets"), openstack.DedicatedProjectSecretName)).AnyTimes()
 		openstackClientFactoryFactory = mockopenstackclient.NewMockFactoryFactory(ctrl)
 		openstackClientFactory = mockopenstackclient.NewMockFactory(ctrl)
 		networkingClient = mockopenstackclient.NewMockNetworking(ctrl)
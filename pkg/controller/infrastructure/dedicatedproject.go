@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/helper"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/controller/infrastructure/dedicatedproject"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+	openstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
+)
+
+// reconcileCredentials returns the credentials the infrastructure resources of the shoot are managed with. If the shoot
+// has a dedicated project, the project is reconciled with the domain-scoped credentials in the cloud provider secret and
+// returned together with the credentials of its technical user. If an application credential is configured, it is
+// reconciled for the user of these credentials and its credentials are returned instead.
+func (a *actuator) reconcileCredentials(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) (*openstack.Credentials, *dedicatedproject.Project, error) {
+	config, err := helper.InfrastructureConfigFromInfrastructure(infra)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	credentials, err := openstack.GetCredentials(ctx, a.client, infra.Spec.SecretRef, false)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not get Openstack credentials: %w", err)
+	}
+
+	var project *dedicatedproject.Project
+	if config.DedicatedProject != nil {
+		identity, domainID, err := newDomainIdentityClient(credentials, infra.Spec.Region)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		log.Info("Reconciling dedicated project")
+		if project, err = dedicatedproject.Reconcile(ctx, a.client, identity, infra.Namespace, domainID, config.DedicatedProject, credentials); err != nil {
+			return nil, nil, fmt.Errorf("could not reconcile the dedicated project: %w", err)
+		}
+		credentials = project.Credentials
+	}
+
+	if config.ApplicationCredential == nil {
+		if err := a.retireApplicationCredential(ctx, log, infra, credentials); err != nil {
+			return nil, nil, err
+		}
+		return credentials, project, nil
+	}
+
+	applicationCredentials, err := a.reconcileApplicationCredential(ctx, log, infra, cluster, config.ApplicationCredential, credentials)
+	if err != nil {
+		return nil, nil, err
+	}
+	return applicationCredentials, project, nil
+}
+
+// deleteDedicatedProject deletes the dedicated project of the shoot with the domain-scoped credentials in the cloud
+// provider secret.
+func (a *actuator) deleteDedicatedProject(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure) error {
+	credentials, err := openstack.GetCredentials(ctx, a.client, infra.Spec.SecretRef, false)
+	if err != nil {
+		return fmt.Errorf("could not get Openstack credentials: %w", err)
+	}
+
+	identity, domainID, err := newDomainIdentityClient(credentials, infra.Spec.Region)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Deleting dedicated project")
+	if err := dedicatedproject.Delete(ctx, a.client, identity, infra.Namespace, domainID); err != nil {
+		return fmt.Errorf("could not delete the dedicated project: %w", err)
+	}
+	return nil
+}
+
+func newDomainIdentityClient(credentials *openstack.Credentials, region string) (openstackclient.Identity, string, error) {
+	if !credentials.IsDomainScoped() {
+		return nil, "", fmt.Errorf("a dedicated project can only be managed with domain-scoped credentials")
+	}
+
+	clientFactory, err := openstackclient.NewOpenstackClientFromCredentials(credentials)
+	if err != nil {
+		return nil, "", err
+	}
+	domainID, err := clientFactory.DomainID()
+	if err != nil {
+		return nil, "", err
+	}
+	identity, err := clientFactory.Identity(openstackclient.WithRegion(region))
+	if err != nil {
+		return nil, "", fmt.Errorf("creating identity client failed: %w", err)
+	}
+	return identity, domainID, nil
+}
@@ -0,0 +1,246 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package dedicatedproject manages the projects dedicated to single shoots. The project of a shoot is created in the
+// domain of its domain-scoped credentials together with a technical user, whose credentials are stored in the namespace
+// of the shoot and used for all of its resources.
+package dedicatedproject
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/utils"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/projects"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/roles"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/users"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+	openstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
+)
+
+const (
+	// DefaultRole is the role the technical user of a shoot gets in its dedicated project if no roles are configured.
+	DefaultRole = "member"
+
+	passwordLength = 32
+)
+
+// Project is the project dedicated to a shoot.
+type Project struct {
+	// ID is the id of the project.
+	ID string
+	// Name is the name of the project.
+	Name string
+	// Credentials are the credentials of the technical user of the shoot in the project.
+	Credentials *openstack.Credentials
+}
+
+// Reconcile ensures that the project dedicated to the shoot in the given namespace and its technical user with the
+// configured roles exist in the domain with the given id. The project and the user are named after the namespace. The
+// credentials of the user are stored in the secret openstack.DedicatedProjectSecretName in the namespace.
+func Reconcile(
+	ctx context.Context,
+	c client.Client,
+	identity openstackclient.Identity,
+	namespace, domainID string,
+	config *api.DedicatedProject,
+	domainCredentials *openstack.Credentials,
+) (*Project, error) {
+	project, err := findProject(identity, namespace, domainID)
+	if err != nil {
+		return nil, err
+	}
+	if project == nil {
+		if project, err = identity.CreateProject(projects.CreateOpts{
+			Name:        namespace,
+			DomainID:    domainID,
+			Description: description(namespace),
+			Tags:        []string{tag(namespace)},
+		}); err != nil {
+			return nil, fmt.Errorf("could not create project %q: %w", namespace, err)
+		}
+	}
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: openstack.DedicatedProjectSecretName, Namespace: namespace}}
+	if err := c.Get(ctx, client.ObjectKeyFromObject(secret), secret); client.IgnoreNotFound(err) != nil {
+		return nil, err
+	}
+	password := string(secret.Data[openstack.Password])
+	passwordChanged := false
+	if password == "" {
+		if password, err = utils.GenerateRandomString(passwordLength); err != nil {
+			return nil, err
+		}
+		passwordChanged = true
+	}
+
+	user, err := findUser(identity, namespace, domainID)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case user == nil:
+		if user, err = identity.CreateUser(users.CreateOpts{
+			Name:             namespace,
+			DomainID:         domainID,
+			DefaultProjectID: project.ID,
+			Description:      description(namespace),
+			Password:         password,
+		}); err != nil {
+			return nil, fmt.Errorf("could not create user %q: %w", namespace, err)
+		}
+	case passwordChanged:
+		// the password of an existing user is only known if the secret was written after the user had been created
+		if _, err := identity.UpdateUser(user.ID, users.UpdateOpts{Password: password}); err != nil {
+			return nil, fmt.Errorf("could not update the password of user %q: %w", namespace, err)
+		}
+	}
+
+	for _, name := range roleNames(config) {
+		roleList, err := identity.ListRoles(roles.ListOpts{Name: name})
+		if err != nil {
+			return nil, err
+		}
+		if len(roleList) == 0 {
+			return nil, fmt.Errorf("role %q not found", name)
+		}
+		if err := identity.AssignRole(roleList[0].ID, roles.AssignOpts{UserID: user.ID, ProjectID: project.ID}); err != nil {
+			return nil, fmt.Errorf("could not assign role %q to user %q: %w", name, namespace, err)
+		}
+	}
+
+	credentials := &openstack.Credentials{
+		DomainName:        domainCredentials.DomainName,
+		DomainID:          domainCredentials.DomainID,
+		TenantName:        project.Name,
+		TenantID:          project.ID,
+		Username:          user.Name,
+		Password:          password,
+		AuthURL:           domainCredentials.AuthURL,
+		CACert:            domainCredentials.CACert,
+		Insecure:          domainCredentials.Insecure,
+		EndpointOverrides: domainCredentials.EndpointOverrides,
+	}
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, c, secret, func() error {
+		secret.Type = corev1.SecretTypeOpaque
+		secret.Data = secretData(credentials)
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("could not store the credentials of the dedicated project: %w", err)
+	}
+
+	return &Project{ID: project.ID, Name: project.Name, Credentials: credentials}, nil
+}
+
+// Delete deletes the project dedicated to the shoot in the given namespace from the domain with the given id together
+// with its technical user and the secret containing its credentials. The resources in the project must have been
+// deleted before.
+func Delete(ctx context.Context, c client.Client, identity openstackclient.Identity, namespace, domainID string) error {
+	user, err := findUser(identity, namespace, domainID)
+	if err != nil {
+		return err
+	}
+	if user != nil {
+		if err := identity.DeleteUser(user.ID); err != nil {
+			return fmt.Errorf("could not delete user %q: %w", namespace, err)
+		}
+	}
+
+	project, err := findProject(identity, namespace, domainID)
+	if err != nil {
+		return err
+	}
+	if project != nil {
+		if err := identity.DeleteProject(project.ID); err != nil {
+			return fmt.Errorf("could not delete project %q: %w", namespace, err)
+		}
+	}
+
+	return kutil.DeleteObject(ctx, c, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: openstack.DedicatedProjectSecretName, Namespace: namespace}})
+}
+
+// findProject returns the project with the given name in the given domain, or nil if it does not exist. Projects which
+// were not created for the shoot are never returned, but result in an error.
+func findProject(identity openstackclient.Identity, name, domainID string) (*projects.Project, error) {
+	projectList, err := identity.ListProjects(projects.ListOpts{Name: name, DomainID: domainID})
+	if err != nil {
+		return nil, err
+	}
+	if len(projectList) == 0 {
+		return nil, nil
+	}
+	project := projectList[0]
+	for _, t := range project.Tags {
+		if t == tag(name) {
+			return &project, nil
+		}
+	}
+	return nil, fmt.Errorf("project %q already exists, but was not created for the shoot", name)
+}
+
+// findUser returns the user with the given name in the given domain, or nil if it does not exist. Users which were not
+// created for the shoot are never returned, but result in an error.
+func findUser(identity openstackclient.Identity, name, domainID string) (*users.User, error) {
+	userList, err := identity.ListUsers(users.ListOpts{Name: name, DomainID: domainID})
+	if err != nil {
+		return nil, err
+	}
+	if len(userList) == 0 {
+		return nil, nil
+	}
+	if userList[0].Description != description(name) {
+		return nil, fmt.Errorf("user %q already exists, but was not created for the shoot", name)
+	}
+	return &userList[0], nil
+}
+
+func roleNames(config *api.DedicatedProject) []string {
+	if config == nil || len(config.Roles) == 0 {
+		return []string{DefaultRole}
+	}
+	return config.Roles
+}
+
+func secretData(credentials *openstack.Credentials) map[string][]byte {
+	data := map[string][]byte{
+		openstack.TenantName: []byte(credentials.TenantName),
+		openstack.TenantID:   []byte(credentials.TenantID),
+		openstack.UserName:   []byte(credentials.Username),
+		openstack.Password:   []byte(credentials.Password),
+	}
+	for key, value := range map[string]string{
+		openstack.DomainName: credentials.DomainName,
+		openstack.DomainID:   credentials.DomainID,
+		openstack.AuthURL:    credentials.AuthURL,
+		openstack.CACert:     credentials.CACert,
+	} {
+		if value != "" {
+			data[key] = []byte(value)
+		}
+	}
+	if credentials.Insecure {
+		data[openstack.Insecure] = []byte("true")
+	}
+	if len(credentials.EndpointOverrides) > 0 {
+		data[openstack.EndpointOverrides] = openstack.MarshalEndpointOverrides(credentials.EndpointOverrides)
+	}
+	return data
+}
+
+func description(namespace string) string {
+	return fmt.Sprintf("Dedicated to the shoot %s, managed by Gardener", namespace)
+}
+
+// tag returns the tag of the project dedicated to the shoot in the given namespace. Project tags must not contain
+// slashes.
+func tag(namespace string) string {
+	return "kubernetes.io-cluster-" + namespace
+}
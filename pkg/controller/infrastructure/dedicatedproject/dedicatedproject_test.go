@@ -0,0 +1,194 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dedicatedproject_test
+
+import (
+	"context"
+
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/projects"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/roles"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/users"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	. "github.com/gardener/gardener-extension-provider-openstack/pkg/controller/infrastructure/dedicatedproject"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+	mockopenstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client/mocks"
+)
+
+var _ = Describe("DedicatedProject", func() {
+	const (
+		namespace   = "shoot--foo--bar"
+		domainID    = "domain-id"
+		projectID   = "project-id"
+		userID      = "user-id"
+		description = "Dedicated to the shoot shoot--foo--bar, managed by Gardener"
+		tag         = "kubernetes.io-cluster-shoot--foo--bar"
+	)
+
+	var (
+		ctx      context.Context
+		ctrl     *gomock.Controller
+		c        client.Client
+		identity *mockopenstackclient.MockIdentity
+
+		domainCredentials *openstack.Credentials
+		project           projects.Project
+		user              users.User
+		secretKey         client.ObjectKey
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		ctrl = gomock.NewController(GinkgoT())
+		c = fakeclient.NewClientBuilder().Build()
+		identity = mockopenstackclient.NewMockIdentity(ctrl)
+
+		domainCredentials = &openstack.Credentials{
+			DomainName: "domain",
+			Username:   "admin",
+			Password:   "secret",
+			AuthURL:    "https://keystone",
+		}
+		project = projects.Project{ID: projectID, Name: namespace, Tags: []string{tag}}
+		user = users.User{ID: userID, Name: namespace, Description: description}
+		secretKey = client.ObjectKey{Namespace: namespace, Name: openstack.DedicatedProjectSecretName}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	expectRole := func(name string) {
+		identity.EXPECT().ListRoles(roles.ListOpts{Name: name}).Return([]roles.Role{{ID: name + "-id", Name: name}}, nil)
+		identity.EXPECT().AssignRole(name+"-id", roles.AssignOpts{UserID: userID, ProjectID: projectID}).Return(nil)
+	}
+
+	Describe("#Reconcile", func() {
+		It("should create the project, the user and the secret", func() {
+			identity.EXPECT().ListProjects(projects.ListOpts{Name: namespace, DomainID: domainID}).Return(nil, nil)
+			identity.EXPECT().CreateProject(projects.CreateOpts{
+				Name:        namespace,
+				DomainID:    domainID,
+				Description: description,
+				Tags:        []string{tag},
+			}).Return(&project, nil)
+			identity.EXPECT().ListUsers(users.ListOpts{Name: namespace, DomainID: domainID}).Return(nil, nil)
+			identity.EXPECT().CreateUser(gomock.Any()).DoAndReturn(func(opts users.CreateOpts) (*users.User, error) {
+				Expect(opts.Name).To(Equal(namespace))
+				Expect(opts.DomainID).To(Equal(domainID))
+				Expect(opts.DefaultProjectID).To(Equal(projectID))
+				Expect(opts.Password).To(HaveLen(32))
+				return &user, nil
+			})
+			expectRole(DefaultRole)
+
+			result, err := Reconcile(ctx, c, identity, namespace, domainID, &api.DedicatedProject{}, domainCredentials)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.ID).To(Equal(projectID))
+			Expect(result.Name).To(Equal(namespace))
+			Expect(result.Credentials.TenantID).To(Equal(projectID))
+			Expect(result.Credentials.Username).To(Equal(namespace))
+			Expect(result.Credentials.DomainName).To(Equal("domain"))
+
+			secret := &corev1.Secret{}
+			Expect(c.Get(ctx, secretKey, secret)).To(Succeed())
+			Expect(secret.Data).To(Equal(map[string][]byte{
+				openstack.TenantName: []byte(namespace),
+				openstack.TenantID:   []byte(projectID),
+				openstack.UserName:   []byte(namespace),
+				openstack.Password:   []byte(result.Credentials.Password),
+				openstack.DomainName: []byte("domain"),
+				openstack.AuthURL:    []byte("https://keystone"),
+			}))
+		})
+
+		It("should reuse existing resources and assign the configured roles", func() {
+			Expect(c.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: secretKey.Name, Namespace: secretKey.Namespace},
+				Data:       map[string][]byte{openstack.Password: []byte("password")},
+			})).To(Succeed())
+
+			identity.EXPECT().ListProjects(gomock.Any()).Return([]projects.Project{project}, nil)
+			identity.EXPECT().ListUsers(gomock.Any()).Return([]users.User{user}, nil)
+			expectRole("reader")
+			expectRole("load-balancer_member")
+
+			result, err := Reconcile(ctx, c, identity, namespace, domainID, &api.DedicatedProject{Roles: []string{"reader", "load-balancer_member"}}, domainCredentials)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Credentials.Password).To(Equal("password"))
+		})
+
+		It("should reset the password of an existing user if the secret is missing", func() {
+			identity.EXPECT().ListProjects(gomock.Any()).Return([]projects.Project{project}, nil)
+			identity.EXPECT().ListUsers(gomock.Any()).Return([]users.User{user}, nil)
+			identity.EXPECT().UpdateUser(userID, gomock.Any()).DoAndReturn(func(_ string, opts users.UpdateOpts) (*users.User, error) {
+				Expect(opts.Password).To(HaveLen(32))
+				return &user, nil
+			})
+			expectRole(DefaultRole)
+
+			_, err := Reconcile(ctx, c, identity, namespace, domainID, nil, domainCredentials)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should fail if the project was not created for the shoot", func() {
+			project.Tags = nil
+			identity.EXPECT().ListProjects(gomock.Any()).Return([]projects.Project{project}, nil)
+
+			_, err := Reconcile(ctx, c, identity, namespace, domainID, nil, domainCredentials)
+			Expect(err).To(MatchError(ContainSubstring("was not created for the shoot")))
+		})
+
+		It("should fail if the user was not created for the shoot", func() {
+			user.Description = ""
+			identity.EXPECT().ListProjects(gomock.Any()).Return([]projects.Project{project}, nil)
+			identity.EXPECT().ListUsers(gomock.Any()).Return([]users.User{user}, nil)
+
+			_, err := Reconcile(ctx, c, identity, namespace, domainID, nil, domainCredentials)
+			Expect(err).To(MatchError(ContainSubstring("was not created for the shoot")))
+		})
+
+		It("should fail if a role does not exist", func() {
+			identity.EXPECT().ListProjects(gomock.Any()).Return([]projects.Project{project}, nil)
+			identity.EXPECT().ListUsers(gomock.Any()).Return(nil, nil)
+			identity.EXPECT().CreateUser(gomock.Any()).Return(&user, nil)
+			identity.EXPECT().ListRoles(roles.ListOpts{Name: "unknown"}).Return(nil, nil)
+
+			_, err := Reconcile(ctx, c, identity, namespace, domainID, &api.DedicatedProject{Roles: []string{"unknown"}}, domainCredentials)
+			Expect(err).To(MatchError(`role "unknown" not found`))
+		})
+	})
+
+	Describe("#Delete", func() {
+		It("should delete the user, the project and the secret", func() {
+			Expect(c.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretKey.Name, Namespace: secretKey.Namespace}})).To(Succeed())
+
+			gomock.InOrder(
+				identity.EXPECT().ListUsers(users.ListOpts{Name: namespace, DomainID: domainID}).Return([]users.User{user}, nil),
+				identity.EXPECT().DeleteUser(userID).Return(nil),
+				identity.EXPECT().ListProjects(projects.ListOpts{Name: namespace, DomainID: domainID}).Return([]projects.Project{project}, nil),
+				identity.EXPECT().DeleteProject(projectID).Return(nil),
+			)
+
+			Expect(Delete(ctx, c, identity, namespace, domainID)).To(Succeed())
+			Expect(c.Get(ctx, secretKey, &corev1.Secret{})).To(BeNotFoundError())
+		})
+
+		It("should succeed if nothing exists", func() {
+			identity.EXPECT().ListUsers(gomock.Any()).Return(nil, nil)
+			identity.EXPECT().ListProjects(gomock.Any()).Return(nil, nil)
+
+			Expect(Delete(ctx, c, identity, namespace, domainID)).To(Succeed())
+		})
+	})
+})
@@ -17,6 +17,7 @@ import (
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/groups"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/rules"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/subnets"
 
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
@@ -51,6 +52,8 @@ type NetworkingAccess interface {
 	GetSecurityGroupByID(id string) (*groups.SecGroup, error)
 	GetSecurityGroupByName(name string) ([]*groups.SecGroup, error)
 	UpdateSecurityGroupRules(group *groups.SecGroup, desiredRules []rules.SecGroupRule, allowDelete func(rule *rules.SecGroupRule) bool) (modified bool, err error)
+	GetPortsBySecurityGroup(securityGroupID string) ([]*ports.Port, error)
+	UpdatePortSecurityGroups(port *ports.Port, securityGroupIDs []string) error
 }
 
 // Router is a simplified router resource
@@ -431,41 +434,49 @@ func (a *networkingAccess) GetSecurityGroupByName(name string) ([]*groups.SecGro
 	return result, nil
 }
 
+// GetPortsBySecurityGroup returns all ports the security group with the given id is attached to.
+func (a *networkingAccess) GetPortsBySecurityGroup(securityGroupID string) ([]*ports.Port, error) {
+	list, err := a.networking.ListPorts(ports.ListOpts{SecurityGroups: []string{securityGroupID}})
+	if err != nil {
+		return nil, err
+	}
+	var result []*ports.Port
+	for _, raw := range list {
+		tmp := raw
+		result = append(result, &tmp)
+	}
+	return result, nil
+}
+
+// UpdatePortSecurityGroups replaces the security groups of the port.
+// The update is guarded by the revision number of the port, so that concurrent modifications are not overwritten.
+func (a *networkingAccess) UpdatePortSecurityGroups(port *ports.Port, securityGroupIDs []string) error {
+	opts := ports.UpdateOpts{
+		SecurityGroups: &securityGroupIDs,
+	}
+	if port.RevisionNumber > 0 {
+		opts.RevisionNumber = &port.RevisionNumber
+	}
+	_, err := a.networking.UpdatePort(port.ID, opts)
+	return err
+}
+
 func (a *networkingAccess) UpdateSecurityGroupRules(
 	group *groups.SecGroup,
 	desiredRules []rules.SecGroupRule,
 	allowDelete func(rule *rules.SecGroupRule) bool,
 ) (modified bool, err error) {
-	for i := range desiredRules {
-		rule := &desiredRules[i]
-		rule.SecGroupID = group.ID
-		rule.ProjectID = group.ProjectID
-		rule.TenantID = group.TenantID
-		if rule.RemoteGroupID == SecurityGroupIDSelf {
-			rule.RemoteGroupID = group.ID
-		}
-	}
-
-	for i := range group.Rules {
-		rule := &group.Rules[i]
-		if desiredRule, _ := a.findMatchingRule(rule, desiredRules); desiredRule == nil {
-			if allowDelete == nil || allowDelete(rule) {
-				if err = a.networking.DeleteRule(rule.ID); err != nil {
-					err = fmt.Errorf("Error deleting rule for security group %s: %s", rule.ID, err)
-					return
-				}
-				modified = true
-			}
-		} else {
-			desiredRule.ID = rule.ID // mark as found
+	missing, obsolete := DiffSecurityGroupRules(group, desiredRules, allowDelete)
+	for i := range obsolete {
+		rule := &obsolete[i]
+		if err = a.networking.DeleteRule(rule.ID); err != nil {
+			err = fmt.Errorf("Error deleting rule for security group %s: %s", rule.ID, err)
+			return
 		}
+		modified = true
 	}
-	for i := range desiredRules {
-		rule := &desiredRules[i]
-		if rule.ID != "" {
-			// ignore found rules
-			continue
-		}
+	for i := range missing {
+		rule := &missing[i]
 		createOpts := rules.CreateOpts{
 			Direction:      rules.RuleDirection(rule.Direction),
 			Description:    rule.Description,
@@ -487,7 +498,44 @@ func (a *networkingAccess) UpdateSecurityGroupRules(
 	return
 }
 
-func (a *networkingAccess) findMatchingRule(rule *rules.SecGroupRule, desiredRules []rules.SecGroupRule) (*rules.SecGroupRule, bool) {
+// DiffSecurityGroupRules compares the rules of the given security group with the desired rules. It returns the desired
+// rules which are missing in the security group, and the rules of the security group which are not desired and may be
+// deleted according to <allowDelete>.
+func DiffSecurityGroupRules(
+	group *groups.SecGroup,
+	desiredRules []rules.SecGroupRule,
+	allowDelete func(rule *rules.SecGroupRule) bool,
+) (missing, obsolete []rules.SecGroupRule) {
+	desiredRules = append([]rules.SecGroupRule(nil), desiredRules...)
+	for i := range desiredRules {
+		rule := &desiredRules[i]
+		rule.SecGroupID = group.ID
+		rule.ProjectID = group.ProjectID
+		rule.TenantID = group.TenantID
+		if rule.RemoteGroupID == SecurityGroupIDSelf {
+			rule.RemoteGroupID = group.ID
+		}
+	}
+
+	for i := range group.Rules {
+		rule := &group.Rules[i]
+		if desiredRule, _ := findMatchingRule(rule, desiredRules); desiredRule == nil {
+			if allowDelete == nil || allowDelete(rule) {
+				obsolete = append(obsolete, *rule)
+			}
+		} else {
+			desiredRule.ID = rule.ID // mark as found
+		}
+	}
+	for _, rule := range desiredRules {
+		if rule.ID == "" {
+			missing = append(missing, rule)
+		}
+	}
+	return
+}
+
+func findMatchingRule(rule *rules.SecGroupRule, desiredRules []rules.SecGroupRule) (*rules.SecGroupRule, bool) {
 	for i := range desiredRules {
 		desired := &desiredRules[i]
 		if desired.ID != "" {
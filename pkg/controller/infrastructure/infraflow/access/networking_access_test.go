@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package access_test
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	. "github.com/gardener/gardener-extension-provider-openstack/pkg/controller/infrastructure/infraflow/access"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client/mocks"
+)
+
+var _ = Describe("NetworkingAccess", func() {
+	var (
+		ctrl       *gomock.Controller
+		networking *mocks.MockNetworking
+		access     NetworkingAccess
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		networking = mocks.NewMockNetworking(ctrl)
+
+		var err error
+		access, err = NewNetworkingAccess(networking, log.Log.WithName("test"))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#GetPortsBySecurityGroup", func() {
+		It("should list the ports of the security group", func() {
+			networking.EXPECT().ListPorts(ports.ListOpts{SecurityGroups: []string{"sg"}}).Return([]ports.Port{
+				{ID: "port1", SecurityGroups: []string{"sg"}},
+				{ID: "port2", SecurityGroups: []string{"sg", "other"}},
+			}, nil)
+
+			result, err := access.GetPortsBySecurityGroup("sg")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(ConsistOf(
+				&ports.Port{ID: "port1", SecurityGroups: []string{"sg"}},
+				&ports.Port{ID: "port2", SecurityGroups: []string{"sg", "other"}},
+			))
+		})
+
+		It("should return the error of the listing", func() {
+			networking.EXPECT().ListPorts(gomock.Any()).Return(nil, fmt.Errorf("fake"))
+
+			_, err := access.GetPortsBySecurityGroup("sg")
+			Expect(err).To(MatchError("fake"))
+		})
+	})
+
+	Describe("#UpdatePortSecurityGroups", func() {
+		It("should replace the security groups of the port guarded by its revision number", func() {
+			networking.EXPECT().UpdatePort("port", ports.UpdateOpts{
+				SecurityGroups: &[]string{"sg", "new"},
+				RevisionNumber: pointer.Int(3),
+			}).Return(&ports.Port{ID: "port"}, nil)
+
+			Expect(access.UpdatePortSecurityGroups(&ports.Port{ID: "port", RevisionNumber: 3}, []string{"sg", "new"})).To(Succeed())
+		})
+
+		It("should not pass a revision number if the port has none", func() {
+			networking.EXPECT().UpdatePort("port", ports.UpdateOpts{
+				SecurityGroups: &[]string{},
+			}).Return(&ports.Port{ID: "port"}, nil)
+
+			Expect(access.UpdatePortSecurityGroups(&ports.Port{ID: "port"}, []string{})).To(Succeed())
+		})
+
+		It("should return the error of the update", func() {
+			networking.EXPECT().UpdatePort("port", gomock.Any()).Return(nil, fmt.Errorf("fake"))
+
+			Expect(access.UpdatePortSecurityGroups(&ports.Port{ID: "port"}, []string{"sg"})).To(MatchError("fake"))
+		})
+	})
+})
@@ -0,0 +1,409 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/rbacpolicies"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/groups"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/rules"
+	"github.com/gophercloud/gophercloud/openstack/sharedfilesystems/v2/sharenetworks"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/pointer"
+
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/controller/infrastructure/infraflow/access"
+)
+
+// auditReport collects the differences between the desired and the actual state of the infrastructure.
+type auditReport struct {
+	differences []string
+}
+
+func (r *auditReport) add(format string, args ...interface{}) {
+	r.differences = append(r.differences, fmt.Sprintf(format, args...))
+}
+
+// Audit compares the desired with the actual state of the infrastructure resources and returns the differences a
+// reconciliation would resolve, without changing any resource. The ids of the found resources are only recorded in the
+// in-memory state, which must not be persisted.
+func (c *FlowContext) Audit(ctx context.Context) ([]string, error) {
+	report := &auditReport{}
+	for _, audit := range []func(context.Context, *auditReport) error{
+		c.auditExternalNetwork,
+		c.auditRouter,
+		c.auditNetwork,
+		c.auditSubnet,
+		c.auditNetworkRBACPolicies,
+		c.auditRouterInterface,
+		c.auditSecGroup,
+		c.auditSSHKeyPair,
+		c.auditShareNetwork,
+		c.auditAuxiliaryStack,
+	} {
+		if err := audit(ctx, report); err != nil {
+			return nil, err
+		}
+	}
+	return report.differences, nil
+}
+
+func (c *FlowContext) auditExternalNetwork(_ context.Context, report *auditReport) error {
+	externalNetwork, err := c.networking.GetExternalNetworkByName(c.config.FloatingPoolName)
+	if err != nil {
+		return err
+	}
+	if externalNetwork == nil {
+		report.add("external network for floating pool name %s not found", c.config.FloatingPoolName)
+		return nil
+	}
+	c.state.Set(IdentifierFloatingNetwork, externalNetwork.ID)
+	return nil
+}
+
+func (c *FlowContext) auditRouter(_ context.Context, report *auditReport) error {
+	if c.config.Networks.Router != nil {
+		router, err := c.access.GetRouterByID(c.config.Networks.Router.ID)
+		if err != nil {
+			return err
+		}
+		if router == nil {
+			report.add("router %s not found", c.config.Networks.Router.ID)
+			return nil
+		}
+		c.state.Set(IdentifierRouter, router.ID)
+		return nil
+	}
+
+	current, err := c.findExistingRouter()
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		report.add("router %s is missing", c.namespace)
+		return nil
+	}
+	c.state.Set(IdentifierRouter, current.ID)
+	if current.Name != c.namespace {
+		report.add("router %s is named %s instead of %s", current.ID, current.Name, c.namespace)
+	}
+	if externalNetworkID := c.state.Get(IdentifierFloatingNetwork); externalNetworkID != nil && current.ExternalNetworkID != *externalNetworkID {
+		report.add("router %s uses external network %s instead of %s", current.ID, current.ExternalNetworkID, *externalNetworkID)
+	}
+	if enableSNAT := c.cloudProfileConfig.UseSNAT; enableSNAT != nil && *enableSNAT != pointer.BoolDeref(current.EnableSNAT, false) {
+		report.add("router %s has SNAT enabled=%t instead of %t", current.ID, pointer.BoolDeref(current.EnableSNAT, false), *enableSNAT)
+	}
+	return nil
+}
+
+func (c *FlowContext) auditNetwork(_ context.Context, report *auditReport) error {
+	if c.config.Networks.ID != nil {
+		network, err := c.access.GetNetworkByID(*c.config.Networks.ID)
+		if err != nil {
+			return err
+		}
+		if network == nil {
+			report.add("network %s not found", *c.config.Networks.ID)
+			return nil
+		}
+		c.state.Set(IdentifierNetwork, network.ID)
+		return nil
+	}
+
+	current, err := c.findExistingNetwork()
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		report.add("network %s is missing", c.namespace)
+		return nil
+	}
+	c.state.Set(IdentifierNetwork, current.ID)
+	if current.Name != c.namespace {
+		report.add("network %s is named %s instead of %s", current.ID, current.Name, c.namespace)
+	}
+	if !current.AdminStateUp {
+		report.add("network %s is administratively down", current.ID)
+	}
+	return nil
+}
+
+func (c *FlowContext) auditSubnet(_ context.Context, report *auditReport) error {
+	networkID := c.state.Get(IdentifierNetwork)
+	workersCIDR := c.workersCIDR()
+
+	if c.config.Networks.SubnetID != nil {
+		subnet, err := c.access.GetSubnetByID(*c.config.Networks.SubnetID)
+		if err != nil {
+			return err
+		}
+		if subnet == nil {
+			report.add("subnet %s not found", *c.config.Networks.SubnetID)
+			return nil
+		}
+		c.state.Set(IdentifierSubnet, subnet.ID)
+		if networkID != nil && subnet.NetworkID != *networkID {
+			report.add("subnet %s does not belong to network %s", subnet.ID, *networkID)
+		}
+		if subnet.CIDR != workersCIDR {
+			report.add("CIDR %s of subnet %s does not match the workers CIDR %s", subnet.CIDR, subnet.ID, workersCIDR)
+		}
+		return nil
+	}
+
+	if networkID == nil {
+		// the network is missing, hence the subnet as well
+		report.add("subnet %s is missing", c.namespace)
+		return nil
+	}
+	current, err := c.findExistingSubnet()
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		report.add("subnet %s is missing", c.namespace)
+		return nil
+	}
+	c.state.Set(IdentifierSubnet, current.ID)
+	if current.Name != c.namespace {
+		report.add("subnet %s is named %s instead of %s", current.ID, current.Name, c.namespace)
+	}
+	if current.CIDR != workersCIDR {
+		report.add("CIDR %s of subnet %s does not match the workers CIDR %s", current.CIDR, current.ID, workersCIDR)
+	}
+	if desired := c.cloudProfileConfig.DNSServers; (len(desired) > 0 || len(current.DNSNameservers) > 0) && strings.Join(desired, ",") != strings.Join(current.DNSNameservers, ",") {
+		report.add("subnet %s uses the DNS servers [%s] instead of [%s]", current.ID, strings.Join(current.DNSNameservers, ", "), strings.Join(desired, ", "))
+	}
+	return nil
+}
+
+func (c *FlowContext) auditNetworkRBACPolicies(_ context.Context, report *auditReport) error {
+	managed := c.state.GetChild(IdentifierNetworkRBACPolicies).AsMap()
+	if len(c.config.Networks.ShareWithProjects) == 0 && len(managed) == 0 {
+		return nil
+	}
+
+	networkID := c.state.Get(IdentifierNetwork)
+	if networkID == nil {
+		for _, project := range c.config.Networks.ShareWithProjects {
+			report.add("network is not shared with project %s", project)
+		}
+		return nil
+	}
+	policies, err := c.networking.ListRBACPolicies(rbacpolicies.ListOpts{
+		ObjectType: rbacPolicyObjectTypeNetwork,
+		ObjectID:   *networkID,
+		Action:     rbacpolicies.ActionAccessShared,
+	})
+	if err != nil {
+		return err
+	}
+	existing := map[string]string{}
+	for _, policy := range policies {
+		existing[policy.TargetTenant] = policy.ID
+	}
+
+	desired := sets.New(c.config.Networks.ShareWithProjects...)
+	for _, project := range c.config.Networks.ShareWithProjects {
+		if _, ok := existing[project]; !ok {
+			report.add("network %s is not shared with project %s", *networkID, project)
+		}
+	}
+	for project, policyID := range managed {
+		if !desired.Has(project) && existing[project] == policyID {
+			report.add("network %s is still shared with project %s by RBAC policy %s", *networkID, project, policyID)
+		}
+	}
+	return nil
+}
+
+func (c *FlowContext) auditRouterInterface(_ context.Context, report *auditReport) error {
+	routerID := c.state.Get(IdentifierRouter)
+	subnetID := c.state.Get(IdentifierSubnet)
+	if routerID == nil || subnetID == nil {
+		// the router or the subnet is missing, which has already been reported
+		return nil
+	}
+	portID, err := c.access.GetRouterInterfacePortID(*routerID, *subnetID)
+	if err != nil {
+		return err
+	}
+	if portID == nil {
+		report.add("router %s is not connected to subnet %s", *routerID, *subnetID)
+	}
+	return nil
+}
+
+func (c *FlowContext) auditSecGroup(_ context.Context, report *auditReport) error {
+	var (
+		group *groups.SecGroup
+		err   error
+	)
+	if c.config.SecurityGroupID != nil {
+		if group, err = c.access.GetSecurityGroupByID(*c.config.SecurityGroupID); err != nil {
+			return err
+		}
+		if group == nil {
+			report.add("security group %s not found", *c.config.SecurityGroupID)
+			return nil
+		}
+	} else {
+		name := c.secGroupName()
+		if group, err = c.findExistingSecGroup(c.state.Get(IdentifierSecGroup), name); err != nil {
+			return err
+		}
+		if err := c.recoverLegacySecGroup(); err != nil {
+			return err
+		}
+		if legacyID := c.state.Get(IdentifierLegacySecGroup); legacyID != nil {
+			report.add("security group %s with the legacy name %s has not been migrated yet", *legacyID, c.legacySecGroupName())
+		}
+		if group == nil {
+			report.add("security group %s is missing", name)
+			return nil
+		}
+	}
+	c.state.Set(IdentifierSecGroup, group.ID)
+	if c.isAdopted() {
+		return nil
+	}
+
+	missing, obsolete := access.DiffSecurityGroupRules(group, c.desiredSecGroupRules(), allowDeleteSecGroupRule)
+	for i := range missing {
+		report.add("security group %s is missing the rule %s", group.ID, describeSecGroupRule(&missing[i]))
+	}
+	for i := range obsolete {
+		report.add("security group %s has the undesired rule %s (%s)", group.ID, obsolete[i].ID, describeSecGroupRule(&obsolete[i]))
+	}
+	return nil
+}
+
+// describeSecGroupRule returns a short description of the given security group rule.
+func describeSecGroupRule(rule *rules.SecGroupRule) string {
+	parts := []string{rule.Direction, rule.EtherType}
+	if rule.Protocol != "" {
+		parts = append(parts, rule.Protocol)
+	}
+	if rule.PortRangeMin != 0 || rule.PortRangeMax != 0 {
+		parts = append(parts, fmt.Sprintf("%d-%d", rule.PortRangeMin, rule.PortRangeMax))
+	}
+	if rule.RemoteIPPrefix != "" {
+		parts = append(parts, rule.RemoteIPPrefix)
+	}
+	if rule.RemoteGroupID != "" {
+		parts = append(parts, "group "+rule.RemoteGroupID)
+	}
+	if rule.Description != "" {
+		return fmt.Sprintf("%q [%s]", rule.Description, strings.Join(parts, " "))
+	}
+	return "[" + strings.Join(parts, " ") + "]"
+}
+
+func (c *FlowContext) auditSSHKeyPair(_ context.Context, report *auditReport) error {
+	if c.config.KeyPairName != nil {
+		keyPair, err := c.compute.GetKeyPair(*c.config.KeyPairName)
+		if err != nil {
+			return err
+		}
+		if keyPair == nil {
+			report.add("key pair %s not found", *c.config.KeyPairName)
+		}
+		return nil
+	}
+
+	keyPair, err := c.compute.GetKeyPair(c.namespace)
+	if err != nil {
+		return err
+	}
+	if keyPair == nil {
+		report.add("SSH key pair %s is missing", c.namespace)
+		return nil
+	}
+	if keyPair.PublicKey != string(c.infraSpec.SSHPublicKey) {
+		report.add("SSH key pair %s has an outdated public key", c.namespace)
+	}
+	return nil
+}
+
+func (c *FlowContext) auditShareNetwork(_ context.Context, report *auditReport) error {
+	if c.config.Networks.ShareNetwork == nil || !c.config.Networks.ShareNetwork.Enabled {
+		id := c.state.Get(IdentifierShareNetwork)
+		if id == nil {
+			return nil
+		}
+		current, err := c.sharedFilesystem.GetShareNetwork(*id)
+		if err != nil {
+			return err
+		}
+		if current != nil {
+			report.add("share network %s is not desired anymore", current.ID)
+		}
+		return nil
+	}
+
+	networkID := pointer.StringDeref(c.state.Get(IdentifierNetwork), "")
+	subnetID := pointer.StringDeref(c.state.Get(IdentifierSubnet), "")
+	current, err := findExisting(c.state.Get(IdentifierShareNetwork),
+		c.namespace,
+		c.sharedFilesystem.GetShareNetwork,
+		func(name string) ([]*sharenetworks.ShareNetwork, error) {
+			list, err := c.sharedFilesystem.ListShareNetworks(sharenetworks.ListOpts{
+				Name:            name,
+				NeutronNetID:    networkID,
+				NeutronSubnetID: subnetID,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return sliceToPtr(list), nil
+		})
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		report.add("share network %s is missing", c.namespace)
+	}
+	return nil
+}
+
+func (c *FlowContext) auditAuxiliaryStack(_ context.Context, report *auditReport) error {
+	name := c.auxiliaryStackName()
+	if c.config.AuxiliaryStack == nil {
+		id := c.state.Get(IdentifierAuxiliaryStack)
+		if id == nil {
+			return nil
+		}
+		current, err := c.orchestration.GetStack(name, *id)
+		if err != nil {
+			return err
+		}
+		if current != nil && current.Status != stackStatusDeleteComplete {
+			report.add("auxiliary stack %s is not configured anymore", name)
+		}
+		return nil
+	}
+
+	_, _, checksum, err := c.desiredAuxiliaryStack()
+	if err != nil {
+		return err
+	}
+	current, err := c.findExistingAuxiliaryStack()
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		report.add("auxiliary stack %s is missing", name)
+		return nil
+	}
+	if strings.HasSuffix(current.Status, stackStatusSuffixFailed) {
+		report.add("auxiliary stack %s is in status %s", name, current.Status)
+	}
+	if pointer.StringDeref(c.state.Get(ChecksumAuxiliaryStack), "") != checksum {
+		report.add("template or parameters of auxiliary stack %s changed since its last update", name)
+	}
+	return nil
+}
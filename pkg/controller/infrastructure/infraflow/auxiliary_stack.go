@@ -0,0 +1,211 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	gardenerutils "github.com/gardener/gardener/pkg/utils"
+	"github.com/gophercloud/gophercloud/openstack/orchestration/v1/stacks"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/yaml"
+
+	openstackapi "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+)
+
+const (
+	// auxiliaryStackTimeout is the timeout of the creation, update and deletion of the auxiliary stack.
+	auxiliaryStackTimeout = 15 * time.Minute
+	// auxiliaryStackPollInterval is the interval in which the status of the auxiliary stack is polled.
+	auxiliaryStackPollInterval = 10 * time.Second
+
+	stackStatusSuffixInProgress = "_IN_PROGRESS"
+	stackStatusSuffixFailed     = "_FAILED"
+	stackStatusDeleteComplete   = "DELETE_COMPLETE"
+)
+
+// ensureAuxiliaryStack creates or updates the Heat stack with the configured template. The stack is only updated if the
+// template or its parameters changed since the last update, or if the last operation failed. A stack recorded in the
+// state is deleted once it is removed from the configuration.
+func (c *FlowContext) ensureAuxiliaryStack(ctx context.Context) error {
+	if c.config.AuxiliaryStack == nil {
+		return c.deleteAuxiliaryStack(ctx)
+	}
+	log := c.LogFromContext(ctx)
+
+	template, parameters, checksum, err := c.desiredAuxiliaryStack()
+	if err != nil {
+		return err
+	}
+
+	name := c.auxiliaryStackName()
+	current, err := c.findExistingAuxiliaryStack()
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		log.Info("creating...", "stack", name)
+		id, err := c.orchestration.CreateStack(name, template, parameters, int(auxiliaryStackTimeout.Minutes()))
+		if err != nil {
+			return fmt.Errorf("failed to create auxiliary stack: %w", err)
+		}
+		c.state.Set(IdentifierAuxiliaryStack, id)
+		c.state.Set(ChecksumAuxiliaryStack, checksum)
+		return c.waitForAuxiliaryStackOperation(ctx, id)
+	}
+
+	id, status := current.ID, current.Status
+	c.state.Set(IdentifierAuxiliaryStack, id)
+	if strings.HasSuffix(status, stackStatusSuffixInProgress) {
+		// a previous operation must be finished before the stack can be updated
+		stack, err := c.waitForAuxiliaryStack(ctx, id)
+		if err != nil {
+			return err
+		}
+		if stack == nil {
+			return fmt.Errorf("auxiliary stack %s vanished while waiting for it", name)
+		}
+		status = stack.Status
+	}
+	if pointer.StringDeref(c.state.Get(ChecksumAuxiliaryStack), "") == checksum && !strings.HasSuffix(status, stackStatusSuffixFailed) {
+		return nil
+	}
+
+	log.Info("updating...", "stack", name, "id", id, "status", status)
+	if err := c.orchestration.UpdateStack(name, id, template, parameters, int(auxiliaryStackTimeout.Minutes())); err != nil {
+		return fmt.Errorf("failed to update auxiliary stack: %w", err)
+	}
+	c.state.Set(ChecksumAuxiliaryStack, checksum)
+	return c.waitForAuxiliaryStackOperation(ctx, id)
+}
+
+// deleteAuxiliaryStack deletes the Heat stack recorded in the state. Stacks which are not recorded in the state have not
+// been created by the extension and are left untouched.
+func (c *FlowContext) deleteAuxiliaryStack(ctx context.Context) error {
+	id := c.state.Get(IdentifierAuxiliaryStack)
+	if id == nil {
+		return nil
+	}
+	log := c.LogFromContext(ctx)
+
+	name := c.auxiliaryStackName()
+	current, err := c.orchestration.GetStack(name, *id)
+	if err != nil {
+		return err
+	}
+	if current != nil && current.Status != stackStatusDeleteComplete {
+		log.Info("deleting...", "stack", name, "id", *id)
+		if err := c.orchestration.DeleteStack(name, *id); err != nil {
+			return fmt.Errorf("failed to delete auxiliary stack: %w", err)
+		}
+		if err := c.waitForAuxiliaryStackOperation(ctx, *id); err != nil {
+			return err
+		}
+	}
+	c.state.Set(IdentifierAuxiliaryStack, "")
+	c.state.Set(ChecksumAuxiliaryStack, "")
+	return nil
+}
+
+func (c *FlowContext) findExistingAuxiliaryStack() (*stacks.ListedStack, error) {
+	found, err := c.orchestration.ListStacks(stacks.ListOpts{Name: c.auxiliaryStackName()})
+	if err != nil {
+		return nil, err
+	}
+	for _, stack := range found {
+		if stack.Name == c.auxiliaryStackName() && stack.Status != stackStatusDeleteComplete {
+			return &stack, nil
+		}
+	}
+	return nil, nil
+}
+
+// waitForAuxiliaryStackOperation waits until the current operation of the stack with the given id is finished. It fails
+// if the operation failed.
+func (c *FlowContext) waitForAuxiliaryStackOperation(ctx context.Context, id string) error {
+	stack, err := c.waitForAuxiliaryStack(ctx, id)
+	if err != nil {
+		return err
+	}
+	if stack != nil && strings.HasSuffix(stack.Status, stackStatusSuffixFailed) {
+		return fmt.Errorf("auxiliary stack %s is in status %s: %s", stack.Name, stack.Status, stack.StatusReason)
+	}
+	return nil
+}
+
+// waitForAuxiliaryStack waits until the stack with the given id has no operation in progress and returns it, or nil if
+// it does not exist anymore.
+func (c *FlowContext) waitForAuxiliaryStack(ctx context.Context, id string) (*stacks.RetrievedStack, error) {
+	name := c.auxiliaryStackName()
+	waiter := informOnWaiting(c.LogFromContext(ctx), 30*time.Second, "waiting for auxiliary stack...", "stack", name, "id", id)
+
+	var stack *stacks.RetrievedStack
+	err := wait.PollUntilContextCancel(ctx, auxiliaryStackPollInterval, true, func(_ context.Context) (bool, error) {
+		var err error
+		if stack, err = c.orchestration.GetStack(name, id); err != nil {
+			return false, err
+		}
+		if stack == nil {
+			return true, nil
+		}
+		waiter.UpdateMessage(fmt.Sprintf("waiting for auxiliary stack in status %s...", stack.Status))
+		return !strings.HasSuffix(stack.Status, stackStatusSuffixInProgress), nil
+	})
+	waiter.Done(err)
+	if err != nil {
+		return nil, err
+	}
+	return stack, nil
+}
+
+// desiredAuxiliaryStack returns the template and the parameters of the auxiliary stack together with their checksum.
+func (c *FlowContext) desiredAuxiliaryStack() (string, map[string]interface{}, string, error) {
+	if c.auxiliaryStackTemplate == nil {
+		return "", nil, "", fmt.Errorf("missing template of auxiliary stack")
+	}
+	template := *c.auxiliaryStackTemplate
+	parameters, err := c.auxiliaryStackParameters(template)
+	if err != nil {
+		return "", nil, "", err
+	}
+	return template, parameters, gardenerutils.ComputeChecksum(map[string]interface{}{"template": template, "parameters": parameters}), nil
+}
+
+// auxiliaryStackName returns the name of the auxiliary stack, which is unique per project.
+func (c *FlowContext) auxiliaryStackName() string {
+	return c.namespace
+}
+
+// auxiliaryStackParameters returns the parameters of the auxiliary stack. The ids of the infrastructure are only passed
+// if the template declares them, as Heat rejects undeclared parameters.
+func (c *FlowContext) auxiliaryStackParameters(template string) (map[string]interface{}, error) {
+	declared := struct {
+		Parameters map[string]interface{} `json:"parameters"`
+	}{}
+	if err := yaml.Unmarshal([]byte(template), &declared); err != nil {
+		return nil, fmt.Errorf("failed to parse template of auxiliary stack: %w", err)
+	}
+
+	parameters := map[string]interface{}{}
+	for key, value := range c.config.AuxiliaryStack.Parameters {
+		parameters[key] = value
+	}
+	for key, value := range map[string]*string{
+		openstackapi.AuxiliaryStackParameterNetworkID:         c.state.Get(IdentifierNetwork),
+		openstackapi.AuxiliaryStackParameterSubnetID:          c.state.Get(IdentifierSubnet),
+		openstackapi.AuxiliaryStackParameterRouterID:          c.state.Get(IdentifierRouter),
+		openstackapi.AuxiliaryStackParameterSecurityGroupID:   c.state.Get(IdentifierSecGroup),
+		openstackapi.AuxiliaryStackParameterFloatingNetworkID: c.state.Get(IdentifierFloatingNetwork),
+	} {
+		if _, ok := declared.Parameters[key]; ok && value != nil {
+			parameters[key] = *value
+		}
+	}
+	return parameters, nil
+}
@@ -9,6 +9,7 @@ import (
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	openstackapi "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/controller/infrastructure/infraflow/access"
@@ -28,8 +29,20 @@ const (
 	IdentifierFloatingNetwork = "FloatingNetwork"
 	// IdentifierSecGroup is the key for the security group id
 	IdentifierSecGroup = "SecurityGroup"
+	// IdentifierLegacySecGroup is the key for the id of the security group with the legacy name, which is migrated
+	IdentifierLegacySecGroup = "LegacySecurityGroup"
 	// IdentifierShareNetwork is the key for the share network id
 	IdentifierShareNetwork = "ShareNetwork"
+	// IdentifierProject is the key for the id of the dedicated project
+	IdentifierProject = "Project"
+	// IdentifierNetworkRBACPolicies is the key for the ids of the RBAC policies sharing the network, keyed by the id of
+	// the project the network is shared with
+	IdentifierNetworkRBACPolicies = "NetworkRBACPolicies"
+	// IdentifierNodeSubnets is the key for the ids of the node subnets in addition to the subnet given by the workers
+	// CIDR, keyed by their name
+	IdentifierNodeSubnets = "NodeSubnets"
+	// IdentifierAuxiliaryStack is the key for the id of the auxiliary Heat stack
+	IdentifierAuxiliaryStack = "AuxiliaryStack"
 
 	// NameFloatingNetwork is the key for the floating network name
 	NameFloatingNetwork = "FloatingNetworkName"
@@ -43,6 +56,12 @@ const (
 	NameSecGroup = "SecurityGroupName"
 	// NameShareNetwork is the name of the shared network
 	NameShareNetwork = "ShareNetworkName"
+	// NameProject is the name of the dedicated project
+	NameProject = "ProjectName"
+
+	// ChecksumAuxiliaryStack is the key for the checksum of the template and the parameters of the last update of the
+	// auxiliary Heat stack
+	ChecksumAuxiliaryStack = "AuxiliaryStackChecksum"
 
 	// RouterIP is the key for the router IP address
 	RouterIP = "RouterIP"
@@ -64,17 +83,23 @@ type FlowContext struct {
 	infraSpec          extensionsv1alpha1.InfrastructureSpec
 	config             *openstackapi.InfrastructureConfig
 	cloudProfileConfig *openstackapi.CloudProfileConfig
+	podsCIDR           *string
+	ipv6SecGroupRules  bool
 	networking         osclient.Networking
 	loadbalancing      osclient.Loadbalancing
 	sharedFilesystem   osclient.SharedFilesystem
 	access             access.NetworkingAccess
 	compute            osclient.Compute
+	orchestration      osclient.Orchestration
+	skipDeletion       sets.Set[string]
+
+	auxiliaryStackTemplate *string
 }
 
 // NewFlowContext creates a new FlowContext object
 func NewFlowContext(log logr.Logger, clientFactory osclient.Factory,
 	infra *extensionsv1alpha1.Infrastructure, config *openstackapi.InfrastructureConfig,
-	cloudProfileConfig *openstackapi.CloudProfileConfig,
+	cloudProfileConfig *openstackapi.CloudProfileConfig, podsCIDR, auxiliaryStackTemplate *string, ipv6SecGroupRules bool,
 	oldState shared.FlatMap, persistor shared.FlowStatePersistor) (*FlowContext, error) {
 
 	whiteboard := shared.NewWhiteboard()
@@ -103,6 +128,14 @@ func NewFlowContext(log logr.Logger, clientFactory osclient.Factory,
 		return nil, err
 	}
 
+	var orchestration osclient.Orchestration
+	// Heat is not available in every installation, its client is only needed for the auxiliary stack
+	if config.AuxiliaryStack != nil || whiteboard.Get(IdentifierAuxiliaryStack) != nil {
+		if orchestration, err = clientFactory.Orchestration(osclient.WithRegion(infra.Spec.Region)); err != nil {
+			return nil, fmt.Errorf("creating orchestration client failed: %w", err)
+		}
+	}
+
 	flowContext := &FlowContext{
 		BasicFlowContext:   *shared.NewBasicFlowContext(log, whiteboard, persistor),
 		state:              whiteboard,
@@ -110,15 +143,26 @@ func NewFlowContext(log logr.Logger, clientFactory osclient.Factory,
 		infraSpec:          infra.Spec,
 		config:             config,
 		cloudProfileConfig: cloudProfileConfig,
+		podsCIDR:           podsCIDR,
+		ipv6SecGroupRules:  ipv6SecGroupRules,
 		networking:         networking,
 		loadbalancing:      loadbalancing,
 		access:             access,
 		compute:            compute,
 		sharedFilesystem:   sharedFilesytem,
+		orchestration:      orchestration,
+		skipDeletion:       skipDeletionOf(infra),
+
+		auxiliaryStackTemplate: auxiliaryStackTemplate,
 	}
 	return flowContext, nil
 }
 
+// isAdopted returns true if all infrastructure resources already exist and must neither be created nor deleted.
+func (c *FlowContext) isAdopted() bool {
+	return c.config.Adopt != nil && *c.config.Adopt
+}
+
 // GetInfrastructureConfig returns the InfrastructureConfig object
 func (c *FlowContext) GetInfrastructureConfig() *openstackapi.InfrastructureConfig {
 	return c.config
@@ -6,15 +6,77 @@ package infraflow
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils/flow"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
 	"github.com/gophercloud/gophercloud/openstack/sharedfilesystems/v2/sharenetworks"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/pointer"
 
 	. "github.com/gardener/gardener-extension-provider-openstack/pkg/controller/infrastructure/infraflow/shared"
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/internal/infrastructure"
+	osclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
 )
 
+const (
+	// AnnotationKeySkipDeletion is the annotation of an Infrastructure listing the kinds of resources, separated by
+	// commas, whose deletion is skipped when the infrastructure is deleted, e.g. resources which cannot be deleted by
+	// the extension and are cleaned up manually.
+	AnnotationKeySkipDeletion = "openstack.provider.extensions.gardener.cloud/skip-deletion"
+
+	// DeletionAuxiliaryStack is the kind of the auxiliary stack in the skip list.
+	DeletionAuxiliaryStack = "auxiliary-stack"
+	// DeletionSSHKeyPair is the kind of the SSH key pair in the skip list.
+	DeletionSSHKeyPair = "ssh-key-pair"
+	// DeletionSecurityGroup is the kind of the security group in the skip list.
+	DeletionSecurityGroup = "security-group"
+	// DeletionKubernetesRoutes is the kind of the routes of Kubernetes in the skip list.
+	DeletionKubernetesRoutes = "kubernetes-routes"
+	// DeletionKubernetesLoadBalancers is the kind of the load balancers of Kubernetes in the skip list.
+	DeletionKubernetesLoadBalancers = "kubernetes-loadbalancers"
+	// DeletionNetworkRBACPolicies is the kind of the RBAC policies sharing the network in the skip list.
+	DeletionNetworkRBACPolicies = "network-rbac-policies"
+	// DeletionShareNetwork is the kind of the share network in the skip list.
+	DeletionShareNetwork = "share-network"
+	// DeletionFloatingIPs is the kind of the floating IPs associated with ports in the network in the skip list.
+	DeletionFloatingIPs = "floating-ips"
+	// DeletionPorts is the kind of the ports left over in the network in the skip list.
+	DeletionPorts = "ports"
+	// DeletionRouterInterface is the kind of the router interface in the skip list.
+	DeletionRouterInterface = "router-interface"
+	// DeletionNodeSubnets is the kind of the node subnets in the skip list.
+	DeletionNodeSubnets = "node-subnets"
+	// DeletionSubnet is the kind of the subnet in the skip list.
+	DeletionSubnet = "subnet"
+	// DeletionNetwork is the kind of the network in the skip list.
+	DeletionNetwork = "network"
+	// DeletionRouter is the kind of the router in the skip list.
+	DeletionRouter = "router"
+
+	// deleteRetries is the number of retries of a failed deletion task within its timeout.
+	deleteRetries = 3
+	// deleteRetryInterval is the interval between the retries of a failed deletion task.
+	deleteRetryInterval = 10 * time.Second
+	// deviceOwnerNeutronPrefix is the prefix of the device owner of ports managed by Neutron itself.
+	deviceOwnerNeutronPrefix = "network:"
+)
+
+// skipDeletionOf returns the kinds of resources whose deletion is skipped for the given Infrastructure.
+func skipDeletionOf(infra *extensionsv1alpha1.Infrastructure) sets.Set[string] {
+	skip := sets.New[string]()
+	for _, kind := range strings.Split(infra.Annotations[AnnotationKeySkipDeletion], ",") {
+		if kind = strings.TrimSpace(kind); kind != "" {
+			skip.Insert(kind)
+		}
+	}
+	return skip
+}
+
 // Delete creates and runs the flow to delete the AWS infrastructure.
 func (c *FlowContext) Delete(ctx context.Context) error {
 	if c.state.IsEmpty() {
@@ -34,30 +96,41 @@ func (c *FlowContext) buildDeleteGraph() *flow.Graph {
 
 	needToDeleteNetwork := c.config.Networks.ID == nil
 	needToDeleteRouter := c.config.Networks.Router == nil
+	// adopted resources are owned by the user and must be kept
+	adopted := c.isAdopted()
 
-	_ = c.AddTask(g, "delete ssh key pair",
+	// the resources of the auxiliary stack may depend on all other resources
+	deleteAuxiliaryStack := c.addDeleteTask(g, DeletionAuxiliaryStack, "delete auxiliary stack",
+		c.deleteAuxiliaryStack,
+		Timeout(auxiliaryStackTimeout))
+	_ = c.addDeleteTask(g, DeletionSSHKeyPair, "delete ssh key pair",
 		c.deleteSSHKeyPair,
-		Timeout(defaultTimeout))
-	_ = c.AddTask(g, "delete security group",
-		c.deleteSecGroup,
-		Timeout(defaultTimeout))
+		DoIf(!adopted), Timeout(defaultTimeout), Dependencies(deleteAuxiliaryStack))
 	recoverRouterID := c.AddTask(g, "recover router ID",
 		c.recoverRouterID,
-		Timeout(defaultTimeout))
+		Timeout(defaultTimeout), Dependencies(deleteAuxiliaryStack))
 	recoverSubnetID := c.AddTask(g, "recover subnet ID",
 		c.recoverSubnetID,
-		Timeout(defaultTimeout))
-	k8sRoutes := c.AddTask(g, "delete kubernetes routes",
+		Timeout(defaultTimeout), Dependencies(deleteAuxiliaryStack))
+	k8sRoutes := c.addDeleteTask(g, DeletionKubernetesRoutes, "delete kubernetes routes",
 		func(ctx context.Context) error {
 			routerID := c.state.Get(IdentifierRouter)
 			if routerID == nil {
 				return nil
 			}
-			return infrastructure.CleanupKubernetesRoutes(ctx, c.networking, *routerID, infrastructure.WorkersCIDR(c.config))
+			if err := infrastructure.CleanupKubernetesRoutes(ctx, c.networking, *routerID, infrastructure.WorkersCIDR(c.config)); err != nil {
+				return err
+			}
+			for _, subnet := range c.config.Networks.Subnets {
+				if err := infrastructure.CleanupKubernetesRoutes(ctx, c.networking, *routerID, subnet.CIDR); err != nil {
+					return err
+				}
+			}
+			return nil
 		},
 		Timeout(defaultTimeout),
 	)
-	k8sLoadBalancers := c.AddTask(g, "delete kubernetes loadbalancers",
+	k8sLoadBalancers := c.addDeleteTask(g, DeletionKubernetesLoadBalancers, "delete kubernetes loadbalancers",
 		func(ctx context.Context) error {
 			subnetID := c.state.Get(IdentifierSubnet)
 			if subnetID == nil {
@@ -68,26 +141,129 @@ func (c *FlowContext) buildDeleteGraph() *flow.Graph {
 		Timeout(defaultTimeout),
 	)
 
-	_ = c.AddTask(g, "delete share network",
+	deleteNetworkRBACPolicies := c.addDeleteTask(g, DeletionNetworkRBACPolicies, "delete network RBAC policies",
+		c.deleteNetworkRBACPolicies,
+		Timeout(defaultTimeout))
+	_ = c.addDeleteTask(g, DeletionShareNetwork, "delete share network",
 		c.deleteShareNetwork,
 		Timeout(defaultTimeout), Dependencies(recoverSubnetID))
-	deleteRouterInterface := c.AddTask(g, "delete router interface",
+	// floating IPs associated with ports in the network block the removal of the router interface, ports left over
+	// e.g. by deleted servers block the deletion of the subnets and the network
+	releaseFloatingIPs := c.addDeleteTask(g, DeletionFloatingIPs, "release floating IPs",
+		func(ctx context.Context) error { return c.releaseFloatingIPs(ctx, needToDeleteNetwork) },
+		DoIf(!adopted), Timeout(defaultTimeout), Dependencies(recoverSubnetID, k8sLoadBalancers))
+	deletePorts := c.addDeleteTask(g, DeletionPorts, "delete ports",
+		func(ctx context.Context) error { return c.deletePorts(ctx, needToDeleteNetwork) },
+		DoIf(!adopted), Timeout(defaultLongTimeout), Dependencies(releaseFloatingIPs))
+	_ = c.addDeleteTask(g, DeletionSecurityGroup, "delete security group",
+		c.deleteSecGroup,
+		DoIf(!adopted), Timeout(defaultTimeout), Dependencies(deleteAuxiliaryStack, deletePorts))
+	deleteRouterInterface := c.addDeleteTask(g, DeletionRouterInterface, "delete router interface",
 		c.deleteRouterInterface,
-		Timeout(defaultTimeout), Dependencies(recoverRouterID, recoverSubnetID, k8sRoutes))
+		DoIf(!adopted), Timeout(defaultTimeout), Dependencies(recoverRouterID, recoverSubnetID, k8sRoutes, releaseFloatingIPs))
+	deleteNodeSubnets := c.addDeleteTask(g, DeletionNodeSubnets, "delete node subnets",
+		c.deleteNodeSubnets,
+		DoIf(!adopted), Timeout(defaultLongTimeout), Dependencies(recoverRouterID, k8sRoutes, deletePorts))
 	// subnet deletion only needed if network is given by spec
-	_ = c.AddTask(g, "delete subnet",
+	_ = c.addDeleteTask(g, DeletionSubnet, "delete subnet",
 		c.deleteSubnet,
-		DoIf(!needToDeleteNetwork), Timeout(defaultTimeout), Dependencies(deleteRouterInterface, k8sLoadBalancers))
-	_ = c.AddTask(g, "delete network",
+		DoIf(!needToDeleteNetwork && !adopted), Timeout(defaultTimeout), Dependencies(deleteRouterInterface, k8sLoadBalancers, deleteNetworkRBACPolicies, deletePorts))
+	_ = c.addDeleteTask(g, DeletionNetwork, "delete network",
 		c.deleteNetwork,
-		DoIf(needToDeleteNetwork), Timeout(defaultTimeout), Dependencies(deleteRouterInterface))
-	_ = c.AddTask(g, "delete router",
+		DoIf(needToDeleteNetwork), Timeout(defaultTimeout), Dependencies(deleteRouterInterface, deleteNodeSubnets, deleteNetworkRBACPolicies, deletePorts))
+	_ = c.addDeleteTask(g, DeletionRouter, "delete router",
 		c.deleteRouter,
-		DoIf(needToDeleteRouter), Timeout(defaultTimeout), Dependencies(deleteRouterInterface))
+		DoIf(needToDeleteRouter), Timeout(defaultTimeout), Dependencies(deleteRouterInterface, deleteNodeSubnets))
 
 	return g
 }
 
+// addDeleteTask adds a task deleting the resources of the given kind, which is retried as the deletion of dependent
+// resources may not be finished yet. The task is skipped if the deletion of the kind is skipped for the infrastructure.
+func (c *FlowContext) addDeleteTask(g *flow.Graph, kind, name string, fn flow.TaskFn, options ...TaskOption) flow.TaskIDer {
+	if c.skipDeletion.Has(kind) {
+		c.Log.Info("Skipping deletion", "kind", kind)
+	}
+	options = append(options, DoIf(!c.skipDeletion.Has(kind)), Retries(deleteRetries, deleteRetryInterval))
+	return c.AddTask(g, name, fn, options...)
+}
+
+// releaseFloatingIPs disassociates the floating IPs from the ports in the network of the shoot, or only from the ports
+// in its subnet if the network is not owned by the shoot. The floating IPs themselves are kept, as they may have been
+// allocated by the user.
+func (c *FlowContext) releaseFloatingIPs(ctx context.Context, ownsNetwork bool) error {
+	log := c.LogFromContext(ctx)
+	list, err := c.listPortsToDelete(ownsNetwork, true)
+	if err != nil {
+		return err
+	}
+	for _, port := range list {
+		fips, err := c.networking.ListFip(floatingips.ListOpts{PortID: port.ID})
+		if err != nil {
+			return err
+		}
+		for _, fip := range fips {
+			log.Info("disassociating...", "floatingIP", fip.ID, "port", port.ID)
+			if _, err := c.networking.UpdateFloatingIP(fip.ID, floatingips.UpdateOpts{PortID: pointer.String("")}); osclient.IgnoreNotFoundError(err) != nil {
+				return fmt.Errorf("failed to disassociate floating IP %s from port %s: %w", fip.FloatingIP, port.ID, err)
+			}
+		}
+	}
+	return nil
+}
+
+// deletePorts deletes the ports left over in the network of the shoot, or only in its subnet if the network is not owned
+// by the shoot, e.g. ports of servers which were deleted without them. Ports managed by Neutron itself, e.g. router
+// interfaces and DHCP ports, are removed with the resources they belong to.
+func (c *FlowContext) deletePorts(ctx context.Context, ownsNetwork bool) error {
+	log := c.LogFromContext(ctx)
+	list, err := c.listPortsToDelete(ownsNetwork, false)
+	if err != nil {
+		return err
+	}
+	for _, port := range list {
+		log.Info("deleting...", "port", port.ID, "deviceOwner", port.DeviceOwner)
+		if err := c.networking.DeletePort(port.ID); osclient.IgnoreNotFoundError(err) != nil {
+			return fmt.Errorf("failed to delete port %s: %w", port.ID, err)
+		}
+	}
+	return nil
+}
+
+// listPortsToDelete lists the ports in the network of the shoot, or only in its subnet if the network is not owned by
+// the shoot. Ports managed by Neutron itself are only included if requested.
+func (c *FlowContext) listPortsToDelete(ownsNetwork, includeNeutronPorts bool) ([]ports.Port, error) {
+	var listOpts ports.ListOpts
+	if ownsNetwork {
+		network, err := c.findExistingNetwork()
+		if err != nil || network == nil {
+			return nil, err
+		}
+		listOpts.NetworkID = network.ID
+	} else {
+		subnetID := c.state.Get(IdentifierSubnet)
+		if subnetID == nil {
+			return nil, nil
+		}
+		listOpts.FixedIPs = []ports.FixedIPOpts{{SubnetID: *subnetID}}
+	}
+
+	list, err := c.networking.ListPorts(listOpts)
+	if err != nil {
+		return nil, err
+	}
+	if includeNeutronPorts {
+		return list, nil
+	}
+	var result []ports.Port
+	for _, port := range list {
+		if !strings.HasPrefix(port.DeviceOwner, deviceOwnerNeutronPrefix) {
+			result = append(result, port)
+		}
+	}
+	return result, nil
+}
+
 func (c *FlowContext) deleteRouter(ctx context.Context) error {
 	log := c.LogFromContext(ctx)
 	current, err := c.findExistingRouter()
@@ -103,6 +279,21 @@ func (c *FlowContext) deleteRouter(ctx context.Context) error {
 	return nil
 }
 
+// deleteNetworkRBACPolicies stops sharing the network with other projects. It fails as long as the other projects still
+// use the network, e.g. by ports of their servers.
+func (c *FlowContext) deleteNetworkRBACPolicies(ctx context.Context) error {
+	log := c.LogFromContext(ctx)
+	managed := c.state.GetChild(IdentifierNetworkRBACPolicies)
+	for project, policyID := range managed.AsMap() {
+		log.Info("deleting...", "project", project, "rbacPolicy", policyID)
+		if err := c.networking.DeleteRBACPolicy(policyID); osclient.IgnoreNotFoundError(err) != nil {
+			return fmt.Errorf("failed to stop sharing the network with project %s: %w", project, err)
+		}
+		managed.Set(project, "")
+	}
+	return nil
+}
+
 func (c *FlowContext) deleteNetwork(ctx context.Context) error {
 	log := c.LogFromContext(ctx)
 	current, err := c.findExistingNetwork()
@@ -154,6 +345,10 @@ func (c *FlowContext) recoverRouterID(_ context.Context) error {
 }
 
 func (c *FlowContext) recoverSubnetID(_ context.Context) error {
+	if c.config.Networks.SubnetID != nil {
+		c.state.Set(IdentifierSubnet, *c.config.Networks.SubnetID)
+		return nil
+	}
 	if c.state.Get(IdentifierSubnet) != nil {
 		return nil
 	}
@@ -197,7 +392,8 @@ func (c *FlowContext) deleteRouterInterface(ctx context.Context) error {
 
 func (c *FlowContext) deleteSecGroup(ctx context.Context) error {
 	log := c.LogFromContext(ctx)
-	current, err := findExisting(c.state.Get(IdentifierSecGroup), c.namespace, c.access.GetSecurityGroupByID, c.access.GetSecurityGroupByName)
+	name := c.secGroupName()
+	current, err := c.findExistingSecGroup(c.state.Get(IdentifierSecGroup), name)
 	if err != nil {
 		return err
 	}
@@ -207,6 +403,16 @@ func (c *FlowContext) deleteSecGroup(ctx context.Context) error {
 			return err
 		}
 	}
+	if err := c.recoverLegacySecGroup(); err != nil {
+		return err
+	}
+	if legacyID := c.state.Get(IdentifierLegacySecGroup); legacyID != nil {
+		log.Info("deleting legacy security group...", "securityGroup", *legacyID)
+		if err := c.networking.DeleteSecurityGroup(*legacyID); err != nil {
+			return err
+		}
+		c.state.Set(IdentifierLegacySecGroup, "")
+	}
 	c.state.Set(NameSecGroup, "")
 	c.state.SetObject(ObjectSecGroup, nil)
 	return nil
@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/subnets"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	osclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
+)
+
+// ensureNodeSubnets creates the node subnets in addition to the subnet given by the workers CIDR and connects them to
+// the router. Node subnets recorded in the state which are no longer configured are disconnected and deleted, which
+// fails as long as machines are still attached to them.
+func (c *FlowContext) ensureNodeSubnets(ctx context.Context) error {
+	log := c.LogFromContext(ctx)
+
+	managed := c.state.GetChild(IdentifierNodeSubnets)
+	if len(c.config.Networks.Subnets) == 0 && len(managed.AsMap()) == 0 {
+		return nil
+	}
+
+	networkID := *c.state.Get(IdentifierNetwork)
+	routerID := c.state.Get(IdentifierRouter)
+	if routerID == nil {
+		return fmt.Errorf("internal error: missing routerID")
+	}
+
+	desired := sets.New[string]()
+	for _, nodeSubnet := range c.config.Networks.Subnets {
+		desired.Insert(nodeSubnet.Name)
+		subnet := &subnets.Subnet{
+			Name:           c.nodeSubnetName(nodeSubnet.Name),
+			NetworkID:      networkID,
+			CIDR:           nodeSubnet.CIDR,
+			IPVersion:      4,
+			DNSNameservers: c.cloudProfileConfig.DNSServers,
+		}
+		current, err := c.findExistingNodeSubnet(nodeSubnet.Name)
+		if err != nil {
+			return err
+		}
+		if current != nil {
+			managed.Set(nodeSubnet.Name, current.ID)
+			if _, err := c.access.UpdateSubnet(subnet, current); err != nil {
+				return err
+			}
+		} else {
+			log.Info("creating...", "subnet", nodeSubnet.Name)
+			created, err := c.access.CreateSubnet(subnet)
+			if err != nil {
+				return err
+			}
+			managed.Set(nodeSubnet.Name, created.ID)
+		}
+
+		subnetID := *managed.Get(nodeSubnet.Name)
+		portID, err := c.access.GetRouterInterfacePortID(*routerID, subnetID)
+		if err != nil {
+			return err
+		}
+		if portID == nil {
+			log.Info("creating router interface...", "subnet", nodeSubnet.Name)
+			if err := c.access.AddRouterInterfaceAndWait(ctx, *routerID, subnetID); err != nil {
+				return err
+			}
+		}
+	}
+
+	for name, subnetID := range managed.AsMap() {
+		if desired.Has(name) {
+			continue
+		}
+		if err := c.deleteNodeSubnet(ctx, name, subnetID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nodeSubnetName returns the name of the OpenStack subnet of the node subnet with the given name.
+func (c *FlowContext) nodeSubnetName(name string) string {
+	return c.namespace + "-" + name
+}
+
+func (c *FlowContext) findExistingNodeSubnet(name string) (*subnets.Subnet, error) {
+	networkID, err := c.getNetworkID()
+	if err != nil {
+		return nil, err
+	}
+	if networkID == nil {
+		return nil, fmt.Errorf("network not found")
+	}
+	getByName := func(name string) ([]*subnets.Subnet, error) {
+		return c.access.GetSubnetByName(*networkID, name)
+	}
+	return findExisting(c.state.GetChild(IdentifierNodeSubnets).Get(name), c.nodeSubnetName(name), c.access.GetSubnetByID, getByName)
+}
+
+// deleteNodeSubnets disconnects all node subnets from the router and deletes them.
+func (c *FlowContext) deleteNodeSubnets(ctx context.Context) error {
+	managed := c.state.GetChild(IdentifierNodeSubnets)
+	for _, nodeSubnet := range c.config.Networks.Subnets {
+		if managed.Get(nodeSubnet.Name) != nil {
+			continue
+		}
+		current, err := c.findExistingNodeSubnet(nodeSubnet.Name)
+		if err != nil {
+			return err
+		}
+		if current != nil {
+			managed.Set(nodeSubnet.Name, current.ID)
+		}
+	}
+
+	for name, subnetID := range managed.AsMap() {
+		if err := c.deleteNodeSubnet(ctx, name, subnetID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteNodeSubnet disconnects the node subnet with the given name and id from the router and deletes it.
+func (c *FlowContext) deleteNodeSubnet(ctx context.Context, name, subnetID string) error {
+	log := c.LogFromContext(ctx)
+
+	if routerID := c.state.Get(IdentifierRouter); routerID != nil {
+		portID, err := c.access.GetRouterInterfacePortID(*routerID, subnetID)
+		if err != nil {
+			return err
+		}
+		if portID != nil {
+			log.Info("deleting router interface...", "subnet", name)
+			if err := c.access.RemoveRouterInterfaceAndWait(ctx, *routerID, subnetID, *portID); err != nil {
+				return err
+			}
+		}
+	}
+
+	log.Info("deleting...", "subnet", name)
+	if err := c.networking.DeleteSubnet(subnetID); osclient.IgnoreNotFoundError(err) != nil {
+		return fmt.Errorf("failed to delete node subnet %s: %w", name, err)
+	}
+	c.state.GetChild(IdentifierNodeSubnets).Set(name, "")
+	return nil
+}
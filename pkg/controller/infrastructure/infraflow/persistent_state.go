@@ -118,3 +118,45 @@ func (s *PersistentState) TerraformCleanedUp() bool {
 func (s *PersistentState) SetTerraformCleanedUp() {
 	s.Data[MarkerTerraformCleanedUp] = "true"
 }
+
+// SetProject records the id and name of the dedicated project.
+func (s *PersistentState) SetProject(id, name string) {
+	s.Data[IdentifierProject] = id
+	s.Data[NameProject] = name
+}
+
+// NewPersistentStateFromInfrastructureStatus creates a new PersistentState from the ids and names of the resources in
+// the given provider status of an infrastructure reconciled by Terraformer. It is marked as migrated from Terraform.
+func NewPersistentStateFromInfrastructureStatus(status *openstack.InfrastructureStatus) *PersistentState {
+	state := NewPersistentState()
+	state.SetMigratedFromTerraform()
+
+	setIfNotEmpty := func(key, value string) {
+		if value != "" {
+			state.Data[key] = value
+		}
+	}
+	setIfNotEmpty(IdentifierNetwork, status.Networks.ID)
+	setIfNotEmpty(NameNetwork, status.Networks.Name)
+	setIfNotEmpty(IdentifierRouter, status.Networks.Router.ID)
+	setIfNotEmpty(RouterIP, status.Networks.Router.IP)
+	setIfNotEmpty(IdentifierFloatingNetwork, status.Networks.FloatingPool.ID)
+	setIfNotEmpty(NameFloatingNetwork, status.Networks.FloatingPool.Name)
+	if status.Networks.ShareNetwork != nil {
+		setIfNotEmpty(IdentifierShareNetwork, status.Networks.ShareNetwork.ID)
+		setIfNotEmpty(NameShareNetwork, status.Networks.ShareNetwork.Name)
+	}
+	for _, subnet := range status.Networks.Subnets {
+		if subnet.Purpose == openstack.PurposeNodes && subnet.Name == nil {
+			setIfNotEmpty(IdentifierSubnet, subnet.ID)
+		}
+	}
+	for _, securityGroup := range status.SecurityGroups {
+		if securityGroup.Purpose == openstack.PurposeNodes {
+			setIfNotEmpty(IdentifierSecGroup, securityGroup.ID)
+			setIfNotEmpty(NameSecGroup, securityGroup.Name)
+		}
+	}
+	setIfNotEmpty(NameKeyPair, status.Node.KeyName)
+	return state
+}
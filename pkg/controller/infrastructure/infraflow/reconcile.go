@@ -7,23 +7,34 @@ package infraflow
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/gardener/gardener/pkg/utils/flow"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/rbacpolicies"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/groups"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/rules"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/subnets"
 	"github.com/gophercloud/gophercloud/openstack/sharedfilesystems/v2/sharenetworks"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/pointer"
 
+	openstackapi "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/helper"
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/controller/infrastructure/infraflow/access"
 	. "github.com/gardener/gardener-extension-provider-openstack/pkg/controller/infrastructure/infraflow/shared"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/internal/infrastructure"
+	osclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
 )
 
 const (
 	defaultTimeout     = 90 * time.Second
 	defaultLongTimeout = 3 * time.Minute
+
+	rbacPolicyObjectTypeNetwork = "network"
+
+	ipv6SelfRuleDescription = "IPv6: allow all incoming traffic within the same security group"
 )
 
 // Reconcile creates and runs the flow to reconcile the AWS infrastructure.
@@ -55,17 +66,29 @@ func (c *FlowContext) buildReconcileGraph() *flow.Graph {
 		c.ensureSubnet,
 		Timeout(defaultTimeout), Dependencies(ensureNetwork))
 
-	_ = c.AddTask(g, "ensure router interface",
+	_ = c.AddTask(g, "ensure network RBAC policies",
+		c.ensureNetworkRBACPolicies,
+		Timeout(defaultTimeout), Dependencies(ensureNetwork))
+
+	ensureRouterInterface := c.AddTask(g, "ensure router interface",
 		c.ensureRouterInterface,
 		Timeout(defaultTimeout), Dependencies(ensureRouter, ensureSubnet))
 
+	_ = c.AddTask(g, "ensure node subnets",
+		c.ensureNodeSubnets,
+		Timeout(defaultLongTimeout), Dependencies(ensureRouter, ensureNetwork))
+
 	ensureSecGroup := c.AddTask(g, "ensure security group",
 		c.ensureSecGroup,
 		Timeout(defaultTimeout), Dependencies(ensureRouter))
 
-	_ = c.AddTask(g, "ensure security group rules",
+	ensureSecGroupRules := c.AddTask(g, "ensure security group rules",
 		c.ensureSecGroupRules,
-		Timeout(defaultTimeout), Dependencies(ensureSecGroup))
+		DoIf(!c.isAdopted()), Timeout(defaultTimeout), Dependencies(ensureSecGroup))
+
+	_ = c.AddTask(g, "migrate legacy security group",
+		c.migrateLegacySecGroup,
+		DoIf(!c.isAdopted() && c.config.SecurityGroupID == nil), Timeout(defaultTimeout), Dependencies(ensureSecGroupRules))
 
 	_ = c.AddTask(g, "ensure ssh key pair",
 		c.ensureSSHKeyPair,
@@ -76,6 +99,10 @@ func (c *FlowContext) buildReconcileGraph() *flow.Graph {
 		Timeout(defaultTimeout), Dependencies(ensureSubnet),
 	)
 
+	_ = c.AddTask(g, "ensure auxiliary stack",
+		c.ensureAuxiliaryStack,
+		Timeout(auxiliaryStackTimeout), Dependencies(ensureRouterInterface, ensureSecGroup))
+
 	return g
 }
 
@@ -243,15 +270,97 @@ func (c *FlowContext) getNetworkID() (*string, error) {
 	return nil, nil
 }
 
-func (c *FlowContext) ensureSubnet(ctx context.Context) error {
+// ensureNetworkRBACPolicies shares the network with the configured projects. Only the RBAC policies created by the
+// flow are recorded in the state and deleted once a project is removed, pre-existing policies are left untouched.
+func (c *FlowContext) ensureNetworkRBACPolicies(ctx context.Context) error {
 	log := c.LogFromContext(ctx)
 
+	managed := c.state.GetChild(IdentifierNetworkRBACPolicies)
+	if len(c.config.Networks.ShareWithProjects) == 0 && len(managed.AsMap()) == 0 {
+		return nil
+	}
+
 	networkID := *c.state.Get(IdentifierNetwork)
-	workersCIDR := c.config.Networks.Workers
-	// Backwards compatibility - remove this code in a future version.
-	if workersCIDR == "" {
-		workersCIDR = c.config.Networks.Worker
+	policies, err := c.networking.ListRBACPolicies(rbacpolicies.ListOpts{
+		ObjectType: rbacPolicyObjectTypeNetwork,
+		ObjectID:   networkID,
+		Action:     rbacpolicies.ActionAccessShared,
+	})
+	if err != nil {
+		return err
+	}
+	existing := map[string]string{}
+	for _, policy := range policies {
+		existing[policy.TargetTenant] = policy.ID
+	}
+
+	desired := sets.New(c.config.Networks.ShareWithProjects...)
+	for _, project := range c.config.Networks.ShareWithProjects {
+		if policyID, ok := existing[project]; ok {
+			// the network may already be shared with the project by a policy the flow has not created
+			if recorded := managed.Get(project); recorded != nil && *recorded != policyID {
+				managed.Set(project, "")
+			}
+			continue
+		}
+		log.Info("creating...", "project", project)
+		created, err := c.networking.CreateRBACPolicy(rbacpolicies.CreateOpts{
+			Action:       rbacpolicies.ActionAccessShared,
+			ObjectType:   rbacPolicyObjectTypeNetwork,
+			ObjectID:     networkID,
+			TargetTenant: project,
+		})
+		if err != nil {
+			return err
+		}
+		managed.Set(project, created.ID)
+	}
+
+	for project, policyID := range managed.AsMap() {
+		if desired.Has(project) {
+			continue
+		}
+		if existing[project] == policyID {
+			log.Info("deleting...", "project", project, "rbacPolicy", policyID)
+			if err := c.networking.DeleteRBACPolicy(policyID); osclient.IgnoreNotFoundError(err) != nil {
+				return fmt.Errorf("failed to stop sharing the network with project %s: %w", project, err)
+			}
+		}
+		managed.Set(project, "")
 	}
+	return nil
+}
+
+func (c *FlowContext) ensureSubnet(ctx context.Context) error {
+	if c.config.Networks.SubnetID != nil {
+		return c.ensureConfiguredSubnet(ctx)
+	}
+	return c.ensureNewSubnet(ctx)
+}
+
+func (c *FlowContext) ensureConfiguredSubnet(_ context.Context) error {
+	subnet, err := c.access.GetSubnetByID(*c.config.Networks.SubnetID)
+	if err != nil {
+		return err
+	}
+	if subnet == nil {
+		return fmt.Errorf("subnet %s not found", *c.config.Networks.SubnetID)
+	}
+	if networkID := pointer.StringDeref(c.state.Get(IdentifierNetwork), ""); subnet.NetworkID != networkID {
+		return fmt.Errorf("subnet %s does not belong to network %s", subnet.ID, networkID)
+	}
+	if workersCIDR := c.workersCIDR(); subnet.CIDR != workersCIDR {
+		return fmt.Errorf("CIDR %s of subnet %s does not match the workers CIDR %s", subnet.CIDR, subnet.ID, workersCIDR)
+	}
+	c.state.Set(IdentifierSubnet, subnet.ID)
+	return nil
+}
+
+func (c *FlowContext) ensureNewSubnet(ctx context.Context) error {
+	log := c.LogFromContext(ctx)
+
+	networkID := *c.state.Get(IdentifierNetwork)
+	workersCIDR := c.workersCIDR()
 	desired := &subnets.Subnet{
 		Name:           c.namespace,
 		NetworkID:      networkID,
@@ -279,6 +388,14 @@ func (c *FlowContext) ensureSubnet(ctx context.Context) error {
 	return nil
 }
 
+func (c *FlowContext) workersCIDR() string {
+	// Backwards compatibility - remove this code in a future version.
+	if c.config.Networks.Workers == "" {
+		return c.config.Networks.Worker
+	}
+	return c.config.Networks.Workers
+}
+
 func (c *FlowContext) findExistingSubnet() (*subnets.Subnet, error) {
 	networkID, err := c.getNetworkID()
 	if err != nil {
@@ -328,21 +445,49 @@ func (c *FlowContext) ensureRouterInterface(ctx context.Context) error {
 	if portID != nil {
 		return nil
 	}
+	if c.isAdopted() {
+		return fmt.Errorf("router %s is not connected to subnet %s", *routerID, *subnetID)
+	}
 	log.Info("creating...")
 	return c.access.AddRouterInterfaceAndWait(ctx, *routerID, *subnetID)
 }
 
 func (c *FlowContext) ensureSecGroup(ctx context.Context) error {
+	if c.config.SecurityGroupID != nil {
+		return c.ensureConfiguredSecGroup(ctx)
+	}
+	return c.ensureNewSecGroup(ctx)
+}
+
+func (c *FlowContext) ensureConfiguredSecGroup(_ context.Context) error {
+	group, err := c.access.GetSecurityGroupByID(*c.config.SecurityGroupID)
+	if err != nil {
+		return err
+	}
+	if group == nil {
+		return fmt.Errorf("security group %s not found", *c.config.SecurityGroupID)
+	}
+	c.state.Set(IdentifierSecGroup, group.ID)
+	c.state.Set(NameSecGroup, group.Name)
+	c.state.SetObject(ObjectSecGroup, group)
+	return nil
+}
+
+func (c *FlowContext) ensureNewSecGroup(ctx context.Context) error {
 	log := c.LogFromContext(ctx)
 
+	name := c.secGroupName()
 	desired := &groups.SecGroup{
-		Name:        c.namespace,
+		Name:        name,
 		Description: "Cluster Nodes",
 	}
-	current, err := findExisting(c.state.Get(IdentifierSecGroup), c.namespace, c.access.GetSecurityGroupByID, c.access.GetSecurityGroupByName)
+	current, err := c.findExistingSecGroup(c.state.Get(IdentifierSecGroup), name)
 	if err != nil {
 		return err
 	}
+	if err := c.recoverLegacySecGroup(); err != nil {
+		return err
+	}
 	if current != nil {
 		c.state.Set(IdentifierSecGroup, current.ID)
 		c.state.Set(NameSecGroup, current.Name)
@@ -361,6 +506,138 @@ func (c *FlowContext) ensureSecGroup(ctx context.Context) error {
 	return nil
 }
 
+// secGroupName returns the name of the security group of the nodes.
+func (c *FlowContext) secGroupName() string {
+	return c.namespace
+}
+
+// legacySecGroupName returns the name former versions of the extension used for the security group of the nodes, or an
+// empty string if its naming scheme has not changed. If it changes, the former name is returned here, so that existing
+// security groups are migrated to the new name by migrateLegacySecGroup without rolling the nodes.
+func (c *FlowContext) legacySecGroupName() string {
+	return ""
+}
+
+// findExistingSecGroup finds the security group by id or by name. Security groups with a different name are ignored.
+func (c *FlowContext) findExistingSecGroup(id *string, name string) (*groups.SecGroup, error) {
+	return findExisting(id, name, c.access.GetSecurityGroupByID, c.access.GetSecurityGroupByName,
+		func(item *groups.SecGroup) bool { return item.Name == name })
+}
+
+// recoverLegacySecGroup remembers the id of the security group of the nodes with the legacy name, if it still exists.
+func (c *FlowContext) recoverLegacySecGroup() error {
+	name := c.legacySecGroupName()
+	if c.isAdopted() || name == "" || name == c.secGroupName() {
+		return nil
+	}
+	legacy, err := c.findExistingSecGroup(c.state.Get(IdentifierLegacySecGroup), name)
+	if err != nil {
+		return err
+	}
+	if legacy == nil {
+		c.state.Set(IdentifierLegacySecGroup, "")
+		return nil
+	}
+	c.state.Set(IdentifierLegacySecGroup, legacy.ID)
+	return nil
+}
+
+// migrateLegacySecGroup moves the ports of the nodes from the security group with the legacy name to the current one
+// without rolling the nodes. All rules of the legacy security group are copied to the current one, and the current
+// security group is attached to the ports alongside the legacy one first. Only if a later reconciliation verifies that
+// all ports already carry the current security group, the legacy one is detached and deleted. In between, the workers
+// are reconciled with the current security group.
+func (c *FlowContext) migrateLegacySecGroup(ctx context.Context) error {
+	log := c.LogFromContext(ctx)
+
+	legacyID := c.state.Get(IdentifierLegacySecGroup)
+	if legacyID == nil {
+		return nil
+	}
+	currentID := c.state.Get(IdentifierSecGroup)
+	if currentID == nil {
+		return fmt.Errorf("missing security group ID")
+	}
+
+	if err := c.copyLegacySecGroupRules(ctx, *legacyID, *currentID); err != nil {
+		return err
+	}
+
+	ports, err := c.access.GetPortsBySecurityGroup(*legacyID)
+	if err != nil {
+		return err
+	}
+	attached := 0
+	for _, port := range ports {
+		if slices.Contains(port.SecurityGroups, *currentID) {
+			continue
+		}
+		log.Info("attaching security group...", "port", port.ID, "securityGroup", *currentID)
+		securityGroups := append(slices.Clone(port.SecurityGroups), *currentID)
+		if err := c.access.UpdatePortSecurityGroups(port, securityGroups); err != nil {
+			return err
+		}
+		attached++
+	}
+	if attached > 0 {
+		log.Info("keeping legacy security group until next reconciliation", "securityGroup", *legacyID, "attachedPorts", attached)
+		return nil
+	}
+
+	for _, port := range ports {
+		log.Info("detaching legacy security group...", "port", port.ID, "securityGroup", *legacyID)
+		securityGroups := slices.DeleteFunc(slices.Clone(port.SecurityGroups), func(id string) bool { return id == *legacyID })
+		if err := c.access.UpdatePortSecurityGroups(port, securityGroups); err != nil {
+			return err
+		}
+	}
+	log.Info("deleting legacy security group...", "securityGroup", *legacyID)
+	if err := c.networking.DeleteSecurityGroup(*legacyID); osclient.IgnoreNotFoundError(err) != nil {
+		return err
+	}
+	c.state.Set(IdentifierLegacySecGroup, "")
+	return nil
+}
+
+// copyLegacySecGroupRules adds all rules of the legacy security group to the current one, i.e. the rules managed by the
+// extension as well as the ones added by users, the bastion controller or the cloud-controller-manager. Rules allowing
+// the traffic within the legacy security group allow the traffic within the current one instead. Rules of other security
+// groups referring to the legacy security group are not adapted.
+func (c *FlowContext) copyLegacySecGroupRules(ctx context.Context, legacyID, currentID string) error {
+	log := c.LogFromContext(ctx)
+
+	legacy, err := c.access.GetSecurityGroupByID(legacyID)
+	if err != nil {
+		return err
+	}
+	if legacy == nil {
+		return nil
+	}
+	current, err := c.access.GetSecurityGroupByID(currentID)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		return fmt.Errorf("security group %s not found", currentID)
+	}
+
+	var legacyRules []rules.SecGroupRule
+	for _, rule := range legacy.Rules {
+		rule.ID = ""
+		if rule.RemoteGroupID == legacyID {
+			rule.RemoteGroupID = access.SecurityGroupIDSelf
+		}
+		legacyRules = append(legacyRules, rule)
+	}
+	// rules of the current security group are never deleted here, as only the rules of the legacy one are passed
+	if modified, err := c.access.UpdateSecurityGroupRules(current, legacyRules, func(_ *rules.SecGroupRule) bool { return false }); err != nil {
+		return err
+	} else if modified {
+		log.Info("copied rules of legacy security group", "securityGroup", legacyID)
+	}
+	return nil
+}
+
 func (c *FlowContext) ensureSecGroupRules(ctx context.Context) error {
 	log := c.LogFromContext(ctx)
 
@@ -373,6 +650,16 @@ func (c *FlowContext) ensureSecGroupRules(ctx context.Context) error {
 		return fmt.Errorf("internal error: casting to SecGroup failed")
 	}
 
+	if modified, err := c.access.UpdateSecurityGroupRules(group, c.desiredSecGroupRules(), allowDeleteSecGroupRule); err != nil {
+		return err
+	} else if modified {
+		log.Info("updated rules")
+	}
+	return nil
+}
+
+// desiredSecGroupRules returns the rules the security group of the nodes must have.
+func (c *FlowContext) desiredSecGroupRules() []rules.SecGroupRule {
 	desiredRules := []rules.SecGroupRule{
 		{
 			Direction:     string(rules.DirIngress),
@@ -380,50 +667,131 @@ func (c *FlowContext) ensureSecGroupRules(ctx context.Context) error {
 			RemoteGroupID: access.SecurityGroupIDSelf,
 			Description:   "IPv4: allow all incoming traffic within the same security group",
 		},
-		{
+	}
+	if c.ipv6SecGroupRules {
+		desiredRules = append(desiredRules, rules.SecGroupRule{
+			Direction:     string(rules.DirIngress),
+			EtherType:     string(rules.EtherType6),
+			RemoteGroupID: access.SecurityGroupIDSelf,
+			Description:   ipv6SelfRuleDescription,
+		})
+	}
+	if !infrastructure.IsEgressRestricted(c.config) {
+		desiredRules = append(desiredRules, rules.SecGroupRule{
 			Direction:   string(rules.DirEgress),
 			EtherType:   string(rules.EtherType4),
 			Description: "IPv4: allow all outgoing traffic",
-		},
-		{
-			Direction:   string(rules.DirEgress),
-			EtherType:   string(rules.EtherType6),
-			Description: "IPv6: allow all outgoing traffic",
-		},
-		{
-			Direction:      string(rules.DirIngress),
-			EtherType:      string(rules.EtherType4),
-			Protocol:       string(rules.ProtocolTCP),
-			PortRangeMin:   30000,
-			PortRangeMax:   32767,
-			RemoteIPPrefix: "0.0.0.0/0",
-			Description:    "IPv4: allow all incoming tcp traffic with port range 30000-32767",
-		},
-		{
-			Direction:      string(rules.DirIngress),
-			EtherType:      string(rules.EtherType4),
-			Protocol:       string(rules.ProtocolUDP),
-			PortRangeMin:   30000,
-			PortRangeMax:   32767,
-			RemoteIPPrefix: "0.0.0.0/0",
-			Description:    "IPv4: allow all incoming udp traffic with port range 30000-32767",
-		},
+		})
+		// All outgoing IPv6 traffic has always been allowed, hence the rule is only dropped if IPv6 rules are disabled
+		// explicitly.
+		if !isIPv6SecGroupRulesDisabled(c.config) {
+			desiredRules = append(desiredRules, rules.SecGroupRule{
+				Direction:   string(rules.DirEgress),
+				EtherType:   string(rules.EtherType6),
+				Description: "IPv6: allow all outgoing traffic",
+			})
+		}
+	}
+	for _, rule := range infrastructure.EgressRules(c.config, c.cloudProfileConfig.DNSServers, c.podsCIDR, c.ipv6SecGroupRules) {
+		desiredRules = append(desiredRules, rules.SecGroupRule{
+			Direction:      string(rules.DirEgress),
+			EtherType:      rule.EtherType,
+			Protocol:       rule.Protocol,
+			PortRangeMin:   rule.Port,
+			PortRangeMax:   rule.Port,
+			RemoteIPPrefix: rule.DestinationCIDR,
+			Description:    rule.Description,
+		})
+	}
+	for _, sourceCIDR := range infrastructure.NodePortsSourceCIDRs(c.config, c.ipv6SecGroupRules) {
+		for _, protocol := range []rules.RuleProtocol{rules.ProtocolTCP, rules.ProtocolUDP} {
+			desiredRules = append(desiredRules, rules.SecGroupRule{
+				Direction:      string(rules.DirIngress),
+				EtherType:      infrastructure.EtherType(sourceCIDR),
+				Protocol:       string(protocol),
+				PortRangeMin:   infrastructure.NodePortRangeMin,
+				PortRangeMax:   infrastructure.NodePortRangeMax,
+				RemoteIPPrefix: sourceCIDR,
+				Description:    infrastructure.NodePortsRuleDescription(string(protocol), sourceCIDR),
+			})
+		}
 	}
 
-	if modified, err := c.access.UpdateSecurityGroupRules(group, desiredRules, func(rule *rules.SecGroupRule) bool {
-		// Do NOT delete unknown rules to keep permissive behaviour as with terraform.
-		// As we don't store the role ids in the state, this function needs to be adjusted
-		// if values in existing rules are changed to identify them for update by replacement.
+	return desiredRules
+}
+
+// isIPv6SecGroupRulesDisabled returns true if managing IPv6 rules in the security group of the nodes is disabled
+// explicitly by the given InfrastructureConfig.
+func isIPv6SecGroupRulesDisabled(config *openstackapi.InfrastructureConfig) bool {
+	return config.SecurityGroupRules != nil && config.SecurityGroupRules.IPv6 != nil && !*config.SecurityGroupRules.IPv6
+}
+
+// allowDeleteSecGroupRule returns true for the rules of the security group which are deleted if they are not desired.
+func allowDeleteSecGroupRule(rule *rules.SecGroupRule) bool {
+	// Do NOT delete unknown rules to keep permissive behaviour as with terraform.
+	// As we don't store the role ids in the state, this function needs to be adjusted
+	// if values in existing rules are changed to identify them for update by replacement.
+	// Rules for the NodePort range are deleted as they are no longer desired if node ports
+	// are disabled or their source CIDRs are changed. The same applies to the rules for
+	// outgoing traffic if its restriction is toggled or its CIDRs are changed, and to the
+	// IPv6 rule for the traffic within the security group if IPv6 rules are disabled.
+	return isNodePortsRule(rule) || isEgressRule(rule) || isIPv6SelfRule(rule)
+}
+
+// isNodePortsRule returns true for the rules allowing incoming traffic to the NodePort range, which are identified by
+// their description, so that rules of the same kind added by users are kept.
+func isNodePortsRule(rule *rules.SecGroupRule) bool {
+	return rule.Direction == string(rules.DirIngress) &&
+		(rule.Protocol == string(rules.ProtocolTCP) || rule.Protocol == string(rules.ProtocolUDP)) &&
+		rule.PortRangeMin == infrastructure.NodePortRangeMin &&
+		rule.PortRangeMax == infrastructure.NodePortRangeMax &&
+		rule.RemoteIPPrefix != "" &&
+		rule.RemoteGroupID == "" &&
+		rule.Description == infrastructure.NodePortsRuleDescription(rule.Protocol, rule.RemoteIPPrefix)
+}
+
+// isEgressRule returns true for rules allowing all outgoing traffic and for the rules allowing restricted outgoing
+// traffic, which are identified by their description.
+func isEgressRule(rule *rules.SecGroupRule) bool {
+	if rule.Direction != string(rules.DirEgress) || rule.RemoteGroupID != "" {
 		return false
-	}); err != nil {
+	}
+	if strings.HasPrefix(rule.Description, infrastructure.EgressRuleDescriptionPrefix) ||
+		strings.HasPrefix(rule.Description, infrastructure.EgressRuleDescriptionPrefixIPv6) {
+		return true
+	}
+	return rule.Protocol == "" && rule.PortRangeMin == 0 && rule.PortRangeMax == 0 && rule.RemoteIPPrefix == ""
+}
+
+// isIPv6SelfRule returns true for the rule allowing incoming IPv6 traffic within the security group, which is
+// identified by its description, as a rule of the same kind may have been added manually before it was managed.
+func isIPv6SelfRule(rule *rules.SecGroupRule) bool {
+	return rule.Direction == string(rules.DirIngress) &&
+		rule.EtherType == string(rules.EtherType6) &&
+		rule.RemoteGroupID != "" &&
+		rule.Description == ipv6SelfRuleDescription
+}
+
+func (c *FlowContext) ensureSSHKeyPair(ctx context.Context) error {
+	if c.config.KeyPairName != nil {
+		return c.ensureConfiguredSSHKeyPair(ctx)
+	}
+	return c.ensureNewSSHKeyPair(ctx)
+}
+
+func (c *FlowContext) ensureConfiguredSSHKeyPair(_ context.Context) error {
+	keyPair, err := c.compute.GetKeyPair(*c.config.KeyPairName)
+	if err != nil {
 		return err
-	} else if modified {
-		log.Info("updated rules")
 	}
+	if keyPair == nil {
+		return fmt.Errorf("key pair %s not found", *c.config.KeyPairName)
+	}
+	c.state.Set(NameKeyPair, keyPair.Name)
 	return nil
 }
 
-func (c *FlowContext) ensureSSHKeyPair(ctx context.Context) error {
+func (c *FlowContext) ensureNewSSHKeyPair(ctx context.Context) error {
 	log := c.LogFromContext(ctx)
 
 	keyPair, err := c.compute.GetKeyPair(c.namespace)
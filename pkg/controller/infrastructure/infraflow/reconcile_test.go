@@ -0,0 +1,232 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/rbacpolicies"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/groups"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/rules"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	openstackapi "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/controller/infrastructure/infraflow/access"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/controller/infrastructure/infraflow/shared"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/internal/infrastructure"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client/mocks"
+)
+
+var _ = Describe("Reconcile", func() {
+	const (
+		namespace = "shoot--foo--bar"
+		legacyID  = "legacy-sg"
+		currentID = "current-sg"
+	)
+
+	var (
+		ctx        context.Context
+		ctrl       *gomock.Controller
+		networking *mocks.MockNetworking
+		state      shared.Whiteboard
+		c          *FlowContext
+
+		legacyGroup  *groups.SecGroup
+		currentGroup *groups.SecGroup
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		ctrl = gomock.NewController(GinkgoT())
+		networking = mocks.NewMockNetworking(ctrl)
+		networkingAccess, err := access.NewNetworkingAccess(networking, log.Log.WithName("test"))
+		Expect(err).NotTo(HaveOccurred())
+
+		state = shared.NewWhiteboard()
+		c = &FlowContext{
+			BasicFlowContext: *shared.NewBasicFlowContext(log.Log.WithName("test"), state, nil),
+			state:            state,
+			namespace:        namespace,
+			config:           &openstackapi.InfrastructureConfig{},
+			networking:       networking,
+			access:           networkingAccess,
+		}
+
+		legacyGroup = &groups.SecGroup{
+			ID:   legacyID,
+			Name: "legacy",
+			Rules: []rules.SecGroupRule{
+				{ID: "r1", SecGroupID: legacyID, Direction: "ingress", EtherType: "IPv4", RemoteGroupID: legacyID},
+				{ID: "r2", SecGroupID: legacyID, Direction: "ingress", EtherType: "IPv4", Protocol: "tcp", PortRangeMin: 22, PortRangeMax: 22, RemoteIPPrefix: "1.2.3.4/32", Description: "bastion"},
+			},
+		}
+		currentGroup = &groups.SecGroup{
+			ID:   currentID,
+			Name: namespace,
+			Rules: []rules.SecGroupRule{
+				{ID: "r3", SecGroupID: currentID, Direction: "ingress", EtherType: "IPv4", RemoteGroupID: currentID},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#secGroupName", func() {
+		It("should name the security group like the namespace", func() {
+			Expect(c.secGroupName()).To(Equal(namespace))
+		})
+
+		It("should not look for a legacy security group as the name has not changed", func() {
+			Expect(c.recoverLegacySecGroup()).To(Succeed())
+			Expect(state.Get(IdentifierLegacySecGroup)).To(BeNil())
+		})
+	})
+
+	Describe("#migrateLegacySecGroup", func() {
+		BeforeEach(func() {
+			state.Set(IdentifierLegacySecGroup, legacyID)
+			state.Set(IdentifierSecGroup, currentID)
+		})
+
+		It("should do nothing if there is no legacy security group", func() {
+			state.Set(IdentifierLegacySecGroup, "")
+
+			Expect(c.migrateLegacySecGroup(ctx)).To(Succeed())
+		})
+
+		It("should copy all rules and attach the current security group to the ports of the legacy one", func() {
+			networking.EXPECT().GetSecurityGroup(legacyID).Return(legacyGroup, nil)
+			networking.EXPECT().GetSecurityGroup(currentID).Return(currentGroup, nil)
+			networking.EXPECT().CreateRule(rules.CreateOpts{
+				Direction:      "ingress",
+				EtherType:      "IPv4",
+				SecGroupID:     currentID,
+				Protocol:       "tcp",
+				PortRangeMin:   22,
+				PortRangeMax:   22,
+				RemoteIPPrefix: "1.2.3.4/32",
+				Description:    "bastion",
+			}).Return(&rules.SecGroupRule{}, nil)
+			networking.EXPECT().ListPorts(ports.ListOpts{SecurityGroups: []string{legacyID}}).Return([]ports.Port{
+				{ID: "port1", SecurityGroups: []string{legacyID}},
+				{ID: "port2", SecurityGroups: []string{legacyID, currentID}},
+			}, nil)
+			networking.EXPECT().UpdatePort("port1", ports.UpdateOpts{SecurityGroups: &[]string{legacyID, currentID}}).Return(&ports.Port{}, nil)
+
+			Expect(c.migrateLegacySecGroup(ctx)).To(Succeed())
+			Expect(state.Get(IdentifierLegacySecGroup)).To(HaveValue(Equal(legacyID)))
+		})
+
+		It("should detach and delete the legacy security group once all ports carry the current one", func() {
+			networking.EXPECT().GetSecurityGroup(legacyID).Return(legacyGroup, nil)
+			currentGroup.Rules = append(currentGroup.Rules, rules.SecGroupRule{ID: "r4", SecGroupID: currentID, Direction: "ingress", EtherType: "IPv4", Protocol: "tcp", PortRangeMin: 22, PortRangeMax: 22, RemoteIPPrefix: "1.2.3.4/32", Description: "bastion"})
+			networking.EXPECT().GetSecurityGroup(currentID).Return(currentGroup, nil)
+			networking.EXPECT().ListPorts(ports.ListOpts{SecurityGroups: []string{legacyID}}).Return([]ports.Port{
+				{ID: "port1", SecurityGroups: []string{legacyID, currentID}},
+			}, nil)
+			networking.EXPECT().UpdatePort("port1", ports.UpdateOpts{SecurityGroups: &[]string{currentID}}).Return(&ports.Port{}, nil)
+			networking.EXPECT().DeleteSecurityGroup(legacyID)
+
+			Expect(c.migrateLegacySecGroup(ctx)).To(Succeed())
+			Expect(state.Get(IdentifierLegacySecGroup)).To(BeNil())
+		})
+	})
+
+	Describe("#ensureNetworkRBACPolicies", func() {
+		const networkID = "network"
+
+		var listOpts rbacpolicies.ListOpts
+
+		BeforeEach(func() {
+			state.Set(IdentifierNetwork, networkID)
+			listOpts = rbacpolicies.ListOpts{ObjectType: "network", ObjectID: networkID, Action: rbacpolicies.ActionAccessShared}
+		})
+
+		It("should create and record the missing RBAC policies", func() {
+			c.config.Networks.ShareWithProjects = []string{"project"}
+			networking.EXPECT().ListRBACPolicies(listOpts).Return(nil, nil)
+			networking.EXPECT().CreateRBACPolicy(rbacpolicies.CreateOpts{
+				Action:       rbacpolicies.ActionAccessShared,
+				ObjectType:   "network",
+				ObjectID:     networkID,
+				TargetTenant: "project",
+			}).Return(&rbacpolicies.RBACPolicy{ID: "policy"}, nil)
+
+			Expect(c.ensureNetworkRBACPolicies(ctx)).To(Succeed())
+			Expect(state.GetChild(IdentifierNetworkRBACPolicies).AsMap()).To(Equal(map[string]string{"project": "policy"}))
+		})
+
+		It("should neither record nor delete pre-existing RBAC policies", func() {
+			c.config.Networks.ShareWithProjects = []string{"project"}
+			networking.EXPECT().ListRBACPolicies(listOpts).Return([]rbacpolicies.RBACPolicy{{ID: "pre-existing", TargetTenant: "project"}}, nil)
+
+			Expect(c.ensureNetworkRBACPolicies(ctx)).To(Succeed())
+			Expect(state.GetChild(IdentifierNetworkRBACPolicies).AsMap()).To(BeEmpty())
+
+			c.config.Networks.ShareWithProjects = nil
+
+			Expect(c.ensureNetworkRBACPolicies(ctx)).To(Succeed())
+		})
+
+		It("should keep the recorded RBAC policies", func() {
+			c.config.Networks.ShareWithProjects = []string{"project"}
+			state.GetChild(IdentifierNetworkRBACPolicies).Set("project", "policy")
+			networking.EXPECT().ListRBACPolicies(listOpts).Return([]rbacpolicies.RBACPolicy{{ID: "policy", TargetTenant: "project"}}, nil)
+
+			Expect(c.ensureNetworkRBACPolicies(ctx)).To(Succeed())
+			Expect(state.GetChild(IdentifierNetworkRBACPolicies).AsMap()).To(Equal(map[string]string{"project": "policy"}))
+		})
+
+		It("should forget a recorded RBAC policy which was replaced by another one", func() {
+			c.config.Networks.ShareWithProjects = []string{"project"}
+			state.GetChild(IdentifierNetworkRBACPolicies).Set("project", "policy")
+			networking.EXPECT().ListRBACPolicies(listOpts).Return([]rbacpolicies.RBACPolicy{{ID: "other", TargetTenant: "project"}}, nil)
+
+			Expect(c.ensureNetworkRBACPolicies(ctx)).To(Succeed())
+			Expect(state.GetChild(IdentifierNetworkRBACPolicies).AsMap()).To(BeEmpty())
+		})
+
+		It("should delete the recorded RBAC policies of removed projects", func() {
+			state.GetChild(IdentifierNetworkRBACPolicies).Set("project", "policy")
+			networking.EXPECT().ListRBACPolicies(listOpts).Return([]rbacpolicies.RBACPolicy{{ID: "policy", TargetTenant: "project"}}, nil)
+			networking.EXPECT().DeleteRBACPolicy("policy")
+
+			Expect(c.ensureNetworkRBACPolicies(ctx)).To(Succeed())
+			Expect(state.GetChild(IdentifierNetworkRBACPolicies).AsMap()).To(BeEmpty())
+		})
+	})
+})
+
+var _ = Describe("#allowDeleteSecGroupRule", func() {
+	nodePortsRule := func(protocol, sourceCIDR, description string) *rules.SecGroupRule {
+		return &rules.SecGroupRule{
+			Direction:      "ingress",
+			EtherType:      infrastructure.EtherType(sourceCIDR),
+			Protocol:       protocol,
+			PortRangeMin:   infrastructure.NodePortRangeMin,
+			PortRangeMax:   infrastructure.NodePortRangeMax,
+			RemoteIPPrefix: sourceCIDR,
+			Description:    description,
+		}
+	}
+
+	It("should allow deleting the managed rules of the NodePort range", func() {
+		Expect(allowDeleteSecGroupRule(nodePortsRule("tcp", "0.0.0.0/0", "IPv4: allow all incoming tcp traffic with port range 30000-32767"))).To(BeTrue())
+		Expect(allowDeleteSecGroupRule(nodePortsRule("udp", "10.0.0.0/8", infrastructure.NodePortsRuleDescription("udp", "10.0.0.0/8")))).To(BeTrue())
+		Expect(allowDeleteSecGroupRule(nodePortsRule("tcp", "2001:db8::/32", infrastructure.NodePortsRuleDescription("tcp", "2001:db8::/32")))).To(BeTrue())
+	})
+
+	It("should keep rules of the NodePort range added by users", func() {
+		Expect(allowDeleteSecGroupRule(nodePortsRule("tcp", "10.0.0.0/8", ""))).To(BeFalse())
+		Expect(allowDeleteSecGroupRule(nodePortsRule("tcp", "10.0.0.0/8", "my rule"))).To(BeFalse())
+		Expect(allowDeleteSecGroupRule(nodePortsRule("udp", "10.0.0.0/8", infrastructure.NodePortsRuleDescription("udp", "192.168.0.0/16")))).To(BeFalse())
+	})
+})
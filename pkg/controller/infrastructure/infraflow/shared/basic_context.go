@@ -21,6 +21,8 @@ type TaskOption struct {
 	Dependencies []flow.TaskIDer
 	Timeout      time.Duration
 	DoIf         *bool
+	Retries      int
+	RetryAfter   time.Duration
 }
 
 // Dependencies creates a TaskOption for dependencies
@@ -38,6 +40,12 @@ func DoIf(condition bool) TaskOption {
 	return TaskOption{DoIf: pointer.Bool(condition)}
 }
 
+// Retries creates a TaskOption retrying a failed task the given number of times after the given interval. The retries
+// are bounded by the timeout of the task.
+func Retries(retries int, retryAfter time.Duration) TaskOption {
+	return TaskOption{Retries: retries, RetryAfter: retryAfter}
+}
+
 // FlowStatePersistor persists the flat map to the provider status
 type FlowStatePersistor func(ctx context.Context, flatMap FlatMap) error
 
@@ -123,9 +131,16 @@ func (c *BasicFlowContext) AddTask(g *flow.Graph, name string, fn flow.TaskFn, o
 			condition = condition && *opt.DoIf
 			allOptions.DoIf = pointer.Bool(condition)
 		}
+		if opt.Retries > 0 {
+			allOptions.Retries = opt.Retries
+			allOptions.RetryAfter = opt.RetryAfter
+		}
 	}
 
 	tunedFn := fn
+	if allOptions.Retries > 0 {
+		tunedFn = retryTaskFn(tunedFn, allOptions.Retries, allOptions.RetryAfter)
+	}
 	if allOptions.Timeout > 0 {
 		tunedFn = tunedFn.Timeout(allOptions.Timeout)
 	}
@@ -142,6 +157,22 @@ func (c *BasicFlowContext) AddTask(g *flow.Graph, name string, fn flow.TaskFn, o
 	return g.Add(task)
 }
 
+func retryTaskFn(fn flow.TaskFn, retries int, retryAfter time.Duration) flow.TaskFn {
+	return func(ctx context.Context) error {
+		err := fn(ctx)
+		for attempt := 1; err != nil && attempt <= retries; attempt++ {
+			logf.FromContext(ctx).Info("Task failed, retrying", "attempt", attempt, "error", err.Error())
+			select {
+			case <-ctx.Done():
+				return err
+			case <-time.After(retryAfter):
+			}
+			err = fn(ctx)
+		}
+		return err
+	}
+}
+
 func (c *BasicFlowContext) wrapTaskFn(flowName, taskName string, fn flow.TaskFn) flow.TaskFn {
 	return func(ctx context.Context) error {
 		taskCtx := logf.IntoContext(ctx, c.Log.WithValues("flow", flowName, "task", taskName))
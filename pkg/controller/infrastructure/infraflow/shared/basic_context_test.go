@@ -152,4 +152,30 @@ var _ = Describe("BasicFlowContext", func() {
 			Expect(err).To(BeNil())
 		})
 	})
+
+	It("should retry failed tasks", func() {
+		var (
+			log      = zap.New(zap.WriteTo(GinkgoWriter))
+			c        = newTestFlowContext(log, shared.NewWhiteboard(), nil)
+			attempts = map[string]int{}
+			failing  = func(name string, failures int) flow.TaskFn {
+				return func(_ context.Context) error {
+					attempts[name]++
+					if attempts[name] <= failures {
+						return fmt.Errorf("attempt %d failed", attempts[name])
+					}
+					return nil
+				}
+			}
+		)
+
+		g := flow.NewGraph("test")
+		task1 := c.AddTask(g, "task1", failing("task1", 2), shared.Retries(2, time.Millisecond))
+		_ = c.AddTask(g, "task2", failing("task2", 3), shared.Retries(2, time.Millisecond), shared.Dependencies(task1))
+
+		err := g.Compile().Run(context.Background(), flow.Opts{Log: log})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(`task "task2" failed: failed to task2: attempt 3 failed`))
+		Expect(attempts).To(Equal(map[string]int{"task1": 3, "task2": 3}))
+	})
 })
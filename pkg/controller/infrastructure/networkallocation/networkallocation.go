@@ -0,0 +1,186 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package networkallocation allocates the CIDRs of the subnets of shoots attached to a shared network. The allocations
+// are recorded in the cluster-scoped NetworkAllocation named after the ID of the shared network, so that the subnets of
+// the shoots of a seed never overlap.
+package networkallocation
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	openstackv1alpha1 "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/v1alpha1"
+)
+
+// DefaultPrefixLength is the prefix length of the CIDRs allocated in a shared network if none is configured.
+const DefaultPrefixLength = 24
+
+// Allocate returns the CIDR allocated to the shoot in the given namespace in the given shared network. If no CIDR has
+// been allocated to the shoot yet, the requested CIDR is allocated if given, e.g. the nodes CIDR of a shoot whose
+// control plane was migrated from another seed. Otherwise, the first free CIDR with the configured prefix length in the
+// range of the shared network is allocated.
+func Allocate(ctx context.Context, c client.Client, namespace string, config *api.SharedNetwork, requested *string) (string, error) {
+	var cidr string
+	err := retry.OnError(retry.DefaultRetry, isConcurrentModification, func() error {
+		allocation := &openstackv1alpha1.NetworkAllocation{ObjectMeta: metav1.ObjectMeta{Name: config.ID}}
+		if err := c.Get(ctx, client.ObjectKeyFromObject(allocation), allocation); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+
+		if cidr = find(allocation, namespace); cidr != "" {
+			return nil
+		}
+
+		var err error
+		if requested != nil {
+			cidr, err = allocateRequested(allocation, *requested)
+		} else {
+			cidr, err = allocateFree(allocation, config)
+		}
+		if err != nil {
+			return err
+		}
+
+		allocation.Spec.Allocations = append(allocation.Spec.Allocations, openstackv1alpha1.CIDRAllocation{
+			Namespace: namespace,
+			CIDR:      cidr,
+		})
+		if allocation.ResourceVersion == "" {
+			return c.Create(ctx, allocation)
+		}
+		return c.Update(ctx, allocation)
+	})
+	if err != nil {
+		return "", fmt.Errorf("could not allocate a CIDR in the shared network %s: %w", config.ID, err)
+	}
+	return cidr, nil
+}
+
+// Get returns the CIDR allocated to the shoot in the given namespace in the shared network with the given ID, or an
+// empty string if no CIDR has been allocated to the shoot.
+func Get(ctx context.Context, c client.Client, namespace, networkID string) (string, error) {
+	allocation := &openstackv1alpha1.NetworkAllocation{}
+	if err := c.Get(ctx, client.ObjectKey{Name: networkID}, allocation); err != nil {
+		return "", client.IgnoreNotFound(err)
+	}
+	return find(allocation, namespace), nil
+}
+
+// Release releases the CIDR allocated to the shoot in the given namespace in the shared network with the given ID.
+func Release(ctx context.Context, c client.Client, namespace, networkID string) error {
+	return retry.OnError(retry.DefaultRetry, isConcurrentModification, func() error {
+		allocation := &openstackv1alpha1.NetworkAllocation{}
+		if err := c.Get(ctx, client.ObjectKey{Name: networkID}, allocation); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+
+		var allocations []openstackv1alpha1.CIDRAllocation
+		for _, a := range allocation.Spec.Allocations {
+			if a.Namespace != namespace {
+				allocations = append(allocations, a)
+			}
+		}
+		if len(allocations) == len(allocation.Spec.Allocations) {
+			return nil
+		}
+
+		allocation.Spec.Allocations = allocations
+		return c.Update(ctx, allocation)
+	})
+}
+
+func find(allocation *openstackv1alpha1.NetworkAllocation, namespace string) string {
+	for _, a := range allocation.Spec.Allocations {
+		if a.Namespace == namespace {
+			return a.CIDR
+		}
+	}
+	return ""
+}
+
+func allocateRequested(allocation *openstackv1alpha1.NetworkAllocation, requested string) (string, error) {
+	_, cidr, err := net.ParseCIDR(requested)
+	if err != nil {
+		return "", err
+	}
+	for _, a := range allocation.Spec.Allocations {
+		_, allocated, err := net.ParseCIDR(a.CIDR)
+		if err != nil {
+			return "", err
+		}
+		if overlaps(cidr, allocated) {
+			return "", fmt.Errorf("CIDR %s overlaps with the CIDR %s allocated to %s", requested, a.CIDR, a.Namespace)
+		}
+	}
+	return cidr.String(), nil
+}
+
+func allocateFree(allocation *openstackv1alpha1.NetworkAllocation, config *api.SharedNetwork) (string, error) {
+	_, allocationRange, err := net.ParseCIDR(config.CIDR)
+	if err != nil {
+		return "", err
+	}
+	if allocationRange.IP.To4() == nil {
+		return "", fmt.Errorf("CIDR %s is no IPv4 CIDR", config.CIDR)
+	}
+	rangeLength, _ := allocationRange.Mask.Size()
+
+	prefixLength := DefaultPrefixLength
+	if config.PrefixLength != nil {
+		prefixLength = int(*config.PrefixLength)
+	}
+	if prefixLength < rangeLength || prefixLength > 32 {
+		return "", fmt.Errorf("prefix length %d does not fit into CIDR %s", prefixLength, config.CIDR)
+	}
+
+	var allocated []*net.IPNet
+	for _, a := range allocation.Spec.Allocations {
+		_, cidr, err := net.ParseCIDR(a.CIDR)
+		if err != nil {
+			return "", err
+		}
+		allocated = append(allocated, cidr)
+	}
+
+	var (
+		start = binary.BigEndian.Uint32(allocationRange.IP.To4())
+		size  = uint64(1) << (32 - prefixLength)
+		count = uint64(1) << (prefixLength - rangeLength)
+		mask  = net.CIDRMask(prefixLength, 32)
+	)
+	for i := uint64(0); i < count; i++ {
+		ip := make(net.IP, net.IPv4len)
+		binary.BigEndian.PutUint32(ip, start+uint32(i*size))
+		candidate := &net.IPNet{IP: ip, Mask: mask}
+
+		free := true
+		for _, cidr := range allocated {
+			if overlaps(candidate, cidr) {
+				free = false
+				break
+			}
+		}
+		if free {
+			return candidate.String(), nil
+		}
+	}
+	return "", fmt.Errorf("no free CIDR with prefix length %d left in %s", prefixLength, config.CIDR)
+}
+
+func overlaps(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
+func isConcurrentModification(err error) bool {
+	return apierrors.IsConflict(err) || apierrors.IsAlreadyExists(err) || apierrors.IsNotFound(err)
+}
@@ -0,0 +1,156 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package networkallocation_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	openstackv1alpha1 "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/v1alpha1"
+	. "github.com/gardener/gardener-extension-provider-openstack/pkg/controller/infrastructure/networkallocation"
+)
+
+var _ = Describe("NetworkAllocation", func() {
+	const (
+		namespace = "shoot--foo--bar"
+		networkID = "7b1a5a6c-2d0d-4a8f-9f4b-2bf0c2b9e2a1"
+	)
+
+	var (
+		ctx    context.Context
+		c      client.Client
+		config *api.SharedNetwork
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		scheme := runtime.NewScheme()
+		Expect(openstackv1alpha1.AddToScheme(scheme)).To(Succeed())
+		c = fakeclient.NewClientBuilder().WithScheme(scheme).Build()
+
+		config = &api.SharedNetwork{
+			ID:   networkID,
+			CIDR: "10.0.0.0/16",
+		}
+	})
+
+	createAllocations := func(allocations ...openstackv1alpha1.CIDRAllocation) {
+		Expect(c.Create(ctx, &openstackv1alpha1.NetworkAllocation{
+			ObjectMeta: metav1.ObjectMeta{Name: networkID},
+			Spec:       openstackv1alpha1.NetworkAllocationSpec{Allocations: allocations},
+		})).To(Succeed())
+	}
+
+	expectAllocations := func(allocations ...openstackv1alpha1.CIDRAllocation) {
+		allocation := &openstackv1alpha1.NetworkAllocation{}
+		Expect(c.Get(ctx, client.ObjectKey{Name: networkID}, allocation)).To(Succeed())
+		Expect(allocation.Spec.Allocations).To(Equal(allocations))
+	}
+
+	Describe("#Allocate", func() {
+		It("should allocate the first CIDR of the range in a new network", func() {
+			cidr, err := Allocate(ctx, c, namespace, config, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cidr).To(Equal("10.0.0.0/24"))
+
+			expectAllocations(openstackv1alpha1.CIDRAllocation{Namespace: namespace, CIDR: "10.0.0.0/24"})
+		})
+
+		It("should allocate the first free CIDR with the configured prefix length", func() {
+			config.PrefixLength = pointer.Int32(25)
+			createAllocations(
+				openstackv1alpha1.CIDRAllocation{Namespace: "shoot--foo--a", CIDR: "10.0.0.0/25"},
+				openstackv1alpha1.CIDRAllocation{Namespace: "shoot--foo--b", CIDR: "10.0.1.0/24"},
+			)
+
+			cidr, err := Allocate(ctx, c, namespace, config, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cidr).To(Equal("10.0.0.128/25"))
+
+			cidr, err = Allocate(ctx, c, "shoot--foo--c", config, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cidr).To(Equal("10.0.2.0/25"))
+		})
+
+		It("should keep the CIDR allocated before", func() {
+			createAllocations(openstackv1alpha1.CIDRAllocation{Namespace: namespace, CIDR: "10.0.5.0/24"})
+
+			cidr, err := Allocate(ctx, c, namespace, config, pointer.String("10.0.6.0/24"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cidr).To(Equal("10.0.5.0/24"))
+
+			expectAllocations(openstackv1alpha1.CIDRAllocation{Namespace: namespace, CIDR: "10.0.5.0/24"})
+		})
+
+		It("should allocate the requested CIDR", func() {
+			createAllocations(openstackv1alpha1.CIDRAllocation{Namespace: "shoot--foo--a", CIDR: "10.0.0.0/24"})
+
+			cidr, err := Allocate(ctx, c, namespace, config, pointer.String("10.0.6.0/23"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cidr).To(Equal("10.0.6.0/23"))
+
+			expectAllocations(
+				openstackv1alpha1.CIDRAllocation{Namespace: "shoot--foo--a", CIDR: "10.0.0.0/24"},
+				openstackv1alpha1.CIDRAllocation{Namespace: namespace, CIDR: "10.0.6.0/23"},
+			)
+		})
+
+		It("should fail if the requested CIDR overlaps with an allocated one", func() {
+			createAllocations(openstackv1alpha1.CIDRAllocation{Namespace: "shoot--foo--a", CIDR: "10.0.6.0/24"})
+
+			_, err := Allocate(ctx, c, namespace, config, pointer.String("10.0.6.0/23"))
+			Expect(err).To(MatchError(ContainSubstring("overlaps with the CIDR 10.0.6.0/24 allocated to shoot--foo--a")))
+		})
+
+		It("should fail if the range is exhausted", func() {
+			config.CIDR = "10.0.0.0/23"
+			createAllocations(
+				openstackv1alpha1.CIDRAllocation{Namespace: "shoot--foo--a", CIDR: "10.0.0.0/24"},
+				openstackv1alpha1.CIDRAllocation{Namespace: "shoot--foo--b", CIDR: "10.0.1.128/25"},
+			)
+
+			_, err := Allocate(ctx, c, namespace, config, nil)
+			Expect(err).To(MatchError(ContainSubstring("no free CIDR with prefix length 24 left in 10.0.0.0/23")))
+		})
+	})
+
+	Describe("#Get", func() {
+		It("should return the CIDR allocated to the shoot", func() {
+			createAllocations(openstackv1alpha1.CIDRAllocation{Namespace: namespace, CIDR: "10.0.5.0/24"})
+
+			Expect(Get(ctx, c, namespace, networkID)).To(Equal("10.0.5.0/24"))
+			Expect(Get(ctx, c, "shoot--foo--a", networkID)).To(BeEmpty())
+		})
+
+		It("should return nothing if no CIDR was allocated in the network", func() {
+			Expect(Get(ctx, c, namespace, networkID)).To(BeEmpty())
+		})
+	})
+
+	Describe("#Release", func() {
+		It("should release the CIDR allocated to the shoot", func() {
+			createAllocations(
+				openstackv1alpha1.CIDRAllocation{Namespace: "shoot--foo--a", CIDR: "10.0.0.0/24"},
+				openstackv1alpha1.CIDRAllocation{Namespace: namespace, CIDR: "10.0.1.0/24"},
+			)
+
+			Expect(Release(ctx, c, namespace, networkID)).To(Succeed())
+
+			expectAllocations(openstackv1alpha1.CIDRAllocation{Namespace: "shoot--foo--a", CIDR: "10.0.0.0/24"})
+		})
+
+		It("should succeed if no CIDR was allocated in the network", func() {
+			Expect(Release(ctx, c, namespace, networkID)).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infrastructure
+
+import (
+	"context"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/controller/infrastructure/networkallocation"
+)
+
+// resolveSharedNetwork configures the given InfrastructureConfig of a shoot attached to a shared network to create the
+// subnet of the worker nodes in the shared network with the CIDR allocated to the shoot, and returns that CIDR. No CIDR
+// is allocated to shoots in deletion, for them the CIDR allocated before or the nodes CIDR of the shoot is used.
+func (a *actuator) resolveSharedNetwork(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure,
+	cluster *extensionscontroller.Cluster, config *api.InfrastructureConfig) (*string, error) {
+	shared := config.Networks.Shared
+	if shared == nil {
+		return nil, nil
+	}
+
+	var nodesCIDR *string
+	if cluster.Shoot != nil && cluster.Shoot.Spec.Networking != nil {
+		nodesCIDR = cluster.Shoot.Spec.Networking.Nodes
+	}
+
+	var (
+		cidr string
+		err  error
+	)
+	if infra.DeletionTimestamp == nil {
+		cidr, err = networkallocation.Allocate(ctx, a.client, infra.Namespace, shared, nodesCIDR)
+		if err != nil {
+			return nil, err
+		}
+		log.Info("Using CIDR allocated in shared network", "networkID", shared.ID, "cidr", cidr)
+	} else {
+		cidr, err = networkallocation.Get(ctx, a.client, infra.Namespace, shared.ID)
+		if err != nil {
+			return nil, err
+		}
+		if cidr == "" && nodesCIDR != nil {
+			cidr = *nodesCIDR
+		}
+	}
+
+	config.Networks.ID = &shared.ID
+	config.Networks.Workers = cidr
+	return &cidr, nil
+}
+
+// releaseSharedNetwork releases the CIDR allocated to the shoot in its shared network.
+func (a *actuator) releaseSharedNetwork(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure, config *api.InfrastructureConfig) error {
+	if config.Networks.Shared == nil {
+		return nil
+	}
+
+	log.Info("Releasing CIDR in shared network", "networkID", config.Networks.Shared.ID)
+	return networkallocation.Release(ctx, a.client, infra.Namespace, config.Networks.Shared.ID)
+}
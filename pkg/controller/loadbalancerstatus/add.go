@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancerstatus
+
+import (
+	"context"
+	"time"
+
+	extensionspredicate "github.com/gardener/gardener/extensions/pkg/predicate"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+	openstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
+)
+
+// ControllerName is the name of the load balancer status controller.
+const ControllerName = "loadbalancer-status"
+
+var (
+	// DefaultAddOptions are the default AddOptions for AddToManager.
+	DefaultAddOptions = AddOptions{
+		SyncPeriod: 5 * time.Minute,
+	}
+)
+
+// AddOptions are options to apply when adding the Openstack load balancer status controller to the manager.
+type AddOptions struct {
+	// Controller are the controller.Options.
+	Controller controller.Options
+	// SyncPeriod is the period in which the load balancers of a shoot are checked.
+	SyncPeriod time.Duration
+}
+
+// AddToManagerWithOptions adds a controller with the given Options to the given manager.
+func AddToManagerWithOptions(_ context.Context, mgr manager.Manager, opts AddOptions) error {
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&extensionsv1alpha1.ControlPlane{}, builder.WithPredicates(
+			extensionspredicate.HasType(openstack.Type),
+			extensionspredicate.HasPurpose(extensionsv1alpha1.Normal),
+			predicate.GenerationChangedPredicate{},
+		)).
+		WithOptions(opts.Controller).
+		Complete(NewReconciler(
+			mgr.GetClient(),
+			openstackclient.FactoryFactoryFunc(openstackclient.NewOpenstackClientFromCredentials),
+			NewShootClient,
+			opts.SyncPeriod,
+		))
+}
+
+// AddToManager adds a controller with the default Options.
+func AddToManager(ctx context.Context, mgr manager.Manager) error {
+	return AddToManagerWithOptions(ctx, mgr, DefaultAddOptions)
+}
@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancerstatus
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/loadbalancers"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// EventReasonLoadBalancerProvisioningFailed is the reason of the events recorded on a Service whose Octavia load
+	// balancer failed to be provisioned.
+	EventReasonLoadBalancerProvisioningFailed = "LoadBalancerProvisioningFailed"
+
+	eventSourceComponent = "openstack-loadbalancer-status"
+
+	// serviceLoadBalancerPrefix is the prefix of the names of the load balancers the cloud-controller-manager creates
+	// for Services. The names have the format kube_service_<cluster-name>_<namespace>_<name>.
+	serviceLoadBalancerPrefix = "kube_service_"
+
+	provisioningStatusError = "ERROR"
+)
+
+// FailedServiceLoadBalancer is an Octavia load balancer of a Service that failed to be provisioned.
+type FailedServiceLoadBalancer struct {
+	// Service is the key of the Service.
+	Service types.NamespacedName
+	// LoadBalancer is the Octavia load balancer.
+	LoadBalancer loadbalancers.LoadBalancer
+}
+
+// FailedServiceLoadBalancers returns the load balancers of the Services of the cluster with the given name whose
+// provisioning status is ERROR.
+func FailedServiceLoadBalancers(clusterName string, lbs []loadbalancers.LoadBalancer) []FailedServiceLoadBalancer {
+	var failed []FailedServiceLoadBalancer
+	for _, lb := range lbs {
+		if lb.ProvisioningStatus != provisioningStatusError {
+			continue
+		}
+		if service, ok := ServiceForLoadBalancer(clusterName, lb.Name); ok {
+			failed = append(failed, FailedServiceLoadBalancer{Service: service, LoadBalancer: lb})
+		}
+	}
+	return failed
+}
+
+// ServiceForLoadBalancer returns the key of the Service the load balancer with the given name was created for by the
+// cloud-controller-manager of the cluster with the given name.
+func ServiceForLoadBalancer(clusterName, lbName string) (types.NamespacedName, bool) {
+	name, ok := strings.CutPrefix(lbName, serviceLoadBalancerPrefix+clusterName+"_")
+	if !ok {
+		return types.NamespacedName{}, false
+	}
+	// Namespaces and names of Services cannot contain underscores.
+	parts := strings.Split(name, "_")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return types.NamespacedName{}, false
+	}
+	return types.NamespacedName{Namespace: parts[0], Name: parts[1]}, true
+}
+
+// NewEvent returns a warning event for the given Service explaining that its load balancer failed to be provisioned.
+func NewEvent(service *corev1.Service, lb loadbalancers.LoadBalancer, now time.Time) *corev1.Event {
+	timestamp := metav1.NewTime(now)
+	return &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: service.Name + ".",
+			Namespace:    service.Namespace,
+		},
+		InvolvedObject: corev1.ObjectReference{
+			APIVersion:      "v1",
+			Kind:            "Service",
+			Namespace:       service.Namespace,
+			Name:            service.Name,
+			UID:             service.UID,
+			ResourceVersion: service.ResourceVersion,
+		},
+		Reason: EventReasonLoadBalancerProvisioningFailed,
+		Message: fmt.Sprintf("OpenStack load balancer %s (%s) is in provisioning status %s and operating status %s, "+
+			"the load balancer provider %q failed to provision it. Delete and recreate the Service or contact your "+
+			"OpenStack operator if the problem persists, e.g. because of an exhausted quota or an unavailable flavor.",
+			lb.Name, lb.ID, lb.ProvisioningStatus, lb.OperatingStatus, lb.Provider),
+		Type:           corev1.EventTypeWarning,
+		Source:         corev1.EventSource{Component: eventSourceComponent},
+		FirstTimestamp: timestamp,
+		LastTimestamp:  timestamp,
+		Count:          1,
+	}
+}
@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancerstatus_test
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/loadbalancers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	. "github.com/gardener/gardener-extension-provider-openstack/pkg/controller/loadbalancerstatus"
+)
+
+var _ = Describe("LoadBalancers", func() {
+	const clusterName = "shoot--foo--bar"
+
+	DescribeTable("#ServiceForLoadBalancer",
+		func(lbName string, expected types.NamespacedName, expectedOK bool) {
+			service, ok := ServiceForLoadBalancer(clusterName, lbName)
+			Expect(ok).To(Equal(expectedOK))
+			Expect(service).To(Equal(expected))
+		},
+		Entry("load balancer of a service", "kube_service_shoot--foo--bar_default_nginx", types.NamespacedName{Namespace: "default", Name: "nginx"}, true),
+		Entry("load balancer of another cluster", "kube_service_shoot--foo--baz_default_nginx", types.NamespacedName{}, false),
+		Entry("load balancer not created for a service", "my-load-balancer", types.NamespacedName{}, false),
+		Entry("load balancer with a malformed name", "kube_service_shoot--foo--bar_nginx", types.NamespacedName{}, false),
+	)
+
+	Describe("#FailedServiceLoadBalancers", func() {
+		It("should only return the failed load balancers of services of the cluster", func() {
+			lbs := []loadbalancers.LoadBalancer{
+				{ID: "1", Name: "kube_service_shoot--foo--bar_default_nginx", ProvisioningStatus: "ERROR"},
+				{ID: "2", Name: "kube_service_shoot--foo--bar_default_active", ProvisioningStatus: "ACTIVE"},
+				{ID: "3", Name: "kube_service_shoot--foo--baz_default_nginx", ProvisioningStatus: "ERROR"},
+				{ID: "4", Name: "other", ProvisioningStatus: "ERROR"},
+			}
+
+			Expect(FailedServiceLoadBalancers(clusterName, lbs)).To(ConsistOf(
+				FailedServiceLoadBalancer{Service: types.NamespacedName{Namespace: "default", Name: "nginx"}, LoadBalancer: lbs[0]},
+			))
+		})
+	})
+
+	Describe("#NewEvent", func() {
+		It("should return a warning event for the service", func() {
+			now := time.Now()
+			service := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "nginx", UID: "uid"}}
+			lb := loadbalancers.LoadBalancer{ID: "1", Name: "kube_service_shoot--foo--bar_default_nginx", ProvisioningStatus: "ERROR", OperatingStatus: "OFFLINE", Provider: "amphora"}
+
+			event := NewEvent(service, lb, now)
+			Expect(event.Namespace).To(Equal("default"))
+			Expect(event.GenerateName).To(Equal("nginx."))
+			Expect(event.InvolvedObject).To(Equal(corev1.ObjectReference{APIVersion: "v1", Kind: "Service", Namespace: "default", Name: "nginx", UID: "uid"}))
+			Expect(event.Type).To(Equal(corev1.EventTypeWarning))
+			Expect(event.Reason).To(Equal(EventReasonLoadBalancerProvisioningFailed))
+			Expect(event.Message).To(ContainSubstring(`OpenStack load balancer kube_service_shoot--foo--bar_default_nginx (1) is in provisioning status ERROR and operating status OFFLINE, the load balancer provider "amphora" failed to provision it`))
+			Expect(event.LastTimestamp).To(Equal(metav1.NewTime(now)))
+		})
+	})
+})
@@ -0,0 +1,184 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancerstatus
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	extensionsconfig "github.com/gardener/gardener/extensions/pkg/apis/config"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	"github.com/gardener/gardener/extensions/pkg/util"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/loadbalancers"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/helper"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+	openstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
+)
+
+// ShootClientFunc returns a client for the shoot whose control plane runs in the given namespace of the seed.
+type ShootClientFunc func(ctx context.Context, seedClient client.Client, namespace string) (client.Client, error)
+
+// NewShootClient returns a client for the shoot whose control plane runs in the given namespace of the seed.
+func NewShootClient(ctx context.Context, seedClient client.Client, namespace string) (client.Client, error) {
+	_, shootClient, err := util.NewClientForShoot(ctx, seedClient, namespace, client.Options{Scheme: kubernetes.ShootScheme}, extensionsconfig.RESTOptions{})
+	return shootClient, err
+}
+
+type reconciler struct {
+	client               client.Client
+	clientFactoryFactory openstackclient.FactoryFactory
+	newShootClient       ShootClientFunc
+	syncPeriod           time.Duration
+
+	lock sync.Mutex
+	// reported contains the ids of the failed load balancers per namespace for which events were already recorded.
+	reported map[string]sets.Set[string]
+}
+
+// NewReconciler creates a new reconciler which periodically checks the Octavia load balancers of the Services of the
+// shoots and records events on the Services in the shoot if their load balancers failed to be provisioned.
+func NewReconciler(c client.Client, clientFactoryFactory openstackclient.FactoryFactory, newShootClient ShootClientFunc, syncPeriod time.Duration) reconcile.Reconciler {
+	return &reconciler{
+		client:               c,
+		clientFactoryFactory: clientFactoryFactory,
+		newShootClient:       newShootClient,
+		syncPeriod:           syncPeriod,
+		reported:             make(map[string]sets.Set[string]),
+	}
+}
+
+func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	cp := &extensionsv1alpha1.ControlPlane{}
+	if err := r.client.Get(ctx, request.NamespacedName, cp); err != nil {
+		if apierrors.IsNotFound(err) {
+			r.forget(request.Namespace)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if cp.DeletionTimestamp != nil {
+		r.forget(cp.Namespace)
+		return reconcile.Result{}, nil
+	}
+
+	cluster, err := extensionscontroller.GetCluster(ctx, r.client, cp.Namespace)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not get cluster: %w", err)
+	}
+	// The Services of hibernated shoots cannot be reached.
+	if extensionscontroller.IsHibernated(cluster) {
+		return reconcile.Result{RequeueAfter: r.syncPeriod}, nil
+	}
+
+	lbs, err := r.listLoadBalancers(ctx, cp, cluster)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	failed := FailedServiceLoadBalancers(cp.Namespace, lbs)
+	unreported := r.unreported(cp.Namespace, failed)
+	if len(unreported) == 0 {
+		return reconcile.Result{RequeueAfter: r.syncPeriod}, nil
+	}
+
+	shootClient, err := r.newShootClient(ctx, r.client, cp.Namespace)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not create shoot client: %w", err)
+	}
+
+	for _, lb := range unreported {
+		service := &corev1.Service{}
+		if err := shootClient.Get(ctx, lb.Service, service); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return reconcile.Result{}, fmt.Errorf("could not get service %s: %w", lb.Service, err)
+		}
+
+		log.Info("Recording event for service with failed load balancer", "service", lb.Service, "loadBalancerID", lb.LoadBalancer.ID)
+		if err := shootClient.Create(ctx, NewEvent(service, lb.LoadBalancer, time.Now())); err != nil {
+			return reconcile.Result{}, fmt.Errorf("could not record event for service %s: %w", lb.Service, err)
+		}
+		r.markReported(cp.Namespace, lb.LoadBalancer.ID)
+	}
+
+	return reconcile.Result{RequeueAfter: r.syncPeriod}, nil
+}
+
+func (r *reconciler) listLoadBalancers(ctx context.Context, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) ([]loadbalancers.LoadBalancer, error) {
+	credentials, err := openstack.GetShootReadOnlyCredentials(ctx, r.client, cp.Spec.SecretRef)
+	if err != nil {
+		return nil, fmt.Errorf("could not get OpenStack credentials: %w", err)
+	}
+	if len(strings.TrimSpace(credentials.AuthURL)) == 0 {
+		cloudProfileConfig, err := helper.CloudProfileConfigFromCluster(cluster)
+		if err != nil {
+			return nil, err
+		}
+		keyStoneURL, err := helper.FindKeyStoneURL(cloudProfileConfig.KeyStoneURLs, cloudProfileConfig.KeyStoneURL, cp.Spec.Region)
+		if err != nil {
+			return nil, err
+		}
+		credentials.AuthURL = keyStoneURL
+	}
+
+	factory, err := r.clientFactoryFactory.NewFactory(credentials)
+	if err != nil {
+		return nil, fmt.Errorf("could not create OpenStack client factory: %w", err)
+	}
+	loadbalancing, err := factory.Loadbalancing(openstackclient.WithRegion(cp.Spec.Region))
+	if err != nil {
+		return nil, fmt.Errorf("could not create OpenStack load balancing client: %w", err)
+	}
+	return loadbalancing.ListLoadbalancers(loadbalancers.ListOpts{ProvisioningStatus: provisioningStatusError})
+}
+
+// unreported returns the given failed load balancers for which no event was recorded yet. Load balancers which are
+// no longer failed are forgotten, so that an event is recorded again if they fail again later on.
+func (r *reconciler) unreported(namespace string, failed []FailedServiceLoadBalancer) []FailedServiceLoadBalancer {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	reported := r.reported[namespace]
+	failedIDs := sets.New[string]()
+	var unreported []FailedServiceLoadBalancer
+	for _, lb := range failed {
+		failedIDs.Insert(lb.LoadBalancer.ID)
+		if !reported.Has(lb.LoadBalancer.ID) {
+			unreported = append(unreported, lb)
+		}
+	}
+	r.reported[namespace] = reported.Intersection(failedIDs)
+	return unreported
+}
+
+func (r *reconciler) markReported(namespace, id string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.reported[namespace].Insert(id)
+}
+
+func (r *reconciler) forget(namespace string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	delete(r.reported, namespace)
+}
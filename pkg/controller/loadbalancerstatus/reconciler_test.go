@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancerstatus_test
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/loadbalancers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	. "github.com/gardener/gardener-extension-provider-openstack/pkg/controller/loadbalancerstatus"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+	mockopenstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client/mocks"
+)
+
+var _ = Describe("Reconciler", func() {
+	const (
+		namespace  = "shoot--foo--bar"
+		region     = "eu-1"
+		syncPeriod = 5 * time.Minute
+	)
+
+	var (
+		ctx  = context.Background()
+		ctrl *gomock.Controller
+
+		factoryFactory *mockopenstackclient.MockFactoryFactory
+		factory        *mockopenstackclient.MockFactory
+		loadbalancing  *mockopenstackclient.MockLoadbalancing
+
+		seedClient  client.Client
+		shootClient client.Client
+		reconciler  reconcile.Reconciler
+		request     = reconcile.Request{NamespacedName: client.ObjectKey{Namespace: namespace, Name: "control-plane"}}
+
+		failedLB = loadbalancers.LoadBalancer{ID: "1", Name: "kube_service_" + namespace + "_default_nginx", ProvisioningStatus: "ERROR"}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		factoryFactory = mockopenstackclient.NewMockFactoryFactory(ctrl)
+		factory = mockopenstackclient.NewMockFactory(ctrl)
+		loadbalancing = mockopenstackclient.NewMockLoadbalancing(ctrl)
+
+		shoot, err := json.Marshal(&gardencorev1beta1.Shoot{})
+		Expect(err).NotTo(HaveOccurred())
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(
+			&extensionsv1alpha1.ControlPlane{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "control-plane"},
+				Spec: extensionsv1alpha1.ControlPlaneSpec{
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: openstack.Type},
+					Region:      region,
+					SecretRef:   corev1.SecretReference{Namespace: namespace, Name: "cloudprovider"},
+				},
+			},
+			&extensionsv1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: namespace},
+				Spec: extensionsv1alpha1.ClusterSpec{
+					CloudProfile: runtime.RawExtension{Raw: []byte(`{}`)},
+					Seed:         runtime.RawExtension{Raw: []byte(`{}`)},
+					Shoot:        runtime.RawExtension{Raw: shoot},
+				},
+			},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "cloudprovider"},
+				Data: map[string][]byte{
+					openstack.DomainName: []byte("domain"),
+					openstack.TenantName: []byte("tenant"),
+					openstack.UserName:   []byte("user"),
+					openstack.Password:   []byte("password"),
+					openstack.AuthURL:    []byte("https://keystone"),
+				},
+			},
+		).Build()
+		shootClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).WithObjects(
+			&corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "nginx"}},
+		).Build()
+
+		reconciler = NewReconciler(seedClient, factoryFactory, func(context.Context, client.Client, string) (client.Client, error) {
+			return shootClient, nil
+		}, syncPeriod)
+
+		factoryFactory.EXPECT().NewFactory(gomock.Any()).Return(factory, nil).AnyTimes()
+		factory.EXPECT().Loadbalancing(gomock.Any()).Return(loadbalancing, nil).AnyTimes()
+	})
+
+	It("should record an event on the service of a failed load balancer only once", func() {
+		loadbalancing.EXPECT().ListLoadbalancers(loadbalancers.ListOpts{ProvisioningStatus: "ERROR"}).Return([]loadbalancers.LoadBalancer{failedLB}, nil).Times(2)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		events := &corev1.EventList{}
+		Expect(shootClient.List(ctx, events, client.InNamespace("default"))).To(Succeed())
+		Expect(events.Items).To(HaveLen(1))
+		Expect(events.Items[0].InvolvedObject.Name).To(Equal("nginx"))
+		Expect(events.Items[0].Reason).To(Equal(EventReasonLoadBalancerProvisioningFailed))
+	})
+
+	It("should record an event again if the load balancer fails again", func() {
+		gomock.InOrder(
+			loadbalancing.EXPECT().ListLoadbalancers(gomock.Any()).Return([]loadbalancers.LoadBalancer{failedLB}, nil),
+			loadbalancing.EXPECT().ListLoadbalancers(gomock.Any()).Return(nil, nil),
+			loadbalancing.EXPECT().ListLoadbalancers(gomock.Any()).Return([]loadbalancers.LoadBalancer{failedLB}, nil),
+		)
+
+		for i := 0; i < 3; i++ {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		}
+
+		events := &corev1.EventList{}
+		Expect(shootClient.List(ctx, events, client.InNamespace("default"))).To(Succeed())
+		Expect(events.Items).To(HaveLen(2))
+	})
+
+	It("should ignore failed load balancers of deleted services", func() {
+		Expect(shootClient.Delete(ctx, &corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "nginx"}})).To(Succeed())
+		loadbalancing.EXPECT().ListLoadbalancers(gomock.Any()).Return([]loadbalancers.LoadBalancer{failedLB}, nil)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		events := &corev1.EventList{}
+		Expect(shootClient.List(ctx, events)).To(Succeed())
+		Expect(events.Items).To(BeEmpty())
+	})
+})
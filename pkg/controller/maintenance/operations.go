@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenance
+
+import (
+	"context"
+	"fmt"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/helper"
+	openstackv1alpha1 "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+)
+
+// recycleServer deletes the machine of the given node, so that the machine-controller-manager drains the node, deletes
+// its server and creates a new machine in its place.
+func (r *reconciler) recycleServer(ctx context.Context, namespace, node string) (string, error) {
+	machineList := &machinev1alpha1.MachineList{}
+	if err := r.client.List(ctx, machineList, client.InNamespace(namespace), client.MatchingLabels{machinev1alpha1.NodeLabelKey: node}); err != nil {
+		return "", fmt.Errorf("could not list machines: %w", err)
+	}
+	if len(machineList.Items) == 0 {
+		return "", failuref("no machine found for node %q", node)
+	}
+
+	machine := &machineList.Items[0]
+	if machine.DeletionTimestamp != nil {
+		return fmt.Sprintf("machine %q of node %q is already being deleted", machine.Name, node), nil
+	}
+	if err := r.client.Delete(ctx, machine); client.IgnoreNotFound(err) != nil {
+		return "", fmt.Errorf("could not delete machine %q: %w", machine.Name, err)
+	}
+	return fmt.Sprintf("machine %q of node %q is deleted, the machine-controller-manager replaces its server", machine.Name, node), nil
+}
+
+// reconcileSecurityGroups requests a reconciliation of the infrastructure of the shoot, which restores the rules of its
+// security groups.
+func (r *reconciler) reconcileSecurityGroups(ctx context.Context, namespace string) (string, error) {
+	infrastructureList := &extensionsv1alpha1.InfrastructureList{}
+	if err := r.client.List(ctx, infrastructureList, client.InNamespace(namespace)); err != nil {
+		return "", fmt.Errorf("could not list infrastructures: %w", err)
+	}
+	infrastructure := findInfrastructure(infrastructureList.Items)
+	if infrastructure == nil {
+		return "", failuref("no infrastructure of type %s found in namespace %q", openstack.Type, namespace)
+	}
+
+	if err := r.requestReconciliation(ctx, infrastructure); err != nil {
+		return "", fmt.Errorf("could not request reconciliation of infrastructure %q: %w", infrastructure.Name, err)
+	}
+	return fmt.Sprintf("reconciliation of infrastructure %q requested, which restores the rules of its security groups", infrastructure.Name), nil
+}
+
+// refreshImageCache discards the images recorded as verified in the provider status of the worker of the shoot and
+// requests a reconciliation of the worker, so that the images are looked up again before machines are rolled.
+func (r *reconciler) refreshImageCache(ctx context.Context, namespace string) (string, error) {
+	workerList := &extensionsv1alpha1.WorkerList{}
+	if err := r.client.List(ctx, workerList, client.InNamespace(namespace)); err != nil {
+		return "", fmt.Errorf("could not list workers: %w", err)
+	}
+	worker := findWorker(workerList.Items)
+	if worker == nil {
+		return "", failuref("no worker of type %s found in namespace %q", openstack.Type, namespace)
+	}
+
+	if err := r.discardVerifiedImages(ctx, worker); err != nil {
+		return "", err
+	}
+
+	if err := r.requestReconciliation(ctx, worker); err != nil {
+		return "", fmt.Errorf("could not request reconciliation of worker %q: %w", worker.Name, err)
+	}
+	return fmt.Sprintf("verified images of worker %q discarded and its reconciliation requested", worker.Name), nil
+}
+
+// discardVerifiedImages removes the verified images from the provider status of the given worker. The provider status
+// is patched with optimistic locking, as the worker controller writes it concurrently. On conflicts, the worker is read
+// again and the verified images are discarded from its latest provider status.
+func (r *reconciler) discardVerifiedImages(ctx context.Context, worker *extensionsv1alpha1.Worker) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		workerStatus, err := helper.WorkerStatusFromRaw(worker.Status.ProviderStatus)
+		if err != nil {
+			return failuref("could not decode provider status of worker %q: %v", worker.Name, err)
+		}
+		if len(workerStatus.VerifiedImages) == 0 {
+			return nil
+		}
+		workerStatus.VerifiedImages = nil
+
+		workerStatusV1alpha1 := &openstackv1alpha1.WorkerStatus{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: openstackv1alpha1.SchemeGroupVersion.String(),
+				Kind:       "WorkerStatus",
+			},
+		}
+		if err := r.scheme.Convert(workerStatus, workerStatusV1alpha1, nil); err != nil {
+			return err
+		}
+
+		patch := client.MergeFromWithOptions(worker.DeepCopy(), client.MergeFromWithOptimisticLock{})
+		worker.Status.ProviderStatus = &runtime.RawExtension{Object: workerStatusV1alpha1}
+		if err := r.client.Status().Patch(ctx, worker, patch); err != nil {
+			if apierrors.IsConflict(err) {
+				if getErr := r.client.Get(ctx, client.ObjectKeyFromObject(worker), worker); getErr != nil {
+					return getErr
+				}
+			}
+			return fmt.Errorf("could not update provider status of worker %q: %w", worker.Name, err)
+		}
+		return nil
+	})
+}
+
+// requestReconciliation annotates the given extension object with the reconcile operation.
+func (r *reconciler) requestReconciliation(ctx context.Context, obj client.Object) error {
+	patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[v1beta1constants.GardenerOperation] = v1beta1constants.GardenerOperationReconcile
+	obj.SetAnnotations(annotations)
+	return r.client.Patch(ctx, obj, patch)
+}
+
+func findInfrastructure(infrastructures []extensionsv1alpha1.Infrastructure) *extensionsv1alpha1.Infrastructure {
+	for i := range infrastructures {
+		if infrastructures[i].Spec.Type == openstack.Type {
+			return &infrastructures[i]
+		}
+	}
+	return nil
+}
+
+func findWorker(workers []extensionsv1alpha1.Worker) *extensionsv1alpha1.Worker {
+	for i := range workers {
+		if workers[i].Spec.Type == openstack.Type {
+			return &workers[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	openstackv1alpha1 "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/v1alpha1"
+)
+
+const (
+	// EventReasonMaintenanceSucceeded is the reason of the events emitted when a maintenance operation was executed.
+	EventReasonMaintenanceSucceeded = "MaintenanceSucceeded"
+	// EventReasonMaintenanceFailed is the reason of the events emitted when a maintenance operation could not be executed.
+	EventReasonMaintenanceFailed = "MaintenanceFailed"
+)
+
+// failure is an error which cannot be resolved by retrying the operation, e.g. because the request is invalid. The
+// operation is marked as failed instead of being retried.
+type failure struct {
+	message string
+}
+
+func (f *failure) Error() string {
+	return f.message
+}
+
+func failuref(format string, args ...interface{}) error {
+	return &failure{message: fmt.Sprintf(format, args...)}
+}
+
+type reconciler struct {
+	client   client.Client
+	scheme   *runtime.Scheme
+	recorder record.EventRecorder
+}
+
+// NewReconciler creates a new reconciler which executes the provider-level maintenance operations requested by
+// OpenStackMaintenances in the namespaces of the shoots and reports their outcome in the status.
+func NewReconciler(c client.Client, scheme *runtime.Scheme, recorder record.EventRecorder) reconcile.Reconciler {
+	return &reconciler{
+		client:   c,
+		scheme:   scheme,
+		recorder: recorder,
+	}
+}
+
+func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	maintenance := &openstackv1alpha1.OpenStackMaintenance{}
+	if err := r.client.Get(ctx, request.NamespacedName, maintenance); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if maintenance.DeletionTimestamp != nil {
+		return reconcile.Result{}, nil
+	}
+	// the operation is only executed once per generation
+	if maintenance.Status.Phase != "" && maintenance.Status.ObservedGeneration == maintenance.Generation {
+		return reconcile.Result{}, nil
+	}
+
+	phase, eventType, reason := openstackv1alpha1.MaintenancePhaseSucceeded, corev1.EventTypeNormal, EventReasonMaintenanceSucceeded
+	message, err := r.execute(ctx, maintenance)
+	if err != nil {
+		var f *failure
+		if !errors.As(err, &f) {
+			return reconcile.Result{}, fmt.Errorf("could not execute maintenance operation %s: %w", maintenance.Spec.Operation, err)
+		}
+		phase, eventType, reason, message = openstackv1alpha1.MaintenancePhaseFailed, corev1.EventTypeWarning, EventReasonMaintenanceFailed, f.message
+	}
+
+	log.Info("Executed maintenance operation", "operation", maintenance.Spec.Operation, "phase", phase, "message", message)
+	r.recorder.Event(maintenance, eventType, reason, message)
+
+	patch := client.MergeFrom(maintenance.DeepCopy())
+	now := metav1.Now()
+	maintenance.Status = openstackv1alpha1.OpenStackMaintenanceStatus{
+		ObservedGeneration: maintenance.Generation,
+		Phase:              phase,
+		Message:            message,
+		LastUpdateTime:     &now,
+	}
+	if err := r.client.Status().Patch(ctx, maintenance, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not update status of maintenance: %w", err)
+	}
+	return reconcile.Result{}, nil
+}
+
+// execute executes the operation requested by the given maintenance and returns a message describing its outcome.
+func (r *reconciler) execute(ctx context.Context, maintenance *openstackv1alpha1.OpenStackMaintenance) (string, error) {
+	switch maintenance.Spec.Operation {
+	case openstackv1alpha1.MaintenanceOperationRecycleServer:
+		if maintenance.Spec.Node == nil || *maintenance.Spec.Node == "" {
+			return "", failuref("the node whose server is recycled must be specified")
+		}
+		return r.recycleServer(ctx, maintenance.Namespace, *maintenance.Spec.Node)
+	case openstackv1alpha1.MaintenanceOperationReconcileSecurityGroups:
+		return r.reconcileSecurityGroups(ctx, maintenance.Namespace)
+	case openstackv1alpha1.MaintenanceOperationRefreshImageCache:
+		return r.refreshImageCache(ctx, maintenance.Namespace)
+	default:
+		return "", failuref("unsupported operation %q, supported operations are %s, %s and %s", maintenance.Spec.Operation,
+			openstackv1alpha1.MaintenanceOperationRecycleServer,
+			openstackv1alpha1.MaintenanceOperationReconcileSecurityGroups,
+			openstackv1alpha1.MaintenanceOperationRefreshImageCache)
+	}
+}
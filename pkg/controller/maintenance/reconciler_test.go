@@ -0,0 +1,214 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenance_test
+
+import (
+	"context"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	openstackinstall "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/install"
+	openstackv1alpha1 "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/v1alpha1"
+	. "github.com/gardener/gardener-extension-provider-openstack/pkg/controller/maintenance"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+)
+
+var _ = Describe("Reconciler", func() {
+	const namespace = "shoot--foo--bar"
+
+	var (
+		ctx = context.Background()
+
+		scheme     *runtime.Scheme
+		seedClient client.Client
+		recorder   *record.FakeRecorder
+		reconciler reconcile.Reconciler
+		request    = reconcile.Request{NamespacedName: client.ObjectKey{Namespace: namespace, Name: "maintenance"}}
+
+		extensionSpec = extensionsv1alpha1.DefaultSpec{Type: openstack.Type}
+	)
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		Expect(kubernetes.AddSeedSchemeToScheme(scheme)).To(Succeed())
+		Expect(openstackinstall.AddToScheme(scheme)).To(Succeed())
+		Expect(machinev1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		seedClient = fakeclient.NewClientBuilder().
+			WithScheme(scheme).
+			WithStatusSubresource(&openstackv1alpha1.OpenStackMaintenance{}, &extensionsv1alpha1.Worker{}).
+			WithObjects(
+				&machinev1alpha1.Machine{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: namespace,
+						Name:      "machine-1",
+						Labels:    map[string]string{"node": "node-1"},
+					},
+				},
+				&extensionsv1alpha1.Infrastructure{
+					ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "bar"},
+					Spec:       extensionsv1alpha1.InfrastructureSpec{DefaultSpec: extensionSpec},
+				},
+				&extensionsv1alpha1.Worker{
+					ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "bar"},
+					Spec:       extensionsv1alpha1.WorkerSpec{DefaultSpec: extensionSpec},
+					Status: extensionsv1alpha1.WorkerStatus{
+						DefaultStatus: extensionsv1alpha1.DefaultStatus{
+							ProviderStatus: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"openstack.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerStatus","verifiedImages":[{"region":"eu-1","image":"gardenlinux","id":"image-id"}]}`)},
+						},
+					},
+				},
+			).Build()
+		recorder = record.NewFakeRecorder(10)
+		reconciler = NewReconciler(seedClient, scheme, recorder)
+	})
+
+	createMaintenance := func(spec openstackv1alpha1.OpenStackMaintenanceSpec) {
+		Expect(seedClient.Create(ctx, &openstackv1alpha1.OpenStackMaintenance{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "maintenance", Generation: 1},
+			Spec:       spec,
+		})).To(Succeed())
+	}
+
+	expectStatus := func(phase openstackv1alpha1.MaintenancePhase, message string) {
+		maintenance := &openstackv1alpha1.OpenStackMaintenance{}
+		Expect(seedClient.Get(ctx, request.NamespacedName, maintenance)).To(Succeed())
+		Expect(maintenance.Status.Phase).To(Equal(phase))
+		Expect(maintenance.Status.Message).To(Equal(message))
+		Expect(maintenance.Status.ObservedGeneration).To(Equal(maintenance.Generation))
+		Expect(maintenance.Status.LastUpdateTime).NotTo(BeNil())
+	}
+
+	It("should recycle the server of a node by deleting its machine", func() {
+		createMaintenance(openstackv1alpha1.OpenStackMaintenanceSpec{
+			Operation: openstackv1alpha1.MaintenanceOperationRecycleServer,
+			Node:      pointer.String("node-1"),
+		})
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		err := seedClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "machine-1"}, &machinev1alpha1.Machine{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		expectStatus(openstackv1alpha1.MaintenancePhaseSucceeded, `machine "machine-1" of node "node-1" is deleted, the machine-controller-manager replaces its server`)
+		Expect(<-recorder.Events).To(HavePrefix("Normal " + EventReasonMaintenanceSucceeded))
+	})
+
+	It("should fail to recycle the server of an unknown node", func() {
+		createMaintenance(openstackv1alpha1.OpenStackMaintenanceSpec{
+			Operation: openstackv1alpha1.MaintenanceOperationRecycleServer,
+			Node:      pointer.String("node-2"),
+		})
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(seedClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "machine-1"}, &machinev1alpha1.Machine{})).To(Succeed())
+		expectStatus(openstackv1alpha1.MaintenancePhaseFailed, `no machine found for node "node-2"`)
+		Expect(<-recorder.Events).To(HavePrefix("Warning " + EventReasonMaintenanceFailed))
+	})
+
+	It("should fail to recycle a server if no node is given", func() {
+		createMaintenance(openstackv1alpha1.OpenStackMaintenanceSpec{
+			Operation: openstackv1alpha1.MaintenanceOperationRecycleServer,
+		})
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		expectStatus(openstackv1alpha1.MaintenancePhaseFailed, "the node whose server is recycled must be specified")
+	})
+
+	It("should request a reconciliation of the infrastructure to reconcile the security groups", func() {
+		createMaintenance(openstackv1alpha1.OpenStackMaintenanceSpec{
+			Operation: openstackv1alpha1.MaintenanceOperationReconcileSecurityGroups,
+		})
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		infrastructure := &extensionsv1alpha1.Infrastructure{}
+		Expect(seedClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "bar"}, infrastructure)).To(Succeed())
+		Expect(infrastructure.Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile))
+		expectStatus(openstackv1alpha1.MaintenancePhaseSucceeded, `reconciliation of infrastructure "bar" requested, which restores the rules of its security groups`)
+	})
+
+	It("should discard the verified images of the worker and request its reconciliation", func() {
+		createMaintenance(openstackv1alpha1.OpenStackMaintenanceSpec{
+			Operation: openstackv1alpha1.MaintenanceOperationRefreshImageCache,
+		})
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		worker := &extensionsv1alpha1.Worker{}
+		Expect(seedClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "bar"}, worker)).To(Succeed())
+		Expect(worker.Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile))
+		Expect(string(worker.Status.ProviderStatus.Raw)).To(ContainSubstring(`"kind":"WorkerStatus"`))
+		Expect(string(worker.Status.ProviderStatus.Raw)).NotTo(ContainSubstring("verifiedImages"))
+		expectStatus(openstackv1alpha1.MaintenancePhaseSucceeded, `verified images of worker "bar" discarded and its reconciliation requested`)
+	})
+
+	It("should discard the verified images from the latest provider status of the worker on conflicts", func() {
+		var conflicts int
+		// emulates the worker controller writing the provider status concurrently
+		seedClient = interceptor.NewClient(seedClient.(client.WithWatch), interceptor.Funcs{
+			SubResourcePatch: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+				if conflicts == 0 {
+					conflicts++
+					worker := &extensionsv1alpha1.Worker{}
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(obj), worker)).To(Succeed())
+					worker.Status.ProviderStatus = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"openstack.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerStatus","machineImages":[{"name":"gardenlinux","version":"1.0","image":"gardenlinux"}],"verifiedImages":[{"region":"eu-1","image":"gardenlinux","id":"image-id"}]}`)}
+					Expect(c.Status().Update(ctx, worker)).To(Succeed())
+				}
+				return c.SubResource(subResourceName).Patch(ctx, obj, patch, opts...)
+			},
+		})
+		reconciler = NewReconciler(seedClient, scheme, recorder)
+		createMaintenance(openstackv1alpha1.OpenStackMaintenanceSpec{
+			Operation: openstackv1alpha1.MaintenanceOperationRefreshImageCache,
+		})
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		worker := &extensionsv1alpha1.Worker{}
+		Expect(seedClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "bar"}, worker)).To(Succeed())
+		Expect(conflicts).To(Equal(1))
+		Expect(string(worker.Status.ProviderStatus.Raw)).To(ContainSubstring(`"machineImages":[{"architecture":"amd64","image":"gardenlinux","name":"gardenlinux","version":"1.0"}]`))
+		Expect(string(worker.Status.ProviderStatus.Raw)).NotTo(ContainSubstring("verifiedImages"))
+		expectStatus(openstackv1alpha1.MaintenancePhaseSucceeded, `verified images of worker "bar" discarded and its reconciliation requested`)
+	})
+
+	It("should fail for unsupported operations", func() {
+		createMaintenance(openstackv1alpha1.OpenStackMaintenanceSpec{
+			Operation: "RebootServer",
+		})
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		expectStatus(openstackv1alpha1.MaintenancePhaseFailed, `unsupported operation "RebootServer", supported operations are RecycleServer, ReconcileSecurityGroups and RefreshImageCache`)
+	})
+
+	It("should execute the operation only once per generation", func() {
+		createMaintenance(openstackv1alpha1.OpenStackMaintenanceSpec{
+			Operation: openstackv1alpha1.MaintenanceOperationRecycleServer,
+			Node:      pointer.String("node-2"),
+		})
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(recorder.Events).To(HaveLen(1))
+	})
+})
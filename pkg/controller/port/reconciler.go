@@ -0,0 +1,378 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package port
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/utils"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/portsecurity"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/qos/policies"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/trunks"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/helper"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+	openstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
+)
+
+const (
+	// FinalizerName is the finalizer of the machines whose ports are the parent ports of trunks. The trunk of a machine
+	// is deleted before the finalizer is removed, as Neutron refuses to delete the parent port of a trunk.
+	FinalizerName = "openstack.provider.extensions.gardener.cloud/trunk"
+	// PortConfigAnnotation is the annotation of the machines containing the checksum of the port configuration of their
+	// worker pool which was applied to the ports of their servers.
+	PortConfigAnnotation = "openstack.provider.extensions.gardener.cloud/port-config"
+
+	// requeueAfter is the period after which a machine whose server or port does not exist yet is reconciled again.
+	requeueAfter = 30 * time.Second
+)
+
+type reconciler struct {
+	client               client.Client
+	clientFactoryFactory openstackclient.FactoryFactory
+}
+
+// NewReconciler creates a new reconciler which configures the ports of the servers of the machines of the worker pools
+// as soon as the servers exist, as the machine-controller-manager cannot configure them when it creates the servers:
+//   - it creates a Neutron trunk for the port in the network of the shoot of each machine of the worker pools with trunk
+//     ports and deletes the trunk when the machine is deleted or its worker pool no longer has trunk ports.
+//   - it adds the allowed address pairs of the worker pool to the port in the network of the shoot.
+//   - it disables the port security of all ports of the servers of the worker pools without port security.
+//   - it applies the QoS policy of the worker pool to all ports of the servers, or removes it once the worker pool no
+//     longer has a QoS policy.
+//
+// As it is driven by the machines, the ports of machines created by rolling updates or the cluster-autoscaler are
+// configured without waiting for the reconciliation of the Worker.
+func NewReconciler(c client.Client, clientFactoryFactory openstackclient.FactoryFactory) reconcile.Reconciler {
+	return &reconciler{
+		client:               c,
+		clientFactoryFactory: clientFactoryFactory,
+	}
+}
+
+func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	machine := &machinev1alpha1.Machine{}
+	if err := r.client.Get(ctx, request.NamespacedName, machine); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	worker, err := r.getWorker(ctx, machine.Namespace)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if worker == nil {
+		// the machine does not belong to an OpenStack shoot, or its worker is gone and with it the credentials
+		if controllerutil.ContainsFinalizer(machine, FinalizerName) {
+			return reconcile.Result{}, controllerutils.RemoveFinalizers(ctx, r.client, machine, FinalizerName)
+		}
+		return reconcile.Result{}, nil
+	}
+
+	deleting := machine.DeletionTimestamp != nil || worker.DeletionTimestamp != nil
+	workerConfig, err := workerConfigOf(worker, machine)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	trunkPort := !deleting && pointer.BoolDeref(workerConfig.TrunkPort, false)
+	if !trunkPort && controllerutil.ContainsFinalizer(machine, FinalizerName) {
+		networking, err := r.networking(ctx, worker)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		log.Info("Deleting trunk of machine")
+		if err := deleteTrunks(networking, machine.Name); err != nil {
+			return reconcile.Result{}, fmt.Errorf("could not delete trunk of machine: %w", err)
+		}
+		if err := controllerutils.RemoveFinalizers(ctx, r.client, machine, FinalizerName); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+	if deleting {
+		return reconcile.Result{}, nil
+	}
+
+	portConfigChecksum, err := portConfigChecksumOf(workerConfig)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	portConfigApplied := machine.Annotations[PortConfigAnnotation] == portConfigChecksum
+	if !trunkPort && portConfigApplied {
+		return reconcile.Result{}, nil
+	}
+
+	if trunkPort && !controllerutil.ContainsFinalizer(machine, FinalizerName) {
+		if err := controllerutils.AddFinalizers(ctx, r.client, machine, FinalizerName); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	serverID := serverIDOf(machine)
+	if serverID == "" {
+		return reconcile.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	infrastructureStatus, err := helper.InfrastructureStatusFromRaw(worker.Spec.InfrastructureProviderStatus)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	networking, err := r.networking(ctx, worker)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	serverPorts, err := networking.ListPorts(ports.ListOpts{DeviceID: serverID})
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not list ports of server %s: %w", serverID, err)
+	}
+	shootPort := findPortInNetwork(serverPorts, infrastructureStatus.Networks.ID)
+	if shootPort == nil {
+		return reconcile.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	if !portConfigApplied {
+		log.Info("Configuring ports of machine")
+		// a QoS policy may have been applied with the previous configuration
+		clearQoSPolicy := machine.Annotations[PortConfigAnnotation] != ""
+		if err := updatePorts(networking, serverPorts, shootPort, workerConfig, clearQoSPolicy); err != nil {
+			return reconcile.Result{}, fmt.Errorf("could not configure ports of server %s: %w", serverID, err)
+		}
+		patch := client.MergeFrom(machine.DeepCopy())
+		if portConfigChecksum == "" {
+			delete(machine.Annotations, PortConfigAnnotation)
+		} else {
+			metav1.SetMetaDataAnnotation(&machine.ObjectMeta, PortConfigAnnotation, portConfigChecksum)
+		}
+		if err := r.client.Patch(ctx, machine, patch); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	if !trunkPort {
+		return reconcile.Result{}, nil
+	}
+
+	portTrunks, err := networking.ListTrunks(trunks.ListOpts{PortID: shootPort.ID})
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not list trunks of port %s: %w", shootPort.ID, err)
+	}
+	if len(portTrunks) > 0 {
+		return reconcile.Result{}, nil
+	}
+
+	log.Info("Creating trunk of machine", "portID", shootPort.ID)
+	if _, err := networking.CreateTrunk(trunks.CreateOpts{
+		Name:        machine.Name,
+		Description: fmt.Sprintf("Trunk of machine %s of cluster %s", machine.Name, machine.Namespace),
+		PortID:      shootPort.ID,
+	}); err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not create trunk of port %s: %w", shootPort.ID, err)
+	}
+	return reconcile.Result{}, nil
+}
+
+// getWorker returns the OpenStack worker in the given namespace, or nil if there is none.
+func (r *reconciler) getWorker(ctx context.Context, namespace string) (*extensionsv1alpha1.Worker, error) {
+	workers := &extensionsv1alpha1.WorkerList{}
+	if err := r.client.List(ctx, workers, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+	for _, worker := range workers.Items {
+		if worker.Spec.Type == openstack.Type {
+			return &worker, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *reconciler) networking(ctx context.Context, worker *extensionsv1alpha1.Worker) (openstackclient.Networking, error) {
+	credentials, err := openstack.GetShootCredentials(ctx, r.client, worker.Spec.SecretRef)
+	if err != nil {
+		return nil, fmt.Errorf("could not get OpenStack credentials: %w", err)
+	}
+	if len(strings.TrimSpace(credentials.AuthURL)) == 0 {
+		cluster, err := extensionscontroller.GetCluster(ctx, r.client, worker.Namespace)
+		if err != nil {
+			return nil, fmt.Errorf("could not get cluster: %w", err)
+		}
+		cloudProfileConfig, err := helper.CloudProfileConfigFromCluster(cluster)
+		if err != nil {
+			return nil, err
+		}
+		if credentials.AuthURL, err = helper.FindKeyStoneURL(cloudProfileConfig.KeyStoneURLs, cloudProfileConfig.KeyStoneURL, worker.Spec.Region); err != nil {
+			return nil, err
+		}
+	}
+
+	factory, err := r.clientFactoryFactory.NewFactory(credentials)
+	if err != nil {
+		return nil, fmt.Errorf("could not create OpenStack client factory: %w", err)
+	}
+	return factory.Networking(openstackclient.WithRegion(worker.Spec.Region))
+}
+
+// workerConfigOf returns the WorkerConfig of the worker pool of the given machine, which is empty if the worker pool no
+// longer exists.
+func workerConfigOf(worker *extensionsv1alpha1.Worker, machine *machinev1alpha1.Machine) (*api.WorkerConfig, error) {
+	poolName := machine.Spec.NodeTemplateSpec.Labels[v1beta1constants.LabelWorkerPool]
+	for _, pool := range worker.Spec.Pools {
+		if pool.Name == poolName {
+			return helper.WorkerConfigFromRawExtension(pool.ProviderConfig)
+		}
+	}
+	return &api.WorkerConfig{}, nil
+}
+
+// portConfig is the configuration of the ports of the machines of a worker pool.
+type portConfig struct {
+	AllowedAddressPairs []api.AllowedAddressPair `json:"allowedAddressPairs,omitempty"`
+	PortSecurityEnabled *bool                    `json:"portSecurityEnabled,omitempty"`
+	QoSPolicyID         *string                  `json:"qosPolicyID,omitempty"`
+}
+
+// portConfigChecksumOf returns the checksum of the port configuration of the given WorkerConfig, which is empty if the
+// ports are not configured.
+func portConfigChecksumOf(workerConfig *api.WorkerConfig) (string, error) {
+	config := portConfig{
+		AllowedAddressPairs: workerConfig.AllowedAddressPairs,
+		QoSPolicyID:         workerConfig.QoSPolicyID,
+	}
+	if !pointer.BoolDeref(workerConfig.PortSecurityEnabled, true) {
+		config.PortSecurityEnabled = pointer.Bool(false)
+	}
+	if len(config.AllowedAddressPairs) == 0 && config.PortSecurityEnabled == nil && config.QoSPolicyID == nil {
+		return "", nil
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+	return utils.ComputeSHA256Hex(data), nil
+}
+
+// updatePorts disables the port security of the given ports of a server if the given WorkerConfig disables it and
+// applies its QoS policy to them, or removes their QoS policy if it has none and clearQoSPolicy is set. The allowed
+// address pairs of the WorkerConfig are added to the given port in the network of the shoot, keeping the ones of the
+// port, as the machine-controller-manager adds the pod network to them.
+func updatePorts(networking openstackclient.Networking, serverPorts []ports.Port, shootPort *ports.Port, workerConfig *api.WorkerConfig, clearQoSPolicy bool) error {
+	portSecurityEnabled := pointer.BoolDeref(workerConfig.PortSecurityEnabled, true)
+	qosPolicyID := workerConfig.QoSPolicyID
+	if qosPolicyID == nil && clearQoSPolicy {
+		qosPolicyID = pointer.String("")
+	}
+
+	if !portSecurityEnabled || qosPolicyID != nil {
+		for _, port := range serverPorts {
+			if _, err := networking.UpdatePort(port.ID, portUpdateOpts(portSecurityEnabled, qosPolicyID)); err != nil {
+				return err
+			}
+		}
+	}
+	if !portSecurityEnabled {
+		return nil
+	}
+
+	allowedAddressPairs := shootPort.AllowedAddressPairs
+	for _, pair := range workerConfig.AllowedAddressPairs {
+		if !containsAddressPair(allowedAddressPairs, pair) {
+			allowedAddressPairs = append(allowedAddressPairs, ports.AddressPair{IPAddress: pair.IPAddress, MACAddress: pointer.StringDeref(pair.MACAddress, "")})
+		}
+	}
+	if len(allowedAddressPairs) == len(shootPort.AllowedAddressPairs) {
+		return nil
+	}
+
+	_, err := networking.UpdatePort(shootPort.ID, ports.UpdateOpts{AllowedAddressPairs: &allowedAddressPairs})
+	return err
+}
+
+// portUpdateOpts returns the options to update the port security and the QoS policy of a port. Neutron refuses to
+// disable the port security of ports with security groups or allowed address pairs, hence they are removed in the same
+// update. A QoS policy id pointing to an empty string removes the QoS policy of the port.
+func portUpdateOpts(portSecurityEnabled bool, qosPolicyID *string) ports.UpdateOptsBuilder {
+	var opts ports.UpdateOptsBuilder = ports.UpdateOpts{}
+	if !portSecurityEnabled {
+		opts = portsecurity.PortUpdateOptsExt{
+			UpdateOptsBuilder: ports.UpdateOpts{
+				SecurityGroups:      &[]string{},
+				AllowedAddressPairs: &[]ports.AddressPair{},
+			},
+			PortSecurityEnabled: pointer.Bool(false),
+		}
+	}
+	if qosPolicyID != nil {
+		opts = policies.PortUpdateOptsExt{UpdateOptsBuilder: opts, QoSPolicyID: qosPolicyID}
+	}
+	return opts
+}
+
+// containsAddressPair returns whether the given allowed address pairs of a port contain the given allowed address pair.
+// Allowed address pairs without a MAC address match any MAC address, as Neutron defaults it to the one of the port.
+func containsAddressPair(allowedAddressPairs []ports.AddressPair, pair api.AllowedAddressPair) bool {
+	for _, existing := range allowedAddressPairs {
+		if existing.IPAddress == pair.IPAddress && (pair.MACAddress == nil || strings.EqualFold(existing.MACAddress, *pair.MACAddress)) {
+			return true
+		}
+	}
+	return false
+}
+
+// findPortInNetwork returns the port in the network with the given id, or nil if there is none.
+func findPortInNetwork(serverPorts []ports.Port, networkID string) *ports.Port {
+	for i := range serverPorts {
+		if serverPorts[i].NetworkID == networkID {
+			return &serverPorts[i]
+		}
+	}
+	return nil
+}
+
+// deleteTrunks deletes the trunks named after the machine with the given name.
+func deleteTrunks(networking openstackclient.Networking, machineName string) error {
+	machineTrunks, err := networking.ListTrunks(trunks.ListOpts{Name: machineName})
+	if err != nil {
+		return err
+	}
+	for _, trunk := range machineTrunks {
+		if err := networking.DeleteTrunk(trunk.ID); err != nil && !openstackclient.IsNotFoundError(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// serverIDOf returns the id of the server of the given machine from its provider id, which has the format
+// openstack:///<region>/<server-id>. It is empty if the server was not created yet.
+func serverIDOf(machine *machinev1alpha1.Machine) string {
+	providerID := machine.Spec.ProviderID
+	if providerID == "" {
+		return ""
+	}
+	return providerID[strings.LastIndex(providerID, "/")+1:]
+}
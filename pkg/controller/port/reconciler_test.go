@@ -0,0 +1,355 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package port_test
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/portsecurity"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/qos/policies"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/trunks"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	apiv1alpha1 "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/v1alpha1"
+	. "github.com/gardener/gardener-extension-provider-openstack/pkg/controller/port"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+	mockopenstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client/mocks"
+)
+
+var _ = Describe("Reconciler", func() {
+	const (
+		namespace   = "shoot--foo--bar"
+		region      = "eu-1"
+		networkID   = "network-id"
+		poolName    = "pool"
+		machineName = "shoot--foo--bar-pool-z1-abcde"
+	)
+
+	var (
+		ctx  = context.Background()
+		ctrl *gomock.Controller
+
+		factoryFactory *mockopenstackclient.MockFactoryFactory
+		factory        *mockopenstackclient.MockFactory
+		networking     *mockopenstackclient.MockNetworking
+
+		scheme     *runtime.Scheme
+		seedClient client.Client
+		reconciler reconcile.Reconciler
+		request    = reconcile.Request{NamespacedName: client.ObjectKey{Namespace: namespace, Name: machineName}}
+
+		worker  *extensionsv1alpha1.Worker
+		machine *machinev1alpha1.Machine
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		factoryFactory = mockopenstackclient.NewMockFactoryFactory(ctrl)
+		factory = mockopenstackclient.NewMockFactory(ctrl)
+		networking = mockopenstackclient.NewMockNetworking(ctrl)
+
+		scheme = runtime.NewScheme()
+		Expect(kubernetes.AddSeedSchemeToScheme(scheme)).To(Succeed())
+		Expect(machinev1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		infrastructureStatus, err := json.Marshal(&apiv1alpha1.InfrastructureStatus{
+			TypeMeta: metav1.TypeMeta{APIVersion: apiv1alpha1.SchemeGroupVersion.String(), Kind: "InfrastructureStatus"},
+			Networks: apiv1alpha1.NetworkStatus{ID: networkID},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		workerConfig, err := json.Marshal(&apiv1alpha1.WorkerConfig{
+			TypeMeta:  metav1.TypeMeta{APIVersion: apiv1alpha1.SchemeGroupVersion.String(), Kind: "WorkerConfig"},
+			TrunkPort: pointer.Bool(true),
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		worker = &extensionsv1alpha1.Worker{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "worker"},
+			Spec: extensionsv1alpha1.WorkerSpec{
+				DefaultSpec:                  extensionsv1alpha1.DefaultSpec{Type: openstack.Type},
+				Region:                       region,
+				SecretRef:                    corev1.SecretReference{Namespace: namespace, Name: "cloudprovider"},
+				InfrastructureProviderStatus: &runtime.RawExtension{Raw: infrastructureStatus},
+				Pools: []extensionsv1alpha1.WorkerPool{
+					{Name: poolName, ProviderConfig: &runtime.RawExtension{Raw: workerConfig}},
+				},
+			},
+		}
+		machine = &machinev1alpha1.Machine{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: machineName},
+			Spec: machinev1alpha1.MachineSpec{
+				ProviderID: "openstack:///" + region + "/server-id",
+				NodeTemplateSpec: machinev1alpha1.NodeTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{v1beta1constants.LabelWorkerPool: poolName}},
+				},
+			},
+		}
+
+		factoryFactory.EXPECT().NewFactory(gomock.Any()).Return(factory, nil).AnyTimes()
+		factory.EXPECT().Networking(gomock.Any()).Return(networking, nil).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	build := func() {
+		seedClient = fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(
+			worker,
+			machine,
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "cloudprovider"},
+				Data: map[string][]byte{
+					openstack.DomainName: []byte("domain"),
+					openstack.TenantName: []byte("tenant"),
+					openstack.UserName:   []byte("user"),
+					openstack.Password:   []byte("password"),
+					openstack.AuthURL:    []byte("https://keystone"),
+				},
+			},
+		).Build()
+		reconciler = NewReconciler(seedClient, factoryFactory)
+	}
+
+	getMachine := func() *machinev1alpha1.Machine {
+		m := &machinev1alpha1.Machine{}
+		ExpectWithOffset(1, seedClient.Get(ctx, request.NamespacedName, m)).To(Succeed())
+		return m
+	}
+
+	It("should create the trunk of the port of the machine and add the finalizer", func() {
+		build()
+		networking.EXPECT().ListPorts(ports.ListOpts{DeviceID: "server-id"}).Return([]ports.Port{{ID: "other-port-id", NetworkID: "other-network-id"}, {ID: "port-id", NetworkID: networkID}}, nil)
+		networking.EXPECT().ListTrunks(trunks.ListOpts{PortID: "port-id"}).Return(nil, nil)
+		networking.EXPECT().CreateTrunk(gomock.Any()).DoAndReturn(func(opts trunks.CreateOpts) (*trunks.Trunk, error) {
+			Expect(opts.Name).To(Equal(machineName))
+			Expect(opts.PortID).To(Equal("port-id"))
+			return &trunks.Trunk{ID: "trunk-id"}, nil
+		})
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(getMachine().Finalizers).To(ConsistOf(FinalizerName))
+	})
+
+	It("should adopt an existing trunk of the port", func() {
+		build()
+		networking.EXPECT().ListPorts(gomock.Any()).Return([]ports.Port{{ID: "port-id", NetworkID: networkID}}, nil)
+		networking.EXPECT().ListTrunks(trunks.ListOpts{PortID: "port-id"}).Return([]trunks.Trunk{{ID: "trunk-id"}}, nil)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(getMachine().Finalizers).To(ConsistOf(FinalizerName))
+	})
+
+	It("should requeue machines whose server does not exist yet", func() {
+		machine.Spec.ProviderID = ""
+		build()
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 30 * time.Second}))
+		Expect(getMachine().Finalizers).To(ConsistOf(FinalizerName))
+	})
+
+	It("should requeue machines whose port does not exist yet", func() {
+		build()
+		networking.EXPECT().ListPorts(gomock.Any()).Return(nil, nil)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 30 * time.Second}))
+	})
+
+	Context("allowed address pairs", func() {
+		BeforeEach(func() {
+			workerConfig, err := json.Marshal(&apiv1alpha1.WorkerConfig{
+				TypeMeta: metav1.TypeMeta{APIVersion: apiv1alpha1.SchemeGroupVersion.String(), Kind: "WorkerConfig"},
+				AllowedAddressPairs: []apiv1alpha1.AllowedAddressPair{
+					{IPAddress: "10.250.0.0/16"},
+					{IPAddress: "192.168.0.10", MACAddress: pointer.String("fa:16:3e:00:00:01")},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			worker.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: workerConfig}
+		})
+
+		It("should add the allowed address pairs to the port and record the applied configuration", func() {
+			build()
+			networking.EXPECT().ListPorts(ports.ListOpts{DeviceID: "server-id"}).Return([]ports.Port{{
+				ID:                  "port-id",
+				NetworkID:           networkID,
+				AllowedAddressPairs: []ports.AddressPair{{IPAddress: "100.96.0.0/11", MACAddress: "fa:16:3e:00:00:00"}, {IPAddress: "10.250.0.0/16", MACAddress: "fa:16:3e:00:00:00"}},
+			}}, nil)
+			networking.EXPECT().UpdatePort("port-id", ports.UpdateOpts{AllowedAddressPairs: &[]ports.AddressPair{
+				{IPAddress: "100.96.0.0/11", MACAddress: "fa:16:3e:00:00:00"},
+				{IPAddress: "10.250.0.0/16", MACAddress: "fa:16:3e:00:00:00"},
+				{IPAddress: "192.168.0.10", MACAddress: "fa:16:3e:00:00:01"},
+			}}).Return(&ports.Port{}, nil)
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+			m := getMachine()
+			Expect(m.Finalizers).To(BeEmpty())
+			Expect(m.Annotations[PortConfigAnnotation]).NotTo(BeEmpty())
+		})
+
+		It("should not configure the ports again once the configuration is applied", func() {
+			build()
+			networking.EXPECT().ListPorts(gomock.Any()).Return([]ports.Port{{ID: "port-id", NetworkID: networkID}}, nil)
+			networking.EXPECT().UpdatePort("port-id", gomock.Any()).Return(&ports.Port{}, nil)
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		})
+
+		It("should requeue machines whose server does not exist yet", func() {
+			machine.Spec.ProviderID = ""
+			build()
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 30 * time.Second}))
+			Expect(getMachine().Annotations).NotTo(HaveKey(PortConfigAnnotation))
+		})
+	})
+
+	Context("port security", func() {
+		BeforeEach(func() {
+			workerConfig, err := json.Marshal(&apiv1alpha1.WorkerConfig{
+				TypeMeta:            metav1.TypeMeta{APIVersion: apiv1alpha1.SchemeGroupVersion.String(), Kind: "WorkerConfig"},
+				PortSecurityEnabled: pointer.Bool(false),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			worker.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: workerConfig}
+		})
+
+		It("should disable the port security of all ports of the server and record the applied configuration", func() {
+			build()
+			networking.EXPECT().ListPorts(ports.ListOpts{DeviceID: "server-id"}).Return([]ports.Port{
+				{ID: "port-id", NetworkID: networkID, SecurityGroups: []string{"nodes"}, AllowedAddressPairs: []ports.AddressPair{{IPAddress: "100.96.0.0/11"}}},
+				{ID: "other-port-id", NetworkID: "other-network-id", SecurityGroups: []string{"nodes"}},
+			}, nil)
+			for _, portID := range []string{"port-id", "other-port-id"} {
+				networking.EXPECT().UpdatePort(portID, portsecurity.PortUpdateOptsExt{
+					UpdateOptsBuilder:   ports.UpdateOpts{SecurityGroups: &[]string{}, AllowedAddressPairs: &[]ports.AddressPair{}},
+					PortSecurityEnabled: pointer.Bool(false),
+				}).Return(&ports.Port{}, nil)
+			}
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+			Expect(getMachine().Annotations[PortConfigAnnotation]).NotTo(BeEmpty())
+		})
+
+		It("should not disable the port security again once the configuration is applied", func() {
+			build()
+			networking.EXPECT().ListPorts(gomock.Any()).Return([]ports.Port{{ID: "port-id", NetworkID: networkID}}, nil)
+			networking.EXPECT().UpdatePort("port-id", gomock.Any()).Return(&ports.Port{}, nil)
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		})
+	})
+
+	Context("QoS policy", func() {
+		BeforeEach(func() {
+			workerConfig, err := json.Marshal(&apiv1alpha1.WorkerConfig{
+				TypeMeta:    metav1.TypeMeta{APIVersion: apiv1alpha1.SchemeGroupVersion.String(), Kind: "WorkerConfig"},
+				QoSPolicyID: pointer.String("qos-policy-id"),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			worker.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: workerConfig}
+		})
+
+		It("should apply the QoS policy to all ports of the server and record the applied configuration", func() {
+			build()
+			networking.EXPECT().ListPorts(ports.ListOpts{DeviceID: "server-id"}).Return([]ports.Port{
+				{ID: "port-id", NetworkID: networkID},
+				{ID: "other-port-id", NetworkID: "other-network-id"},
+			}, nil)
+			for _, portID := range []string{"port-id", "other-port-id"} {
+				networking.EXPECT().UpdatePort(portID, policies.PortUpdateOptsExt{UpdateOptsBuilder: ports.UpdateOpts{}, QoSPolicyID: pointer.String("qos-policy-id")}).Return(&ports.Port{}, nil)
+			}
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+			Expect(getMachine().Annotations[PortConfigAnnotation]).NotTo(BeEmpty())
+		})
+
+		It("should apply a changed QoS policy to the ports of existing machines", func() {
+			machine.Annotations = map[string]string{PortConfigAnnotation: "checksum-of-previous-policy"}
+			build()
+			networking.EXPECT().ListPorts(gomock.Any()).Return([]ports.Port{{ID: "port-id", NetworkID: networkID}}, nil)
+			networking.EXPECT().UpdatePort("port-id", policies.PortUpdateOptsExt{UpdateOptsBuilder: ports.UpdateOpts{}, QoSPolicyID: pointer.String("qos-policy-id")}).Return(&ports.Port{}, nil)
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+			Expect(getMachine().Annotations[PortConfigAnnotation]).NotTo(Equal("checksum-of-previous-policy"))
+		})
+	})
+
+	It("should remove the annotation of the applied configuration and the QoS policy once the ports are no longer configured", func() {
+		worker.Spec.Pools[0].ProviderConfig = nil
+		machine.Annotations = map[string]string{PortConfigAnnotation: "checksum"}
+		build()
+		networking.EXPECT().ListPorts(gomock.Any()).Return([]ports.Port{{ID: "port-id", NetworkID: networkID}}, nil)
+		networking.EXPECT().UpdatePort("port-id", policies.PortUpdateOptsExt{UpdateOptsBuilder: ports.UpdateOpts{}, QoSPolicyID: pointer.String("")}).Return(&ports.Port{}, nil)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(getMachine().Annotations).NotTo(HaveKey(PortConfigAnnotation))
+	})
+
+	It("should delete the trunk of a machine which is being deleted and remove the finalizer", func() {
+		machine.Finalizers = []string{FinalizerName, "machine.sapcloud.io/machine-controller-manager"}
+		machine.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+		build()
+		networking.EXPECT().ListTrunks(trunks.ListOpts{Name: machineName}).Return([]trunks.Trunk{{ID: "trunk-id"}}, nil)
+		networking.EXPECT().DeleteTrunk("trunk-id").Return(nil)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(getMachine().Finalizers).To(ConsistOf("machine.sapcloud.io/machine-controller-manager"))
+	})
+
+	It("should delete the trunk of a machine whose worker pool no longer has trunk ports", func() {
+		machine.Finalizers = []string{FinalizerName}
+		worker.Spec.Pools[0].ProviderConfig = nil
+		build()
+		networking.EXPECT().ListTrunks(trunks.ListOpts{Name: machineName}).Return([]trunks.Trunk{{ID: "trunk-id"}}, nil)
+		networking.EXPECT().DeleteTrunk("trunk-id").Return(nil)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(getMachine().Finalizers).To(BeEmpty())
+	})
+
+	It("should ignore machines of worker pools without trunk ports", func() {
+		worker.Spec.Pools[0].ProviderConfig = nil
+		build()
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(getMachine().Finalizers).To(BeEmpty())
+	})
+
+	It("should ignore machines of other providers", func() {
+		worker.Spec.Type = "other"
+		build()
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(getMachine().Finalizers).To(BeEmpty())
+	})
+
+	It("should succeed if the machine is gone", func() {
+		build()
+		Expect(seedClient.Delete(ctx, machine)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(seedClient.Get(ctx, request.NamespacedName, &machinev1alpha1.Machine{})).To(BeNotFoundError())
+	})
+})
@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package quota
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	// quotaUsage is the amount of a resource used by the OpenStack project of a shoot.
+	quotaUsage = promauto.With(runtimemetrics.Registry).NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "openstack_quota_usage",
+			Help: "Amount of a resource used by the OpenStack project of a shoot.",
+		},
+		[]string{"namespace", "resource"},
+	)
+
+	// quotaLimit is the quota of a resource of the OpenStack project of a shoot. A value of -1 means unlimited.
+	quotaLimit = promauto.With(runtimemetrics.Registry).NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "openstack_quota_limit",
+			Help: "Quota of a resource of the OpenStack project of a shoot, -1 means unlimited.",
+		},
+		[]string{"namespace", "resource"},
+	)
+)
+
+func recordUsages(namespace string, usages []Usage) {
+	deleteUsages(namespace)
+	for _, usage := range usages {
+		quotaUsage.WithLabelValues(namespace, usage.Resource).Set(float64(usage.Used))
+		quotaLimit.WithLabelValues(namespace, usage.Resource).Set(float64(usage.Limit))
+	}
+}
+
+func deleteUsages(namespace string) {
+	quotaUsage.DeletePartialMatch(prometheus.Labels{"namespace": namespace})
+	quotaLimit.DeletePartialMatch(prometheus.Labels{"namespace": namespace})
+}
@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package quota
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/config"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/helper"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+	openstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
+)
+
+// EventReasonQuotaThresholdReached is the reason of the events emitted when the usage of a quota reaches a threshold.
+const EventReasonQuotaThresholdReached = "QuotaThresholdReached"
+
+type reconciler struct {
+	client               client.Client
+	recorder             record.EventRecorder
+	clientFactoryFactory openstackclient.FactoryFactory
+	syncPeriod           time.Duration
+	thresholds           []int32
+
+	lock sync.Mutex
+	// reached contains the last threshold reached per namespace and resource.
+	reached map[string]map[string]int32
+}
+
+// NewReconciler creates a new reconciler which periodically collects the quota usage of the OpenStack projects of the
+// shoots, exposes it as metrics and emits events on the Infrastructure when the usage reaches one of the thresholds.
+func NewReconciler(c client.Client, recorder record.EventRecorder, clientFactoryFactory openstackclient.FactoryFactory, quotaMonitoring config.QuotaMonitoring) reconcile.Reconciler {
+	var syncPeriod time.Duration
+	if quotaMonitoring.SyncPeriod != nil {
+		syncPeriod = quotaMonitoring.SyncPeriod.Duration
+	}
+
+	return &reconciler{
+		client:               c,
+		recorder:             recorder,
+		clientFactoryFactory: clientFactoryFactory,
+		syncPeriod:           syncPeriod,
+		thresholds:           quotaMonitoring.Thresholds,
+		reached:              make(map[string]map[string]int32),
+	}
+}
+
+func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	infra := &extensionsv1alpha1.Infrastructure{}
+	if err := r.client.Get(ctx, request.NamespacedName, infra); err != nil {
+		if apierrors.IsNotFound(err) {
+			r.forget(request.Namespace)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if infra.DeletionTimestamp != nil {
+		r.forget(infra.Namespace)
+		return reconcile.Result{}, nil
+	}
+
+	cluster, err := extensionscontroller.GetCluster(ctx, r.client, infra.Namespace)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not get cluster: %w", err)
+	}
+	cloudProfileConfig, err := helper.CloudProfileConfigFromCluster(cluster)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	credentials, err := openstack.GetShootReadOnlyCredentials(ctx, r.client, infra.Spec.SecretRef)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not get OpenStack credentials: %w", err)
+	}
+	if len(strings.TrimSpace(credentials.AuthURL)) == 0 {
+		keyStoneURL, err := helper.FindKeyStoneURL(cloudProfileConfig.KeyStoneURLs, cloudProfileConfig.KeyStoneURL, infra.Spec.Region)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		credentials.AuthURL = keyStoneURL
+	}
+
+	factory, err := r.clientFactoryFactory.NewFactory(credentials)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not create OpenStack client factory: %w", err)
+	}
+	usages, err := CollectUsages(factory, infra.Spec.Region)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	log.V(1).Info("Collected quota usages", "usages", usages)
+	recordUsages(infra.Namespace, usages)
+	r.emitEvents(infra, usages)
+
+	return reconcile.Result{RequeueAfter: r.syncPeriod}, nil
+}
+
+// emitEvents emits a warning event for each resource whose usage reached a higher threshold than in the last sync.
+func (r *reconciler) emitEvents(infra *extensionsv1alpha1.Infrastructure, usages []Usage) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	reached, ok := r.reached[infra.Namespace]
+	if !ok {
+		reached = make(map[string]int32, len(usages))
+		r.reached[infra.Namespace] = reached
+	}
+
+	for _, usage := range usages {
+		threshold := ExceededThreshold(usage, r.thresholds)
+		if threshold > reached[usage.Resource] {
+			r.recorder.Eventf(infra, corev1.EventTypeWarning, EventReasonQuotaThresholdReached,
+				"Usage of the OpenStack quota for %s reached %d%% (%d of %d)", usage.Resource, threshold, usage.Used, usage.Limit)
+		}
+		reached[usage.Resource] = threshold
+	}
+}
+
+func (r *reconciler) forget(namespace string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	delete(r.reached, namespace)
+	deleteUsages(namespace)
+}
@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package quota
+
+import (
+	"fmt"
+	"sort"
+
+	openstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
+)
+
+const (
+	// ResourceInstances is the resource name of the number of servers.
+	ResourceInstances = "instances"
+	// ResourceCores is the resource name of the number of vCPUs.
+	ResourceCores = "cores"
+	// ResourceRAM is the resource name of the memory in MiB.
+	ResourceRAM = "ram"
+	// ResourceVolumes is the resource name of the number of volumes.
+	ResourceVolumes = "volumes"
+	// ResourceFloatingIPs is the resource name of the number of floating IPs.
+	ResourceFloatingIPs = "floatingips"
+)
+
+// Usage is the usage of a quota of an OpenStack project.
+type Usage struct {
+	// Resource is the name of the resource.
+	Resource string
+	// Used is the amount of the resource which is in use.
+	Used int
+	// Limit is the quota of the resource. A negative value means unlimited.
+	Limit int
+}
+
+// CollectUsages returns the quota usages of the project the given client factory is scoped to in the given region.
+// The volume usage is omitted if the block storage service is not available.
+func CollectUsages(factory openstackclient.Factory, region string) ([]Usage, error) {
+	projectID, err := factory.ProjectID()
+	if err != nil {
+		return nil, fmt.Errorf("could not determine the OpenStack project: %w", err)
+	}
+
+	compute, err := factory.Compute(openstackclient.WithRegion(region))
+	if err != nil {
+		return nil, fmt.Errorf("could not create OpenStack compute client: %w", err)
+	}
+	limits, err := compute.GetLimits()
+	if err != nil {
+		return nil, fmt.Errorf("could not get compute limits: %w", err)
+	}
+	usages := []Usage{
+		{Resource: ResourceInstances, Used: limits.Absolute.TotalInstancesUsed, Limit: limits.Absolute.MaxTotalInstances},
+		{Resource: ResourceCores, Used: limits.Absolute.TotalCoresUsed, Limit: limits.Absolute.MaxTotalCores},
+		{Resource: ResourceRAM, Used: limits.Absolute.TotalRAMUsed, Limit: limits.Absolute.MaxTotalRAMSize},
+	}
+
+	blockStorage, err := factory.BlockStorage(openstackclient.WithRegion(region))
+	if err != nil && !openstackclient.IsEndpointNotFoundError(err) {
+		return nil, fmt.Errorf("could not create OpenStack block storage client: %w", err)
+	}
+	if err == nil {
+		volumeQuotas, err := blockStorage.GetQuotaUsage(projectID)
+		if err != nil {
+			return nil, fmt.Errorf("could not get block storage quotas: %w", err)
+		}
+		usages = append(usages, Usage{Resource: ResourceVolumes, Used: volumeQuotas.Volumes.InUse, Limit: volumeQuotas.Volumes.Limit})
+	}
+
+	networking, err := factory.Networking(openstackclient.WithRegion(region))
+	if err != nil {
+		return nil, fmt.Errorf("could not create OpenStack networking client: %w", err)
+	}
+	networkQuotas, err := networking.GetQuotaDetail(projectID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get networking quotas: %w", err)
+	}
+	usages = append(usages, Usage{Resource: ResourceFloatingIPs, Used: networkQuotas.FloatingIP.Used, Limit: networkQuotas.FloatingIP.Limit})
+
+	return usages, nil
+}
+
+// ExceededThreshold returns the highest of the given thresholds in percent which is reached by the given usage, or 0 if
+// none is reached. Unlimited resources and resources with a quota of 0 never reach a threshold.
+func ExceededThreshold(usage Usage, thresholds []int32) int32 {
+	if usage.Limit <= 0 {
+		return 0
+	}
+
+	sorted := append([]int32(nil), thresholds...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] > sorted[j] })
+	for _, threshold := range sorted {
+		if int64(usage.Used)*100 >= int64(threshold)*int64(usage.Limit) {
+			return threshold
+		}
+	}
+	return 0
+}
@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package quota_test
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/quotasets"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/limits"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/quotas"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	. "github.com/gardener/gardener-extension-provider-openstack/pkg/controller/quota"
+	mockopenstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client/mocks"
+)
+
+var _ = Describe("Usage", func() {
+	Describe("#CollectUsages", func() {
+		const (
+			region    = "eu-1"
+			projectID = "project"
+		)
+
+		var (
+			ctrl *gomock.Controller
+
+			factory      *mockopenstackclient.MockFactory
+			compute      *mockopenstackclient.MockCompute
+			blockStorage *mockopenstackclient.MockBlockStorage
+			networking   *mockopenstackclient.MockNetworking
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+
+			factory = mockopenstackclient.NewMockFactory(ctrl)
+			compute = mockopenstackclient.NewMockCompute(ctrl)
+			blockStorage = mockopenstackclient.NewMockBlockStorage(ctrl)
+			networking = mockopenstackclient.NewMockNetworking(ctrl)
+
+			factory.EXPECT().ProjectID().Return(projectID, nil)
+			factory.EXPECT().Compute(gomock.Any()).Return(compute, nil)
+			compute.EXPECT().GetLimits().Return(&limits.Limits{Absolute: limits.Absolute{
+				MaxTotalInstances:  10,
+				TotalInstancesUsed: 8,
+				MaxTotalCores:      40,
+				TotalCoresUsed:     32,
+				MaxTotalRAMSize:    -1,
+				TotalRAMUsed:       65536,
+			}}, nil)
+		})
+
+		It("should collect the usages of all services", func() {
+			factory.EXPECT().BlockStorage(gomock.Any()).Return(blockStorage, nil)
+			blockStorage.EXPECT().GetQuotaUsage(projectID).Return(&quotasets.QuotaUsageSet{Volumes: quotasets.QuotaUsage{InUse: 5, Limit: 20}}, nil)
+			factory.EXPECT().Networking(gomock.Any()).Return(networking, nil)
+			networking.EXPECT().GetQuotaDetail(projectID).Return(&quotas.QuotaDetailSet{FloatingIP: quotas.QuotaDetail{Used: 2, Limit: 2}}, nil)
+
+			Expect(CollectUsages(factory, region)).To(ConsistOf(
+				Usage{Resource: ResourceInstances, Used: 8, Limit: 10},
+				Usage{Resource: ResourceCores, Used: 32, Limit: 40},
+				Usage{Resource: ResourceRAM, Used: 65536, Limit: -1},
+				Usage{Resource: ResourceVolumes, Used: 5, Limit: 20},
+				Usage{Resource: ResourceFloatingIPs, Used: 2, Limit: 2},
+			))
+		})
+
+		It("should skip the volumes if the block storage service is not available", func() {
+			factory.EXPECT().BlockStorage(gomock.Any()).Return(nil, &gophercloud.ErrEndpointNotFound{})
+			factory.EXPECT().Networking(gomock.Any()).Return(networking, nil)
+			networking.EXPECT().GetQuotaDetail(projectID).Return(&quotas.QuotaDetailSet{FloatingIP: quotas.QuotaDetail{Used: 1, Limit: 2}}, nil)
+
+			usages, err := CollectUsages(factory, region)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(usages).To(HaveLen(4))
+			Expect(usages).NotTo(ContainElement(HaveField("Resource", ResourceVolumes)))
+		})
+
+		It("should fail if the quotas of a service cannot be retrieved", func() {
+			factory.EXPECT().BlockStorage(gomock.Any()).Return(blockStorage, nil)
+			blockStorage.EXPECT().GetQuotaUsage(projectID).Return(nil, fmt.Errorf("forbidden"))
+
+			_, err := CollectUsages(factory, region)
+			Expect(err).To(MatchError(ContainSubstring("could not get block storage quotas")))
+		})
+	})
+
+	DescribeTable("#ExceededThreshold",
+		func(used, limit int, expected int32) {
+			Expect(ExceededThreshold(Usage{Used: used, Limit: limit}, []int32{90, 80, 100})).To(Equal(expected))
+		},
+		Entry("below all thresholds", 7, 10, int32(0)),
+		Entry("at the lowest threshold", 8, 10, int32(80)),
+		Entry("between two thresholds", 95, 100, int32(90)),
+		Entry("at the limit", 10, 10, int32(100)),
+		Entry("unlimited", 1000, -1, int32(0)),
+		Entry("quota of 0", 0, 0, int32(0)),
+	)
+})
@@ -15,16 +15,21 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	gardener "github.com/gardener/gardener/pkg/client/kubernetes"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/helper"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/circuitbreaker"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
 	openstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
 )
 
@@ -33,26 +38,37 @@ type delegateFactory struct {
 	restConfig   *rest.Config
 	scheme       *runtime.Scheme
 	gardenReader client.Reader
+	recorder     record.EventRecorder
+
+	machineClassExtraAllowedKeys []string
 }
 
 // NewActuator creates a new Actuator that updates the status of the handled WorkerPoolConfigs.
-func NewActuator(mgr manager.Manager, gardenCluster cluster.Cluster) worker.Actuator {
+func NewActuator(mgr manager.Manager, gardenCluster cluster.Cluster, machineClassExtraAllowedKeys []string) worker.Actuator {
 	var (
 		workerDelegate = &delegateFactory{
 			seedClient: mgr.GetClient(),
 			restConfig: mgr.GetConfig(),
 			scheme:     mgr.GetScheme(),
+			recorder:   mgr.GetEventRecorderFor(openstack.Name + "-worker-controller"),
+
+			machineClassExtraAllowedKeys: machineClassExtraAllowedKeys,
 		}
 	)
 
-	return genericactuator.NewActuator(
-		mgr,
-		gardenCluster,
-		workerDelegate,
-		func(err error) []gardencorev1beta1.ErrorCode {
-			return util.DetermineErrorCodes(err, helper.KnownCodes)
-		},
-	)
+	return &auditingActuator{
+		Actuator: genericactuator.NewActuator(
+			mgr,
+			gardenCluster,
+			workerDelegate,
+			func(err error) []gardencorev1beta1.ErrorCode {
+				return util.DetermineErrorCodes(err, helper.KnownCodes)
+			},
+		),
+		client:          mgr.GetClient(),
+		delegateFactory: workerDelegate,
+		circuitBreaker:  circuitbreaker.Default,
+	}
 }
 
 func (d *delegateFactory) WorkerDelegate(ctx context.Context, worker *extensionsv1alpha1.Worker, cluster *extensionscontroller.Cluster) (genericactuator.WorkerDelegate, error) {
@@ -81,7 +97,12 @@ func (d *delegateFactory) WorkerDelegate(ctx context.Context, worker *extensions
 		return nil, err
 	}
 
-	openstackClient, err := openstackclient.NewOpenStackClientFromSecretRef(ctx, d.seedClient, worker.Spec.SecretRef, &keyStoneURL)
+	secretRef, err := openstack.ShootCredentialsSecretRef(ctx, d.seedClient, worker.Spec.SecretRef)
+	if err != nil {
+		return nil, err
+	}
+
+	openstackClient, err := openstackclient.NewOpenStackClientFromSecretRef(ctx, d.seedClient, secretRef, &keyStoneURL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create openstack seedClient: %w", err)
 	}
@@ -96,6 +117,8 @@ func (d *delegateFactory) WorkerDelegate(ctx context.Context, worker *extensions
 		worker,
 		cluster,
 		openstackClient,
+		d.recorder,
+		d.machineClassExtraAllowedKeys,
 	)
 }
 
@@ -114,8 +137,22 @@ type workerDelegate struct {
 	machineClasses     []map[string]interface{}
 	machineDeployments worker.MachineDeployments
 	machineImages      []api.MachineImage
+	workerPools        []api.WorkerPoolStatus
+
+	existingMachineDeploymentsByName map[string]*machinev1alpha1.MachineDeployment
+	flavorsByName                    map[string]flavors.Flavor
+	flavorExtraSpecs                 map[string]map[string]string
+	pendingCanaryRollouts            []pendingRollout
+	pendingZoneRollouts              []pendingRollout
+	previouslyVerifiedImages         []api.VerifiedImage
+	verifiedImages                   []api.VerifiedImage
+	pendingImageVerifications        []pendingImageVerification
 
 	openstackClient openstackclient.Factory
+	recorder        record.EventRecorder
+	naming          MachineDeploymentNaming
+
+	machineClassExtraAllowedKeys []string
 }
 
 // NewWorkerDelegate creates a new context for a worker reconciliation.
@@ -129,6 +166,8 @@ func NewWorkerDelegate(
 	worker *extensionsv1alpha1.Worker,
 	cluster *extensionscontroller.Cluster,
 	openstackClient openstackclient.Factory,
+	recorder record.EventRecorder,
+	machineClassExtraAllowedKeys []string,
 ) (genericactuator.WorkerDelegate, error) {
 	config, err := helper.CloudProfileConfigFromCluster(cluster)
 	if err != nil {
@@ -147,5 +186,9 @@ func NewWorkerDelegate(
 		cluster:            cluster,
 		worker:             worker,
 		openstackClient:    openstackClient,
+		recorder:           recorder,
+		naming:             Naming,
+
+		machineClassExtraAllowedKeys: machineClassExtraAllowedKeys,
 	}, nil
 }
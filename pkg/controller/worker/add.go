@@ -31,6 +31,9 @@ type AddOptions struct {
 	IgnoreOperationAnnotation bool
 	// GardenCluster is the garden cluster object.
 	GardenCluster cluster.Cluster
+	// MachineClassExtraAllowedKeys are the keys of the provider spec of the machine classes which may be set in the
+	// MachineClassExtra of the WorkerConfig.
+	MachineClassExtraAllowedKeys []string
 }
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
@@ -45,7 +48,7 @@ func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, opts AddO
 	}
 
 	return worker.Add(ctx, mgr, worker.AddArgs{
-		Actuator:          NewActuator(mgr, opts.GardenCluster),
+		Actuator:          NewActuator(mgr, opts.GardenCluster, opts.MachineClassExtraAllowedKeys),
 		ControllerOptions: opts.Controller,
 		Predicates:        worker.DefaultPredicates(ctx, mgr, opts.IgnoreOperationAnnotation),
 		Type:              openstack.Type,
@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	"github.com/gardener/gardener/extensions/pkg/controller/worker"
+	"github.com/gardener/gardener/extensions/pkg/controller/worker/genericactuator"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/audit"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/circuitbreaker"
+)
+
+// auditingActuator only audits the workers of shoots in audit mode instead of reconciling them. The reconciliations
+// are paused while the circuit breaker of the region of the worker is open, and their results are recorded in it.
+type auditingActuator struct {
+	worker.Actuator
+
+	client          client.Client
+	delegateFactory genericactuator.DelegateFactory
+	circuitBreaker  *circuitbreaker.Breaker
+}
+
+// Reconcile reconciles the given worker, or compares the desired with the existing machine deployments and reports the
+// differences in the audit condition of the worker if the audit mode is enabled for the shoot.
+func (a *auditingActuator) Reconcile(ctx context.Context, log logr.Logger, w *extensionsv1alpha1.Worker, cluster *extensionscontroller.Cluster) error {
+	authURL, err := circuitbreaker.KeystoneURL(ctx, a.client, w.Spec.SecretRef, cluster, w.Spec.Region)
+	if err != nil {
+		return err
+	}
+	if err := a.circuitBreaker.Guard(ctx, a.client, w, authURL, w.Spec.Region); err != nil {
+		return err
+	}
+
+	if !audit.IsEnabled(cluster) {
+		err := a.Actuator.Reconcile(ctx, log, w, cluster)
+		a.circuitBreaker.Record(authURL, w.Spec.Region, err)
+		return err
+	}
+	log.Info("Auditing worker, no changes are applied in audit mode")
+
+	differences, err := a.audit(ctx, w, cluster)
+	if err != nil {
+		log.Error(err, "Worker could not be audited")
+	} else {
+		log.Info("Worker audited", "differences", differences)
+	}
+	return audit.ReportCondition(ctx, a.client, clock.RealClock{}, w, differences, err)
+}
+
+func (a *auditingActuator) audit(ctx context.Context, w *extensionsv1alpha1.Worker, cluster *extensionscontroller.Cluster) ([]string, error) {
+	delegate, err := a.delegateFactory.WorkerDelegate(ctx, w, cluster)
+	if err != nil {
+		return nil, err
+	}
+	desired, err := delegate.GenerateMachineDeployments(ctx)
+	if err != nil {
+		return nil, err
+	}
+	existing := &machinev1alpha1.MachineDeploymentList{}
+	if err := a.client.List(ctx, existing, client.InNamespace(w.Namespace)); err != nil {
+		return nil, err
+	}
+	return diffMachineDeployments(desired, existing.Items, extensionscontroller.IsHibernationEnabled(cluster)), nil
+}
+
+// diffMachineDeployments returns the differences between the desired and the existing machine deployments. The
+// replicas of an existing machine deployment differ if they are not within the range of the desired machine deployment,
+// or not zero if the shoot is hibernated.
+func diffMachineDeployments(desired worker.MachineDeployments, existing []machinev1alpha1.MachineDeployment, hibernated bool) []string {
+	existingByName := map[string]*machinev1alpha1.MachineDeployment{}
+	for i := range existing {
+		existingByName[existing[i].Name] = &existing[i]
+	}
+
+	var (
+		differences  []string
+		desiredNames = sets.New[string]()
+	)
+	for _, deployment := range desired {
+		desiredNames.Insert(deployment.Name)
+		current, ok := existingByName[deployment.Name]
+		if !ok {
+			differences = append(differences, fmt.Sprintf("machine deployment %s is missing", deployment.Name))
+			continue
+		}
+		if className := current.Spec.Template.Spec.Class.Name; className != deployment.ClassName {
+			differences = append(differences, fmt.Sprintf("machine deployment %s uses machine class %s instead of %s", deployment.Name, className, deployment.ClassName))
+		}
+		minimum, maximum := deployment.Minimum, deployment.Maximum
+		if hibernated {
+			minimum, maximum = 0, 0
+		}
+		if replicas := current.Spec.Replicas; replicas < minimum || replicas > maximum {
+			differences = append(differences, fmt.Sprintf("machine deployment %s has %d replicas instead of %d to %d", deployment.Name, replicas, minimum, maximum))
+		}
+	}
+	for _, current := range existing {
+		if !desiredNames.Has(current.Name) {
+			differences = append(differences, fmt.Sprintf("machine deployment %s is not desired anymore", current.Name))
+		}
+	}
+	return differences
+}
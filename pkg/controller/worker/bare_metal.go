@@ -0,0 +1,172 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/gophercloud/gophercloud/openstack/baremetal/v1/nodes"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/pointer"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	osclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
+)
+
+const (
+	// flavorExtraSpecCustomResourcePrefix is the prefix of the flavor extra specs requesting a custom resource class
+	// from the Placement service. Bare metal flavors request the resource class of the Ironic nodes this way.
+	flavorExtraSpecCustomResourcePrefix = flavorExtraSpecResourcesPrefix + "CUSTOM_"
+	// flavorExtraSpecCapabilityPrefix is the prefix of the flavor extra specs requesting a capability of the Ironic
+	// nodes the servers of the flavor are placed on.
+	flavorExtraSpecCapabilityPrefix = "capabilities:"
+
+	// bareMetalMachineCreationTimeout is the default creation timeout of machines of bare metal flavors. Deploying an
+	// Ironic node includes cleaning and (re)booting the hardware, which takes considerably longer than booting a VM.
+	bareMetalMachineCreationTimeout = 60 * time.Minute
+	// bareMetalMachineHealthTimeout is the default health timeout of machines of bare metal flavors, which takes the
+	// longer reboot of the hardware into account.
+	bareMetalMachineHealthTimeout = 30 * time.Minute
+
+	// eventReasonServerGroupIgnored is the reason of the events recorded on the worker for server groups that are
+	// ignored for worker pools of bare metal flavors.
+	eventReasonServerGroupIgnored = "ServerGroupIgnored"
+)
+
+// isBareMetalFlavor returns whether the flavor with the given extra specs is a bare metal flavor, i.e. whether it
+// requests a custom resource class of Ironic nodes instead of the standard resource classes of hypervisors.
+func isBareMetalFlavor(extraSpecs map[string]string) bool {
+	requestsCustomResourceClass := false
+	for key, value := range extraSpecs {
+		if strings.HasPrefix(key, flavorExtraSpecCustomResourcePrefix) && value != "0" {
+			requestsCustomResourceClass = true
+			break
+		}
+	}
+	return requestsCustomResourceClass &&
+		extraSpecs[flavorExtraSpecResourcesPrefix+resourceClassVCPU] == "0" &&
+		extraSpecs[flavorExtraSpecResourcesPrefix+resourceClassMemoryMB] == "0"
+}
+
+// isBareMetalPool returns whether the machine type of the given worker pool is a bare metal flavor.
+func (w *workerDelegate) isBareMetalPool(pool extensionsv1alpha1.WorkerPool) (bool, error) {
+	if w.openstackClient == nil {
+		return false, nil
+	}
+
+	flavor, extraSpecs, err := w.lookupFlavor(pool.MachineType)
+	if err != nil || flavor == nil {
+		return false, err
+	}
+	return isBareMetalFlavor(extraSpecs), nil
+}
+
+// poolRequiresServerGroup returns whether a server group has to be managed for the given worker pool. Server groups are
+// ignored for pools of bare metal flavors as Nova cannot apply their (anti-)affinity policies to Ironic nodes, which
+// are all managed by the same compute service.
+func (w *workerDelegate) poolRequiresServerGroup(pool extensionsv1alpha1.WorkerPool, config *api.WorkerConfig) (bool, error) {
+	if !isServerGroupRequired(config) {
+		return false, nil
+	}
+
+	bareMetal, err := w.isBareMetalPool(pool)
+	if err != nil {
+		return false, err
+	}
+	return !bareMetal, nil
+}
+
+// applyBareMetalMachineConfiguration defaults the creation and health timeouts of the given machine configuration for
+// pools of bare metal flavors unless they are configured explicitly.
+func applyBareMetalMachineConfiguration(machineConfiguration *machinev1alpha1.MachineConfiguration) *machinev1alpha1.MachineConfiguration {
+	if machineConfiguration == nil {
+		machineConfiguration = &machinev1alpha1.MachineConfiguration{}
+	}
+	if machineConfiguration.MachineCreationTimeout == nil {
+		machineConfiguration.MachineCreationTimeout = &metav1.Duration{Duration: bareMetalMachineCreationTimeout}
+	}
+	if machineConfiguration.MachineHealthTimeout == nil {
+		machineConfiguration.MachineHealthTimeout = &metav1.Duration{Duration: bareMetalMachineHealthTimeout}
+	}
+	return machineConfiguration
+}
+
+// ignoresAvailabilityZones returns whether the servers of the worker pool are created without an availability zone.
+func ignoresAvailabilityZones(workerConfig *api.WorkerConfig) bool {
+	return workerConfig.BareMetal != nil && pointer.BoolDeref(workerConfig.BareMetal.IgnoreAvailabilityZones, false)
+}
+
+// validateBareMetalCapabilities validates that the flavor of the given worker pool requests the capabilities of the
+// Ironic nodes configured for the pool. Nova only places servers on Ironic nodes with the capabilities requested by the
+// `capabilities:<name>` extra specs of their flavor, hence the capabilities cannot be requested per server.
+func (w *workerDelegate) validateBareMetalCapabilities(pool extensionsv1alpha1.WorkerPool, workerConfig *api.WorkerConfig) error {
+	if w.openstackClient == nil || workerConfig.BareMetal == nil || len(workerConfig.BareMetal.Capabilities) == 0 {
+		return nil
+	}
+
+	flavor, extraSpecs, err := w.lookupFlavor(pool.MachineType)
+	if err != nil {
+		return fmt.Errorf("could not look up flavor %q: %w", pool.MachineType, err)
+	}
+	if flavor == nil {
+		return fmt.Errorf("flavor %q not found", pool.MachineType)
+	}
+
+	var missing []string
+	for _, name := range sets.List(sets.KeySet(workerConfig.BareMetal.Capabilities)) {
+		if value := workerConfig.BareMetal.Capabilities[name]; extraSpecs[flavorExtraSpecCapabilityPrefix+name] != value {
+			missing = append(missing, name+"="+value)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("flavor %q does not request the capabilities %s of the Ironic nodes", pool.MachineType, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// bareMetalHashData returns the bare metal configuration of the WorkerConfig for the worker pool hash, as it only
+// affects where new servers are scheduled to. The capabilities are not included, as they are requested by the flavor.
+func bareMetalHashData(workerConfig *api.WorkerConfig) []string {
+	if workerConfig.BareMetal == nil {
+		return nil
+	}
+
+	var data []string
+	if workerConfig.BareMetal.IgnoreAvailabilityZones != nil {
+		data = append(data, "bareMetalIgnoreAvailabilityZones="+strconv.FormatBool(*workerConfig.BareMetal.IgnoreAvailabilityZones))
+	}
+	return data
+}
+
+// bareMetalClient returns a client of the Ironic service or nil if the cloud does not expose the Ironic API.
+func (w *workerDelegate) bareMetalClient() (osclient.BareMetal, error) {
+	bareMetalClient, err := w.openstackClient.BareMetal(osclient.WithRegion(w.worker.Spec.Region))
+	if err != nil {
+		if osclient.IsEndpointNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return bareMetalClient, nil
+}
+
+// findServerNode returns the Ironic node the server with the given id is deployed to or nil if it is not a bare metal
+// server.
+func findServerNode(bareMetalClient osclient.BareMetal, serverID string) (*nodes.Node, error) {
+	list, err := bareMetalClient.ListNodes(nodes.ListOpts{InstanceUUID: serverID})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return &list[0], nil
+}
@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/worker"
+	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+)
+
+// rolloutRequeueInterval is the interval in which a worker with a pending canary or zone rollout is reconciled again.
+const rolloutRequeueInterval = time.Minute
+
+// pendingRollout describes a worker pool whose rollout is held back for some of its zones.
+type pendingRollout struct {
+	poolName string
+	reason   string
+	// requeueAfter is the duration after which the rollout should be re-evaluated.
+	requeueAfter time.Duration
+}
+
+// applyCanaryRollout holds back the rollout of the non-canary zones of the given pool as long as the machine
+// deployment of the canary zone has not been updated completely or has not been healthy for the configured soak time.
+// Held back machine deployments keep referring to their current machine class.
+func (w *workerDelegate) applyCanaryRollout(ctx context.Context, poolName string, zones []string, canary *api.CanaryRollout, deployments worker.MachineDeployments) error {
+	canaryZone := pointer.StringDeref(canary.Zone, zones[0])
+	canaryIndex := -1
+	for i, zone := range zones {
+		if zone == canaryZone {
+			canaryIndex = i
+		}
+	}
+	if canaryIndex < 0 {
+		return fmt.Errorf("canary zone %q is not a zone of worker pool %q", canaryZone, poolName)
+	}
+
+	if canary.Machines != nil {
+		deployments[canaryIndex].MaxSurge = intstr.FromInt(int(*canary.Machines))
+		deployments[canaryIndex].MaxUnavailable = intstr.FromInt(0)
+	}
+
+	existing, err := w.existingMachineDeployments(ctx)
+	if err != nil {
+		return err
+	}
+
+	canaryDeployment, ok := existing[deployments[canaryIndex].Name]
+	if !ok {
+		// the worker pool (or its canary zone) is new, there is nothing to roll
+		return nil
+	}
+
+	reason, requeueAfter := rolloutProgress("canary machine deployment", canaryDeployment, deployments[canaryIndex].ClassName, canary.SoakTime)
+	if reason == "" {
+		return nil
+	}
+
+	heldBack := false
+	for i := range deployments {
+		if i != canaryIndex && keepCurrentMachineClass(&deployments[i], existing) {
+			heldBack = true
+		}
+	}
+
+	if heldBack {
+		w.pendingCanaryRollouts = append(w.pendingCanaryRollouts, pendingRollout{
+			poolName:     poolName,
+			reason:       reason,
+			requeueAfter: requeueAfter,
+		})
+	}
+	return nil
+}
+
+// keepCurrentMachineClass lets the given machine deployment keep referring to the machine class of its existing
+// counterpart, if any. It returns true if the rollout of the machine deployment has been held back by this.
+func keepCurrentMachineClass(deployment *worker.MachineDeployment, existing map[string]*machinev1alpha1.MachineDeployment) bool {
+	current, ok := existing[deployment.Name]
+	if !ok || current.Spec.Template.Spec.Class.Name == deployment.ClassName {
+		return false
+	}
+	deployment.ClassName = current.Spec.Template.Spec.Class.Name
+	deployment.SecretName = current.Spec.Template.Spec.Class.Name
+	return true
+}
+
+// rolloutProgress checks whether the given machine deployment has been rolled to the wanted machine class and stayed
+// available for the given duration. It returns the reason why the rollout of the remaining zones must still be held
+// back (empty if the rollout can proceed) and the duration after which the progress should be checked again. The
+// reason refers to the machine deployment by the given description.
+func rolloutProgress(description string, deployment *machinev1alpha1.MachineDeployment, wantedClassName string, healthyFor *metav1.Duration) (string, time.Duration) {
+	if deployment.Spec.Template.Spec.Class.Name != wantedClassName {
+		return fmt.Sprintf("%s %q is not yet updated", description, deployment.Name), rolloutRequeueInterval
+	}
+
+	status := deployment.Status
+	if deployment.Generation > status.ObservedGeneration ||
+		status.UpdatedReplicas != deployment.Spec.Replicas ||
+		status.Replicas != deployment.Spec.Replicas ||
+		status.AvailableReplicas != deployment.Spec.Replicas {
+		return fmt.Sprintf("%s %q is rolling or has unavailable machines", description, deployment.Name), rolloutRequeueInterval
+	}
+
+	if healthyFor == nil || healthyFor.Duration == 0 {
+		return "", 0
+	}
+
+	var availableSince time.Time
+	for _, condition := range status.Conditions {
+		if condition.Type == machinev1alpha1.MachineDeploymentProgressing {
+			availableSince = condition.LastUpdateTime.Time
+		}
+	}
+	if remaining := availableSince.Add(healthyFor.Duration).Sub(time.Now()); remaining > 0 {
+		return fmt.Sprintf("%s %q must stay healthy for another %s", description, deployment.Name, remaining.Round(time.Second)), remaining
+	}
+	return "", 0
+}
+
+func (w *workerDelegate) existingMachineDeployments(ctx context.Context) (map[string]*machinev1alpha1.MachineDeployment, error) {
+	if w.existingMachineDeploymentsByName != nil {
+		return w.existingMachineDeploymentsByName, nil
+	}
+
+	list := &machinev1alpha1.MachineDeploymentList{}
+	if err := w.seedClient.List(ctx, list, client.InNamespace(w.worker.Namespace)); err != nil {
+		return nil, err
+	}
+
+	w.existingMachineDeploymentsByName = make(map[string]*machinev1alpha1.MachineDeployment, len(list.Items))
+	for i := range list.Items {
+		w.existingMachineDeploymentsByName[list.Items[i].Name] = &list.Items[i]
+	}
+	return w.existingMachineDeploymentsByName, nil
+}
+
+// checkPendingCanaryRollouts returns an error requeueing the worker if the rollout of any worker pool is held back.
+func (w *workerDelegate) checkPendingCanaryRollouts() error {
+	return pendingRolloutsError("canary rollout", w.pendingCanaryRollouts)
+}
+
+// pendingRolloutsError returns an error requeueing the worker after the shortest requeue duration of the given pending
+// rollouts, or nil if there are none.
+func pendingRolloutsError(kind string, pendingRollouts []pendingRollout) error {
+	if len(pendingRollouts) == 0 {
+		return nil
+	}
+
+	requeueAfter := pendingRollouts[0].requeueAfter
+	var messages []string
+	for _, pending := range pendingRollouts {
+		messages = append(messages, fmt.Sprintf("worker pool %q: %s", pending.poolName, pending.reason))
+		if pending.requeueAfter < requeueAfter {
+			requeueAfter = pending.requeueAfter
+		}
+	}
+
+	return &reconcilerutils.RequeueAfterError{
+		Cause:        fmt.Errorf("%s in progress: %s", kind, strings.Join(messages, "; ")),
+		RequeueAfter: requeueAfter,
+	}
+}
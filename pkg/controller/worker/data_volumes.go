@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/pointer"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+)
+
+// dataVolumesMachineClassSpec returns the data volumes of the WorkerConfig for the spec of a machine class of the given
+// server availability zone. Their sizes are given in GiB like the size of the root disk.
+func dataVolumesMachineClassSpec(dataVolumes []api.DataVolume, serverZone string) []map[string]interface{} {
+	var result []map[string]interface{}
+	for _, dataVolume := range dataVolumes {
+		volume := map[string]interface{}{
+			"name":                dataVolume.Name,
+			"size":                int(dataVolume.Size.Value() / gibibyte),
+			"deleteOnTermination": pointer.BoolDeref(dataVolume.DeleteOnTermination, true),
+		}
+		if dataVolume.Type != nil {
+			volume["type"] = *dataVolume.Type
+		}
+		if pointer.BoolDeref(dataVolume.InheritAvailabilityZone, true) && serverZone != "" {
+			volume["availabilityZone"] = serverZone
+		}
+		result = append(result, volume)
+	}
+	return result
+}
+
+// dataVolumesHashData returns the data volumes of the WorkerConfig for the worker pool hash, as volumes are only
+// attached to machines when they are created.
+func dataVolumesHashData(dataVolumes []api.DataVolume) []string {
+	var data []string
+	for _, dataVolume := range dataVolumes {
+		data = append(data, "dataVolume="+dataVolume.Name, "dataVolumeSize="+dataVolume.Size.String())
+		if dataVolume.Type != nil {
+			data = append(data, "dataVolumeType="+*dataVolume.Type)
+		}
+		if dataVolume.InheritAvailabilityZone != nil {
+			data = append(data, "dataVolumeInheritAvailabilityZone="+strconv.FormatBool(*dataVolume.InheritAvailabilityZone))
+		}
+		if dataVolume.DeleteOnTermination != nil {
+			data = append(data, "dataVolumeDeleteOnTermination="+strconv.FormatBool(*dataVolume.DeleteOnTermination))
+		}
+	}
+	return data
+}
+
+// dataVolumesNodeTemplateCapacity returns a copy of the given node template capacity whose ephemeral storage includes
+// the data volumes, so that the cluster-autoscaler takes them into account when scaling up for pods requesting
+// ephemeral storage. It returns the given capacity if the worker pool has no data volumes or the capacity is unknown.
+func dataVolumesNodeTemplateCapacity(capacity corev1.ResourceList, dataVolumes []api.DataVolume) corev1.ResourceList {
+	if len(dataVolumes) == 0 || capacity == nil {
+		return capacity
+	}
+
+	ephemeralStorage := capacity[corev1.ResourceEphemeralStorage].DeepCopy()
+	for _, dataVolume := range dataVolumes {
+		ephemeralStorage.Add(dataVolume.Size)
+	}
+
+	result := capacity.DeepCopy()
+	result[corev1.ResourceEphemeralStorage] = ephemeralStorage
+	return result
+}
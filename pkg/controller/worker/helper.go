@@ -8,30 +8,25 @@ import (
 	"context"
 	"fmt"
 
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/helper"
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/v1alpha1"
 )
 
 func (w *workerDelegate) decodeWorkerProviderStatus() (*api.WorkerStatus, error) {
-	workerStatus := &api.WorkerStatus{}
-
-	if w.worker.Status.ProviderStatus == nil {
-		return workerStatus, nil
-	}
-
-	marshalled, err := w.worker.Status.GetProviderStatus().MarshalJSON()
+	workerStatus, err := helper.WorkerStatusFromRaw(w.worker.Status.ProviderStatus)
 	if err != nil {
-		return nil, err
-	}
-	if _, _, err := w.decoder.Decode(marshalled, nil, workerStatus); err != nil {
 		return nil, fmt.Errorf("could not decode WorkerStatus %q: %w", kutil.ObjectName(w.worker), err)
 	}
-
 	return workerStatus, nil
 }
 
@@ -47,9 +42,43 @@ func (w *workerDelegate) updateWorkerProviderStatus(ctx context.Context, workerS
 		return err
 	}
 
-	patch := client.MergeFrom(w.worker.DeepCopy())
-	w.worker.Status.ProviderStatus = &runtime.RawExtension{Object: workerStatusV1alpha1}
-	return w.seedClient.Status().Patch(ctx, w.worker, patch)
+	return w.patchStatus(ctx, func(status *extensionsv1alpha1.WorkerStatus) (bool, error) {
+		if status.ProviderStatus != nil {
+			current, err := w.decodeWorkerProviderStatus()
+			if err != nil {
+				return false, err
+			}
+			if equality.Semantic.DeepEqual(current, workerStatus) {
+				return false, nil
+			}
+		}
+		status.ProviderStatus = &runtime.RawExtension{Object: workerStatusV1alpha1.DeepCopy()}
+		return true, nil
+	})
+}
+
+// patchStatus applies the given mutation to the status of the worker and patches it with optimistic locking. The
+// mutation returns whether it changed the status, the patch is skipped otherwise. The status writers of a reconciliation
+// write the same status again and again for large shoots, hence unchanged statuses are not sent at all. On conflicts,
+// the worker is read again and the mutation is applied to the latest status.
+func (w *workerDelegate) patchStatus(ctx context.Context, mutate func(status *extensionsv1alpha1.WorkerStatus) (bool, error)) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		patch := client.MergeFromWithOptions(w.worker.DeepCopy(), client.MergeFromWithOptimisticLock{})
+		changed, err := mutate(&w.worker.Status)
+		if err != nil || !changed {
+			return err
+		}
+
+		if err := w.seedClient.Status().Patch(ctx, w.worker, patch); err != nil {
+			if apierrors.IsConflict(err) {
+				if getErr := w.seedClient.Get(ctx, client.ObjectKeyFromObject(w.worker), w.worker); getErr != nil {
+					return getErr
+				}
+			}
+			return err
+		}
+		return nil
+	})
 }
 
 func (w *workerDelegate) updateMachineDependenciesStatus(ctx context.Context, workerStatus *api.WorkerStatus, serverGroupDependencies []api.ServerGroupDependency, err error) error {
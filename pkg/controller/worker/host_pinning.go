@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"fmt"
+
+	"k8s.io/utils/pointer"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/features"
+)
+
+// serverAvailabilityZones returns the availability zones of the servers of the zones of a worker pool which are pinned
+// to hypervisor hosts or nodes, in the `zone:host:node` syntax of Nova. They are only applied if the HostPinning feature
+// gate is enabled.
+func serverAvailabilityZones(workerConfig *api.WorkerConfig) (map[string]string, error) {
+	if len(workerConfig.HostPinning) == 0 {
+		return nil, nil
+	}
+	if !features.ExtensionFeatureGate.Enabled(features.HostPinning) {
+		return nil, fmt.Errorf("hostPinning requires the %s feature gate", features.HostPinning)
+	}
+
+	zones := make(map[string]string, len(workerConfig.HostPinning))
+	for _, pinning := range workerConfig.HostPinning {
+		zone := pinning.Zone + ":" + pointer.StringDeref(pinning.Host, "")
+		if pinning.Node != nil {
+			zone += ":" + *pinning.Node
+		}
+		zones[pinning.Zone] = zone
+	}
+	return zones, nil
+}
+
+// hostPinningHashData returns the pinned availability zones of the servers of a worker pool for the worker pool hash, as
+// the machines must be recreated to move them to other hosts.
+func hostPinningHashData(workerConfig *api.WorkerConfig) []string {
+	var data []string
+	for _, pinning := range workerConfig.HostPinning {
+		data = append(data, "hostPinning="+pinning.Zone+":"+pointer.StringDeref(pinning.Host, "")+":"+pointer.StringDeref(pinning.Node, ""))
+	}
+	return data
+}
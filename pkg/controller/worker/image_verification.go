@@ -0,0 +1,167 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/worker"
+	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/images"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	openstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
+)
+
+const (
+	// imageVerificationRequeueInterval is the interval in which a worker with a rollout held back because of an
+	// unverified image is reconciled again.
+	imageVerificationRequeueInterval = time.Minute
+
+	imageStatusActive = "ACTIVE"
+)
+
+// pendingImageVerification describes a worker pool whose rollout is held back as its image could not be verified.
+type pendingImageVerification struct {
+	poolName string
+	reason   string
+}
+
+// gateRolloutOnImage holds back the rollout of the given machine deployments of a worker pool as long as the image of
+// the pool has not been verified to exist in the region of the worker. Held back machine deployments keep referring to
+// their current machine class. New machine deployments are not held back, as there is nothing to roll. It returns
+// true if the rollout of the pool is held back.
+func (w *workerDelegate) gateRolloutOnImage(ctx context.Context, poolName string, machineImage *api.MachineImage, deployments worker.MachineDeployments) (bool, error) {
+	if w.isImageVerified(machineImage) {
+		return false, nil
+	}
+
+	existing, err := w.existingMachineDeployments(ctx)
+	if err != nil {
+		return false, err
+	}
+	rolling := false
+	for _, deployment := range deployments {
+		if current, ok := existing[deployment.Name]; ok && current.Spec.Template.Spec.Class.Name != deployment.ClassName {
+			rolling = true
+		}
+	}
+	if !rolling {
+		return false, nil
+	}
+
+	reason, err := w.verifyImage(machineImage)
+	if err != nil {
+		return false, fmt.Errorf("could not verify image of worker pool %q: %w", poolName, err)
+	}
+	if reason == "" {
+		return false, nil
+	}
+
+	for i := range deployments {
+		if current, ok := existing[deployments[i].Name]; ok {
+			deployments[i].ClassName = current.Spec.Template.Spec.Class.Name
+			deployments[i].SecretName = current.Spec.Template.Spec.Class.Name
+		}
+	}
+	w.pendingImageVerifications = append(w.pendingImageVerifications, pendingImageVerification{
+		poolName: poolName,
+		reason:   reason,
+	})
+	return true, nil
+}
+
+// isImageVerified returns true if the image of the given machine image has already been verified to exist in the
+// region of the worker, either by this reconciliation or by a previous one according to the worker status. Images
+// verified by a previous reconciliation are kept in the verified images of this reconciliation.
+func (w *workerDelegate) isImageVerified(machineImage *api.MachineImage) bool {
+	if findVerifiedImage(w.verifiedImages, w.worker.Spec.Region, machineImage) != nil {
+		return true
+	}
+	if verified := findVerifiedImage(w.previouslyVerifiedImages, w.worker.Spec.Region, machineImage); verified != nil {
+		w.verifiedImages = append(w.verifiedImages, *verified)
+		return true
+	}
+	return false
+}
+
+// verifyImage looks up the image of the given machine image in the region of the worker. It returns the reason why the
+// image cannot be used (empty if the image exists and is active).
+func (w *workerDelegate) verifyImage(machineImage *api.MachineImage) (string, error) {
+	region := w.worker.Spec.Region
+	computeClient, err := w.openstackClient.Compute(openstackclient.WithRegion(region))
+	if err != nil {
+		return "", err
+	}
+
+	var (
+		image     *images.Image
+		reference = machineImage.ID
+	)
+	if machineImage.ID != "" {
+		if image, err = computeClient.GetImage(machineImage.ID); err != nil {
+			return "", err
+		}
+	} else {
+		reference = machineImage.Image
+		imageList, err := computeClient.FindImages(machineImage.Image)
+		if err != nil {
+			return "", err
+		}
+		for i := range imageList {
+			if image == nil || imageList[i].Status == imageStatusActive {
+				image = &imageList[i]
+			}
+		}
+	}
+
+	if image == nil {
+		return fmt.Sprintf("image %q does not exist in region %q", reference, region), nil
+	}
+	if image.Status != imageStatusActive {
+		return fmt.Sprintf("image %q is not active in region %q, but %s", reference, region, strings.ToLower(image.Status)), nil
+	}
+
+	w.verifiedImages = append(w.verifiedImages, api.VerifiedImage{
+		Region: region,
+		Image:  machineImage.Image,
+		ID:     image.ID,
+	})
+	return "", nil
+}
+
+// checkPendingImageVerifications returns an error requeueing the worker if the rollout of any worker pool is held back
+// because of an unverified image.
+func (w *workerDelegate) checkPendingImageVerifications() error {
+	if len(w.pendingImageVerifications) == 0 {
+		return nil
+	}
+
+	var messages []string
+	for _, pending := range w.pendingImageVerifications {
+		messages = append(messages, fmt.Sprintf("worker pool %q: %s", pending.poolName, pending.reason))
+	}
+
+	return &reconcilerutils.RequeueAfterError{
+		Cause:        fmt.Errorf("rollout held back until the machine image is available: %s", strings.Join(messages, "; ")),
+		RequeueAfter: imageVerificationRequeueInterval,
+	}
+}
+
+func findVerifiedImage(verifiedImages []api.VerifiedImage, region string, machineImage *api.MachineImage) *api.VerifiedImage {
+	for i, verified := range verifiedImages {
+		if verified.Region != region {
+			continue
+		}
+		if (machineImage.ID != "" && verified.ID == machineImage.ID) ||
+			(machineImage.ID == "" && machineImage.Image != "" && verified.Image == machineImage.Image) {
+			return &verifiedImages[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/features"
+)
+
+// machineClassExtra returns the extra values merged into the provider spec of the machine classes of a worker pool.
+// They are only applied if the MachineClassExtra feature gate is enabled and all their keys are allowed in the
+// configuration of the extension, so that operators control which fields of the machine-controller-manager provider
+// shoot owners may set.
+func (w *workerDelegate) machineClassExtra(workerConfig *api.WorkerConfig) (map[string]interface{}, error) {
+	if len(workerConfig.MachineClassExtra) == 0 {
+		return nil, nil
+	}
+	if !features.ExtensionFeatureGate.Enabled(features.MachineClassExtra) {
+		return nil, fmt.Errorf("machineClassExtra requires the %s feature gate", features.MachineClassExtra)
+	}
+
+	var (
+		allowed  = sets.New(w.machineClassExtraAllowedKeys...)
+		reserved = sets.New(api.ReservedMachineClassExtraKeys...)
+		extra    = map[string]interface{}{}
+	)
+	for _, key := range sets.List(sets.KeySet(workerConfig.MachineClassExtra)) {
+		if reserved.Has(key) || !allowed.Has(key) {
+			return nil, fmt.Errorf("key %q of machineClassExtra is not allowed, allowed keys are %v", key, sets.List(allowed.Difference(reserved)))
+		}
+		var value interface{}
+		if err := json.Unmarshal(workerConfig.MachineClassExtra[key].Raw, &value); err != nil {
+			return nil, fmt.Errorf("could not decode value of key %q of machineClassExtra: %w", key, err)
+		}
+		extra[key] = value
+	}
+	return extra, nil
+}
+
+// machineClassExtraHashData returns the extra values of the machine classes of a worker pool for the worker pool hash,
+// as they may change the servers of the machines.
+func machineClassExtraHashData(workerConfig *api.WorkerConfig) []string {
+	var data []string
+	for _, key := range sets.List(sets.KeySet(workerConfig.MachineClassExtra)) {
+		value := workerConfig.MachineClassExtra[key].Raw
+		compacted := &bytes.Buffer{}
+		if err := json.Compact(compacted, value); err == nil {
+			value = compacted.Bytes()
+		}
+		data = append(data, key+"="+string(value))
+	}
+	return data
+}
@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"maps"
+
+	"github.com/gardener/gardener/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// machineClassesWithChangedSecrets returns the machine classes to render. The secrets of machine classes whose content
+// did not change are omitted, so that they are not written on every reconciliation, which causes a considerable write
+// load in seeds with many machine classes.
+func (w *workerDelegate) machineClassesWithChangedSecrets(ctx context.Context) ([]map[string]interface{}, error) {
+	machineClasses := make([]map[string]interface{}, 0, len(w.machineClasses))
+	for _, machineClass := range w.machineClasses {
+		changed, err := w.machineClassSecretChanged(ctx, machineClass)
+		if err != nil {
+			return nil, err
+		}
+		if !changed {
+			machineClass = maps.Clone(machineClass)
+			delete(machineClass, "secret")
+		}
+		machineClasses = append(machineClasses, machineClass)
+	}
+	return machineClasses, nil
+}
+
+// machineClassSecretChanged returns whether the secret of the given machine class does not exist yet or differs from
+// the existing secret.
+func (w *workerDelegate) machineClassSecretChanged(ctx context.Context, machineClass map[string]interface{}) (bool, error) {
+	secret := &corev1.Secret{}
+	if err := w.seedClient.Get(ctx, client.ObjectKey{Namespace: w.worker.Namespace, Name: machineClass["name"].(string)}, secret); err != nil {
+		return true, client.IgnoreNotFound(err)
+	}
+
+	for key, value := range machineClass["labels"].(map[string]string) {
+		if secret.Labels[key] != value {
+			return true, nil
+		}
+	}
+	return utils.ComputeSecretChecksum(secret.Data) != utils.ComputeSecretChecksum(machineClassSecretData(machineClass)), nil
+}
+
+// machineClassSecretData returns the data of the secret of the given machine class as rendered by the chart.
+func machineClassSecretData(machineClass map[string]interface{}) map[string][]byte {
+	return map[string][]byte{
+		"userData": []byte(machineClass["secret"].(map[string]interface{})["cloudConfig"].(string)),
+	}
+}
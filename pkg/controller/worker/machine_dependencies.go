@@ -9,6 +9,7 @@ import (
 	"fmt"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
@@ -30,6 +31,10 @@ func (w *workerDelegate) CleanupMachineDependencies(_ context.Context) error {
 
 // PreReconcileHook implements genericactuator.WorkerDelegate.
 func (w *workerDelegate) PreReconcileHook(ctx context.Context) error {
+	if err := w.checkWakeUpDependencies(ctx); err != nil {
+		return err
+	}
+
 	computeClient, err := w.openstackClient.Compute()
 	if err != nil {
 		return err
@@ -40,6 +45,12 @@ func (w *workerDelegate) PreReconcileHook(ctx context.Context) error {
 		return err
 	}
 
+	workerStatus.FailedMachines, err = w.failedMachines(ctx, computeClient)
+	if err != nil {
+		return err
+	}
+	w.recordFailedMachineEvents(workerStatus.FailedMachines)
+
 	serverGroupDepSet, err := w.reconcileServerGroups(computeClient, workerStatus.DeepCopy())
 	return w.updateMachineDependenciesStatus(ctx, workerStatus, serverGroupDepSet.extract(), err)
 }
@@ -47,58 +58,92 @@ func (w *workerDelegate) PreReconcileHook(ctx context.Context) error {
 func (w *workerDelegate) reconcileServerGroups(computeClient osclient.Compute, workerStatus *api.WorkerStatus) (serverGroupDependencySet, error) {
 	serverGroupDepSet := newServerGroupDependencySet(workerStatus.ServerGroupDependencies)
 	for _, pool := range w.worker.Spec.Pools {
-		serverGroupDependencyStatus, err := w.reconcilePoolServerGroup(computeClient, pool, serverGroupDepSet)
+		serverGroupDependencyStatuses, err := w.reconcilePoolServerGroups(computeClient, pool, serverGroupDepSet)
 		if err != nil {
 			return serverGroupDepSet, fmt.Errorf("reconciling server groups failed for pool %q: %w", pool.Name, err)
 		}
-		serverGroupDepSet.upsert(serverGroupDependencyStatus)
+		for _, serverGroupDependencyStatus := range serverGroupDependencyStatuses {
+			serverGroupDepSet.upsert(serverGroupDependencyStatus)
+		}
 	}
 	return serverGroupDepSet, nil
 }
 
-func (w *workerDelegate) reconcilePoolServerGroup(computeClient osclient.Compute, pool extensionsv1alpha1.WorkerPool, set serverGroupDependencySet) (*api.ServerGroupDependency, error) {
+func (w *workerDelegate) reconcilePoolServerGroups(computeClient osclient.Compute, pool extensionsv1alpha1.WorkerPool, set serverGroupDependencySet) ([]*api.ServerGroupDependency, error) {
 	poolProviderConfig, err := helper.WorkerConfigFromRawExtension(pool.ProviderConfig)
 	if err != nil {
 		return nil, err
 	}
 
-	if !isServerGroupRequired(poolProviderConfig) {
+	required, err := w.poolRequiresServerGroup(pool, poolProviderConfig)
+	if err != nil {
+		return nil, err
+	}
+	if !required {
+		if isServerGroupRequired(poolProviderConfig) {
+			w.recorder.Eventf(w.worker, corev1.EventTypeWarning, eventReasonServerGroupIgnored,
+				"Server group of worker pool %q is ignored as its machine type %q is a bare metal flavor", pool.Name, pool.MachineType)
+		}
 		return nil, nil
 	}
 
-	poolDep := set.getByPoolName(pool.Name)
+	var result []*api.ServerGroupDependency
+	for _, zone := range serverGroupZones(pool, poolProviderConfig) {
+		dep, err := w.reconcileServerGroup(computeClient, pool.Name, zone, poolProviderConfig.ServerGroup.Policy, set)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, dep)
+	}
+	return result, nil
+}
+
+// reconcileServerGroup creates the server group of the given worker pool and zone, unless the server group of its
+// dependency exists with the given policy. It returns nil if the dependency is up-to-date.
+func (w *workerDelegate) reconcileServerGroup(computeClient osclient.Compute, poolName string, zone *string, policy string, set serverGroupDependencySet) (*api.ServerGroupDependency, error) {
+	poolDep := set.get(poolName, zone)
 	if poolDep != nil {
 		serverGroup, err := computeClient.GetServerGroup(poolDep.ID)
 		if err != nil && !osclient.IsNotFoundError(err) {
 			return nil, err
 		} else if err == nil {
-			if serverGroup.Name == poolDep.Name && (len(serverGroup.Policies) > 0 && serverGroup.Policies[0] == poolProviderConfig.ServerGroup.Policy) {
+			if serverGroup.Name == poolDep.Name && (len(serverGroup.Policies) > 0 && serverGroup.Policies[0] == policy) {
 				// if the current dependency's spec matches the provider resource, do nothing.
 				return nil, nil
 			}
 		}
 	}
 
-	name, err := generateServerGroupName(w.ClusterTechnicalName(), pool.Name)
+	name, err := generateServerGroupName(w.ClusterTechnicalName(), poolName, zone)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate server group name for worker pool %q: %w", pool.Name, err)
+		return nil, fmt.Errorf("failed to generate server group name for worker pool %q: %w", poolName, err)
 	}
 
-	result, err := computeClient.CreateServerGroup(name, poolProviderConfig.ServerGroup.Policy)
+	result, err := computeClient.CreateServerGroup(name, policy)
 	if err != nil {
 		return nil, err
 	}
 
 	return &api.ServerGroupDependency{
-		PoolName: pool.Name,
+		PoolName: poolName,
 		ID:       result.ID,
 		Name:     result.Name,
+		Zone:     zone,
 	}, nil
 }
 
 // PostReconcileHook implements genericactuator.WorkerDelegate.
 func (w *workerDelegate) PostReconcileHook(ctx context.Context) error {
-	return w.cleanupMachineDependencies(ctx)
+	if err := w.cleanupMachineDependencies(ctx); err != nil {
+		return err
+	}
+	if err := w.checkPendingImageVerifications(); err != nil {
+		return err
+	}
+	if err := w.checkPendingCanaryRollouts(); err != nil {
+		return err
+	}
+	return w.checkPendingZoneRollouts()
 }
 
 // PreDeleteHook implements genericactuator.WorkerDelegate.
@@ -140,7 +185,7 @@ func (w *workerDelegate) cleanupMachineDependencies(ctx context.Context) error {
 // a) worker is terminating and all server groups have to be deleted
 // b) worker pool is deleted
 // c) worker pool's server group configuration (e.g. policy) changed
-// d) worker pool no longer requires use of server groups
+// d) worker pool no longer requires use of server groups, or no longer for the zone of the server group
 func (w *workerDelegate) cleanupServerGroupDependencies(computeClient osclient.Compute, set serverGroupDependencySet) error {
 	groups, err := computeClient.ListServerGroups()
 	if err != nil {
@@ -169,12 +214,13 @@ func (w *workerDelegate) cleanupServerGroupDependencies(computeClient osclient.C
 				return err
 			}
 
-			set.deleteByPoolName(d.PoolName)
+			set.delete(d)
 			return nil
 		})
 	}
 
-	// Find out which worker pools use server groups. Deps whose worker pool is not present in the map will be deleted.
+	// Find out which worker pools and zones use server groups. Deps whose worker pool and zone are not present in the
+	// set will be deleted.
 	configs := sets.NewString()
 	for _, pool := range w.worker.Spec.Pools {
 		poolConfig, err := helper.WorkerConfigFromRawExtension(pool.ProviderConfig)
@@ -182,16 +228,22 @@ func (w *workerDelegate) cleanupServerGroupDependencies(computeClient osclient.C
 			return err
 		}
 
-		if !isServerGroupRequired(poolConfig) {
+		required, err := w.poolRequiresServerGroup(pool, poolConfig)
+		if err != nil {
+			return err
+		}
+		if !required {
 			continue
 		}
 
-		configs.Insert(pool.Name)
+		for _, zone := range serverGroupZones(pool, poolConfig) {
+			configs.Insert(serverGroupDependencyKey(pool.Name, zone))
+		}
 	}
 
 	// handles cases [b,d]
 	return set.forEach(func(d api.ServerGroupDependency) error {
-		if configs.Has(d.PoolName) {
+		if configs.Has(serverGroupDependencyKey(d.PoolName, d.Zone)) {
 			return nil
 		}
 
@@ -199,7 +251,7 @@ func (w *workerDelegate) cleanupServerGroupDependencies(computeClient osclient.C
 			return err
 		}
 
-		set.deleteByPoolName(d.PoolName)
+		set.delete(d)
 		return nil
 	})
 }
@@ -16,13 +16,27 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	k8smocks "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/baremetal/v1/nodes"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/keypairs"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/servergroups"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/images"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/groups"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/subnets"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	"go.uber.org/mock/gomock"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
 	apiv1alpha1 "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/v1alpha1"
@@ -39,6 +53,7 @@ var _ = Describe("#MachineDependencies", func() {
 		computeClient  *mocks.MockCompute
 		cl             *k8smocks.MockClient
 		statusCl       *k8smocks.MockStatusWriter
+		recorder       *record.FakeRecorder
 		scheme         *runtime.Scheme
 		workerDelegate genericactuator.WorkerDelegate
 	)
@@ -53,6 +68,7 @@ var _ = Describe("#MachineDependencies", func() {
 		statusCl = k8smocks.NewMockStatusWriter(ctrl)
 
 		cl.EXPECT().Status().AnyTimes().Return(statusCl)
+		recorder = record.NewFakeRecorder(10)
 
 		scheme = runtime.NewScheme()
 		_ = api.AddToScheme(scheme)
@@ -77,6 +93,7 @@ var _ = Describe("#MachineDependencies", func() {
 				},
 			}
 			osFactory.EXPECT().Compute().AnyTimes().Return(computeClient, nil)
+			osFactory.EXPECT().Compute(gomock.Any()).AnyTimes().Return(computeClient, nil)
 		})
 
 		Context("#PreReconcileHook", func() {
@@ -89,9 +106,12 @@ var _ = Describe("#MachineDependencies", func() {
 					w,
 					newClusterWithDefaultCloudProfileConfig(clusterName),
 					osFactory,
+					recorder,
+					nil,
 				)
 
 				ctx := context.Background()
+				expectMachineList(ctx, cl)
 				expectStatusUpdateToSucceed(ctx, statusCl)
 
 				err := workerDelegate.PreReconcileHook(ctx)
@@ -124,14 +144,18 @@ var _ = Describe("#MachineDependencies", func() {
 					w,
 					newClusterWithDefaultCloudProfileConfig(clusterName),
 					osFactory,
+					recorder,
+					nil,
 				)
 
+				computeClient.EXPECT().ListFlavors(gomock.Any()).Return(nil, nil)
 				computeClient.EXPECT().CreateServerGroup(prefixMatch(serverGroupPrefix(clusterName, pool1)), policy).Return(&servergroups.ServerGroup{
 					ID: serverGroupID1,
 				}, nil)
 				computeClient.EXPECT().CreateServerGroup(prefixMatch(serverGroupPrefix(clusterName, pool2)), policy).Return(&servergroups.ServerGroup{
 					ID: serverGroupID2,
 				}, nil)
+				expectMachineList(ctx, cl)
 				expectStatusUpdateToSucceed(ctx, statusCl)
 
 				err := workerDelegate.PreReconcileHook(ctx)
@@ -152,6 +176,180 @@ var _ = Describe("#MachineDependencies", func() {
 				))
 			})
 
+			It("should create a server group per zone if specified in worker pool", func() {
+				var (
+					ctx    = context.Background()
+					policy = "foo"
+					pool   = newWorkerPoolWithPolicy("pool-1", &policy)
+				)
+
+				pool.Zones = []string{"zone-1", "zone-2"}
+				pool.ProviderConfig = &runtime.RawExtension{Raw: encode(&apiv1alpha1.WorkerConfig{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+						Kind:       "WorkerConfig",
+					},
+					ServerGroup: &apiv1alpha1.ServerGroup{
+						Policy:  policy,
+						PerZone: pointer.Bool(true),
+					},
+				})}
+				w.Spec.Pools = append(w.Spec.Pools, *pool)
+
+				workerDelegate, _ = worker.NewWorkerDelegate(
+					cl,
+					scheme,
+					nil,
+					"",
+					w,
+					newClusterWithDefaultCloudProfileConfig(clusterName),
+					osFactory,
+					recorder,
+					nil,
+				)
+
+				computeClient.EXPECT().ListFlavors(gomock.Any()).Return(nil, nil)
+				computeClient.EXPECT().CreateServerGroup(prefixMatch(serverGroupPrefix(clusterName, "pool-1-zone-1")), policy).Return(&servergroups.ServerGroup{
+					ID: "id-1",
+				}, nil)
+				computeClient.EXPECT().CreateServerGroup(prefixMatch(serverGroupPrefix(clusterName, "pool-1-zone-2")), policy).Return(&servergroups.ServerGroup{
+					ID: "id-2",
+				}, nil)
+				expectMachineList(ctx, cl)
+				expectStatusUpdateToSucceed(ctx, statusCl)
+
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+
+				workerStatus := w.Status.ProviderStatus.Object.(*apiv1alpha1.WorkerStatus)
+				Expect(workerStatus.ServerGroupDependencies).To(ConsistOf(
+					MatchFields(IgnoreExtras, Fields{
+						"ID":       Equal("id-1"),
+						"PoolName": Equal("pool-1"),
+						"Zone":     PointTo(Equal("zone-1")),
+					}),
+					MatchFields(IgnoreExtras, Fields{
+						"ID":       Equal("id-2"),
+						"PoolName": Equal("pool-1"),
+						"Zone":     PointTo(Equal("zone-2")),
+					}),
+				))
+			})
+
+			It("should patch the status with optimistic locking and retry on conflicts", func() {
+				var (
+					ctx    = context.Background()
+					policy = "foo"
+				)
+
+				w.ResourceVersion = "1"
+				w.Spec.Pools = append(w.Spec.Pools, *(newWorkerPoolWithPolicy("pool-1", &policy)))
+
+				workerDelegate, _ = worker.NewWorkerDelegate(
+					cl,
+					scheme,
+					nil,
+					"",
+					w,
+					newClusterWithDefaultCloudProfileConfig(clusterName),
+					osFactory,
+					recorder,
+					nil,
+				)
+
+				computeClient.EXPECT().ListFlavors(gomock.Any()).Return(nil, nil)
+				computeClient.EXPECT().CreateServerGroup(prefixMatch(serverGroupPrefix(clusterName, "pool-1")), policy).Return(&servergroups.ServerGroup{
+					ID: "id-1",
+				}, nil)
+				expectMachineList(ctx, cl)
+				gomock.InOrder(
+					statusCl.EXPECT().Patch(ctx, w, gomock.Any()).Return(apierrors.NewConflict(extensionsv1alpha1.Resource("workers"), w.Name, fmt.Errorf("conflict"))),
+					cl.EXPECT().Get(ctx, client.ObjectKeyFromObject(w), w).DoAndReturn(
+						func(_ context.Context, _ client.ObjectKey, obj *extensionsv1alpha1.Worker, _ ...client.GetOption) error {
+							obj.ResourceVersion = "2"
+							obj.Status.ProviderStatus = nil
+							return nil
+						}),
+					statusCl.EXPECT().Patch(ctx, w, gomock.Any()).DoAndReturn(
+						func(_ context.Context, _ client.Object, patch client.Patch, _ ...client.SubResourcePatchOption) error {
+							data, err := patch.Data(w)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(string(data)).To(ContainSubstring(`"resourceVersion":"2"`))
+							return nil
+						}),
+				)
+
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+
+				workerStatus := w.Status.ProviderStatus.Object.(*apiv1alpha1.WorkerStatus)
+				Expect(workerStatus.ServerGroupDependencies).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+					"ID":       Equal("id-1"),
+					"PoolName": Equal("pool-1"),
+				})))
+			})
+
+			It("should not patch an unchanged status", func() {
+				w.Status.ProviderStatus = &runtime.RawExtension{
+					Raw: []byte(`{"apiVersion":"openstack.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerStatus"}`),
+				}
+
+				workerDelegate, _ = worker.NewWorkerDelegate(
+					cl,
+					scheme,
+					nil,
+					"",
+					w,
+					newClusterWithDefaultCloudProfileConfig(clusterName),
+					osFactory,
+					recorder,
+					nil,
+				)
+
+				ctx := context.Background()
+				expectMachineList(ctx, cl)
+
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+			})
+
+			It("should ignore server groups of pools with bare metal flavors", func() {
+				var (
+					ctx    = context.Background()
+					policy = "foo"
+					pool   = newWorkerPoolWithPolicy("pool-1", &policy)
+				)
+				pool.MachineType = "baremetal"
+				w.Spec.Pools = append(w.Spec.Pools, *pool)
+
+				workerDelegate, _ = worker.NewWorkerDelegate(
+					cl,
+					scheme,
+					nil,
+					"",
+					w,
+					newClusterWithDefaultCloudProfileConfig(clusterName),
+					osFactory,
+					recorder,
+					nil,
+				)
+
+				computeClient.EXPECT().ListFlavors(gomock.Any()).Return([]flavors.Flavor{{ID: "flavor-id", Name: "baremetal"}}, nil)
+				computeClient.EXPECT().GetFlavorExtraSpecs("flavor-id").Return(map[string]string{
+					"resources:CUSTOM_BAREMETAL_LARGE": "1",
+					"resources:VCPU":                   "0",
+					"resources:MEMORY_MB":              "0",
+					"resources:DISK_GB":                "0",
+				}, nil)
+				expectMachineList(ctx, cl)
+				expectStatusUpdateToSucceed(ctx, statusCl)
+
+				err := workerDelegate.PreReconcileHook(ctx)
+				Expect(err).NotTo(HaveOccurred())
+
+				workerStatus := w.Status.ProviderStatus.Object.(*apiv1alpha1.WorkerStatus)
+				Expect(workerStatus.ServerGroupDependencies).To(BeEmpty())
+				Expect(recorder.Events).To(HaveLen(1))
+				Expect(<-recorder.Events).To(Equal(`Warning ServerGroupIgnored Server group of worker pool "pool-1" is ignored as its machine type "baremetal" is a bare metal flavor`))
+			})
+
 			It("should recreate server group if specs do not match", func() {
 				var (
 					ctx       = context.Background()
@@ -169,11 +367,15 @@ var _ = Describe("#MachineDependencies", func() {
 					w,
 					newClusterWithDefaultCloudProfileConfig(clusterName),
 					osFactory,
+					recorder,
+					nil,
 				)
 
+				computeClient.EXPECT().ListFlavors(gomock.Any()).Return(nil, nil)
 				computeClient.EXPECT().CreateServerGroup(prefixMatch(serverGroupPrefix(clusterName, poolName)), policy).Return(&servergroups.ServerGroup{
 					ID: "id",
 				}, nil)
+				expectMachineList(ctx, cl)
 				expectStatusUpdateToSucceed(ctx, statusCl)
 
 				err := workerDelegate.PreReconcileHook(ctx)
@@ -195,6 +397,7 @@ var _ = Describe("#MachineDependencies", func() {
 				computeClient.EXPECT().CreateServerGroup(prefixMatch(serverGroupPrefix(clusterName, poolName)), newPolicy).Return(&servergroups.ServerGroup{
 					ID: "new-id",
 				}, nil)
+				expectMachineList(ctx, cl)
 				expectStatusUpdateToSucceed(ctx, statusCl)
 
 				err = workerDelegate.PreReconcileHook(ctx)
@@ -208,6 +411,224 @@ var _ = Describe("#MachineDependencies", func() {
 					}),
 				))
 			})
+
+			It("should summarize failed machines with their Nova fault and record events", func() {
+				var (
+					ctx      = context.Background()
+					serverID = "server-id"
+					fault    = "No valid host was found."
+				)
+
+				workerDelegate, _ = worker.NewWorkerDelegate(
+					cl,
+					scheme,
+					nil,
+					"",
+					w,
+					newClusterWithDefaultCloudProfileConfig(clusterName),
+					osFactory,
+					recorder,
+					nil,
+				)
+
+				expectMachineList(ctx, cl,
+					machinev1alpha1.Machine{
+						ObjectMeta: metav1.ObjectMeta{Name: "machine-running"},
+						Status: machinev1alpha1.MachineStatus{
+							CurrentStatus: machinev1alpha1.CurrentStatus{Phase: machinev1alpha1.MachineRunning},
+						},
+					},
+					machinev1alpha1.Machine{
+						ObjectMeta: metav1.ObjectMeta{Name: "machine-failed"},
+						Spec:       machinev1alpha1.MachineSpec{ProviderID: "openstack:///region/" + serverID},
+						Status: machinev1alpha1.MachineStatus{
+							CurrentStatus: machinev1alpha1.CurrentStatus{Phase: machinev1alpha1.MachineFailed},
+							LastOperation: machinev1alpha1.LastOperation{Description: "Machine creation failed"},
+						},
+					},
+					machinev1alpha1.Machine{
+						ObjectMeta: metav1.ObjectMeta{Name: "machine-crashing"},
+						Status: machinev1alpha1.MachineStatus{
+							CurrentStatus: machinev1alpha1.CurrentStatus{Phase: machinev1alpha1.MachineCrashLoopBackOff},
+						},
+					},
+				)
+				computeClient.EXPECT().GetServer(serverID).Return(&servers.Server{ID: serverID, Fault: servers.Fault{Message: fault}}, nil)
+				computeClient.EXPECT().FindServersByName("machine-crashing").Return(nil, nil)
+				osFactory.EXPECT().BareMetal(gomock.Any()).Return(nil, &gophercloud.ErrEndpointNotFound{})
+				expectStatusUpdateToSucceed(ctx, statusCl)
+
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+
+				workerStatus := w.Status.ProviderStatus.Object.(*apiv1alpha1.WorkerStatus)
+				Expect(workerStatus.FailedMachines).To(Equal([]apiv1alpha1.FailedMachine{
+					{Name: "machine-crashing"},
+					{Name: "machine-failed", ServerID: &serverID, Message: "Machine creation failed", Fault: &fault},
+				}))
+				Expect(recorder.Events).To(HaveLen(2))
+				Expect(<-recorder.Events).To(Equal(`Warning MachineFailed Machine "machine-crashing" failed`))
+				Expect(<-recorder.Events).To(Equal(`Warning MachineFailed Machine "machine-failed" failed: Machine creation failed (server server-id, Nova fault: No valid host was found.)`))
+			})
+			It("should add the provision state of the Ironic nodes of failed bare metal machines", func() {
+				var (
+					ctx            = context.Background()
+					serverID       = "server-id"
+					provisionState = "deploy failed"
+					lastError      = "Timeout reached while waiting for callback for node"
+				)
+
+				workerDelegate, _ = worker.NewWorkerDelegate(
+					cl,
+					scheme,
+					nil,
+					"",
+					w,
+					newClusterWithDefaultCloudProfileConfig(clusterName),
+					osFactory,
+					recorder,
+					nil,
+				)
+
+				expectMachineList(ctx, cl,
+					machinev1alpha1.Machine{
+						ObjectMeta: metav1.ObjectMeta{Name: "machine-failed"},
+						Spec:       machinev1alpha1.MachineSpec{ProviderID: "openstack:///region/" + serverID},
+						Status: machinev1alpha1.MachineStatus{
+							CurrentStatus: machinev1alpha1.CurrentStatus{Phase: machinev1alpha1.MachineFailed},
+							LastOperation: machinev1alpha1.LastOperation{Description: "Machine creation timed out"},
+						},
+					},
+				)
+				bareMetalClient := mocks.NewMockBareMetal(ctrl)
+				computeClient.EXPECT().GetServer(serverID).Return(&servers.Server{ID: serverID}, nil)
+				osFactory.EXPECT().BareMetal(gomock.Any()).Return(bareMetalClient, nil)
+				bareMetalClient.EXPECT().ListNodes(nodes.ListOpts{InstanceUUID: serverID}).Return([]nodes.Node{
+					{UUID: "node-id", InstanceUUID: serverID, ProvisionState: provisionState, LastError: lastError},
+				}, nil)
+				expectStatusUpdateToSucceed(ctx, statusCl)
+
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+
+				workerStatus := w.Status.ProviderStatus.Object.(*apiv1alpha1.WorkerStatus)
+				Expect(workerStatus.FailedMachines).To(Equal([]apiv1alpha1.FailedMachine{
+					{Name: "machine-failed", ServerID: &serverID, Message: "Machine creation timed out", ProvisionState: &provisionState, LastError: &lastError},
+				}))
+				Expect(recorder.Events).To(HaveLen(1))
+				Expect(<-recorder.Events).To(Equal(`Warning MachineFailed Machine "machine-failed" failed: Machine creation timed out (server server-id, Ironic provision state: deploy failed, Ironic error: Timeout reached while waiting for callback for node)`))
+			})
+			It("should mark failed machines of preemptible worker pools whose server is gone as preempted", func() {
+				ctx := context.Background()
+
+				workerDelegate, _ = worker.NewWorkerDelegate(
+					cl,
+					scheme,
+					nil,
+					"",
+					w,
+					newClusterWithDefaultCloudProfileConfig(clusterName),
+					osFactory,
+					recorder,
+					nil,
+				)
+
+				preemptibleNodeTemplate := machinev1alpha1.NodeTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{"node.openstack.provider.extensions.gardener.cloud/preemptible": "true"},
+					},
+				}
+				expectMachineList(ctx, cl,
+					machinev1alpha1.Machine{
+						ObjectMeta: metav1.ObjectMeta{Name: "machine-preempted"},
+						Spec: machinev1alpha1.MachineSpec{
+							ProviderID:       "openstack:///region/preempted-server-id",
+							NodeTemplateSpec: preemptibleNodeTemplate,
+						},
+						Status: machinev1alpha1.MachineStatus{
+							CurrentStatus: machinev1alpha1.CurrentStatus{Phase: machinev1alpha1.MachineFailed},
+						},
+					},
+					machinev1alpha1.Machine{
+						ObjectMeta: metav1.ObjectMeta{Name: "machine-unknown"},
+						Spec:       machinev1alpha1.MachineSpec{NodeTemplateSpec: preemptibleNodeTemplate},
+						Status: machinev1alpha1.MachineStatus{
+							CurrentStatus: machinev1alpha1.CurrentStatus{Phase: machinev1alpha1.MachineFailed},
+						},
+					},
+				)
+				computeClient.EXPECT().GetServer("preempted-server-id").Return(nil, nil)
+				computeClient.EXPECT().FindServersByName("machine-preempted").Return(nil, nil)
+				computeClient.EXPECT().FindServersByName("machine-unknown").Return(nil, fmt.Errorf("error"))
+				expectStatusUpdateToSucceed(ctx, statusCl)
+
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+
+				workerStatus := w.Status.ProviderStatus.Object.(*apiv1alpha1.WorkerStatus)
+				Expect(workerStatus.FailedMachines).To(Equal([]apiv1alpha1.FailedMachine{
+					{Name: "machine-preempted", Preempted: true},
+					{Name: "machine-unknown"},
+				}))
+				Expect(recorder.Events).To(HaveLen(2))
+				Expect(<-recorder.Events).To(Equal(`Normal MachinePreempted Machine "machine-preempted" was preempted by the cloud and is replaced`))
+				Expect(<-recorder.Events).To(Equal(`Warning MachineFailed Machine "machine-unknown" failed`))
+			})
+			It("should only attribute servers named exactly like the failed machine", func() {
+				var (
+					ctx      = context.Background()
+					serverID = "server-id"
+				)
+
+				workerDelegate, _ = worker.NewWorkerDelegate(
+					cl,
+					scheme,
+					nil,
+					"",
+					w,
+					newClusterWithDefaultCloudProfileConfig(clusterName),
+					osFactory,
+					recorder,
+					nil,
+				)
+
+				failedStatus := machinev1alpha1.MachineStatus{
+					CurrentStatus: machinev1alpha1.CurrentStatus{Phase: machinev1alpha1.MachineFailed},
+				}
+				expectMachineList(ctx, cl,
+					machinev1alpha1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "machine-1"}, Status: failedStatus},
+					machinev1alpha1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "machine-2"}, Status: failedStatus},
+					machinev1alpha1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "machine-3"}, Status: failedStatus},
+					machinev1alpha1.Machine{
+						ObjectMeta: metav1.ObjectMeta{Name: "machine-4"},
+						Spec:       machinev1alpha1.MachineSpec{ProviderID: "openstack:///region/unknown-server-id"},
+						Status:     failedStatus,
+					},
+				)
+				computeClient.EXPECT().FindServersByName("machine-1").Return([]servers.Server{
+					{ID: "other-server-id", Name: "machine-10"},
+					{ID: serverID, Name: "machine-1"},
+				}, nil)
+				computeClient.EXPECT().FindServersByName("machine-2").Return([]servers.Server{
+					{ID: "other-server-id", Name: "machine-20"},
+				}, nil)
+				computeClient.EXPECT().FindServersByName("machine-3").Return([]servers.Server{
+					{ID: "first-server-id", Name: "machine-3"},
+					{ID: "second-server-id", Name: "machine-3"},
+				}, nil)
+				computeClient.EXPECT().GetServer("unknown-server-id").Return(nil, fmt.Errorf("error"))
+				osFactory.EXPECT().BareMetal(gomock.Any()).Return(nil, &gophercloud.ErrEndpointNotFound{})
+				expectStatusUpdateToSucceed(ctx, statusCl)
+
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+
+				workerStatus := w.Status.ProviderStatus.Object.(*apiv1alpha1.WorkerStatus)
+				Expect(workerStatus.FailedMachines).To(Equal([]apiv1alpha1.FailedMachine{
+					{Name: "machine-1", ServerID: &serverID},
+					{Name: "machine-2"},
+					{Name: "machine-3"},
+					{Name: "machine-4"},
+				}))
+				Expect(recorder.Events).To(HaveLen(4))
+				Expect(<-recorder.Events).To(Equal(`Warning MachineFailed Machine "machine-1" failed (server server-id)`))
+			})
 		})
 
 		Context("#PostReconcileHook", func() {
@@ -241,6 +662,8 @@ var _ = Describe("#MachineDependencies", func() {
 					w,
 					newClusterWithDefaultCloudProfileConfig(clusterName),
 					osFactory,
+					recorder,
+					nil,
 				)
 
 				computeClient.EXPECT().ListServerGroups().Return([]servergroups.ServerGroup{
@@ -259,6 +682,73 @@ var _ = Describe("#MachineDependencies", func() {
 				Expect(workerStatus.ServerGroupDependencies).To(BeEmpty())
 			})
 
+			It("should clean the server group of a worker pool which got a server group per zone", func() {
+				var (
+					ctx    = context.Background()
+					policy = "foo"
+					pool   = newWorkerPoolWithPolicy("pool", &policy)
+				)
+
+				pool.Zones = []string{"zone-1"}
+				pool.ProviderConfig = &runtime.RawExtension{Raw: encode(&apiv1alpha1.WorkerConfig{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+						Kind:       "WorkerConfig",
+					},
+					ServerGroup: &apiv1alpha1.ServerGroup{
+						Policy:  policy,
+						PerZone: pointer.Bool(true),
+					},
+				})}
+				w.Spec.Pools = append(w.Spec.Pools, *pool)
+				w.Status.ProviderStatus = &runtime.RawExtension{
+					Object: &apiv1alpha1.WorkerStatus{
+						TypeMeta: metav1.TypeMeta{
+							Kind:       "WorkerStatus",
+							APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+						},
+						ServerGroupDependencies: []apiv1alpha1.ServerGroupDependency{
+							{
+								PoolName: "pool",
+								ID:       "pool-id",
+							},
+							{
+								PoolName: "pool",
+								ID:       "zone-id",
+								Zone:     pointer.String("zone-1"),
+							},
+						},
+					},
+				}
+				workerDelegate, _ = worker.NewWorkerDelegate(
+					cl,
+					scheme,
+					nil,
+					"",
+					w,
+					newClusterWithDefaultCloudProfileConfig(clusterName),
+					osFactory,
+					recorder,
+					nil,
+				)
+
+				computeClient.EXPECT().ListFlavors(gomock.Any()).Return(nil, nil)
+				computeClient.EXPECT().ListServerGroups().Return([]servergroups.ServerGroup{
+					{ID: "pool-id", Name: clusterName + "-pool-rand"},
+					{ID: "zone-id", Name: clusterName + "-pool-zone-1-rand"},
+				}, nil)
+				computeClient.EXPECT().DeleteServerGroup("pool-id").Return(nil)
+				expectStatusUpdateToSucceed(ctx, statusCl)
+
+				Expect(workerDelegate.PostReconcileHook(ctx)).To(Succeed())
+
+				workerStatus := w.Status.ProviderStatus.Object.(*apiv1alpha1.WorkerStatus)
+				Expect(workerStatus.ServerGroupDependencies).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+					"ID":   Equal("zone-id"),
+					"Zone": PointTo(Equal("zone-1")),
+				})))
+			})
+
 			It("should clean old server group if worker pool specs changed", func() {
 				var (
 					ctx = context.Background()
@@ -296,8 +786,11 @@ var _ = Describe("#MachineDependencies", func() {
 					w,
 					newClusterWithDefaultCloudProfileConfig(clusterName),
 					osFactory,
+					recorder,
+					nil,
 				)
 
+				computeClient.EXPECT().ListFlavors(gomock.Any()).Return(nil, nil)
 				computeClient.EXPECT().ListServerGroups().Return([]servergroups.ServerGroup{
 					{
 						ID:   serverGroupID,
@@ -309,7 +802,7 @@ var _ = Describe("#MachineDependencies", func() {
 					},
 				}, nil)
 				computeClient.EXPECT().DeleteServerGroup(oldServerGroupID).Return(nil)
-				expectStatusUpdateToSucceed(ctx, statusCl)
+				// the server group dependencies are unchanged, hence the status is not patched
 
 				err := workerDelegate.PostReconcileHook(ctx)
 				Expect(err).NotTo(HaveOccurred())
@@ -359,6 +852,8 @@ var _ = Describe("#MachineDependencies", func() {
 					w,
 					newClusterWithDefaultCloudProfileConfig(clusterName),
 					osFactory,
+					recorder,
+					nil,
 				)
 
 				computeClient.EXPECT().ListServerGroups().Return([]servergroups.ServerGroup{
@@ -414,6 +909,8 @@ var _ = Describe("#MachineDependencies", func() {
 					w,
 					newClusterWithDefaultCloudProfileConfig(clusterName),
 					osFactory,
+					recorder,
+					nil,
 				)
 
 				computeClient.EXPECT().ListServerGroups().Return([]servergroups.ServerGroup{
@@ -469,8 +966,11 @@ var _ = Describe("#MachineDependencies", func() {
 					w,
 					newClusterWithDefaultCloudProfileConfig(clusterName),
 					osFactory,
+					recorder,
+					nil,
 				)
 
+				computeClient.EXPECT().ListFlavors(gomock.Any()).Return(nil, nil)
 				computeClient.EXPECT().ListServerGroups().Return([]servergroups.ServerGroup{
 					{
 						ID:   serverGroupID,
@@ -482,7 +982,7 @@ var _ = Describe("#MachineDependencies", func() {
 					},
 				}, nil)
 				computeClient.EXPECT().DeleteServerGroup(oldServerGroupID).Return(nil)
-				expectStatusUpdateToSucceed(ctx, statusCl)
+				// the server group dependencies are unchanged, hence the status is not patched
 
 				err := workerDelegate.PostDeleteHook(ctx)
 				Expect(err).NotTo(HaveOccurred())
@@ -532,6 +1032,8 @@ var _ = Describe("#MachineDependencies", func() {
 					w,
 					newClusterWithDefaultCloudProfileConfig(clusterName),
 					osFactory,
+					recorder,
+					nil,
 				)
 
 				computeClient.EXPECT().ListServerGroups().Return([]servergroups.ServerGroup{
@@ -556,6 +1058,126 @@ var _ = Describe("#MachineDependencies", func() {
 			})
 		})
 	})
+
+	Context("#WakeUp", func() {
+		var (
+			ctx = context.Background()
+
+			clusterName    = "shoot--foobar--openstack"
+			region         = "eu-de-1"
+			networkID      = "network-id"
+			subnetID       = "subnet-id"
+			securityGroup  = "security-group-id"
+			keyName        = "key-name"
+			flavorName     = "flavor"
+			imageID        = "image-id"
+			networkClient  *mocks.MockNetworking
+			cluster        *controller.Cluster
+			w              *extensionsv1alpha1.Worker
+			expectWakeUpOK func()
+		)
+
+		BeforeEach(func() {
+			networkClient = mocks.NewMockNetworking(ctrl)
+			osFactory.EXPECT().Compute(gomock.Any()).AnyTimes().Return(computeClient, nil)
+			osFactory.EXPECT().Networking(gomock.Any()).AnyTimes().Return(networkClient, nil)
+
+			cloudProfileConfig, err := json.Marshal(&apiv1alpha1.CloudProfileConfig{
+				TypeMeta: metav1.TypeMeta{APIVersion: apiv1alpha1.SchemeGroupVersion.String(), Kind: "CloudProfileConfig"},
+				MachineImages: []apiv1alpha1.MachineImages{{
+					Name:     "ubuntu",
+					Versions: []apiv1alpha1.MachineImageVersion{{Version: "1.0", Regions: []apiv1alpha1.RegionIDMapping{{Name: region, ID: imageID}}}},
+				}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			infrastructureStatus, err := json.Marshal(&apiv1alpha1.InfrastructureStatus{
+				TypeMeta: metav1.TypeMeta{APIVersion: apiv1alpha1.SchemeGroupVersion.String(), Kind: "InfrastructureStatus"},
+				Networks: apiv1alpha1.NetworkStatus{
+					ID:      networkID,
+					Subnets: []apiv1alpha1.Subnet{{Purpose: apiv1alpha1.PurposeNodes, ID: subnetID}},
+				},
+				Node:           apiv1alpha1.NodeStatus{KeyName: keyName},
+				SecurityGroups: []apiv1alpha1.SecurityGroup{{Purpose: apiv1alpha1.PurposeNodes, ID: securityGroup}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			cluster = &controller.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: clusterName},
+				CloudProfile: &gardencorev1beta1.CloudProfile{
+					Spec: gardencorev1beta1.CloudProfileSpec{ProviderConfig: &runtime.RawExtension{Raw: cloudProfileConfig}},
+				},
+				Shoot: &gardencorev1beta1.Shoot{
+					Spec:   gardencorev1beta1.ShootSpec{Region: region},
+					Status: gardencorev1beta1.ShootStatus{IsHibernated: true},
+				},
+			}
+			w = &extensionsv1alpha1.Worker{
+				ObjectMeta: metav1.ObjectMeta{Namespace: clusterName},
+				Spec: extensionsv1alpha1.WorkerSpec{
+					Region:                       region,
+					InfrastructureProviderStatus: &runtime.RawExtension{Raw: infrastructureStatus},
+					Pools: []extensionsv1alpha1.WorkerPool{{
+						Name:         "pool",
+						MachineType:  flavorName,
+						MachineImage: extensionsv1alpha1.MachineImage{Name: "ubuntu", Version: "1.0"},
+					}},
+				},
+			}
+
+			expectWakeUpOK = func() {
+				networkClient.EXPECT().ListNetwork(gomock.Any()).Return([]networks.Network{{ID: networkID}}, nil)
+				networkClient.EXPECT().ListSubnets(gomock.Any()).Return([]subnets.Subnet{{ID: subnetID}}, nil)
+				networkClient.EXPECT().GetSecurityGroup(securityGroup).Return(&groups.SecGroup{ID: securityGroup}, nil)
+				computeClient.EXPECT().GetKeyPair(keyName).Return(&keypairs.KeyPair{Name: keyName}, nil)
+				computeClient.EXPECT().ListFlavors(gomock.Any()).Return([]flavors.Flavor{{ID: "flavor-id", Name: flavorName}}, nil)
+				computeClient.EXPECT().GetFlavorExtraSpecs("flavor-id").Return(nil, nil)
+			}
+		})
+
+		It("should not check the dependencies if the shoot is not waking up", func() {
+			cluster.Shoot.Status.IsHibernated = false
+			workerDelegate, _ = worker.NewWorkerDelegate(cl, scheme, nil, "", w, cluster, osFactory, recorder, nil)
+
+			expectMachineList(ctx, cl)
+			expectStatusUpdateToSucceed(ctx, statusCl)
+
+			Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+			Expect(w.Status.Conditions).To(BeEmpty())
+		})
+
+		It("should report available dependencies when waking up", func() {
+			workerDelegate, _ = worker.NewWorkerDelegate(cl, scheme, nil, "", w, cluster, osFactory, recorder, nil)
+
+			expectWakeUpOK()
+			computeClient.EXPECT().GetImage(imageID).Return(&images.Image{ID: imageID, Status: "ACTIVE"}, nil)
+			statusCl.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.Worker{}), gomock.Any()).Return(nil).Times(2)
+			expectMachineList(ctx, cl)
+
+			Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+			Expect(w.Status.Conditions).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(worker.ConditionTypeWakeUpDependenciesAvailable),
+				"Status": Equal(gardencorev1beta1.ConditionTrue),
+				"Reason": Equal(worker.ReasonDependenciesAvailable),
+			})))
+		})
+
+		It("should report missing dependencies and fail before the machines are scaled up", func() {
+			workerDelegate, _ = worker.NewWorkerDelegate(cl, scheme, nil, "", w, cluster, osFactory, recorder, nil)
+
+			expectWakeUpOK()
+			computeClient.EXPECT().GetImage(imageID).Return(nil, nil)
+			expectStatusUpdateToSucceed(ctx, statusCl)
+
+			err := workerDelegate.PreReconcileHook(ctx)
+			Expect(err).To(MatchError(ContainSubstring(`image "image-id" does not exist in region "eu-de-1" for worker pool "pool"`)))
+			Expect(w.Status.Conditions).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Type":    Equal(worker.ConditionTypeWakeUpDependenciesAvailable),
+				"Status":  Equal(gardencorev1beta1.ConditionFalse),
+				"Reason":  Equal(worker.ReasonDependenciesMissing),
+				"Message": ContainSubstring(`image "image-id" does not exist`),
+			})))
+		})
+	})
 })
 
 func newWorkerPoolWithPolicy(name string, policy *string) *extensionsv1alpha1.WorkerPool {
@@ -608,6 +1230,14 @@ func newClusterWithDefaultCloudProfileConfig(name string) *controller.Cluster {
 	}
 }
 
+func expectMachineList(ctx context.Context, c *k8smocks.MockClient, machines ...machinev1alpha1.Machine) {
+	c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&machinev1alpha1.MachineList{}), gomock.Any()).DoAndReturn(
+		func(_ context.Context, list *machinev1alpha1.MachineList, _ ...client.ListOption) error {
+			list.Items = machines
+			return nil
+		})
+}
+
 func expectStatusUpdateToSucceed(ctx context.Context, statusWriter *k8smocks.MockStatusWriter) {
 	statusWriter.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.Worker{}), gomock.Any()).Return(nil)
 }
@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	osclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
+)
+
+const (
+	// maxFailedMachines is the maximum number of failed machines that are summarized in the worker status.
+	maxFailedMachines = 20
+	// eventReasonMachineFailed is the reason of the events recorded on the worker for failed machines.
+	eventReasonMachineFailed = "MachineFailed"
+)
+
+// failedMachines returns a summary of the failed machines of the worker. The fault reported by Nova for the server
+// backing a machine and, for bare metal servers, the provision state of the Ironic node are added on a best effort
+// basis, i.e. errors while looking up the server or node are ignored. Machines of preemptible worker pools whose server
+// does not exist anymore are marked as preempted.
+func (w *workerDelegate) failedMachines(ctx context.Context, computeClient osclient.Compute) ([]api.FailedMachine, error) {
+	machineList := &machinev1alpha1.MachineList{}
+	if err := w.seedClient.List(ctx, machineList, client.InNamespace(w.worker.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list machines: %w", err)
+	}
+
+	var failed []machinev1alpha1.Machine
+	for _, machine := range machineList.Items {
+		if phase := machine.Status.CurrentStatus.Phase; phase == machinev1alpha1.MachineFailed || phase == machinev1alpha1.MachineCrashLoopBackOff {
+			failed = append(failed, machine)
+		}
+	}
+	sort.Slice(failed, func(i, j int) bool { return failed[i].Name < failed[j].Name })
+	if len(failed) > maxFailedMachines {
+		failed = failed[:maxFailedMachines]
+	}
+
+	var (
+		result          []api.FailedMachine
+		bareMetalClient osclient.BareMetal
+		bareMetalLookup bool
+	)
+	for _, machine := range failed {
+		failedMachine := api.FailedMachine{
+			Name:    machine.Name,
+			Message: machine.Status.LastOperation.Description,
+		}
+		server, err := findMachineServer(computeClient, machine)
+		if err == nil && server != nil {
+			failedMachine.ServerID = &server.ID
+			if server.Fault.Message != "" {
+				failedMachine.Fault = &server.Fault.Message
+			}
+
+			// the Ironic client is only created once a server is found, as most clouds do not expose the Ironic API
+			if !bareMetalLookup {
+				bareMetalClient, _ = w.bareMetalClient()
+				bareMetalLookup = true
+			}
+			if bareMetalClient != nil {
+				if node, err := findServerNode(bareMetalClient, server.ID); err == nil && node != nil {
+					failedMachine.ProvisionState = &node.ProvisionState
+					if node.LastError != "" {
+						failedMachine.LastError = &node.LastError
+					}
+				}
+			}
+		}
+		// the servers of preemptible worker pools may be deleted by the cloud at any time, which is expected
+		if err == nil && server == nil && isPreemptibleMachine(machine) {
+			failedMachine.Preempted = true
+		}
+		result = append(result, failedMachine)
+	}
+	return result, nil
+}
+
+// recordFailedMachineEvents records a warning event on the worker for each of the given failed machines, enriched with
+// the fault reported by Nova for the server backing the machine and the state of its Ironic node. Preempted machines
+// are expected to be replaced and are only recorded as normal events.
+func (w *workerDelegate) recordFailedMachineEvents(failedMachines []api.FailedMachine) {
+	for _, machine := range failedMachines {
+		if machine.Preempted {
+			w.recorder.Event(w.worker, corev1.EventTypeNormal, eventReasonMachinePreempted,
+				fmt.Sprintf("Machine %q was preempted by the cloud and is replaced", machine.Name))
+			continue
+		}
+
+		message := fmt.Sprintf("Machine %q failed", machine.Name)
+		if machine.Message != "" {
+			message += ": " + machine.Message
+		}
+		if machine.ServerID != nil {
+			message += fmt.Sprintf(" (server %s", *machine.ServerID)
+			if machine.Fault != nil {
+				message += fmt.Sprintf(", Nova fault: %s", *machine.Fault)
+			}
+			if machine.ProvisionState != nil {
+				message += fmt.Sprintf(", Ironic provision state: %s", *machine.ProvisionState)
+			}
+			if machine.LastError != nil {
+				message += fmt.Sprintf(", Ironic error: %s", *machine.LastError)
+			}
+			message += ")"
+		}
+		w.recorder.Event(w.worker, corev1.EventTypeWarning, eventReasonMachineFailed, message)
+	}
+}
+
+// findMachineServer returns the server backing the given machine or nil if it does not exist. An error is returned if
+// the existence of the server cannot be determined or if several servers are named like the machine.
+func findMachineServer(computeClient osclient.Compute, machine machinev1alpha1.Machine) (*servers.Server, error) {
+	// the provider id has the format openstack:///<region>/<server-id>
+	if providerID := machine.Spec.ProviderID; providerID != "" {
+		server, err := computeClient.GetServer(providerID[strings.LastIndex(providerID, "/")+1:])
+		if err != nil {
+			return nil, err
+		}
+		if server != nil {
+			return server, nil
+		}
+	}
+
+	list, err := computeClient.FindServersByName(machine.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	// Nova matches the name as regular expression, hence the list may contain servers of other machines
+	var found *servers.Server
+	for i := range list {
+		if list[i].Name != machine.Name {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("found several servers named %q", machine.Name)
+		}
+		found = &list[i]
+	}
+	return found, nil
+}
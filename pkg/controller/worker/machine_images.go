@@ -7,19 +7,31 @@ package worker
 import (
 	"context"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/worker"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
-	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/pointer"
 
 	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/helper"
 )
 
+const (
+	// prebakedImageMachineCreationTimeout is the default creation timeout of machines using pre-baked images. They join
+	// the cluster without downloading the kubelet and the container images of the node components first, so that failed
+	// machines are detected and replaced earlier than with the default timeout of the machine-controller-manager.
+	prebakedImageMachineCreationTimeout = 10 * time.Minute
+	// prebakedImageMachineHealthTimeout is the default health timeout of machines using pre-baked images.
+	prebakedImageMachineHealthTimeout = 5 * time.Minute
+)
+
 func (w *workerDelegate) UpdateMachineImagesStatus(ctx context.Context) error {
 	if w.machineImages == nil {
-		if err := w.generateMachineConfig(); err != nil {
+		if err := w.generateMachineConfig(ctx); err != nil {
 			return fmt.Errorf("unable to generate the machine config: %w", err)
 		}
 	}
@@ -31,6 +43,8 @@ func (w *workerDelegate) UpdateMachineImagesStatus(ctx context.Context) error {
 	}
 
 	workerStatus.MachineImages = w.machineImages
+	workerStatus.VerifiedImages = w.verifiedImages
+	workerStatus.WorkerPools = w.workerPools
 	if err := w.updateWorkerProviderStatus(ctx, workerStatus); err != nil {
 		return fmt.Errorf("unable to update worker provider status: %w", err)
 	}
@@ -38,6 +52,18 @@ func (w *workerDelegate) UpdateMachineImagesStatus(ctx context.Context) error {
 	return nil
 }
 
+// resolvedImageID returns the id of the image of the given machine image. If the machine image refers to the image by
+// name, the id is only known once the image has been verified.
+func (w *workerDelegate) resolvedImageID(machineImage *api.MachineImage) string {
+	if machineImage.ID != "" {
+		return machineImage.ID
+	}
+	if verified := findVerifiedImage(w.verifiedImages, w.worker.Spec.Region, machineImage); verified != nil {
+		return verified.ID
+	}
+	return ""
+}
+
 func (w *workerDelegate) findMachineImage(name, version, architecture string) (*api.MachineImage, error) {
 	image, err := helper.FindImageFromCloudProfile(w.cloudProfileConfig, name, version, w.cluster.Shoot.Spec.Region, architecture)
 	if err == nil {
@@ -45,10 +71,10 @@ func (w *workerDelegate) findMachineImage(name, version, architecture string) (*
 	}
 
 	// Try to look up machine image in worker provider status as it was not found in componentconfig.
-	if providerStatus := w.worker.Status.ProviderStatus; providerStatus != nil {
-		workerStatus := &api.WorkerStatus{}
-		if _, _, err := w.decoder.Decode(providerStatus.Raw, nil, workerStatus); err != nil {
-			return nil, fmt.Errorf("could not decode worker status of worker '%s': %w", kutil.ObjectName(w.worker), err)
+	if w.worker.Status.ProviderStatus != nil {
+		workerStatus, err := w.decodeWorkerProviderStatus()
+		if err != nil {
+			return nil, err
 		}
 
 		machineImage, err := helper.FindMachineImage(workerStatus.MachineImages, name, version, architecture)
@@ -56,13 +82,6 @@ func (w *workerDelegate) findMachineImage(name, version, architecture string) (*
 			return nil, worker.ErrorMachineImageNotFound(name, version)
 		}
 
-		// The architecture field might not be present in the WorkerStatus if the Shoot has been created before introduction
-		// of the field. Hence, initialize it if it's empty.
-		machineImage = machineImage.DeepCopy()
-		if machineImage.Architecture == nil {
-			machineImage.Architecture = &architecture
-		}
-
 		return machineImage, nil
 	}
 
@@ -75,3 +94,23 @@ func appendMachineImage(machineImages []api.MachineImage, machineImage api.Machi
 	}
 	return machineImages
 }
+
+// hasMachineImageCapability returns whether the given machine image has the given capability.
+func hasMachineImageCapability(machineImage *api.MachineImage, capability api.MachineImageCapability) bool {
+	return slices.Contains(machineImage.Capabilities, capability)
+}
+
+// applyPrebakedImageMachineConfiguration defaults the creation and health timeouts of the given machine configuration
+// for pools using pre-baked images unless they are configured explicitly.
+func applyPrebakedImageMachineConfiguration(machineConfiguration *machinev1alpha1.MachineConfiguration) *machinev1alpha1.MachineConfiguration {
+	if machineConfiguration == nil {
+		machineConfiguration = &machinev1alpha1.MachineConfiguration{}
+	}
+	if machineConfiguration.MachineCreationTimeout == nil {
+		machineConfiguration.MachineCreationTimeout = &metav1.Duration{Duration: prebakedImageMachineCreationTimeout}
+	}
+	if machineConfiguration.MachineHealthTimeout == nil {
+		machineConfiguration.MachineHealthTimeout = &metav1.Duration{Duration: prebakedImageMachineHealthTimeout}
+	}
+	return machineConfiguration
+}
@@ -10,6 +10,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
@@ -20,6 +21,9 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/utils"
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -47,33 +51,39 @@ func (w *workerDelegate) MachineClassList() client.ObjectList {
 // DeployMachineClasses generates and creates the OpenStack specific machine classes.
 func (w *workerDelegate) DeployMachineClasses(ctx context.Context) error {
 	if w.machineClasses == nil {
-		if err := w.generateMachineConfig(); err != nil {
+		if err := w.generateMachineConfig(ctx); err != nil {
 			return err
 		}
 	}
 
-	return w.seedChartApplier.ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join(charts.InternalChartsPath, "machineclass"), w.worker.Namespace, "machineclass", kubernetes.Values(map[string]interface{}{"machineClasses": w.machineClasses}))
+	machineClasses, err := w.machineClassesWithChangedSecrets(ctx)
+	if err != nil {
+		return err
+	}
+
+	return w.seedChartApplier.ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join(charts.InternalChartsPath, "machineclass"), w.worker.Namespace, "machineclass", kubernetes.Values(map[string]interface{}{"machineClasses": machineClasses}))
 }
 
 // GenerateMachineDeployments generates the configuration for the desired machine deployments.
-func (w *workerDelegate) GenerateMachineDeployments(_ context.Context) (worker.MachineDeployments, error) {
+func (w *workerDelegate) GenerateMachineDeployments(ctx context.Context) (worker.MachineDeployments, error) {
 	if w.machineDeployments == nil {
-		if err := w.generateMachineConfig(); err != nil {
+		if err := w.generateMachineConfig(ctx); err != nil {
 			return nil, err
 		}
 	}
 	return w.machineDeployments, nil
 }
 
-func (w *workerDelegate) generateMachineConfig() error {
+func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 	var (
 		machineDeployments = worker.MachineDeployments{}
 		machineClasses     []map[string]interface{}
 		machineImages      []api.MachineImage
+		workerPools        []api.WorkerPoolStatus
 	)
 
-	infrastructureStatus := &api.InfrastructureStatus{}
-	if _, _, err := w.decoder.Decode(w.worker.Spec.InfrastructureProviderStatus.Raw, nil, infrastructureStatus); err != nil {
+	infrastructureStatus, err := helper.InfrastructureStatusFromRaw(w.worker.Spec.InfrastructureProviderStatus)
+	if err != nil {
 		return err
 	}
 
@@ -83,19 +93,30 @@ func (w *workerDelegate) generateMachineConfig() error {
 	}
 
 	serverGroupDepSet := newServerGroupDependencySet(workerStatus.ServerGroupDependencies)
+	w.previouslyVerifiedImages = workerStatus.VerifiedImages
 
 	nodesSecurityGroup, err := helper.FindSecurityGroupByPurpose(infrastructureStatus.SecurityGroups, api.PurposeNodes)
 	if err != nil {
 		return err
 	}
 
-	subnet, err := helper.FindSubnetByPurpose(infrastructureStatus.Networks.Subnets, api.PurposeNodes)
+	credentialsSecretRef, err := openstack.ShootCredentialsSecretRef(ctx, w.seedClient, w.worker.Spec.SecretRef)
 	if err != nil {
 		return err
 	}
 
+	var regionConfig *api.RegionConfig
+	if w.cloudProfileConfig != nil {
+		regionConfig = helper.FindRegionConfig(w.cloudProfileConfig.Regions, w.worker.Spec.Region)
+	}
+
 	for _, pool := range w.worker.Spec.Pools {
-		zoneLen := int32(len(pool.Zones))
+		pool.Zones = w.naming.OrderZones(pool.Zones)
+
+		distribution, err := w.zoneDistribution(ctx, pool)
+		if err != nil {
+			return err
+		}
 
 		architecture := pointer.StringDeref(pool.Architecture, v1beta1constants.ArchitectureAMD64)
 		machineImage, err := w.findMachineImage(pool.MachineImage.Name, pool.MachineImage.Version, architecture)
@@ -104,73 +125,159 @@ func (w *workerDelegate) generateMachineConfig() error {
 		}
 		machineImages = appendMachineImage(machineImages, *machineImage)
 
-		var volumeSize int
-		if pool.Volume != nil {
-			volumeSize, err = worker.DiskSize(pool.Volume.Size)
-			if err != nil {
-				return err
-			}
-		}
-
 		workerConfig, err := helper.WorkerConfigFromRawExtension(pool.ProviderConfig)
 		if err != nil {
 			return err
 		}
 
-		var serverGroupDep *api.ServerGroupDependency
-		if isServerGroupRequired(workerConfig) {
-			serverGroupDep = serverGroupDepSet.getByPoolName(pool.Name)
-			if serverGroupDep == nil {
-				return fmt.Errorf("server group is required for pool %q, but no server group dependency found", pool.Name)
-			}
+		userData, err := userDataOf(pool.UserData, workerConfig, machineImage)
+		if err != nil {
+			return fmt.Errorf("invalid user data of worker pool %q: %w", pool.Name, err)
 		}
 
-		workerPoolHash, err := w.generateWorkerPoolHash(pool, serverGroupDep, workerConfig)
+		disk, err := rootDiskOf(pool, workerConfig, regionConfig)
 		if err != nil {
 			return err
 		}
 
+		subnet, err := helper.FindNodeSubnet(infrastructureStatus.Networks.Subnets, workerConfig.Subnet)
+		if err != nil {
+			return fmt.Errorf("invalid provider config of worker pool %q: %w", pool.Name, err)
+		}
+		// the machine-controller-manager cannot pin the port in the network of the shoot to a subnet if the machines are
+		// attached to additional networks
+		if requiresNetworksMachineClassSpec(workerConfig) && countNodeSubnets(infrastructureStatus.Networks.Subnets) > 1 {
+			return fmt.Errorf("invalid provider config of worker pool %q: machines attached to additional networks cannot join a node subnet of a network with several node subnets", pool.Name)
+		}
+
+		securityGroups := append([]string{nodesSecurityGroup.Name}, workerConfig.AdditionalSecurityGroups...)
+
+		machineClassExtra, err := w.machineClassExtra(workerConfig)
+		if err != nil {
+			return fmt.Errorf("invalid provider config of worker pool %q: %w", pool.Name, err)
+		}
+
+		serverZones, err := serverAvailabilityZones(workerConfig)
+		if err != nil {
+			return fmt.Errorf("invalid provider config of worker pool %q: %w", pool.Name, err)
+		}
+
+		bareMetal, err := w.isBareMetalPool(pool)
+		if err != nil {
+			return fmt.Errorf("could not determine whether flavor %q is a bare metal flavor: %w", pool.MachineType, err)
+		}
+		if err := w.validateBareMetalCapabilities(pool, workerConfig); err != nil {
+			return fmt.Errorf("invalid provider config of worker pool %q: %w", pool.Name, err)
+		}
+
+		var serverGroupDeps []*api.ServerGroupDependency
+		if !bareMetal {
+			if serverGroupDeps, err = poolServerGroupDependencies(serverGroupDepSet, pool, workerConfig); err != nil {
+				return err
+			}
+		}
+
+		var nodeTemplateCapacity corev1.ResourceList
+		if workerConfig.NodeTemplate != nil {
+			nodeTemplateCapacity = workerConfig.NodeTemplate.Capacity
+		} else {
+			if nodeTemplateCapacity, err = w.nodeTemplateCapacity(pool, disk); err != nil {
+				return err
+			}
+			nodeTemplateCapacity = dataVolumesNodeTemplateCapacity(nodeTemplateCapacity, workerConfig.DataVolumes)
+		}
+
+		labels, taints := pool.Labels, pool.Taints
+		if workerConfig.VGPU != nil {
+			vgpus, err := w.flavorVGPUs(pool.MachineType)
+			if err != nil {
+				return fmt.Errorf("could not determine vGPUs of worker pool %q: %w", pool.Name, err)
+			}
+			nodeTemplateCapacity = vgpuNodeTemplateCapacity(nodeTemplateCapacity, w.nodeTemplateGPUResourceName(), vgpus, workerConfig.VGPU)
+			labels = vgpuLabels(labels, workerConfig.VGPU)
+			taints = vgpuTaints(taints, workerConfig.VGPU)
+		}
+		if isPreemptible(workerConfig) {
+			labels = preemptibleLabels(labels)
+			taints = preemptibleTaints(taints)
+		}
+
 		machineLabels := map[string]string{}
 		for _, pair := range workerConfig.MachineLabels {
 			machineLabels[pair.Name] = pair.Value
 		}
+		var serverMetadata map[string]string
+		if workerConfig.ServerMetadata != nil {
+			serverMetadata = workerConfig.ServerMetadata.Items
+		}
+		if isPreemptible(workerConfig) {
+			serverMetadata = utils.MergeStringMaps(serverMetadata, map[string]string{serverMetadataPreemptible: "true"})
+		}
 
+		var (
+			poolMachineDeployments worker.MachineDeployments
+			poolServerZones        []string
+		)
 		for zoneIndex, zone := range pool.Zones {
 			zoneIdx := int32(zoneIndex)
+			var serverGroupDep *api.ServerGroupDependency
+			if serverGroupDeps != nil {
+				serverGroupDep = serverGroupDeps[zoneIndex]
+			}
+			// the hash is computed per zone, as the zones of a worker pool may have different server groups
+			workerPoolHash, err := w.generateWorkerPoolHash(pool, serverGroupDep, workerConfig)
+			if err != nil {
+				return err
+			}
+
+			serverZone := zone
+			if pinnedZone, ok := serverZones[zone]; ok {
+				serverZone = pinnedZone
+			}
+			// the zones of bare metal worker pools ignoring availability zones only distribute and label the machines
+			if ignoresAvailabilityZones(workerConfig) {
+				serverZone = ""
+			} else {
+				poolServerZones = append(poolServerZones, serverZone)
+			}
 			machineClassSpec := map[string]interface{}{
 				"region":           w.worker.Spec.Region,
-				"availabilityZone": zone,
+				"availabilityZone": serverZone,
 				"machineType":      pool.MachineType,
-				"keyName":          infrastructureStatus.Node.KeyName,
-				"networkID":        infrastructureStatus.Networks.ID,
+				"keyName":          pointer.StringDeref(workerConfig.KeyName, infrastructureStatus.Node.KeyName),
 				"podNetworkCidr":   extensionscontroller.GetPodNetwork(w.cluster),
-				"securityGroups":   []string{nodesSecurityGroup.Name},
+				"securityGroups":   securityGroups,
 				"tags": utils.MergeStringMaps(
 					NormalizeLabelsForMachineClass(pool.Labels),
 					NormalizeLabelsForMachineClass(machineLabels),
+					serverMetadata,
 					map[string]string{
 						fmt.Sprintf("kubernetes.io-cluster-%s", w.worker.Namespace): "1",
 						"kubernetes.io-role-node":                                   "1",
 					},
 				),
 				"credentialsSecretRef": map[string]interface{}{
-					"name":      w.worker.Spec.SecretRef.Name,
-					"namespace": w.worker.Spec.SecretRef.Namespace,
+					"name":      credentialsSecretRef.Name,
+					"namespace": credentialsSecretRef.Namespace,
 				},
 				"secret": map[string]interface{}{
-					"cloudConfig": string(pool.UserData),
+					"cloudConfig": string(userData),
 				},
 			}
 
-			machineClassSpec["subnetID"] = subnet.ID
-
-			if volumeSize > 0 {
-				machineClassSpec["rootDiskSize"] = volumeSize
+			if requiresNetworksMachineClassSpec(workerConfig) {
+				machineClassSpec["networks"] = networksMachineClassSpec(infrastructureStatus.Networks.ID, workerConfig)
+			} else {
+				machineClassSpec["networkID"] = infrastructureStatus.Networks.ID
+				machineClassSpec["subnetID"] = subnet.ID
 			}
 
-			// specifying the volume type requires a custom volume size to be specified too.
-			if pool.Volume != nil && pool.Volume.Type != nil {
-				machineClassSpec["rootDiskType"] = *pool.Volume.Type
+			disk.machineClassSpec(machineClassSpec, zone)
+			if dataVolumes := dataVolumesMachineClassSpec(workerConfig.DataVolumes, serverZone); len(dataVolumes) > 0 {
+				machineClassSpec["dataVolumes"] = dataVolumes
+			}
+			if workerConfig.ConfigDrive != nil {
+				machineClassSpec["useConfigDrive"] = *workerConfig.ConfigDrive
 			}
 
 			if machineImage.ID != "" {
@@ -182,17 +289,17 @@ func (w *workerDelegate) generateMachineConfig() error {
 			if serverGroupDep != nil {
 				machineClassSpec["serverGroupID"] = serverGroupDep.ID
 			}
+			if len(workerConfig.SchedulerHints) > 0 {
+				machineClassSpec["schedulerHints"] = workerConfig.SchedulerHints
+			}
 
-			if workerConfig.NodeTemplate != nil {
-				machineClassSpec["nodeTemplate"] = machinev1alpha1.NodeTemplate{
-					Capacity:     workerConfig.NodeTemplate.Capacity,
-					InstanceType: pool.MachineType,
-					Region:       w.worker.Spec.Region,
-					Zone:         zone,
-				}
-			} else if pool.NodeTemplate != nil {
+			if machineClassExtra != nil {
+				machineClassSpec["extra"] = machineClassExtra
+			}
+
+			if nodeTemplateCapacity != nil {
 				machineClassSpec["nodeTemplate"] = machinev1alpha1.NodeTemplate{
-					Capacity:     pool.NodeTemplate.Capacity,
+					Capacity:     nodeTemplateCapacity,
 					InstanceType: pool.MachineType,
 					Region:       w.worker.Spec.Region,
 					Zone:         zone,
@@ -200,22 +307,40 @@ func (w *workerDelegate) generateMachineConfig() error {
 			}
 
 			var (
-				deploymentName = fmt.Sprintf("%s-%s-z%d", w.worker.Namespace, pool.Name, zoneIndex+1)
-				className      = fmt.Sprintf("%s-%s", deploymentName, workerPoolHash)
+				deploymentName = w.naming.DeploymentName(w.worker.Namespace, pool.Name, zoneIndex)
+				className      = w.naming.ClassName(deploymentName, workerPoolHash)
 			)
 
-			machineDeployments = append(machineDeployments, worker.MachineDeployment{
+			zoneMaxSurge, err := distribution.DistributePositiveIntOrPercent(zoneIdx, pool.MaxSurge, pool.Maximum)
+			if err != nil {
+				return fmt.Errorf("invalid maxSurge of worker pool %q: %w", pool.Name, err)
+			}
+			zoneMaxUnavailable, err := distribution.DistributePositiveIntOrPercent(zoneIdx, pool.MaxUnavailable, pool.Minimum)
+			if err != nil {
+				return fmt.Errorf("invalid maxUnavailable of worker pool %q: %w", pool.Name, err)
+			}
+			maxSurge, maxUnavailable := updateStrategyParameters(workerConfig.UpdateStrategy, zoneMaxSurge, zoneMaxUnavailable)
+
+			machineConfiguration := genericworkeractuator.ReadMachineConfiguration(pool)
+			// the hardware of bare metal machines takes longer to boot, regardless of the image
+			if bareMetal {
+				machineConfiguration = applyBareMetalMachineConfiguration(machineConfiguration)
+			} else if hasMachineImageCapability(machineImage, api.MachineImageCapabilityPrebaked) {
+				machineConfiguration = applyPrebakedImageMachineConfiguration(machineConfiguration)
+			}
+
+			poolMachineDeployments = append(poolMachineDeployments, worker.MachineDeployment{
 				Name:                 deploymentName,
 				ClassName:            className,
 				SecretName:           className,
-				Minimum:              worker.DistributeOverZones(zoneIdx, pool.Minimum, zoneLen),
-				Maximum:              worker.DistributeOverZones(zoneIdx, pool.Maximum, zoneLen),
-				MaxSurge:             worker.DistributePositiveIntOrPercent(zoneIdx, pool.MaxSurge, zoneLen, pool.Maximum),
-				MaxUnavailable:       worker.DistributePositiveIntOrPercent(zoneIdx, pool.MaxUnavailable, zoneLen, pool.Minimum),
-				Labels:               addTopologyLabel(pool.Labels, zone),
+				Minimum:              distribution.Distribute(zoneIdx, pool.Minimum),
+				Maximum:              distribution.Distribute(zoneIdx, pool.Maximum),
+				MaxSurge:             maxSurge,
+				MaxUnavailable:       maxUnavailable,
+				Labels:               addTopologyLabel(labels, zone),
 				Annotations:          pool.Annotations,
-				Taints:               pool.Taints,
-				MachineConfiguration: genericworkeractuator.ReadMachineConfiguration(pool),
+				Taints:               taints,
+				MachineConfiguration: machineConfiguration,
 			})
 
 			machineClassSpec["name"] = className
@@ -225,16 +350,65 @@ func (w *workerDelegate) generateMachineConfig() error {
 
 			machineClasses = append(machineClasses, machineClassSpec)
 		}
+
+		heldBack, err := w.gateRolloutOnImage(ctx, pool.Name, machineImage, poolMachineDeployments)
+		if err != nil {
+			return err
+		}
+		if !heldBack && workerConfig.Canary != nil {
+			if err := w.applyCanaryRollout(ctx, pool.Name, pool.Zones, workerConfig.Canary, poolMachineDeployments); err != nil {
+				return err
+			}
+		}
+		if !heldBack && workerConfig.ZoneRollout != nil {
+			maxSurge, maxUnavailable := updateStrategyParameters(workerConfig.UpdateStrategy, pool.MaxSurge, pool.MaxUnavailable)
+			if err := w.applyZoneRollout(ctx, pool.Name, workerConfig.ZoneRollout, maxSurge, maxUnavailable, poolMachineDeployments); err != nil {
+				return err
+			}
+		}
+		machineDeployments = append(machineDeployments, poolMachineDeployments...)
+
+		workerPool := api.WorkerPoolStatus{
+			Name:               pool.Name,
+			Flavor:             pool.MachineType,
+			ImageID:            w.resolvedImageID(machineImage),
+			ImageName:          machineImage.Image,
+			SecurityGroups:     securityGroups,
+			NetworkID:          infrastructureStatus.Networks.ID,
+			SubnetID:           subnet.ID,
+			AvailabilityZones:  poolServerZones,
+			AdditionalNetworks: workerConfig.Networks,
+		}
+		// the server groups of the zones of a worker pool with a server group per zone are only listed in the server group
+		// dependencies
+		if len(serverGroupDeps) > 0 && !isServerGroupPerZone(workerConfig) {
+			workerPool.ServerGroupID = &serverGroupDeps[0].ID
+		}
+		workerPools = append(workerPools, workerPool)
 	}
 
 	w.machineDeployments = machineDeployments
 	w.machineClasses = machineClasses
 	w.machineImages = machineImages
+	w.workerPools = workerPools
 
 	return nil
 }
 
 func (w *workerDelegate) generateWorkerPoolHash(pool extensionsv1alpha1.WorkerPool, serverGroupDependency *api.ServerGroupDependency, workerConfig *api.WorkerConfig) (string, error) {
+	// Currently the raw providerConfig is used to generate the hash which has unintended consequences like causing machine
+	// rollouts. Instead the provider-extension should be capable of providing information
+	if !w.hasPreserveAnnotation() {
+		pool.ProviderConfig = nil
+	}
+
+	// Generate the worker pool hash.
+	return w.naming.WorkerPoolHash(pool, w.cluster, WorkerPoolHashData(serverGroupDependency, workerConfig)...)
+}
+
+// WorkerPoolHashData returns the additional data the hash of a worker pool is computed with, i.e. the values of its
+// provider config which require new machines if they are changed. The data is ordered deterministically.
+func WorkerPoolHashData(serverGroupDependency *api.ServerGroupDependency, workerConfig *api.WorkerConfig) []string {
 	var additionalHashData []string
 
 	// Include the given worker pool dependencies into the hash.
@@ -255,14 +429,62 @@ func (w *workerDelegate) generateWorkerPoolHash(pool extensionsv1alpha1.WorkerPo
 		additionalHashData = append(additionalHashData, pairs...)
 	}
 
-	// Currently the raw providerConfig is used to generate the hash which has unintended consequences like causing machine
-	// rollouts. Instead the provider-extension should be capable of providing information
-	if !w.hasPreserveAnnotation() {
-		pool.ProviderConfig = nil
+	// include the server metadata marked for rolling
+	if metadata := workerConfig.ServerMetadata; metadata != nil && metadata.TriggerRollingOnUpdate {
+		for _, key := range sets.List(sets.KeySet(metadata.Items)) {
+			additionalHashData = append(additionalHashData, "serverMetadata:"+key+"="+metadata.Items[key])
+		}
 	}
 
-	// Generate the worker pool hash.
-	return worker.WorkerPoolHash(pool, w.cluster, additionalHashData...)
+	// include the extra values of the machine classes
+	additionalHashData = append(additionalHashData, machineClassExtraHashData(workerConfig)...)
+
+	// include the hosts the machines are pinned to
+	additionalHashData = append(additionalHashData, hostPinningHashData(workerConfig)...)
+
+	// include the root volume the machines boot from
+	additionalHashData = append(additionalHashData, rootVolumeHashData(workerConfig)...)
+
+	// include the data volumes attached to the machines
+	additionalHashData = append(additionalHashData, dataVolumesHashData(workerConfig.DataVolumes)...)
+
+	// include the configuration of the ports and the additional networks the machines are attached to
+	additionalHashData = append(additionalHashData, networksHashData(workerConfig)...)
+
+	// include the additional security groups, which are only assigned to the servers when they are created
+	for _, securityGroup := range workerConfig.AdditionalSecurityGroups {
+		additionalHashData = append(additionalHashData, "securityGroup="+securityGroup)
+	}
+
+	// include the scheduler hints, which only affect the placement of new servers
+	for _, key := range sets.List(sets.KeySet(workerConfig.SchedulerHints)) {
+		additionalHashData = append(additionalHashData, "schedulerHint:"+key+"="+strings.Join(workerConfig.SchedulerHints[key], ","))
+	}
+
+	// include the bare metal configuration, which only affects the placement of new servers
+	additionalHashData = append(additionalHashData, bareMetalHashData(workerConfig)...)
+
+	// include whether the user data is provided on a config drive, which is only attached when the machines are created
+	if workerConfig.ConfigDrive != nil {
+		additionalHashData = append(additionalHashData, "configDrive="+strconv.FormatBool(*workerConfig.ConfigDrive))
+	}
+
+	// include the key pair of the worker pool, which is only injected into the servers when they are created
+	if workerConfig.KeyName != nil {
+		additionalHashData = append(additionalHashData, "keyName="+*workerConfig.KeyName)
+	}
+
+	// include the format of the user data, which is only processed when the machines are created
+	if isIgnitionUserData(workerConfig) {
+		additionalHashData = append(additionalHashData, "userDataFormat="+string(api.UserDataFormatIgnition))
+	}
+
+	// include whether the machines are preemptible, as only new servers are marked as preemptible
+	if isPreemptible(workerConfig) {
+		additionalHashData = append(additionalHashData, "preemptible")
+	}
+
+	return additionalHashData
 }
 
 // NormalizeLabelsForMachineClass because metadata in OpenStack resources do not allow for certain characters that present in k8s labels e.g. "/",
@@ -284,6 +506,16 @@ func (w *workerDelegate) hasPreserveAnnotation() bool {
 	return false
 }
 
+// updateStrategyParameters returns the maxSurge and maxUnavailable of a machine deployment for the given update strategy.
+// The machine deployments are always rolled by MCM's RollingUpdate strategy, hence the Recreate strategy is realized by
+// deleting all machines before any replacement is created.
+func updateStrategyParameters(strategy *api.UpdateStrategyType, maxSurge, maxUnavailable intstr.IntOrString) (intstr.IntOrString, intstr.IntOrString) {
+	if strategy != nil && *strategy == api.UpdateStrategyRecreate {
+		return intstr.FromInt(0), intstr.FromString("100%")
+	}
+	return maxSurge, maxUnavailable
+}
+
 func addTopologyLabel(labels map[string]string, zone string) map[string]string {
 	return utils.MergeStringMaps(labels, map[string]string{
 		openstack.CSIDiskDriverTopologyKey:   zone,
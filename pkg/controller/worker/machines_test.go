@@ -6,6 +6,7 @@ package worker_test
 
 import (
 	"context"
+	"embed"
 	"encoding/json"
 	"fmt"
 	"path/filepath"
@@ -22,22 +23,32 @@ import (
 	mockkubernetes "github.com/gardener/gardener/pkg/client/kubernetes/mock"
 	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
 	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/test"
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/images"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener-extension-provider-openstack/charts"
 	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
 	apiv1alpha1 "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/v1alpha1"
 	. "github.com/gardener/gardener-extension-provider-openstack/pkg/controller/worker"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/features"
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client/mocks"
 )
 
 var _ = Describe("Machines", func() {
@@ -69,7 +80,7 @@ var _ = Describe("Machines", func() {
 
 	Context("workerDelegate", func() {
 		BeforeEach(func() {
-			workerDelegate, _ = NewWorkerDelegate(nil, scheme, nil, "", nil, nil, nil)
+			workerDelegate, _ = NewWorkerDelegate(nil, scheme, nil, "", nil, nil, nil, &record.FakeRecorder{}, nil)
 		})
 
 		Describe("#TestLabelNormalization", func() {
@@ -142,10 +153,33 @@ var _ = Describe("Machines", func() {
 				clusterWithoutImages   *extensionscontroller.Cluster
 				cluster                *extensionscontroller.Cluster
 				w                      *extensionsv1alpha1.Worker
+
+				existingDeployments []machinev1alpha1.MachineDeployment
+				existingSecrets     map[string]*corev1.Secret
 			)
 
 			BeforeEach(func() {
 				namespace = "shoot--foobar--openstack"
+				c.EXPECT().Get(gomock.Any(), client.ObjectKey{Namespace: namespace, Name: openstack.ApplicationCredentialSecretName}, gomock.AssignableToTypeOf(&corev1.Secret{})).
+					Return(apierrors.NewNotFound(corev1.Resource("secrets"), openstack.ApplicationCredentialSecretName)).AnyTimes()
+				c.EXPECT().Get(gomock.Any(), client.ObjectKey{Namespace: namespace, Name: openstack.DedicatedProjectSecretName}, gomock.AssignableToTypeOf(&corev1.Secret{})).
+					Return(apierrors.NewNotFound(corev1.Resource("secrets"), openstack.DedicatedProjectSecretName)).AnyTimes()
+				existingSecrets = map[string]*corev1.Secret{}
+				c.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(&corev1.Secret{})).
+					DoAndReturn(func(_ context.Context, key client.ObjectKey, secret *corev1.Secret, _ ...client.GetOption) error {
+						existing, ok := existingSecrets[key.Name]
+						if !ok {
+							return apierrors.NewNotFound(corev1.Resource("secrets"), key.Name)
+						}
+						existing.DeepCopyInto(secret)
+						return nil
+					}).AnyTimes()
+				existingDeployments = nil
+				c.EXPECT().List(gomock.Any(), gomock.AssignableToTypeOf(&machinev1alpha1.MachineDeploymentList{}), gomock.Any()).
+					DoAndReturn(func(_ context.Context, list *machinev1alpha1.MachineDeploymentList, _ ...client.ListOption) error {
+						list.Items = existingDeployments
+						return nil
+					}).AnyTimes()
 				cloudProfileName = "openstack"
 
 				region = "eu-de-1"
@@ -349,7 +383,7 @@ var _ = Describe("Machines", func() {
 				workerPoolHash1, _ = worker.WorkerPoolHash(w.Spec.Pools[0], cluster)
 				workerPoolHash2, _ = worker.WorkerPoolHash(w.Spec.Pools[1], cluster)
 
-				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, clusterWithoutImages, nil)
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, clusterWithoutImages, nil, &record.FakeRecorder{}, nil)
 			})
 
 			Describe("machine images", func() {
@@ -361,6 +395,23 @@ var _ = Describe("Machines", func() {
 					clusterWithRegion   *extensionscontroller.Cluster
 				)
 
+				expectedWorkerPools := func(imageName, imageID string) []apiv1alpha1.WorkerPoolStatus {
+					var workerPools []apiv1alpha1.WorkerPoolStatus
+					for _, name := range []string{namePool1, namePool2} {
+						workerPools = append(workerPools, apiv1alpha1.WorkerPoolStatus{
+							Name:              name,
+							Flavor:            machineType,
+							ImageID:           imageID,
+							ImageName:         imageName,
+							SecurityGroups:    []string{securityGroupName},
+							NetworkID:         networkID,
+							SubnetID:          subnetID,
+							AvailabilityZones: []string{zone1, zone2},
+						})
+					}
+					return workerPools
+				}
+
 				setup := func(region, name, imageID string) {
 					workerWithRegion = w.DeepCopy()
 					workerWithRegion.Spec.Region = region
@@ -494,7 +545,7 @@ var _ = Describe("Machines", func() {
 
 				It("should return the expected machine deployments for profile image types", func() {
 					setup(region, machineImage, "")
-					workerDelegate, _ := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil)
+					workerDelegate, _ := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
 
 					// Test workerDelegate.DeployMachineClasses()
 					chartApplier.
@@ -527,6 +578,7 @@ var _ = Describe("Machines", func() {
 								Architecture: pointer.String(v1beta1constants.ArchitectureAMD64),
 							},
 						},
+						WorkerPools: expectedWorkerPools(machineImage, ""),
 					}
 
 					workerWithExpectedImages := w.DeepCopy()
@@ -548,9 +600,114 @@ var _ = Describe("Machines", func() {
 					Expect(result).To(Equal(machineDeployments))
 				})
 
+				It("should derive the node template capacity from the resources of the flavor", func() {
+					setup(region, machineImage, "")
+
+					var (
+						osFactory     = mocks.NewMockFactory(ctrl)
+						computeClient = mocks.NewMockCompute(ctrl)
+					)
+					osFactory.EXPECT().Compute(gomock.Any()).Return(computeClient, nil).AnyTimes()
+					computeClient.EXPECT().ListFlavors(gomock.Any()).Return([]flavors.Flavor{
+						{ID: "flavor-id", Name: machineType, VCPUs: 8, RAM: 128 * 1024},
+					}, nil)
+					computeClient.EXPECT().GetFlavorExtraSpecs("flavor-id").Return(map[string]string{
+						"resources:VCPU": "0",
+						"resources:PCPU": "4",
+					}, nil)
+
+					capacity := nodeCapacity.DeepCopy()
+					capacity["cpu"] = *resource.NewQuantity(4, resource.DecimalSI)
+					for _, class := range machineClasses["machineClasses"].([]map[string]interface{}) {
+						nodeTemplate := class["nodeTemplate"].(machinev1alpha1.NodeTemplate)
+						nodeTemplate.Capacity = capacity
+						class["nodeTemplate"] = nodeTemplate
+					}
+
+					workerDelegate, _ := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, osFactory, &record.FakeRecorder{}, nil)
+					chartApplier.
+						EXPECT().
+						ApplyFromEmbeddedFS(
+							context.TODO(),
+							charts.InternalChart,
+							filepath.Join("internal", "machineclass"),
+							namespace,
+							"machineclass",
+							kubernetes.Values(machineClasses),
+						).
+						Return(nil)
+
+					Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+				})
+
+				It("should use the capacity of the cloud profile if the flavor cannot be found", func() {
+					setup(region, machineImage, "")
+
+					var (
+						osFactory     = mocks.NewMockFactory(ctrl)
+						computeClient = mocks.NewMockCompute(ctrl)
+					)
+					osFactory.EXPECT().Compute(gomock.Any()).Return(computeClient, nil).AnyTimes()
+					computeClient.EXPECT().ListFlavors(gomock.Any()).Return(nil, nil)
+
+					workerDelegate, _ := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, osFactory, &record.FakeRecorder{}, nil)
+					chartApplier.
+						EXPECT().
+						ApplyFromEmbeddedFS(
+							context.TODO(),
+							charts.InternalChart,
+							filepath.Join("internal", "machineclass"),
+							namespace,
+							"machineclass",
+							kubernetes.Values(machineClasses),
+						).
+						Return(nil)
+
+					Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+				})
+
+				It("should derive the ephemeral storage of the node template from the disk of the flavor", func() {
+					setup(region, machineImage, "")
+
+					var (
+						osFactory     = mocks.NewMockFactory(ctrl)
+						computeClient = mocks.NewMockCompute(ctrl)
+					)
+					osFactory.EXPECT().Compute(gomock.Any()).Return(computeClient, nil).AnyTimes()
+					computeClient.EXPECT().ListFlavors(gomock.Any()).Return([]flavors.Flavor{
+						{ID: "flavor-id", Name: machineType, VCPUs: 8, RAM: 128 * 1024, Disk: 64, Ephemeral: 100, Swap: 4096},
+					}, nil)
+					computeClient.EXPECT().GetFlavorExtraSpecs("flavor-id").Return(nil, nil)
+
+					capacity := nodeCapacity.DeepCopy()
+					capacity["cpu"] = *resource.NewQuantity(8, resource.DecimalSI)
+					capacity["memory"] = resource.MustParse("128Gi")
+					capacity["ephemeral-storage"] = resource.MustParse("64Gi")
+					for _, class := range machineClasses["machineClasses"].([]map[string]interface{}) {
+						nodeTemplate := class["nodeTemplate"].(machinev1alpha1.NodeTemplate)
+						nodeTemplate.Capacity = capacity
+						class["nodeTemplate"] = nodeTemplate
+					}
+
+					workerDelegate, _ := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, osFactory, &record.FakeRecorder{}, nil)
+					chartApplier.
+						EXPECT().
+						ApplyFromEmbeddedFS(
+							context.TODO(),
+							charts.InternalChart,
+							filepath.Join("internal", "machineclass"),
+							namespace,
+							"machineclass",
+							kubernetes.Values(machineClasses),
+						).
+						Return(nil)
+
+					Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+				})
+
 				It("should return the expected machine deployments for profile image types with id", func() {
 					setup(regionWithImages, "", machineImageID)
-					workerDelegate, _ := NewWorkerDelegate(c, scheme, chartApplier, "", workerWithRegion, clusterWithRegion, nil)
+					workerDelegate, _ := NewWorkerDelegate(c, scheme, chartApplier, "", workerWithRegion, clusterWithRegion, nil, &record.FakeRecorder{}, nil)
 					clusterWithRegion.Shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{Enabled: pointer.Bool(true)}
 
 					// Test workerDelegate.DeployMachineClasses()
@@ -584,6 +741,7 @@ var _ = Describe("Machines", func() {
 								Architecture: pointer.String(v1beta1constants.ArchitectureAMD64),
 							},
 						},
+						WorkerPools: expectedWorkerPools("", machineImageID),
 					}
 
 					workerWithExpectedImages := workerWithRegion.DeepCopy()
@@ -660,7 +818,7 @@ var _ = Describe("Machines", func() {
 							},
 						}
 
-						workerDelegate, _ := NewWorkerDelegate(c, scheme, chartApplier, "", workerWithServerGroup, cluster, nil)
+						workerDelegate, _ := NewWorkerDelegate(c, scheme, chartApplier, "", workerWithServerGroup, cluster, nil, &record.FakeRecorder{}, nil)
 
 						// Test workerDelegate.DeployMachineClasses()
 						workerPoolHash1, _ := worker.WorkerPoolHash(w.Spec.Pools[0], cluster, serverGroupID1)
@@ -720,6 +878,78 @@ var _ = Describe("Machines", func() {
 						Expect(err).NotTo(HaveOccurred())
 					})
 
+					It("should use the server group of each zone for worker pools with a server group per zone", func() {
+						setup(region, machineImage, "")
+
+						workerWithServerGroup := w.DeepCopy()
+						workerWithServerGroup.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+							Object: &apiv1alpha1.WorkerConfig{
+								TypeMeta: metav1.TypeMeta{
+									Kind:       "WorkerConfig",
+									APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+								},
+								ServerGroup: &apiv1alpha1.ServerGroup{
+									Policy:  "policy",
+									PerZone: pointer.Bool(true),
+								},
+							},
+						}
+						workerWithServerGroup.Status.ProviderStatus = &runtime.RawExtension{
+							Object: &apiv1alpha1.WorkerStatus{
+								TypeMeta: metav1.TypeMeta{
+									Kind:       "WorkerStatus",
+									APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+								},
+								ServerGroupDependencies: []apiv1alpha1.ServerGroupDependency{
+									{
+										PoolName: namePool1,
+										Name:     "servergroup1",
+										ID:       "id1",
+										Zone:     pointer.String(zone1),
+									},
+									{
+										PoolName: namePool1,
+										Name:     "servergroup2",
+										ID:       "id2",
+										Zone:     pointer.String(zone2),
+									},
+								},
+							},
+						}
+
+						workerDelegate, _ := NewWorkerDelegate(c, scheme, chartApplier, "", workerWithServerGroup, cluster, nil, &record.FakeRecorder{}, nil)
+
+						var machineClassValues map[string]interface{}
+						chartApplier.EXPECT().
+							ApplyFromEmbeddedFS(context.TODO(), charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+							DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+								applyOpts := &kubernetes.ApplyOptions{}
+								for _, opt := range opts {
+									opt.MutateApplyOptions(applyOpts)
+								}
+								machineClassValues = applyOpts.Values.(map[string]interface{})
+								return nil
+							})
+						Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+
+						serverGroupIDs := map[string]interface{}{}
+						for _, class := range machineClassValues["machineClasses"].([]map[string]interface{}) {
+							if strings.Contains(class["name"].(string), namePool1) {
+								serverGroupIDs[class["availabilityZone"].(string)] = class["serverGroupID"]
+							} else {
+								Expect(class).NotTo(HaveKey("serverGroupID"))
+							}
+						}
+						Expect(serverGroupIDs).To(Equal(map[string]interface{}{zone1: "id1", zone2: "id2"}))
+
+						workerPoolHash1, _ := worker.WorkerPoolHash(w.Spec.Pools[0], cluster, "id1")
+						workerPoolHash2, _ := worker.WorkerPoolHash(w.Spec.Pools[0], cluster, "id2")
+						machineDeployments, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+						Expect(err).NotTo(HaveOccurred())
+						Expect(machineDeployments[0].ClassName).To(HaveSuffix(workerPoolHash1))
+						Expect(machineDeployments[1].ClassName).To(HaveSuffix(workerPoolHash2))
+					})
+
 					It("should fail if the server group dependencies do not exist", func() {
 						setup(region, machineImage, "")
 
@@ -736,7 +966,7 @@ var _ = Describe("Machines", func() {
 							},
 						}
 
-						workerDelegate, _ := NewWorkerDelegate(c, scheme, chartApplier, "", workerWithServerGroup, cluster, nil)
+						workerDelegate, _ := NewWorkerDelegate(c, scheme, chartApplier, "", workerWithServerGroup, cluster, nil, &record.FakeRecorder{}, nil)
 						err := workerDelegate.DeployMachineClasses(context.TODO())
 						Expect(err).To(HaveOccurred())
 						Expect(err.Error()).To(Equal(`server group is required for pool "pool-1", but no server group dependency found`))
@@ -777,7 +1007,7 @@ var _ = Describe("Machines", func() {
 							w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
 								Raw: encode(workerConfig),
 							}
-							workerDelegate, _ := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil)
+							workerDelegate, _ := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
 							result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 							Expect(err).NotTo(HaveOccurred())
 							Expect(result[0].Labels).To(HaveKeyWithValue("k1", "v1"))
@@ -854,9 +1084,372 @@ var _ = Describe("Machines", func() {
 				})
 			})
 
+			expectMachineDeployments := func(deployments ...machinev1alpha1.MachineDeployment) {
+				existingDeployments = deployments
+			}
+
+			machineDeployment := func(name, className string, replicas, updated, available int32, progressingSince time.Time) machinev1alpha1.MachineDeployment {
+				return machinev1alpha1.MachineDeployment{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+					Spec: machinev1alpha1.MachineDeploymentSpec{
+						Replicas: replicas,
+						Template: machinev1alpha1.MachineTemplateSpec{
+							Spec: machinev1alpha1.MachineSpec{
+								Class: machinev1alpha1.ClassSpec{Name: className},
+							},
+						},
+					},
+					Status: machinev1alpha1.MachineDeploymentStatus{
+						Replicas:          replicas,
+						UpdatedReplicas:   updated,
+						AvailableReplicas: available,
+						Conditions: []machinev1alpha1.MachineDeploymentCondition{
+							{
+								Type:           machinev1alpha1.MachineDeploymentProgressing,
+								LastUpdateTime: metav1.NewTime(progressingSince),
+							},
+						},
+					},
+				}
+			}
+
+			Context("Canary Rollout", func() {
+				var (
+					canaryDeploymentName string
+					otherDeploymentName  string
+				)
+
+				BeforeEach(func() {
+					canaryDeploymentName = fmt.Sprintf("%s-%s-z1", namespace, namePool1)
+					otherDeploymentName = fmt.Sprintf("%s-%s-z2", namespace, namePool1)
+
+					w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+						Raw: encode(&apiv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       "WorkerConfig",
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							},
+							Canary: &apiv1alpha1.CanaryRollout{
+								Machines: pointer.Int32(1),
+								SoakTime: &metav1.Duration{Duration: 10 * time.Minute},
+							},
+						}),
+					}
+					w.Status.ProviderStatus = &runtime.RawExtension{
+						Raw: encode(&apiv1alpha1.WorkerStatus{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       "WorkerStatus",
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							},
+							VerifiedImages: []apiv1alpha1.VerifiedImage{{Region: region, Image: machineImage, ID: machineImageID}},
+						}),
+					}
+				})
+
+				It("should only roll the canary zone first", func() {
+					expectMachineDeployments(
+						machineDeployment(canaryDeploymentName, canaryDeploymentName+"-old", 3, 3, 3, time.Now().Add(-time.Hour)),
+						machineDeployment(otherDeploymentName, otherDeploymentName+"-old", 3, 3, 3, time.Now().Add(-time.Hour)),
+					)
+
+					workerDelegate, _ := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+					result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(result[0].ClassName).NotTo(Equal(canaryDeploymentName + "-old"))
+					Expect(result[0].MaxSurge).To(Equal(intstr.FromInt(1)))
+					Expect(result[0].MaxUnavailable).To(Equal(intstr.FromInt(0)))
+					Expect(result[1].ClassName).To(Equal(otherDeploymentName + "-old"))
+					Expect(result[1].SecretName).To(Equal(otherDeploymentName + "-old"))
+				})
+
+				It("should hold back the remaining zones while the canary zone is soaking", func() {
+					workerDelegate, _ := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+					expectMachineDeployments()
+					result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+
+					expectMachineDeployments(
+						machineDeployment(canaryDeploymentName, result[0].ClassName, 3, 3, 3, time.Now().Add(-time.Minute)),
+						machineDeployment(otherDeploymentName, otherDeploymentName+"-old", 3, 3, 3, time.Now().Add(-time.Hour)),
+					)
+
+					workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+					result, err = workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result[1].ClassName).To(Equal(otherDeploymentName + "-old"))
+				})
+
+				It("should hold back the remaining zones while the canary zone has unavailable machines", func() {
+					workerDelegate, _ := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+					expectMachineDeployments()
+					result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+
+					expectMachineDeployments(
+						machineDeployment(canaryDeploymentName, result[0].ClassName, 3, 3, 2, time.Now().Add(-time.Hour)),
+						machineDeployment(otherDeploymentName, otherDeploymentName+"-old", 3, 3, 3, time.Now().Add(-time.Hour)),
+					)
+
+					workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+					result, err = workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result[1].ClassName).To(Equal(otherDeploymentName + "-old"))
+				})
+
+				It("should roll the remaining zones once the canary zone has been soaked", func() {
+					workerDelegate, _ := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+					expectMachineDeployments()
+					wanted, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+
+					expectMachineDeployments(
+						machineDeployment(canaryDeploymentName, wanted[0].ClassName, 3, 3, 3, time.Now().Add(-time.Hour)),
+						machineDeployment(otherDeploymentName, otherDeploymentName+"-old", 3, 3, 3, time.Now().Add(-time.Hour)),
+					)
+
+					workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+					result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result).To(Equal(wanted))
+				})
+			})
+
+			Context("Zone Rollout", func() {
+				var (
+					firstDeploymentName  string
+					secondDeploymentName string
+				)
+
+				BeforeEach(func() {
+					firstDeploymentName = fmt.Sprintf("%s-%s-z1", namespace, namePool1)
+					secondDeploymentName = fmt.Sprintf("%s-%s-z2", namespace, namePool1)
+
+					w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+						Raw: encode(&apiv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       "WorkerConfig",
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							},
+							ZoneRollout: &apiv1alpha1.ZoneRollout{
+								GracePeriod: &metav1.Duration{Duration: 10 * time.Minute},
+							},
+						}),
+					}
+					w.Status.ProviderStatus = &runtime.RawExtension{
+						Raw: encode(&apiv1alpha1.WorkerStatus{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       "WorkerStatus",
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							},
+							VerifiedImages: []apiv1alpha1.VerifiedImage{{Region: region, Image: machineImage, ID: machineImageID}},
+						}),
+					}
+				})
+
+				It("should not hold back the zones of a new worker pool", func() {
+					expectMachineDeployments()
+
+					workerDelegate, _ := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+					result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(result[0].MaxSurge).To(Equal(worker.DistributePositiveIntOrPercent(0, maxSurgePool1, 2, maxPool1)))
+					Expect(result[1].MaxSurge).To(Equal(worker.DistributePositiveIntOrPercent(1, maxSurgePool1, 2, maxPool1)))
+				})
+
+				It("should roll the first zone with the parameters of the whole pool and hold back the second zone", func() {
+					expectMachineDeployments(
+						machineDeployment(firstDeploymentName, firstDeploymentName+"-old", 3, 3, 3, time.Now().Add(-time.Hour)),
+						machineDeployment(secondDeploymentName, secondDeploymentName+"-old", 3, 3, 3, time.Now().Add(-time.Hour)),
+					)
+
+					workerDelegate, _ := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+					result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(result[0].ClassName).NotTo(Equal(firstDeploymentName + "-old"))
+					Expect(result[0].MaxSurge).To(Equal(maxSurgePool1))
+					Expect(result[0].MaxUnavailable).To(Equal(maxUnavailablePool1))
+					Expect(result[1].ClassName).To(Equal(secondDeploymentName + "-old"))
+					Expect(result[1].SecretName).To(Equal(secondDeploymentName + "-old"))
+					Expect(result[1].MaxSurge).To(Equal(worker.DistributePositiveIntOrPercent(1, maxSurgePool1, 2, maxPool1)))
+				})
+
+				It("should hold back the second zone during the grace period of the first zone", func() {
+					workerDelegate, _ := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+					expectMachineDeployments()
+					result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+
+					expectMachineDeployments(
+						machineDeployment(firstDeploymentName, result[0].ClassName, 3, 3, 3, time.Now().Add(-time.Minute)),
+						machineDeployment(secondDeploymentName, secondDeploymentName+"-old", 3, 3, 3, time.Now().Add(-time.Hour)),
+					)
+
+					workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+					result, err = workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result[1].ClassName).To(Equal(secondDeploymentName + "-old"))
+				})
+
+				It("should roll the second zone with the parameters of the whole pool once the first zone has passed its grace period", func() {
+					workerDelegate, _ := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+					expectMachineDeployments()
+					wanted, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+
+					expectMachineDeployments(
+						machineDeployment(firstDeploymentName, wanted[0].ClassName, 3, 3, 3, time.Now().Add(-time.Hour)),
+						machineDeployment(secondDeploymentName, secondDeploymentName+"-old", 3, 3, 3, time.Now().Add(-time.Hour)),
+					)
+
+					workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+					result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result[0]).To(Equal(wanted[0]))
+					Expect(result[1].ClassName).To(Equal(wanted[1].ClassName))
+					Expect(result[1].MaxSurge).To(Equal(maxSurgePool1))
+					Expect(result[1].MaxUnavailable).To(Equal(maxUnavailablePool1))
+				})
+			})
+
+			Context("Image Verification", func() {
+				var (
+					osFactory     *mocks.MockFactory
+					computeClient *mocks.MockCompute
+				)
+
+				BeforeEach(func() {
+					osFactory = mocks.NewMockFactory(ctrl)
+					computeClient = mocks.NewMockCompute(ctrl)
+					osFactory.EXPECT().Compute(gomock.Any()).Return(computeClient, nil).AnyTimes()
+					computeClient.EXPECT().ListFlavors(gomock.Any()).Return([]flavors.Flavor{{ID: "flavor-id", Name: machineType}}, nil).AnyTimes()
+					computeClient.EXPECT().GetFlavorExtraSpecs("flavor-id").Return(nil, nil).AnyTimes()
+
+					for _, pool := range []string{namePool1, namePool2} {
+						for _, zone := range []string{"z1", "z2"} {
+							name := fmt.Sprintf("%s-%s-%s", namespace, pool, zone)
+							existingDeployments = append(existingDeployments, machinev1alpha1.MachineDeployment{
+								ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+								Spec: machinev1alpha1.MachineDeploymentSpec{
+									Template: machinev1alpha1.MachineTemplateSpec{
+										Spec: machinev1alpha1.MachineSpec{Class: machinev1alpha1.ClassSpec{Name: name + "-old"}},
+									},
+								},
+							})
+						}
+					}
+				})
+
+				It("should roll the worker pools once their image has been verified", func() {
+					computeClient.EXPECT().FindImages(machineImage).Return([]images.Image{{ID: machineImageID, Status: "ACTIVE"}}, nil)
+
+					workerDelegate, _ := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, osFactory, &record.FakeRecorder{}, nil)
+					result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+					for _, deployment := range result {
+						Expect(deployment.ClassName).NotTo(HaveSuffix("-old"))
+					}
+
+					c.EXPECT().Status().Return(statusWriter)
+					statusWriter.EXPECT().Patch(gomock.Any(), w, gomock.Any()).Return(nil)
+					Expect(workerDelegate.UpdateMachineImagesStatus(context.TODO())).To(Succeed())
+					Expect(w.Status.ProviderStatus.Object.(*apiv1alpha1.WorkerStatus).VerifiedImages).To(ConsistOf(
+						apiv1alpha1.VerifiedImage{Region: region, Image: machineImage, ID: machineImageID},
+					))
+					Expect(w.Status.ProviderStatus.Object.(*apiv1alpha1.WorkerStatus).WorkerPools).To(ConsistOf(
+						MatchFields(IgnoreExtras, Fields{"Name": Equal(namePool1), "ImageName": Equal(machineImage), "ImageID": Equal(machineImageID)}),
+						MatchFields(IgnoreExtras, Fields{"Name": Equal(namePool2), "ImageName": Equal(machineImage), "ImageID": Equal(machineImageID)}),
+					))
+				})
+
+				It("should not look up images which have been verified before", func() {
+					w.Status.ProviderStatus = &runtime.RawExtension{
+						Raw: encode(&apiv1alpha1.WorkerStatus{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       "WorkerStatus",
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							},
+							VerifiedImages: []apiv1alpha1.VerifiedImage{{Region: region, Image: machineImage, ID: machineImageID}},
+						}),
+					}
+
+					workerDelegate, _ := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, osFactory, &record.FakeRecorder{}, nil)
+					result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+					for _, deployment := range result {
+						Expect(deployment.ClassName).NotTo(HaveSuffix("-old"))
+					}
+				})
+
+				It("should hold back the rollout if the image does not exist", func() {
+					computeClient.EXPECT().FindImages(machineImage).Return(nil, nil).Times(2)
+
+					workerDelegate, _ := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, osFactory, &record.FakeRecorder{}, nil)
+					result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+					for _, deployment := range result {
+						Expect(deployment.ClassName).To(Equal(deployment.Name + "-old"))
+						Expect(deployment.SecretName).To(Equal(deployment.Name + "-old"))
+					}
+
+					computeClient.EXPECT().ListServerGroups().Return(nil, nil)
+					c.EXPECT().Status().Return(statusWriter)
+					statusWriter.EXPECT().Patch(gomock.Any(), w, gomock.Any()).Return(nil)
+					err = workerDelegate.PostReconcileHook(context.TODO())
+					Expect(err).To(MatchError(ContainSubstring(fmt.Sprintf("image %q does not exist in region %q", machineImage, region))))
+				})
+
+				It("should hold back the rollout if the image is not active", func() {
+					computeClient.EXPECT().FindImages(machineImage).Return([]images.Image{{ID: machineImageID, Status: "SAVING"}}, nil).Times(2)
+
+					workerDelegate, _ := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, osFactory, &record.FakeRecorder{}, nil)
+					result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+					for _, deployment := range result {
+						Expect(deployment.ClassName).To(Equal(deployment.Name + "-old"))
+					}
+				})
+
+				It("should not verify the image of new worker pools", func() {
+					existingDeployments = nil
+
+					workerDelegate, _ := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, osFactory, &record.FakeRecorder{}, nil)
+					_, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+				})
+			})
+
+			It("should delete the machines before creating replacements for the Recreate update strategy", func() {
+				strategy := apiv1alpha1.UpdateStrategyRecreate
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&apiv1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							Kind:       "WorkerConfig",
+							APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+						},
+						UpdateStrategy: &strategy,
+					}),
+				}
+
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+
+				for _, deployment := range result {
+					if strings.Contains(deployment.Name, namePool1) {
+						Expect(deployment.MaxSurge).To(Equal(intstr.FromInt(0)))
+						Expect(deployment.MaxUnavailable).To(Equal(intstr.FromString("100%")))
+					} else {
+						Expect(deployment.MaxSurge).NotTo(Equal(intstr.FromInt(0)))
+					}
+				}
+			})
+
 			It("should fail because the version is invalid", func() {
 				clusterWithoutImages.Shoot.Spec.Kubernetes.Version = "invalid"
-				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil)
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -866,7 +1459,7 @@ var _ = Describe("Machines", func() {
 			It("should fail because the infrastructure status cannot be decoded", func() {
 				w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{}
 
-				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil)
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -878,7 +1471,7 @@ var _ = Describe("Machines", func() {
 					Raw: encode(&api.InfrastructureStatus{}),
 				}
 
-				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil)
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -888,16 +1481,43 @@ var _ = Describe("Machines", func() {
 			It("should fail because the machine image for this cloud profile cannot be found", func() {
 				clusterWithoutImages.CloudProfile.Name = "another-cloud-profile"
 
-				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, clusterWithoutImages, nil)
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, clusterWithoutImages, nil, &record.FakeRecorder{}, nil)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
 				Expect(result).To(BeNil())
 			})
 
-			It("should set expected machineControllerManager settings on machine deployment", func() {
-				testDrainTimeout := metav1.Duration{Duration: 10 * time.Minute}
-				testHealthTimeout := metav1.Duration{Duration: 20 * time.Minute}
+			It("should take the machine image from the provider status written by older extension versions", func() {
+				w.Status.ProviderStatus = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "openstack.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "WorkerStatus",
+"machineImages": [{"name": "` + machineImageName + `", "version": "` + machineImageVersion + `", "image": "` + machineImage + `", "legacyField": true}],
+"removedField": "bar"
+}`)}
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, clusterWithoutImages, nil, &record.FakeRecorder{}, nil)
+
+				var machineClassValues map[string]interface{}
+				chartApplier.EXPECT().
+					ApplyFromEmbeddedFS(context.TODO(), charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+						applyOpts := &kubernetes.ApplyOptions{}
+						for _, opt := range opts {
+							opt.MutateApplyOptions(applyOpts)
+						}
+						machineClassValues = applyOpts.Values.(map[string]interface{})
+						return nil
+					})
+				Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+
+				for _, class := range machineClassValues["machineClasses"].([]map[string]interface{}) {
+					Expect(class).To(HaveKeyWithValue("imageName", machineImage))
+				}
+			})
+
+			It("should set expected machineControllerManager settings on machine deployment", func() {
+				testDrainTimeout := metav1.Duration{Duration: 10 * time.Minute}
+				testHealthTimeout := metav1.Duration{Duration: 20 * time.Minute}
 				testCreationTimeout := metav1.Duration{Duration: 30 * time.Minute}
 				testMaxEvictRetries := int32(30)
 				testNodeConditions := []string{"ReadonlyFilesystem", "KernelDeadlock", "DiskPressure"}
@@ -909,7 +1529,7 @@ var _ = Describe("Machines", func() {
 					NodeConditions:         testNodeConditions,
 				}
 
-				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil)
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				resultSettings := result[0].MachineConfiguration
@@ -922,6 +1542,1365 @@ var _ = Describe("Machines", func() {
 				Expect(resultSettings.MaxEvictRetries).To(Equal(&testMaxEvictRetries))
 				Expect(resultSettings.NodeConditions).To(Equal(&resultNodeConditions))
 			})
+
+			It("should configure the nodes of worker pools with vGPUs for the NVIDIA GPU operator", func() {
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&apiv1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							Kind:       "WorkerConfig",
+							APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+						},
+						VGPU: &apiv1alpha1.VGPU{
+							TimeSlicingReplicas: pointer.Int32(4),
+							DevicePluginConfig:  pointer.String("time-sliced-4"),
+						},
+					}),
+				}
+
+				var (
+					osFactory     = mocks.NewMockFactory(ctrl)
+					computeClient = mocks.NewMockCompute(ctrl)
+				)
+				osFactory.EXPECT().Compute(gomock.Any()).Return(computeClient, nil).AnyTimes()
+				computeClient.EXPECT().ListFlavors(gomock.Any()).Return([]flavors.Flavor{{ID: "flavor-id", Name: w.Spec.Pools[0].MachineType}}, nil)
+				computeClient.EXPECT().GetFlavorExtraSpecs("flavor-id").Return(map[string]string{"resources:VGPU": "2"}, nil)
+
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, osFactory, &record.FakeRecorder{}, nil)
+
+				var machineClassValues map[string]interface{}
+				chartApplier.EXPECT().
+					ApplyFromEmbeddedFS(context.TODO(), charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+						applyOpts := &kubernetes.ApplyOptions{}
+						for _, opt := range opts {
+							opt.MutateApplyOptions(applyOpts)
+						}
+						machineClassValues = applyOpts.Values.(map[string]interface{})
+						return nil
+					})
+				Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+
+				for _, class := range machineClassValues["machineClasses"].([]map[string]interface{}) {
+					capacity := class["nodeTemplate"].(machinev1alpha1.NodeTemplate).Capacity
+					if strings.Contains(class["name"].(string), namePool1) {
+						Expect(capacity).To(HaveKeyWithValue(corev1.ResourceName("gpu"), *resource.NewQuantity(8, resource.DecimalSI)))
+					} else {
+						// the vGPUs of the flavor are added without time-slicing to worker pools without vGPU configuration
+						Expect(capacity).To(HaveKeyWithValue(corev1.ResourceName("gpu"), *resource.NewQuantity(2, resource.DecimalSI)))
+					}
+				}
+
+				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+				for _, deployment := range result {
+					if strings.Contains(deployment.Name, namePool1) {
+						Expect(deployment.Labels).To(HaveKeyWithValue("nvidia.com/gpu.present", "true"))
+						Expect(deployment.Labels).To(HaveKeyWithValue("nvidia.com/device-plugin.config", "time-sliced-4"))
+						Expect(deployment.Taints).To(ContainElement(corev1.Taint{Key: "nvidia.com/gpu", Value: "present", Effect: corev1.TaintEffectNoSchedule}))
+					} else {
+						Expect(deployment.Labels).NotTo(HaveKey("nvidia.com/gpu.present"))
+						Expect(deployment.Taints).NotTo(ContainElement(HaveField("Key", "nvidia.com/gpu")))
+					}
+				}
+			})
+
+			It("should add the GPUs passed through to the flavor to the node template", func() {
+				cloudProfileConfig.GPU = &api.GPUConfig{
+					ResourceName: pointer.String("nvidia.com/gpu"),
+					PCIAliases:   []string{"a100"},
+				}
+				cluster.CloudProfile.Spec.ProviderConfig.Raw, _ = json.Marshal(cloudProfileConfig)
+
+				var (
+					osFactory     = mocks.NewMockFactory(ctrl)
+					computeClient = mocks.NewMockCompute(ctrl)
+				)
+				osFactory.EXPECT().Compute(gomock.Any()).Return(computeClient, nil).AnyTimes()
+				computeClient.EXPECT().ListFlavors(gomock.Any()).Return([]flavors.Flavor{{ID: "flavor-id", Name: w.Spec.Pools[0].MachineType}}, nil)
+				computeClient.EXPECT().GetFlavorExtraSpecs("flavor-id").Return(map[string]string{"pci_passthrough:alias": "a100:2,nic:1"}, nil)
+
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, osFactory, &record.FakeRecorder{}, nil)
+
+				var machineClassValues map[string]interface{}
+				chartApplier.EXPECT().
+					ApplyFromEmbeddedFS(context.TODO(), charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+						applyOpts := &kubernetes.ApplyOptions{}
+						for _, opt := range opts {
+							opt.MutateApplyOptions(applyOpts)
+						}
+						machineClassValues = applyOpts.Values.(map[string]interface{})
+						return nil
+					})
+				Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+
+				for _, class := range machineClassValues["machineClasses"].([]map[string]interface{}) {
+					capacity := class["nodeTemplate"].(machinev1alpha1.NodeTemplate).Capacity
+					Expect(capacity).To(HaveKeyWithValue(corev1.ResourceName("nvidia.com/gpu"), *resource.NewQuantity(2, resource.DecimalSI)))
+				}
+			})
+
+			Context("machine class extra values", func() {
+				var workerConfigWithExtra *runtime.RawExtension
+
+				BeforeEach(func() {
+					workerConfigWithExtra = &runtime.RawExtension{
+						Raw: encode(&apiv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       "WorkerConfig",
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							},
+							MachineClassExtra: map[string]apiextensionsv1.JSON{
+								"serverMetadata": {Raw: []byte(`{"team": "a"}`)},
+							},
+						}),
+					}
+				})
+
+				It("should pass the allowed extra values to the machine classes of the worker pool", func() {
+					DeferCleanup(test.WithFeatureGate(features.ExtensionFeatureGate, features.MachineClassExtra, true))
+
+					workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+					withoutExtra, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+
+					w.Spec.Pools[0].ProviderConfig = workerConfigWithExtra
+					workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, []string{"serverMetadata"})
+
+					var machineClassValues map[string]interface{}
+					chartApplier.EXPECT().
+						ApplyFromEmbeddedFS(context.TODO(), charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+						DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+							applyOpts := &kubernetes.ApplyOptions{}
+							for _, opt := range opts {
+								opt.MutateApplyOptions(applyOpts)
+							}
+							machineClassValues = applyOpts.Values.(map[string]interface{})
+							return nil
+						})
+					Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+
+					for _, class := range machineClassValues["machineClasses"].([]map[string]interface{}) {
+						if strings.Contains(class["name"].(string), namePool1) {
+							Expect(class).To(HaveKeyWithValue("extra", map[string]interface{}{"serverMetadata": map[string]interface{}{"team": "a"}}))
+						} else {
+							Expect(class).NotTo(HaveKey("extra"))
+						}
+					}
+
+					withExtra, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+					for i := range withExtra {
+						if strings.Contains(withExtra[i].Name, namePool1) {
+							Expect(withExtra[i].ClassName).NotTo(Equal(withoutExtra[i].ClassName))
+						} else {
+							Expect(withExtra[i].ClassName).To(Equal(withoutExtra[i].ClassName))
+						}
+					}
+				})
+
+				It("should fail if the feature gate is disabled", func() {
+					DeferCleanup(test.WithFeatureGate(features.ExtensionFeatureGate, features.MachineClassExtra, false))
+
+					w.Spec.Pools[0].ProviderConfig = workerConfigWithExtra
+					workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, []string{"serverMetadata"})
+
+					_, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).To(MatchError(ContainSubstring("feature gate")))
+				})
+
+				It("should fail if a key is not allowed", func() {
+					DeferCleanup(test.WithFeatureGate(features.ExtensionFeatureGate, features.MachineClassExtra, true))
+
+					w.Spec.Pools[0].ProviderConfig = workerConfigWithExtra
+					workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, []string{"bootFromVolume"})
+
+					_, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).To(MatchError(ContainSubstring(`key "serverMetadata" of machineClassExtra is not allowed`)))
+				})
+			})
+
+			Context("host pinning", func() {
+				var workerConfigWithHostPinning *runtime.RawExtension
+
+				BeforeEach(func() {
+					workerConfigWithHostPinning = &runtime.RawExtension{
+						Raw: encode(&apiv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       "WorkerConfig",
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							},
+							HostPinning: []apiv1alpha1.HostPinning{
+								{Zone: zone1, Host: pointer.String("compute-1")},
+								{Zone: zone2, Node: pointer.String("node-2")},
+							},
+						}),
+					}
+				})
+
+				It("should pin the servers of the zones of the worker pool to the hosts", func() {
+					DeferCleanup(test.WithFeatureGate(features.ExtensionFeatureGate, features.HostPinning, true))
+
+					workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+					withoutPinning, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+
+					w.Spec.Pools[0].ProviderConfig = workerConfigWithHostPinning
+					workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+
+					var machineClassValues map[string]interface{}
+					chartApplier.EXPECT().
+						ApplyFromEmbeddedFS(context.TODO(), charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+						DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+							applyOpts := &kubernetes.ApplyOptions{}
+							for _, opt := range opts {
+								opt.MutateApplyOptions(applyOpts)
+							}
+							machineClassValues = applyOpts.Values.(map[string]interface{})
+							return nil
+						})
+					Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+
+					var availabilityZones []interface{}
+					for _, class := range machineClassValues["machineClasses"].([]map[string]interface{}) {
+						availabilityZones = append(availabilityZones, class["availabilityZone"])
+					}
+					Expect(availabilityZones).To(ConsistOf(zone1+":compute-1", zone2+"::node-2", zone1, zone2))
+
+					withPinning, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+					for i := range withPinning {
+						if strings.Contains(withPinning[i].Name, namePool1) {
+							Expect(withPinning[i].ClassName).NotTo(Equal(withoutPinning[i].ClassName))
+						} else {
+							Expect(withPinning[i].ClassName).To(Equal(withoutPinning[i].ClassName))
+						}
+					}
+				})
+
+				It("should fail if the feature gate is disabled", func() {
+					DeferCleanup(test.WithFeatureGate(features.ExtensionFeatureGate, features.HostPinning, false))
+
+					w.Spec.Pools[0].ProviderConfig = workerConfigWithHostPinning
+					workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+
+					_, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).To(MatchError(ContainSubstring("feature gate")))
+				})
+			})
+
+			It("should boot the machines from the root volume", func() {
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+				withoutRootVolume, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+
+				size := resource.MustParse("50Gi")
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&apiv1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							Kind:       "WorkerConfig",
+							APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+						},
+						RootVolume: &apiv1alpha1.RootVolume{
+							Size:                &size,
+							Type:                pointer.String("ssd"),
+							DeleteOnTermination: pointer.Bool(false),
+						},
+					}),
+				}
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+
+				var machineClassValues map[string]interface{}
+				chartApplier.EXPECT().
+					ApplyFromEmbeddedFS(context.TODO(), charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+						applyOpts := &kubernetes.ApplyOptions{}
+						for _, opt := range opts {
+							opt.MutateApplyOptions(applyOpts)
+						}
+						machineClassValues = applyOpts.Values.(map[string]interface{})
+						return nil
+					})
+				Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+
+				for _, class := range machineClassValues["machineClasses"].([]map[string]interface{}) {
+					if strings.Contains(class["name"].(string), namePool1) {
+						Expect(class).To(HaveKeyWithValue("rootDiskSize", 50))
+						Expect(class).To(HaveKeyWithValue("rootDiskType", "ssd"))
+						Expect(class).To(HaveKeyWithValue("rootDiskDeleteOnTermination", false))
+						ephemeralStorage := class["nodeTemplate"].(machinev1alpha1.NodeTemplate).Capacity[corev1.ResourceEphemeralStorage]
+						Expect(ephemeralStorage.Cmp(size)).To(BeZero())
+					} else {
+						Expect(class).NotTo(HaveKey("rootDiskType"))
+						Expect(class).NotTo(HaveKey("rootDiskDeleteOnTermination"))
+					}
+				}
+
+				withRootVolume, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+				for i := range withRootVolume {
+					if strings.Contains(withRootVolume[i].Name, namePool1) {
+						Expect(withRootVolume[i].ClassName).NotTo(Equal(withoutRootVolume[i].ClassName))
+					} else {
+						Expect(withRootVolume[i].ClassName).To(Equal(withoutRootVolume[i].ClassName))
+					}
+				}
+			})
+
+			It("should resolve the root volume type per zone", func() {
+				cloudProfileConfig.Regions = []api.RegionConfig{{
+					Name:            region,
+					RootVolumeTypes: map[string]string{zone1: "ssd-" + zone1, zone2: "ssd-" + zone2},
+				}}
+				cluster.CloudProfile.Spec.ProviderConfig.Raw, _ = json.Marshal(cloudProfileConfig)
+
+				size := resource.MustParse("50Gi")
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&apiv1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							Kind:       "WorkerConfig",
+							APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+						},
+						RootVolume: &apiv1alpha1.RootVolume{
+							Size:      &size,
+							ZoneTypes: map[string]string{zone2: "premium"},
+						},
+					}),
+				}
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+
+				var machineClassValues map[string]interface{}
+				chartApplier.EXPECT().
+					ApplyFromEmbeddedFS(context.TODO(), charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+						applyOpts := &kubernetes.ApplyOptions{}
+						for _, opt := range opts {
+							opt.MutateApplyOptions(applyOpts)
+						}
+						machineClassValues = applyOpts.Values.(map[string]interface{})
+						return nil
+					})
+				Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+
+				for _, class := range machineClassValues["machineClasses"].([]map[string]interface{}) {
+					switch name := class["name"].(string); {
+					case strings.Contains(name, namePool1+"-z1"):
+						Expect(class).To(HaveKeyWithValue("rootDiskType", "ssd-"+zone1))
+					case strings.Contains(name, namePool1+"-z2"):
+						Expect(class).To(HaveKeyWithValue("rootDiskType", "premium"))
+					default:
+						Expect(class).NotTo(HaveKey("rootDiskType"))
+					}
+				}
+			})
+
+			It("should attach the data volumes to the machines", func() {
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+				withoutDataVolumes, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&apiv1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							Kind:       "WorkerConfig",
+							APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+						},
+						DataVolumes: []apiv1alpha1.DataVolume{
+							{Name: "data", Size: resource.MustParse("100Gi"), Type: pointer.String("ssd")},
+							{Name: "logs", Size: resource.MustParse("10Gi"), InheritAvailabilityZone: pointer.Bool(false), DeleteOnTermination: pointer.Bool(false)},
+						},
+					}),
+				}
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+
+				var machineClassValues map[string]interface{}
+				chartApplier.EXPECT().
+					ApplyFromEmbeddedFS(context.TODO(), charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+						applyOpts := &kubernetes.ApplyOptions{}
+						for _, opt := range opts {
+							opt.MutateApplyOptions(applyOpts)
+						}
+						machineClassValues = applyOpts.Values.(map[string]interface{})
+						return nil
+					})
+				Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+
+				for _, class := range machineClassValues["machineClasses"].([]map[string]interface{}) {
+					nodeTemplate := class["nodeTemplate"].(machinev1alpha1.NodeTemplate)
+					if strings.Contains(class["name"].(string), namePool1) {
+						Expect(class).To(HaveKeyWithValue("dataVolumes", []map[string]interface{}{
+							{"name": "data", "size": 100, "type": "ssd", "availabilityZone": class["availabilityZone"], "deleteOnTermination": true},
+							{"name": "logs", "size": 10, "deleteOnTermination": false},
+						}))
+						Expect(nodeTemplate.Capacity.StorageEphemeral().Cmp(resource.MustParse("110Gi"))).To(BeZero())
+					} else {
+						Expect(class).NotTo(HaveKey("dataVolumes"))
+						Expect(nodeTemplate.Capacity).NotTo(HaveKey(corev1.ResourceEphemeralStorage))
+					}
+				}
+
+				withDataVolumes, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+				for i := range withDataVolumes {
+					if strings.Contains(withDataVolumes[i].Name, namePool1) {
+						Expect(withDataVolumes[i].ClassName).NotTo(Equal(withoutDataVolumes[i].ClassName))
+					} else {
+						Expect(withDataVolumes[i].ClassName).To(Equal(withoutDataVolumes[i].ClassName))
+					}
+				}
+			})
+
+			It("should provide the user data on a config drive", func() {
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+				withoutConfigDrive, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&apiv1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							Kind:       "WorkerConfig",
+							APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+						},
+						ConfigDrive: pointer.Bool(true),
+					}),
+				}
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+
+				var machineClassValues map[string]interface{}
+				chartApplier.EXPECT().
+					ApplyFromEmbeddedFS(context.TODO(), charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+						applyOpts := &kubernetes.ApplyOptions{}
+						for _, opt := range opts {
+							opt.MutateApplyOptions(applyOpts)
+						}
+						machineClassValues = applyOpts.Values.(map[string]interface{})
+						return nil
+					})
+				Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+
+				for _, class := range machineClassValues["machineClasses"].([]map[string]interface{}) {
+					if strings.Contains(class["name"].(string), namePool1) {
+						Expect(class).To(HaveKeyWithValue("useConfigDrive", true))
+					} else {
+						Expect(class).NotTo(HaveKey("useConfigDrive"))
+					}
+				}
+
+				withConfigDrive, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+				for i := range withConfigDrive {
+					if strings.Contains(withConfigDrive[i].Name, namePool1) {
+						Expect(withConfigDrive[i].ClassName).NotTo(Equal(withoutConfigDrive[i].ClassName))
+					} else {
+						Expect(withConfigDrive[i].ClassName).To(Equal(withoutConfigDrive[i].ClassName))
+					}
+				}
+			})
+
+			It("should only render the secrets of the machine classes whose content changed", func() {
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+				machineDeployments, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+
+				secretLabels := map[string]string{v1beta1constants.GardenerPurpose: v1beta1constants.GardenPurposeMachineClass}
+				// unchanged
+				existingSecrets[machineDeployments[0].ClassName] = &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: machineDeployments[0].ClassName, Labels: secretLabels},
+					Data:       map[string][]byte{"userData": userData},
+				}
+				// outdated user data
+				existingSecrets[machineDeployments[1].ClassName] = &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: machineDeployments[1].ClassName, Labels: secretLabels},
+					Data:       map[string][]byte{"userData": []byte("outdated")},
+				}
+				// missing labels
+				existingSecrets[machineDeployments[2].ClassName] = &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: machineDeployments[2].ClassName},
+					Data:       map[string][]byte{"userData": userData},
+				}
+
+				var machineClassValues map[string]interface{}
+				chartApplier.EXPECT().
+					ApplyFromEmbeddedFS(context.TODO(), charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+						applyOpts := &kubernetes.ApplyOptions{}
+						for _, opt := range opts {
+							opt.MutateApplyOptions(applyOpts)
+						}
+						machineClassValues = applyOpts.Values.(map[string]interface{})
+						return nil
+					})
+				Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+
+				machineClasses := machineClassValues["machineClasses"].([]map[string]interface{})
+				Expect(machineClasses).To(HaveLen(4))
+				Expect(machineClasses[0]).NotTo(HaveKey("secret"))
+				for _, machineClass := range machineClasses[1:] {
+					Expect(machineClass).To(HaveKeyWithValue("secret", map[string]interface{}{"cloudConfig": string(userData)}))
+				}
+			})
+
+			Context("user data format", func() {
+				var machineClassValues map[string]interface{}
+
+				BeforeEach(func() {
+					w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+						Raw: encode(&apiv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       "WorkerConfig",
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							},
+							UserDataFormat: (*apiv1alpha1.UserDataFormat)(pointer.String("ignition")),
+						}),
+					}
+					cloudProfileConfig.MachineImages[0].Versions[0].Capabilities = []api.MachineImageCapability{api.MachineImageCapabilityIgnition}
+					cluster.CloudProfile.Spec.ProviderConfig.Raw, _ = json.Marshal(cloudProfileConfig)
+
+					chartApplier.EXPECT().
+						ApplyFromEmbeddedFS(context.TODO(), charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+						DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+							applyOpts := &kubernetes.ApplyOptions{}
+							for _, opt := range opts {
+								opt.MutateApplyOptions(applyOpts)
+							}
+							machineClassValues = applyOpts.Values.(map[string]interface{})
+							return nil
+						}).AnyTimes()
+				})
+
+				cloudConfigs := func() map[string]string {
+					result := map[string]string{}
+					for _, class := range machineClassValues["machineClasses"].([]map[string]interface{}) {
+						result[class["name"].(string)] = class["secret"].(map[string]interface{})["cloudConfig"].(string)
+					}
+					return result
+				}
+
+				It("should wrap the user data of worker pools using Ignition into an Ignition config", func() {
+					w.Spec.Pools[0].UserData = []byte("#!/bin/bash\necho foo\n")
+
+					workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+					Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+
+					for name, cloudConfig := range cloudConfigs() {
+						if !strings.Contains(name, namePool1) {
+							Expect(cloudConfig).To(Equal(string(userData)))
+							continue
+						}
+
+						config := map[string]interface{}{}
+						Expect(json.Unmarshal([]byte(cloudConfig), &config)).To(Succeed())
+						Expect(config).To(HaveKeyWithValue("ignition", HaveKeyWithValue("version", "3.3.0")))
+						Expect(config).To(HaveKeyWithValue("storage", HaveKeyWithValue("files", ConsistOf(And(
+							HaveKeyWithValue("path", "/var/lib/gardener/user-data"),
+							HaveKeyWithValue("contents", HaveKeyWithValue("source", "data:;base64,"+utils.EncodeBase64(w.Spec.Pools[0].UserData))),
+						)))))
+						Expect(config).To(HaveKeyWithValue("systemd", HaveKeyWithValue("units", ConsistOf(And(
+							HaveKeyWithValue("name", "gardener-user-data.service"),
+							HaveKeyWithValue("contents", ContainSubstring("ExecStart=/var/lib/gardener/user-data\n")),
+						)))))
+					}
+				})
+
+				It("should pass user data which is an Ignition config already through", func() {
+					w.Spec.Pools[0].UserData = []byte(`{"ignition":{"version":"3.4.0"}}`)
+
+					workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+					Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+
+					for name, cloudConfig := range cloudConfigs() {
+						if strings.Contains(name, namePool1) {
+							Expect(cloudConfig).To(Equal(`{"ignition":{"version":"3.4.0"}}`))
+						}
+					}
+				})
+
+				It("should roll the machines if the user data format is changed", func() {
+					w.Spec.Pools[0].UserData = []byte("#cloud-config\n")
+
+					workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+					withIgnition, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+
+					w.Spec.Pools[0].ProviderConfig = nil
+					workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+					withCloudInit, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+
+					for i := range withIgnition {
+						if strings.Contains(withIgnition[i].Name, namePool1) {
+							Expect(withIgnition[i].ClassName).NotTo(Equal(withCloudInit[i].ClassName))
+						} else {
+							Expect(withIgnition[i].ClassName).To(Equal(withCloudInit[i].ClassName))
+						}
+					}
+				})
+
+				It("should fail if the user data cannot be converted to an Ignition config", func() {
+					workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+					_, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).To(MatchError(ContainSubstring("can only be converted to Ignition if it is a script or a cloud-config")))
+				})
+
+				It("should fail if the machine image is not provisioned with Ignition", func() {
+					w.Spec.Pools[0].UserData = []byte("#cloud-config\n")
+					cloudProfileConfig.MachineImages[0].Versions[0].Capabilities = nil
+					cluster.CloudProfile.Spec.ProviderConfig.Raw, _ = json.Marshal(cloudProfileConfig)
+
+					workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+					_, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).To(MatchError(ContainSubstring("is not provisioned with Ignition")))
+				})
+			})
+
+			It("should merge the server metadata into the tags and only roll the machines if requested", func() {
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+				withoutMetadata, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+
+				setServerMetadata := func(triggerRollingOnUpdate bool) {
+					w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+						Raw: encode(&apiv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       "WorkerConfig",
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							},
+							ServerMetadata: &apiv1alpha1.ServerMetadata{
+								Items:                  map[string]string{"cost-center": "1234"},
+								TriggerRollingOnUpdate: triggerRollingOnUpdate,
+							},
+						}),
+					}
+					workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+				}
+
+				setServerMetadata(false)
+				var machineClassValues map[string]interface{}
+				chartApplier.EXPECT().
+					ApplyFromEmbeddedFS(context.TODO(), charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+						applyOpts := &kubernetes.ApplyOptions{}
+						for _, opt := range opts {
+							opt.MutateApplyOptions(applyOpts)
+						}
+						machineClassValues = applyOpts.Values.(map[string]interface{})
+						return nil
+					})
+				Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+
+				for _, class := range machineClassValues["machineClasses"].([]map[string]interface{}) {
+					tags := class["tags"].(map[string]string)
+					Expect(tags).To(HaveKeyWithValue("kubernetes.io-role-node", "1"))
+					if strings.Contains(class["name"].(string), namePool1) {
+						Expect(tags).To(HaveKeyWithValue("cost-center", "1234"))
+					} else {
+						Expect(tags).NotTo(HaveKey("cost-center"))
+					}
+				}
+
+				withMetadata, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+				for i := range withMetadata {
+					Expect(withMetadata[i].ClassName).To(Equal(withoutMetadata[i].ClassName))
+				}
+
+				setServerMetadata(true)
+				withRolledMetadata, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+				for i := range withRolledMetadata {
+					if strings.Contains(withRolledMetadata[i].Name, namePool1) {
+						Expect(withRolledMetadata[i].ClassName).NotTo(Equal(withoutMetadata[i].ClassName))
+					} else {
+						Expect(withRolledMetadata[i].ClassName).To(Equal(withoutMetadata[i].ClassName))
+					}
+				}
+			})
+
+			It("should attach the machines to the additional networks", func() {
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+				withoutNetworks, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&apiv1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							Kind:       "WorkerConfig",
+							APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+						},
+						Networks: []apiv1alpha1.WorkerNetwork{
+							{ID: pointer.String("storage-network-id")},
+							{Name: pointer.String("management")},
+						},
+					}),
+				}
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+
+				var machineClassValues map[string]interface{}
+				chartApplier.EXPECT().
+					ApplyFromEmbeddedFS(context.TODO(), charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+						applyOpts := &kubernetes.ApplyOptions{}
+						for _, opt := range opts {
+							opt.MutateApplyOptions(applyOpts)
+						}
+						machineClassValues = applyOpts.Values.(map[string]interface{})
+						return nil
+					})
+				Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+
+				for _, class := range machineClassValues["machineClasses"].([]map[string]interface{}) {
+					if strings.Contains(class["name"].(string), namePool1) {
+						Expect(class).NotTo(HaveKey("networkID"))
+						Expect(class).NotTo(HaveKey("subnetID"))
+						Expect(class["networks"]).To(Equal([]map[string]interface{}{
+							{"id": networkID, "podNetwork": true},
+							{"id": "storage-network-id"},
+							{"name": "management"},
+						}))
+					} else {
+						Expect(class).To(HaveKeyWithValue("networkID", networkID))
+						Expect(class).To(HaveKeyWithValue("subnetID", subnetID))
+						Expect(class).NotTo(HaveKey("networks"))
+					}
+				}
+
+				withNetworks, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+				for i := range withNetworks {
+					if strings.Contains(withNetworks[i].Name, namePool1) {
+						Expect(withNetworks[i].ClassName).NotTo(Equal(withoutNetworks[i].ClassName))
+					} else {
+						Expect(withNetworks[i].ClassName).To(Equal(withoutNetworks[i].ClassName))
+					}
+				}
+			})
+
+			It("should assign the machines to the additional security groups", func() {
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+				withoutSecurityGroups, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&apiv1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							Kind:       "WorkerConfig",
+							APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+						},
+						AdditionalSecurityGroups: []string{"monitoring", "5f0c3b4e-7a2d-4c1e-9b8f-3d6a1e2c4b7a"},
+					}),
+				}
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+
+				var machineClassValues map[string]interface{}
+				chartApplier.EXPECT().
+					ApplyFromEmbeddedFS(context.TODO(), charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+						applyOpts := &kubernetes.ApplyOptions{}
+						for _, opt := range opts {
+							opt.MutateApplyOptions(applyOpts)
+						}
+						machineClassValues = applyOpts.Values.(map[string]interface{})
+						return nil
+					})
+				Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+
+				for _, class := range machineClassValues["machineClasses"].([]map[string]interface{}) {
+					if strings.Contains(class["name"].(string), namePool1) {
+						Expect(class["securityGroups"]).To(Equal([]string{securityGroupName, "monitoring", "5f0c3b4e-7a2d-4c1e-9b8f-3d6a1e2c4b7a"}))
+					} else {
+						Expect(class["securityGroups"]).To(Equal([]string{securityGroupName}))
+					}
+				}
+
+				withSecurityGroups, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+				for i := range withSecurityGroups {
+					if strings.Contains(withSecurityGroups[i].Name, namePool1) {
+						Expect(withSecurityGroups[i].ClassName).NotTo(Equal(withoutSecurityGroups[i].ClassName))
+					} else {
+						Expect(withSecurityGroups[i].ClassName).To(Equal(withoutSecurityGroups[i].ClassName))
+					}
+				}
+			})
+
+			It("should not render the allowed address pairs of the ports of the machines but roll them", func() {
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+				withoutAllowedAddressPairs, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&apiv1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							Kind:       "WorkerConfig",
+							APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+						},
+						AllowedAddressPairs: []apiv1alpha1.AllowedAddressPair{
+							{IPAddress: "10.250.0.100"},
+							{IPAddress: "10.250.128.0/24", MACAddress: pointer.String("fa:16:3e:00:00:01")},
+						},
+					}),
+				}
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+
+				var machineClassValues map[string]interface{}
+				chartApplier.EXPECT().
+					ApplyFromEmbeddedFS(context.TODO(), charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+						applyOpts := &kubernetes.ApplyOptions{}
+						for _, opt := range opts {
+							opt.MutateApplyOptions(applyOpts)
+						}
+						machineClassValues = applyOpts.Values.(map[string]interface{})
+						return nil
+					})
+				Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+
+				for _, class := range machineClassValues["machineClasses"].([]map[string]interface{}) {
+					Expect(class).NotTo(HaveKey("networks"))
+					Expect(class["networkID"]).To(Equal(networkID))
+					Expect(class["subnetID"]).To(Equal(subnetID))
+				}
+
+				withAllowedAddressPairs, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+				for i := range withAllowedAddressPairs {
+					if strings.Contains(withAllowedAddressPairs[i].Name, namePool1) {
+						Expect(withAllowedAddressPairs[i].ClassName).NotTo(Equal(withoutAllowedAddressPairs[i].ClassName))
+					} else {
+						Expect(withAllowedAddressPairs[i].ClassName).To(Equal(withoutAllowedAddressPairs[i].ClassName))
+					}
+				}
+			})
+
+			It("should not render the disabled port security of the ports of the machines but roll them", func() {
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+				withPortSecurity, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&apiv1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							Kind:       "WorkerConfig",
+							APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+						},
+						PortSecurityEnabled: pointer.Bool(false),
+					}),
+				}
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+
+				var machineClassValues map[string]interface{}
+				chartApplier.EXPECT().
+					ApplyFromEmbeddedFS(context.TODO(), charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+						applyOpts := &kubernetes.ApplyOptions{}
+						for _, opt := range opts {
+							opt.MutateApplyOptions(applyOpts)
+						}
+						machineClassValues = applyOpts.Values.(map[string]interface{})
+						return nil
+					})
+				Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+
+				for _, class := range machineClassValues["machineClasses"].([]map[string]interface{}) {
+					Expect(class).NotTo(HaveKey("networks"))
+					Expect(class["networkID"]).To(Equal(networkID))
+					Expect(class["securityGroups"]).To(Equal([]string{securityGroupName}))
+				}
+
+				withoutPortSecurity, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+				for i := range withoutPortSecurity {
+					if strings.Contains(withoutPortSecurity[i].Name, namePool1) {
+						Expect(withoutPortSecurity[i].ClassName).NotTo(Equal(withPortSecurity[i].ClassName))
+					} else {
+						Expect(withoutPortSecurity[i].ClassName).To(Equal(withPortSecurity[i].ClassName))
+					}
+				}
+			})
+
+			It("should neither render the QoS policy of the ports of the machines nor roll them", func() {
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+				withoutQoSPolicy, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&apiv1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							Kind:       "WorkerConfig",
+							APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+						},
+						QoSPolicyID: pointer.String("qos-policy-1"),
+					}),
+				}
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+
+				var machineClassValues map[string]interface{}
+				chartApplier.EXPECT().
+					ApplyFromEmbeddedFS(context.TODO(), charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+						applyOpts := &kubernetes.ApplyOptions{}
+						for _, opt := range opts {
+							opt.MutateApplyOptions(applyOpts)
+						}
+						machineClassValues = applyOpts.Values.(map[string]interface{})
+						return nil
+					})
+				Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+
+				for _, class := range machineClassValues["machineClasses"].([]map[string]interface{}) {
+					Expect(class).NotTo(HaveKey("networks"))
+					Expect(class["networkID"]).To(Equal(networkID))
+				}
+
+				withQoSPolicy, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+				for i := range withQoSPolicy {
+					Expect(withQoSPolicy[i].ClassName).To(Equal(withoutQoSPolicy[i].ClassName))
+				}
+			})
+
+			It("should join the machines of a worker pool to its node subnet", func() {
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+				withoutSubnet, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+
+				w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{
+					Raw: encode(&api.InfrastructureStatus{
+						SecurityGroups: []api.SecurityGroup{{Purpose: api.PurposeNodes, Name: securityGroupName}},
+						Node:           api.NodeStatus{KeyName: keyName},
+						Networks: api.NetworkStatus{
+							ID: networkID,
+							Subnets: []api.Subnet{
+								{Purpose: api.PurposeNodes, ID: "subnet-zone-a", Name: pointer.String("zone-a")},
+								{Purpose: api.PurposeNodes, ID: subnetID},
+							},
+						},
+					}),
+				}
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&apiv1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							Kind:       "WorkerConfig",
+							APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+						},
+						Subnet: pointer.String("zone-a"),
+					}),
+				}
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+
+				var machineClassValues map[string]interface{}
+				chartApplier.EXPECT().
+					ApplyFromEmbeddedFS(context.TODO(), charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+						applyOpts := &kubernetes.ApplyOptions{}
+						for _, opt := range opts {
+							opt.MutateApplyOptions(applyOpts)
+						}
+						machineClassValues = applyOpts.Values.(map[string]interface{})
+						return nil
+					})
+				Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+
+				for _, class := range machineClassValues["machineClasses"].([]map[string]interface{}) {
+					Expect(class["networkID"]).To(Equal(networkID))
+					if strings.Contains(class["name"].(string), namePool1) {
+						Expect(class["subnetID"]).To(Equal("subnet-zone-a"))
+					} else {
+						Expect(class["subnetID"]).To(Equal(subnetID))
+					}
+				}
+
+				withSubnet, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+				for i := range withSubnet {
+					if strings.Contains(withSubnet[i].Name, namePool1) {
+						Expect(withSubnet[i].ClassName).NotTo(Equal(withoutSubnet[i].ClassName))
+					} else {
+						Expect(withSubnet[i].ClassName).To(Equal(withoutSubnet[i].ClassName))
+					}
+				}
+			})
+
+			It("should fail to attach the machines to additional networks if the network of the shoot has several node subnets", func() {
+				w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{
+					Raw: encode(&api.InfrastructureStatus{
+						SecurityGroups: []api.SecurityGroup{{Purpose: api.PurposeNodes, Name: securityGroupName}},
+						Node:           api.NodeStatus{KeyName: keyName},
+						Networks: api.NetworkStatus{
+							ID: networkID,
+							Subnets: []api.Subnet{
+								{Purpose: api.PurposeNodes, ID: "subnet-zone-a", Name: pointer.String("zone-a")},
+								{Purpose: api.PurposeNodes, ID: subnetID},
+							},
+						},
+					}),
+				}
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&apiv1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							Kind:       "WorkerConfig",
+							APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+						},
+						Networks: []apiv1alpha1.WorkerNetwork{{Name: pointer.String("storage")}},
+					}),
+				}
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+
+				_, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).To(MatchError(ContainSubstring("cannot join a node subnet of a network with several node subnets")))
+			})
+
+			It("should fail because the node subnet of a worker pool cannot be found", func() {
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&apiv1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							Kind:       "WorkerConfig",
+							APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+						},
+						Subnet: pointer.String("zone-a"),
+					}),
+				}
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+
+				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).To(MatchError(ContainSubstring(`cannot find node subnet with name "zone-a"`)))
+				Expect(result).To(BeNil())
+			})
+
+			It("should inject the key pair of the worker pool into the machines", func() {
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+				withoutKeyName, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&apiv1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							Kind:       "WorkerConfig",
+							APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+						},
+						KeyName: pointer.String("operators"),
+					}),
+				}
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+
+				var machineClassValues map[string]interface{}
+				chartApplier.EXPECT().
+					ApplyFromEmbeddedFS(context.TODO(), charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+						applyOpts := &kubernetes.ApplyOptions{}
+						for _, opt := range opts {
+							opt.MutateApplyOptions(applyOpts)
+						}
+						machineClassValues = applyOpts.Values.(map[string]interface{})
+						return nil
+					})
+				Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+
+				for _, class := range machineClassValues["machineClasses"].([]map[string]interface{}) {
+					if strings.Contains(class["name"].(string), namePool1) {
+						Expect(class["keyName"]).To(Equal("operators"))
+					} else {
+						Expect(class["keyName"]).To(Equal(keyName))
+					}
+				}
+
+				withKeyName, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+				for i := range withKeyName {
+					if strings.Contains(withKeyName[i].Name, namePool1) {
+						Expect(withKeyName[i].ClassName).NotTo(Equal(withoutKeyName[i].ClassName))
+					} else {
+						Expect(withKeyName[i].ClassName).To(Equal(withoutKeyName[i].ClassName))
+					}
+				}
+			})
+
+			It("should pass the scheduler hints to the machine classes", func() {
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+				withoutSchedulerHints, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+
+				schedulerHints := map[string][]string{
+					"different_host": {"a0cf03a5-d921-4877-bb5c-86d26cf818e1"},
+					"aggregate":      {"nfv"},
+				}
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&apiv1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							Kind:       "WorkerConfig",
+							APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+						},
+						SchedulerHints: schedulerHints,
+					}),
+				}
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+
+				var machineClassValues map[string]interface{}
+				chartApplier.EXPECT().
+					ApplyFromEmbeddedFS(context.TODO(), charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+						applyOpts := &kubernetes.ApplyOptions{}
+						for _, opt := range opts {
+							opt.MutateApplyOptions(applyOpts)
+						}
+						machineClassValues = applyOpts.Values.(map[string]interface{})
+						return nil
+					})
+				Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+
+				for _, class := range machineClassValues["machineClasses"].([]map[string]interface{}) {
+					if strings.Contains(class["name"].(string), namePool1) {
+						Expect(class).To(HaveKeyWithValue("schedulerHints", schedulerHints))
+					} else {
+						Expect(class).NotTo(HaveKey("schedulerHints"))
+					}
+				}
+
+				withSchedulerHints, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+				for i := range withSchedulerHints {
+					if strings.Contains(withSchedulerHints[i].Name, namePool1) {
+						Expect(withSchedulerHints[i].ClassName).NotTo(Equal(withoutSchedulerHints[i].ClassName))
+					} else {
+						Expect(withSchedulerHints[i].ClassName).To(Equal(withoutSchedulerHints[i].ClassName))
+					}
+				}
+			})
+
+			It("should create the servers of bare metal worker pools without availability zone", func() {
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+				withoutBareMetal, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&apiv1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							Kind:       "WorkerConfig",
+							APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+						},
+						SchedulerHints: map[string][]string{"aggregate": {"ironic"}},
+						BareMetal: &apiv1alpha1.BareMetal{
+							IgnoreAvailabilityZones: pointer.Bool(true),
+						},
+					}),
+				}
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+
+				var machineClassValues map[string]interface{}
+				chartApplier.EXPECT().
+					ApplyFromEmbeddedFS(context.TODO(), charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+						applyOpts := &kubernetes.ApplyOptions{}
+						for _, opt := range opts {
+							opt.MutateApplyOptions(applyOpts)
+						}
+						machineClassValues = applyOpts.Values.(map[string]interface{})
+						return nil
+					})
+				Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+
+				for _, class := range machineClassValues["machineClasses"].([]map[string]interface{}) {
+					if strings.Contains(class["name"].(string), namePool1) {
+						Expect(class).To(HaveKeyWithValue("availabilityZone", ""))
+						Expect(class).To(HaveKeyWithValue("schedulerHints", map[string][]string{"aggregate": {"ironic"}}))
+						Expect(class["nodeTemplate"].(machinev1alpha1.NodeTemplate).Zone).NotTo(BeEmpty())
+					} else {
+						Expect(class["availabilityZone"]).NotTo(BeEmpty())
+					}
+				}
+
+				withBareMetal, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(withBareMetal).To(HaveLen(len(withoutBareMetal)))
+				for i := range withBareMetal {
+					if strings.Contains(withBareMetal[i].Name, namePool1) {
+						Expect(withBareMetal[i].ClassName).NotTo(Equal(withoutBareMetal[i].ClassName))
+					} else {
+						Expect(withBareMetal[i].ClassName).To(Equal(withoutBareMetal[i].ClassName))
+					}
+				}
+			})
+
+			It("should fail if the flavor of a bare metal worker pool does not request the capabilities", func() {
+				var (
+					osFactory     = mocks.NewMockFactory(ctrl)
+					computeClient = mocks.NewMockCompute(ctrl)
+				)
+				osFactory.EXPECT().Compute(gomock.Any()).Return(computeClient, nil).AnyTimes()
+				computeClient.EXPECT().ListFlavors(gomock.Any()).Return([]flavors.Flavor{{ID: "flavor-id", Name: w.Spec.Pools[0].MachineType}}, nil)
+				computeClient.EXPECT().GetFlavorExtraSpecs("flavor-id").Return(map[string]string{
+					"resources:CUSTOM_BAREMETAL_LARGE": "1",
+					"resources:VCPU":                   "0",
+					"resources:MEMORY_MB":              "0",
+					"capabilities:boot_mode":           "bios",
+				}, nil)
+
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&apiv1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							Kind:       "WorkerConfig",
+							APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+						},
+						BareMetal: &apiv1alpha1.BareMetal{
+							Capabilities: map[string]string{"boot_mode": "uefi"},
+						},
+					}),
+				}
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, osFactory, &record.FakeRecorder{}, nil)
+
+				_, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).To(MatchError(ContainSubstring("does not request the capabilities boot_mode=uefi of the Ironic nodes")))
+			})
+
+			It("should label and taint the nodes and mark the servers of preemptible worker pools", func() {
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+				withoutPreemptible, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&apiv1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							Kind:       "WorkerConfig",
+							APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+						},
+						Preemptible: pointer.Bool(true),
+					}),
+				}
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+
+				var machineClassValues map[string]interface{}
+				chartApplier.EXPECT().
+					ApplyFromEmbeddedFS(context.TODO(), charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+						applyOpts := &kubernetes.ApplyOptions{}
+						for _, opt := range opts {
+							opt.MutateApplyOptions(applyOpts)
+						}
+						machineClassValues = applyOpts.Values.(map[string]interface{})
+						return nil
+					})
+				Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+
+				for _, class := range machineClassValues["machineClasses"].([]map[string]interface{}) {
+					if strings.Contains(class["name"].(string), namePool1) {
+						Expect(class["tags"]).To(HaveKeyWithValue("preemptible", "true"))
+					} else {
+						Expect(class["tags"]).NotTo(HaveKey("preemptible"))
+					}
+				}
+
+				withPreemptible, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+				for i := range withPreemptible {
+					if strings.Contains(withPreemptible[i].Name, namePool1) {
+						Expect(withPreemptible[i].Labels).To(HaveKeyWithValue("node.openstack.provider.extensions.gardener.cloud/preemptible", "true"))
+						Expect(withPreemptible[i].Taints).To(ContainElement(corev1.Taint{
+							Key:    "node.openstack.provider.extensions.gardener.cloud/preemptible",
+							Value:  "true",
+							Effect: corev1.TaintEffectNoSchedule,
+						}))
+						Expect(withPreemptible[i].ClassName).NotTo(Equal(withoutPreemptible[i].ClassName))
+					} else {
+						Expect(withPreemptible[i].Labels).NotTo(HaveKey("node.openstack.provider.extensions.gardener.cloud/preemptible"))
+						Expect(withPreemptible[i].Taints).To(Equal(withoutPreemptible[i].Taints))
+						Expect(withPreemptible[i].ClassName).To(Equal(withoutPreemptible[i].ClassName))
+					}
+				}
+			})
+
+			It("should default the machine timeouts for pre-baked images", func() {
+				testCreationTimeout := metav1.Duration{Duration: 15 * time.Minute}
+				w.Spec.Pools[0].MachineControllerManagerSettings = &gardencorev1beta1.MachineControllerManagerSettings{
+					MachineCreationTimeout: &testCreationTimeout,
+				}
+				cloudProfileConfig.MachineImages[0].Versions[0].Capabilities = []api.MachineImageCapability{api.MachineImageCapabilityPrebaked}
+				cluster.CloudProfile.Spec.ProviderConfig.Raw, _ = json.Marshal(cloudProfileConfig)
+
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+
+				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+				for _, machineDeployment := range result {
+					if strings.Contains(machineDeployment.Name, namePool1) {
+						Expect(machineDeployment.MachineConfiguration.MachineCreationTimeout).To(Equal(&testCreationTimeout))
+					} else {
+						Expect(machineDeployment.MachineConfiguration.MachineCreationTimeout).To(Equal(&metav1.Duration{Duration: 10 * time.Minute}))
+					}
+					Expect(machineDeployment.MachineConfiguration.MachineHealthTimeout).To(Equal(&metav1.Duration{Duration: 5 * time.Minute}))
+				}
+			})
+
+			It("should default the machine timeouts for bare metal flavors", func() {
+				testCreationTimeout := metav1.Duration{Duration: 90 * time.Minute}
+				w.Spec.Pools[0].MachineControllerManagerSettings = &gardencorev1beta1.MachineControllerManagerSettings{
+					MachineCreationTimeout: &testCreationTimeout,
+				}
+
+				var (
+					osFactory     = mocks.NewMockFactory(ctrl)
+					computeClient = mocks.NewMockCompute(ctrl)
+				)
+				osFactory.EXPECT().Compute(gomock.Any()).Return(computeClient, nil).AnyTimes()
+				computeClient.EXPECT().ListFlavors(gomock.Any()).Return([]flavors.Flavor{{ID: "flavor-id", Name: w.Spec.Pools[0].MachineType}}, nil)
+				computeClient.EXPECT().GetFlavorExtraSpecs("flavor-id").Return(map[string]string{
+					"resources:CUSTOM_BAREMETAL_LARGE": "1",
+					"resources:VCPU":                   "0",
+					"resources:MEMORY_MB":              "0",
+					"resources:DISK_GB":                "0",
+				}, nil)
+
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, osFactory, &record.FakeRecorder{}, nil)
+
+				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result[0].MachineConfiguration.MachineCreationTimeout).To(Equal(&testCreationTimeout))
+				Expect(result[0].MachineConfiguration.MachineHealthTimeout).To(Equal(&metav1.Duration{Duration: 30 * time.Minute}))
+			})
+
+			It("should name the machine deployments and machine classes according to the naming", func() {
+				defaultNaming := Naming
+				DeferCleanup(func() { Naming = defaultNaming })
+
+				Naming.OrderZones = func(zones []string) []string {
+					return []string{zones[1], zones[0]}
+				}
+				Naming.DeploymentName = func(_, poolName string, zoneIndex int) string {
+					return fmt.Sprintf("%s-%d", poolName, zoneIndex)
+				}
+				Naming.WorkerPoolHash = func(pool extensionsv1alpha1.WorkerPool, _ *extensionscontroller.Cluster, _ ...string) (string, error) {
+					return "hash-" + pool.Name, nil
+				}
+				Naming.ClassName = func(deploymentName, workerPoolHash string) string {
+					return workerPoolHash + "-" + deploymentName
+				}
+
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(result).To(HaveLen(4))
+				for i, pool := range []string{namePool1, namePool2} {
+					for zoneIndex, zone := range []string{zone2, zone1} {
+						machineDeployment := result[2*i+zoneIndex]
+						Expect(machineDeployment.Name).To(Equal(fmt.Sprintf("%s-%d", pool, zoneIndex)))
+						Expect(machineDeployment.ClassName).To(Equal(fmt.Sprintf("hash-%s-%s-%d", pool, pool, zoneIndex)))
+						Expect(machineDeployment.Labels).To(HaveKeyWithValue(openstack.CSIDiskDriverTopologyKey, zone))
+					}
+				}
+			})
+
+			It("should generate the same machine deployments on every reconciliation", func() {
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+				expected, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+
+				for i := 0; i < 10; i++ {
+					workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, nil, &record.FakeRecorder{}, nil)
+					Expect(workerDelegate.GenerateMachineDeployments(context.TODO())).To(Equal(expected))
+				}
+			})
 		})
 	})
 })
@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"fmt"
+	"slices"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	"github.com/gardener/gardener/extensions/pkg/controller/worker"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// MachineDeploymentNaming determines the names of the machine deployments and machine classes generated for the worker
+// pools. Changing it for existing shoots renames their machine deployments or machine classes, which rolls their nodes.
+type MachineDeploymentNaming struct {
+	// OrderZones returns the zones of a worker pool in the order its machine deployments are generated and indexed in.
+	OrderZones func(zones []string) []string
+	// DeploymentName returns the name of the machine deployment of a worker pool for the zone with the given index.
+	DeploymentName func(namespace, poolName string, zoneIndex int) string
+	// WorkerPoolHash returns the hash of a worker pool the names of its machine classes are suffixed with. The additional
+	// data contains the values of the provider config which require new machines if they are changed.
+	WorkerPoolHash func(pool extensionsv1alpha1.WorkerPool, cluster *extensionscontroller.Cluster, additionalData ...string) (string, error)
+	// ClassName returns the name of the machine class of the machine deployment with the given name.
+	ClassName func(deploymentName, workerPoolHash string) string
+}
+
+// Naming is the naming of the machine deployments and machine classes of worker delegates created by NewWorkerDelegate.
+var Naming = MachineDeploymentNaming{
+	OrderZones:     SpecifiedZoneOrder,
+	DeploymentName: MachineDeploymentName,
+	WorkerPoolHash: worker.WorkerPoolHash,
+	ClassName:      MachineClassName,
+}
+
+// SpecifiedZoneOrder returns the zones of a worker pool in the order they are specified in.
+func SpecifiedZoneOrder(zones []string) []string {
+	return slices.Clone(zones)
+}
+
+// MachineDeploymentName returns the name of the machine deployment of the zone with the given index of a worker pool.
+func MachineDeploymentName(namespace, poolName string, zoneIndex int) string {
+	return fmt.Sprintf("%s-%s-z%d", namespace, poolName, zoneIndex+1)
+}
+
+// MachineClassName returns the name of the machine class of a machine deployment, which is suffixed with the hash of
+// the worker pool so that changes of the pool requiring new machines result in a new machine class.
+func MachineClassName(deploymentName, workerPoolHash string) string {
+	return fmt.Sprintf("%s-%s", deploymentName, workerPoolHash)
+}
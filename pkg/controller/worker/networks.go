@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"k8s.io/utils/pointer"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+)
+
+// portSecurityEnabled returns whether the port security of the ports of the machines of the worker pool is enabled,
+// which is the default.
+func portSecurityEnabled(workerConfig *api.WorkerConfig) bool {
+	return pointer.BoolDeref(workerConfig.PortSecurityEnabled, true)
+}
+
+// requiresNetworksMachineClassSpec returns whether the networks of the machine class must be rendered as list, which is
+// the case if the machines are attached to additional networks. The allowed address pairs, the port security and the
+// QoS policy are configured on the ports by the port controller once the servers exist.
+func requiresNetworksMachineClassSpec(workerConfig *api.WorkerConfig) bool {
+	return len(workerConfig.Networks) > 0
+}
+
+// countNodeSubnets returns the number of node subnets of the network of the shoot.
+func countNodeSubnets(subnets []api.Subnet) int {
+	count := 0
+	for _, subnet := range subnets {
+		if subnet.Purpose == api.PurposeNodes {
+			count++
+		}
+	}
+	return count
+}
+
+// networksMachineClassSpec returns the networks of the spec of a machine class whose machines are attached to the
+// additional networks of the given WorkerConfig, with one port each. The network of the shoot comes first and is marked
+// as the pod network, so that the pod traffic is routed through the port in the network of the shoot. The
+// machine-controller-manager does not select the subnets of the ports in the networks, hence Neutron allocates their
+// fixed IPs from any subnet of the networks.
+func networksMachineClassSpec(networkID string, workerConfig *api.WorkerConfig) []map[string]interface{} {
+	shootNetwork := map[string]interface{}{
+		"id":         networkID,
+		"podNetwork": true,
+	}
+
+	result := []map[string]interface{}{shootNetwork}
+	for _, additionalNetwork := range workerConfig.Networks {
+		network := map[string]interface{}{}
+		if additionalNetwork.ID != nil {
+			network["id"] = *additionalNetwork.ID
+		}
+		if additionalNetwork.Name != nil {
+			network["name"] = *additionalNetwork.Name
+		}
+		result = append(result, network)
+	}
+	return result
+}
+
+// networksHashData returns the node subnet and the allowed address pairs of the port in the network of the shoot, the
+// port security of the ports and the additional networks of the WorkerConfig for the worker pool hash, as changes of
+// them are not applied to the ports of existing machines. The QoS policy is not part of the hash, as the port
+// controller updates it on the ports of existing machines.
+func networksHashData(workerConfig *api.WorkerConfig) []string {
+	var data []string
+	if workerConfig.Subnet != nil {
+		data = append(data, "subnet="+*workerConfig.Subnet)
+	}
+	if !portSecurityEnabled(workerConfig) {
+		data = append(data, "portSecurityEnabled=false")
+	}
+	for _, pair := range workerConfig.AllowedAddressPairs {
+		data = append(data, "allowedAddressPair="+pair.IPAddress+","+pointer.StringDeref(pair.MACAddress, ""))
+	}
+	for _, additionalNetwork := range workerConfig.Networks {
+		if additionalNetwork.ID != nil {
+			data = append(data, "networkID="+*additionalNetwork.ID)
+		}
+		if additionalNetwork.Name != nil {
+			data = append(data, "networkName="+*additionalNetwork.Name)
+		}
+	}
+	return data
+}
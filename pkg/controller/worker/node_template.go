@@ -0,0 +1,238 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	openstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
+)
+
+const (
+	// flavorExtraSpecResourcesPrefix is the prefix of the flavor extra specs overriding the resources requested from
+	// the Placement service for servers of the flavor.
+	flavorExtraSpecResourcesPrefix = "resources:"
+	// flavorExtraSpecPCIPassthroughAlias is the flavor extra spec listing the aliases and counts of the PCI devices
+	// passed through to servers of the flavor, e.g. `a100:2,nic:1`.
+	flavorExtraSpecPCIPassthroughAlias = "pci_passthrough:alias"
+
+	resourceClassVCPU     = "VCPU"
+	resourceClassPCPU     = "PCPU"
+	resourceClassMemoryMB = "MEMORY_MB"
+	resourceClassVGPU     = "VGPU"
+)
+
+// nodeTemplateCapacity returns the capacity of the node template of the given worker pool. The capacity derived from the
+// resources of the pool's flavor takes precedence over the capacity derived from the machine type of the cloud profile,
+// as do the GPUs of the flavor. The ephemeral storage is derived from the root disk of the machines unless it is given
+// by the cloud profile. It returns nil if neither is known.
+func (w *workerDelegate) nodeTemplateCapacity(pool extensionsv1alpha1.WorkerPool, disk rootDisk) (corev1.ResourceList, error) {
+	resourcesCapacity, err := w.flavorResourcesCapacity(pool.MachineType)
+	if err != nil {
+		return nil, fmt.Errorf("could not determine capacity of flavor %q: %w", pool.MachineType, err)
+	}
+
+	var capacity corev1.ResourceList
+	if pool.NodeTemplate != nil {
+		capacity = pool.NodeTemplate.Capacity.DeepCopy()
+	}
+	for name, quantity := range resourcesCapacity {
+		if capacity == nil {
+			capacity = corev1.ResourceList{}
+		}
+		capacity[name] = quantity
+	}
+	if capacity == nil {
+		return nil, nil
+	}
+
+	gpus, err := w.flavorGPUs(pool.MachineType)
+	if err != nil {
+		return nil, fmt.Errorf("could not determine GPUs of flavor %q: %w", pool.MachineType, err)
+	}
+	if gpus > 0 {
+		capacity[w.nodeTemplateGPUResourceName()] = *resource.NewQuantity(gpus, resource.DecimalSI)
+	}
+
+	if _, ok := capacity[corev1.ResourceEphemeralStorage]; !ok {
+		ephemeralStorage, err := w.rootDiskEphemeralStorage(pool.MachineType, disk)
+		if err != nil {
+			return nil, fmt.Errorf("could not determine ephemeral storage of flavor %q: %w", pool.MachineType, err)
+		}
+		if ephemeralStorage != nil {
+			capacity[corev1.ResourceEphemeralStorage] = *ephemeralStorage
+		}
+	}
+	return capacity, nil
+}
+
+// rootDiskEphemeralStorage returns the ephemeral storage of the nodes of a worker pool, i.e. the size of the root disk
+// of its machines: the root volume if the machines boot from a volume, and the disk of the given flavor otherwise. The
+// ephemeral and swap disks of the flavor are attached as separate block devices which are not used by the kubelet,
+// hence they are not included. It returns nil if the size is unknown.
+func (w *workerDelegate) rootDiskEphemeralStorage(flavorName string, disk rootDisk) (*resource.Quantity, error) {
+	if disk.size > 0 {
+		return resource.NewQuantity(int64(disk.size)*gibibyte, resource.BinarySI), nil
+	}
+	if w.openstackClient == nil {
+		return nil, nil
+	}
+
+	flavor, _, err := w.lookupFlavor(flavorName)
+	if err != nil || flavor == nil || flavor.Disk == 0 {
+		return nil, err
+	}
+	return resource.NewQuantity(int64(flavor.Disk)*gibibyte, resource.BinarySI), nil
+}
+
+// flavorGPUs returns the number of GPUs of servers of the given flavor, i.e. the vGPUs requested from the Placement
+// service and the PCI devices passed through whose aliases are configured as GPUs in the cloud profile. It returns 0 if
+// the flavor cannot be found.
+func (w *workerDelegate) flavorGPUs(flavorName string) (int64, error) {
+	if w.openstackClient == nil {
+		return 0, nil
+	}
+
+	flavor, extraSpecs, err := w.lookupFlavor(flavorName)
+	if err != nil || flavor == nil {
+		return 0, err
+	}
+
+	var pciAliases []string
+	if w.cloudProfileConfig != nil && w.cloudProfileConfig.GPU != nil {
+		pciAliases = w.cloudProfileConfig.GPU.PCIAliases
+	}
+	return gpusFromExtraSpecs(extraSpecs, pciAliases)
+}
+
+// gpusFromExtraSpecs returns the number of GPUs requested by the given flavor extra specs, i.e. the vGPUs requested via
+// `resources:VGPU` and the PCI devices with one of the given aliases passed through via `pci_passthrough:alias`.
+func gpusFromExtraSpecs(extraSpecs map[string]string, pciAliases []string) (int64, error) {
+	var gpus int64
+	if value, ok := extraSpecs[flavorExtraSpecResourcesPrefix+resourceClassVGPU]; ok {
+		vgpus, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid extra spec %q: %w", flavorExtraSpecResourcesPrefix+resourceClassVGPU, err)
+		}
+		gpus += vgpus
+	}
+
+	if value, ok := extraSpecs[flavorExtraSpecPCIPassthroughAlias]; ok {
+		for _, device := range strings.Split(value, ",") {
+			alias, count, found := strings.Cut(strings.TrimSpace(device), ":")
+			if !slices.Contains(pciAliases, alias) {
+				continue
+			}
+			if !found {
+				return 0, fmt.Errorf("invalid extra spec %q: missing count of alias %q", flavorExtraSpecPCIPassthroughAlias, alias)
+			}
+			devices, err := strconv.ParseInt(count, 10, 64)
+			if err != nil {
+				return 0, fmt.Errorf("invalid extra spec %q: %w", flavorExtraSpecPCIPassthroughAlias, err)
+			}
+			gpus += devices
+		}
+	}
+	return gpus, nil
+}
+
+// nodeTemplateGPUResourceName returns the name of the GPU resource in the node templates configured in the cloud
+// profile.
+func (w *workerDelegate) nodeTemplateGPUResourceName() corev1.ResourceName {
+	if w.cloudProfileConfig != nil && w.cloudProfileConfig.GPU != nil && w.cloudProfileConfig.GPU.ResourceName != nil {
+		return corev1.ResourceName(*w.cloudProfileConfig.GPU.ResourceName)
+	}
+	return api.DefaultGPUResourceName
+}
+
+// flavorResourcesCapacity returns the capacity of servers of the given flavor, i.e. the resources Nova requests from
+// the Placement service for them. It returns nil if the flavor cannot be found.
+func (w *workerDelegate) flavorResourcesCapacity(flavorName string) (corev1.ResourceList, error) {
+	if w.openstackClient == nil {
+		return nil, nil
+	}
+
+	flavor, extraSpecs, err := w.lookupFlavor(flavorName)
+	if err != nil || flavor == nil {
+		return nil, err
+	}
+	return flavorCapacity(*flavor, extraSpecs)
+}
+
+// lookupFlavor returns the flavor with the given name together with its extra specs. It returns nil if the flavor
+// cannot be found. The flavors and their extra specs are cached for the lifetime of the worker delegate.
+func (w *workerDelegate) lookupFlavor(flavorName string) (*flavors.Flavor, map[string]string, error) {
+	computeClient, err := w.openstackClient.Compute(openstackclient.WithRegion(w.worker.Spec.Region))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if w.flavorsByName == nil {
+		allFlavors, err := computeClient.ListFlavors(flavors.ListOpts{AccessType: flavors.AllAccess})
+		if err != nil {
+			return nil, nil, err
+		}
+		w.flavorsByName = make(map[string]flavors.Flavor, len(allFlavors))
+		for _, flavor := range allFlavors {
+			w.flavorsByName[flavor.Name] = flavor
+		}
+		w.flavorExtraSpecs = map[string]map[string]string{}
+	}
+
+	flavor, ok := w.flavorsByName[flavorName]
+	if !ok {
+		return nil, nil, nil
+	}
+
+	extraSpecs, ok := w.flavorExtraSpecs[flavor.ID]
+	if !ok {
+		if extraSpecs, err = computeClient.GetFlavorExtraSpecs(flavor.ID); err != nil {
+			return nil, nil, err
+		}
+		w.flavorExtraSpecs[flavor.ID] = extraSpecs
+	}
+	return &flavor, extraSpecs, nil
+}
+
+// flavorCapacity returns the capacity of servers of the given flavor. Resources requested from the Placement service
+// via `resources:<class>` extra specs take precedence over the vCPUs and RAM of the flavor, e.g. for flavors with
+// dedicated CPUs. The allocation ratios of the compute hosts are not taken into account as they do not change the
+// resources of a server. It returns nil if the flavor does not request CPU and memory, e.g. for bare metal flavors.
+func flavorCapacity(flavor flavors.Flavor, extraSpecs map[string]string) (corev1.ResourceList, error) {
+	requested := map[string]int64{
+		resourceClassVCPU:     int64(flavor.VCPUs),
+		resourceClassMemoryMB: int64(flavor.RAM),
+	}
+	for _, resourceClass := range []string{resourceClassVCPU, resourceClassPCPU, resourceClassMemoryMB} {
+		value, ok := extraSpecs[flavorExtraSpecResourcesPrefix+resourceClass]
+		if !ok {
+			continue
+		}
+		amount, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid extra spec %q: %w", flavorExtraSpecResourcesPrefix+resourceClass, err)
+		}
+		requested[resourceClass] = amount
+	}
+
+	cpu := requested[resourceClassVCPU] + requested[resourceClassPCPU]
+	if cpu == 0 || requested[resourceClassMemoryMB] == 0 {
+		return nil, nil
+	}
+
+	return corev1.ResourceList{
+		corev1.ResourceCPU:    *resource.NewQuantity(cpu, resource.DecimalSI),
+		corev1.ResourceMemory: *resource.NewQuantity(requested[resourceClassMemoryMB]*1024*1024, resource.BinarySI),
+	}, nil
+}
@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/pointer"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+)
+
+const (
+	// labelPreemptible is the label of the nodes of preemptible worker pools. Workloads which tolerate the loss of their
+	// node at any time can select these nodes with it.
+	labelPreemptible = "node.openstack.provider.extensions.gardener.cloud/preemptible"
+	// taintKeyPreemptible is the key of the taint of the nodes of preemptible worker pools, which only workloads
+	// tolerating the loss of their node at any time are expected to tolerate.
+	taintKeyPreemptible = labelPreemptible
+	// serverMetadataPreemptible is the metadata key marking the servers of preemptible worker pools.
+	serverMetadataPreemptible = "preemptible"
+	// eventReasonMachinePreempted is the reason of the events recorded on the worker for preempted machines.
+	eventReasonMachinePreempted = "MachinePreempted"
+)
+
+// isPreemptible returns whether the machines of the worker pool with the given configuration are preemptible.
+func isPreemptible(workerConfig *api.WorkerConfig) bool {
+	return pointer.BoolDeref(workerConfig.Preemptible, false)
+}
+
+// preemptibleLabels returns the given labels extended by the label of the nodes of preemptible worker pools.
+func preemptibleLabels(labels map[string]string) map[string]string {
+	result := make(map[string]string, len(labels)+1)
+	for k, v := range labels {
+		result[k] = v
+	}
+	result[labelPreemptible] = "true"
+	return result
+}
+
+// preemptibleTaints returns the given taints extended by the taint of the nodes of preemptible worker pools, unless the
+// worker pool already configures a taint with the same key.
+func preemptibleTaints(taints []corev1.Taint) []corev1.Taint {
+	for _, taint := range taints {
+		if taint.Key == taintKeyPreemptible {
+			return taints
+		}
+	}
+
+	result := append([]corev1.Taint{}, taints...)
+	return append(result, corev1.Taint{
+		Key:    taintKeyPreemptible,
+		Value:  "true",
+		Effect: corev1.TaintEffectNoSchedule,
+	})
+}
+
+// isPreemptibleMachine returns whether the given machine belongs to a preemptible worker pool.
+func isPreemptibleMachine(machine machinev1alpha1.Machine) bool {
+	return machine.Spec.NodeTemplateSpec.Labels[labelPreemptible] == "true"
+}
@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"maps"
+	"strconv"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/worker"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+)
+
+// gibibyte is the unit of the size of root volumes.
+const gibibyte = 1 << 30
+
+// rootDisk is the root disk of the machines of a worker pool. The machines boot from a Cinder volume if its size is set,
+// and from the ephemeral disk of their flavor otherwise.
+type rootDisk struct {
+	// size is the size of the root volume in GiB.
+	size int
+	// volumeType is the Cinder volume type of the root volume.
+	volumeType *string
+	// zoneVolumeTypes are the Cinder volume types of the root volumes in the zones of the worker pool. They take
+	// precedence over the volume type.
+	zoneVolumeTypes map[string]string
+	// deleteOnTermination indicates whether the root volume is deleted together with its server.
+	deleteOnTermination *bool
+}
+
+// rootDiskOf returns the root disk of the machines of the given worker pool. The root volume of the WorkerConfig takes
+// precedence over the volume of the worker pool. If neither specify a volume type, the root volume types of the zones
+// of the given region are used.
+func rootDiskOf(pool extensionsv1alpha1.WorkerPool, workerConfig *api.WorkerConfig, regionConfig *api.RegionConfig) (rootDisk, error) {
+	var disk rootDisk
+	if pool.Volume != nil {
+		size, err := worker.DiskSize(pool.Volume.Size)
+		if err != nil {
+			return disk, err
+		}
+		disk.size, disk.volumeType = size, pool.Volume.Type
+	}
+
+	if rootVolume := workerConfig.RootVolume; rootVolume != nil {
+		if rootVolume.Size != nil {
+			disk.size = int(rootVolume.Size.Value() / gibibyte)
+		}
+		if rootVolume.Type != nil {
+			disk.volumeType = rootVolume.Type
+		}
+		disk.deleteOnTermination = rootVolume.DeleteOnTermination
+	}
+
+	if disk.size > 0 && disk.volumeType == nil && regionConfig != nil {
+		disk.zoneVolumeTypes = maps.Clone(regionConfig.RootVolumeTypes)
+	}
+	if rootVolume := workerConfig.RootVolume; rootVolume != nil && len(rootVolume.ZoneTypes) > 0 {
+		if disk.zoneVolumeTypes == nil {
+			disk.zoneVolumeTypes = map[string]string{}
+		}
+		maps.Copy(disk.zoneVolumeTypes, rootVolume.ZoneTypes)
+	}
+	return disk, nil
+}
+
+// rootVolumeHashData returns the root volume of the WorkerConfig for the worker pool hash, as the machines must be
+// recreated to boot from another volume. The volume of the worker pool is already part of the hash.
+func rootVolumeHashData(workerConfig *api.WorkerConfig) []string {
+	rootVolume := workerConfig.RootVolume
+	if rootVolume == nil {
+		return nil
+	}
+
+	var data []string
+	if rootVolume.Size != nil {
+		data = append(data, "rootVolumeSize="+rootVolume.Size.String())
+	}
+	if rootVolume.Type != nil {
+		data = append(data, "rootVolumeType="+*rootVolume.Type)
+	}
+	if rootVolume.DeleteOnTermination != nil {
+		data = append(data, "rootVolumeDeleteOnTermination="+strconv.FormatBool(*rootVolume.DeleteOnTermination))
+	}
+	for _, zone := range sets.List(sets.KeySet(rootVolume.ZoneTypes)) {
+		data = append(data, "rootVolumeZoneType="+zone+"="+rootVolume.ZoneTypes[zone])
+	}
+	return data
+}
+
+// machineClassSpec sets the root disk of the machines in the given zone in the given spec of a machine class.
+func (d rootDisk) machineClassSpec(machineClassSpec map[string]interface{}, zone string) {
+	if d.size > 0 {
+		machineClassSpec["rootDiskSize"] = d.size
+	}
+	// specifying the volume type requires a custom volume size to be specified too.
+	if volumeType, ok := d.zoneVolumeTypes[zone]; ok {
+		machineClassSpec["rootDiskType"] = volumeType
+	} else if d.volumeType != nil {
+		machineClassSpec["rootDiskType"] = *d.volumeType
+	}
+	if d.deleteOnTermination != nil {
+		machineClassSpec["rootDiskDeleteOnTermination"] = *d.deleteOnTermination
+	}
+}
@@ -9,8 +9,10 @@ import (
 	"sort"
 	"strings"
 
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/servergroups"
+	"k8s.io/utils/pointer"
 
 	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
 )
@@ -19,12 +21,64 @@ func isServerGroupRequired(config *api.WorkerConfig) bool {
 	return config != nil && config.ServerGroup != nil && config.ServerGroup.Policy != ""
 }
 
-func generateServerGroupName(clusterName, poolName string) (string, error) {
+// isServerGroupPerZone returns whether a separate server group is managed for each zone of the worker pool with the
+// given configuration.
+func isServerGroupPerZone(config *api.WorkerConfig) bool {
+	return isServerGroupRequired(config) && pointer.BoolDeref(config.ServerGroup.PerZone, false)
+}
+
+// serverGroupZones returns the zones a server group is managed for in the given worker pool. The zone is nil for the
+// server group of a worker pool which has a single server group.
+func serverGroupZones(pool extensionsv1alpha1.WorkerPool, config *api.WorkerConfig) []*string {
+	if !isServerGroupPerZone(config) {
+		return []*string{nil}
+	}
+
+	zones := make([]*string, 0, len(pool.Zones))
+	for _, zone := range pool.Zones {
+		zones = append(zones, pointer.String(zone))
+	}
+	return zones
+}
+
+// poolServerGroupDependencies returns the server group dependency of each zone of the given worker pool, which is the
+// same for all zones unless the worker pool has a server group per zone. It returns nil if the worker pool has no
+// server group.
+func poolServerGroupDependencies(set serverGroupDependencySet, pool extensionsv1alpha1.WorkerPool, config *api.WorkerConfig) ([]*api.ServerGroupDependency, error) {
+	if !isServerGroupRequired(config) {
+		return nil, nil
+	}
+
+	perZone := isServerGroupPerZone(config)
+	deps := make([]*api.ServerGroupDependency, 0, len(pool.Zones))
+	for _, zone := range pool.Zones {
+		if !perZone {
+			dep := set.get(pool.Name, nil)
+			if dep == nil {
+				return nil, fmt.Errorf("server group is required for pool %q, but no server group dependency found", pool.Name)
+			}
+			deps = append(deps, dep)
+			continue
+		}
+
+		dep := set.get(pool.Name, pointer.String(zone))
+		if dep == nil {
+			return nil, fmt.Errorf("server group is required for zone %q of pool %q, but no server group dependency found", zone, pool.Name)
+		}
+		deps = append(deps, dep)
+	}
+	return deps, nil
+}
+
+func generateServerGroupName(clusterName, poolName string, zone *string) (string, error) {
 	suffix, err := utils.GenerateRandomString(10)
 	if err != nil {
 		return "", err
 	}
 
+	if zone != nil {
+		return fmt.Sprintf("%s-%s-%s-%s", clusterName, poolName, *zone, suffix), nil
+	}
 	return fmt.Sprintf("%s-%s-%s", clusterName, poolName, suffix), nil
 }
 
@@ -39,7 +93,16 @@ func filterServerGroupsByPrefix(sgs []servergroups.ServerGroup, prefix string) [
 	return result
 }
 
-// serverGroupDependencySet is a set implementation for ServerGroupDependency objects that uses the PoolName as identifying key.
+// serverGroupDependencyKey returns the key identifying the server group dependency of the given worker pool and zone.
+func serverGroupDependencyKey(poolName string, zone *string) string {
+	if zone == nil {
+		return poolName
+	}
+	return poolName + "/" + *zone
+}
+
+// serverGroupDependencySet is a set implementation for ServerGroupDependency objects that uses the PoolName and the
+// Zone as identifying key.
 type serverGroupDependencySet struct {
 	set map[string]api.ServerGroupDependency
 }
@@ -48,7 +111,7 @@ type serverGroupDependencySet struct {
 func newServerGroupDependencySet(deps []api.ServerGroupDependency) serverGroupDependencySet {
 	m := make(map[string]api.ServerGroupDependency, len(deps))
 	for _, d := range deps {
-		m[d.PoolName] = d
+		m[serverGroupDependencyKey(d.PoolName, d.Zone)] = d
 	}
 
 	return serverGroupDependencySet{m}
@@ -59,12 +122,13 @@ func (s *serverGroupDependencySet) upsert(d *api.ServerGroupDependency) {
 	if d == nil {
 		return
 	}
-	s.set[d.PoolName] = *d
+	s.set[serverGroupDependencyKey(d.PoolName, d.Zone)] = *d
 }
 
-// getByPoolName retrieves a ServerGroupDependency if it matches the provided PoolName. It returns nil if there is no matching entry in the set.
-func (s *serverGroupDependencySet) getByPoolName(pn string) *api.ServerGroupDependency {
-	d, ok := s.set[pn]
+// get retrieves a ServerGroupDependency if it matches the provided PoolName and Zone. It returns nil if there is no
+// matching entry in the set.
+func (s *serverGroupDependencySet) get(pn string, zone *string) *api.ServerGroupDependency {
+	d, ok := s.set[serverGroupDependencyKey(pn, zone)]
 	if !ok {
 		return nil
 	}
@@ -82,22 +146,22 @@ func (s *serverGroupDependencySet) getById(id string) *api.ServerGroupDependency
 	return nil
 }
 
-// deleteByPoolName deletes a ServerGroupDependency if it matches the provided PoolName. It is a no-op if there is no matching entry in the set.
-func (s *serverGroupDependencySet) deleteByPoolName(pn string) {
-	delete(s.set, pn)
+// delete deletes the given ServerGroupDependency. It is a no-op if there is no matching entry in the set.
+func (s *serverGroupDependencySet) delete(d api.ServerGroupDependency) {
+	delete(s.set, serverGroupDependencyKey(d.PoolName, d.Zone))
 }
 
 // deleteByID deletes a ServerGroupDependency if it matches the provided ID. It is a no-op if there is no matching entry in the set.
 func (s *serverGroupDependencySet) deleteByID(id string) {
-	for _, v := range s.set {
+	for k, v := range s.set {
 		if v.ID == id {
-			delete(s.set, v.PoolName)
+			delete(s.set, k)
 			break
 		}
 	}
 }
 
-// extract produces a slice from the elements contained in the set, sorted by PoolName.
+// extract produces a slice from the elements contained in the set, sorted by PoolName and Zone.
 func (s *serverGroupDependencySet) extract() []api.ServerGroupDependency {
 	if len(s.set) == 0 {
 		return nil
@@ -110,7 +174,10 @@ func (s *serverGroupDependencySet) extract() []api.ServerGroupDependency {
 
 	// sort resulting slice to avoid randomization from map
 	sort.Slice(r, func(i, j int) bool {
-		return r[i].PoolName < r[j].PoolName
+		if r[i].PoolName != r[j].PoolName {
+			return r[i].PoolName < r[j].PoolName
+		}
+		return pointer.StringDeref(r[i].Zone, "") < pointer.StringDeref(r[j].Zone, "")
 	})
 	return r
 }
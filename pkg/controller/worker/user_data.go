@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+)
+
+const (
+	// ignitionVersion is the version of the Ignition configs the user data is wrapped into.
+	ignitionVersion = "3.3.0"
+	// ignitionUserDataPath is the path the wrapped user data is written to by Ignition.
+	ignitionUserDataPath = "/var/lib/gardener/user-data"
+	// ignitionUserDataUnit is the name of the systemd unit processing the wrapped user data on the first boot.
+	ignitionUserDataUnit = "gardener-user-data.service"
+)
+
+// isIgnitionUserData returns whether the worker pool with the given configuration delivers its user data as Ignition
+// config.
+func isIgnitionUserData(workerConfig *api.WorkerConfig) bool {
+	return workerConfig.UserDataFormat != nil && *workerConfig.UserDataFormat == api.UserDataFormatIgnition
+}
+
+// userDataOf returns the user data of the machines of a worker pool in the format of its configuration.
+func userDataOf(userData []byte, workerConfig *api.WorkerConfig, machineImage *api.MachineImage) ([]byte, error) {
+	if !isIgnitionUserData(workerConfig) {
+		return userData, nil
+	}
+
+	if !hasMachineImageCapability(machineImage, api.MachineImageCapabilityIgnition) {
+		return nil, fmt.Errorf("machine image %q in version %q is not provisioned with Ignition", machineImage.Name, machineImage.Version)
+	}
+	return ignitionUserData(userData)
+}
+
+// ignitionUserData returns the given user data as Ignition config. User data which is an Ignition config already is
+// returned as is. Scripts and cloud-configs are written to a file which is executed or applied with coreos-cloudinit
+// by a systemd unit on the first boot.
+func ignitionUserData(userData []byte) ([]byte, error) {
+	var existing struct {
+		Ignition *json.RawMessage `json:"ignition"`
+	}
+	if err := json.Unmarshal(userData, &existing); err == nil && existing.Ignition != nil {
+		return userData, nil
+	}
+
+	var execStart string
+	switch {
+	case bytes.HasPrefix(userData, []byte("#!")):
+		execStart = ignitionUserDataPath
+	case bytes.HasPrefix(userData, []byte("#cloud-config")):
+		execStart = "/usr/bin/coreos-cloudinit --from-file=" + ignitionUserDataPath
+	default:
+		return nil, fmt.Errorf("user data can only be converted to Ignition if it is a script or a cloud-config")
+	}
+
+	return json.Marshal(&ignitionConfig{
+		Ignition: ignitionMetadata{Version: ignitionVersion},
+		Storage: ignitionStorage{Files: []ignitionFile{{
+			Path:      ignitionUserDataPath,
+			Mode:      0700,
+			Overwrite: true,
+			Contents:  ignitionFileContents{Source: "data:;base64," + base64.StdEncoding.EncodeToString(userData)},
+		}}},
+		Systemd: ignitionSystemd{Units: []ignitionUnit{{
+			Name:    ignitionUserDataUnit,
+			Enabled: true,
+			Contents: fmt.Sprintf(`[Unit]
+Description=Process the user data of the machine
+Wants=network-online.target
+After=network-online.target
+ConditionFirstBoot=true
+
+[Service]
+Type=oneshot
+RemainAfterExit=true
+ExecStart=%s
+
+[Install]
+WantedBy=multi-user.target
+`, execStart),
+		}}},
+	})
+}
+
+type ignitionConfig struct {
+	Ignition ignitionMetadata `json:"ignition"`
+	Storage  ignitionStorage  `json:"storage"`
+	Systemd  ignitionSystemd  `json:"systemd"`
+}
+
+type ignitionMetadata struct {
+	Version string `json:"version"`
+}
+
+type ignitionStorage struct {
+	Files []ignitionFile `json:"files"`
+}
+
+type ignitionFile struct {
+	Path      string               `json:"path"`
+	Mode      int                  `json:"mode"`
+	Overwrite bool                 `json:"overwrite"`
+	Contents  ignitionFileContents `json:"contents"`
+}
+
+type ignitionFileContents struct {
+	Source string `json:"source"`
+}
+
+type ignitionSystemd struct {
+	Units []ignitionUnit `json:"units"`
+}
+
+type ignitionUnit struct {
+	Name     string `json:"name"`
+	Enabled  bool   `json:"enabled"`
+	Contents string `json:"contents"`
+}
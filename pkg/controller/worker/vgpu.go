@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"fmt"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/pointer"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+)
+
+const (
+	// gpuPresentLabel is the label the NVIDIA GPU operator uses to select the nodes to deploy its operands, e.g. the
+	// device plugin, to.
+	gpuPresentLabel = "nvidia.com/gpu.present"
+	// devicePluginConfigLabel is the label selecting the configuration of the NVIDIA device plugin of a node.
+	devicePluginConfigLabel = "nvidia.com/device-plugin.config"
+	// gpuTaintKey is the key of the taint of GPU nodes, which is tolerated by the operands of the NVIDIA GPU operator.
+	gpuTaintKey = "nvidia.com/gpu"
+)
+
+// flavorVGPUs returns the number of vGPUs the given flavor requests from the Placement service.
+func (w *workerDelegate) flavorVGPUs(flavorName string) (int64, error) {
+	if w.openstackClient == nil {
+		return 0, fmt.Errorf("no OpenStack client available to look up flavor %q", flavorName)
+	}
+
+	flavor, extraSpecs, err := w.lookupFlavor(flavorName)
+	if err != nil {
+		return 0, err
+	}
+	if flavor == nil {
+		return 0, fmt.Errorf("flavor %q not found", flavorName)
+	}
+
+	key := flavorExtraSpecResourcesPrefix + resourceClassVGPU
+	value, ok := extraSpecs[key]
+	if !ok {
+		return 0, fmt.Errorf("flavor %q does not request vGPUs via the %q extra spec", flavorName, key)
+	}
+	vgpus, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid extra spec %q of flavor %q: %w", key, flavorName, err)
+	}
+	return vgpus, nil
+}
+
+// vgpuNodeTemplateCapacity returns a copy of the given node template capacity with the GPUs advertised by the NVIDIA
+// device plugin for the given number of vGPUs, i.e. each vGPU counts as often as it is replicated by time-slicing.
+func vgpuNodeTemplateCapacity(capacity corev1.ResourceList, resourceName corev1.ResourceName, vgpus int64, config *api.VGPU) corev1.ResourceList {
+	if capacity == nil {
+		return nil
+	}
+
+	result := capacity.DeepCopy()
+	replicas := int64(pointer.Int32Deref(config.TimeSlicingReplicas, 1))
+	result[resourceName] = *resource.NewQuantity(vgpus*replicas, resource.DecimalSI)
+	return result
+}
+
+// vgpuLabels returns the given labels extended by the labels for the NVIDIA GPU operator.
+func vgpuLabels(labels map[string]string, config *api.VGPU) map[string]string {
+	result := make(map[string]string, len(labels)+2)
+	for k, v := range labels {
+		result[k] = v
+	}
+	result[gpuPresentLabel] = "true"
+	if config.DevicePluginConfig != nil {
+		result[devicePluginConfigLabel] = *config.DevicePluginConfig
+	}
+	return result
+}
+
+// vgpuTaints returns the given taints extended by the GPU taint unless tainting is disabled or the taint is already
+// present.
+func vgpuTaints(taints []corev1.Taint, config *api.VGPU) []corev1.Taint {
+	if !pointer.BoolDeref(config.Taint, true) {
+		return taints
+	}
+	for _, taint := range taints {
+		if taint.Key == gpuTaintKey {
+			return taints
+		}
+	}
+
+	result := append([]corev1.Taint{}, taints...)
+	return append(result, corev1.Taint{
+		Key:    gpuTaintKey,
+		Value:  "present",
+		Effect: corev1.TaintEffectNoSchedule,
+	})
+}
@@ -0,0 +1,199 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/subnets"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/pointer"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/helper"
+	openstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
+)
+
+const (
+	// ConditionTypeWakeUpDependenciesAvailable is the type of the condition of a worker reporting whether the OpenStack
+	// resources its machines depend on still existed when the shoot was woken up from hibernation.
+	ConditionTypeWakeUpDependenciesAvailable gardencorev1beta1.ConditionType = "WakeUpDependenciesAvailable"
+
+	// ReasonDependenciesAvailable is the reason of the condition if all dependencies exist.
+	ReasonDependenciesAvailable = "DependenciesAvailable"
+	// ReasonDependenciesMissing is the reason of the condition if dependencies went missing during the hibernation.
+	ReasonDependenciesMissing = "DependenciesMissing"
+)
+
+// isWakingUp returns true if the shoot of the given cluster is being woken up from hibernation, i.e. it is still
+// hibernated but its hibernation has been disabled.
+func isWakingUp(cluster *extensionscontroller.Cluster) bool {
+	return cluster != nil && cluster.Shoot != nil && !extensionscontroller.IsHibernationEnabled(cluster) && cluster.Shoot.Status.IsHibernated
+}
+
+// checkWakeUpDependencies verifies that the OpenStack resources the machines of the worker depend on still exist before
+// the shoot is woken up from hibernation, as they may have been deleted while the shoot was hibernated. The result is
+// reported in a dedicated condition of the worker. Missing dependencies fail the reconciliation before the machine
+// classes are deployed and the machine deployments are scaled up, so that no machines are created which cannot start.
+func (w *workerDelegate) checkWakeUpDependencies(ctx context.Context) error {
+	if !isWakingUp(w.cluster) {
+		return nil
+	}
+
+	missing, err := w.missingWakeUpDependencies()
+	if err != nil {
+		return fmt.Errorf("could not check the dependencies of the machines before waking up: %w", err)
+	}
+
+	status, reason, message := gardencorev1beta1.ConditionTrue, ReasonDependenciesAvailable, "All OpenStack resources the machines depend on exist."
+	if len(missing) > 0 {
+		status, reason, message = gardencorev1beta1.ConditionFalse, ReasonDependenciesMissing,
+			fmt.Sprintf("OpenStack resources the machines depend on went missing during the hibernation: %s", strings.Join(missing, "; "))
+	}
+	if err := w.updateWakeUpCondition(ctx, status, reason, message); err != nil {
+		return err
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("shoot cannot be woken up: %s", message)
+	}
+	return nil
+}
+
+// missingWakeUpDependencies returns descriptions of the dependencies of the machines which do not exist anymore: the
+// network, subnet, security group and key pair of the infrastructure, as well as the flavors, images and key pairs of
+// the worker pools.
+func (w *workerDelegate) missingWakeUpDependencies() ([]string, error) {
+	var missing []string
+
+	infrastructureStatus, err := helper.InfrastructureStatusFromRaw(w.worker.Spec.InfrastructureProviderStatus)
+	if err != nil {
+		return nil, err
+	}
+	infrastructureMissing, err := w.missingInfrastructureDependencies(infrastructureStatus)
+	if err != nil {
+		return nil, err
+	}
+	missing = append(missing, infrastructureMissing...)
+
+	for _, pool := range w.worker.Spec.Pools {
+		flavor, _, err := w.lookupFlavor(pool.MachineType)
+		if err != nil {
+			return nil, err
+		}
+		if flavor == nil {
+			missing = append(missing, fmt.Sprintf("flavor %q of worker pool %q does not exist", pool.MachineType, pool.Name))
+		}
+
+		architecture := pointer.StringDeref(pool.Architecture, v1beta1constants.ArchitectureAMD64)
+		machineImage, err := w.findMachineImage(pool.MachineImage.Name, pool.MachineImage.Version, architecture)
+		if err != nil {
+			return nil, err
+		}
+		reason, err := w.verifyImage(machineImage)
+		if err != nil {
+			return nil, err
+		}
+		if reason != "" {
+			missing = append(missing, fmt.Sprintf("%s for worker pool %q", reason, pool.Name))
+		}
+
+		workerConfig, err := helper.WorkerConfigFromRawExtension(pool.ProviderConfig)
+		if err != nil {
+			return nil, err
+		}
+		if workerConfig.KeyName != nil {
+			exists, err := w.keyPairExists(*workerConfig.KeyName)
+			if err != nil {
+				return nil, err
+			}
+			if !exists {
+				missing = append(missing, fmt.Sprintf("key pair %q of worker pool %q does not exist", *workerConfig.KeyName, pool.Name))
+			}
+		}
+	}
+	return missing, nil
+}
+
+func (w *workerDelegate) missingInfrastructureDependencies(infrastructureStatus *api.InfrastructureStatus) ([]string, error) {
+	var missing []string
+
+	networkingClient, err := w.openstackClient.Networking(openstackclient.WithRegion(w.worker.Spec.Region))
+	if err != nil {
+		return nil, err
+	}
+
+	networkList, err := networkingClient.ListNetwork(networks.ListOpts{ID: infrastructureStatus.Networks.ID})
+	if err != nil {
+		return nil, err
+	}
+	if len(networkList) == 0 {
+		missing = append(missing, fmt.Sprintf("network %q does not exist", infrastructureStatus.Networks.ID))
+	}
+
+	if subnet, err := helper.FindSubnetByPurpose(infrastructureStatus.Networks.Subnets, api.PurposeNodes); err == nil {
+		subnetList, err := networkingClient.ListSubnets(subnets.ListOpts{ID: subnet.ID})
+		if err != nil {
+			return nil, err
+		}
+		if len(subnetList) == 0 {
+			missing = append(missing, fmt.Sprintf("subnet %q does not exist", subnet.ID))
+		}
+	}
+
+	if securityGroup, err := helper.FindSecurityGroupByPurpose(infrastructureStatus.SecurityGroups, api.PurposeNodes); err == nil {
+		if _, err := networkingClient.GetSecurityGroup(securityGroup.ID); err != nil {
+			if !openstackclient.IsNotFoundError(err) {
+				return nil, err
+			}
+			missing = append(missing, fmt.Sprintf("security group %q does not exist", securityGroup.ID))
+		}
+	}
+
+	if keyName := infrastructureStatus.Node.KeyName; keyName != "" {
+		exists, err := w.keyPairExists(keyName)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			missing = append(missing, fmt.Sprintf("key pair %q does not exist", keyName))
+		}
+	}
+	return missing, nil
+}
+
+func (w *workerDelegate) keyPairExists(name string) (bool, error) {
+	computeClient, err := w.openstackClient.Compute(openstackclient.WithRegion(w.worker.Spec.Region))
+	if err != nil {
+		return false, err
+	}
+	keyPair, err := computeClient.GetKeyPair(name)
+	if err != nil {
+		return false, err
+	}
+	return keyPair != nil, nil
+}
+
+func (w *workerDelegate) updateWakeUpCondition(ctx context.Context, status gardencorev1beta1.ConditionStatus, reason, message string) error {
+	return w.patchStatus(ctx, func(workerStatus *extensionsv1alpha1.WorkerStatus) (bool, error) {
+		c := clock.RealClock{}
+		condition := v1beta1helper.GetOrInitConditionWithClock(c, workerStatus.Conditions, ConditionTypeWakeUpDependenciesAvailable)
+		if condition.Status == status && condition.Reason == reason && condition.Message == message {
+			return false, nil
+		}
+
+		condition = v1beta1helper.UpdatedConditionWithClock(c, condition, status, reason, message)
+		workerStatus.Conditions = v1beta1helper.MergeConditions(workerStatus.Conditions, condition)
+		return true, nil
+	})
+}
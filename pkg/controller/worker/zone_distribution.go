@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/internal/zones"
+)
+
+// zoneDistribution returns the distribution of a worker pool over its zones. Values which cannot be distributed evenly
+// are assigned to the zones with the most machines first, so that resizing the pool or adding zones does not move
+// machines between the zones unnecessarily.
+func (w *workerDelegate) zoneDistribution(ctx context.Context, pool extensionsv1alpha1.WorkerPool) (*zones.Distribution, error) {
+	existing, err := w.existingMachineDeployments(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	current := make([]int32, len(pool.Zones))
+	for zoneIndex := range pool.Zones {
+		if deployment, ok := existing[w.naming.DeploymentName(w.worker.Namespace, pool.Name, zoneIndex)]; ok {
+			current[zoneIndex] = deployment.Spec.Replicas
+		}
+	}
+	return zones.NewStable(current), nil
+}
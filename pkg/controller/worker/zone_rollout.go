@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/worker"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+)
+
+// applyZoneRollout rolls the zones of the given pool one after another. The first zone whose machine deployment has
+// not been updated completely or has not stayed healthy for the grace period is rolled with the maxSurge and
+// maxUnavailable of the whole pool, so that the capacity of only one zone is lost at a time. The machine deployments
+// of the subsequent zones keep referring to their current machine class.
+func (w *workerDelegate) applyZoneRollout(ctx context.Context, poolName string, zoneRollout *api.ZoneRollout, maxSurge, maxUnavailable intstr.IntOrString, deployments worker.MachineDeployments) error {
+	existing, err := w.existingMachineDeployments(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i := range deployments {
+		current, ok := existing[deployments[i].Name]
+		if !ok {
+			// the zone is new to the worker pool, there is nothing to roll
+			continue
+		}
+
+		reason, requeueAfter := rolloutProgress("machine deployment", current, deployments[i].ClassName, zoneRollout.GracePeriod)
+		if reason == "" {
+			continue
+		}
+
+		deployments[i].MaxSurge = maxSurge
+		deployments[i].MaxUnavailable = maxUnavailable
+
+		heldBack := false
+		for j := i + 1; j < len(deployments); j++ {
+			if keepCurrentMachineClass(&deployments[j], existing) {
+				heldBack = true
+			}
+		}
+		if heldBack {
+			w.pendingZoneRollouts = append(w.pendingZoneRollouts, pendingRollout{
+				poolName:     poolName,
+				reason:       reason,
+				requeueAfter: requeueAfter,
+			})
+		}
+		return nil
+	}
+
+	return nil
+}
+
+// checkPendingZoneRollouts returns an error requeueing the worker if the rollout of any zone of a worker pool is held
+// back.
+func (w *workerDelegate) checkPendingZoneRollouts() error {
+	return pendingRolloutsError("zone rollout", w.pendingZoneRollouts)
+}
@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package features
+
+import (
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/component-base/featuregate"
+)
+
+const (
+	// Every feature gate should add a constant here following this template:
+	//
+	// // MyFeature enables Foo.
+	// // alpha: v1.X.0
+	// MyFeature featuregate.Feature = "MyFeature"
+
+	// InfrastructureFlow reconciles all infrastructures with the flow reconciler instead of Terraformer, as if they were
+	// annotated with `openstack.provider.extensions.gardener.cloud/use-flow=true`. Infrastructures which have been
+	// reconciled with the flow reconciler once keep being reconciled with it if the gate is disabled again.
+	// alpha: v1.40.0
+	InfrastructureFlow featuregate.Feature = "InfrastructureFlow"
+
+	// MachineClassExtra merges the MachineClassExtra of the WorkerConfig of a worker pool into the provider spec of its
+	// machine classes, if the keys are allowed in the configuration of the extension.
+	// alpha: v1.40.0
+	MachineClassExtra featuregate.Feature = "MachineClassExtra"
+
+	// HostPinning pins the machines of worker pools to the hypervisor hosts or nodes configured in the HostPinning of
+	// their WorkerConfig.
+	// alpha: v1.40.0
+	HostPinning featuregate.Feature = "HostPinning"
+)
+
+// ExtensionFeatureGate is the feature gate of the extension. It is configured with the feature gates of the
+// ControllerConfiguration on startup.
+var ExtensionFeatureGate = featuregate.NewFeatureGate()
+
+// allFeatureGates are the known features of the extension and their specs.
+var allFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
+	InfrastructureFlow: {Default: false, PreRelease: featuregate.Alpha},
+	MachineClassExtra:  {Default: false, PreRelease: featuregate.Alpha},
+	HostPinning:        {Default: false, PreRelease: featuregate.Alpha},
+}
+
+func init() {
+	utilruntime.Must(ExtensionFeatureGate.Add(allFeatureGates))
+}
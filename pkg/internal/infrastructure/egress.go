@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infrastructure
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/rules"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+)
+
+const (
+	// APIServerPort is the port the API server of the shoot is exposed at by the load balancers of the seed.
+	APIServerPort = 443
+	// VPNTunnelPort is the port the VPN tunnel endpoint of the shoot is exposed at by the load balancers of the seed.
+	VPNTunnelPort = 8132
+	// EgressRuleDescriptionPrefix is the prefix of the descriptions of all security group rules allowing restricted
+	// outgoing IPv4 traffic of the nodes.
+	EgressRuleDescriptionPrefix = "IPv4: allow outgoing "
+	// EgressRuleDescriptionPrefixIPv6 is the prefix of the descriptions of all security group rules allowing restricted
+	// outgoing IPv6 traffic of the nodes.
+	EgressRuleDescriptionPrefixIPv6 = "IPv6: allow outgoing "
+
+	dnsPort                 = 53
+	metadataServicePort     = 80
+	metadataServiceCIDR     = "169.254.169.254/32"
+	metadataServiceIPv6CIDR = "fe80::a9fe:a9fe/128"
+)
+
+// EgressRule is a rule of the security group of the nodes allowing outgoing traffic.
+type EgressRule struct {
+	// EtherType is the ether type of the rule, i.e. `IPv4` or `IPv6` depending on the destination CIDR.
+	EtherType string
+	// Protocol is the protocol of the allowed traffic, or empty if traffic of all protocols is allowed.
+	Protocol string
+	// Port is the destination port of the allowed traffic, or zero if traffic to all ports is allowed.
+	Port int
+	// DestinationCIDR is the CIDR to which traffic is allowed.
+	DestinationCIDR string
+	// Description is the description of the rule.
+	Description string
+}
+
+// IsEgressRestricted returns true if the outgoing traffic of the nodes is restricted by the given InfrastructureConfig.
+func IsEgressRestricted(config *openstack.InfrastructureConfig) bool {
+	return config != nil && config.Egress != nil && config.Egress.Restricted
+}
+
+// EgressRules determines the rules allowing the outgoing traffic needed by the cluster from the given
+// InfrastructureConfig, the DNS servers of the CloudProfile and the pod network of the shoot. No rules are returned if
+// egress traffic is not restricted, as all outgoing traffic is allowed then. IPv6 DNS servers, pod networks and the
+// IPv6 address of the metadata service are only considered if IPv6 rules are managed.
+func EgressRules(config *openstack.InfrastructureConfig, dnsServers []string, podsCIDR *string, ipv6 bool) []EgressRule {
+	if !IsEgressRestricted(config) {
+		return nil
+	}
+
+	var (
+		egressRules []EgressRule
+		seen        = sets.New[string]()
+	)
+	add := func(protocol string, port int, destinationCIDR, format string, args ...interface{}) {
+		key := fmt.Sprintf("%s:%d:%s", protocol, port, destinationCIDR)
+		if seen.Has(key) {
+			return
+		}
+		seen.Insert(key)
+		etherType, descriptionPrefix := string(rules.EtherType4), EgressRuleDescriptionPrefix
+		if !isIPv4CIDR(destinationCIDR) {
+			etherType, descriptionPrefix = string(rules.EtherType6), EgressRuleDescriptionPrefixIPv6
+		}
+		egressRules = append(egressRules, EgressRule{
+			EtherType:       etherType,
+			Protocol:        protocol,
+			Port:            port,
+			DestinationCIDR: destinationCIDR,
+			Description:     descriptionPrefix + fmt.Sprintf(format, args...),
+		})
+	}
+
+	for _, cidr := range config.Egress.SeedCIDRs {
+		add("tcp", APIServerPort, cidr, "tcp traffic to the API server at %s", cidr)
+		add("tcp", VPNTunnelPort, cidr, "tcp traffic to the VPN tunnel endpoint at %s", cidr)
+	}
+	for _, server := range dnsServers {
+		ip := net.ParseIP(server)
+		if ip == nil || (ip.To4() == nil && !ipv6) {
+			continue
+		}
+		destinationCIDR := ip.String() + "/32"
+		if ip.To4() == nil {
+			destinationCIDR = ip.String() + "/128"
+		}
+		for _, protocol := range []string{"udp", "tcp"} {
+			add(protocol, dnsPort, destinationCIDR, "%s traffic to the DNS server %s", protocol, ip)
+		}
+	}
+	add("tcp", metadataServicePort, metadataServiceCIDR, "tcp traffic to the metadata service")
+	if ipv6 {
+		add("tcp", metadataServicePort, metadataServiceIPv6CIDR, "tcp traffic to the metadata service")
+	}
+
+	destinationCIDRs := []string{WorkersCIDR(config)}
+	for _, subnet := range config.Networks.Subnets {
+		destinationCIDRs = append(destinationCIDRs, subnet.CIDR)
+	}
+	if podsCIDR != nil && (isIPv4CIDR(*podsCIDR) || ipv6) {
+		destinationCIDRs = append(destinationCIDRs, *podsCIDR)
+	}
+	for _, cidr := range append(destinationCIDRs, config.Egress.CIDRs...) {
+		add("", 0, cidr, "traffic to %s", cidr)
+	}
+
+	return egressRules
+}
+
+func isIPv4CIDR(cidr string) bool {
+	ip, _, err := net.ParseCIDR(cidr)
+	return err == nil && ip.To4() != nil
+}
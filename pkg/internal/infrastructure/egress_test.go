@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infrastructure
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/pointer"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+)
+
+var _ = Describe("Egress", func() {
+	var config *api.InfrastructureConfig
+
+	BeforeEach(func() {
+		config = &api.InfrastructureConfig{
+			Networks: api.Networks{Workers: "10.250.0.0/19"},
+			Egress: &api.Egress{
+				Restricted: true,
+				SeedCIDRs:  []string{"203.0.113.10/32"},
+			},
+		}
+	})
+
+	Describe("#EgressRules", func() {
+		It("should not return any rules if egress traffic is not restricted", func() {
+			config.Egress.Restricted = false
+
+			Expect(EgressRules(config, []string{"192.0.2.53"}, pointer.String("100.96.0.0/11"), false)).To(BeEmpty())
+			Expect(EgressRules(&api.InfrastructureConfig{}, nil, nil, false)).To(BeEmpty())
+		})
+
+		It("should derive the rules from the config, the DNS servers and the pod network", func() {
+			config.Egress.CIDRs = []string{"198.51.100.0/24"}
+
+			Expect(EgressRules(config, []string{"192.0.2.53", "2001:db8::53", "invalid"}, pointer.String("100.96.0.0/11"), false)).To(Equal([]EgressRule{
+				{EtherType: "IPv4", Protocol: "tcp", Port: 443, DestinationCIDR: "203.0.113.10/32", Description: "IPv4: allow outgoing tcp traffic to the API server at 203.0.113.10/32"},
+				{EtherType: "IPv4", Protocol: "tcp", Port: 8132, DestinationCIDR: "203.0.113.10/32", Description: "IPv4: allow outgoing tcp traffic to the VPN tunnel endpoint at 203.0.113.10/32"},
+				{EtherType: "IPv4", Protocol: "udp", Port: 53, DestinationCIDR: "192.0.2.53/32", Description: "IPv4: allow outgoing udp traffic to the DNS server 192.0.2.53"},
+				{EtherType: "IPv4", Protocol: "tcp", Port: 53, DestinationCIDR: "192.0.2.53/32", Description: "IPv4: allow outgoing tcp traffic to the DNS server 192.0.2.53"},
+				{EtherType: "IPv4", Protocol: "tcp", Port: 80, DestinationCIDR: "169.254.169.254/32", Description: "IPv4: allow outgoing tcp traffic to the metadata service"},
+				{EtherType: "IPv4", DestinationCIDR: "10.250.0.0/19", Description: "IPv4: allow outgoing traffic to 10.250.0.0/19"},
+				{EtherType: "IPv4", DestinationCIDR: "100.96.0.0/11", Description: "IPv4: allow outgoing traffic to 100.96.0.0/11"},
+				{EtherType: "IPv4", DestinationCIDR: "198.51.100.0/24", Description: "IPv4: allow outgoing traffic to 198.51.100.0/24"},
+			}))
+		})
+
+		It("should allow outgoing traffic to the node subnets", func() {
+			config.Networks.Subnets = []api.NodeSubnet{{Name: "zone-a", CIDR: "10.250.32.0/19"}}
+
+			Expect(EgressRules(config, nil, nil, false)).To(Equal([]EgressRule{
+				{EtherType: "IPv4", Protocol: "tcp", Port: 443, DestinationCIDR: "203.0.113.10/32", Description: "IPv4: allow outgoing tcp traffic to the API server at 203.0.113.10/32"},
+				{EtherType: "IPv4", Protocol: "tcp", Port: 8132, DestinationCIDR: "203.0.113.10/32", Description: "IPv4: allow outgoing tcp traffic to the VPN tunnel endpoint at 203.0.113.10/32"},
+				{EtherType: "IPv4", Protocol: "tcp", Port: 80, DestinationCIDR: "169.254.169.254/32", Description: "IPv4: allow outgoing tcp traffic to the metadata service"},
+				{EtherType: "IPv4", DestinationCIDR: "10.250.0.0/19", Description: "IPv4: allow outgoing traffic to 10.250.0.0/19"},
+				{EtherType: "IPv4", DestinationCIDR: "10.250.32.0/19", Description: "IPv4: allow outgoing traffic to 10.250.32.0/19"},
+			}))
+		})
+
+		It("should add the IPv6 rules if IPv6 rules are managed", func() {
+			config.Egress.SeedCIDRs = append(config.Egress.SeedCIDRs, "2001:db8:1::10/128")
+
+			Expect(EgressRules(config, []string{"192.0.2.53", "2001:db8::53"}, pointer.String("fd00::/48"), true)).To(Equal([]EgressRule{
+				{EtherType: "IPv4", Protocol: "tcp", Port: 443, DestinationCIDR: "203.0.113.10/32", Description: "IPv4: allow outgoing tcp traffic to the API server at 203.0.113.10/32"},
+				{EtherType: "IPv4", Protocol: "tcp", Port: 8132, DestinationCIDR: "203.0.113.10/32", Description: "IPv4: allow outgoing tcp traffic to the VPN tunnel endpoint at 203.0.113.10/32"},
+				{EtherType: "IPv6", Protocol: "tcp", Port: 443, DestinationCIDR: "2001:db8:1::10/128", Description: "IPv6: allow outgoing tcp traffic to the API server at 2001:db8:1::10/128"},
+				{EtherType: "IPv6", Protocol: "tcp", Port: 8132, DestinationCIDR: "2001:db8:1::10/128", Description: "IPv6: allow outgoing tcp traffic to the VPN tunnel endpoint at 2001:db8:1::10/128"},
+				{EtherType: "IPv4", Protocol: "udp", Port: 53, DestinationCIDR: "192.0.2.53/32", Description: "IPv4: allow outgoing udp traffic to the DNS server 192.0.2.53"},
+				{EtherType: "IPv4", Protocol: "tcp", Port: 53, DestinationCIDR: "192.0.2.53/32", Description: "IPv4: allow outgoing tcp traffic to the DNS server 192.0.2.53"},
+				{EtherType: "IPv6", Protocol: "udp", Port: 53, DestinationCIDR: "2001:db8::53/128", Description: "IPv6: allow outgoing udp traffic to the DNS server 2001:db8::53"},
+				{EtherType: "IPv6", Protocol: "tcp", Port: 53, DestinationCIDR: "2001:db8::53/128", Description: "IPv6: allow outgoing tcp traffic to the DNS server 2001:db8::53"},
+				{EtherType: "IPv4", Protocol: "tcp", Port: 80, DestinationCIDR: "169.254.169.254/32", Description: "IPv4: allow outgoing tcp traffic to the metadata service"},
+				{EtherType: "IPv6", Protocol: "tcp", Port: 80, DestinationCIDR: "fe80::a9fe:a9fe/128", Description: "IPv6: allow outgoing tcp traffic to the metadata service"},
+				{EtherType: "IPv4", DestinationCIDR: "10.250.0.0/19", Description: "IPv4: allow outgoing traffic to 10.250.0.0/19"},
+				{EtherType: "IPv6", DestinationCIDR: "fd00::/48", Description: "IPv6: allow outgoing traffic to fd00::/48"},
+			}))
+		})
+
+		It("should skip duplicate rules and IPv6 pod networks", func() {
+			config.Egress.CIDRs = []string{"10.250.0.0/19"}
+
+			Expect(EgressRules(config, nil, pointer.String("fd00::/48"), false)).To(Equal([]EgressRule{
+				{EtherType: "IPv4", Protocol: "tcp", Port: 443, DestinationCIDR: "203.0.113.10/32", Description: "IPv4: allow outgoing tcp traffic to the API server at 203.0.113.10/32"},
+				{EtherType: "IPv4", Protocol: "tcp", Port: 8132, DestinationCIDR: "203.0.113.10/32", Description: "IPv4: allow outgoing tcp traffic to the VPN tunnel endpoint at 203.0.113.10/32"},
+				{EtherType: "IPv4", Protocol: "tcp", Port: 80, DestinationCIDR: "169.254.169.254/32", Description: "IPv4: allow outgoing tcp traffic to the metadata service"},
+				{EtherType: "IPv4", DestinationCIDR: "10.250.0.0/19", Description: "IPv4: allow outgoing traffic to 10.250.0.0/19"},
+			}))
+		})
+	})
+})
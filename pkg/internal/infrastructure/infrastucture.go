@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -16,7 +17,9 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/loadbalancers"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/rules"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/pointer"
 
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
 	openstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
@@ -24,6 +27,14 @@ import (
 
 const (
 	servicePrefix = "kube_service_"
+
+	// NodePortRangeMin is the first port of the NodePort range of the nodes.
+	NodePortRangeMin = 30000
+	// NodePortRangeMax is the last port of the NodePort range of the nodes.
+	NodePortRangeMax = 32767
+
+	anyIPv4CIDR = "0.0.0.0/0"
+	anyIPv6CIDR = "::/0"
 )
 
 // CleanupKubernetesLoadbalancers cleans loadbalancers that could prevent shoot deletion from proceeding. Particularly it tries to prevent orphan ports from blocking subnet deletion.
@@ -142,3 +153,46 @@ func WorkersCIDR(config *openstack.InfrastructureConfig) string {
 
 	return workersCIDR
 }
+
+// NodePortsSourceCIDRs determines the CIDRs from which ingress traffic to the NodePort range of the nodes is allowed
+// from the given InfrastructureConfig. No CIDRs are returned if node ports are disabled. If IPv6 rules are managed,
+// ingress traffic from any IPv4 address is paired with ingress traffic from any IPv6 address.
+func NodePortsSourceCIDRs(config *openstack.InfrastructureConfig, ipv6 bool) []string {
+	sourceCIDRs := []string{anyIPv4CIDR}
+	if config != nil && config.NodePorts != nil {
+		if config.NodePorts.Enabled != nil && !*config.NodePorts.Enabled {
+			return nil
+		}
+		if len(config.NodePorts.SourceCIDRs) > 0 {
+			sourceCIDRs = config.NodePorts.SourceCIDRs
+		}
+	}
+	if ipv6 && slices.Contains(sourceCIDRs, anyIPv4CIDR) && !slices.Contains(sourceCIDRs, anyIPv6CIDR) {
+		sourceCIDRs = append(slices.Clone(sourceCIDRs), anyIPv6CIDR)
+	}
+	return sourceCIDRs
+}
+
+// NodePortsRuleDescription returns the description of the security group rule allowing ingress traffic of the given
+// protocol from the given CIDR to the NodePort range of the nodes.
+func NodePortsRuleDescription(protocol, sourceCIDR string) string {
+	if sourceCIDR == anyIPv4CIDR || sourceCIDR == anyIPv6CIDR {
+		return fmt.Sprintf("%s: allow all incoming %s traffic with port range %d-%d", EtherType(sourceCIDR), protocol, NodePortRangeMin, NodePortRangeMax)
+	}
+	return fmt.Sprintf("%s: allow incoming %s traffic from %s with port range %d-%d", EtherType(sourceCIDR), protocol, sourceCIDR, NodePortRangeMin, NodePortRangeMax)
+}
+
+// EtherType returns the ether type of the security group rules for the given CIDR, i.e. `IPv6` for IPv6 CIDRs and
+// `IPv4` otherwise.
+func EtherType(cidr string) string {
+	if ip, _, err := net.ParseCIDR(cidr); err == nil && ip.To4() == nil {
+		return string(rules.EtherType6)
+	}
+	return string(rules.EtherType4)
+}
+
+// IPv6SecurityGroupRules returns whether matching IPv6 rules are managed for the IPv4 rules of the security group of
+// the nodes, which must be enabled in the given InfrastructureConfig.
+func IPv6SecurityGroupRules(config *openstack.InfrastructureConfig) bool {
+	return config != nil && config.SecurityGroupRules != nil && pointer.BoolDeref(config.SecurityGroupRules.IPv6, false)
+}
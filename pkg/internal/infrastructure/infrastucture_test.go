@@ -10,8 +10,10 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	"k8s.io/utils/pointer"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client/mocks"
 )
 
@@ -126,4 +128,29 @@ var _ = Describe("Infrastructure", func() {
 			Expect(err).To(BeNil())
 		})
 	})
+
+	Context("IPv6 security group rules", func() {
+		It("should not manage IPv6 rules by default", func() {
+			Expect(IPv6SecurityGroupRules(nil)).To(BeFalse())
+			Expect(IPv6SecurityGroupRules(&api.InfrastructureConfig{})).To(BeFalse())
+			Expect(IPv6SecurityGroupRules(&api.InfrastructureConfig{SecurityGroupRules: &api.SecurityGroupRules{}})).To(BeFalse())
+		})
+
+		It("should respect the configured value", func() {
+			Expect(IPv6SecurityGroupRules(&api.InfrastructureConfig{
+				SecurityGroupRules: &api.SecurityGroupRules{IPv6: pointer.Bool(true)},
+			})).To(BeTrue())
+			Expect(IPv6SecurityGroupRules(&api.InfrastructureConfig{
+				SecurityGroupRules: &api.SecurityGroupRules{IPv6: pointer.Bool(false)},
+			})).To(BeFalse())
+		})
+
+		It("should pair the ingress traffic to the NodePort range from any address", func() {
+			Expect(NodePortsSourceCIDRs(nil, true)).To(Equal([]string{"0.0.0.0/0", "::/0"}))
+			Expect(NodePortsSourceCIDRs(&api.InfrastructureConfig{
+				NodePorts: &api.NodePorts{SourceCIDRs: []string{"10.0.0.0/8", "2001:db8::/32"}},
+			}, true)).To(Equal([]string{"10.0.0.0/8", "2001:db8::/32"}))
+			Expect(NodePortsSourceCIDRs(nil, false)).To(Equal([]string{"0.0.0.0/0"}))
+		})
+	})
 })
@@ -9,7 +9,9 @@ terraform {
 provider "openstack" {
   auth_url    = "{{ .openstack.authURL }}"
   domain_name = var.DOMAIN_NAME
+  domain_id   = var.DOMAIN_ID
   tenant_name = var.TENANT_NAME
+  tenant_id   = var.TENANT_ID
   region      = "{{ .openstack.region }}"
   user_name   = var.USER_NAME
   password    = var.PASSWORD
@@ -17,6 +19,7 @@ provider "openstack" {
   application_credential_name   = var.APPLICATION_CREDENTIAL_NAME
   application_credential_secret = var.APPLICATION_CREDENTIAL_SECRET
   max_retries = "{{ .openstack.maxApiCallRetries }}"
+  endpoint_overrides = var.ENDPOINT_OVERRIDES
 {{ if .openstack.insecure }}
   insecure    = true
 {{ end }}
@@ -99,6 +102,17 @@ resource "openstack_networking_secgroup_rule_v2" "cluster_self" {
   security_group_id = openstack_networking_secgroup_v2.cluster.id
   remote_group_id   = openstack_networking_secgroup_v2.cluster.id
 }
+{{- if .ipv6 }}
+
+resource "openstack_networking_secgroup_rule_v2" "cluster_self_ipv6" {
+  direction         = "ingress"
+  description       = "IPv6: allow all incoming traffic within the same security group"
+  ethertype         = "IPv6"
+  security_group_id = openstack_networking_secgroup_v2.cluster.id
+  remote_group_id   = openstack_networking_secgroup_v2.cluster.id
+}
+{{- end }}
+{{- if .egress.allowAll }}
 
 resource "openstack_networking_secgroup_rule_v2" "cluster_egress" {
   direction         = "egress"
@@ -106,28 +120,59 @@ resource "openstack_networking_secgroup_rule_v2" "cluster_egress" {
   ethertype         = "IPv4"
   security_group_id = openstack_networking_secgroup_v2.cluster.id
 }
+{{- if .ipv6 }}
+
+resource "openstack_networking_secgroup_rule_v2" "cluster_egress_ipv6" {
+  direction         = "egress"
+  description       = "IPv6: allow all outgoing traffic"
+  ethertype         = "IPv6"
+  security_group_id = openstack_networking_secgroup_v2.cluster.id
+}
+{{- end }}
+{{- end }}
+
+{{- range $i, $rule := .egress.rules }}
+
+resource "openstack_networking_secgroup_rule_v2" "cluster_egress_{{ $i }}" {
+  direction         = "egress"
+  description       = "{{ $rule.description }}"
+  ethertype         = "{{ $rule.etherType }}"
+  {{- if $rule.protocol }}
+  protocol          = "{{ $rule.protocol }}"
+  {{- end }}
+  {{- if $rule.port }}
+  port_range_min    = {{ $rule.port }}
+  port_range_max    = {{ $rule.port }}
+  {{- end }}
+  remote_ip_prefix  = "{{ $rule.destinationCIDR }}"
+  security_group_id = openstack_networking_secgroup_v2.cluster.id
+}
+{{- end }}
 
-resource "openstack_networking_secgroup_rule_v2" "cluster_tcp_all" {
+{{- range $rule := .nodePorts }}
+
+resource "openstack_networking_secgroup_rule_v2" "cluster_tcp_all{{ $rule.suffix }}" {
   direction         = "ingress"
-  description       = "IPv4: allow all incoming tcp traffic with port range 30000-32767"
-  ethertype         = "IPv4"
+  description       = "{{ $rule.tcpDescription }}"
+  ethertype         = "{{ $rule.etherType }}"
   protocol          = "tcp"
-  remote_ip_prefix  = "0.0.0.0/0"
+  remote_ip_prefix  = "{{ $rule.sourceCIDR }}"
   port_range_min    = 30000
   port_range_max    = 32767
   security_group_id = openstack_networking_secgroup_v2.cluster.id
 }
 
-resource "openstack_networking_secgroup_rule_v2" "cluster_udp_all" {
+resource "openstack_networking_secgroup_rule_v2" "cluster_udp_all{{ $rule.suffix }}" {
   direction         = "ingress"
-  description       = "IPv4: allow all incoming udp traffic with port range 30000-32767"
-  ethertype         = "IPv4"
+  description       = "{{ $rule.udpDescription }}"
+  ethertype         = "{{ $rule.etherType }}"
   protocol          = "udp"
-  remote_ip_prefix  = "0.0.0.0/0"
+  remote_ip_prefix  = "{{ $rule.sourceCIDR }}"
   port_range_min    = 30000
   port_range_max    = 32767
   security_group_id = openstack_networking_secgroup_v2.cluster.id
 }
+{{- end }}
 
 {{ if .create.shareNetwork -}}
 //=====================================================================
@@ -154,9 +199,10 @@ resource "openstack_compute_keypair_v2" "ssh_key" {
 // existing clusters as Terraform won't detect a diff when we run `terraform plan`.
 // Workaround: Providing a null-resource for letting Terraform think that there are
 // differences, enabling the Gardener to start an actual `terraform apply` job.
+// The trigger changes with the schema version of the outputs.
 resource "null_resource" "outputs" {
   triggers = {
-    recompute = "outputs"
+    recompute = "outputs-v{{ .outputSchema }}"
   }
 }
 
@@ -200,6 +246,10 @@ output "{{ .outputKeys.subnetID }}" {
   value = openstack_networking_subnet_v2.cluster.id
 }
 
+output "{{ .outputKeys.schemaVersion }}" {
+  value = "{{ .outputSchema }}"
+}
+
 {{ if .create.shareNetwork -}}
 output "{{ .outputKeys.shareNetworkID }}" {
   value = "${openstack_sharedfilesystem_sharenetwork_v2.cluster.id}"
@@ -1,11 +1,25 @@
 variable "DOMAIN_NAME" {
   description = "OpenStack domain name"
   type        = string
+  default     = "" # not needed if DOMAIN_ID is given
+}
+
+variable "DOMAIN_ID" {
+  description = "OpenStack domain id"
+  type        = string
+  default     = "" # not needed if DOMAIN_NAME is given
 }
 
 variable "TENANT_NAME" {
   description = "OpenStack project name"
   type        = string
+  default     = "" # not needed if TENANT_ID is given
+}
+
+variable "TENANT_ID" {
+  description = "OpenStack project id"
+  type        = string
+  default     = "" # not needed if TENANT_NAME is given
 }
 
 variable "USER_NAME" {
@@ -43,3 +57,9 @@ variable "CA_CERT" {
   type        = string
   default     = "" # not needed if username/password are used
 }
+
+variable "ENDPOINT_OVERRIDES" {
+  description = "OpenStack endpoints used instead of the ones in the service catalog, mapped by service type"
+  type        = map(string)
+  default     = {}
+}
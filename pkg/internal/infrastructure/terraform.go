@@ -45,6 +45,14 @@ const (
 	TerraformOutputKeyShareNetworkID = "share_network_id"
 	// TerraformOutputKeyShareNetworkName is the share network name.
 	TerraformOutputKeyShareNetworkName = "share_network_name"
+	// TerraformOutputKeySchemaVersion is the version of the schema of the outputs.
+	TerraformOutputKeySchemaVersion = "output_schema_version"
+
+	// TerraformOutputSchemaVersion is the version of the schema of the outputs of the Terraform template, i.e. of their
+	// keys and meaning as read by ExtractTerraformState. It must be increased whenever the outputs are changed
+	// incompatibly, so that states with outputs of another version are detected instead of yielding empty statuses.
+	// Changing it also triggers an apply of existing states, which would not be applied for changed outputs only.
+	TerraformOutputSchemaVersion = "1"
 
 	// DefaultRouterID is the computed router ID as generated by terraform.
 	DefaultRouterID = "openstack_networking_router_v2.router.id"
@@ -81,6 +89,7 @@ func ComputeTerraformerTemplateValues(
 			"securityGroupName": TerraformOutputKeySecurityGroupName,
 			"floatingNetworkID": TerraformOutputKeyFloatingNetworkID,
 			"subnetID":          TerraformOutputKeySubnetID,
+			"schemaVersion":     TerraformOutputKeySchemaVersion,
 		}
 	)
 
@@ -126,6 +135,8 @@ func ComputeTerraformerTemplateValues(
 		outputKeysConfig["shareNetworkName"] = TerraformOutputKeyShareNetworkName
 	}
 
+	ipv6 := IPv6SecurityGroupRules(config)
+
 	return map[string]interface{}{
 		"openstack": map[string]interface{}{
 			"maxApiCallRetries": MaxApiCallRetries,
@@ -145,10 +156,58 @@ func ComputeTerraformerTemplateValues(
 		"router":       routerConfig,
 		"clusterName":  infra.Namespace,
 		"networks":     networksConfig,
+		"ipv6":         ipv6,
+		"nodePorts":    computeNodePortsRules(config, ipv6),
+		"egress":       computeEgressValues(config, cloudProfileConfig.DNSServers, cluster, ipv6),
 		"outputKeys":   outputKeysConfig,
+		"outputSchema": TerraformOutputSchemaVersion,
 	}, nil
 }
 
+// computeNodePortsRules returns the values of the security group rules for the NodePort range. The resources of the
+// rules for the first source CIDR keep their names to not recreate the rules of existing clusters.
+func computeNodePortsRules(config *api.InfrastructureConfig, ipv6 bool) []map[string]interface{} {
+	var nodePortsRules []map[string]interface{}
+	for i, sourceCIDR := range NodePortsSourceCIDRs(config, ipv6) {
+		suffix := ""
+		if i > 0 {
+			suffix = fmt.Sprintf("_%d", i)
+		}
+		nodePortsRules = append(nodePortsRules, map[string]interface{}{
+			"suffix":         suffix,
+			"etherType":      EtherType(sourceCIDR),
+			"sourceCIDR":     sourceCIDR,
+			"tcpDescription": NodePortsRuleDescription("tcp", sourceCIDR),
+			"udpDescription": NodePortsRuleDescription("udp", sourceCIDR),
+		})
+	}
+	return nodePortsRules
+}
+
+// computeEgressValues returns the values of the security group rules for the outgoing traffic of the nodes.
+func computeEgressValues(config *api.InfrastructureConfig, dnsServers []string, cluster *controller.Cluster, ipv6 bool) map[string]interface{} {
+	var podsCIDR *string
+	if cluster != nil && cluster.Shoot != nil && cluster.Shoot.Spec.Networking != nil {
+		podsCIDR = cluster.Shoot.Spec.Networking.Pods
+	}
+
+	var egressRules []map[string]interface{}
+	for _, rule := range EgressRules(config, dnsServers, podsCIDR, ipv6) {
+		egressRules = append(egressRules, map[string]interface{}{
+			"etherType":       rule.EtherType,
+			"protocol":        rule.Protocol,
+			"port":            rule.Port,
+			"destinationCIDR": rule.DestinationCIDR,
+			"description":     rule.Description,
+		})
+	}
+
+	return map[string]interface{}{
+		"allowAll": !IsEgressRestricted(config),
+		"rules":    egressRules,
+	}
+}
+
 func findFloatingSubnet(isRouterRequired bool, config *api.InfrastructureConfig, cloudProfileConfig *api.CloudProfileConfig, region string) *string {
 	if !isRouterRequired {
 		return nil
@@ -223,12 +282,11 @@ type TerraformState struct {
 	ShareNetworkName string
 }
 
-// ExtractTerraformState extracts the TerraformState from the given Terraformer.
-func ExtractTerraformState(ctx context.Context, tf terraformer.Terraformer, config *api.InfrastructureConfig) (*TerraformState, error) {
+// requiredOutputKeys returns the keys of the outputs of the Terraform template which must not be empty.
+func requiredOutputKeys(config *api.InfrastructureConfig) []string {
 	outputKeys := []string{
 		TerraformOutputKeySSHKeyName,
 		TerraformOutputKeyRouterID,
-		TerraformOutputKeyRouterIP,
 		TerraformOutputKeyNetworkID,
 		TerraformOutputKeyNetworkName,
 		TerraformOutputKeySubnetID,
@@ -240,12 +298,31 @@ func ExtractTerraformState(ctx context.Context, tf terraformer.Terraformer, conf
 	if config.Networks.ShareNetwork != nil && config.Networks.ShareNetwork.Enabled {
 		outputKeys = append(outputKeys, TerraformOutputKeyShareNetworkID, TerraformOutputKeyShareNetworkName)
 	}
+	return outputKeys
+}
+
+// OutputKeys returns the keys of the outputs of the Terraform template which are read by ExtractTerraformState.
+func OutputKeys(config *api.InfrastructureConfig) []string {
+	return append(requiredOutputKeys(config), TerraformOutputKeyRouterIP, TerraformOutputKeySchemaVersion)
+}
 
-	vars, err := tf.GetStateOutputVariables(ctx, outputKeys...)
+// ExtractTerraformState extracts the TerraformState from the given Terraformer. It fails if the outputs of the state
+// have another schema version than TerraformOutputSchemaVersion or if a required output is empty.
+func ExtractTerraformState(ctx context.Context, tf terraformer.Terraformer, config *api.InfrastructureConfig) (*TerraformState, error) {
+	vars, err := tf.GetStateOutputVariables(ctx, OutputKeys(config)...)
 	if err != nil {
 		return nil, err
 	}
 
+	if version := vars[TerraformOutputKeySchemaVersion]; version != TerraformOutputSchemaVersion {
+		return nil, fmt.Errorf("outputs of the Terraform state have schema version %q, but version %q is expected", version, TerraformOutputSchemaVersion)
+	}
+	for _, key := range requiredOutputKeys(config) {
+		if vars[key] == "" {
+			return nil, fmt.Errorf("output %q of the Terraform state is empty", key)
+		}
+	}
+
 	return &TerraformState{
 		SSHKeyName:        vars[TerraformOutputKeySSHKeyName],
 		RouterID:          vars[TerraformOutputKeyRouterID],
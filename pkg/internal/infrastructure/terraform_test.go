@@ -5,14 +5,18 @@
 package infrastructure
 
 import (
+	"context"
 	"encoding/json"
+	"regexp"
 	"strconv"
 
 	"github.com/gardener/gardener/extensions/pkg/controller"
+	mockterraformer "github.com/gardener/gardener/extensions/pkg/terraformer/mock"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -102,6 +106,8 @@ var _ = Describe("Terraform", func() {
 			expectedRouterValues     map[string]interface{}
 			expectedNetworkValues    map[string]interface{}
 			expectedOutputKeysValues map[string]interface{}
+			expectedNodePortsValues  []map[string]interface{}
+			expectedEgressValues     map[string]interface{}
 		)
 
 		BeforeEach(func() {
@@ -134,6 +140,18 @@ var _ = Describe("Terraform", func() {
 				"securityGroupName": TerraformOutputKeySecurityGroupName,
 				"floatingNetworkID": TerraformOutputKeyFloatingNetworkID,
 				"subnetID":          TerraformOutputKeySubnetID,
+				"schemaVersion":     TerraformOutputKeySchemaVersion,
+			}
+			expectedNodePortsValues = []map[string]interface{}{{
+				"suffix":         "",
+				"etherType":      "IPv4",
+				"sourceCIDR":     "0.0.0.0/0",
+				"tcpDescription": "IPv4: allow all incoming tcp traffic with port range 30000-32767",
+				"udpDescription": "IPv4: allow all incoming udp traffic with port range 30000-32767",
+			}}
+			expectedEgressValues = map[string]interface{}{
+				"allowAll": true,
+				"rules":    []map[string]interface{}(nil),
 			}
 		})
 
@@ -149,7 +167,11 @@ var _ = Describe("Terraform", func() {
 				"router":       expectedRouterValues,
 				"clusterName":  infra.Namespace,
 				"networks":     expectedNetworkValues,
+				"ipv6":         false,
+				"nodePorts":    expectedNodePortsValues,
+				"egress":       expectedEgressValues,
 				"outputKeys":   expectedOutputKeysValues,
+				"outputSchema": TerraformOutputSchemaVersion,
 			}))
 		})
 
@@ -173,7 +195,11 @@ var _ = Describe("Terraform", func() {
 				"router":       expectedRouterValues,
 				"clusterName":  infra.Namespace,
 				"networks":     expectedNetworkValues,
+				"ipv6":         false,
+				"nodePorts":    expectedNodePortsValues,
+				"egress":       expectedEgressValues,
 				"outputKeys":   expectedOutputKeysValues,
+				"outputSchema": TerraformOutputSchemaVersion,
 			}))
 		})
 
@@ -194,7 +220,11 @@ var _ = Describe("Terraform", func() {
 				"router":       expectedRouterValues,
 				"clusterName":  infra.Namespace,
 				"networks":     expectedNetworkValues,
+				"ipv6":         false,
+				"nodePorts":    expectedNodePortsValues,
+				"egress":       expectedEgressValues,
 				"outputKeys":   expectedOutputKeysValues,
+				"outputSchema": TerraformOutputSchemaVersion,
 			}))
 		})
 
@@ -218,7 +248,11 @@ var _ = Describe("Terraform", func() {
 				"router":       expectedRouterValues,
 				"clusterName":  infra.Namespace,
 				"networks":     expectedNetworkValues,
+				"ipv6":         false,
+				"nodePorts":    expectedNodePortsValues,
+				"egress":       expectedEgressValues,
 				"outputKeys":   expectedOutputKeysValues,
+				"outputSchema": TerraformOutputSchemaVersion,
 			}))
 		})
 
@@ -239,10 +273,187 @@ var _ = Describe("Terraform", func() {
 				"router":       expectedRouterValues,
 				"clusterName":  infra.Namespace,
 				"networks":     expectedNetworkValues,
+				"ipv6":         false,
+				"nodePorts":    expectedNodePortsValues,
+				"egress":       expectedEgressValues,
 				"outputKeys":   expectedOutputKeysValues,
+				"outputSchema": TerraformOutputSchemaVersion,
+			}))
+		})
+
+		It("should correctly compute the terraformer chart values for restricted node ports", func() {
+			config.NodePorts = &api.NodePorts{SourceCIDRs: []string{"10.0.0.0/8", "192.168.0.0/16"}}
+			expectedNodePortsValues = []map[string]interface{}{{
+				"suffix":         "",
+				"etherType":      "IPv4",
+				"sourceCIDR":     "10.0.0.0/8",
+				"tcpDescription": "IPv4: allow incoming tcp traffic from 10.0.0.0/8 with port range 30000-32767",
+				"udpDescription": "IPv4: allow incoming udp traffic from 10.0.0.0/8 with port range 30000-32767",
+			}, {
+				"suffix":         "_1",
+				"etherType":      "IPv4",
+				"sourceCIDR":     "192.168.0.0/16",
+				"tcpDescription": "IPv4: allow incoming tcp traffic from 192.168.0.0/16 with port range 30000-32767",
+				"udpDescription": "IPv4: allow incoming udp traffic from 192.168.0.0/16 with port range 30000-32767",
+			}}
+
+			values, err := ComputeTerraformerTemplateValues(infra, config, cluster)
+			Expect(err).To(BeNil())
+			Expect(values).To(HaveKeyWithValue("nodePorts", expectedNodePortsValues))
+		})
+
+		It("should correctly compute the terraformer chart values for IPv6 rules", func() {
+			config.SecurityGroupRules = &api.SecurityGroupRules{IPv6: pointer.Bool(true)}
+			expectedNodePortsValues = append(expectedNodePortsValues, map[string]interface{}{
+				"suffix":         "_1",
+				"etherType":      "IPv6",
+				"sourceCIDR":     "::/0",
+				"tcpDescription": "IPv6: allow all incoming tcp traffic with port range 30000-32767",
+				"udpDescription": "IPv6: allow all incoming udp traffic with port range 30000-32767",
+			})
+
+			values, err := ComputeTerraformerTemplateValues(infra, config, cluster)
+			Expect(err).To(BeNil())
+			Expect(values).To(HaveKeyWithValue("ipv6", true))
+			Expect(values).To(HaveKeyWithValue("nodePorts", expectedNodePortsValues))
+		})
+
+		It("should correctly compute the terraformer chart values for disabled node ports", func() {
+			config.NodePorts = &api.NodePorts{Enabled: pointer.Bool(false)}
+
+			values, err := ComputeTerraformerTemplateValues(infra, config, cluster)
+			Expect(err).To(BeNil())
+			Expect(values).To(HaveKeyWithValue("nodePorts", BeEmpty()))
+		})
+
+		It("should correctly compute the terraformer chart values for restricted egress traffic", func() {
+			config.Egress = &api.Egress{Restricted: true, SeedCIDRs: []string{"203.0.113.10/32"}}
+
+			values, err := ComputeTerraformerTemplateValues(infra, config, cluster)
+			Expect(err).To(BeNil())
+			Expect(values).To(HaveKeyWithValue("egress", map[string]interface{}{
+				"allowAll": false,
+				"rules": []map[string]interface{}{{
+					"etherType":       "IPv4",
+					"protocol":        "tcp",
+					"port":            443,
+					"destinationCIDR": "203.0.113.10/32",
+					"description":     "IPv4: allow outgoing tcp traffic to the API server at 203.0.113.10/32",
+				}, {
+					"etherType":       "IPv4",
+					"protocol":        "tcp",
+					"port":            8132,
+					"destinationCIDR": "203.0.113.10/32",
+					"description":     "IPv4: allow outgoing tcp traffic to the VPN tunnel endpoint at 203.0.113.10/32",
+				}, {
+					"etherType":       "IPv4",
+					"protocol":        "tcp",
+					"port":            80,
+					"destinationCIDR": "169.254.169.254/32",
+					"description":     "IPv4: allow outgoing tcp traffic to the metadata service",
+				}, {
+					"etherType":       "IPv4",
+					"protocol":        "",
+					"port":            0,
+					"destinationCIDR": "10.1.0.0/16",
+					"description":     "IPv4: allow outgoing traffic to 10.1.0.0/16",
+				}, {
+					"etherType":       "IPv4",
+					"protocol":        "",
+					"port":            0,
+					"destinationCIDR": "11.0.0.0/16",
+					"description":     "IPv4: allow outgoing traffic to 11.0.0.0/16",
+				}},
+			}))
+		})
+	})
+
+	Describe("#ExtractTerraformState", func() {
+		var (
+			ctx  = context.TODO()
+			ctrl *gomock.Controller
+			tf   *mockterraformer.MockTerraformer
+			vars map[string]string
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			tf = mockterraformer.NewMockTerraformer(ctrl)
+			vars = map[string]string{
+				TerraformOutputKeySSHKeyName:        "key",
+				TerraformOutputKeyRouterID:          "router",
+				TerraformOutputKeyRouterIP:          "1.1.1.1",
+				TerraformOutputKeyNetworkID:         "network",
+				TerraformOutputKeyNetworkName:       "network-name",
+				TerraformOutputKeySubnetID:          "subnet",
+				TerraformOutputKeyFloatingNetworkID: "fip",
+				TerraformOutputKeySecurityGroupID:   "sg",
+				TerraformOutputKeySecurityGroupName: "sg-name",
+				TerraformOutputKeySchemaVersion:     TerraformOutputSchemaVersion,
+			}
+		})
+
+		It("should extract the state from the outputs", func() {
+			tf.EXPECT().GetStateOutputVariables(ctx, OutputKeys(config)).Return(vars, nil)
+
+			state, err := ExtractTerraformState(ctx, tf, config)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(state).To(Equal(&TerraformState{
+				SSHKeyName:        "key",
+				RouterID:          "router",
+				RouterIP:          "1.1.1.1",
+				NetworkID:         "network",
+				NetworkName:       "network-name",
+				SubnetID:          "subnet",
+				FloatingNetworkID: "fip",
+				SecurityGroupID:   "sg",
+				SecurityGroupName: "sg-name",
 			}))
 		})
 
+		It("should fail for outputs of another schema version", func() {
+			vars[TerraformOutputKeySchemaVersion] = "0"
+			tf.EXPECT().GetStateOutputVariables(ctx, OutputKeys(config)).Return(vars, nil)
+
+			_, err := ExtractTerraformState(ctx, tf, config)
+			Expect(err).To(MatchError(ContainSubstring(`schema version "0"`)))
+		})
+
+		It("should fail for empty required outputs", func() {
+			vars[TerraformOutputKeySubnetID] = ""
+			tf.EXPECT().GetStateOutputVariables(ctx, OutputKeys(config)).Return(vars, nil)
+
+			_, err := ExtractTerraformState(ctx, tf, config)
+			Expect(err).To(MatchError(ContainSubstring(`output "subnet_id" of the Terraform state is empty`)))
+		})
+	})
+
+	Describe("#RenderTerraformerTemplate", func() {
+		outputPattern := regexp.MustCompile(`(?m)^output "([^"]+)"`)
+
+		renderOutputs := func() ([]string, string) {
+			files, err := RenderTerraformerTemplate(infra, config, cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			var outputs []string
+			for _, match := range outputPattern.FindAllStringSubmatch(files.Main, -1) {
+				outputs = append(outputs, match[1])
+			}
+			return outputs, files.Main
+		}
+
+		It("should declare exactly the outputs read from the state", func() {
+			outputs, main := renderOutputs()
+			Expect(outputs).To(ConsistOf(OutputKeys(config)))
+			Expect(main).To(ContainSubstring(`value = "` + TerraformOutputSchemaVersion + `"`))
+		})
+
+		It("should declare exactly the outputs read from the state with share network", func() {
+			config.Networks.ShareNetwork = &api.ShareNetwork{Enabled: true}
+
+			outputs, _ := renderOutputs()
+			Expect(outputs).To(ConsistOf(OutputKeys(config)))
+		})
 	})
 
 	Describe("#StatusFromTerraformState", func() {
@@ -5,6 +5,7 @@
 package internal
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/gardener/gardener/extensions/pkg/terraformer"
@@ -21,8 +22,12 @@ import (
 const (
 	// TerraformVarNameDomainName maps to terraform internal var representation.
 	TerraformVarNameDomainName = "TF_VAR_DOMAIN_NAME"
+	// TerraformVarNameDomainID maps to terraform internal var representation.
+	TerraformVarNameDomainID = "TF_VAR_DOMAIN_ID"
 	// TerraformVarNameProjectName maps to terraform internal var representation.
 	TerraformVarNameProjectName = "TF_VAR_TENANT_NAME"
+	// TerraformVarNameProjectID maps to terraform internal var representation.
+	TerraformVarNameProjectID = "TF_VAR_TENANT_ID"
 	// TerraformVarNameUserName maps to terraform internal var representation.
 	TerraformVarNameUserName = "TF_VAR_USER_NAME"
 	// TerraformVarNamePassword maps to terraform internal var representation.
@@ -35,19 +40,43 @@ const (
 	TerraformVarNameApplicationCredentialSecret = "TF_VAR_APPLICATION_CREDENTIAL_SECRET"
 	// TerraformVarCACert  maps to terraform internal var representation.
 	TerraformVarCACert = "TF_VAR_CA_CERT"
+	// TerraformVarEndpointOverrides maps to terraform internal var representation.
+	TerraformVarEndpointOverrides = "TF_VAR_ENDPOINT_OVERRIDES"
+	// TerraformCLIArgsApply contains additional arguments of `terraform apply`.
+	TerraformCLIArgsApply = "TF_CLI_ARGS_apply"
+	// TerraformCLIArgsDestroy contains additional arguments of `terraform destroy`.
+	TerraformCLIArgsDestroy = "TF_CLI_ARGS_destroy"
 )
 
+// TerraformerParallelismEnvVars computes the Terraformer environment variables which limit the number of resources
+// Terraform creates, updates or deletes concurrently to the given parallelism. If it is nil, the default of Terraform
+// is used.
+func TerraformerParallelismEnvVars(parallelism *int32) []corev1.EnvVar {
+	if parallelism == nil {
+		return nil
+	}
+	arg := fmt.Sprintf("-parallelism=%d", *parallelism)
+	return []corev1.EnvVar{
+		{Name: TerraformCLIArgsApply, Value: arg},
+		{Name: TerraformCLIArgsDestroy, Value: arg},
+	}
+}
+
 // TerraformerEnvVars computes the Terraformer environment variables from the given secret reference.
 func TerraformerEnvVars(secretRef corev1.SecretReference, credentials *openstack.Credentials) []corev1.EnvVar {
 	var envVars []corev1.EnvVar
 	if credentials.CACert != "" {
 		envVars = append(envVars, createEnvVar(secretRef, TerraformVarCACert, openstack.CACert))
 	}
+	// the JSON object of the endpoint overrides is a valid HCL expression for the map variable
+	if len(credentials.EndpointOverrides) > 0 {
+		envVars = append(envVars, createEnvVar(secretRef, TerraformVarEndpointOverrides, openstack.EndpointOverrides))
+	}
+
+	envVars = append(envVars, scopeEnvVars(secretRef, credentials)...)
 
 	if credentials.ApplicationCredentialSecret != "" {
 		envVars = append(envVars,
-			createEnvVar(secretRef, TerraformVarNameDomainName, openstack.DomainName),
-			createEnvVar(secretRef, TerraformVarNameProjectName, openstack.TenantName),
 			createEnvVar(secretRef, TerraformVarNameApplicationCredentialSecret, openstack.ApplicationCredentialSecret))
 		if credentials.ApplicationCredentialID != "" {
 			envVars = append(envVars, createEnvVar(secretRef, TerraformVarNameApplicationCredentialId, openstack.ApplicationCredentialID))
@@ -62,13 +91,27 @@ func TerraformerEnvVars(secretRef corev1.SecretReference, credentials *openstack
 	}
 
 	return append(envVars,
-		createEnvVar(secretRef, TerraformVarNameDomainName, openstack.DomainName),
-		createEnvVar(secretRef, TerraformVarNameProjectName, openstack.TenantName),
 		createEnvVar(secretRef, TerraformVarNameUserName, openstack.UserName),
 		createEnvVar(secretRef, TerraformVarNamePassword, openstack.Password),
 	)
 }
 
+// scopeEnvVars returns the environment variables for the domain and project. Ids take precedence over names.
+func scopeEnvVars(secretRef corev1.SecretReference, credentials *openstack.Credentials) []corev1.EnvVar {
+	var envVars []corev1.EnvVar
+	if credentials.DomainID != "" {
+		envVars = append(envVars, createEnvVar(secretRef, TerraformVarNameDomainID, openstack.DomainID))
+	} else {
+		envVars = append(envVars, createEnvVar(secretRef, TerraformVarNameDomainName, openstack.DomainName))
+	}
+	if credentials.TenantID != "" {
+		envVars = append(envVars, createEnvVar(secretRef, TerraformVarNameProjectID, openstack.TenantID))
+	} else {
+		envVars = append(envVars, createEnvVar(secretRef, TerraformVarNameProjectName, openstack.TenantName))
+	}
+	return envVars
+}
+
 // NewTerraformer initializes a new Terraformer.
 func NewTerraformer(
 	logger logr.Logger,
@@ -8,6 +8,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/pointer"
 
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
 )
@@ -55,6 +56,50 @@ var _ = Describe("Terraform", func() {
 					}},
 				}))
 		})
+		It("should correctly create the environment variables for domain and project ids", func() {
+			secretRef := corev1.SecretReference{Name: "cloud"}
+			credentials := &openstack.Credentials{
+				DomainID: "domain-id",
+				TenantID: "tenant-id",
+			}
+			Expect(TerraformerEnvVars(secretRef, credentials)).To(ConsistOf(
+				corev1.EnvVar{
+					Name: "TF_VAR_DOMAIN_ID",
+					ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: secretRef.Name,
+						},
+						Key: "domainID",
+					}},
+				},
+				corev1.EnvVar{
+					Name: "TF_VAR_TENANT_ID",
+					ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: secretRef.Name,
+						},
+						Key: "tenantID",
+					}},
+				},
+				corev1.EnvVar{
+					Name: "TF_VAR_USER_NAME",
+					ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: secretRef.Name,
+						},
+						Key: "username",
+					}},
+				},
+				corev1.EnvVar{
+					Name: "TF_VAR_PASSWORD",
+					ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: secretRef.Name,
+						},
+						Key: "password",
+					}},
+				}))
+		})
 		It("should correctly create the environment variables for application credentials (id + secret)", func() {
 			secretRef := corev1.SecretReference{Name: "cloud"}
 			credentials := &openstack.Credentials{
@@ -153,5 +198,37 @@ var _ = Describe("Terraform", func() {
 					}},
 				}))
 		})
+
+		It("should create the environment variable for the endpoint overrides", func() {
+			secretRef := corev1.SecretReference{Name: "cloud"}
+			credentials := &openstack.Credentials{
+				Username:          "user",
+				Password:          "password",
+				EndpointOverrides: map[string]string{"volumev3": "https://cinder.internal"},
+			}
+			Expect(TerraformerEnvVars(secretRef, credentials)).To(ContainElement(
+				corev1.EnvVar{
+					Name: "TF_VAR_ENDPOINT_OVERRIDES",
+					ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: secretRef.Name,
+						},
+						Key: "endpointOverrides",
+					}},
+				}))
+		})
+	})
+
+	Describe("#TerraformerParallelismEnvVars", func() {
+		It("should not create environment variables without a parallelism", func() {
+			Expect(TerraformerParallelismEnvVars(nil)).To(BeEmpty())
+		})
+
+		It("should pass the parallelism to apply and destroy", func() {
+			Expect(TerraformerParallelismEnvVars(pointer.Int32(20))).To(ConsistOf(
+				corev1.EnvVar{Name: "TF_CLI_ARGS_apply", Value: "-parallelism=20"},
+				corev1.EnvVar{Name: "TF_CLI_ARGS_destroy", Value: "-parallelism=20"},
+			))
+		})
 	})
 })
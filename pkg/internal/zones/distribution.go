@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package zones
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// Distribution distributes the values of a worker pool, e.g. its minimum and maximum, over its zones. Values which
+// cannot be distributed evenly are rounded down and the remainder is assigned to the zones one by one in the order of
+// their rank.
+type Distribution struct {
+	// ranks are the positions of the zones in the order in which the remainder is assigned.
+	ranks []int32
+}
+
+// New returns a Distribution over the given number of zones which assigns the remainder in the order of the zones,
+// like worker.DistributeOverZones.
+func New(zones int32) *Distribution {
+	ranks := make([]int32, zones)
+	for i := range ranks {
+		ranks[i] = int32(i)
+	}
+	return &Distribution{ranks: ranks}
+}
+
+// NewStable returns a Distribution over zones with the given current number of machines which assigns the remainder to
+// the zones with the most machines first. This keeps the distribution of the machines of a worker pool if its size is
+// changed or zones are added, instead of moving machines to the first zones. Zones with the same number of machines are
+// ordered by their index, so that the distribution is deterministic and equals the one of New for evenly distributed
+// machines.
+func NewStable(current []int32) *Distribution {
+	order := make([]int, len(current))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return current[order[i]] > current[order[j]]
+	})
+
+	ranks := make([]int32, len(current))
+	for rank, zoneIndex := range order {
+		ranks[zoneIndex] = int32(rank)
+	}
+	return &Distribution{ranks: ranks}
+}
+
+// Zones returns the number of zones of the distribution.
+func (d *Distribution) Zones() int32 {
+	return int32(len(d.ranks))
+}
+
+// Distribute returns the share of the given total of the zone with the given index.
+func (d *Distribution) Distribute(zoneIndex, total int32) int32 {
+	zones := d.Zones()
+	share := total / zones
+	if d.ranks[zoneIndex] < total%zones {
+		share++
+	}
+	return share
+}
+
+// DistributePercent returns the percentage of the zone with the given index for the given percentage of the given
+// total. If the total cannot be distributed evenly, the percentage is weighted with the share of the zone of the total
+// and rounded up.
+func (d *Distribution) DistributePercent(zoneIndex int32, percent string, total int32) (string, error) {
+	percents, err := strconv.Atoi(strings.TrimSuffix(percent, "%"))
+	if err != nil || !strings.HasSuffix(percent, "%") {
+		return "", fmt.Errorf("given value %q is not a percent value", percent)
+	}
+
+	zones := d.Zones()
+	if total%zones == 0 {
+		return percent, nil
+	}
+
+	ratio := 100.0 / (float64(total) / float64(zones)) * float64(d.Distribute(zoneIndex, total))
+	return fmt.Sprintf("%d%%", int(math.Ceil(ratio*float64(percents)/100.0))), nil
+}
+
+// DistributePositiveIntOrPercent returns the share of the zone with the given index of the given int or percentage of
+// the given total, e.g. of the maxSurge of a worker pool with respect to its maximum.
+func (d *Distribution) DistributePositiveIntOrPercent(zoneIndex int32, intOrPercent intstr.IntOrString, total int32) (intstr.IntOrString, error) {
+	if intOrPercent.Type == intstr.String {
+		percent, err := d.DistributePercent(zoneIndex, intOrPercent.StrVal, total)
+		if err != nil {
+			return intstr.IntOrString{}, err
+		}
+		return intstr.FromString(percent), nil
+	}
+	return intstr.FromInt32(d.Distribute(zoneIndex, intOrPercent.IntVal)), nil
+}
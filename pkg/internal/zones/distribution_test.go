@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package zones_test
+
+import (
+	"github.com/gardener/gardener/extensions/pkg/controller/worker"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	. "github.com/gardener/gardener-extension-provider-openstack/pkg/internal/zones"
+)
+
+func distribute(d *Distribution, total int32) []int32 {
+	shares := make([]int32, d.Zones())
+	for i := range shares {
+		shares[i] = d.Distribute(int32(i), total)
+	}
+	return shares
+}
+
+var _ = Describe("Distribution", func() {
+	Describe("#New", func() {
+		It("should distribute like the generic worker helpers", func() {
+			for zones := int32(1); zones <= 4; zones++ {
+				d := New(zones)
+				for total := int32(0); total <= 10; total++ {
+					for zoneIndex := int32(0); zoneIndex < zones; zoneIndex++ {
+						Expect(d.Distribute(zoneIndex, total)).To(Equal(worker.DistributeOverZones(zoneIndex, total, zones)))
+
+						for _, v := range []intstr.IntOrString{intstr.FromInt32(total), intstr.FromString("25%"), intstr.FromString("100%")} {
+							Expect(d.DistributePositiveIntOrPercent(zoneIndex, v, total)).To(Equal(worker.DistributePositiveIntOrPercent(zoneIndex, v, zones, total)))
+						}
+					}
+				}
+			}
+		})
+	})
+
+	Describe("#NewStable", func() {
+		It("should distribute like New for evenly distributed machines", func() {
+			Expect(distribute(NewStable([]int32{2, 2, 2}), 7)).To(Equal([]int32{3, 2, 2}))
+			Expect(distribute(NewStable([]int32{0, 0, 0}), 8)).To(Equal([]int32{3, 3, 2}))
+		})
+
+		It("should assign the remainder to the zones with the most machines", func() {
+			Expect(distribute(NewStable([]int32{1, 2, 2}), 4)).To(Equal([]int32{1, 2, 1}))
+			Expect(distribute(NewStable([]int32{1, 2, 2}), 5)).To(Equal([]int32{1, 2, 2}))
+			Expect(distribute(NewStable([]int32{1, 1, 2}), 5)).To(Equal([]int32{2, 1, 2}))
+		})
+
+		It("should keep the machines in the existing zones if a zone is added", func() {
+			Expect(distribute(NewStable([]int32{2, 2, 0}), 5)).To(Equal([]int32{2, 2, 1}))
+		})
+
+		It("should weight percentages with the share of the zone", func() {
+			d := NewStable([]int32{1, 2})
+			Expect(d.DistributePercent(0, "50%", 3)).To(Equal("34%"))
+			Expect(d.DistributePercent(1, "50%", 3)).To(Equal("67%"))
+		})
+	})
+
+	Describe("#DistributePercent", func() {
+		It("should return an error for invalid percentages", func() {
+			for _, percent := range []string{"", "%", "10", "ten%"} {
+				_, err := New(2).DistributePercent(0, percent, 3)
+				Expect(err).To(MatchError(ContainSubstring("is not a percent value")))
+			}
+		})
+	})
+})
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package nodelabeler
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	openstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
+)
+
+const (
+	// ControllerName is the name of the node labeler controller.
+	ControllerName = "node-labeler"
+	// DefaultSyncPeriod is the default period in which the labels of the nodes are synchronized with their placement.
+	DefaultSyncPeriod = 10 * time.Minute
+)
+
+// AddOptions are options to apply when adding the node labeler controller to the manager.
+type AddOptions struct {
+	// Controller are the controller.Options.
+	Controller controller.Options
+	// Compute is the client of the compute service of the region of the shoot.
+	Compute openstackclient.Compute
+	// SyncPeriod is the period in which the labels of the nodes are synchronized with their placement.
+	SyncPeriod time.Duration
+}
+
+// AddToManagerWithOptions adds a controller with the given Options to the given manager.
+func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) error {
+	syncPeriod := opts.SyncPeriod
+	if syncPeriod <= 0 {
+		syncPeriod = DefaultSyncPeriod
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&corev1.Node{}, builder.WithPredicates(ProviderIDOrLabelsChanged())).
+		WithOptions(opts.Controller).
+		Complete(NewReconciler(mgr.GetClient(), opts.Compute, syncPeriod))
+}
+
+// ProviderIDOrLabelsChanged is a predicate filtering the updates of nodes to those changing their provider ID or their
+// labels, so that the frequent status updates of the nodes do not cause calls to OpenStack.
+func ProviderIDOrLabelsChanged() predicate.Predicate {
+	return predicate.Or(
+		predicate.LabelChangedPredicate{},
+		predicate.Funcs{
+			UpdateFunc: func(e event.UpdateEvent) bool {
+				oldNode, ok := e.ObjectOld.(*corev1.Node)
+				if !ok {
+					return false
+				}
+				newNode, ok := e.ObjectNew.(*corev1.Node)
+				if !ok {
+					return false
+				}
+				return oldNode.Spec.ProviderID != newNode.Spec.ProviderID
+			},
+		},
+	)
+}
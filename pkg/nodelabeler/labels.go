@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package nodelabeler
+
+import (
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	// LabelServerGroup is the label of the nodes holding the name of the server group of their server.
+	LabelServerGroup = "node.openstack.provider.extensions.gardener.cloud/server-group"
+	// LabelHypervisor is the label of the nodes holding the hostname of the hypervisor their server runs on. It is only
+	// maintained if the policy of Nova allows the user of the shoot to see the hypervisor of its servers.
+	LabelHypervisor = "node.openstack.provider.extensions.gardener.cloud/hypervisor"
+	// LabelPrefixHostAggregate is the prefix of the labels of the nodes marking the host aggregates of the hypervisor
+	// their server runs on. The labels are named after the aggregates and have the value "true". They are only
+	// maintained if the policy of Nova allows the user of the shoot to list the host aggregates.
+	LabelPrefixHostAggregate = "aggregate.node.openstack.provider.extensions.gardener.cloud/"
+)
+
+// Placement describes where the server of a node is placed in OpenStack.
+type Placement struct {
+	// ServerGroup is the name of the server group of the server, if any.
+	ServerGroup string
+	// Hypervisor is the hostname of the hypervisor the server runs on, if visible.
+	Hypervisor string
+	// HostAggregates are the names of the host aggregates of the hypervisor the server runs on, if visible.
+	HostAggregates []string
+}
+
+// PlacementLabels returns the labels describing the given placement. Values which are no valid label values and
+// aggregates whose names do not form valid label keys are skipped.
+func PlacementLabels(placement *Placement) map[string]string {
+	labels := map[string]string{}
+	if placement == nil {
+		return labels
+	}
+
+	if isValidLabelValue(placement.ServerGroup) {
+		labels[LabelServerGroup] = placement.ServerGroup
+	}
+	if isValidLabelValue(placement.Hypervisor) {
+		labels[LabelHypervisor] = placement.Hypervisor
+	}
+	for _, aggregate := range placement.HostAggregates {
+		key := LabelPrefixHostAggregate + aggregate
+		if len(validation.IsQualifiedName(key)) == 0 {
+			labels[key] = "true"
+		}
+	}
+	return labels
+}
+
+// ApplyLabels sets the given labels on the node and removes all other labels managed by the node labeler. It returns
+// true if the labels of the node have been changed.
+func ApplyLabels(node *corev1.Node, labels map[string]string) bool {
+	changed := false
+	for key := range node.Labels {
+		if _, ok := labels[key]; !ok && isManagedLabel(key) {
+			delete(node.Labels, key)
+			changed = true
+		}
+	}
+	for key, value := range labels {
+		if current, ok := node.Labels[key]; ok && current == value {
+			continue
+		}
+		if node.Labels == nil {
+			node.Labels = map[string]string{}
+		}
+		node.Labels[key] = value
+		changed = true
+	}
+	return changed
+}
+
+func isManagedLabel(key string) bool {
+	return key == LabelServerGroup || key == LabelHypervisor || strings.HasPrefix(key, LabelPrefixHostAggregate)
+}
+
+func isValidLabelValue(value string) bool {
+	return value != "" && len(validation.IsValidLabelValue(value)) == 0
+}
@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package nodelabeler_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/gardener/gardener-extension-provider-openstack/pkg/nodelabeler"
+)
+
+var _ = Describe("Labels", func() {
+	Describe("#PlacementLabels", func() {
+		It("should return no labels for an empty placement", func() {
+			Expect(PlacementLabels(nil)).To(BeEmpty())
+			Expect(PlacementLabels(&Placement{})).To(BeEmpty())
+		})
+
+		It("should return the labels of the placement", func() {
+			Expect(PlacementLabels(&Placement{
+				ServerGroup:    "shoot--foo--bar-pool-1",
+				Hypervisor:     "compute-1.example.com",
+				HostAggregates: []string{"ssd", "gpu"},
+			})).To(Equal(map[string]string{
+				LabelServerGroup:                 "shoot--foo--bar-pool-1",
+				LabelHypervisor:                  "compute-1.example.com",
+				LabelPrefixHostAggregate + "ssd": "true",
+				LabelPrefixHostAggregate + "gpu": "true",
+			}))
+		})
+
+		It("should skip invalid label values and keys", func() {
+			Expect(PlacementLabels(&Placement{
+				ServerGroup:    "server group",
+				Hypervisor:     strings.Repeat("a", 64),
+				HostAggregates: []string{"fast disks", "ssd"},
+			})).To(Equal(map[string]string{
+				LabelPrefixHostAggregate + "ssd": "true",
+			}))
+		})
+	})
+
+	Describe("#ApplyLabels", func() {
+		var node *corev1.Node
+
+		BeforeEach(func() {
+			node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{
+				"foo":                            "bar",
+				LabelServerGroup:                 "old",
+				LabelPrefixHostAggregate + "ssd": "true",
+			}}}
+		})
+
+		It("should set the labels and remove stale managed labels", func() {
+			Expect(ApplyLabels(node, map[string]string{
+				LabelServerGroup: "new",
+				LabelHypervisor:  "compute-1",
+			})).To(BeTrue())
+			Expect(node.Labels).To(Equal(map[string]string{
+				"foo":            "bar",
+				LabelServerGroup: "new",
+				LabelHypervisor:  "compute-1",
+			}))
+		})
+
+		It("should not change the node if the labels are up to date", func() {
+			Expect(ApplyLabels(node, map[string]string{
+				LabelServerGroup:                 "old",
+				LabelPrefixHostAggregate + "ssd": "true",
+			})).To(BeFalse())
+		})
+
+		It("should set the labels on a node without labels", func() {
+			node.Labels = nil
+			Expect(ApplyLabels(node, map[string]string{LabelHypervisor: "compute-1"})).To(BeTrue())
+			Expect(node.Labels).To(Equal(map[string]string{LabelHypervisor: "compute-1"}))
+		})
+	})
+
+	Describe("#ServerIDFromProviderID", func() {
+		It("should return the server ID", func() {
+			Expect(ServerIDFromProviderID("openstack:///1234-5678")).To(Equal("1234-5678"))
+		})
+
+		It("should return an empty string for other provider IDs", func() {
+			Expect(ServerIDFromProviderID("")).To(BeEmpty())
+			Expect(ServerIDFromProviderID("aws:///eu-west-1a/i-1234")).To(BeEmpty())
+		})
+	})
+})
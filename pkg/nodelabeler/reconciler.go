@@ -0,0 +1,128 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package nodelabeler
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	openstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
+)
+
+// providerIDPrefix is the prefix of the provider IDs of the nodes, followed by the ID of their server.
+const providerIDPrefix = "openstack:///"
+
+type reconciler struct {
+	client     client.Client
+	compute    openstackclient.Compute
+	syncPeriod time.Duration
+}
+
+// NewReconciler creates a new reconciler which periodically labels the nodes of the shoot with the placement of their
+// servers in OpenStack, i.e. their server group, the hypervisor they run on and its host aggregates.
+func NewReconciler(c client.Client, compute openstackclient.Compute, syncPeriod time.Duration) reconcile.Reconciler {
+	return &reconciler{
+		client:     c,
+		compute:    compute,
+		syncPeriod: syncPeriod,
+	}
+}
+
+func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	node := &corev1.Node{}
+	if err := r.client.Get(ctx, request.NamespacedName, node); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	serverID := ServerIDFromProviderID(node.Spec.ProviderID)
+	if serverID == "" {
+		// the node is reconciled again as soon as the cloud-controller-manager has set its provider ID
+		log.V(1).Info("Node has no OpenStack provider ID yet, skipping")
+		return reconcile.Result{}, nil
+	}
+
+	placement, err := r.getPlacement(log, serverID)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not determine placement of server %s: %w", serverID, err)
+	}
+
+	patch := client.MergeFrom(node.DeepCopy())
+	if ApplyLabels(node, PlacementLabels(placement)) {
+		log.Info("Updating placement labels of node", "serverGroup", placement.ServerGroup, "hypervisor", placement.Hypervisor,
+			"hostAggregates", placement.HostAggregates)
+		if err := r.client.Patch(ctx, node, patch); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	return reconcile.Result{RequeueAfter: r.syncPeriod}, nil
+}
+
+// getPlacement determines the placement of the server with the given ID. An empty placement is returned if the server
+// does not exist (anymore). Attributes the user of the shoot is not allowed to see are left empty.
+func (r *reconciler) getPlacement(log logr.Logger, serverID string) (*Placement, error) {
+	placement := &Placement{}
+
+	server, err := r.compute.GetServerWithExtendedAttributes(serverID)
+	if err != nil {
+		return nil, err
+	}
+	if server == nil {
+		log.Info("Server of node not found, removing placement labels", "serverID", serverID)
+		return placement, nil
+	}
+	placement.Hypervisor = server.HypervisorHostname
+
+	serverGroups, err := r.compute.ListServerGroups()
+	if err != nil {
+		return nil, err
+	}
+	for _, serverGroup := range serverGroups {
+		if slices.Contains(serverGroup.Members, serverID) {
+			placement.ServerGroup = serverGroup.Name
+			break
+		}
+	}
+
+	// the host of the server is only visible if the user is allowed to see the extended attributes of the server
+	if server.Host == "" {
+		return placement, nil
+	}
+	aggregates, err := r.compute.ListAggregates()
+	if err != nil {
+		if openstackclient.IsForbiddenError(err) {
+			log.V(1).Info("Not allowed to list host aggregates, skipping")
+			return placement, nil
+		}
+		return nil, err
+	}
+	for _, aggregate := range aggregates {
+		if slices.Contains(aggregate.Hosts, server.Host) {
+			placement.HostAggregates = append(placement.HostAggregates, aggregate.Name)
+		}
+	}
+
+	return placement, nil
+}
+
+// ServerIDFromProviderID returns the ID of the server of a node with the given provider ID, or an empty string if the
+// provider ID is no OpenStack provider ID.
+func ServerIDFromProviderID(providerID string) string {
+	if !strings.HasPrefix(providerID, providerIDPrefix) {
+		return ""
+	}
+	return strings.TrimPrefix(providerID, providerIDPrefix)
+}
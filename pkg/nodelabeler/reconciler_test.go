@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package nodelabeler_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/aggregates"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/extendedserverattributes"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/servergroups"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	. "github.com/gardener/gardener-extension-provider-openstack/pkg/nodelabeler"
+	openstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client/mocks"
+)
+
+var _ = Describe("Reconciler", func() {
+	const (
+		serverID   = "1234-5678"
+		syncPeriod = 5 * time.Minute
+	)
+
+	var (
+		ctx = context.TODO()
+
+		ctrl    *gomock.Controller
+		compute *mocks.MockCompute
+
+		c          client.Client
+		node       *corev1.Node
+		reconciler reconcile.Reconciler
+		request    reconcile.Request
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		compute = mocks.NewMockCompute(ctrl)
+
+		node = &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "node-1",
+				Labels: map[string]string{
+					"foo":           "bar",
+					LabelHypervisor: "compute-0",
+				},
+			},
+			Spec: corev1.NodeSpec{ProviderID: "openstack:///" + serverID},
+		}
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).WithObjects(node).Build()
+		reconciler = NewReconciler(c, compute, syncPeriod)
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(node)}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	expectLabels := func(labels map[string]string) {
+		ExpectWithOffset(1, c.Get(ctx, request.NamespacedName, node)).To(Succeed())
+		ExpectWithOffset(1, node.Labels).To(Equal(labels))
+	}
+
+	It("should label the node with the placement of its server", func() {
+		compute.EXPECT().GetServerWithExtendedAttributes(serverID).Return(&openstackclient.ServerWithExtendedAttributes{
+			Server: servers.Server{ID: serverID},
+			ServerAttributesExt: extendedserverattributes.ServerAttributesExt{
+				Host:               "compute-1",
+				HypervisorHostname: "compute-1.example.com",
+			},
+		}, nil)
+		compute.EXPECT().ListServerGroups().Return([]servergroups.ServerGroup{
+			{Name: "other", Members: []string{"other-server"}},
+			{Name: "pool-1", Members: []string{"other-server", serverID}},
+		}, nil)
+		compute.EXPECT().ListAggregates().Return([]aggregates.Aggregate{
+			{Name: "ssd", Hosts: []string{"compute-1", "compute-2"}},
+			{Name: "gpu", Hosts: []string{"compute-2"}},
+		}, nil)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		expectLabels(map[string]string{
+			"foo":                            "bar",
+			LabelServerGroup:                 "pool-1",
+			LabelHypervisor:                  "compute-1.example.com",
+			LabelPrefixHostAggregate + "ssd": "true",
+		})
+	})
+
+	It("should skip the attributes the user is not allowed to see", func() {
+		compute.EXPECT().GetServerWithExtendedAttributes(serverID).Return(&openstackclient.ServerWithExtendedAttributes{
+			Server: servers.Server{ID: serverID},
+		}, nil)
+		compute.EXPECT().ListServerGroups().Return([]servergroups.ServerGroup{
+			{Name: "pool-1", Members: []string{serverID}},
+		}, nil)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		expectLabels(map[string]string{
+			"foo":            "bar",
+			LabelServerGroup: "pool-1",
+		})
+	})
+
+	It("should skip the host aggregates if listing them is forbidden", func() {
+		compute.EXPECT().GetServerWithExtendedAttributes(serverID).Return(&openstackclient.ServerWithExtendedAttributes{
+			Server:              servers.Server{ID: serverID},
+			ServerAttributesExt: extendedserverattributes.ServerAttributesExt{Host: "compute-1", HypervisorHostname: "compute-1"},
+		}, nil)
+		compute.EXPECT().ListServerGroups().Return(nil, nil)
+		compute.EXPECT().ListAggregates().Return(nil, gophercloud.ErrDefault403{})
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		expectLabels(map[string]string{
+			"foo":           "bar",
+			LabelHypervisor: "compute-1",
+		})
+	})
+
+	It("should remove the placement labels if the server does not exist", func() {
+		compute.EXPECT().GetServerWithExtendedAttributes(serverID).Return(nil, nil)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		expectLabels(map[string]string{"foo": "bar"})
+	})
+
+	It("should skip nodes without provider ID", func() {
+		node.Spec.ProviderID = ""
+		Expect(c.Update(ctx, node)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		expectLabels(map[string]string{
+			"foo":           "bar",
+			LabelHypervisor: "compute-0",
+		})
+	})
+
+	It("should ignore deleted nodes", func() {
+		Expect(c.Delete(ctx, node)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should return errors of OpenStack", func() {
+		compute.EXPECT().GetServerWithExtendedAttributes(serverID).Return(nil, gophercloud.ErrDefault500{})
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).To(MatchError(ContainSubstring("could not determine placement of server " + serverID)))
+	})
+})
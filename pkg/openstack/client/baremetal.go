@@ -0,0 +1,18 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"github.com/gophercloud/gophercloud/openstack/baremetal/v1/nodes"
+)
+
+// ListNodes lists all Ironic nodes matching the given list options.
+func (c *BareMetalClient) ListNodes(listOpts nodes.ListOpts) ([]nodes.Node, error) {
+	allPages, err := nodes.List(c.client, listOpts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return nodes.ExtractNodes(allPages)
+}
@@ -0,0 +1,18 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/quotasets"
+)
+
+// GetQuotaUsage returns the quotas of the project with the given id including their usage.
+func (c *BlockStorageClient) GetQuotaUsage(projectID string) (*quotasets.QuotaUsageSet, error) {
+	quotaUsage, err := quotasets.GetUsage(c.client, projectID).Extract()
+	if err != nil {
+		return nil, err
+	}
+	return &quotaUsage, nil
+}
@@ -6,77 +6,30 @@ package client
 
 import (
 	"context"
-	"crypto/tls"
-	"crypto/x509"
 	"fmt"
-	"net/http"
 	"strings"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack"
-	"github.com/gophercloud/utils/openstack/clientconfig"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	os "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/clientbuilder"
 )
 
 // NewOpenstackClientFromCredentials returns a Factory implementation that can be used to create clients for OpenStack services.
 // TODO: respect CloudProfile's requestTimeout for the OpenStack client.
 // see https://github.com/kubernetes/cloud-provider-openstack/blob/c44d941cdb5c7fe651f5cb9191d0af23e266c7cb/pkg/openstack/openstack.go#L257
 func NewOpenstackClientFromCredentials(credentials *os.Credentials) (Factory, error) {
-	opts := &clientconfig.ClientOpts{
-		AuthInfo: &clientconfig.AuthInfo{
-			AuthURL:                     credentials.AuthURL,
-			Username:                    credentials.Username,
-			Password:                    credentials.Password,
-			ProjectName:                 credentials.TenantName,
-			DomainName:                  credentials.DomainName,
-			ApplicationCredentialID:     credentials.ApplicationCredentialID,
-			ApplicationCredentialName:   credentials.ApplicationCredentialName,
-			ApplicationCredentialSecret: credentials.ApplicationCredentialSecret,
-		},
-	}
-
-	config := &tls.Config{
-		InsecureSkipVerify: credentials.Insecure,
-	}
-	if len(credentials.CACert) > 0 {
-		pool := x509.NewCertPool()
-		ok := pool.AppendCertsFromPEM([]byte(credentials.CACert))
-		if !ok {
-			return nil, fmt.Errorf("failed to load CA Bundle for KeyStone")
-		}
-		config.RootCAs = pool
-	}
-
-	if opts.AuthInfo.ApplicationCredentialSecret != "" {
-		opts.AuthType = clientconfig.AuthV3ApplicationCredential
-	}
-
-	transport := &http.Transport{Proxy: http.ProxyFromEnvironment, TLSClientConfig: config}
-	opts.HTTPClient = &http.Client{
-		Transport: transport,
-	}
-
-	authOpts, err := clientconfig.AuthOptions(opts)
-	if err != nil {
-		return nil, err
-	}
-
-	// AllowReauth should be set to true if you grant permission for Gophercloud to
-	// cache your credentials in memory, and to allow Gophercloud to attempt to
-	// re-authenticate automatically if/when your token expires.
-	authOpts.AllowReauth = true
-
-	provider, err := openstack.NewClient(authOpts.IdentityEndpoint)
-	if err != nil {
-		return nil, err
-	}
-
-	provider.HTTPClient = *opts.HTTPClient
+	return NewOpenstackClientFromCredentialsWithOptions(credentials)
+}
 
-	err = openstack.Authenticate(provider, *authOpts)
+// NewOpenstackClientFromCredentialsWithOptions returns a Factory implementation like NewOpenstackClientFromCredentials,
+// whose provider client is built with the given options, e.g. an HTTP client with a timeout.
+func NewOpenstackClientFromCredentialsWithOptions(credentials *os.Credentials, opts ...clientbuilder.Option) (Factory, error) {
+	provider, err := clientbuilder.NewProviderClient(credentials, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -209,6 +162,176 @@ func (oc *OpenstackClientFactory) SharedFilesystem(options ...Option) (SharedFil
 	}, nil
 }
 
+// Placement creates a new Placement client.
+func (oc *OpenstackClientFactory) Placement(options ...Option) (Placement, error) {
+	eo := gophercloud.EndpointOpts{}
+	for _, opt := range options {
+		eo = opt(eo)
+	}
+
+	client, err := openstack.NewPlacementV1(oc.providerClient, eo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PlacementClient{
+		client: client,
+	}, nil
+}
+
+// BareMetal creates a new BareMetal client.
+func (oc *OpenstackClientFactory) BareMetal(options ...Option) (BareMetal, error) {
+	eo := gophercloud.EndpointOpts{}
+	for _, opt := range options {
+		eo = opt(eo)
+	}
+
+	client, err := openstack.NewBareMetalV1(oc.providerClient, eo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BareMetalClient{
+		client: client,
+	}, nil
+}
+
+// BlockStorage creates a new BlockStorage client. The client uses Cinder v3 API for issuing calls.
+func (oc *OpenstackClientFactory) BlockStorage(options ...Option) (BlockStorage, error) {
+	eo := gophercloud.EndpointOpts{}
+	for _, opt := range options {
+		eo = opt(eo)
+	}
+
+	client, err := openstack.NewBlockStorageV3(oc.providerClient, eo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BlockStorageClient{
+		client: client,
+	}, nil
+}
+
+// Identity creates a new Identity client. The client uses Keystone v3 API for issuing calls.
+func (oc *OpenstackClientFactory) Identity(options ...Option) (Identity, error) {
+	eo := gophercloud.EndpointOpts{}
+	for _, opt := range options {
+		eo = opt(eo)
+	}
+
+	client, err := openstack.NewIdentityV3(oc.providerClient, eo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IdentityClient{
+		client: client,
+	}, nil
+}
+
+// Orchestration creates a new Heat client.
+func (oc *OpenstackClientFactory) Orchestration(options ...Option) (Orchestration, error) {
+	eo := gophercloud.EndpointOpts{}
+	for _, opt := range options {
+		eo = opt(eo)
+	}
+
+	client, err := openstack.NewOrchestrationV1(oc.providerClient, eo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OrchestrationClient{
+		client: client,
+	}, nil
+}
+
+// ProjectID returns the id of the project the token of the client is scoped to.
+func (oc *OpenstackClientFactory) ProjectID() (string, error) {
+	result, ok := oc.providerClient.GetAuthResult().(interface {
+		ExtractProject() (*tokens.Project, error)
+	})
+	if !ok {
+		return "", fmt.Errorf("project extraction is only supported for identity v3 tokens")
+	}
+	project, err := result.ExtractProject()
+	if err != nil {
+		return "", err
+	}
+	if project == nil {
+		return "", fmt.Errorf("token is not scoped to a project")
+	}
+	return project.ID, nil
+}
+
+// DomainID returns the id of the domain the token of the client is scoped to.
+func (oc *OpenstackClientFactory) DomainID() (string, error) {
+	result, ok := oc.providerClient.GetAuthResult().(interface {
+		ExtractDomain() (*tokens.Domain, error)
+	})
+	if !ok {
+		return "", fmt.Errorf("domain extraction is only supported for identity v3 tokens")
+	}
+	domain, err := result.ExtractDomain()
+	if err != nil {
+		return "", err
+	}
+	if domain == nil {
+		return "", fmt.Errorf("token is not scoped to a domain")
+	}
+	return domain.ID, nil
+}
+
+// UserID returns the id of the user the token of the client is issued for.
+func (oc *OpenstackClientFactory) UserID() (string, error) {
+	result, ok := oc.providerClient.GetAuthResult().(interface {
+		ExtractUser() (*tokens.User, error)
+	})
+	if !ok {
+		return "", fmt.Errorf("user extraction is only supported for identity v3 tokens")
+	}
+	user, err := result.ExtractUser()
+	if err != nil {
+		return "", err
+	}
+	return user.ID, nil
+}
+
+// ServiceEndpoints returns the URLs of the identity endpoint and of the public endpoints of all services in the service
+// catalog of the token. If a region is given, only the endpoints of this region are returned.
+func (oc *OpenstackClientFactory) ServiceEndpoints(options ...Option) ([]string, error) {
+	eo := gophercloud.EndpointOpts{}
+	for _, opt := range options {
+		eo = opt(eo)
+	}
+
+	result, ok := oc.providerClient.GetAuthResult().(interface {
+		ExtractServiceCatalog() (*tokens.ServiceCatalog, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("service catalog is only supported for identity v3 tokens")
+	}
+	catalog, err := result.ExtractServiceCatalog()
+	if err != nil {
+		return nil, err
+	}
+
+	urls := []string{oc.providerClient.IdentityEndpoint}
+	for _, entry := range catalog.Entries {
+		for _, endpoint := range entry.Endpoints {
+			if endpoint.Interface != string(gophercloud.AvailabilityPublic) {
+				continue
+			}
+			if eo.Region != "" && endpoint.Region != eo.Region && endpoint.RegionID != eo.Region {
+				continue
+			}
+			urls = append(urls, endpoint.URL)
+		}
+	}
+	return urls, nil
+}
+
 // IsNotFoundError checks if an error returned by OpenStack is caused by HTTP 404 status code.
 func IsNotFoundError(err error) bool {
 	if err == nil {
@@ -226,6 +349,32 @@ func IsNotFoundError(err error) bool {
 	return false
 }
 
+// IsForbiddenError checks if an error returned by OpenStack is caused by HTTP 403 status code.
+func IsForbiddenError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if _, ok := err.(gophercloud.ErrDefault403); ok {
+		return true
+	}
+
+	if _, ok := err.(gophercloud.Err403er); ok {
+		return true
+	}
+
+	return false
+}
+
+// IsEndpointNotFoundError checks if an error is caused by a service missing in the service catalog.
+func IsEndpointNotFoundError(err error) bool {
+	switch err.(type) {
+	case *gophercloud.ErrEndpointNotFound, gophercloud.ErrEndpointNotFound:
+		return true
+	}
+	return false
+}
+
 // IgnoreNotFoundError ignore not found error
 func IgnoreNotFoundError(err error) error {
 	if IsNotFoundError(err) {
@@ -5,9 +5,14 @@
 package client
 
 import (
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/aggregates"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/availabilityzones"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/extendedserverattributes"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/floatingips"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/keypairs"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/limits"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/servergroups"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/images"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
 	flavorutils "github.com/gophercloud/utils/openstack/compute/v2/flavors"
@@ -78,6 +83,44 @@ func (c *ComputeClient) DeleteServer(id string) error {
 	return servers.Delete(c.client, id).ExtractErr()
 }
 
+// GetServer retrieves the server with the specified id. It returns nil if the server could not be found.
+func (c *ComputeClient) GetServer(id string) (*servers.Server, error) {
+	server, err := servers.Get(c.client, id).Extract()
+	if err != nil {
+		if IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return server, nil
+}
+
+// ServerWithExtendedAttributes is a server together with its extended attributes. The attributes are empty if the
+// policy of Nova does not allow the user to see them.
+type ServerWithExtendedAttributes struct {
+	servers.Server
+	extendedserverattributes.ServerAttributesExt
+}
+
+// GetServerWithExtendedAttributes retrieves the server with the specified id together with its extended attributes.
+// It returns nil if the server could not be found.
+func (c *ComputeClient) GetServerWithExtendedAttributes(id string) (*ServerWithExtendedAttributes, error) {
+	result := servers.Get(c.client, id)
+	server, err := result.Extract()
+	if err != nil {
+		if IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	// the attributes are extracted separately, as the custom unmarshalling of the server would otherwise skip them
+	serverWithAttributes := &ServerWithExtendedAttributes{Server: *server}
+	if err := result.ExtractInto(&serverWithAttributes.ServerAttributesExt); err != nil {
+		return nil, err
+	}
+	return serverWithAttributes, nil
+}
+
 // FindServersByName retrieves the Compute Server by Name
 func (c *ComputeClient) FindServersByName(name string) ([]servers.Server, error) {
 	listOpts := servers.ListOpts{
@@ -125,6 +168,44 @@ func (c *ComputeClient) FindFlavorID(name string) (string, error) {
 	return flavorutils.IDFromName(c.client, name)
 }
 
+// ListFlavors lists all flavors
+func (c *ComputeClient) ListFlavors(listOpts flavors.ListOpts) ([]flavors.Flavor, error) {
+	allPages, err := flavors.ListDetail(c.client, listOpts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return flavors.ExtractFlavors(allPages)
+}
+
+// GetFlavorExtraSpecs returns the extra specs of the flavor with the given id.
+func (c *ComputeClient) GetFlavorExtraSpecs(flavorID string) (map[string]string, error) {
+	return flavors.ListExtraSpecs(c.client, flavorID).Extract()
+}
+
+// ListAvailabilityZones lists all availability zones including the hosts belonging to them.
+func (c *ComputeClient) ListAvailabilityZones() ([]availabilityzones.AvailabilityZone, error) {
+	allPages, err := availabilityzones.ListDetail(c.client).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return availabilityzones.ExtractAvailabilityZones(allPages)
+}
+
+// GetLimits returns the absolute limits and their usage of the project.
+func (c *ComputeClient) GetLimits() (*limits.Limits, error) {
+	return limits.Get(c.client, limits.GetOpts{}).Extract()
+}
+
+// ListAggregates lists all host aggregates. Listing them is only allowed for administrators by the default policy of
+// Nova.
+func (c *ComputeClient) ListAggregates() ([]aggregates.Aggregate, error) {
+	pages, err := aggregates.List(c.client).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return aggregates.ExtractAggregates(pages)
+}
+
 // FindImages find image ID by images name
 func (c *ComputeClient) FindImages(name string) ([]images.Image, error) {
 	listOpts := images.ListOpts{
@@ -133,6 +214,12 @@ func (c *ComputeClient) FindImages(name string) ([]images.Image, error) {
 	return c.ListImages(listOpts)
 }
 
+// GetImage gets the image with the given id, or returns nil if it does not exist
+func (c *ComputeClient) GetImage(id string) (*images.Image, error) {
+	image, err := images.Get(c.client, id).Extract()
+	return image, IgnoreNotFoundError(err)
+}
+
 // ListImages list all images
 func (c *ComputeClient) ListImages(listOpts images.ListOpts) ([]images.Image, error) {
 	allPages, err := images.ListDetail(c.client, listOpts).AllPages()
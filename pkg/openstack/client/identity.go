@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/applicationcredentials"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/projects"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/roles"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/users"
+)
+
+// ListProjects returns the projects matching the given options.
+func (c *IdentityClient) ListProjects(listOpts projects.ListOpts) ([]projects.Project, error) {
+	allPages, err := projects.List(c.client, listOpts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return projects.ExtractProjects(allPages)
+}
+
+// CreateProject creates a project.
+func (c *IdentityClient) CreateProject(createOpts projects.CreateOpts) (*projects.Project, error) {
+	return projects.Create(c.client, createOpts).Extract()
+}
+
+// DeleteProject deletes the project with the given id. It returns nil if the project could not be found.
+func (c *IdentityClient) DeleteProject(id string) error {
+	return IgnoreNotFoundError(projects.Delete(c.client, id).ExtractErr())
+}
+
+// ListUsers returns the users matching the given options.
+func (c *IdentityClient) ListUsers(listOpts users.ListOpts) ([]users.User, error) {
+	allPages, err := users.List(c.client, listOpts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return users.ExtractUsers(allPages)
+}
+
+// CreateUser creates a user.
+func (c *IdentityClient) CreateUser(createOpts users.CreateOpts) (*users.User, error) {
+	return users.Create(c.client, createOpts).Extract()
+}
+
+// UpdateUser updates the user with the given id.
+func (c *IdentityClient) UpdateUser(id string, updateOpts users.UpdateOpts) (*users.User, error) {
+	return users.Update(c.client, id, updateOpts).Extract()
+}
+
+// DeleteUser deletes the user with the given id. It returns nil if the user could not be found.
+func (c *IdentityClient) DeleteUser(id string) error {
+	return IgnoreNotFoundError(users.Delete(c.client, id).ExtractErr())
+}
+
+// ListRoles returns the roles matching the given options.
+func (c *IdentityClient) ListRoles(listOpts roles.ListOpts) ([]roles.Role, error) {
+	allPages, err := roles.List(c.client, listOpts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return roles.ExtractRoles(allPages)
+}
+
+// AssignRole assigns the role with the given id. Assigning a role twice is not an error.
+func (c *IdentityClient) AssignRole(roleID string, assignOpts roles.AssignOpts) error {
+	return roles.Assign(c.client, roleID, assignOpts).ExtractErr()
+}
+
+// CreateApplicationCredential creates an application credential of the user with the given id.
+func (c *IdentityClient) CreateApplicationCredential(userID string, createOpts applicationcredentials.CreateOpts) (*applicationcredentials.ApplicationCredential, error) {
+	return applicationcredentials.Create(c.client, userID, createOpts).Extract()
+}
+
+// GetApplicationCredential returns the application credential with the given id of the user with the given id. It
+// returns nil if the application credential could not be found.
+func (c *IdentityClient) GetApplicationCredential(userID, id string) (*applicationcredentials.ApplicationCredential, error) {
+	applicationCredential, err := applicationcredentials.Get(c.client, userID, id).Extract()
+	if err != nil {
+		return nil, IgnoreNotFoundError(err)
+	}
+	return applicationCredential, nil
+}
+
+// DeleteApplicationCredential deletes the application credential with the given id of the user with the given id. It
+// returns nil if the application credential could not be found.
+func (c *IdentityClient) DeleteApplicationCredential(userID, id string) error {
+	return IgnoreNotFoundError(applicationcredentials.Delete(c.client, userID, id).ExtractErr())
+}
@@ -1,28 +1,45 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client (interfaces: Factory,FactoryFactory,Compute,DNS,Networking,Loadbalancing,SharedFilesystem)
+// Source: github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client (interfaces: Factory,FactoryFactory,Compute,DNS,Networking,Loadbalancing,SharedFilesystem,Placement,BareMetal,BlockStorage,Identity,Storage,Orchestration)
 
 // Package mocks is a generated GoMock package.
 package mocks
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
 
 	openstack "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
 	client "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
+	nodes "github.com/gophercloud/gophercloud/openstack/baremetal/v1/nodes"
+	quotasets "github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/quotasets"
+	aggregates "github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/aggregates"
+	availabilityzones "github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/availabilityzones"
 	floatingips "github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/floatingips"
 	keypairs "github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/keypairs"
+	limits "github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/limits"
 	servergroups "github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/servergroups"
+	flavors "github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
 	images "github.com/gophercloud/gophercloud/openstack/compute/v2/images"
 	servers "github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	applicationcredentials "github.com/gophercloud/gophercloud/openstack/identity/v3/applicationcredentials"
+	projects "github.com/gophercloud/gophercloud/openstack/identity/v3/projects"
+	roles "github.com/gophercloud/gophercloud/openstack/identity/v3/roles"
+	users "github.com/gophercloud/gophercloud/openstack/identity/v3/users"
 	loadbalancers "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/loadbalancers"
 	floatingips0 "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
 	routers "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/routers"
+	quotas "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/quotas"
+	rbacpolicies "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/rbacpolicies"
 	groups "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/groups"
 	rules "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/rules"
+	trunks "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/trunks"
 	networks "github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
 	ports "github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
 	subnets "github.com/gophercloud/gophercloud/openstack/networking/v2/subnets"
+	objects "github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
+	stacks "github.com/gophercloud/gophercloud/openstack/orchestration/v1/stacks"
+	resourceproviders "github.com/gophercloud/gophercloud/openstack/placement/v1/resourceproviders"
 	sharenetworks "github.com/gophercloud/gophercloud/openstack/sharedfilesystems/v2/sharenetworks"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -50,6 +67,44 @@ func (m *MockFactory) EXPECT() *MockFactoryMockRecorder {
 	return m.recorder
 }
 
+// BareMetal mocks base method.
+func (m *MockFactory) BareMetal(arg0 ...client.Option) (client.BareMetal, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range arg0 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BareMetal", varargs...)
+	ret0, _ := ret[0].(client.BareMetal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BareMetal indicates an expected call of BareMetal.
+func (mr *MockFactoryMockRecorder) BareMetal(arg0 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BareMetal", reflect.TypeOf((*MockFactory)(nil).BareMetal), arg0...)
+}
+
+// BlockStorage mocks base method.
+func (m *MockFactory) BlockStorage(arg0 ...client.Option) (client.BlockStorage, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range arg0 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BlockStorage", varargs...)
+	ret0, _ := ret[0].(client.BlockStorage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockStorage indicates an expected call of BlockStorage.
+func (mr *MockFactoryMockRecorder) BlockStorage(arg0 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockStorage", reflect.TypeOf((*MockFactory)(nil).BlockStorage), arg0...)
+}
+
 // Compute mocks base method.
 func (m *MockFactory) Compute(arg0 ...client.Option) (client.Compute, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +143,40 @@ func (mr *MockFactoryMockRecorder) DNS(arg0 ...interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DNS", reflect.TypeOf((*MockFactory)(nil).DNS), arg0...)
 }
 
+// DomainID mocks base method.
+func (m *MockFactory) DomainID() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DomainID")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DomainID indicates an expected call of DomainID.
+func (mr *MockFactoryMockRecorder) DomainID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DomainID", reflect.TypeOf((*MockFactory)(nil).DomainID))
+}
+
+// Identity mocks base method.
+func (m *MockFactory) Identity(arg0 ...client.Option) (client.Identity, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range arg0 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Identity", varargs...)
+	ret0, _ := ret[0].(client.Identity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Identity indicates an expected call of Identity.
+func (mr *MockFactoryMockRecorder) Identity(arg0 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Identity", reflect.TypeOf((*MockFactory)(nil).Identity), arg0...)
+}
+
 // Loadbalancing mocks base method.
 func (m *MockFactory) Loadbalancing(arg0 ...client.Option) (client.Loadbalancing, error) {
 	m.ctrl.T.Helper()
@@ -126,6 +215,78 @@ func (mr *MockFactoryMockRecorder) Networking(arg0 ...interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Networking", reflect.TypeOf((*MockFactory)(nil).Networking), arg0...)
 }
 
+// Orchestration mocks base method.
+func (m *MockFactory) Orchestration(arg0 ...client.Option) (client.Orchestration, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range arg0 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Orchestration", varargs...)
+	ret0, _ := ret[0].(client.Orchestration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Orchestration indicates an expected call of Orchestration.
+func (mr *MockFactoryMockRecorder) Orchestration(arg0 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Orchestration", reflect.TypeOf((*MockFactory)(nil).Orchestration), arg0...)
+}
+
+// Placement mocks base method.
+func (m *MockFactory) Placement(arg0 ...client.Option) (client.Placement, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range arg0 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Placement", varargs...)
+	ret0, _ := ret[0].(client.Placement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Placement indicates an expected call of Placement.
+func (mr *MockFactoryMockRecorder) Placement(arg0 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Placement", reflect.TypeOf((*MockFactory)(nil).Placement), arg0...)
+}
+
+// ProjectID mocks base method.
+func (m *MockFactory) ProjectID() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectID")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProjectID indicates an expected call of ProjectID.
+func (mr *MockFactoryMockRecorder) ProjectID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectID", reflect.TypeOf((*MockFactory)(nil).ProjectID))
+}
+
+// ServiceEndpoints mocks base method.
+func (m *MockFactory) ServiceEndpoints(arg0 ...client.Option) ([]string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range arg0 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ServiceEndpoints", varargs...)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceEndpoints indicates an expected call of ServiceEndpoints.
+func (mr *MockFactoryMockRecorder) ServiceEndpoints(arg0 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceEndpoints", reflect.TypeOf((*MockFactory)(nil).ServiceEndpoints), arg0...)
+}
+
 // SharedFilesystem mocks base method.
 func (m *MockFactory) SharedFilesystem(arg0 ...client.Option) (client.SharedFilesystem, error) {
 	m.ctrl.T.Helper()
@@ -164,6 +325,21 @@ func (mr *MockFactoryMockRecorder) Storage(arg0 ...interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Storage", reflect.TypeOf((*MockFactory)(nil).Storage), arg0...)
 }
 
+// UserID mocks base method.
+func (m *MockFactory) UserID() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserID")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserID indicates an expected call of UserID.
+func (mr *MockFactoryMockRecorder) UserID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserID", reflect.TypeOf((*MockFactory)(nil).UserID))
+}
+
 // MockFactoryFactory is a mock of FactoryFactory interface.
 type MockFactoryFactory struct {
 	ctrl     *gomock.Controller
@@ -386,6 +562,36 @@ func (mr *MockComputeMockRecorder) FindServersByName(arg0 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindServersByName", reflect.TypeOf((*MockCompute)(nil).FindServersByName), arg0)
 }
 
+// GetFlavorExtraSpecs mocks base method.
+func (m *MockCompute) GetFlavorExtraSpecs(arg0 string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFlavorExtraSpecs", arg0)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFlavorExtraSpecs indicates an expected call of GetFlavorExtraSpecs.
+func (mr *MockComputeMockRecorder) GetFlavorExtraSpecs(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFlavorExtraSpecs", reflect.TypeOf((*MockCompute)(nil).GetFlavorExtraSpecs), arg0)
+}
+
+// GetImage mocks base method.
+func (m *MockCompute) GetImage(arg0 string) (*images.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImage", arg0)
+	ret0, _ := ret[0].(*images.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImage indicates an expected call of GetImage.
+func (mr *MockComputeMockRecorder) GetImage(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImage", reflect.TypeOf((*MockCompute)(nil).GetImage), arg0)
+}
+
 // GetKeyPair mocks base method.
 func (m *MockCompute) GetKeyPair(arg0 string) (*keypairs.KeyPair, error) {
 	m.ctrl.T.Helper()
@@ -401,6 +607,36 @@ func (mr *MockComputeMockRecorder) GetKeyPair(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKeyPair", reflect.TypeOf((*MockCompute)(nil).GetKeyPair), arg0)
 }
 
+// GetLimits mocks base method.
+func (m *MockCompute) GetLimits() (*limits.Limits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLimits")
+	ret0, _ := ret[0].(*limits.Limits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLimits indicates an expected call of GetLimits.
+func (mr *MockComputeMockRecorder) GetLimits() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLimits", reflect.TypeOf((*MockCompute)(nil).GetLimits))
+}
+
+// GetServer mocks base method.
+func (m *MockCompute) GetServer(arg0 string) (*servers.Server, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServer", arg0)
+	ret0, _ := ret[0].(*servers.Server)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServer indicates an expected call of GetServer.
+func (mr *MockComputeMockRecorder) GetServer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServer", reflect.TypeOf((*MockCompute)(nil).GetServer), arg0)
+}
+
 // GetServerGroup mocks base method.
 func (m *MockCompute) GetServerGroup(arg0 string) (*servergroups.ServerGroup, error) {
 	m.ctrl.T.Helper()
@@ -416,6 +652,66 @@ func (mr *MockComputeMockRecorder) GetServerGroup(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServerGroup", reflect.TypeOf((*MockCompute)(nil).GetServerGroup), arg0)
 }
 
+// GetServerWithExtendedAttributes mocks base method.
+func (m *MockCompute) GetServerWithExtendedAttributes(arg0 string) (*client.ServerWithExtendedAttributes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServerWithExtendedAttributes", arg0)
+	ret0, _ := ret[0].(*client.ServerWithExtendedAttributes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServerWithExtendedAttributes indicates an expected call of GetServerWithExtendedAttributes.
+func (mr *MockComputeMockRecorder) GetServerWithExtendedAttributes(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServerWithExtendedAttributes", reflect.TypeOf((*MockCompute)(nil).GetServerWithExtendedAttributes), arg0)
+}
+
+// ListAggregates mocks base method.
+func (m *MockCompute) ListAggregates() ([]aggregates.Aggregate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAggregates")
+	ret0, _ := ret[0].([]aggregates.Aggregate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAggregates indicates an expected call of ListAggregates.
+func (mr *MockComputeMockRecorder) ListAggregates() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAggregates", reflect.TypeOf((*MockCompute)(nil).ListAggregates))
+}
+
+// ListAvailabilityZones mocks base method.
+func (m *MockCompute) ListAvailabilityZones() ([]availabilityzones.AvailabilityZone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAvailabilityZones")
+	ret0, _ := ret[0].([]availabilityzones.AvailabilityZone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAvailabilityZones indicates an expected call of ListAvailabilityZones.
+func (mr *MockComputeMockRecorder) ListAvailabilityZones() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAvailabilityZones", reflect.TypeOf((*MockCompute)(nil).ListAvailabilityZones))
+}
+
+// ListFlavors mocks base method.
+func (m *MockCompute) ListFlavors(arg0 flavors.ListOpts) ([]flavors.Flavor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFlavors", arg0)
+	ret0, _ := ret[0].([]flavors.Flavor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFlavors indicates an expected call of ListFlavors.
+func (mr *MockComputeMockRecorder) ListFlavors(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFlavors", reflect.TypeOf((*MockCompute)(nil).ListFlavors), arg0)
+}
+
 // ListImages mocks base method.
 func (m *MockCompute) ListImages(arg0 images.ListOpts) ([]images.Image, error) {
 	m.ctrl.T.Helper()
@@ -580,6 +876,21 @@ func (mr *MockNetworkingMockRecorder) CreateNetwork(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetwork", reflect.TypeOf((*MockNetworking)(nil).CreateNetwork), arg0)
 }
 
+// CreateRBACPolicy mocks base method.
+func (m *MockNetworking) CreateRBACPolicy(arg0 rbacpolicies.CreateOpts) (*rbacpolicies.RBACPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRBACPolicy", arg0)
+	ret0, _ := ret[0].(*rbacpolicies.RBACPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRBACPolicy indicates an expected call of CreateRBACPolicy.
+func (mr *MockNetworkingMockRecorder) CreateRBACPolicy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRBACPolicy", reflect.TypeOf((*MockNetworking)(nil).CreateRBACPolicy), arg0)
+}
+
 // CreateRouter mocks base method.
 func (m *MockNetworking) CreateRouter(arg0 routers.CreateOpts) (*routers.Router, error) {
 	m.ctrl.T.Helper()
@@ -640,6 +951,21 @@ func (mr *MockNetworkingMockRecorder) CreateSubnet(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubnet", reflect.TypeOf((*MockNetworking)(nil).CreateSubnet), arg0)
 }
 
+// CreateTrunk mocks base method.
+func (m *MockNetworking) CreateTrunk(arg0 trunks.CreateOpts) (*trunks.Trunk, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTrunk", arg0)
+	ret0, _ := ret[0].(*trunks.Trunk)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTrunk indicates an expected call of CreateTrunk.
+func (mr *MockNetworkingMockRecorder) CreateTrunk(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTrunk", reflect.TypeOf((*MockNetworking)(nil).CreateTrunk), arg0)
+}
+
 // DeleteFloatingIP mocks base method.
 func (m *MockNetworking) DeleteFloatingIP(arg0 string) error {
 	m.ctrl.T.Helper()
@@ -668,6 +994,34 @@ func (mr *MockNetworkingMockRecorder) DeleteNetwork(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetwork", reflect.TypeOf((*MockNetworking)(nil).DeleteNetwork), arg0)
 }
 
+// DeletePort mocks base method.
+func (m *MockNetworking) DeletePort(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePort", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePort indicates an expected call of DeletePort.
+func (mr *MockNetworkingMockRecorder) DeletePort(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePort", reflect.TypeOf((*MockNetworking)(nil).DeletePort), arg0)
+}
+
+// DeleteRBACPolicy mocks base method.
+func (m *MockNetworking) DeleteRBACPolicy(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRBACPolicy", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRBACPolicy indicates an expected call of DeleteRBACPolicy.
+func (mr *MockNetworkingMockRecorder) DeleteRBACPolicy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRBACPolicy", reflect.TypeOf((*MockNetworking)(nil).DeleteRBACPolicy), arg0)
+}
+
 // DeleteRouter mocks base method.
 func (m *MockNetworking) DeleteRouter(arg0 string) error {
 	m.ctrl.T.Helper()
@@ -724,6 +1078,20 @@ func (mr *MockNetworkingMockRecorder) DeleteSubnet(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubnet", reflect.TypeOf((*MockNetworking)(nil).DeleteSubnet), arg0)
 }
 
+// DeleteTrunk mocks base method.
+func (m *MockNetworking) DeleteTrunk(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTrunk", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTrunk indicates an expected call of DeleteTrunk.
+func (mr *MockNetworkingMockRecorder) DeleteTrunk(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrunk", reflect.TypeOf((*MockNetworking)(nil).DeleteTrunk), arg0)
+}
+
 // GetExternalNetworkByName mocks base method.
 func (m *MockNetworking) GetExternalNetworkByName(arg0 string) (*networks.Network, error) {
 	m.ctrl.T.Helper()
@@ -799,6 +1167,21 @@ func (mr *MockNetworkingMockRecorder) GetPort(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPort", reflect.TypeOf((*MockNetworking)(nil).GetPort), arg0)
 }
 
+// GetQuotaDetail mocks base method.
+func (m *MockNetworking) GetQuotaDetail(arg0 string) (*quotas.QuotaDetailSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuotaDetail", arg0)
+	ret0, _ := ret[0].(*quotas.QuotaDetailSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuotaDetail indicates an expected call of GetQuotaDetail.
+func (mr *MockNetworkingMockRecorder) GetQuotaDetail(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuotaDetail", reflect.TypeOf((*MockNetworking)(nil).GetQuotaDetail), arg0)
+}
+
 // GetRouterByID mocks base method.
 func (m *MockNetworking) GetRouterByID(arg0 string) (*routers.Router, error) {
 	m.ctrl.T.Helper()
@@ -889,6 +1272,36 @@ func (mr *MockNetworkingMockRecorder) ListNetwork(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetwork", reflect.TypeOf((*MockNetworking)(nil).ListNetwork), arg0)
 }
 
+// ListPorts mocks base method.
+func (m *MockNetworking) ListPorts(arg0 ports.ListOpts) ([]ports.Port, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPorts", arg0)
+	ret0, _ := ret[0].([]ports.Port)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPorts indicates an expected call of ListPorts.
+func (mr *MockNetworkingMockRecorder) ListPorts(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPorts", reflect.TypeOf((*MockNetworking)(nil).ListPorts), arg0)
+}
+
+// ListRBACPolicies mocks base method.
+func (m *MockNetworking) ListRBACPolicies(arg0 rbacpolicies.ListOpts) ([]rbacpolicies.RBACPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRBACPolicies", arg0)
+	ret0, _ := ret[0].([]rbacpolicies.RBACPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRBACPolicies indicates an expected call of ListRBACPolicies.
+func (mr *MockNetworkingMockRecorder) ListRBACPolicies(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRBACPolicies", reflect.TypeOf((*MockNetworking)(nil).ListRBACPolicies), arg0)
+}
+
 // ListRouters mocks base method.
 func (m *MockNetworking) ListRouters(arg0 routers.ListOpts) ([]routers.Router, error) {
 	m.ctrl.T.Helper()
@@ -949,6 +1362,21 @@ func (mr *MockNetworkingMockRecorder) ListSubnets(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubnets", reflect.TypeOf((*MockNetworking)(nil).ListSubnets), arg0)
 }
 
+// ListTrunks mocks base method.
+func (m *MockNetworking) ListTrunks(arg0 trunks.ListOpts) ([]trunks.Trunk, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrunks", arg0)
+	ret0, _ := ret[0].([]trunks.Trunk)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrunks indicates an expected call of ListTrunks.
+func (mr *MockNetworkingMockRecorder) ListTrunks(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrunks", reflect.TypeOf((*MockNetworking)(nil).ListTrunks), arg0)
+}
+
 // RemoveRouterInterface mocks base method.
 func (m *MockNetworking) RemoveRouterInterface(arg0 string, arg1 routers.RemoveInterfaceOpts) (*routers.InterfaceInfo, error) {
 	m.ctrl.T.Helper()
@@ -964,6 +1392,21 @@ func (mr *MockNetworkingMockRecorder) RemoveRouterInterface(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRouterInterface", reflect.TypeOf((*MockNetworking)(nil).RemoveRouterInterface), arg0, arg1)
 }
 
+// UpdateFloatingIP mocks base method.
+func (m *MockNetworking) UpdateFloatingIP(arg0 string, arg1 floatingips0.UpdateOpts) (*floatingips0.FloatingIP, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFloatingIP", arg0, arg1)
+	ret0, _ := ret[0].(*floatingips0.FloatingIP)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFloatingIP indicates an expected call of UpdateFloatingIP.
+func (mr *MockNetworkingMockRecorder) UpdateFloatingIP(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFloatingIP", reflect.TypeOf((*MockNetworking)(nil).UpdateFloatingIP), arg0, arg1)
+}
+
 // UpdateNetwork mocks base method.
 func (m *MockNetworking) UpdateNetwork(arg0 string, arg1 networks.UpdateOpts) (*networks.Network, error) {
 	m.ctrl.T.Helper()
@@ -979,6 +1422,21 @@ func (mr *MockNetworkingMockRecorder) UpdateNetwork(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNetwork", reflect.TypeOf((*MockNetworking)(nil).UpdateNetwork), arg0, arg1)
 }
 
+// UpdatePort mocks base method.
+func (m *MockNetworking) UpdatePort(arg0 string, arg1 ports.UpdateOptsBuilder) (*ports.Port, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePort", arg0, arg1)
+	ret0, _ := ret[0].(*ports.Port)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePort indicates an expected call of UpdatePort.
+func (mr *MockNetworkingMockRecorder) UpdatePort(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePort", reflect.TypeOf((*MockNetworking)(nil).UpdatePort), arg0, arg1)
+}
+
 // UpdateRouter mocks base method.
 func (m *MockNetworking) UpdateRouter(arg0 string, arg1 routers.UpdateOpts) (*routers.Router, error) {
 	m.ctrl.T.Helper()
@@ -1172,3 +1630,567 @@ func (mr *MockSharedFilesystemMockRecorder) ListShareNetworks(arg0 interface{})
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListShareNetworks", reflect.TypeOf((*MockSharedFilesystem)(nil).ListShareNetworks), arg0)
 }
+
+// MockPlacement is a mock of Placement interface.
+type MockPlacement struct {
+	ctrl     *gomock.Controller
+	recorder *MockPlacementMockRecorder
+}
+
+// MockPlacementMockRecorder is the mock recorder for MockPlacement.
+type MockPlacementMockRecorder struct {
+	mock *MockPlacement
+}
+
+// NewMockPlacement creates a new mock instance.
+func NewMockPlacement(ctrl *gomock.Controller) *MockPlacement {
+	mock := &MockPlacement{ctrl: ctrl}
+	mock.recorder = &MockPlacementMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPlacement) EXPECT() *MockPlacementMockRecorder {
+	return m.recorder
+}
+
+// GetInventories mocks base method.
+func (m *MockPlacement) GetInventories(arg0 string) (*resourceproviders.ResourceProviderInventories, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInventories", arg0)
+	ret0, _ := ret[0].(*resourceproviders.ResourceProviderInventories)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInventories indicates an expected call of GetInventories.
+func (mr *MockPlacementMockRecorder) GetInventories(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInventories", reflect.TypeOf((*MockPlacement)(nil).GetInventories), arg0)
+}
+
+// GetUsages mocks base method.
+func (m *MockPlacement) GetUsages(arg0 string) (*resourceproviders.ResourceProviderUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsages", arg0)
+	ret0, _ := ret[0].(*resourceproviders.ResourceProviderUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsages indicates an expected call of GetUsages.
+func (mr *MockPlacementMockRecorder) GetUsages(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsages", reflect.TypeOf((*MockPlacement)(nil).GetUsages), arg0)
+}
+
+// ListResourceProviders mocks base method.
+func (m *MockPlacement) ListResourceProviders() ([]resourceproviders.ResourceProvider, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResourceProviders")
+	ret0, _ := ret[0].([]resourceproviders.ResourceProvider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResourceProviders indicates an expected call of ListResourceProviders.
+func (mr *MockPlacementMockRecorder) ListResourceProviders() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceProviders", reflect.TypeOf((*MockPlacement)(nil).ListResourceProviders))
+}
+
+// MockBareMetal is a mock of BareMetal interface.
+type MockBareMetal struct {
+	ctrl     *gomock.Controller
+	recorder *MockBareMetalMockRecorder
+}
+
+// MockBareMetalMockRecorder is the mock recorder for MockBareMetal.
+type MockBareMetalMockRecorder struct {
+	mock *MockBareMetal
+}
+
+// NewMockBareMetal creates a new mock instance.
+func NewMockBareMetal(ctrl *gomock.Controller) *MockBareMetal {
+	mock := &MockBareMetal{ctrl: ctrl}
+	mock.recorder = &MockBareMetalMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBareMetal) EXPECT() *MockBareMetalMockRecorder {
+	return m.recorder
+}
+
+// ListNodes mocks base method.
+func (m *MockBareMetal) ListNodes(arg0 nodes.ListOpts) ([]nodes.Node, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNodes", arg0)
+	ret0, _ := ret[0].([]nodes.Node)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNodes indicates an expected call of ListNodes.
+func (mr *MockBareMetalMockRecorder) ListNodes(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNodes", reflect.TypeOf((*MockBareMetal)(nil).ListNodes), arg0)
+}
+
+// MockBlockStorage is a mock of BlockStorage interface.
+type MockBlockStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockBlockStorageMockRecorder
+}
+
+// MockBlockStorageMockRecorder is the mock recorder for MockBlockStorage.
+type MockBlockStorageMockRecorder struct {
+	mock *MockBlockStorage
+}
+
+// NewMockBlockStorage creates a new mock instance.
+func NewMockBlockStorage(ctrl *gomock.Controller) *MockBlockStorage {
+	mock := &MockBlockStorage{ctrl: ctrl}
+	mock.recorder = &MockBlockStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBlockStorage) EXPECT() *MockBlockStorageMockRecorder {
+	return m.recorder
+}
+
+// GetQuotaUsage mocks base method.
+func (m *MockBlockStorage) GetQuotaUsage(arg0 string) (*quotasets.QuotaUsageSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuotaUsage", arg0)
+	ret0, _ := ret[0].(*quotasets.QuotaUsageSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuotaUsage indicates an expected call of GetQuotaUsage.
+func (mr *MockBlockStorageMockRecorder) GetQuotaUsage(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuotaUsage", reflect.TypeOf((*MockBlockStorage)(nil).GetQuotaUsage), arg0)
+}
+
+// MockIdentity is a mock of Identity interface.
+type MockIdentity struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdentityMockRecorder
+}
+
+// MockIdentityMockRecorder is the mock recorder for MockIdentity.
+type MockIdentityMockRecorder struct {
+	mock *MockIdentity
+}
+
+// NewMockIdentity creates a new mock instance.
+func NewMockIdentity(ctrl *gomock.Controller) *MockIdentity {
+	mock := &MockIdentity{ctrl: ctrl}
+	mock.recorder = &MockIdentityMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdentity) EXPECT() *MockIdentityMockRecorder {
+	return m.recorder
+}
+
+// AssignRole mocks base method.
+func (m *MockIdentity) AssignRole(arg0 string, arg1 roles.AssignOpts) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignRole", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignRole indicates an expected call of AssignRole.
+func (mr *MockIdentityMockRecorder) AssignRole(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignRole", reflect.TypeOf((*MockIdentity)(nil).AssignRole), arg0, arg1)
+}
+
+// CreateApplicationCredential mocks base method.
+func (m *MockIdentity) CreateApplicationCredential(arg0 string, arg1 applicationcredentials.CreateOpts) (*applicationcredentials.ApplicationCredential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateApplicationCredential", arg0, arg1)
+	ret0, _ := ret[0].(*applicationcredentials.ApplicationCredential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateApplicationCredential indicates an expected call of CreateApplicationCredential.
+func (mr *MockIdentityMockRecorder) CreateApplicationCredential(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateApplicationCredential", reflect.TypeOf((*MockIdentity)(nil).CreateApplicationCredential), arg0, arg1)
+}
+
+// CreateProject mocks base method.
+func (m *MockIdentity) CreateProject(arg0 projects.CreateOpts) (*projects.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProject", arg0)
+	ret0, _ := ret[0].(*projects.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProject indicates an expected call of CreateProject.
+func (mr *MockIdentityMockRecorder) CreateProject(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockIdentity)(nil).CreateProject), arg0)
+}
+
+// CreateUser mocks base method.
+func (m *MockIdentity) CreateUser(arg0 users.CreateOpts) (*users.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", arg0)
+	ret0, _ := ret[0].(*users.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockIdentityMockRecorder) CreateUser(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockIdentity)(nil).CreateUser), arg0)
+}
+
+// DeleteApplicationCredential mocks base method.
+func (m *MockIdentity) DeleteApplicationCredential(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteApplicationCredential", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteApplicationCredential indicates an expected call of DeleteApplicationCredential.
+func (mr *MockIdentityMockRecorder) DeleteApplicationCredential(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteApplicationCredential", reflect.TypeOf((*MockIdentity)(nil).DeleteApplicationCredential), arg0, arg1)
+}
+
+// DeleteProject mocks base method.
+func (m *MockIdentity) DeleteProject(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProject", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProject indicates an expected call of DeleteProject.
+func (mr *MockIdentityMockRecorder) DeleteProject(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*MockIdentity)(nil).DeleteProject), arg0)
+}
+
+// DeleteUser mocks base method.
+func (m *MockIdentity) DeleteUser(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUser", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUser indicates an expected call of DeleteUser.
+func (mr *MockIdentityMockRecorder) DeleteUser(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockIdentity)(nil).DeleteUser), arg0)
+}
+
+// GetApplicationCredential mocks base method.
+func (m *MockIdentity) GetApplicationCredential(arg0, arg1 string) (*applicationcredentials.ApplicationCredential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationCredential", arg0, arg1)
+	ret0, _ := ret[0].(*applicationcredentials.ApplicationCredential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationCredential indicates an expected call of GetApplicationCredential.
+func (mr *MockIdentityMockRecorder) GetApplicationCredential(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationCredential", reflect.TypeOf((*MockIdentity)(nil).GetApplicationCredential), arg0, arg1)
+}
+
+// ListProjects mocks base method.
+func (m *MockIdentity) ListProjects(arg0 projects.ListOpts) ([]projects.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjects", arg0)
+	ret0, _ := ret[0].([]projects.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjects indicates an expected call of ListProjects.
+func (mr *MockIdentityMockRecorder) ListProjects(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjects", reflect.TypeOf((*MockIdentity)(nil).ListProjects), arg0)
+}
+
+// ListRoles mocks base method.
+func (m *MockIdentity) ListRoles(arg0 roles.ListOpts) ([]roles.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoles", arg0)
+	ret0, _ := ret[0].([]roles.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoles indicates an expected call of ListRoles.
+func (mr *MockIdentityMockRecorder) ListRoles(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoles", reflect.TypeOf((*MockIdentity)(nil).ListRoles), arg0)
+}
+
+// ListUsers mocks base method.
+func (m *MockIdentity) ListUsers(arg0 users.ListOpts) ([]users.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsers", arg0)
+	ret0, _ := ret[0].([]users.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsers indicates an expected call of ListUsers.
+func (mr *MockIdentityMockRecorder) ListUsers(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockIdentity)(nil).ListUsers), arg0)
+}
+
+// UpdateUser mocks base method.
+func (m *MockIdentity) UpdateUser(arg0 string, arg1 users.UpdateOpts) (*users.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUser", arg0, arg1)
+	ret0, _ := ret[0].(*users.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUser indicates an expected call of UpdateUser.
+func (mr *MockIdentityMockRecorder) UpdateUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockIdentity)(nil).UpdateUser), arg0, arg1)
+}
+
+// MockStorage is a mock of Storage interface.
+type MockStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageMockRecorder
+}
+
+// MockStorageMockRecorder is the mock recorder for MockStorage.
+type MockStorageMockRecorder struct {
+	mock *MockStorage
+}
+
+// NewMockStorage creates a new mock instance.
+func NewMockStorage(ctrl *gomock.Controller) *MockStorage {
+	mock := &MockStorage{ctrl: ctrl}
+	mock.recorder = &MockStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorage) EXPECT() *MockStorageMockRecorder {
+	return m.recorder
+}
+
+// CreateContainerIfNotExists mocks base method.
+func (m *MockStorage) CreateContainerIfNotExists(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateContainerIfNotExists", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateContainerIfNotExists indicates an expected call of CreateContainerIfNotExists.
+func (mr *MockStorageMockRecorder) CreateContainerIfNotExists(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateContainerIfNotExists", reflect.TypeOf((*MockStorage)(nil).CreateContainerIfNotExists), arg0, arg1)
+}
+
+// DeleteContainerIfExists mocks base method.
+func (m *MockStorage) DeleteContainerIfExists(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteContainerIfExists", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteContainerIfExists indicates an expected call of DeleteContainerIfExists.
+func (mr *MockStorageMockRecorder) DeleteContainerIfExists(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteContainerIfExists", reflect.TypeOf((*MockStorage)(nil).DeleteContainerIfExists), arg0, arg1)
+}
+
+// DeleteObjectsWithPrefix mocks base method.
+func (m *MockStorage) DeleteObjectsWithPrefix(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteObjectsWithPrefix", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteObjectsWithPrefix indicates an expected call of DeleteObjectsWithPrefix.
+func (mr *MockStorageMockRecorder) DeleteObjectsWithPrefix(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObjectsWithPrefix", reflect.TypeOf((*MockStorage)(nil).DeleteObjectsWithPrefix), arg0, arg1, arg2)
+}
+
+// DownloadObject mocks base method.
+func (m *MockStorage) DownloadObject(arg0 context.Context, arg1, arg2 string) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadObject", arg0, arg1, arg2)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DownloadObject indicates an expected call of DownloadObject.
+func (mr *MockStorageMockRecorder) DownloadObject(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadObject", reflect.TypeOf((*MockStorage)(nil).DownloadObject), arg0, arg1, arg2)
+}
+
+// EnsureContainerTempURLKey mocks base method.
+func (m *MockStorage) EnsureContainerTempURLKey(arg0 context.Context, arg1 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureContainerTempURLKey", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureContainerTempURLKey indicates an expected call of EnsureContainerTempURLKey.
+func (mr *MockStorageMockRecorder) EnsureContainerTempURLKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureContainerTempURLKey", reflect.TypeOf((*MockStorage)(nil).EnsureContainerTempURLKey), arg0, arg1)
+}
+
+// GetObjectHeader mocks base method.
+func (m *MockStorage) GetObjectHeader(arg0 context.Context, arg1, arg2 string) (*objects.GetHeader, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetObjectHeader", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*objects.GetHeader)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetObjectHeader indicates an expected call of GetObjectHeader.
+func (mr *MockStorageMockRecorder) GetObjectHeader(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObjectHeader", reflect.TypeOf((*MockStorage)(nil).GetObjectHeader), arg0, arg1, arg2)
+}
+
+// ListObjects mocks base method.
+func (m *MockStorage) ListObjects(arg0 context.Context, arg1, arg2 string) ([]objects.Object, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListObjects", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]objects.Object)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListObjects indicates an expected call of ListObjects.
+func (mr *MockStorageMockRecorder) ListObjects(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjects", reflect.TypeOf((*MockStorage)(nil).ListObjects), arg0, arg1, arg2)
+}
+
+// MockOrchestration is a mock of Orchestration interface.
+type MockOrchestration struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrchestrationMockRecorder
+}
+
+// MockOrchestrationMockRecorder is the mock recorder for MockOrchestration.
+type MockOrchestrationMockRecorder struct {
+	mock *MockOrchestration
+}
+
+// NewMockOrchestration creates a new mock instance.
+func NewMockOrchestration(ctrl *gomock.Controller) *MockOrchestration {
+	mock := &MockOrchestration{ctrl: ctrl}
+	mock.recorder = &MockOrchestrationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrchestration) EXPECT() *MockOrchestrationMockRecorder {
+	return m.recorder
+}
+
+// CreateStack mocks base method.
+func (m *MockOrchestration) CreateStack(arg0, arg1 string, arg2 map[string]interface{}, arg3 int) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStack", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateStack indicates an expected call of CreateStack.
+func (mr *MockOrchestrationMockRecorder) CreateStack(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStack", reflect.TypeOf((*MockOrchestration)(nil).CreateStack), arg0, arg1, arg2, arg3)
+}
+
+// DeleteStack mocks base method.
+func (m *MockOrchestration) DeleteStack(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStack", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteStack indicates an expected call of DeleteStack.
+func (mr *MockOrchestrationMockRecorder) DeleteStack(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStack", reflect.TypeOf((*MockOrchestration)(nil).DeleteStack), arg0, arg1)
+}
+
+// GetStack mocks base method.
+func (m *MockOrchestration) GetStack(arg0, arg1 string) (*stacks.RetrievedStack, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStack", arg0, arg1)
+	ret0, _ := ret[0].(*stacks.RetrievedStack)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStack indicates an expected call of GetStack.
+func (mr *MockOrchestrationMockRecorder) GetStack(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStack", reflect.TypeOf((*MockOrchestration)(nil).GetStack), arg0, arg1)
+}
+
+// ListStacks mocks base method.
+func (m *MockOrchestration) ListStacks(arg0 stacks.ListOpts) ([]stacks.ListedStack, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStacks", arg0)
+	ret0, _ := ret[0].([]stacks.ListedStack)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStacks indicates an expected call of ListStacks.
+func (mr *MockOrchestrationMockRecorder) ListStacks(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStacks", reflect.TypeOf((*MockOrchestration)(nil).ListStacks), arg0)
+}
+
+// UpdateStack mocks base method.
+func (m *MockOrchestration) UpdateStack(arg0, arg1, arg2 string, arg3 map[string]interface{}, arg4 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStack", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStack indicates an expected call of UpdateStack.
+func (mr *MockOrchestrationMockRecorder) UpdateStack(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStack", reflect.TypeOf((*MockOrchestration)(nil).UpdateStack), arg0, arg1, arg2, arg3, arg4)
+}
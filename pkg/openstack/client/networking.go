@@ -11,8 +11,11 @@ import (
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/external"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/quotas"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/rbacpolicies"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/groups"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/rules"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/trunks"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/subnets"
@@ -129,6 +132,11 @@ func (c *NetworkingClient) DeleteFloatingIP(id string) error {
 	return floatingips.Delete(c.client, id).ExtractErr()
 }
 
+// UpdateFloatingIP updates the floating IP with the given id.
+func (c *NetworkingClient) UpdateFloatingIP(id string, updateOpts floatingips.UpdateOpts) (*floatingips.FloatingIP, error) {
+	return floatingips.Update(c.client, id, updateOpts).Extract()
+}
+
 // ListRules returns a list of security group rules
 func (c *NetworkingClient) ListRules(listOpts rules.ListOpts) ([]rules.SecGroupRule, error) {
 	allPages, err := rules.List(c.client, listOpts).AllPages()
@@ -258,6 +266,25 @@ func (c *NetworkingClient) GetPort(portID string) (*ports.Port, error) {
 	return ports.Get(c.client, portID).Extract()
 }
 
+// ListPorts returns a list of all ports matching the given ListOpts.
+func (c *NetworkingClient) ListPorts(listOpts ports.ListOpts) ([]ports.Port, error) {
+	page, err := ports.List(c.client, listOpts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return ports.ExtractPorts(page)
+}
+
+// UpdatePort updates the port with the given identifier.
+func (c *NetworkingClient) UpdatePort(portID string, updateOpts ports.UpdateOptsBuilder) (*ports.Port, error) {
+	return ports.Update(c.client, portID, updateOpts).Extract()
+}
+
+// DeletePort deletes the port with the given identifier.
+func (c *NetworkingClient) DeletePort(portID string) error {
+	return ports.Delete(c.client, portID).ExtractErr()
+}
+
 // GetRouterInterfacePort gets a port for a router interface
 func (c *NetworkingClient) GetRouterInterfacePort(routerID, subnetID string) (*ports.Port, error) {
 	page, err := ports.List(c.client, ports.ListOpts{
@@ -279,3 +306,46 @@ func (c *NetworkingClient) GetRouterInterfacePort(routerID, subnetID string) (*p
 	}
 	return &list[0], nil
 }
+
+// CreateTrunk creates a trunk with the given parent port.
+func (c *NetworkingClient) CreateTrunk(createOpts trunks.CreateOpts) (*trunks.Trunk, error) {
+	return trunks.Create(c.client, createOpts).Extract()
+}
+
+// ListTrunks returns a list of all trunks matching the given ListOpts.
+func (c *NetworkingClient) ListTrunks(listOpts trunks.ListOpts) ([]trunks.Trunk, error) {
+	page, err := trunks.List(c.client, listOpts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return trunks.ExtractTrunks(page)
+}
+
+// DeleteTrunk deletes the trunk with the given id. The parent port and the subports of the trunk are not deleted.
+func (c *NetworkingClient) DeleteTrunk(trunkID string) error {
+	return trunks.Delete(c.client, trunkID).ExtractErr()
+}
+
+// GetQuotaDetail returns the quotas of the project with the given id including their usage.
+func (c *NetworkingClient) GetQuotaDetail(projectID string) (*quotas.QuotaDetailSet, error) {
+	return quotas.GetDetail(c.client, projectID).Extract()
+}
+
+// ListRBACPolicies returns the RBAC policies matching the given options.
+func (c *NetworkingClient) ListRBACPolicies(listOpts rbacpolicies.ListOpts) ([]rbacpolicies.RBACPolicy, error) {
+	allPages, err := rbacpolicies.List(c.client, listOpts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return rbacpolicies.ExtractRBACPolicies(allPages)
+}
+
+// CreateRBACPolicy creates an RBAC policy.
+func (c *NetworkingClient) CreateRBACPolicy(createOpts rbacpolicies.CreateOpts) (*rbacpolicies.RBACPolicy, error) {
+	return rbacpolicies.Create(c.client, createOpts).Extract()
+}
+
+// DeleteRBACPolicy deletes the RBAC policy with the given id.
+func (c *NetworkingClient) DeleteRBACPolicy(id string) error {
+	return rbacpolicies.Delete(c.client, id).ExtractErr()
+}
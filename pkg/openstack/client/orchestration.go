@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"github.com/gophercloud/gophercloud/openstack/orchestration/v1/stacks"
+)
+
+// rawTemplateOpts passes a template as is to Heat. Unlike the options of gophercloud, it does not resolve the files and
+// nested templates referenced by the template on the local file system, which must not be accessible to the templates
+// of users.
+type rawTemplateOpts map[string]interface{}
+
+// ToStackCreateMap implements stacks.CreateOptsBuilder.
+func (o rawTemplateOpts) ToStackCreateMap() (map[string]interface{}, error) {
+	return o, nil
+}
+
+// ToStackUpdateMap implements stacks.UpdateOptsBuilder.
+func (o rawTemplateOpts) ToStackUpdateMap() (map[string]interface{}, error) {
+	return o, nil
+}
+
+// ListStacks returns the stacks matching the given options.
+func (c *OrchestrationClient) ListStacks(listOpts stacks.ListOpts) ([]stacks.ListedStack, error) {
+	page, err := stacks.List(c.client, listOpts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return stacks.ExtractStacks(page)
+}
+
+// GetStack returns the stack with the given name and id, or nil if it does not exist.
+func (c *OrchestrationClient) GetStack(name, id string) (*stacks.RetrievedStack, error) {
+	stack, err := stacks.Get(c.client, name, id).Extract()
+	if err != nil {
+		if IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return stack, nil
+}
+
+// CreateStack creates a stack with the given name from the given template and returns its id.
+func (c *OrchestrationClient) CreateStack(name, template string, parameters map[string]interface{}, timeoutMinutes int) (string, error) {
+	stack, err := stacks.Create(c.client, rawTemplateOpts{
+		"stack_name":       name,
+		"template":         template,
+		"parameters":       parameters,
+		"timeout_mins":     timeoutMinutes,
+		"disable_rollback": true,
+	}).Extract()
+	if err != nil {
+		return "", err
+	}
+	return stack.ID, nil
+}
+
+// UpdateStack replaces the template and the parameters of the stack with the given name and id.
+func (c *OrchestrationClient) UpdateStack(name, id, template string, parameters map[string]interface{}, timeoutMinutes int) error {
+	return stacks.Update(c.client, name, id, rawTemplateOpts{
+		"template":     template,
+		"parameters":   parameters,
+		"timeout_mins": timeoutMinutes,
+	}).ExtractErr()
+}
+
+// DeleteStack deletes the stack with the given name and id.
+func (c *OrchestrationClient) DeleteStack(name, id string) error {
+	return stacks.Delete(c.client, name, id).ExtractErr()
+}
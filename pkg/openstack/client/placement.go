@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"github.com/gophercloud/gophercloud/openstack/placement/v1/resourceproviders"
+)
+
+// ListResourceProviders lists all resource providers.
+func (c *PlacementClient) ListResourceProviders() ([]resourceproviders.ResourceProvider, error) {
+	allPages, err := resourceproviders.List(c.client, resourceproviders.ListOpts{}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return resourceproviders.ExtractResourceProviders(allPages)
+}
+
+// GetInventories returns the inventories of the resource provider with the given id.
+func (c *PlacementClient) GetInventories(resourceProviderID string) (*resourceproviders.ResourceProviderInventories, error) {
+	return resourceproviders.GetInventories(c.client, resourceProviderID).Extract()
+}
+
+// GetUsages returns the usages of the resource provider with the given id.
+func (c *PlacementClient) GetUsages(resourceProviderID string) (*resourceproviders.ResourceProviderUsage, error) {
+	return resourceproviders.GetUsages(c.client, resourceProviderID).Extract()
+}
@@ -6,7 +6,9 @@ package client
 
 import (
 	"context"
+	"io"
 
+	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/containers"
 	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
@@ -15,6 +17,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// tempURLKeyLength is the length of generated keys for temporary URLs.
+const tempURLKeyLength = 32
+
 // NewStorageClientFromSecretRef retrieves the openstack client from specified by the secret reference.
 func NewStorageClientFromSecretRef(ctx context.Context, c client.Client, secretRef corev1.SecretReference, region string) (Storage, error) {
 	base, err := NewOpenStackClientFromSecretRef(ctx, c, secretRef, nil)
@@ -97,3 +102,56 @@ func (s *StorageClient) DeleteContainerIfExists(ctx context.Context, container s
 	}
 	return nil
 }
+
+// EnsureContainerTempURLKey returns the key for temporary URLs of the openstack blob container with name <container>.
+// If the container has no key yet, a random one is generated.
+func (s *StorageClient) EnsureContainerTempURLKey(_ context.Context, container string) (string, error) {
+	header, err := containers.Get(s.client, container, nil).Extract()
+	if err != nil {
+		return "", err
+	}
+	if header.TempURLKey != "" {
+		return header.TempURLKey, nil
+	}
+
+	key, err := utils.GenerateRandomString(tempURLKeyLength)
+	if err != nil {
+		return "", err
+	}
+	if _, err := containers.Update(s.client, container, containers.UpdateOpts{TempURLKey: key}).Extract(); err != nil {
+		return "", err
+	}
+	return key, nil
+}
+
+// ListObjects lists all objects with the given <prefix> in the openstack blob container with name <container>.
+func (s *StorageClient) ListObjects(_ context.Context, container, prefix string) ([]objects.Object, error) {
+	pages, err := objects.List(s.client, container, &objects.ListOpts{
+		Full:   true,
+		Prefix: prefix,
+	}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return objects.ExtractInfo(pages)
+}
+
+// GetObjectHeader returns the header of the object with name <object> in the openstack blob container with name
+// <container>. If it does not exist, nil is returned.
+func (s *StorageClient) GetObjectHeader(_ context.Context, container, object string) (*objects.GetHeader, error) {
+	header, err := objects.Get(s.client, container, object, nil).Extract()
+	if err != nil {
+		return nil, IgnoreNotFoundError(err)
+	}
+	return header, nil
+}
+
+// DownloadObject downloads the content of the object with name <object> from the openstack blob container with name
+// <container>. The caller has to close the returned reader.
+func (s *StorageClient) DownloadObject(_ context.Context, container, object string) (io.ReadCloser, error) {
+	result := objects.Download(s.client, container, object, nil)
+	if result.Err != nil {
+		return nil, result.Err
+	}
+	return result.Body, nil
+}
@@ -2,26 +2,43 @@
 //
 // SPDX-License-Identifier: Apache-2.0
 
-//go:generate mockgen -destination=mocks/client_mocks.go -package=mocks . Factory,FactoryFactory,Compute,DNS,Networking,Loadbalancing,SharedFilesystem
+//go:generate mockgen -destination=mocks/client_mocks.go -package=mocks . Factory,FactoryFactory,Compute,DNS,Networking,Loadbalancing,SharedFilesystem,Placement,BareMetal,BlockStorage,Identity,Storage,Orchestration
 package client
 
 import (
 	"context"
+	"io"
 
 	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/baremetal/v1/nodes"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/quotasets"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/aggregates"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/availabilityzones"
 	computefip "github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/floatingips"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/keypairs"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/limits"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/servergroups"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/images"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/applicationcredentials"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/projects"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/roles"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/users"
 	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/loadbalancers"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/quotas"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/rbacpolicies"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/groups"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/rules"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/trunks"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/subnets"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
+	"github.com/gophercloud/gophercloud/openstack/orchestration/v1/stacks"
+	"github.com/gophercloud/gophercloud/openstack/placement/v1/resourceproviders"
 	"github.com/gophercloud/gophercloud/openstack/sharedfilesystems/v2/sharenetworks"
 
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
@@ -62,6 +79,31 @@ type SharedFilesystemClient struct {
 	client *gophercloud.ServiceClient
 }
 
+// PlacementClient is a client for the Placement service.
+type PlacementClient struct {
+	client *gophercloud.ServiceClient
+}
+
+// BareMetalClient is a client for the Ironic service.
+type BareMetalClient struct {
+	client *gophercloud.ServiceClient
+}
+
+// BlockStorageClient is a client for the Cinder service.
+type BlockStorageClient struct {
+	client *gophercloud.ServiceClient
+}
+
+// IdentityClient is a client for the Keystone service.
+type IdentityClient struct {
+	client *gophercloud.ServiceClient
+}
+
+// OrchestrationClient is a client for the Heat service.
+type OrchestrationClient struct {
+	client *gophercloud.ServiceClient
+}
+
 // Option can be passed to Factory implementations to modify the produced clients.
 type Option func(opts gophercloud.EndpointOpts) gophercloud.EndpointOpts
 
@@ -73,6 +115,19 @@ type Factory interface {
 	Networking(options ...Option) (Networking, error)
 	Loadbalancing(options ...Option) (Loadbalancing, error)
 	SharedFilesystem(options ...Option) (SharedFilesystem, error)
+	Placement(options ...Option) (Placement, error)
+	BareMetal(options ...Option) (BareMetal, error)
+	BlockStorage(options ...Option) (BlockStorage, error)
+	Identity(options ...Option) (Identity, error)
+	Orchestration(options ...Option) (Orchestration, error)
+	// ServiceEndpoints returns the URLs of the public endpoints of all services in the service catalog.
+	ServiceEndpoints(options ...Option) ([]string, error)
+	// ProjectID returns the id of the project the token is scoped to.
+	ProjectID() (string, error)
+	// DomainID returns the id of the domain the token is scoped to.
+	DomainID() (string, error)
+	// UserID returns the id of the user the token is issued for.
+	UserID() (string, error)
 }
 
 // Storage describes the operations of a client interacting with OpenStack's ObjectStorage service.
@@ -80,6 +135,10 @@ type Storage interface {
 	DeleteObjectsWithPrefix(ctx context.Context, container, prefix string) error
 	CreateContainerIfNotExists(ctx context.Context, container string) error
 	DeleteContainerIfExists(ctx context.Context, container string) error
+	EnsureContainerTempURLKey(ctx context.Context, container string) (string, error)
+	ListObjects(ctx context.Context, container, prefix string) ([]objects.Object, error)
+	GetObjectHeader(ctx context.Context, container, object string) (*objects.GetHeader, error)
+	DownloadObject(ctx context.Context, container, object string) (io.ReadCloser, error)
 }
 
 // Compute describes the operations of a client interacting with OpenStack's Compute service.
@@ -90,6 +149,8 @@ type Compute interface {
 	// Server
 	CreateServer(createOpts servers.CreateOpts) (*servers.Server, error)
 	DeleteServer(id string) error
+	GetServer(id string) (*servers.Server, error)
+	GetServerWithExtendedAttributes(id string) (*ServerWithExtendedAttributes, error)
 	ListServerGroups() ([]servergroups.ServerGroup, error)
 	FindServersByName(name string) ([]servers.Server, error)
 	AssociateFIPWithInstance(serverID string, associateOpts computefip.AssociateOpts) error
@@ -97,9 +158,21 @@ type Compute interface {
 	FindFloatingIDByInstanceID(id string) (string, error)
 
 	FindFlavorID(name string) (string, error)
+	ListFlavors(listOpts flavors.ListOpts) ([]flavors.Flavor, error)
+	GetFlavorExtraSpecs(flavorID string) (map[string]string, error)
 	FindImages(name string) ([]images.Image, error)
+	GetImage(id string) (*images.Image, error)
 	ListImages(listOpts images.ListOpts) ([]images.Image, error)
 
+	// Availability Zones
+	ListAvailabilityZones() ([]availabilityzones.AvailabilityZone, error)
+
+	// Limits
+	GetLimits() (*limits.Limits, error)
+
+	// Aggregates
+	ListAggregates() ([]aggregates.Aggregate, error)
+
 	// KeyPairs
 	CreateKeyPair(name, publicKey string) (*keypairs.KeyPair, error)
 	GetKeyPair(name string) (*keypairs.KeyPair, error)
@@ -127,6 +200,7 @@ type Networking interface {
 	// FloatingIP
 	CreateFloatingIP(createOpts floatingips.CreateOpts) (*floatingips.FloatingIP, error)
 	DeleteFloatingIP(id string) error
+	UpdateFloatingIP(id string, updateOpts floatingips.UpdateOpts) (*floatingips.FloatingIP, error)
 	ListFip(listOpts floatingips.ListOpts) ([]floatingips.FloatingIP, error)
 	GetFipByName(name string) ([]floatingips.FloatingIP, error)
 	// Security Group
@@ -155,7 +229,20 @@ type Networking interface {
 	DeleteSubnet(subnetID string) error
 	// Ports
 	GetPort(portID string) (*ports.Port, error)
+	ListPorts(listOpts ports.ListOpts) ([]ports.Port, error)
+	UpdatePort(portID string, updateOpts ports.UpdateOptsBuilder) (*ports.Port, error)
+	DeletePort(portID string) error
 	GetRouterInterfacePort(routerID, subnetID string) (*ports.Port, error)
+	// Trunks
+	CreateTrunk(createOpts trunks.CreateOpts) (*trunks.Trunk, error)
+	ListTrunks(listOpts trunks.ListOpts) ([]trunks.Trunk, error)
+	DeleteTrunk(trunkID string) error
+	// Quotas
+	GetQuotaDetail(projectID string) (*quotas.QuotaDetailSet, error)
+	// RBAC policies
+	ListRBACPolicies(listOpts rbacpolicies.ListOpts) ([]rbacpolicies.RBACPolicy, error)
+	CreateRBACPolicy(createOpts rbacpolicies.CreateOpts) (*rbacpolicies.RBACPolicy, error)
+	DeleteRBACPolicy(id string) error
 }
 
 // Loadbalancing describes the operations of a client interacting with OpenStack's Octavia service.
@@ -174,6 +261,48 @@ type SharedFilesystem interface {
 	DeleteShareNetwork(id string) error
 }
 
+// Placement describes the operations of a client interacting with OpenStack's Placement service.
+type Placement interface {
+	ListResourceProviders() ([]resourceproviders.ResourceProvider, error)
+	GetInventories(resourceProviderID string) (*resourceproviders.ResourceProviderInventories, error)
+	GetUsages(resourceProviderID string) (*resourceproviders.ResourceProviderUsage, error)
+}
+
+// BareMetal describes the operations of a client interacting with OpenStack's Ironic service.
+type BareMetal interface {
+	ListNodes(listOpts nodes.ListOpts) ([]nodes.Node, error)
+}
+
+// BlockStorage describes the operations of a client interacting with OpenStack's Cinder service.
+type BlockStorage interface {
+	GetQuotaUsage(projectID string) (*quotasets.QuotaUsageSet, error)
+}
+
+// Identity describes the operations of a client interacting with OpenStack's Keystone service.
+type Identity interface {
+	ListProjects(listOpts projects.ListOpts) ([]projects.Project, error)
+	CreateProject(createOpts projects.CreateOpts) (*projects.Project, error)
+	DeleteProject(id string) error
+	ListUsers(listOpts users.ListOpts) ([]users.User, error)
+	CreateUser(createOpts users.CreateOpts) (*users.User, error)
+	UpdateUser(id string, updateOpts users.UpdateOpts) (*users.User, error)
+	DeleteUser(id string) error
+	ListRoles(listOpts roles.ListOpts) ([]roles.Role, error)
+	AssignRole(roleID string, assignOpts roles.AssignOpts) error
+	CreateApplicationCredential(userID string, createOpts applicationcredentials.CreateOpts) (*applicationcredentials.ApplicationCredential, error)
+	GetApplicationCredential(userID, id string) (*applicationcredentials.ApplicationCredential, error)
+	DeleteApplicationCredential(userID, id string) error
+}
+
+// Orchestration describes the operations of a client interacting with OpenStack's Heat service.
+type Orchestration interface {
+	ListStacks(listOpts stacks.ListOpts) ([]stacks.ListedStack, error)
+	GetStack(name, id string) (*stacks.RetrievedStack, error)
+	CreateStack(name, template string, parameters map[string]interface{}, timeoutMinutes int) (string, error)
+	UpdateStack(name, id, template string, parameters map[string]interface{}, timeoutMinutes int) error
+	DeleteStack(name, id string) error
+}
+
 // FactoryFactory creates instances of Factory.
 type FactoryFactory interface {
 	// NewFactory creates a new instance of Factory for the given Openstack credentials.
@@ -0,0 +1,242 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package clientbuilder builds authenticated gophercloud clients from the cloud provider credentials of OpenStack
+// shoots. It is meant to be reused by other Gardener extensions operating on the OpenStack infrastructure of shoots,
+// so that they parse the credentials, handle custom CAs and select regions in the same way as this extension.
+//
+// The following authentication strategies are supported:
+//   - username and password, scoped to the project given by `tenantID` or `tenantName`,
+//   - application credentials given by `applicationCredentialID` or `applicationCredentialName` and
+//     `applicationCredentialSecret`, and
+//   - username and password scoped to the domain if `authScope` is `domain`.
+//
+// The endpoints of the services are looked up in the service catalog of Keystone, unless the credentials override them
+// for the type of the service, e.g. in clouds with broken or split-horizon catalogs.
+//
+// The exported functions and types of this package are kept stable. Changes are only made in a backwards compatible
+// way, i.e. new service types or options may be added.
+package clientbuilder
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+	"github.com/gophercloud/utils/openstack/clientconfig"
+	corev1 "k8s.io/api/core/v1"
+
+	os "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+)
+
+// ServiceType is the type of an OpenStack service a client can be built for.
+type ServiceType string
+
+const (
+	// ServiceTypeIdentity is the service type of Keystone.
+	ServiceTypeIdentity ServiceType = "identity"
+	// ServiceTypeCompute is the service type of Nova.
+	ServiceTypeCompute ServiceType = "compute"
+	// ServiceTypeNetwork is the service type of Neutron.
+	ServiceTypeNetwork ServiceType = "network"
+	// ServiceTypeLoadBalancer is the service type of Octavia.
+	ServiceTypeLoadBalancer ServiceType = "load-balancer"
+	// ServiceTypeDNS is the service type of Designate.
+	ServiceTypeDNS ServiceType = "dns"
+	// ServiceTypeObjectStorage is the service type of Swift.
+	ServiceTypeObjectStorage ServiceType = "object-store"
+	// ServiceTypeSharedFileSystem is the service type of Manila.
+	ServiceTypeSharedFileSystem ServiceType = "sharev2"
+	// ServiceTypePlacement is the service type of Placement.
+	ServiceTypePlacement ServiceType = "placement"
+	// ServiceTypeBlockStorage is the service type of Cinder.
+	ServiceTypeBlockStorage ServiceType = "volumev3"
+)
+
+// Options are options for building a provider client.
+type Options struct {
+	// AuthURL is the URL of Keystone used if the credentials do not contain one, e.g. the KeyStone URL of the region of
+	// the shoot from the CloudProfile.
+	AuthURL string
+	// HTTPClient is the base HTTP client. Its transport is replaced by one honouring the CA and TLS settings of the
+	// credentials and the proxy settings of the environment. Defaults to a new HTTP client.
+	HTTPClient *http.Client
+}
+
+// Option modifies the Options for building a provider client.
+type Option func(*Options)
+
+// WithAuthURL returns an Option setting the URL of Keystone used if the credentials do not contain one.
+func WithAuthURL(authURL string) Option {
+	return func(opts *Options) {
+		opts.AuthURL = authURL
+	}
+}
+
+// WithHTTPClient returns an Option setting the base HTTP client, e.g. to configure a timeout.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(opts *Options) {
+		opts.HTTPClient = httpClient
+	}
+}
+
+// NewProviderClientFromSecret returns an authenticated provider client for the credentials in the given cloud
+// provider secret.
+func NewProviderClientFromSecret(secret *corev1.Secret, opts ...Option) (*gophercloud.ProviderClient, error) {
+	credentials, err := os.ExtractCredentials(secret, false)
+	if err != nil {
+		return nil, err
+	}
+	return NewProviderClient(credentials, opts...)
+}
+
+// NewProviderClient returns an authenticated provider client for the given credentials. The client re-authenticates
+// automatically if its token expires, and uses the endpoint overrides of the credentials instead of the service catalog.
+func NewProviderClient(credentials *os.Credentials, opts ...Option) (*gophercloud.ProviderClient, error) {
+	options := &Options{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	authOpts, err := AuthOptions(credentials, options.AuthURL)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig, err := TLSConfig(credentials)
+	if err != nil {
+		return nil, err
+	}
+	httpClient := http.Client{}
+	if options.HTTPClient != nil {
+		httpClient = *options.HTTPClient
+	}
+	httpClient.Transport = &http.Transport{Proxy: http.ProxyFromEnvironment, TLSClientConfig: tlsConfig}
+
+	provider, err := openstack.NewClient(authOpts.IdentityEndpoint)
+	if err != nil {
+		return nil, err
+	}
+	provider.HTTPClient = httpClient
+
+	if err := openstack.Authenticate(provider, *authOpts); err != nil {
+		return nil, err
+	}
+	OverrideEndpoints(provider, credentials.EndpointOverrides)
+	return provider, nil
+}
+
+// OverrideEndpoints makes the given authenticated provider client use the given endpoints, mapped by the type of their
+// service, instead of the endpoints in the service catalog. The endpoints of other service types are still looked up
+// in the service catalog.
+func OverrideEndpoints(provider *gophercloud.ProviderClient, overrides map[string]string) {
+	if len(overrides) == 0 {
+		return
+	}
+	locator := provider.EndpointLocator
+	provider.EndpointLocator = func(eo gophercloud.EndpointOpts) (string, error) {
+		if url, ok := overrides[eo.Type]; ok {
+			return gophercloud.NormalizeURL(url), nil
+		}
+		return locator(eo)
+	}
+}
+
+// AuthOptions returns the options for authenticating with the given credentials. The given auth URL is used if the
+// credentials do not contain one.
+func AuthOptions(credentials *os.Credentials, authURL string) (*gophercloud.AuthOptions, error) {
+	opts := &clientconfig.ClientOpts{
+		AuthInfo: &clientconfig.AuthInfo{
+			AuthURL:                     credentials.AuthURL,
+			Username:                    credentials.Username,
+			Password:                    credentials.Password,
+			ApplicationCredentialID:     credentials.ApplicationCredentialID,
+			ApplicationCredentialName:   credentials.ApplicationCredentialName,
+			ApplicationCredentialSecret: credentials.ApplicationCredentialSecret,
+		},
+	}
+	if len(strings.TrimSpace(opts.AuthInfo.AuthURL)) == 0 {
+		opts.AuthInfo.AuthURL = authURL
+	}
+
+	// ids take precedence over names as names are not unique across domains, only one of them must be passed.
+	if credentials.DomainID != "" {
+		opts.AuthInfo.DomainID = credentials.DomainID
+	} else {
+		opts.AuthInfo.DomainName = credentials.DomainName
+	}
+	if credentials.IsDomainScoped() {
+		// the user domain is not derived from the domain for domain-scoped tokens
+		opts.AuthInfo.UserDomainID = opts.AuthInfo.DomainID
+		opts.AuthInfo.UserDomainName = opts.AuthInfo.DomainName
+	} else if credentials.TenantID != "" {
+		opts.AuthInfo.ProjectID = credentials.TenantID
+	} else {
+		opts.AuthInfo.ProjectName = credentials.TenantName
+	}
+
+	if opts.AuthInfo.ApplicationCredentialSecret != "" {
+		opts.AuthType = clientconfig.AuthV3ApplicationCredential
+	}
+
+	authOpts, err := clientconfig.AuthOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// AllowReauth should be set to true if you grant permission for Gophercloud to
+	// cache your credentials in memory, and to allow Gophercloud to attempt to
+	// re-authenticate automatically if/when your token expires.
+	authOpts.AllowReauth = true
+	return authOpts, nil
+}
+
+// TLSConfig returns the TLS configuration for connecting to the OpenStack services with the given credentials, i.e. it
+// trusts the CA bundle of the credentials, if any, and skips the verification of certificates if requested.
+func TLSConfig(credentials *os.Credentials) (*tls.Config, error) {
+	config := &tls.Config{
+		InsecureSkipVerify: credentials.Insecure,
+	}
+	if len(credentials.CACert) > 0 {
+		pool := x509.NewCertPool()
+		if ok := pool.AppendCertsFromPEM([]byte(credentials.CACert)); !ok {
+			return nil, fmt.Errorf("failed to load CA Bundle for KeyStone")
+		}
+		config.RootCAs = pool
+	}
+	return config, nil
+}
+
+// NewServiceClient returns a client for the public endpoint of the given service type in the given region. If the
+// region is empty, the endpoint is selected regardless of its region.
+func NewServiceClient(provider *gophercloud.ProviderClient, serviceType ServiceType, region string) (*gophercloud.ServiceClient, error) {
+	eo := gophercloud.EndpointOpts{Region: region}
+
+	switch serviceType {
+	case ServiceTypeIdentity:
+		return openstack.NewIdentityV3(provider, eo)
+	case ServiceTypeCompute:
+		return openstack.NewComputeV2(provider, eo)
+	case ServiceTypeNetwork:
+		return openstack.NewNetworkV2(provider, eo)
+	case ServiceTypeLoadBalancer:
+		return openstack.NewLoadBalancerV2(provider, eo)
+	case ServiceTypeDNS:
+		return openstack.NewDNSV2(provider, eo)
+	case ServiceTypeObjectStorage:
+		return openstack.NewObjectStorageV1(provider, eo)
+	case ServiceTypeSharedFileSystem:
+		return openstack.NewSharedFileSystemV2(provider, eo)
+	case ServiceTypePlacement:
+		return openstack.NewPlacementV1(provider, eo)
+	case ServiceTypeBlockStorage:
+		return openstack.NewBlockStorageV3(provider, eo)
+	default:
+		return nil, fmt.Errorf("unsupported service type %q", serviceType)
+	}
+}
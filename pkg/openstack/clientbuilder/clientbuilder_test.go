@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clientbuilder_test
+
+import (
+	"github.com/gophercloud/gophercloud"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+	. "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/clientbuilder"
+)
+
+var _ = Describe("ClientBuilder", func() {
+	var credentials *openstack.Credentials
+
+	BeforeEach(func() {
+		credentials = &openstack.Credentials{
+			DomainName: "domain",
+			TenantName: "project",
+			Username:   "user",
+			Password:   "password",
+			AuthURL:    "https://keystone.example.com/v3",
+		}
+	})
+
+	Describe("#AuthOptions", func() {
+		It("should scope password credentials to the project", func() {
+			authOpts, err := AuthOptions(credentials, "")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(authOpts.IdentityEndpoint).To(Equal("https://keystone.example.com/v3"))
+			Expect(authOpts.Username).To(Equal("user"))
+			Expect(authOpts.Password).To(Equal("password"))
+			Expect(authOpts.DomainName).To(Equal("domain"))
+			Expect(authOpts.Scope).To(Equal(&gophercloud.AuthScope{ProjectName: "project", DomainName: "domain"}))
+			Expect(authOpts.AllowReauth).To(BeTrue())
+		})
+
+		It("should prefer the project and domain ids", func() {
+			credentials.DomainID = "domain-id"
+			credentials.TenantID = "project-id"
+
+			authOpts, err := AuthOptions(credentials, "")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(authOpts.DomainID).To(Equal("domain-id"))
+			Expect(authOpts.DomainName).To(BeEmpty())
+			Expect(authOpts.Scope).To(Equal(&gophercloud.AuthScope{ProjectID: "project-id"}))
+		})
+
+		It("should scope credentials to the domain", func() {
+			credentials.TenantName = ""
+			credentials.AuthScope = openstack.AuthScopeDomain
+
+			authOpts, err := AuthOptions(credentials, "")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(authOpts.Scope).To(Equal(&gophercloud.AuthScope{DomainName: "domain"}))
+		})
+
+		It("should use application credentials", func() {
+			credentials.Username = ""
+			credentials.Password = ""
+			credentials.ApplicationCredentialID = "app-id"
+			credentials.ApplicationCredentialSecret = "app-secret"
+
+			authOpts, err := AuthOptions(credentials, "")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(authOpts.ApplicationCredentialID).To(Equal("app-id"))
+			Expect(authOpts.ApplicationCredentialSecret).To(Equal("app-secret"))
+		})
+
+		It("should fall back to the given auth URL", func() {
+			credentials.AuthURL = ""
+
+			authOpts, err := AuthOptions(credentials, "https://fallback.example.com/v3")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(authOpts.IdentityEndpoint).To(Equal("https://fallback.example.com/v3"))
+		})
+	})
+
+	Describe("#TLSConfig", func() {
+		It("should honour the insecure flag", func() {
+			credentials.Insecure = true
+
+			config, err := TLSConfig(credentials)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(config.InsecureSkipVerify).To(BeTrue())
+			Expect(config.RootCAs).To(BeNil())
+		})
+
+		It("should fail for an invalid CA bundle", func() {
+			credentials.CACert = "invalid"
+
+			_, err := TLSConfig(credentials)
+			Expect(err).To(MatchError(ContainSubstring("failed to load CA Bundle")))
+		})
+	})
+
+	Describe("#NewServiceClient", func() {
+		var provider *gophercloud.ProviderClient
+
+		BeforeEach(func() {
+			provider = &gophercloud.ProviderClient{
+				EndpointLocator: func(opts gophercloud.EndpointOpts) (string, error) {
+					return "https://" + opts.Type + "." + opts.Region + ".example.com/", nil
+				},
+			}
+		})
+
+		It("should select the endpoint of the service in the region", func() {
+			serviceClient, err := NewServiceClient(provider, ServiceTypeNetwork, "eu-1")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(serviceClient.Endpoint).To(HavePrefix("https://network.eu-1.example.com/"))
+			Expect(serviceClient.ProviderClient).To(BeIdenticalTo(provider))
+		})
+
+		It("should use the overridden endpoints instead of the service catalog", func() {
+			OverrideEndpoints(provider, map[string]string{"volumev3": "https://cinder.internal:8776/v3/project-id"})
+
+			serviceClient, err := NewServiceClient(provider, ServiceTypeBlockStorage, "eu-1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(serviceClient.Endpoint).To(Equal("https://cinder.internal:8776/v3/project-id/"))
+
+			serviceClient, err = NewServiceClient(provider, ServiceTypeNetwork, "eu-1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(serviceClient.Endpoint).To(HavePrefix("https://network.eu-1.example.com/"))
+		})
+
+		It("should fail for unsupported service types", func() {
+			_, err := NewServiceClient(provider, "unknown", "eu-1")
+			Expect(err).To(MatchError(ContainSubstring("unsupported service type")))
+		})
+	})
+})
@@ -6,19 +6,30 @@ package openstack
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // Credentials contains the necessary OpenStack credential information.
 type Credentials struct {
+	// the domain is identified by either name or id
 	DomainName string
+	DomainID   string
+	// the tenant (project) is identified by either name or id, it is empty for domain-scoped credentials
 	TenantName string
+	TenantID   string
+
+	// AuthScope is the scope of the requested tokens, either project (default if empty) or domain.
+	AuthScope string
 
 	// either authenticate with username/password credentials
 	Username string
@@ -33,6 +44,10 @@ type Credentials struct {
 	CACert  string
 
 	Insecure bool
+
+	// EndpointOverrides maps the types of OpenStack services to the URLs of the endpoints used instead of the ones in
+	// the service catalog.
+	EndpointOverrides map[string]string
 }
 
 // GetCredentials computes for a given context and infrastructure the corresponding credentials object.
@@ -44,13 +59,190 @@ func GetCredentials(ctx context.Context, c client.Client, secretRef corev1.Secre
 	return ExtractCredentials(secret, allowDNSKeys)
 }
 
+// ShootCredentialsSecretRef returns the reference to the secret containing the credentials the resources of a shoot are
+// managed with. This is the secret of the application credential managed for the shoot unless it is retired or, if it
+// does not exist, the secret of the dedicated project of the shoot if it exists in the namespace of the given cloud
+// provider secret, and the cloud provider secret otherwise.
+func ShootCredentialsSecretRef(ctx context.Context, c client.Client, secretRef corev1.SecretReference) (corev1.SecretReference, error) {
+	for _, name := range []string{ApplicationCredentialSecretName, DedicatedProjectSecretName} {
+		ref := corev1.SecretReference{Name: name, Namespace: secretRef.Namespace}
+		secret, err := extensionscontroller.GetSecretByReference(ctx, c, &ref)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return corev1.SecretReference{}, err
+		}
+		if secret.Annotations[ApplicationCredentialRetiredAnnotation] == "true" {
+			continue
+		}
+		return ref, nil
+	}
+	return secretRef, nil
+}
+
+// GetShootCredentials returns the credentials the resources of a shoot are managed with, see ShootCredentialsSecretRef.
+func GetShootCredentials(ctx context.Context, c client.Client, secretRef corev1.SecretReference) (*Credentials, error) {
+	shootSecretRef, err := ShootCredentialsSecretRef(ctx, c, secretRef)
+	if err != nil {
+		return nil, err
+	}
+	return GetCredentials(ctx, c, shootSecretRef, false)
+}
+
+// GetShootReadOnlyCredentials returns the credentials used for read-only operations on the resources of a shoot, like
+// health checks and quota probes. These are the read-only credentials of the given cloud provider secret, which are
+// scoped to the project of the credentials the resources of the shoot are managed with, see ShootCredentialsSecretRef.
+// If the cloud provider secret does not contain read-only credentials, the credentials the resources of the shoot are
+// managed with are returned.
+func GetShootReadOnlyCredentials(ctx context.Context, c client.Client, secretRef corev1.SecretReference) (*Credentials, error) {
+	secret, err := extensionscontroller.GetSecretByReference(ctx, c, &secretRef)
+	if err != nil {
+		return nil, err
+	}
+	if !HasReadOnlyCredentials(secret) {
+		return GetShootCredentials(ctx, c, secretRef)
+	}
+
+	credentials, err := ExtractReadOnlyCredentials(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	shootSecretRef, err := ShootCredentialsSecretRef(ctx, c, secretRef)
+	if err != nil {
+		return nil, err
+	}
+	if shootSecretRef != secretRef {
+		shootCredentials, err := GetCredentials(ctx, c, shootSecretRef, false)
+		if err != nil {
+			return nil, err
+		}
+		// the credentials the resources of the shoot are managed with are always scoped to a project, so domain-scoped
+		// read-only credentials must be scoped to it as well for the project to take effect
+		credentials.TenantName, credentials.TenantID = shootCredentials.TenantName, shootCredentials.TenantID
+		credentials.AuthScope = AuthScopeProject
+	}
+	return credentials, nil
+}
+
+// readOnlyKeys maps the keys of the credentials of a cloud provider secret to the keys of its read-only credentials.
+var readOnlyKeys = map[string]string{
+	UserName:                    ReadOnlyUserName,
+	Password:                    ReadOnlyPassword,
+	ApplicationCredentialID:     ReadOnlyApplicationCredentialID,
+	ApplicationCredentialName:   ReadOnlyApplicationCredentialName,
+	ApplicationCredentialSecret: ReadOnlyApplicationCredentialSecret,
+}
+
+// HasReadOnlyCredentials returns true if the given cloud provider secret contains read-only credentials.
+func HasReadOnlyCredentials(secret *corev1.Secret) bool {
+	for _, key := range readOnlyKeys {
+		if _, ok := secret.Data[key]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// ExtractReadOnlyCredentials generates a credentials object for the read-only credentials of the given cloud provider
+// secret, which are used for read-only operations like health checks, quota probes and admission validation, so that
+// the credentials of the secret are only used to manage resources. The read-only credentials consist of a user or an
+// application credential and share the domain, project and auth URL with the credentials of the secret. If the secret
+// does not contain read-only credentials, the credentials of the secret are returned.
+func ExtractReadOnlyCredentials(secret *corev1.Secret) (*Credentials, error) {
+	if !HasReadOnlyCredentials(secret) {
+		return ExtractCredentials(secret, false)
+	}
+
+	readOnlySecret := secret.DeepCopy()
+	for key, readOnlyKey := range readOnlyKeys {
+		delete(readOnlySecret.Data, key)
+		if value, ok := secret.Data[readOnlyKey]; ok {
+			readOnlySecret.Data[key] = value
+		}
+	}
+
+	credentials, err := ExtractCredentials(readOnlySecret, false)
+	if err != nil {
+		return nil, fmt.Errorf("invalid read-only credentials: %w", err)
+	}
+	return credentials, nil
+}
+
+// aliasedKeys are the keys of a cloud provider secret which are taken from the secret referenced by its
+// credentialsResourceName if it aliases the credentials of a centrally managed secret.
+var aliasedKeys = []string{
+	DomainName,
+	DomainID,
+	TenantName,
+	TenantID,
+	AuthScope,
+	UserName,
+	Password,
+	ApplicationCredentialID,
+	ApplicationCredentialName,
+	ApplicationCredentialSecret,
+	ReadOnlyUserName,
+	ReadOnlyPassword,
+	ReadOnlyApplicationCredentialID,
+	ReadOnlyApplicationCredentialName,
+	ReadOnlyApplicationCredentialSecret,
+}
+
+// CredentialsResourceNameOf returns the name of the resource of the shoot referencing the secret whose credentials
+// are aliased by the given cloud provider secret and whether the secret aliases credentials at all.
+func CredentialsResourceNameOf(secret *corev1.Secret) (string, bool) {
+	name, ok := secret.Data[CredentialsResourceName]
+	return string(name), ok
+}
+
+// ApplyAliasedCredentials replaces the credentials of the given cloud provider secret with the ones of the given
+// centrally managed secret. Keys not holding credentials, like the credentialsResourceName, are kept.
+func ApplyAliasedCredentials(secret, credentialsSecret *corev1.Secret) {
+	if secret.Data == nil {
+		secret.Data = make(map[string][]byte)
+	}
+	for _, key := range aliasedKeys {
+		delete(secret.Data, key)
+		if value, ok := credentialsSecret.Data[key]; ok {
+			secret.Data[key] = value
+		}
+	}
+}
+
+// ReadCredentialsSecretFromDir reads the credentials from the given directory into a secret, as they are mounted from a
+// secret with OpenStack credentials into the pods of components running outside the extension.
+func ReadCredentialsSecretFromDir(dir string) (*corev1.Secret, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("could not read credentials: %w", err)
+	}
+
+	secret := &corev1.Secret{Data: map[string][]byte{}}
+	for _, entry := range entries {
+		// the keys of a mounted secret are symlinks to the files in a hidden directory
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("could not read credentials: %w", err)
+		}
+		secret.Data[entry.Name()] = data
+	}
+	return secret, nil
+}
+
 // ExtractCredentials generates a credentials object for a given provider secret.
 func ExtractCredentials(secret *corev1.Secret, allowDNSKeys bool) (*Credentials, error) {
-	var altDomainNameKey, altTenantNameKey, altUserNameKey, altPasswordKey, altAuthURLKey, altCABundleKey *string
+	var altDomainNameKey, altDomainIDKey, altTenantNameKey, altTenantIDKey, altUserNameKey, altPasswordKey, altAuthURLKey, altCABundleKey *string
 	var altApplicationCredentialID, altApplicationCredentialName, altApplicationCredentialSecret *string
 	if allowDNSKeys {
 		altDomainNameKey = pointer.String(DNSDomainName)
+		altDomainIDKey = pointer.String(DNSDomainID)
 		altTenantNameKey = pointer.String(DNSTenantName)
+		altTenantIDKey = pointer.String(DNSTenantID)
 		altUserNameKey = pointer.String(DNSUserName)
 		altPasswordKey = pointer.String(DNSPassword)
 		altApplicationCredentialID = pointer.String(DNSApplicationCredentialID)
@@ -63,14 +255,41 @@ func ExtractCredentials(secret *corev1.Secret, allowDNSKeys bool) (*Credentials,
 	if secret.Data == nil {
 		return nil, fmt.Errorf("secret does not contain any data")
 	}
-	domainName, err := getRequired(secret, DomainName, altDomainNameKey)
-	if err != nil {
-		return nil, err
+
+	// project-scoped tokens are requested if no auth scope is given
+	authScope := getOptional(secret, AuthScope, nil)
+	switch authScope {
+	case "", AuthScopeProject, AuthScopeDomain:
+	default:
+		return nil, fmt.Errorf("unsupported value %q for '%s' in secret %s/%s, supported values are %q and %q", authScope, AuthScope, secret.Namespace, secret.Name,
+			AuthScopeProject, AuthScopeDomain)
 	}
-	tenantName, err := getRequired(secret, TenantName, altTenantNameKey)
-	if err != nil {
-		return nil, err
+
+	// the domain id takes precedence over the domain name, the domain name is required if no id is given
+	domainID := getOptional(secret, DomainID, altDomainIDKey)
+	domainName := getOptional(secret, DomainName, altDomainNameKey)
+	if domainID == "" {
+		var err error
+		if domainName, err = getRequired(secret, DomainName, altDomainNameKey); err != nil {
+			return nil, err
+		}
+	}
+
+	// the tenant id takes precedence over the tenant name, names are not unique across domains
+	tenantID := getOptional(secret, TenantID, altTenantIDKey)
+	tenantName := getOptional(secret, TenantName, altTenantNameKey)
+	switch {
+	case authScope == AuthScopeDomain:
+		if tenantID != "" || tenantName != "" {
+			return nil, fmt.Errorf("cannot specify '%s' or '%s' for domain-scoped authentication in secret %s/%s", TenantName, TenantID, secret.Namespace, secret.Name)
+		}
+	case tenantID == "":
+		var err error
+		if tenantName, err = getRequired(secret, TenantName, altTenantNameKey); err != nil {
+			return nil, err
+		}
 	}
+
 	userName := getOptional(secret, UserName, altUserNameKey)
 	password := getOptional(secret, Password, altPasswordKey)
 	applicationCredentialID := getOptional(secret, ApplicationCredentialID, altApplicationCredentialID)
@@ -79,6 +298,13 @@ func ExtractCredentials(secret *corev1.Secret, allowDNSKeys bool) (*Credentials,
 	authURL := getOptional(secret, AuthURL, altAuthURLKey)
 	caCert := getOptional(secret, CACert, altCABundleKey)
 
+	var endpointOverrides map[string]string
+	if value := getOptional(secret, EndpointOverrides, nil); value != "" {
+		if err := json.Unmarshal([]byte(value), &endpointOverrides); err != nil {
+			return nil, fmt.Errorf("'%s' in secret %s/%s must be a JSON object mapping service types to URLs: %w", EndpointOverrides, secret.Namespace, secret.Name, err)
+		}
+	}
+
 	if password != "" {
 		if applicationCredentialSecret != "" {
 			return nil, fmt.Errorf("cannot specify both '%s' and '%s' in secret %s/%s", Password, ApplicationCredentialSecret, secret.Namespace, secret.Name)
@@ -90,6 +316,9 @@ func ExtractCredentials(secret *corev1.Secret, allowDNSKeys bool) (*Credentials,
 		if applicationCredentialSecret == "" {
 			return nil, fmt.Errorf("must either specify '%s' or '%s' in secret %s/%s", Password, ApplicationCredentialSecret, secret.Namespace, secret.Name)
 		}
+		if authScope == AuthScopeDomain {
+			return nil, fmt.Errorf("application credentials cannot be used for domain-scoped authentication in secret %s/%s", secret.Namespace, secret.Name)
+		}
 		if applicationCredentialID == "" {
 			if userName == "" || applicationCredentialName == "" {
 				return nil, fmt.Errorf("'%s' and '%s' are required if application credentials are used without '%s' in secret %s/%s", ApplicationCredentialName, UserName,
@@ -100,7 +329,10 @@ func ExtractCredentials(secret *corev1.Secret, allowDNSKeys bool) (*Credentials,
 
 	return &Credentials{
 		DomainName:                  domainName,
+		DomainID:                    domainID,
 		TenantName:                  tenantName,
+		TenantID:                    tenantID,
+		AuthScope:                   authScope,
 		Username:                    userName,
 		Password:                    password,
 		ApplicationCredentialID:     applicationCredentialID,
@@ -109,9 +341,23 @@ func ExtractCredentials(secret *corev1.Secret, allowDNSKeys bool) (*Credentials,
 		AuthURL:                     authURL,
 		CACert:                      caCert,
 		Insecure:                    strings.ToLower(strings.TrimSpace(string(secret.Data[Insecure]))) == "true",
+		EndpointOverrides:           endpointOverrides,
 	}, nil
 }
 
+// MarshalEndpointOverrides returns the given endpoint overrides as the value of the EndpointOverrides key of a cloud
+// provider secret.
+func MarshalEndpointOverrides(overrides map[string]string) []byte {
+	// encoding a map of strings cannot fail
+	data, _ := json.Marshal(overrides)
+	return data
+}
+
+// IsDomainScoped returns true if the credentials request domain-scoped tokens.
+func (c *Credentials) IsDomainScoped() bool {
+	return c.AuthScope == AuthScopeDomain
+}
+
 // getOptional returns optional value for a corresponding key or empty string
 func getOptional(secret *corev1.Secret, key string, altKey *string) string {
 	if value, ok := secret.Data[key]; ok {
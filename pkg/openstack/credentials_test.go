@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package openstack_test
+
+import (
+	"context"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+)
+
+var _ = Describe("Credentials", func() {
+	const namespace = "shoot--foo--bar"
+
+	var (
+		ctx       = context.Background()
+		c         client.Client
+		secretRef = corev1.SecretReference{Namespace: namespace, Name: "cloudprovider"}
+		secret    *corev1.Secret
+	)
+
+	BeforeEach(func() {
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: secretRef.Name},
+			Data: map[string][]byte{
+				DomainName:       []byte("domain"),
+				AuthScope:        []byte(AuthScopeDomain),
+				UserName:         []byte("user"),
+				Password:         []byte("password"),
+				ReadOnlyUserName: []byte("reader"),
+				ReadOnlyPassword: []byte("reader-password"),
+			},
+		}
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+	})
+
+	Describe("#GetShootReadOnlyCredentials", func() {
+		It("should return the read-only credentials of the cloud provider secret", func() {
+			Expect(c.Create(ctx, secret)).To(Succeed())
+
+			credentials, err := GetShootReadOnlyCredentials(ctx, c, secretRef)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(credentials.Username).To(Equal("reader"))
+			Expect(credentials.Password).To(Equal("reader-password"))
+			Expect(credentials.IsDomainScoped()).To(BeTrue())
+		})
+
+		It("should scope domain-scoped read-only credentials to the project of the shoot credentials", func() {
+			Expect(c.Create(ctx, secret)).To(Succeed())
+			Expect(c.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: DedicatedProjectSecretName},
+				Data: map[string][]byte{
+					DomainName: []byte("domain"),
+					TenantName: []byte("dedicated-project"),
+					TenantID:   []byte("dedicated-project-id"),
+					UserName:   []byte("user"),
+					Password:   []byte("password"),
+				},
+			})).To(Succeed())
+
+			credentials, err := GetShootReadOnlyCredentials(ctx, c, secretRef)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(credentials.Username).To(Equal("reader"))
+			Expect(credentials.TenantName).To(Equal("dedicated-project"))
+			Expect(credentials.TenantID).To(Equal("dedicated-project-id"))
+			Expect(credentials.AuthScope).To(Equal(AuthScopeProject))
+			Expect(credentials.IsDomainScoped()).To(BeFalse())
+		})
+
+		It("should return the shoot credentials if the secret contains no read-only credentials", func() {
+			delete(secret.Data, ReadOnlyUserName)
+			delete(secret.Data, ReadOnlyPassword)
+			Expect(c.Create(ctx, secret)).To(Succeed())
+
+			credentials, err := GetShootReadOnlyCredentials(ctx, c, secretRef)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(credentials.Username).To(Equal("user"))
+		})
+	})
+})
@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package openrc
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/applicationcredentials"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
+
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+)
+
+// UserID returns the id of the user the given provider client is authenticated as.
+func UserID(provider *gophercloud.ProviderClient) (string, error) {
+	result, ok := provider.GetAuthResult().(tokens.CreateResult)
+	if !ok {
+		return "", fmt.Errorf("provider client is not authenticated with a token of the identity API v3")
+	}
+	user, err := result.ExtractUser()
+	if err != nil {
+		return "", err
+	}
+	return user.ID, nil
+}
+
+// IssueApplicationCredential creates a restricted application credential with the given name expiring at the given
+// time for the given user, and returns a copy of the given credentials authenticating with it instead. It is meant to
+// hand out temporary credentials, which do not reveal the password of the technical user of a shoot.
+func IssueApplicationCredential(identity *gophercloud.ServiceClient, userID string, credentials *openstack.Credentials, name string, expiresAt time.Time) (*openstack.Credentials, error) {
+	if credentials.IsDomainScoped() {
+		return nil, fmt.Errorf("application credentials cannot be issued for domain-scoped credentials")
+	}
+
+	appCredential, err := applicationcredentials.Create(identity, userID, applicationcredentials.CreateOpts{
+		Name:        name,
+		Description: "Temporary credentials for debugging the infrastructure of a shoot",
+		ExpiresAt:   &expiresAt,
+	}).Extract()
+	if err != nil {
+		return nil, fmt.Errorf("could not create application credential: %w", err)
+	}
+
+	issued := *credentials
+	issued.Username = ""
+	issued.Password = ""
+	issued.ApplicationCredentialID = appCredential.ID
+	issued.ApplicationCredentialName = ""
+	issued.ApplicationCredentialSecret = appCredential.Secret
+	return &issued, nil
+}
@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package openrc_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fakeclient "github.com/gophercloud/gophercloud/testhelper/client"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/openrc"
+)
+
+var _ = Describe("ApplicationCredential", func() {
+	var (
+		credentials *openstack.Credentials
+		expiresAt   = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	)
+
+	BeforeEach(func() {
+		th.SetupHTTP()
+		DeferCleanup(th.TeardownHTTP)
+
+		credentials = &openstack.Credentials{
+			DomainName: "domain",
+			TenantName: "tenant",
+			Username:   "user",
+			Password:   "password",
+			AuthURL:    "https://keystone/v3",
+		}
+	})
+
+	Describe("#IssueApplicationCredential", func() {
+		It("should create an expiring application credential and return credentials using it", func() {
+			th.Mux.HandleFunc("/users/user-id/application_credentials", func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+				Expect(r.Method).To(Equal(http.MethodPost))
+				body, err := io.ReadAll(r.Body)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(body).To(MatchJSON(`{"application_credential": {
+					"name": "debug",
+					"description": "Temporary credentials for debugging the infrastructure of a shoot",
+					"unrestricted": false,
+					"expires_at": "2024-01-01T12:00:00"
+				}}`))
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusCreated)
+				fmt.Fprint(w, `{"application_credential": {"id": "app-id", "name": "debug", "secret": "app-secret"}}`)
+			})
+
+			issued, err := openrc.IssueApplicationCredential(fakeclient.ServiceClient(), "user-id", credentials, "debug", expiresAt)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(issued).To(Equal(&openstack.Credentials{
+				DomainName:                  "domain",
+				TenantName:                  "tenant",
+				ApplicationCredentialID:     "app-id",
+				ApplicationCredentialSecret: "app-secret",
+				AuthURL:                     "https://keystone/v3",
+			}))
+			Expect(credentials.Password).To(Equal("password"))
+		})
+
+		It("should fail for domain-scoped credentials", func() {
+			credentials.AuthScope = openstack.AuthScopeDomain
+
+			_, err := openrc.IssueApplicationCredential(fakeclient.ServiceClient(), "user-id", credentials, "debug", expiresAt)
+			Expect(err).To(MatchError(ContainSubstring("domain-scoped")))
+		})
+	})
+})
@@ -0,0 +1,202 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package openrc renders the cloud provider credentials of OpenStack shoots as OpenStack RC file or clouds.yaml, e.g.
+// to debug the infrastructure of a shoot with the OpenStack CLI. The RC file uses the same variables as the
+// `gardenctl provider-env` command.
+package openrc
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+)
+
+const (
+	authTypePassword              = "password"
+	authTypeApplicationCredential = "v3applicationcredential"
+)
+
+// Context is the context the credentials are rendered for.
+type Context struct {
+	// Name is the name of the cloud in the clouds.yaml, e.g. the technical id of the shoot.
+	Name string
+	// Credentials are the credentials of the shoot.
+	Credentials *openstack.Credentials
+	// Region is the region of the shoot.
+	Region string
+	// CACertFile is the path of the file containing the CA bundle of the credentials, if any.
+	CACertFile string
+	// InfrastructureStatus is the status of the infrastructure of the shoot. If set, its resources are listed in a
+	// comment.
+	InfrastructureStatus *api.InfrastructureStatus
+}
+
+// RC returns an OpenStack RC file exporting the credentials of the given context, which can be sourced in a shell.
+func RC(c *Context) string {
+	var b strings.Builder
+
+	writeInfrastructureComment(&b, c)
+	for _, v := range c.variables() {
+		fmt.Fprintf(&b, "export %s=%s\n", v.name, shellQuote(v.value))
+	}
+	return b.String()
+}
+
+// CloudsYAML returns a clouds.yaml containing a cloud with the credentials of the given context.
+func CloudsYAML(c *Context) ([]byte, error) {
+	cloud := map[string]interface{}{
+		"auth":                 c.auth(),
+		"auth_type":            c.authType(),
+		"region_name":          c.Region,
+		"interface":            "public",
+		"identity_api_version": 3,
+	}
+	if c.CACertFile != "" {
+		cloud["cacert"] = c.CACertFile
+	}
+	if c.Credentials.Insecure {
+		cloud["verify"] = false
+	}
+
+	data, err := yaml.Marshal(map[string]interface{}{"clouds": map[string]interface{}{c.Name: cloud}})
+	if err != nil {
+		return nil, err
+	}
+
+	var b strings.Builder
+	writeInfrastructureComment(&b, c)
+	b.Write(data)
+	return []byte(b.String()), nil
+}
+
+type variable struct {
+	name, value string
+}
+
+// variables returns the environment variables of the OpenStack CLI for the given context.
+func (c *Context) variables() []variable {
+	vars := []variable{
+		{"OS_IDENTITY_API_VERSION", "3"},
+		{"OS_AUTH_VERSION", "3"},
+		{"OS_AUTH_STRATEGY", "keystone"},
+		{"OS_AUTH_TYPE", c.authType()},
+		{"OS_AUTH_URL", c.Credentials.AuthURL},
+		{"OS_REGION_NAME", c.Region},
+		{"OS_INTERFACE", "public"},
+	}
+
+	auth := c.auth()
+	keys := make([]string, 0, len(auth))
+	for key := range auth {
+		if key != "auth_url" {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		vars = append(vars, variable{"OS_" + strings.ToUpper(key), auth[key]})
+	}
+
+	if c.CACertFile != "" {
+		vars = append(vars, variable{"OS_CACERT", c.CACertFile})
+	}
+	if c.Credentials.Insecure {
+		vars = append(vars, variable{"OS_INSECURE", "true"})
+	}
+	return vars
+}
+
+// auth returns the auth section of a cloud in a clouds.yaml for the given context. The keys match the environment
+// variables of the OpenStack CLI without the `OS_` prefix.
+func (c *Context) auth() map[string]string {
+	var (
+		creds = c.Credentials
+		auth  = map[string]string{"auth_url": creds.AuthURL}
+	)
+
+	if c.authType() == authTypeApplicationCredential {
+		setIfNotEmpty(auth, "application_credential_id", creds.ApplicationCredentialID)
+		setIfNotEmpty(auth, "application_credential_name", creds.ApplicationCredentialName)
+		setIfNotEmpty(auth, "application_credential_secret", creds.ApplicationCredentialSecret)
+		// application credentials are looked up by name for the user of the given domain
+		if creds.ApplicationCredentialID == "" {
+			setIfNotEmpty(auth, "username", creds.Username)
+			setIfNotEmpty(auth, "user_domain_id", creds.DomainID)
+			setIfNotEmpty(auth, "user_domain_name", creds.DomainName)
+		}
+		return auth
+	}
+
+	auth["username"] = creds.Username
+	auth["password"] = creds.Password
+	// ids take precedence over names as names are not unique across domains, only one of them must be passed.
+	domainKey, domain := "domain_name", creds.DomainName
+	if creds.DomainID != "" {
+		domainKey, domain = "domain_id", creds.DomainID
+	}
+	auth["user_"+domainKey] = domain
+	if creds.IsDomainScoped() {
+		auth[domainKey] = domain
+		return auth
+	}
+
+	auth["project_"+domainKey] = domain
+	if creds.TenantID != "" {
+		auth["project_id"] = creds.TenantID
+	} else {
+		auth["project_name"] = creds.TenantName
+	}
+	return auth
+}
+
+func (c *Context) authType() string {
+	if c.Credentials.ApplicationCredentialSecret != "" {
+		return authTypeApplicationCredential
+	}
+	return authTypePassword
+}
+
+// writeInfrastructureComment writes a comment listing the resources of the infrastructure of the given context.
+func writeInfrastructureComment(b *strings.Builder, c *Context) {
+	status := c.InfrastructureStatus
+	if status == nil {
+		return
+	}
+
+	fmt.Fprintf(b, "# Infrastructure of %s in region %s:\n", c.Name, c.Region)
+	fmt.Fprintf(b, "#   network:        %s (%s)\n", status.Networks.Name, status.Networks.ID)
+	for _, subnet := range status.Networks.Subnets {
+		fmt.Fprintf(b, "#   subnet:         %s (%s)\n", subnet.ID, subnet.Purpose)
+	}
+	if status.Networks.Router.ID != "" {
+		fmt.Fprintf(b, "#   router:         %s (%s)\n", status.Networks.Router.ID, status.Networks.Router.IP)
+	}
+	fmt.Fprintf(b, "#   floating pool:  %s (%s)\n", status.Networks.FloatingPool.Name, status.Networks.FloatingPool.ID)
+	for _, group := range status.SecurityGroups {
+		fmt.Fprintf(b, "#   security group: %s (%s)\n", group.Name, group.ID)
+	}
+	if status.Node.KeyName != "" {
+		fmt.Fprintf(b, "#   key pair:       %s\n", status.Node.KeyName)
+	}
+	if status.Networks.ShareNetwork != nil {
+		fmt.Fprintf(b, "#   share network:  %s (%s)\n", status.Networks.ShareNetwork.Name, status.Networks.ShareNetwork.ID)
+	}
+}
+
+func setIfNotEmpty(m map[string]string, key, value string) {
+	if value != "" {
+		m[key] = value
+	}
+}
+
+// shellQuote quotes the given value for POSIX shells.
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'"'"'`) + "'"
+}
@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package openrc_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/openrc"
+)
+
+var _ = Describe("OpenRC", func() {
+	var c *openrc.Context
+
+	BeforeEach(func() {
+		c = &openrc.Context{
+			Name: "shoot--foo--bar",
+			Credentials: &openstack.Credentials{
+				DomainName: "domain",
+				TenantName: "tenant",
+				Username:   "user",
+				Password:   "pass'word",
+				AuthURL:    "https://keystone/v3",
+			},
+			Region: "eu-1",
+		}
+	})
+
+	Describe("#RC", func() {
+		It("should export the password credentials", func() {
+			Expect(openrc.RC(c)).To(Equal(`export OS_IDENTITY_API_VERSION='3'
+export OS_AUTH_VERSION='3'
+export OS_AUTH_STRATEGY='keystone'
+export OS_AUTH_TYPE='password'
+export OS_AUTH_URL='https://keystone/v3'
+export OS_REGION_NAME='eu-1'
+export OS_INTERFACE='public'
+export OS_PASSWORD='pass'"'"'word'
+export OS_PROJECT_DOMAIN_NAME='domain'
+export OS_PROJECT_NAME='tenant'
+export OS_USER_DOMAIN_NAME='domain'
+export OS_USERNAME='user'
+`))
+		})
+
+		It("should export the ids instead of the names if given", func() {
+			c.Credentials.DomainID = "domain-id"
+			c.Credentials.TenantID = "tenant-id"
+
+			rc := openrc.RC(c)
+			Expect(rc).To(ContainSubstring("export OS_PROJECT_DOMAIN_ID='domain-id'\n"))
+			Expect(rc).To(ContainSubstring("export OS_USER_DOMAIN_ID='domain-id'\n"))
+			Expect(rc).To(ContainSubstring("export OS_PROJECT_ID='tenant-id'\n"))
+			Expect(rc).NotTo(ContainSubstring("NAME='domain'"))
+			Expect(rc).NotTo(ContainSubstring("OS_PROJECT_NAME"))
+		})
+
+		It("should export domain-scoped credentials", func() {
+			c.Credentials.AuthScope = openstack.AuthScopeDomain
+			c.Credentials.TenantName = ""
+
+			rc := openrc.RC(c)
+			Expect(rc).To(ContainSubstring("export OS_DOMAIN_NAME='domain'\n"))
+			Expect(rc).NotTo(ContainSubstring("OS_PROJECT"))
+		})
+
+		It("should export application credentials", func() {
+			c.Credentials.Username = ""
+			c.Credentials.Password = ""
+			c.Credentials.ApplicationCredentialID = "app-id"
+			c.Credentials.ApplicationCredentialSecret = "app-secret"
+
+			rc := openrc.RC(c)
+			Expect(rc).To(ContainSubstring("export OS_AUTH_TYPE='v3applicationcredential'\n"))
+			Expect(rc).To(ContainSubstring("export OS_APPLICATION_CREDENTIAL_ID='app-id'\n"))
+			Expect(rc).To(ContainSubstring("export OS_APPLICATION_CREDENTIAL_SECRET='app-secret'\n"))
+			Expect(rc).NotTo(ContainSubstring("OS_PASSWORD"))
+			Expect(rc).NotTo(ContainSubstring("OS_PROJECT"))
+		})
+
+		It("should export the CA bundle and the insecure flag", func() {
+			c.CACertFile = "/tmp/ca.crt"
+			c.Credentials.Insecure = true
+
+			rc := openrc.RC(c)
+			Expect(rc).To(ContainSubstring("export OS_CACERT='/tmp/ca.crt'\n"))
+			Expect(rc).To(ContainSubstring("export OS_INSECURE='true'\n"))
+		})
+
+		It("should list the resources of the infrastructure", func() {
+			c.InfrastructureStatus = &api.InfrastructureStatus{
+				Networks: api.NetworkStatus{
+					ID:           "network-id",
+					Name:         "shoot--foo--bar",
+					FloatingPool: api.FloatingPoolStatus{ID: "fip-id", Name: "fip"},
+					Router:       api.RouterStatus{ID: "router-id", IP: "10.0.0.1"},
+					Subnets:      []api.Subnet{{Purpose: api.PurposeNodes, ID: "subnet-id"}},
+				},
+				Node:           api.NodeStatus{KeyName: "shoot--foo--bar"},
+				SecurityGroups: []api.SecurityGroup{{Purpose: api.PurposeNodes, ID: "sg-id", Name: "shoot--foo--bar"}},
+			}
+
+			Expect(openrc.RC(c)).To(HavePrefix(`# Infrastructure of shoot--foo--bar in region eu-1:
+#   network:        shoot--foo--bar (network-id)
+#   subnet:         subnet-id (nodes)
+#   router:         router-id (10.0.0.1)
+#   floating pool:  fip (fip-id)
+#   security group: shoot--foo--bar (sg-id)
+#   key pair:       shoot--foo--bar
+export OS_IDENTITY_API_VERSION='3'
+`))
+		})
+	})
+
+	Describe("#CloudsYAML", func() {
+		It("should return a clouds.yaml with the credentials", func() {
+			c.CACertFile = "/tmp/ca.crt"
+
+			Expect(openrc.CloudsYAML(c)).To(MatchYAML(`clouds:
+  shoot--foo--bar:
+    auth:
+      auth_url: https://keystone/v3
+      password: pass'word
+      project_domain_name: domain
+      project_name: tenant
+      user_domain_name: domain
+      username: user
+    auth_type: password
+    cacert: /tmp/ca.crt
+    identity_api_version: 3
+    interface: public
+    region_name: eu-1
+`))
+		})
+
+		It("should disable the verification of certificates for insecure credentials", func() {
+			c.Credentials.Insecure = true
+
+			Expect(openrc.CloudsYAML(c)).To(ContainSubstring("verify: false"))
+		})
+	})
+})
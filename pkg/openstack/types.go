@@ -44,13 +44,23 @@ const (
 	CSISnapshotValidationWebhookImageName = "csi-snapshot-validation-webhook"
 	// MachineControllerManagerProviderOpenStackImageName is the name of the MachineControllerManager OpenStack image.
 	MachineControllerManagerProviderOpenStackImageName = "machine-controller-manager-provider-openstack"
+	// NodeLabelerImageName is the name of the openstack-node-labeler image.
+	NodeLabelerImageName = "openstack-node-labeler"
+	// BackupVerifierImageName is the name of the openstack-backup-verifier image.
+	BackupVerifierImageName = "openstack-backup-verifier"
 
 	// AuthURL is a constant for the key in a cloud provider secret that holds the OpenStack auth url.
 	AuthURL = "authURL"
 	// DomainName is a constant for the key in a cloud provider secret that holds the OpenStack domain name.
 	DomainName = "domainName"
+	// DomainID is a constant for the key in a cloud provider secret that holds the OpenStack domain id.
+	DomainID = "domainID"
 	// TenantName is a constant for the key in a cloud provider secret that holds the OpenStack tenant name.
 	TenantName = "tenantName"
+	// TenantID is a constant for the key in a cloud provider secret that holds the OpenStack tenant (project) id.
+	TenantID = "tenantID"
+	// AuthScope is a constant for the key in a cloud provider secret that configures the scope of the requested tokens.
+	AuthScope = "authScope"
 	// UserName is a constant for the key in a cloud provider secret and backup secret that holds the OpenStack username.
 	UserName = "username"
 	// Password is a constant for the key in a cloud provider secret and backup secret that holds the OpenStack password.
@@ -63,17 +73,44 @@ const (
 	ApplicationCredentialSecret = "applicationCredentialSecret"
 	// Region is a constant for the key in a backup secret that holds the Openstack region.
 	Region = "region"
+	// SegmentSize is a constant for the key in a backup secret that holds the size of the segments of large objects in bytes.
+	SegmentSize = "segmentSize"
+	// TempURLKey is a constant for the key in a backup secret that holds the key for temporary URLs of the Swift container.
+	TempURLKey = "tempURLKey"
+	// TempURLTTL is a constant for the key in a backup secret that holds the validity of temporary URLs.
+	TempURLTTL = "tempURLTTL"
 	// Insecure is a constant for the key in a cloud provider secret that configures whether the OpenStack client verifies the server's certificate.
 	Insecure = "insecure"
 	// CACert is a constant for the key in a cloud provider secret that configures the CA bundle used to verify the server's certificate.
 	CACert = "caCert"
+	// EndpointOverrides is a constant for the key in a cloud provider secret that holds a JSON object mapping the types
+	// of OpenStack services to the URLs of the endpoints used instead of the ones in the service catalog.
+	EndpointOverrides = "endpointOverrides"
+	// ReadOnlyUserName is a constant for the key in a cloud provider secret that holds the OpenStack username of the read-only credentials.
+	ReadOnlyUserName = "readOnlyUsername"
+	// ReadOnlyPassword is a constant for the key in a cloud provider secret that holds the OpenStack password of the read-only credentials.
+	ReadOnlyPassword = "readOnlyPassword"
+	// ReadOnlyApplicationCredentialID is a constant for the key in a cloud provider secret that holds the OpenStack application credential id of the read-only credentials.
+	ReadOnlyApplicationCredentialID = "readOnlyApplicationCredentialID"
+	// ReadOnlyApplicationCredentialName is a constant for the key in a cloud provider secret that holds the OpenStack application credential name of the read-only credentials.
+	ReadOnlyApplicationCredentialName = "readOnlyApplicationCredentialName"
+	// ReadOnlyApplicationCredentialSecret is a constant for the key in a cloud provider secret that holds the OpenStack application credential secret of the read-only credentials.
+	ReadOnlyApplicationCredentialSecret = "readOnlyApplicationCredentialSecret"
+	// CredentialsResourceName is a constant for the key in a cloud provider secret that holds the name of a resource of
+	// the shoot referencing a centrally managed secret, whose credentials are used instead of the ones of the cloud
+	// provider secret.
+	CredentialsResourceName = "credentialsResourceName"
 
 	// DNSAuthURL is a constant for the key in a DNS secret that holds the OpenStack auth url.
 	DNSAuthURL = "OS_AUTH_URL"
 	// DNSDomainName is a constant for the key in a DNS secret that holds the OpenStack domain name.
 	DNSDomainName = "OS_DOMAIN_NAME"
+	// DNSDomainID is a constant for the key in a DNS secret that holds the OpenStack domain id.
+	DNSDomainID = "OS_DOMAIN_ID"
 	// DNSTenantName is a constant for the key in a DNS secret that holds the OpenStack tenant name.
 	DNSTenantName = "OS_PROJECT_NAME"
+	// DNSTenantID is a constant for the key in a DNS secret that holds the OpenStack tenant (project) id.
+	DNSTenantID = "OS_PROJECT_ID"
 	// DNSUserName is a constant for the key in a DNS secret that holds the OpenStack username.
 	DNSUserName = "OS_USERNAME"
 	// DNSPassword is a constant for the key in a DNS secret that holds the OpenStack password.
@@ -87,6 +124,17 @@ const (
 	// DNS_CA_Bundle is a constant for the key in a DNS secret that holds the Openstack CA Bundle for the KeyStone server.
 	DNS_CA_Bundle = "OS_CACERT"
 
+	// AuthScopeProject is the auth scope for project-scoped tokens. It is the default auth scope.
+	AuthScopeProject = "project"
+	// AuthScopeDomain is the auth scope for domain-scoped tokens.
+	AuthScopeDomain = "domain"
+
+	// DedicatedProjectSecretName is the name of the secret in the namespace of a shoot containing the credentials of
+	// the technical user of its dedicated project, if the shoot has one.
+	DedicatedProjectSecretName = "cloudprovider-dedicated-project"
+	// ApplicationCredentialSecretName is the name of the secret in the namespace of a shoot containing the application
+	// credential managed for the shoot, if it has one.
+	ApplicationCredentialSecretName = "cloudprovider-application-credential"
 	// CloudProviderConfigName is the name of the secret containing the cloud provider config.
 	CloudProviderConfigName = "cloud-provider-config"
 	// CloudProviderDiskConfigName is the name of the secret containing the cloud provider config for disk/volume handling. It is used by kube-controller-manager.
@@ -131,12 +179,34 @@ const (
 	CSISnapshotValidationName = "csi-snapshot-validation"
 	// CSIStorageProvisioner is a constant with the storage provisioner name which is used in storageclasses.
 	CSIStorageProvisioner = "cinder.csi.openstack.org"
+	// CSIStorageAvailabilityParameter is the storageclass parameter of the Cinder CSI driver pinning the volumes to an
+	// availability zone.
+	CSIStorageAvailabilityParameter = "availability"
+	// CSIStorageTopologyZoneKey is the topology key of the availability zone reported by the Cinder CSI driver.
+	CSIStorageTopologyZoneKey = "topology.cinder.csi.openstack.org/zone"
 	// CSIManilaStorageProvisionerNFS is a constant with the storage provisioner name which is used in storageclasses for Manila NFS.
 	CSIManilaStorageProvisionerNFS = "nfs.manila.csi.openstack.org"
 	// CSIManilaNFS is a constant for CSI Manila NFS resource objects
 	CSIManilaNFS = "csi-manila-nfs"
 	// CSIManilaSecret is a constant for additional role/rolebiding for CSI manila plugin secret
 	CSIManilaSecret = "csi-manila-secret"
+	// NodeLabelerName is a constant for the chart name for the openstack-node-labeler deployment in the seed.
+	NodeLabelerName = "openstack-node-labeler"
+	// BackupVerifierName is a constant for the name of the openstack-backup-verifier cron job verifying the backups in a
+	// backup bucket.
+	BackupVerifierName = "openstack-backup-verifier"
+
+	// LabelNetworkPolicyToOpenStackEndpoints is the label for pods which are allowed to egress to the OpenStack endpoints
+	// if the egress traffic of the control plane components is restricted.
+	LabelNetworkPolicyToOpenStackEndpoints = "networking.gardener.cloud/to-openstack-endpoints"
+
+	// CredentialsChecksumAnnotation is the annotation of the cloudprovider secret in the namespace of a shoot holding
+	// the checksum of the credentials copied into it from the secret referenced by its credentialsResourceName.
+	CredentialsChecksumAnnotation = "openstack.provider.extensions.gardener.cloud/credentials-checksum"
+	// ApplicationCredentialRetiredAnnotation is the annotation of the application credential secret in the namespace of
+	// a shoot marking that the application credential is no longer configured. The secret is no longer used for the
+	// resources of the shoot, and is deleted once no component of the shoot uses the application credential anymore.
+	ApplicationCredentialRetiredAnnotation = "openstack.provider.extensions.gardener.cloud/application-credential-retired"
 
 	// PreserveWorkerHashAnnotation controls whether the providerConfig will be included in the hash calculation for the respective worker pool.
 	// Deprecated: It is only introduced to ease the transition to the new hash calculation.
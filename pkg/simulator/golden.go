@@ -0,0 +1,170 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	// UpdateGoldenFilesEnv is the environment variable which makes VerifyGoldenFiles update the golden files instead of
+	// comparing them if it is set to "true", e.g. `UPDATE_GOLDEN_FILES=true go test ./pkg/simulator/...`.
+	UpdateGoldenFilesEnv = "UPDATE_GOLDEN_FILES"
+
+	// GoldenDirectory is the directory of a test case containing its golden files.
+	GoldenDirectory = "golden"
+
+	// diffContext is the number of unchanged lines shown around the changed lines of a diff.
+	diffContext = 3
+)
+
+// VerifyGoldenFiles simulates the test case in the given directory and compares the output with its golden files. A
+// test case directory contains the manifests shoot.yaml, cloudprofile.yaml and secret.yaml and the golden files in the
+// subdirectory GoldenDirectory, which has the layout of an output directory, see Output.Files. If update is true, the
+// golden files are replaced by the output instead.
+func VerifyGoldenFiles(ctx context.Context, dir string, update bool) error {
+	input, err := ReadInput(filepath.Join(dir, "shoot.yaml"), filepath.Join(dir, "cloudprofile.yaml"), filepath.Join(dir, "secret.yaml"))
+	if err != nil {
+		return err
+	}
+
+	output, err := Simulate(ctx, input)
+	if err != nil {
+		return err
+	}
+
+	goldenDir := filepath.Join(dir, GoldenDirectory)
+	if update {
+		if err := os.RemoveAll(goldenDir); err != nil {
+			return err
+		}
+		return output.WriteToDirectory(goldenDir)
+	}
+	return output.CompareWithDirectory(goldenDir)
+}
+
+// CompareWithDirectory compares the files of the output with the files in the given directory, e.g. with the output
+// written for an earlier version of the extension or of the manifests. It returns an error containing the diffs of all
+// files which differ, as well as the files which are missing in the directory or are not part of the output anymore.
+func (o *Output) CompareWithDirectory(dir string) error {
+	files, err := o.Files()
+	if err != nil {
+		return err
+	}
+
+	existing := map[string]bool{}
+	if err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		name, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		existing[name] = true
+		return nil
+	}); err != nil {
+		return fmt.Errorf("could not read %s: %w", dir, err)
+	}
+
+	var problems []string
+	for _, name := range sortedKeys(files) {
+		if !existing[name] {
+			problems = append(problems, fmt.Sprintf("%s is missing", name))
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(data, files[name]) {
+			problems = append(problems, fmt.Sprintf("%s differs:\n%s", name, diffLines(string(data), string(files[name]))))
+		}
+	}
+	for _, name := range sortedKeys(existing) {
+		if _, ok := files[name]; !ok {
+			problems = append(problems, fmt.Sprintf("%s is not rendered anymore", name))
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("the rendered files differ from %s:\n%s", dir, strings.Join(problems, "\n"))
+	}
+	return nil
+}
+
+// diffLines returns the lines removed from want with prefix "-" and the lines added to got with prefix "+", together
+// with the unchanged lines around them.
+func diffLines(want, got string) string {
+	a, b := strings.Split(want, "\n"), strings.Split(got, "\n")
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var lines []string
+	for i, j := 0, 0; i < len(a) || j < len(b); {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			lines = append(lines, "  "+a[i])
+			i, j = i+1, j+1
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			lines = append(lines, "- "+a[i])
+			i++
+		default:
+			lines = append(lines, "+ "+b[j])
+			j++
+		}
+	}
+
+	// only keep the changed lines and their context
+	var (
+		result  []string
+		lastOut = -1
+	)
+	for k, line := range lines {
+		if strings.HasPrefix(line, "  ") {
+			continue
+		}
+		from := max(k-diffContext, lastOut+1)
+		if lastOut >= 0 && from > lastOut+1 {
+			result = append(result, "  ...")
+		}
+		for c := from; c <= min(k+diffContext, len(lines)-1); c++ {
+			if c > lastOut {
+				result = append(result, lines[c])
+				lastOut = c
+			}
+		}
+	}
+	return strings.Join(result, "\n")
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
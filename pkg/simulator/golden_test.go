@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener-extension-provider-openstack/pkg/simulator"
+)
+
+var _ = Describe("Golden files", func() {
+	var ctx = context.Background()
+
+	testCases, err := os.ReadDir("testdata")
+	if err != nil {
+		panic(err)
+	}
+	for _, testCase := range testCases {
+		dir := filepath.Join("testdata", testCase.Name())
+		It("should render the golden files of test case "+testCase.Name(), func() {
+			Expect(VerifyGoldenFiles(ctx, dir, os.Getenv(UpdateGoldenFilesEnv) == "true")).To(Succeed())
+		})
+	}
+
+	Describe("#CompareWithDirectory", func() {
+		var (
+			output *Output
+			dir    string
+		)
+
+		BeforeEach(func() {
+			input, err := ReadInput(filepath.Join("testdata", "default", "shoot.yaml"), filepath.Join("testdata", "default", "cloudprofile.yaml"),
+				filepath.Join("testdata", "default", "secret.yaml"))
+			Expect(err).NotTo(HaveOccurred())
+			output, err = Simulate(ctx, input)
+			Expect(err).NotTo(HaveOccurred())
+
+			dir = GinkgoT().TempDir()
+			Expect(output.WriteToDirectory(dir)).To(Succeed())
+		})
+
+		It("should succeed if the files are equal", func() {
+			Expect(output.CompareWithDirectory(dir)).To(Succeed())
+		})
+
+		It("should report the diffs of changed files", func() {
+			output.CloudProviderConfigs["cloud-provider-config"] = `[Global]
+auth-url="https://keystone.example.com/v3"
+region="eu-2"
+`
+			Expect(os.WriteFile(filepath.Join(dir, "cloud-provider-config", "cloud-provider-config.conf"), []byte(`[Global]
+auth-url="https://keystone.example.com/v3"
+region="eu-1"
+`), 0o600)).To(Succeed())
+
+			Expect(output.CompareWithDirectory(dir)).To(MatchError(And(
+				ContainSubstring("cloud-provider-config/cloud-provider-config.conf differs"),
+				ContainSubstring(`- region="eu-1"`),
+				ContainSubstring(`+ region="eu-2"`),
+				ContainSubstring(`  auth-url="https://keystone.example.com/v3"`),
+			)))
+		})
+
+		It("should report missing and stale files", func() {
+			Expect(os.Remove(filepath.Join(dir, "machineclasses.yaml"))).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(dir, "stale.yaml"), nil, 0o600)).To(Succeed())
+
+			Expect(output.CompareWithDirectory(dir)).To(MatchError(And(
+				ContainSubstring("machineclasses.yaml is missing"),
+				ContainSubstring("stale.yaml is not rendered anymore"),
+			)))
+		})
+	})
+})
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"fmt"
+	"os"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// DecodeInput decodes the given manifests of the shoot, its cloud profile and the secret containing its OpenStack
+// credentials.
+func DecodeInput(shoot, cloudProfile, secret []byte) (*Input, error) {
+	input := &Input{
+		Shoot:        &gardencorev1beta1.Shoot{},
+		CloudProfile: &gardencorev1beta1.CloudProfile{},
+		Secret:       &corev1.Secret{},
+	}
+
+	for _, m := range []struct {
+		data []byte
+		into runtime.Object
+	}{
+		{shoot, input.Shoot},
+		{cloudProfile, input.CloudProfile},
+		{secret, input.Secret},
+	} {
+		obj, gvk, err := kubernetes.GardenCodec.UniversalDeserializer().Decode(m.data, nil, m.into)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode %T: %w", m.into, err)
+		}
+		if obj != m.into {
+			return nil, fmt.Errorf("expected manifest of %T, got %s", m.into, gvk.Kind)
+		}
+	}
+
+	return input, nil
+}
+
+// ReadInput reads and decodes the manifests of the shoot, its cloud profile and the secret containing its OpenStack
+// credentials from the given files.
+func ReadInput(shootPath, cloudProfilePath, secretPath string) (*Input, error) {
+	var manifests [3][]byte
+	for i, path := range []string{shootPath, cloudProfilePath, secretPath} {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("could not read %s: %w", path, err)
+		}
+		manifests[i] = data
+	}
+	return DecodeInput(manifests[0], manifests[1], manifests[2])
+}
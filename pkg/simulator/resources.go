@@ -0,0 +1,340 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/utils/pointer"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/helper"
+	openstackinstall "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/install"
+	apiv1alpha1 "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+)
+
+// Placeholders for the values which are only known once the infrastructure has been created or the operating system
+// config has been generated.
+const (
+	PlaceholderNetworkID         = "<network-id>"
+	PlaceholderSubnetID          = "<subnet-id>"
+	PlaceholderRouterID          = "<router-id>"
+	PlaceholderRouterIP          = "<router-ip>"
+	PlaceholderFloatingNetworkID = "<floating-network-id>"
+	PlaceholderSecurityGroupID   = "<security-group-id>"
+	PlaceholderServerGroupID     = "<server-group-id>"
+	PlaceholderSSHPublicKey      = "<ssh-public-key>"
+	PlaceholderUserData          = "<user-data>"
+)
+
+// secretName is the name of the cloud provider secret in the shoot namespace of the seed.
+const secretName = v1beta1constants.SecretNameCloudProvider
+
+// resources are the extension resources gardenlet would create in the seed for the simulated shoot.
+type resources struct {
+	scheme *runtime.Scheme
+
+	cluster              *extensionscontroller.Cluster
+	secret               *corev1.Secret
+	infrastructure       *extensionsv1alpha1.Infrastructure
+	infrastructureConfig *api.InfrastructureConfig
+	controlPlane         *extensionsv1alpha1.ControlPlane
+	worker               *extensionsv1alpha1.Worker
+}
+
+func newResources(input *Input) (*resources, error) {
+	if input.Shoot == nil || input.CloudProfile == nil || input.Secret == nil {
+		return nil, fmt.Errorf("a shoot, a cloud profile and a secret are required")
+	}
+	if input.Shoot.Spec.Provider.Type != openstack.Type {
+		return nil, fmt.Errorf("shoot %q has provider type %q, only %q is supported", input.Shoot.Name, input.Shoot.Spec.Provider.Type, openstack.Type)
+	}
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(kubernetes.AddSeedSchemeToScheme(scheme))
+	utilruntime.Must(machinev1alpha1.AddToScheme(scheme))
+	utilruntime.Must(openstackinstall.AddToScheme(scheme))
+
+	shoot := input.Shoot.DeepCopy()
+	kubernetes.GardenScheme.Default(shoot)
+	cloudProfile := input.CloudProfile.DeepCopy()
+	kubernetes.GardenScheme.Default(cloudProfile)
+
+	var (
+		namespace = gardenerutils.ComputeTechnicalID(projectName(shoot.Namespace), shoot)
+		secretRef = corev1.SecretReference{Namespace: namespace, Name: secretName}
+		cluster   = &extensionscontroller.Cluster{
+			ObjectMeta:   metav1.ObjectMeta{Name: namespace},
+			CloudProfile: cloudProfile,
+			Seed:         &gardencorev1beta1.Seed{},
+			Shoot:        shoot,
+		}
+	)
+
+	cloudProfileConfig, err := helper.CloudProfileConfigFromCluster(cluster)
+	if err != nil {
+		return nil, err
+	}
+	if cloudProfileConfig == nil {
+		return nil, fmt.Errorf("cloud profile %q has no provider config", cloudProfile.Name)
+	}
+
+	infrastructureConfig, err := helper.InfrastructureConfigFromRawExtension(shoot.Spec.Provider.InfrastructureConfig)
+	if err != nil {
+		return nil, err
+	}
+	// the CIDR allocated in a shared network is not known offline, the nodes CIDR of the shoot is used instead
+	if shared := infrastructureConfig.Networks.Shared; shared != nil {
+		infrastructureConfig.Networks.ID = &shared.ID
+		if shoot.Spec.Networking != nil && shoot.Spec.Networking.Nodes != nil {
+			infrastructureConfig.Networks.Workers = *shoot.Spec.Networking.Nodes
+		}
+	}
+
+	infrastructureStatus, err := json.Marshal(infrastructureStatus(namespace, infrastructureConfig))
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := cloudProviderSecret(input.Secret, secretRef, cloudProfileConfig, shoot.Spec.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	worker, err := newWorker(shoot, cloudProfile, secretRef, namespace, &runtime.RawExtension{Raw: infrastructureStatus})
+	if err != nil {
+		return nil, err
+	}
+
+	return &resources{
+		scheme:  scheme,
+		cluster: cluster,
+		secret:  secret,
+		infrastructure: &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: shoot.Name},
+			Spec: extensionsv1alpha1.InfrastructureSpec{
+				DefaultSpec:  extensionsv1alpha1.DefaultSpec{Type: openstack.Type, ProviderConfig: shoot.Spec.Provider.InfrastructureConfig},
+				Region:       shoot.Spec.Region,
+				SecretRef:    secretRef,
+				SSHPublicKey: []byte(PlaceholderSSHPublicKey),
+			},
+		},
+		infrastructureConfig: infrastructureConfig,
+		controlPlane: &extensionsv1alpha1.ControlPlane{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: shoot.Name},
+			Spec: extensionsv1alpha1.ControlPlaneSpec{
+				DefaultSpec:                  extensionsv1alpha1.DefaultSpec{Type: openstack.Type, ProviderConfig: shoot.Spec.Provider.ControlPlaneConfig},
+				Region:                       shoot.Spec.Region,
+				SecretRef:                    secretRef,
+				InfrastructureProviderStatus: &runtime.RawExtension{Raw: infrastructureStatus},
+			},
+		},
+		worker: worker,
+	}, nil
+}
+
+// projectName returns the name of the project of the given namespace of the garden cluster.
+func projectName(namespace string) string {
+	if namespace == v1beta1constants.GardenNamespace {
+		return namespace
+	}
+	return strings.TrimPrefix(namespace, v1beta1constants.GardenNamespace+"-")
+}
+
+// infrastructureStatus returns the status of the infrastructure as created by Terraform, using placeholders for the
+// ids of the resources which do not exist yet.
+func infrastructureStatus(namespace string, config *api.InfrastructureConfig) *apiv1alpha1.InfrastructureStatus {
+	var (
+		networkID = PlaceholderNetworkID
+		subnetID  = PlaceholderSubnetID
+		routerID  = PlaceholderRouterID
+	)
+	if config.Networks.ID != nil {
+		networkID = *config.Networks.ID
+	}
+	if config.Networks.SubnetID != nil {
+		subnetID = *config.Networks.SubnetID
+	}
+	if config.Networks.Router != nil {
+		routerID = config.Networks.Router.ID
+	}
+
+	subnets := []apiv1alpha1.Subnet{{Purpose: apiv1alpha1.PurposeNodes, ID: subnetID}}
+	for _, subnet := range config.Networks.Subnets {
+		subnets = append(subnets, apiv1alpha1.Subnet{
+			Purpose: apiv1alpha1.PurposeNodes,
+			ID:      fmt.Sprintf("<subnet-id-%s>", subnet.Name),
+			Name:    pointer.String(subnet.Name),
+		})
+	}
+
+	return &apiv1alpha1.InfrastructureStatus{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+			Kind:       "InfrastructureStatus",
+		},
+		Networks: apiv1alpha1.NetworkStatus{
+			ID:           networkID,
+			Name:         namespace,
+			FloatingPool: apiv1alpha1.FloatingPoolStatus{ID: PlaceholderFloatingNetworkID, Name: config.FloatingPoolName},
+			Router:       apiv1alpha1.RouterStatus{ID: routerID, IP: PlaceholderRouterIP},
+			Subnets:      subnets,
+		},
+		Node:           apiv1alpha1.NodeStatus{KeyName: namespace},
+		SecurityGroups: []apiv1alpha1.SecurityGroup{{Purpose: apiv1alpha1.PurposeNodes, ID: PlaceholderSecurityGroupID, Name: namespace}},
+	}
+}
+
+// cloudProviderSecret returns the cloud provider secret of the shoot namespace, which contains the keystone URL and the
+// endpoint overrides of the region like the secret mutated by the cloudprovider webhook.
+func cloudProviderSecret(in *corev1.Secret, secretRef corev1.SecretReference, cloudProfileConfig *api.CloudProfileConfig, region string) (*corev1.Secret, error) {
+	keyStoneURL, err := helper.FindKeyStoneURL(cloudProfileConfig.KeyStoneURLs, cloudProfileConfig.KeyStoneURL, region)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: secretRef.Namespace, Name: secretRef.Name},
+		Data:       make(map[string][]byte, len(in.Data)+len(in.StringData)),
+	}
+	for key, value := range in.Data {
+		secret.Data[key] = value
+	}
+	for key, value := range in.StringData {
+		secret.Data[key] = []byte(value)
+	}
+
+	secret.Data[openstack.AuthURL] = []byte(keyStoneURL)
+	if caCert := helper.FindKeyStoneCACert(cloudProfileConfig.KeyStoneURLs, cloudProfileConfig.KeyStoneCACert, region); caCert != nil {
+		secret.Data[openstack.CACert] = []byte(*caCert)
+	}
+	delete(secret.Data, openstack.EndpointOverrides)
+	if overrides := helper.FindEndpointOverrides(cloudProfileConfig.EndpointOverrides, region); len(overrides) > 0 {
+		secret.Data[openstack.EndpointOverrides] = openstack.MarshalEndpointOverrides(overrides)
+	}
+	delete(secret.Data, openstack.Insecure)
+	if cloudProfileConfig.KeyStoneForceInsecure {
+		secret.Data[openstack.Insecure] = []byte("true")
+	}
+
+	return secret, nil
+}
+
+// newWorker returns the worker of the given shoot like it is created by gardenlet. Server groups are assumed to exist
+// for all pools requiring them.
+func newWorker(shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile, secretRef corev1.SecretReference, namespace string, infrastructureStatus *runtime.RawExtension) (*extensionsv1alpha1.Worker, error) {
+	var (
+		pools        []extensionsv1alpha1.WorkerPool
+		workerStatus = &apiv1alpha1.WorkerStatus{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+				Kind:       "WorkerStatus",
+			},
+		}
+		nodeLocalDNSEnabled = v1beta1helper.IsNodeLocalDNSEnabled(shoot.Spec.SystemComponents)
+	)
+
+	for _, pool := range shoot.Spec.Provider.Workers {
+		if pool.Machine.Image == nil || pool.Machine.Image.Version == nil {
+			return nil, fmt.Errorf("machine image version of worker pool %q must be set", pool.Name)
+		}
+
+		var volume *extensionsv1alpha1.Volume
+		if pool.Volume != nil {
+			volume = &extensionsv1alpha1.Volume{
+				Name:      pool.Volume.Name,
+				Type:      pool.Volume.Type,
+				Size:      pool.Volume.VolumeSize,
+				Encrypted: pool.Volume.Encrypted,
+			}
+		}
+
+		var nodeTemplate *extensionsv1alpha1.NodeTemplate
+		if machineType := v1beta1helper.FindMachineTypeByName(cloudProfile.Spec.MachineTypes, pool.Machine.Type); machineType != nil {
+			nodeTemplate = &extensionsv1alpha1.NodeTemplate{
+				Capacity: corev1.ResourceList{
+					corev1.ResourceCPU:    machineType.CPU,
+					"gpu":                 machineType.GPU,
+					corev1.ResourceMemory: machineType.Memory,
+				},
+			}
+		}
+
+		kubernetesVersion := shoot.Spec.Kubernetes.Version
+		if pool.Kubernetes != nil && pool.Kubernetes.Version != nil {
+			kubernetesVersion = *pool.Kubernetes.Version
+		}
+
+		workerConfig, err := helper.WorkerConfigFromRawExtension(pool.ProviderConfig)
+		if err != nil {
+			return nil, err
+		}
+		if workerConfig.ServerGroup != nil && workerConfig.ServerGroup.Policy != "" {
+			workerStatus.ServerGroupDependencies = append(workerStatus.ServerGroupDependencies, apiv1alpha1.ServerGroupDependency{
+				PoolName: pool.Name,
+				ID:       PlaceholderServerGroupID,
+				Name:     fmt.Sprintf("%s-%s", namespace, pool.Name),
+			})
+		}
+
+		pools = append(pools, extensionsv1alpha1.WorkerPool{
+			Name:           pool.Name,
+			Minimum:        pool.Minimum,
+			Maximum:        pool.Maximum,
+			MaxSurge:       *pool.MaxSurge,
+			MaxUnavailable: *pool.MaxUnavailable,
+			Annotations:    pool.Annotations,
+			Labels:         gardenerutils.NodeLabelsForWorkerPool(pool, nodeLocalDNSEnabled),
+			Taints:         pool.Taints,
+			MachineType:    pool.Machine.Type,
+			MachineImage: extensionsv1alpha1.MachineImage{
+				Name:    pool.Machine.Image.Name,
+				Version: *pool.Machine.Image.Version,
+			},
+			NodeTemplate:                     nodeTemplate,
+			ProviderConfig:                   pool.ProviderConfig,
+			UserData:                         []byte(PlaceholderUserData),
+			Volume:                           volume,
+			KubernetesVersion:                &kubernetesVersion,
+			Zones:                            pool.Zones,
+			MachineControllerManagerSettings: pool.MachineControllerManagerSettings,
+			Architecture:                     pool.Machine.Architecture,
+		})
+	}
+
+	rawWorkerStatus, err := json.Marshal(workerStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	return &extensionsv1alpha1.Worker{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: shoot.Name},
+		Spec: extensionsv1alpha1.WorkerSpec{
+			DefaultSpec:                  extensionsv1alpha1.DefaultSpec{Type: openstack.Type},
+			Region:                       shoot.Spec.Region,
+			SecretRef:                    secretRef,
+			InfrastructureProviderStatus: infrastructureStatus,
+			Pools:                        pools,
+		},
+		Status: extensionsv1alpha1.WorkerStatus{
+			DefaultStatus: extensionsv1alpha1.DefaultStatus{ProviderStatus: &runtime.RawExtension{Raw: rawWorkerStatus}},
+		},
+	}, nil
+}
@@ -0,0 +1,185 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/chartrenderer"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/tools/record"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener-extension-provider-openstack/charts"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/config"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/controller/controlplane"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/controller/worker"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/internal/infrastructure"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+	openstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
+)
+
+const (
+	// DefaultSeedVersion is the Kubernetes version of the seed the charts are rendered for by default.
+	DefaultSeedVersion = "v1.28.0"
+
+	// ChartConfig is the name of the control plane chart containing the cloud provider configs.
+	ChartConfig = "config"
+	// ChartStorageClasses is the name of the control plane chart containing the storage classes of the shoot.
+	ChartStorageClasses = "storageclasses"
+)
+
+// Input are the manifests a shoot reconciliation is simulated for.
+type Input struct {
+	// Shoot is the shoot to simulate the reconciliation for.
+	Shoot *gardencorev1beta1.Shoot
+	// CloudProfile is the cloud profile referenced by the shoot.
+	CloudProfile *gardencorev1beta1.CloudProfile
+	// Secret is the secret containing the OpenStack credentials of the shoot.
+	Secret *corev1.Secret
+	// SeedVersion is the Kubernetes version of the seed the charts are rendered for. Defaults to DefaultSeedVersion.
+	SeedVersion string
+}
+
+// Output are the resources rendered for a shoot.
+type Output struct {
+	// MachineClasses is the manifest of the machine classes and their secrets.
+	MachineClasses []byte
+	// Terraform are the Terraform files of the infrastructure.
+	Terraform *infrastructure.TerraformFiles
+	// CloudProviderConfigs are the cloud provider configs of the cloud-controller-manager and the CSI driver by the
+	// names of the secrets containing them.
+	CloudProviderConfigs map[string]string
+	// ControlPlaneValues are the values the control plane charts are rendered with by the names of the charts.
+	ControlPlaneValues map[string]map[string]interface{}
+}
+
+// Simulate renders the machine classes, the Terraform files of the infrastructure and the cloud provider config for
+// the given input without talking to a seed or to OpenStack. Values which are only known once the infrastructure has
+// been created (e.g. the ids of the network and the router) are replaced by placeholders, and values which require
+// calls to the OpenStack API (e.g. the node template capacity from the flavor) are omitted.
+func Simulate(ctx context.Context, input *Input) (*Output, error) {
+	r, err := newResources(input)
+	if err != nil {
+		return nil, err
+	}
+
+	terraformFiles, err := infrastructure.RenderTerraformerTemplate(r.infrastructure, r.infrastructureConfig, r.cluster)
+	if err != nil {
+		return nil, fmt.Errorf("could not render Terraform files: %w", err)
+	}
+
+	seedVersion := input.SeedVersion
+	if seedVersion == "" {
+		seedVersion = DefaultSeedVersion
+	}
+	renderer := chartrenderer.NewWithServerVersion(&version.Info{GitVersion: seedVersion})
+
+	machineClasses, err := renderMachineClasses(ctx, r, renderer, seedVersion)
+	if err != nil {
+		return nil, fmt.Errorf("could not render machine classes: %w", err)
+	}
+
+	controlPlaneValues, err := controlPlaneValues(ctx, r)
+	if err != nil {
+		return nil, fmt.Errorf("could not compute control plane values: %w", err)
+	}
+
+	cloudProviderConfigs, err := renderCloudProviderConfigs(r, renderer, controlPlaneValues[ChartConfig])
+	if err != nil {
+		return nil, fmt.Errorf("could not render cloud provider config: %w", err)
+	}
+
+	return &Output{
+		MachineClasses:       machineClasses,
+		Terraform:            terraformFiles,
+		CloudProviderConfigs: cloudProviderConfigs,
+		ControlPlaneValues:   controlPlaneValues,
+	}, nil
+}
+
+func renderMachineClasses(ctx context.Context, r *resources, renderer chartrenderer.Interface, seedVersion string) ([]byte, error) {
+	var (
+		manifest bytes.Buffer
+		c        = fakeclient.NewClientBuilder().WithScheme(r.scheme).WithObjects(r.secret).Build()
+	)
+
+	// The worker delegate is created without OpenStack client, so that it does not look up flavors.
+	delegate, err := worker.NewWorkerDelegate(c, r.scheme, kubernetes.NewChartApplier(renderer, &manifestWriter{w: &manifest}), seedVersion,
+		r.worker, r.cluster, nil, &record.FakeRecorder{}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := delegate.DeployMachineClasses(ctx); err != nil {
+		return nil, err
+	}
+	return manifest.Bytes(), nil
+}
+
+// controlPlaneValues returns the values of the control plane charts which can be computed offline by the names of the
+// charts.
+func controlPlaneValues(ctx context.Context, r *resources) (map[string]map[string]interface{}, error) {
+	var (
+		c = fakeclient.NewClientBuilder().WithScheme(r.scheme).WithObjects(r.secret).Build()
+		// Additional floating pools require to look up their networks in OpenStack.
+		offlineFactory = openstackclient.FactoryFactoryFunc(func(*openstack.Credentials) (openstackclient.Factory, error) {
+			return nil, fmt.Errorf("additional floating pools cannot be simulated as they are looked up in OpenStack")
+		})
+		vp = controlplane.NewValuesProviderForClient(c, r.scheme, offlineFactory, config.EgressRestriction{}, config.ControlPlaneScheduling{})
+	)
+
+	configValues, err := vp.GetConfigChartValues(ctx, r.controlPlane, r.cluster)
+	if err != nil {
+		return nil, err
+	}
+	storageClassesValues, err := vp.GetStorageClassesChartValues(ctx, r.controlPlane, r.cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]map[string]interface{}{
+		ChartConfig:         configValues,
+		ChartStorageClasses: storageClassesValues,
+	}, nil
+}
+
+func renderCloudProviderConfigs(r *resources, renderer chartrenderer.Interface, values map[string]interface{}) (map[string]string, error) {
+	release, err := renderer.RenderEmbeddedFS(charts.InternalChart, filepath.Join(charts.InternalChartsPath, openstack.CloudProviderConfigName),
+		openstack.CloudProviderConfigName, r.controlPlane.Namespace, values)
+	if err != nil {
+		return nil, err
+	}
+
+	configs := map[string]string{}
+	reader := kubernetes.NewManifestReader(release.Manifest())
+	for {
+		obj, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return configs, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if obj == nil {
+			continue
+		}
+
+		secret := &corev1.Secret{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, secret); err != nil {
+			return nil, err
+		}
+		configs[secret.Name] = string(secret.Data[openstack.CloudProviderConfigDataKey])
+	}
+}
@@ -0,0 +1,207 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener-extension-provider-openstack/pkg/simulator"
+)
+
+const (
+	shootManifest = `apiVersion: core.gardener.cloud/v1beta1
+kind: Shoot
+metadata:
+  name: bar
+  namespace: garden-foo
+spec:
+  cloudProfileName: openstack
+  region: eu-1
+  secretBindingName: openstack
+  kubernetes:
+    version: 1.28.2
+  networking:
+    type: calico
+    nodes: 10.250.0.0/16
+    pods: 100.96.0.0/11
+  provider:
+    type: openstack
+    infrastructureConfig:
+      apiVersion: openstack.provider.extensions.gardener.cloud/v1alpha1
+      kind: InfrastructureConfig
+      floatingPoolName: fip
+      networks:
+        workers: 10.250.0.0/16
+    controlPlaneConfig:
+      apiVersion: openstack.provider.extensions.gardener.cloud/v1alpha1
+      kind: ControlPlaneConfig
+      loadBalancerProvider: amphora
+    workers:
+    - name: worker
+      minimum: 2
+      maximum: 4
+      machine:
+        type: m1.large
+        image:
+          name: gardenlinux
+          version: 1.0.0
+      zones:
+      - eu-1a
+      - eu-1b
+      providerConfig:
+        apiVersion: openstack.provider.extensions.gardener.cloud/v1alpha1
+        kind: WorkerConfig
+        serverGroup:
+          policy: soft-anti-affinity
+`
+	cloudProfileManifest = `apiVersion: core.gardener.cloud/v1beta1
+kind: CloudProfile
+metadata:
+  name: openstack
+spec:
+  type: openstack
+  kubernetes:
+    versions:
+    - version: 1.28.2
+  machineImages:
+  - name: gardenlinux
+    versions:
+    - version: 1.0.0
+  machineTypes:
+  - name: m1.large
+    cpu: "4"
+    gpu: "0"
+    memory: 16Gi
+    usable: true
+  regions:
+  - name: eu-1
+    zones:
+    - name: eu-1a
+    - name: eu-1b
+  providerConfig:
+    apiVersion: openstack.provider.extensions.gardener.cloud/v1alpha1
+    kind: CloudProfileConfig
+    keystoneURL: https://keystone.example.com/v3
+    dnsServers:
+    - 10.0.0.53
+    constraints:
+      floatingPools:
+      - name: fip
+      loadBalancerProviders:
+      - name: amphora
+    machineImages:
+    - name: gardenlinux
+      versions:
+      - version: 1.0.0
+        image: gardenlinux-1.0.0
+`
+	secretManifest = `apiVersion: v1
+kind: Secret
+metadata:
+  name: openstack
+  namespace: garden-foo
+stringData:
+  domainName: domain
+  tenantName: tenant
+  username: user
+  password: secret-password
+`
+)
+
+var _ = Describe("Simulator", func() {
+	var (
+		ctx   = context.Background()
+		input *Input
+	)
+
+	BeforeEach(func() {
+		var err error
+		input, err = DecodeInput([]byte(shootManifest), []byte(cloudProfileManifest), []byte(secretManifest))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("#DecodeInput", func() {
+		It("should reject manifests of the wrong kind", func() {
+			_, err := DecodeInput([]byte(cloudProfileManifest), []byte(cloudProfileManifest), []byte(secretManifest))
+			Expect(err).To(MatchError(ContainSubstring("expected manifest of *v1beta1.Shoot, got CloudProfile")))
+		})
+	})
+
+	Describe("#Simulate", func() {
+		It("should render the machine classes", func() {
+			output, err := Simulate(ctx, input)
+			Expect(err).NotTo(HaveOccurred())
+
+			machineClasses := string(output.MachineClasses)
+			Expect(machineClasses).To(ContainSubstring("kind: MachineClass"))
+			Expect(machineClasses).To(ContainSubstring("namespace: shoot--foo--bar"))
+			Expect(machineClasses).To(ContainSubstring("availabilityZone: eu-1a"))
+			Expect(machineClasses).To(ContainSubstring("availabilityZone: eu-1b"))
+			Expect(machineClasses).To(ContainSubstring("imageName: gardenlinux-1.0.0"))
+			Expect(machineClasses).To(ContainSubstring("flavorName: m1.large"))
+			Expect(machineClasses).To(ContainSubstring("networkID: " + PlaceholderNetworkID))
+			Expect(machineClasses).To(ContainSubstring("serverGroupID: " + PlaceholderServerGroupID))
+		})
+
+		It("should render the Terraform files", func() {
+			output, err := Simulate(ctx, input)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(output.Terraform.Main).To(ContainSubstring(`auth_url    = "https://keystone.example.com/v3"`))
+			Expect(output.Terraform.Main).To(ContainSubstring(`cidr            = "10.250.0.0/16"`))
+			Expect(output.Terraform.Variables).NotTo(BeEmpty())
+		})
+
+		It("should render the cloud provider config", func() {
+			output, err := Simulate(ctx, input)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(output.CloudProviderConfigs).To(HaveKey("cloud-provider-config"))
+			Expect(output.CloudProviderConfigs).To(HaveKey("cloud-provider-disk-config"))
+			Expect(output.CloudProviderConfigs["cloud-provider-config"]).To(And(
+				ContainSubstring(`auth-url="https://keystone.example.com/v3"`),
+				ContainSubstring(`region="eu-1"`),
+				ContainSubstring(`lb-provider="amphora"`),
+				ContainSubstring(`subnet-id="`+PlaceholderSubnetID+`"`),
+			))
+		})
+
+		It("should fail for shoots of other providers", func() {
+			input.Shoot.Spec.Provider.Type = "aws"
+
+			_, err := Simulate(ctx, input)
+			Expect(err).To(MatchError(ContainSubstring(`only "openstack" is supported`)))
+		})
+
+		It("should fail for worker pools without machine image version", func() {
+			input.Shoot.Spec.Provider.Workers[0].Machine.Image.Version = nil
+
+			_, err := Simulate(ctx, input)
+			Expect(err).To(MatchError(ContainSubstring(`machine image version of worker pool "worker" must be set`)))
+		})
+	})
+
+	Describe("#WriteToDirectory", func() {
+		It("should write the output files", func() {
+			output, err := Simulate(ctx, input)
+			Expect(err).NotTo(HaveOccurred())
+
+			dir := GinkgoT().TempDir()
+			Expect(output.WriteToDirectory(dir)).To(Succeed())
+
+			for _, name := range []string{"machineclasses.yaml", "cloud-provider-config/cloud-provider-config.conf", "terraform/main.tf", "terraform/variables.tf", "terraform/terraform.tfvars"} {
+				Expect(filepath.Join(dir, name)).To(BeAnExistingFile())
+			}
+			machineClasses, err := os.ReadFile(filepath.Join(dir, "machineclasses.yaml"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(machineClasses).To(Equal(output.MachineClasses))
+		})
+	})
+})
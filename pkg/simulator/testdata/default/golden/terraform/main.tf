@@ -0,0 +1,180 @@
+terraform {
+  required_providers {
+    openstack = {
+      source  = "terraform-provider-openstack/openstack"
+    }
+  }
+}
+
+provider "openstack" {
+  auth_url    = "https://keystone.example.com/v3"
+  domain_name = var.DOMAIN_NAME
+  domain_id   = var.DOMAIN_ID
+  tenant_name = var.TENANT_NAME
+  tenant_id   = var.TENANT_ID
+  region      = "eu-1"
+  user_name   = var.USER_NAME
+  password    = var.PASSWORD
+  application_credential_id     = var.APPLICATION_CREDENTIAL_ID
+  application_credential_name   = var.APPLICATION_CREDENTIAL_NAME
+  application_credential_secret = var.APPLICATION_CREDENTIAL_SECRET
+  max_retries = "10"
+  endpoint_overrides = var.ENDPOINT_OVERRIDES
+
+
+}
+
+//=====================================================================
+//= Networking: Router/Interfaces/Net/SubNet/SecGroup/SecRules
+//=====================================================================
+
+data "openstack_networking_network_v2" "fip" {
+  name = "fip"
+}
+
+
+
+resource "openstack_networking_router_v2" "router" {
+  name                = "shoot--foo--bar"
+  region              = "eu-1"
+  external_network_id = data.openstack_networking_network_v2.fip.id
+  
+  
+}
+
+
+resource "openstack_networking_network_v2" "cluster" {
+  name           = "shoot--foo--bar"
+  admin_state_up = "true"
+}
+
+
+resource "openstack_networking_subnet_v2" "cluster" {
+  name            = "shoot--foo--bar"
+  cidr            = "10.250.0.0/16"
+  network_id      = openstack_networking_network_v2.cluster.id
+
+  ip_version      = 4
+  dns_nameservers = ["10.0.0.53"]
+}
+
+resource "openstack_networking_router_interface_v2" "router_nodes" {
+  router_id = openstack_networking_router_v2.router.id
+  subnet_id = openstack_networking_subnet_v2.cluster.id
+}
+
+resource "openstack_networking_secgroup_v2" "cluster" {
+  name                 = "shoot--foo--bar"
+  description          = "Cluster Nodes"
+  delete_default_rules = true
+}
+
+resource "openstack_networking_secgroup_rule_v2" "cluster_self" {
+  direction         = "ingress"
+  description       = "IPv4: allow all incoming traffic within the same security group"
+  ethertype         = "IPv4"
+  security_group_id = openstack_networking_secgroup_v2.cluster.id
+  remote_group_id   = openstack_networking_secgroup_v2.cluster.id
+}
+
+resource "openstack_networking_secgroup_rule_v2" "cluster_egress" {
+  direction         = "egress"
+  description       = "IPv4: allow all outgoing traffic"
+  ethertype         = "IPv4"
+  security_group_id = openstack_networking_secgroup_v2.cluster.id
+}
+
+resource "openstack_networking_secgroup_rule_v2" "cluster_tcp_all" {
+  direction         = "ingress"
+  description       = "IPv4: allow all incoming tcp traffic with port range 30000-32767"
+  ethertype         = "IPv4"
+  protocol          = "tcp"
+  remote_ip_prefix  = "0.0.0.0/0"
+  port_range_min    = 30000
+  port_range_max    = 32767
+  security_group_id = openstack_networking_secgroup_v2.cluster.id
+}
+
+resource "openstack_networking_secgroup_rule_v2" "cluster_udp_all" {
+  direction         = "ingress"
+  description       = "IPv4: allow all incoming udp traffic with port range 30000-32767"
+  ethertype         = "IPv4"
+  protocol          = "udp"
+  remote_ip_prefix  = "0.0.0.0/0"
+  port_range_min    = 30000
+  port_range_max    = 32767
+  security_group_id = openstack_networking_secgroup_v2.cluster.id
+}
+
+
+
+//=====================================================================
+//= SSH Key for Nodes (Bastion and Worker)
+//=====================================================================
+
+resource "openstack_compute_keypair_v2" "ssh_key" {
+  name       = "shoot--foo--bar"
+  public_key = "<ssh-public-key>"
+}
+
+// We have introduced new output variables. However, they are not applied for
+// existing clusters as Terraform won't detect a diff when we run `terraform plan`.
+// Workaround: Providing a null-resource for letting Terraform think that there are
+// differences, enabling the Gardener to start an actual `terraform apply` job.
+// The trigger changes with the schema version of the outputs.
+resource "null_resource" "outputs" {
+  triggers = {
+    recompute = "outputs-v1"
+  }
+}
+
+//=====================================================================
+//= Output Variables
+//=====================================================================
+
+output "router_id" {
+  value = openstack_networking_router_v2.router.id
+}
+
+output "router_ip" {
+  value = openstack_networking_router_v2.router.external_fixed_ip[0].ip_address
+
+}
+
+output "network_id" {
+  value = openstack_networking_network_v2.cluster.id
+
+}
+
+output "network_name" {
+  value = openstack_networking_network_v2.cluster.name
+
+}
+
+output "key_name" {
+  value = openstack_compute_keypair_v2.ssh_key.name
+}
+
+output "security_group_id" {
+  value = openstack_networking_secgroup_v2.cluster.id
+}
+
+output "security_group_name" {
+  value = openstack_networking_secgroup_v2.cluster.name
+}
+
+output "floating_network_id" {
+  value = data.openstack_networking_network_v2.fip.id
+}
+
+output "subnet_id" {
+  value = openstack_networking_subnet_v2.cluster.id
+}
+
+output "output_schema_version" {
+  value = "1"
+}
+
+
+
+// Helpers
\ No newline at end of file
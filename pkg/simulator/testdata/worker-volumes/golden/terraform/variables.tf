@@ -0,0 +1,65 @@
+variable "DOMAIN_NAME" {
+  description = "OpenStack domain name"
+  type        = string
+  default     = "" # not needed if DOMAIN_ID is given
+}
+
+variable "DOMAIN_ID" {
+  description = "OpenStack domain id"
+  type        = string
+  default     = "" # not needed if DOMAIN_NAME is given
+}
+
+variable "TENANT_NAME" {
+  description = "OpenStack project name"
+  type        = string
+  default     = "" # not needed if TENANT_ID is given
+}
+
+variable "TENANT_ID" {
+  description = "OpenStack project id"
+  type        = string
+  default     = "" # not needed if TENANT_NAME is given
+}
+
+variable "USER_NAME" {
+  description = "OpenStack user name"
+  type        = string
+  default     = "" # not needed if application credentials are used with APPLICATION_CREDENTIAL_ID
+}
+
+variable "PASSWORD" {
+  description = "OpenStack password"
+  type        = string
+  default     = "" # not needed if application credentials are used
+}
+
+variable "APPLICATION_CREDENTIAL_ID" {
+  description = "OpenStack application credential id"
+  type        = string
+  default     = "" # not needed if username/password are used
+}
+
+variable "APPLICATION_CREDENTIAL_NAME" {
+  description = "OpenStack application credential name"
+  type        = string
+  default     = "" # not needed if username/password are used or APPLICATION_CREDENTIAL_ID is given
+}
+
+variable "APPLICATION_CREDENTIAL_SECRET" {
+  description = "OpenStack application credential secret"
+  type        = string
+  default     = "" # not needed if username/password are used
+}
+
+variable "CA_CERT" {
+  description = "OpenStack Keystone CA bundle"
+  type        = string
+  default     = "" # not needed if username/password are used
+}
+
+variable "ENDPOINT_OVERRIDES" {
+  description = "OpenStack endpoints used instead of the ones in the service catalog, mapped by service type"
+  type        = map(string)
+  default     = {}
+}
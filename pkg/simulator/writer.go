@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"sigs.k8s.io/yaml"
+)
+
+// manifestWriter is a kubernetes.Applier writing the manifests to be applied to the given writer instead of applying
+// them to a cluster.
+type manifestWriter struct {
+	w io.Writer
+}
+
+var _ kubernetes.Applier = &manifestWriter{}
+
+func (m *manifestWriter) ApplyManifest(_ context.Context, r kubernetes.UnstructuredReader, _ kubernetes.MergeFuncs) error {
+	for {
+		obj, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if obj == nil {
+			continue
+		}
+
+		data, err := yaml.Marshal(obj.Object)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(m.w, "---\n%s", data); err != nil {
+			return err
+		}
+	}
+}
+
+func (m *manifestWriter) DeleteManifest(context.Context, kubernetes.UnstructuredReader, ...kubernetes.DeleteManifestOption) error {
+	return fmt.Errorf("deleting manifests is not supported")
+}
+
+// Files returns the files of the output by their paths relative to an output directory:
+//   - machineclasses.yaml contains the machine classes and their secrets,
+//   - terraform/ contains the Terraform files of the infrastructure,
+//   - cloud-provider-config/ contains the cloud provider configs named after their secrets, and
+//   - controlplane/ contains the values of the control plane charts named after the charts.
+func (o *Output) Files() (map[string][]byte, error) {
+	files := map[string][]byte{
+		"machineclasses.yaml":                          o.MachineClasses,
+		filepath.Join("terraform", "main.tf"):          []byte(o.Terraform.Main),
+		filepath.Join("terraform", "variables.tf"):     []byte(o.Terraform.Variables),
+		filepath.Join("terraform", "terraform.tfvars"): o.Terraform.TFVars,
+	}
+	for name, config := range o.CloudProviderConfigs {
+		files[filepath.Join("cloud-provider-config", name+".conf")] = []byte(config)
+	}
+	for chart, values := range o.ControlPlaneValues {
+		data, err := yaml.Marshal(values)
+		if err != nil {
+			return nil, fmt.Errorf("could not marshal the values of chart %q: %w", chart, err)
+		}
+		files[filepath.Join("controlplane", chart+"-values.yaml")] = data
+	}
+	return files, nil
+}
+
+// WriteToDirectory writes the files of the output to the given directory, see Files.
+func (o *Output) WriteToDirectory(dir string) error {
+	files, err := o.Files()
+	if err != nil {
+		return err
+	}
+
+	for name, data := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, data, 0o600); err != nil {
+			return fmt.Errorf("could not write %s: %w", path, err)
+		}
+	}
+	return nil
+}
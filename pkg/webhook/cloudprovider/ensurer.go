@@ -8,12 +8,17 @@ import (
 	"context"
 	"fmt"
 
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/webhook/cloudprovider"
 	gcontext "github.com/gardener/gardener/extensions/pkg/webhook/context"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/utils"
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
@@ -24,12 +29,14 @@ import (
 // NewEnsurer creates cloudprovider ensurer.
 func NewEnsurer(mgr manager.Manager, logger logr.Logger) cloudprovider.Ensurer {
 	return &ensurer{
+		client:  mgr.GetClient(),
 		decoder: serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
 		logger:  logger,
 	}
 }
 
 type ensurer struct {
+	client  client.Client
 	logger  logr.Logger
 	decoder runtime.Decoder
 }
@@ -44,6 +51,14 @@ func (e *ensurer) EnsureCloudProviderSecret(
 		return fmt.Errorf("could not get cluster object from ensurer context: %v", err)
 	}
 
+	if err := e.ensureAliasedCredentials(ctx, cluster, new); err != nil {
+		return err
+	}
+
+	// endpoint overrides are only accepted from the CloudProfile, as the clients of the extension would send their tokens
+	// to any endpoint configured by the user
+	delete(new.Data, types.EndpointOverrides)
+
 	// do not do anything if the providerConfig is missing from cluster
 	if cluster.CloudProfile.Spec.ProviderConfig == nil {
 		return nil
@@ -72,6 +87,10 @@ func (e *ensurer) EnsureCloudProviderSecret(
 		new.Data[types.CACert] = []byte(*keyStoneCABundle)
 	}
 
+	if overrides := helper.FindEndpointOverrides(config.EndpointOverrides, cluster.Shoot.Spec.Region); len(overrides) > 0 {
+		new.Data[types.EndpointOverrides] = types.MarshalEndpointOverrides(overrides)
+	}
+
 	// remove key from user
 	delete(new.Data, types.Insecure)
 	if config.KeyStoneForceInsecure {
@@ -79,3 +98,33 @@ func (e *ensurer) EnsureCloudProviderSecret(
 	}
 	return nil
 }
+
+// ensureAliasedCredentials copies the credentials of the centrally managed secret referenced by the resource of the
+// shoot named in the given cloudprovider secret into it, so that they are used transparently by all controllers and
+// control plane components. The checksum of the copied credentials is recorded in an annotation to detect changes.
+func (e *ensurer) ensureAliasedCredentials(ctx context.Context, cluster *extensionscontroller.Cluster, secret *corev1.Secret) error {
+	resourceName, ok := types.CredentialsResourceNameOf(secret)
+	if !ok {
+		return nil
+	}
+
+	if cluster.Shoot == nil {
+		return fmt.Errorf("missing shoot in cluster to resolve resource %q of the aliased credentials", resourceName)
+	}
+	resource := v1beta1helper.GetResourceByName(cluster.Shoot.Spec.Resources, resourceName)
+	if resource == nil {
+		return fmt.Errorf("resource %q of the aliased credentials not found in the resources of the shoot", resourceName)
+	}
+	if resource.ResourceRef.Kind != "Secret" {
+		return fmt.Errorf("resource %q of the aliased credentials is not a Secret", resourceName)
+	}
+
+	credentialsSecret := &corev1.Secret{}
+	if err := extensionscontroller.GetObjectByReference(ctx, e.client, &resource.ResourceRef, secret.Namespace, credentialsSecret); err != nil {
+		return fmt.Errorf("could not get secret of the aliased credentials: %w", err)
+	}
+
+	types.ApplyAliasedCredentials(secret, credentialsSecret)
+	metav1.SetMetaDataAnnotation(&secret.ObjectMeta, types.CredentialsChecksumAnnotation, utils.ComputeSecretChecksum(credentialsSecret.Data))
+	return nil
+}
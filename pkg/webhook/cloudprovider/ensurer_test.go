@@ -12,14 +12,18 @@ import (
 	"github.com/gardener/gardener/extensions/pkg/webhook/cloudprovider"
 	gcontext "github.com/gardener/gardener/extensions/pkg/webhook/context"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
 	mockmanager "github.com/gardener/gardener/pkg/mock/controller-runtime/manager"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/install"
 	openstackv1alpha1 "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/v1alpha1"
@@ -39,8 +43,9 @@ var _ = Describe("Ensurer", func() {
 		scheme  *runtime.Scheme
 		cluster *extensionscontroller.Cluster
 
-		ctrl *gomock.Controller
-		mgr  *mockmanager.MockManager
+		ctrl       *gomock.Controller
+		mgr        *mockmanager.MockManager
+		seedClient client.Client
 
 		authUrl = "foo://bar"
 	)
@@ -52,6 +57,8 @@ var _ = Describe("Ensurer", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mgr = mockmanager.NewMockManager(ctrl)
 		mgr.EXPECT().GetScheme().Return(scheme)
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		mgr.EXPECT().GetClient().Return(seedClient)
 
 		cluster = &extensionscontroller.Cluster{
 			CloudProfile: &gardencorev1beta1.CloudProfile{
@@ -139,6 +146,98 @@ var _ = Describe("Ensurer", func() {
 		Expect(err).NotTo(HaveOccurred())
 		Expect(newSecret.Data[types.CACert]).To(Equal([]byte("cert")))
 	})
+
+	It("Should replace the endpoint overrides of the secret with the ones of the CloudProfile", func() {
+		newSecret := &corev1.Secret{
+			Data: map[string][]byte{
+				types.EndpointOverrides: []byte(`{"load-balancer":"https://octavia.user","volumev3":"https://cinder.user"}`),
+			},
+		}
+		cluster.Shoot.Spec.Region = "eu-1"
+		cluster.CloudProfile.Spec.ProviderConfig = encodeCloudProfileConfig(&openstackv1alpha1.CloudProfileConfig{
+			KeyStoneURL: authUrl,
+			EndpointOverrides: []openstackv1alpha1.EndpointOverride{
+				{Service: "volumev3", URL: "https://cinder.internal"},
+				{Region: pointer.String("eu-2"), Service: "compute", URL: "https://nova.internal"},
+			},
+		})
+
+		err := ensurer.EnsureCloudProviderSecret(ctx, ectx, newSecret, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(newSecret.Data[types.EndpointOverrides])).To(Equal(`{"volumev3":"https://cinder.internal"}`))
+	})
+
+	It("Should remove the endpoint overrides of the secret if the CloudProfile has none", func() {
+		newSecret := &corev1.Secret{
+			Data: map[string][]byte{
+				types.EndpointOverrides: []byte(`{"volumev3": "https://cinder.user"}`),
+			},
+		}
+
+		err := ensurer.EnsureCloudProviderSecret(ctx, ectx, newSecret, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(newSecret.Data).NotTo(HaveKey(types.EndpointOverrides))
+	})
+
+	Context("aliased credentials", func() {
+		const namespace = "shoot--foo--bar"
+
+		BeforeEach(func() {
+			cluster.Shoot.Spec.Resources = []gardencorev1beta1.NamedResourceReference{{
+				Name: "shared-credentials",
+				ResourceRef: autoscalingv1.CrossVersionObjectReference{
+					APIVersion: "v1",
+					Kind:       "Secret",
+					Name:       "openstack-credentials",
+				},
+			}}
+			Expect(seedClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "ref-openstack-credentials"},
+				Data: map[string][]byte{
+					types.DomainName:                  []byte("domain"),
+					types.TenantName:                  []byte("tenant"),
+					types.ApplicationCredentialID:     []byte("app-id"),
+					types.ApplicationCredentialSecret: []byte("app-secret"),
+				},
+			})).To(Succeed())
+		})
+
+		It("Should replace the credentials of the secret with the ones of the referenced secret", func() {
+			newSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "cloudprovider"},
+				Data: map[string][]byte{
+					types.CredentialsResourceName: []byte("shared-credentials"),
+					types.UserName:                []byte("stale-user"),
+					types.Password:                []byte("stale-password"),
+					types.EndpointOverrides:       []byte(`{"volumev3": "https://cinder.user"}`),
+				},
+			}
+
+			err := ensurer.EnsureCloudProviderSecret(ctx, ectx, newSecret, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(newSecret.Data).To(Equal(map[string][]byte{
+				types.CredentialsResourceName:     []byte("shared-credentials"),
+				types.DomainName:                  []byte("domain"),
+				types.TenantName:                  []byte("tenant"),
+				types.ApplicationCredentialID:     []byte("app-id"),
+				types.ApplicationCredentialSecret: []byte("app-secret"),
+				types.AuthURL:                     []byte(authUrl),
+			}))
+			Expect(newSecret.Annotations).To(HaveKeyWithValue(types.CredentialsChecksumAnnotation, Not(BeEmpty())))
+		})
+
+		It("Should return an error if the resource is not found in the shoot", func() {
+			newSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "cloudprovider"},
+				Data: map[string][]byte{
+					types.CredentialsResourceName: []byte("unknown"),
+				},
+			}
+
+			err := ensurer.EnsureCloudProviderSecret(ctx, ectx, newSecret, nil)
+			Expect(err).To(MatchError(ContainSubstring(`resource "unknown" of the aliased credentials not found`)))
+		})
+	})
 })
 
 func encodeCloudProfileConfig(config *openstackv1alpha1.CloudProfileConfig) *runtime.RawExtension {
@@ -16,13 +16,25 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/config"
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
 )
 
 var (
 	logger = log.Log.WithName("openstack-controlplane-webhook")
+
+	// DefaultAddOptions are the default AddOptions for AddToManager.
+	DefaultAddOptions = AddOptions{}
 )
 
+// AddOptions are options to apply when adding the OpenStack controlplane webhook to the manager.
+type AddOptions struct {
+	// EgressRestriction is the config for restricting the egress traffic of the control plane components.
+	EgressRestriction config.EgressRestriction
+	// ControlPlaneScheduling is the config for the scheduling of the control plane components.
+	ControlPlaneScheduling config.ControlPlaneScheduling
+}
+
 // AddToManager creates a webhook and adds it to the manager.
 func AddToManager(mgr manager.Manager) (*extensionswebhook.Webhook, error) {
 	logger.Info("Adding webhook to manager")
@@ -35,7 +47,7 @@ func AddToManager(mgr manager.Manager) (*extensionswebhook.Webhook, error) {
 			{Obj: &vpaautoscalingv1.VerticalPodAutoscaler{}},
 			{Obj: &extensionsv1alpha1.OperatingSystemConfig{}},
 		},
-		Mutator: genericmutator.NewMutator(mgr, NewEnsurer(logger), oscutils.NewUnitSerializer(),
+		Mutator: genericmutator.NewMutator(mgr, NewEnsurer(logger, DefaultAddOptions.EgressRestriction.Enabled, DefaultAddOptions.ControlPlaneScheduling), oscutils.NewUnitSerializer(),
 			kubelet.NewConfigCodec(fciCodec), fciCodec, logger),
 	})
 }
@@ -29,21 +29,26 @@ import (
 	"k8s.io/utils/pointer"
 
 	"github.com/gardener/gardener-extension-provider-openstack/imagevector"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/config"
 	apisopenstack "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/helper"
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
 )
 
 // NewEnsurer creates a new controlplane ensurer.
-func NewEnsurer(logger logr.Logger) genericmutator.Ensurer {
+func NewEnsurer(logger logr.Logger, egressRestricted bool, scheduling config.ControlPlaneScheduling) genericmutator.Ensurer {
 	return &ensurer{
-		logger: logger.WithName("openstack-controlplane-ensurer"),
+		logger:           logger.WithName("openstack-controlplane-ensurer"),
+		egressRestricted: egressRestricted,
+		scheduling:       scheduling,
 	}
 }
 
 type ensurer struct {
 	genericmutator.NoopEnsurer
-	logger logr.Logger
+	logger           logr.Logger
+	egressRestricted bool
+	scheduling       config.ControlPlaneScheduling
 }
 
 // ImageVector is exposed for testing.
@@ -60,9 +65,39 @@ func (e *ensurer) EnsureMachineControllerManagerDeployment(_ context.Context, _
 		newObj.Spec.Template.Spec.Containers,
 		machinecontrollermanager.ProviderSidecarContainer(newObj.Namespace, openstack.Name, image.String()),
 	)
+
+	// the egress traffic to the OpenStack endpoints is allowed by the network policy deployed with the control plane
+	if e.egressRestricted {
+		delete(newObj.Spec.Template.Labels, v1beta1constants.LabelNetworkPolicyToPublicNetworks)
+		delete(newObj.Spec.Template.Labels, v1beta1constants.LabelNetworkPolicyToPrivateNetworks)
+		metav1.SetMetaDataLabel(&newObj.Spec.Template.ObjectMeta, openstack.LabelNetworkPolicyToOpenStackEndpoints, v1beta1constants.LabelNetworkPolicyAllowed)
+	}
+
+	if e.scheduling.PriorityClassName != nil {
+		newObj.Spec.Template.Spec.PriorityClassName = *e.scheduling.PriorityClassName
+	}
+	for _, topologyKey := range e.scheduling.TopologySpreadKeys {
+		newObj.Spec.Template.Spec.TopologySpreadConstraints = ensureTopologySpreadConstraint(newObj.Spec.Template.Spec.TopologySpreadConstraints, corev1.TopologySpreadConstraint{
+			MaxSkew:           1,
+			TopologyKey:       topologyKey,
+			WhenUnsatisfiable: corev1.ScheduleAnyway,
+			LabelSelector:     newObj.Spec.Selector,
+		})
+	}
 	return nil
 }
 
+// ensureTopologySpreadConstraint adds the given constraint or replaces the existing constraint with the same topology key.
+func ensureTopologySpreadConstraint(constraints []corev1.TopologySpreadConstraint, constraint corev1.TopologySpreadConstraint) []corev1.TopologySpreadConstraint {
+	for i := range constraints {
+		if constraints[i].TopologyKey == constraint.TopologyKey {
+			constraints[i] = constraint
+			return constraints
+		}
+	}
+	return append(constraints, constraint)
+}
+
 // EnsureMachineControllerManagerVPA ensures that the machine-controller-manager VPA conforms to the provider requirements.
 func (e *ensurer) EnsureMachineControllerManagerVPA(_ context.Context, _ gcontext.GardenContext, newObj, _ *vpaautoscalingv1.VerticalPodAutoscaler) error {
 	var (
@@ -36,6 +36,7 @@ import (
 	kubeletconfigv1beta1 "k8s.io/kubelet/config/v1beta1"
 	"k8s.io/utils/pointer"
 
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/config"
 	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
 )
 
@@ -147,7 +148,7 @@ var _ = Describe("Ensurer", func() {
 
 	BeforeEach(func() {
 		ctrl = gomock.NewController(GinkgoT())
-		ensurer = NewEnsurer(logger)
+		ensurer = NewEnsurer(logger, false, config.ControlPlaneScheduling{})
 	})
 
 	AfterEach(func() {
@@ -314,7 +315,7 @@ var _ = Describe("Ensurer", func() {
 				},
 			}
 
-			ensurer = NewEnsurer(logger)
+			ensurer = NewEnsurer(logger, false, config.ControlPlaneScheduling{})
 		})
 
 		It("should add missing elements to kube-scheduler deployment (k8s < 1.26)", func() {
@@ -361,7 +362,7 @@ var _ = Describe("Ensurer", func() {
 				},
 			}
 
-			ensurer = NewEnsurer(logger)
+			ensurer = NewEnsurer(logger, false, config.ControlPlaneScheduling{})
 		})
 
 		It("should add missing elements to cluster-autoscaler deployment (k8s < 1.26))", func() {
@@ -505,7 +506,7 @@ WantedBy=multi-user.target
 
 		It("should add additional units if resolvConfOptions field is not set", func() {
 			// Create ensurer
-			ensurer := NewEnsurer(logger)
+			ensurer := NewEnsurer(logger, false, config.ControlPlaneScheduling{})
 
 			// Call EnsureAdditionalUnits method and check the result
 			err := ensurer.EnsureAdditionalUnits(ctx, eContextK8s126, &units, nil)
@@ -523,7 +524,7 @@ WantedBy=multi-user.target
 			)
 
 			// Create ensurer
-			ensurer := NewEnsurer(logger)
+			ensurer := NewEnsurer(logger, false, config.ControlPlaneScheduling{})
 
 			// Call EnsureAdditionalUnits method and check the result
 			err := ensurer.EnsureAdditionalUnits(ctx, eContextK8s126WithResolvConfOptions, &units, nil)
@@ -556,7 +557,7 @@ WantedBy=multi-user.target
 		It("should add additional files to the current ones if resolvConfOptions field is not set", func() {
 			files := []extensionsv1alpha1.File{oldFile}
 			// Create ensurer
-			ensurer := NewEnsurer(logger)
+			ensurer := NewEnsurer(logger, false, config.ControlPlaneScheduling{})
 
 			// Call EnsureAdditionalFiles method and check the result
 			err := ensurer.EnsureAdditionalFiles(ctx, eContextK8s126, &files, nil)
@@ -567,7 +568,7 @@ WantedBy=multi-user.target
 			files := []extensionsv1alpha1.File{oldFile}
 
 			// Create ensurer
-			ensurer := NewEnsurer(logger)
+			ensurer := NewEnsurer(logger, false, config.ControlPlaneScheduling{})
 
 			// Call EnsureAdditionalFiles method and check the result
 			err := ensurer.EnsureAdditionalFiles(ctx, eContextK8s126WithResolvConfOptions, &files, nil)
@@ -582,7 +583,7 @@ WantedBy=multi-user.target
 			)
 
 			// Create ensurer
-			ensurer := NewEnsurer(logger)
+			ensurer := NewEnsurer(logger, false, config.ControlPlaneScheduling{})
 
 			// Call EnsureAdditionalFiles method and check the result
 			err := ensurer.EnsureAdditionalFiles(ctx, eContextK8s126WithResolvConfOptions, &files, nil)
@@ -603,7 +604,7 @@ WantedBy=multi-user.target
 		})
 
 		BeforeEach(func() {
-			ensurer = NewEnsurer(logger)
+			ensurer = NewEnsurer(logger, false, config.ControlPlaneScheduling{})
 			DeferCleanup(testutils.WithVar(&ImageVector, imagevector.ImageVector{{
 				Name:       "machine-controller-manager-provider-openstack",
 				Repository: "foo",
@@ -653,6 +654,41 @@ WantedBy=multi-user.target
 				}},
 			}))
 		})
+
+		It("should replace the network policy labels if the egress traffic is restricted", func() {
+			deployment.Spec.Template.Labels = map[string]string{
+				"networking.gardener.cloud/to-dns":              "allowed",
+				"networking.gardener.cloud/to-public-networks":  "allowed",
+				"networking.gardener.cloud/to-private-networks": "allowed",
+			}
+
+			ensurer = NewEnsurer(logger, true, config.ControlPlaneScheduling{})
+			Expect(ensurer.EnsureMachineControllerManagerDeployment(context.TODO(), nil, deployment, nil)).To(BeNil())
+			Expect(deployment.Spec.Template.Labels).To(Equal(map[string]string{
+				"networking.gardener.cloud/to-dns":                 "allowed",
+				"networking.gardener.cloud/to-openstack-endpoints": "allowed",
+			}))
+		})
+
+		It("should apply the scheduling config", func() {
+			selector := &metav1.LabelSelector{MatchLabels: map[string]string{"role": "machine-controller-manager"}}
+			deployment.Spec.Selector = selector
+			deployment.Spec.Template.Spec.PriorityClassName = "gardener-system-300"
+			deployment.Spec.Template.Spec.TopologySpreadConstraints = []corev1.TopologySpreadConstraint{
+				{MaxSkew: 2, TopologyKey: "topology.kubernetes.io/zone", WhenUnsatisfiable: corev1.DoNotSchedule},
+			}
+
+			ensurer = NewEnsurer(logger, false, config.ControlPlaneScheduling{
+				PriorityClassName:  pointer.String("openstack-control-plane"),
+				TopologySpreadKeys: []string{"topology.kubernetes.io/zone", "kubernetes.io/hostname"},
+			})
+			Expect(ensurer.EnsureMachineControllerManagerDeployment(context.TODO(), nil, deployment, nil)).To(BeNil())
+			Expect(deployment.Spec.Template.Spec.PriorityClassName).To(Equal("openstack-control-plane"))
+			Expect(deployment.Spec.Template.Spec.TopologySpreadConstraints).To(Equal([]corev1.TopologySpreadConstraint{
+				{MaxSkew: 1, TopologyKey: "topology.kubernetes.io/zone", WhenUnsatisfiable: corev1.ScheduleAnyway, LabelSelector: selector},
+				{MaxSkew: 1, TopologyKey: "kubernetes.io/hostname", WhenUnsatisfiable: corev1.ScheduleAnyway, LabelSelector: selector},
+			}))
+		})
 	})
 
 	Describe("#EnsureMachineControllerManagerVPA", func() {
@@ -666,7 +702,7 @@ WantedBy=multi-user.target
 		})
 
 		BeforeEach(func() {
-			ensurer = NewEnsurer(logger)
+			ensurer = NewEnsurer(logger, false, config.ControlPlaneScheduling{})
 		})
 
 		It("should inject the sidecar container policy", func() {
@@ -27,6 +27,8 @@ var (
 type AddOptions struct {
 	// ETCDStorage is the etcd storage configuration.
 	ETCDStorage config.ETCDStorage
+	// ZoneRedundantLoadBalancer is the config of the zone-redundant load balancers of the kube-apiservers.
+	ZoneRedundantLoadBalancer *config.ZoneRedundantLoadBalancer
 }
 
 var logger = log.Log.WithName("openstack-controlplaneexposure-webhook")
@@ -42,7 +44,7 @@ func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) (*extensionsw
 			{Obj: &corev1.Service{}},
 			{Obj: &druidv1alpha1.Etcd{}},
 		},
-		Mutator: genericmutator.NewMutator(mgr, NewEnsurer(&opts.ETCDStorage, logger), nil, nil, nil, logger),
+		Mutator: genericmutator.NewMutator(mgr, NewEnsurer(&opts.ETCDStorage, opts.ZoneRedundantLoadBalancer, logger), nil, nil, nil, logger),
 	})
 }
 
@@ -12,23 +12,85 @@ import (
 	"github.com/gardener/gardener/extensions/pkg/webhook/controlplane/genericmutator"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/config"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/helper"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+)
+
+const (
+	// AnnotationLoadBalancerFlavorID is the annotation of services of type LoadBalancer for the id of the Octavia flavor
+	// of the load balancer created by the OpenStack cloud-controller-manager.
+	AnnotationLoadBalancerFlavorID = "loadbalancer.openstack.org/flavor-id"
+	// AnnotationLoadBalancerAvailabilityZone is the annotation of services of type LoadBalancer for the Octavia
+	// availability zone of the load balancer created by the OpenStack cloud-controller-manager.
+	AnnotationLoadBalancerAvailabilityZone = "loadbalancer.openstack.org/availability-zone"
 )
 
 // NewEnsurer creates a new controlplaneexposure ensurer.
-func NewEnsurer(etcdStorage *config.ETCDStorage, logger logr.Logger) genericmutator.Ensurer {
+func NewEnsurer(etcdStorage *config.ETCDStorage, zoneRedundantLoadBalancer *config.ZoneRedundantLoadBalancer, logger logr.Logger) genericmutator.Ensurer {
 	return &ensurer{
-		etcdStorage: etcdStorage,
-		logger:      logger.WithName("openstack-controlplaneexposure-ensurer"),
+		etcdStorage:               etcdStorage,
+		zoneRedundantLoadBalancer: zoneRedundantLoadBalancer,
+		logger:                    logger.WithName("openstack-controlplaneexposure-ensurer"),
 	}
 }
 
 type ensurer struct {
 	genericmutator.NoopEnsurer
-	etcdStorage *config.ETCDStorage
-	logger      logr.Logger
+	etcdStorage               *config.ETCDStorage
+	zoneRedundantLoadBalancer *config.ZoneRedundantLoadBalancer
+	logger                    logr.Logger
+}
+
+// EnsureKubeAPIServerService ensures that the load balancer of the kube-apiserver service is zone-redundant if the
+// shoot requests it and the seed offers zone-redundant load balancers. As the webhook is called for the kube-apiservers
+// of all shoots in the seed, services of shoots of other providers are left untouched.
+func (e *ensurer) EnsureKubeAPIServerService(ctx context.Context, gctx gcontext.GardenContext, newObj, _ *corev1.Service) error {
+	if newObj.Spec.Type != corev1.ServiceTypeLoadBalancer || e.zoneRedundantLoadBalancer == nil {
+		return nil
+	}
+
+	cluster, err := gctx.GetCluster(ctx)
+	if err != nil {
+		return err
+	}
+	if cluster.Shoot == nil || cluster.Shoot.Spec.Provider.Type != openstack.Type {
+		return nil
+	}
+	cpConfig, err := helper.ControlPlaneConfigFromCluster(cluster)
+	if err != nil {
+		return err
+	}
+
+	if cpConfig.Exposure == nil || !cpConfig.Exposure.ZoneRedundant {
+		// only remove the annotations set by this webhook before, e.g. if the shoot no longer requests a zone-redundant
+		// load balancer
+		deleteAnnotationWithValue(newObj, AnnotationLoadBalancerFlavorID, e.zoneRedundantLoadBalancer.FlavorID)
+		deleteAnnotationWithValue(newObj, AnnotationLoadBalancerAvailabilityZone, e.zoneRedundantLoadBalancer.AvailabilityZone)
+		return nil
+	}
+
+	setOrDeleteAnnotation(newObj, AnnotationLoadBalancerFlavorID, e.zoneRedundantLoadBalancer.FlavorID)
+	setOrDeleteAnnotation(newObj, AnnotationLoadBalancerAvailabilityZone, e.zoneRedundantLoadBalancer.AvailabilityZone)
+	return nil
+}
+
+func setOrDeleteAnnotation(obj *corev1.Service, key string, value *string) {
+	if value == nil {
+		delete(obj.Annotations, key)
+		return
+	}
+	metav1.SetMetaDataAnnotation(&obj.ObjectMeta, key, *value)
+}
+
+func deleteAnnotationWithValue(obj *corev1.Service, key string, value *string) {
+	if value != nil && obj.Annotations[key] == *value {
+		delete(obj.Annotations, key)
+	}
 }
 
 // EnsureETCD ensures that the etcd conform to the provider requirements.
@@ -9,17 +9,22 @@ import (
 	"testing"
 
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/v1alpha1"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	gcontext "github.com/gardener/gardener/extensions/pkg/webhook/context"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/utils"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/pointer"
 
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/config"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
 )
 
 func TestController(t *testing.T) {
@@ -56,7 +61,7 @@ var _ = Describe("Ensurer", func() {
 			)
 
 			// Create ensurer
-			ensurer := NewEnsurer(etcdStorage, logger)
+			ensurer := NewEnsurer(etcdStorage, nil, logger)
 
 			// Call EnsureETCDStatefulSet method and check the result
 			err := ensurer.EnsureETCD(context.TODO(), dummyContext, etcd, nil)
@@ -76,7 +81,7 @@ var _ = Describe("Ensurer", func() {
 			)
 
 			// Create ensurer
-			ensurer := NewEnsurer(etcdStorage, logger)
+			ensurer := NewEnsurer(etcdStorage, nil, logger)
 
 			// Call EnsureETCDStatefulSet method and check the result
 			err := ensurer.EnsureETCD(context.TODO(), dummyContext, etcd, nil)
@@ -92,7 +97,7 @@ var _ = Describe("Ensurer", func() {
 			)
 
 			// Create ensurer
-			ensurer := NewEnsurer(etcdStorage, logger)
+			ensurer := NewEnsurer(etcdStorage, nil, logger)
 
 			// Call EnsureETCDStatefulSet method and check the result
 			err := ensurer.EnsureETCD(context.TODO(), dummyContext, etcd, nil)
@@ -112,7 +117,7 @@ var _ = Describe("Ensurer", func() {
 			)
 
 			// Create ensurer
-			ensurer := NewEnsurer(etcdStorage, logger)
+			ensurer := NewEnsurer(etcdStorage, nil, logger)
 
 			// Call EnsureETCDStatefulSet method and check the result
 			err := ensurer.EnsureETCD(context.TODO(), dummyContext, etcd, nil)
@@ -120,6 +125,105 @@ var _ = Describe("Ensurer", func() {
 			checkETCDEvents(etcd)
 		})
 	})
+
+	Describe("#EnsureKubeAPIServerService", func() {
+		var (
+			zoneRedundantLoadBalancer = &config.ZoneRedundantLoadBalancer{
+				FlavorID:         pointer.String("active-standby"),
+				AvailabilityZone: pointer.String("multi-az"),
+			}
+
+			svc *corev1.Service
+		)
+
+		gardenContextWithProvider := func(providerType, cpConfig string) gcontext.GardenContext {
+			return gcontext.NewInternalGardenContext(&extensionscontroller.Cluster{
+				Shoot: &gardencorev1beta1.Shoot{
+					Spec: gardencorev1beta1.ShootSpec{
+						Provider: gardencorev1beta1.Provider{
+							Type:               providerType,
+							ControlPlaneConfig: &runtime.RawExtension{Raw: []byte(cpConfig)},
+						},
+					},
+				},
+			})
+		}
+		gardenContext := func(cpConfig string) gcontext.GardenContext {
+			return gardenContextWithProvider(openstack.Type, cpConfig)
+		}
+
+		BeforeEach(func() {
+			svc = &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: v1beta1constants.DeploymentNameKubeAPIServer},
+				Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+			}
+		})
+
+		It("should request a zone-redundant load balancer", func() {
+			ensurer := NewEnsurer(etcdStorage, zoneRedundantLoadBalancer, logger)
+
+			Expect(ensurer.EnsureKubeAPIServerService(context.TODO(), gardenContext(`{"apiVersion":"openstack.provider.extensions.gardener.cloud/v1alpha1","kind":"ControlPlaneConfig","exposure":{"zoneRedundant":true}}`), svc, nil)).To(Succeed())
+			Expect(svc.Annotations).To(Equal(map[string]string{
+				AnnotationLoadBalancerFlavorID:         "active-standby",
+				AnnotationLoadBalancerAvailabilityZone: "multi-az",
+			}))
+		})
+
+		It("should remove the annotations if the shoot does not request a zone-redundant load balancer", func() {
+			ensurer := NewEnsurer(etcdStorage, zoneRedundantLoadBalancer, logger)
+			svc.Annotations = map[string]string{
+				AnnotationLoadBalancerFlavorID:         "active-standby",
+				AnnotationLoadBalancerAvailabilityZone: "multi-az",
+			}
+
+			Expect(ensurer.EnsureKubeAPIServerService(context.TODO(), gardenContext(`{"apiVersion":"openstack.provider.extensions.gardener.cloud/v1alpha1","kind":"ControlPlaneConfig"}`), svc, nil)).To(Succeed())
+			Expect(svc.Annotations).To(BeEmpty())
+		})
+
+		It("should keep annotations not set by the webhook if the shoot does not request a zone-redundant load balancer", func() {
+			ensurer := NewEnsurer(etcdStorage, zoneRedundantLoadBalancer, logger)
+			svc.Annotations = map[string]string{
+				AnnotationLoadBalancerFlavorID: "custom",
+			}
+
+			Expect(ensurer.EnsureKubeAPIServerService(context.TODO(), gardenContext(`{"apiVersion":"openstack.provider.extensions.gardener.cloud/v1alpha1","kind":"ControlPlaneConfig"}`), svc, nil)).To(Succeed())
+			Expect(svc.Annotations).To(Equal(map[string]string{
+				AnnotationLoadBalancerFlavorID: "custom",
+			}))
+		})
+
+		It("should not touch the service if the seed does not offer zone-redundant load balancers", func() {
+			ensurer := NewEnsurer(etcdStorage, nil, logger)
+			svc.Annotations = map[string]string{
+				AnnotationLoadBalancerFlavorID: "custom",
+			}
+
+			Expect(ensurer.EnsureKubeAPIServerService(context.TODO(), gardenContext(`{"apiVersion":"openstack.provider.extensions.gardener.cloud/v1alpha1","kind":"ControlPlaneConfig","exposure":{"zoneRedundant":true}}`), svc, nil)).To(Succeed())
+			Expect(svc.Annotations).To(Equal(map[string]string{
+				AnnotationLoadBalancerFlavorID: "custom",
+			}))
+		})
+
+		It("should not touch the services of shoots of other providers", func() {
+			ensurer := NewEnsurer(etcdStorage, zoneRedundantLoadBalancer, logger)
+			svc.Annotations = map[string]string{
+				AnnotationLoadBalancerFlavorID: "active-standby",
+			}
+
+			Expect(ensurer.EnsureKubeAPIServerService(context.TODO(), gardenContextWithProvider("aws", `{"apiVersion":"aws.provider.extensions.gardener.cloud/v1alpha1","kind":"ControlPlaneConfig"}`), svc, nil)).To(Succeed())
+			Expect(svc.Annotations).To(Equal(map[string]string{
+				AnnotationLoadBalancerFlavorID: "active-standby",
+			}))
+		})
+
+		It("should not modify services of other types", func() {
+			ensurer := NewEnsurer(etcdStorage, zoneRedundantLoadBalancer, logger)
+			svc.Spec.Type = corev1.ServiceTypeClusterIP
+
+			Expect(ensurer.EnsureKubeAPIServerService(context.TODO(), dummyContext, svc, nil)).To(Succeed())
+			Expect(svc.Annotations).To(BeEmpty())
+		})
+	})
 })
 
 func checkETCDMain(etcd *druidv1alpha1.Etcd) {
@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tuning
+
+import (
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+)
+
+const (
+	// WebhookName is the name of the tuning webhook.
+	WebhookName = "tuning"
+	// WebhookPath is the path of the tuning webhook.
+	WebhookPath = "tuning"
+)
+
+var logger = log.Log.WithName("openstack-tuning-webhook")
+
+// AddToManager creates a webhook applying the tuning profiles of the worker pools to their operating system configs and
+// adds it to the manager.
+func AddToManager(mgr manager.Manager) (*extensionswebhook.Webhook, error) {
+	logger.Info("Adding webhook to manager")
+	return extensionswebhook.New(mgr, extensionswebhook.Args{
+		Provider: openstack.Type,
+		Name:     WebhookName,
+		Path:     WebhookPath,
+		Target:   extensionswebhook.TargetSeed,
+		Selector: &metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: v1beta1constants.LabelShootProvider, Operator: metav1.LabelSelectorOpIn, Values: []string{openstack.Type}},
+			},
+		},
+		Mutators: map[extensionswebhook.Mutator][]extensionswebhook.Type{
+			NewMutator(mgr.GetClient(), logger): {{Obj: &extensionsv1alpha1.OperatingSystemConfig{}}},
+		},
+	})
+}
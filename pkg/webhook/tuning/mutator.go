@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tuning
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	oscutils "github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/utils"
+	"github.com/go-logr/logr"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/helper"
+)
+
+// Profiles are the kernel settings of the tuning profiles.
+var Profiles = map[api.TuningProfile]map[string]string{
+	api.TuningProfileHighConnectionCount: {
+		// Widen the ephemeral port range, but keep the node ports of Kubernetes services out of it.
+		"net.ipv4.ip_local_port_range":     "1024 65535",
+		"net.ipv4.ip_local_reserved_ports": "30000-32767",
+		"net.core.somaxconn":               "65535",
+		"net.ipv4.tcp_max_syn_backlog":     "65535",
+		"net.ipv4.tcp_fin_timeout":         "15",
+		"net.ipv4.tcp_max_tw_buckets":      "2000000",
+		"net.netfilter.nf_conntrack_max":   "4194304",
+	},
+	api.TuningProfileHighThroughput: {
+		"net.core.rmem_max":           "67108864",
+		"net.core.wmem_max":           "67108864",
+		"net.ipv4.tcp_rmem":           "4096 87380 67108864",
+		"net.ipv4.tcp_wmem":           "4096 65536 67108864",
+		"net.core.netdev_max_backlog": "250000",
+		"net.core.netdev_budget":      "600",
+		// Discover the path MTU, as the MTU of tenant networks is often lowered by the encapsulation of the overlay.
+		"net.ipv4.tcp_mtu_probing": "1",
+	},
+}
+
+type mutator struct {
+	client client.Client
+	logger logr.Logger
+	codec  oscutils.FileContentInlineCodec
+}
+
+// NewMutator creates a new mutator which applies the tuning profile of a worker pool to the kernel settings of its
+// operating system configs.
+func NewMutator(c client.Client, logger logr.Logger) extensionswebhook.Mutator {
+	return &mutator{
+		client: c,
+		logger: logger,
+		codec:  oscutils.NewFileContentInlineCodec(),
+	}
+}
+
+// Mutate merges the kernel settings of the tuning profile of the worker pool of the given operating system config into
+// the kernel settings file of Gardener. Kernel settings of the worker pool in the shoot take precedence.
+func (m *mutator) Mutate(ctx context.Context, newObj, _ client.Object) error {
+	osc, ok := newObj.(*extensionsv1alpha1.OperatingSystemConfig)
+	if !ok {
+		return fmt.Errorf("wrong object type %T", newObj)
+	}
+	if osc.DeletionTimestamp != nil || osc.Spec.Purpose != extensionsv1alpha1.OperatingSystemConfigPurposeReconcile {
+		return nil
+	}
+	poolName, ok := osc.Labels[v1beta1constants.LabelWorkerPool]
+	if !ok {
+		return nil
+	}
+
+	cluster, err := extensionscontroller.GetCluster(ctx, m.client, osc.Namespace)
+	if err != nil {
+		return fmt.Errorf("could not get cluster: %w", err)
+	}
+	if cluster.Shoot == nil {
+		return nil
+	}
+
+	for _, pool := range cluster.Shoot.Spec.Provider.Workers {
+		if pool.Name != poolName || pool.ProviderConfig == nil {
+			continue
+		}
+
+		workerConfig, err := helper.WorkerConfigFromRawExtension(pool.ProviderConfig)
+		if err != nil {
+			return fmt.Errorf("could not decode provider config of worker pool %q: %w", pool.Name, err)
+		}
+		if workerConfig.TuningProfile == nil {
+			return nil
+		}
+
+		settings := map[string]string{}
+		for key, value := range Profiles[*workerConfig.TuningProfile] {
+			if _, ok := pool.Sysctls[key]; !ok {
+				settings[key] = value
+			}
+		}
+		m.logger.V(1).Info("Applying tuning profile", "operatingSystemConfig", client.ObjectKeyFromObject(osc), "profile", *workerConfig.TuningProfile)
+		return m.ensureKernelSettings(osc, settings)
+	}
+	return nil
+}
+
+// ensureKernelSettings sets the given kernel settings in the kernel settings file of the given operating system config.
+// The file is added if it does not exist, and its settings are kept in a well-defined order.
+func (m *mutator) ensureKernelSettings(osc *extensionsv1alpha1.OperatingSystemConfig, settings map[string]string) error {
+	if len(settings) == 0 {
+		return nil
+	}
+
+	idx := slices.IndexFunc(osc.Spec.Files, func(file extensionsv1alpha1.File) bool {
+		return file.Path == v1beta1constants.OperatingSystemConfigFilePathKernelSettings
+	})
+	if idx < 0 {
+		osc.Spec.Files = append(osc.Spec.Files, extensionsv1alpha1.File{
+			Path:        v1beta1constants.OperatingSystemConfigFilePathKernelSettings,
+			Permissions: pointer.Int32(0o644),
+			Content:     extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{}},
+		})
+		idx = len(osc.Spec.Files) - 1
+	}
+	file := &osc.Spec.Files[idx]
+	if file.Content.Inline == nil {
+		return fmt.Errorf("kernel settings file %s has no inline content", file.Path)
+	}
+
+	data, err := m.codec.Decode(file.Content.Inline)
+	if err != nil {
+		return fmt.Errorf("could not decode kernel settings file %s: %w", file.Path, err)
+	}
+
+	var (
+		keys    []string
+		current = map[string]string{}
+	)
+	for _, line := range strings.Split(string(data), "\n") {
+		key, value, found := strings.Cut(line, "=")
+		key = strings.TrimSpace(key)
+		if !found || key == "" || strings.HasPrefix(key, "#") || strings.HasPrefix(key, ";") {
+			continue
+		}
+		if _, ok := current[key]; !ok {
+			keys = append(keys, key)
+		}
+		current[key] = strings.TrimSpace(value)
+	}
+	for key, value := range settings {
+		if _, ok := current[key]; !ok {
+			keys = append(keys, key)
+		}
+		current[key] = value
+	}
+	slices.Sort(keys)
+
+	var content strings.Builder
+	for _, key := range keys {
+		fmt.Fprintf(&content, "%s = %s\n", key, current[key])
+	}
+
+	inline, err := m.codec.Encode([]byte(content.String()), file.Content.Inline.Encoding)
+	if err != nil {
+		return fmt.Errorf("could not encode kernel settings file %s: %w", file.Path, err)
+	}
+	file.Content.Inline = inline
+	return nil
+}
@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tuning_test
+
+import (
+	"context"
+	"encoding/json"
+
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/gardener/gardener-extension-provider-openstack/pkg/webhook/tuning"
+)
+
+var _ = Describe("Mutator", func() {
+	const namespace = "shoot--foo--bar"
+
+	var (
+		ctx = context.Background()
+
+		c       client.Client
+		mutator extensionswebhook.Mutator
+		osc     *extensionsv1alpha1.OperatingSystemConfig
+
+		newCluster = func(workers ...gardencorev1beta1.Worker) *extensionsv1alpha1.Cluster {
+			shoot, err := json.Marshal(&gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{Provider: gardencorev1beta1.Provider{Workers: workers}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			return &extensionsv1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: namespace},
+				Spec: extensionsv1alpha1.ClusterSpec{
+					CloudProfile: runtime.RawExtension{Raw: []byte(`{}`)},
+					Seed:         runtime.RawExtension{Raw: []byte(`{}`)},
+					Shoot:        runtime.RawExtension{Raw: shoot},
+				},
+			}
+		}
+		workerWithProfile = func(profile string, sysctls map[string]string) gardencorev1beta1.Worker {
+			return gardencorev1beta1.Worker{
+				Name: "ingress",
+				ProviderConfig: &runtime.RawExtension{
+					Raw: []byte(`{"apiVersion":"openstack.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","tuningProfile":"` + profile + `"}`),
+				},
+				Sysctls: sysctls,
+			}
+		}
+		kernelSettings = func() string {
+			for _, file := range osc.Spec.Files {
+				if file.Path == v1beta1constants.OperatingSystemConfigFilePathKernelSettings {
+					return file.Content.Inline.Data
+				}
+			}
+			return ""
+		}
+	)
+
+	BeforeEach(func() {
+		osc = &extensionsv1alpha1.OperatingSystemConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      "cloud-config-ingress",
+				Labels:    map[string]string{v1beta1constants.LabelWorkerPool: "ingress"},
+			},
+			Spec: extensionsv1alpha1.OperatingSystemConfigSpec{
+				Purpose: extensionsv1alpha1.OperatingSystemConfigPurposeReconcile,
+				Files: []extensionsv1alpha1.File{{
+					Path: v1beta1constants.OperatingSystemConfigFilePathKernelSettings,
+					Content: extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{
+						Data: "net.core.somaxconn = 32768\nnet.ipv4.ip_local_port_range = 32768 65535\nvm.max_map_count = 135217728\n",
+					}},
+				}},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		mutator = NewMutator(c, logr.Discard())
+	})
+
+	Context("worker pool with tuning profile", func() {
+		BeforeEach(func() {
+			c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(
+				newCluster(workerWithProfile("high-connection-count", map[string]string{"net.core.somaxconn": "4096"})),
+			).Build()
+		})
+
+		It("should merge the kernel settings of the profile, giving precedence to the settings of the worker pool", func() {
+			Expect(mutator.Mutate(ctx, osc, nil)).To(Succeed())
+
+			Expect(kernelSettings()).To(Equal(`net.core.somaxconn = 32768
+net.ipv4.ip_local_port_range = 1024 65535
+net.ipv4.ip_local_reserved_ports = 30000-32767
+net.ipv4.tcp_fin_timeout = 15
+net.ipv4.tcp_max_syn_backlog = 65535
+net.ipv4.tcp_max_tw_buckets = 2000000
+net.netfilter.nf_conntrack_max = 4194304
+vm.max_map_count = 135217728
+`))
+		})
+
+		It("should not mutate the operating system config used for the provisioning", func() {
+			osc.Spec.Purpose = extensionsv1alpha1.OperatingSystemConfigPurposeProvision
+			expected := osc.DeepCopy()
+
+			Expect(mutator.Mutate(ctx, osc, nil)).To(Succeed())
+			Expect(osc).To(Equal(expected))
+		})
+
+		It("should not mutate operating system configs of other worker pools", func() {
+			osc.Labels[v1beta1constants.LabelWorkerPool] = "other"
+			expected := osc.DeepCopy()
+
+			Expect(mutator.Mutate(ctx, osc, nil)).To(Succeed())
+			Expect(osc).To(Equal(expected))
+		})
+	})
+
+	Context("worker pool without tuning profile", func() {
+		BeforeEach(func() {
+			c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(
+				newCluster(gardencorev1beta1.Worker{Name: "ingress"}),
+			).Build()
+		})
+
+		It("should not mutate the operating system config", func() {
+			expected := osc.DeepCopy()
+
+			Expect(mutator.Mutate(ctx, osc, nil)).To(Succeed())
+			Expect(osc).To(Equal(expected))
+		})
+	})
+})